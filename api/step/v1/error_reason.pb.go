@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/go-kratos/kratos/v2/errors"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -24,19 +25,82 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
-	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND           ErrorReason = 1
+	// 请求中没有用户身份
+	ErrorReason_UNAUTHENTICATED ErrorReason = 2
+	// 访问他人的目标、积累等
+	ErrorReason_NOT_OWNER        ErrorReason = 3
+	ErrorReason_TARGET_NOT_FOUND ErrorReason = 4
+	ErrorReason_STEP_NOT_FOUND   ErrorReason = 5
+	// 其它实体不存在
+	ErrorReason_RESOURCE_NOT_FOUND ErrorReason = 6
+	// 违反唯一约束或外键约束
+	ErrorReason_CONFLICT               ErrorReason = 7
+	ErrorReason_INVALID_ARGUMENT       ErrorReason = 8
+	ErrorReason_INVALID_COMMENT_TYPE   ErrorReason = 9
+	ErrorReason_COMMENT_ALREADY_EXISTS ErrorReason = 10
+	// 积累没有分享或已超过评论时间
+	ErrorReason_COMMENT_NOT_ALLOWED ErrorReason = 11
+	// 上传的文件为空或类型不允许
+	ErrorReason_UPLOAD_REJECTED ErrorReason = 12
+	// 同一目标的导出、导入、报告任务正在进行
+	ErrorReason_TASK_RUNNING ErrorReason = 13
+	// 分享链接的share_to无效或者不包含评论角色
+	ErrorReason_INVALID_SHARE_TOKEN ErrorReason = 14
+	// 免登录接口超过IP或积累的请求频率
+	ErrorReason_RATE_LIMITED ErrorReason = 15
+	// 评论挑战的nonce无效、过期、已使用或者solution不满足难度
+	ErrorReason_INVALID_CHALLENGE ErrorReason = 16
+	// 邀请码不存在、已过期或者是自己的邀请
+	ErrorReason_INVALID_INVITE ErrorReason = 17
+	// 不是积累所有者关联的老师或家长
+	ErrorReason_NOT_REVIEWER ErrorReason = 18
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "GREETER_UNSPECIFIED",
-		1: "USER_NOT_FOUND",
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "USER_NOT_FOUND",
+		2:  "UNAUTHENTICATED",
+		3:  "NOT_OWNER",
+		4:  "TARGET_NOT_FOUND",
+		5:  "STEP_NOT_FOUND",
+		6:  "RESOURCE_NOT_FOUND",
+		7:  "CONFLICT",
+		8:  "INVALID_ARGUMENT",
+		9:  "INVALID_COMMENT_TYPE",
+		10: "COMMENT_ALREADY_EXISTS",
+		11: "COMMENT_NOT_ALLOWED",
+		12: "UPLOAD_REJECTED",
+		13: "TASK_RUNNING",
+		14: "INVALID_SHARE_TOKEN",
+		15: "RATE_LIMITED",
+		16: "INVALID_CHALLENGE",
+		17: "INVALID_INVITE",
+		18: "NOT_REVIEWER",
 	}
 	ErrorReason_value = map[string]int32{
-		"GREETER_UNSPECIFIED": 0,
-		"USER_NOT_FOUND":      1,
+		"ERROR_REASON_UNSPECIFIED": 0,
+		"USER_NOT_FOUND":           1,
+		"UNAUTHENTICATED":          2,
+		"NOT_OWNER":                3,
+		"TARGET_NOT_FOUND":         4,
+		"STEP_NOT_FOUND":           5,
+		"RESOURCE_NOT_FOUND":       6,
+		"CONFLICT":                 7,
+		"INVALID_ARGUMENT":         8,
+		"INVALID_COMMENT_TYPE":     9,
+		"COMMENT_ALREADY_EXISTS":   10,
+		"COMMENT_NOT_ALLOWED":      11,
+		"UPLOAD_REJECTED":          12,
+		"TASK_RUNNING":             13,
+		"INVALID_SHARE_TOKEN":      14,
+		"RATE_LIMITED":             15,
+		"INVALID_CHALLENGE":        16,
+		"INVALID_INVITE":           17,
+		"NOT_REVIEWER":             18,
 	}
 )
 
@@ -72,14 +136,45 @@ var File_step_v1_error_reason_proto protoreflect.FileDescriptor
 var file_step_v1_error_reason_proto_rawDesc = string([]byte{
 	0x0a, 0x1a, 0x73, 0x74, 0x65, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
 	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x74,
-	0x65, 0x70, 0x2e, 0x76, 0x31, 0x2a, 0x3a, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x52, 0x45, 0x45, 0x54, 0x45, 0x52, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x01, 0x42, 0x2c, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x70, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x13,
-	0x73, 0x74, 0x65, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x65, 0x70, 0x2f, 0x76, 0x31,
-	0x3b, 0x76, 0x31, 0xa2, 0x02, 0x09, 0x41, 0x50, 0x49, 0x53, 0x74, 0x65, 0x70, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x99, 0x04, 0x0a, 0x0b, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x04, 0xa8, 0x45,
+	0x94, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49,
+	0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x13, 0x0a,
+	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x1a, 0x04, 0xa8, 0x45,
+	0x93, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x18,
+	0x0a, 0x0e, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x05, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06,
+	0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x12, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49,
+	0x43, 0x54, 0x10, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08,
+	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x09,
+	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x20, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53,
+	0x10, 0x0a, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1d, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10,
+	0x0b, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x55, 0x50, 0x4c, 0x4f, 0x41,
+	0x44, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x0d, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1d, 0x0a, 0x13, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x10, 0x0e, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x0f, 0x1a, 0x04, 0xa8, 0x45, 0xad,
+	0x03, 0x12, 0x1b, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x41,
+	0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x10, 0x10, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x18,
+	0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45,
+	0x10, 0x11, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x12, 0x1a, 0x04, 0xa8, 0x45, 0x93, 0x03,
+	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x2c, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x70, 0x2e, 0x76,
+	0x31, 0x50, 0x01, 0x5a, 0x13, 0x73, 0x74, 0x65, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74,
+	0x65, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0xa2, 0x02, 0x09, 0x41, 0x50, 0x49, 0x53, 0x74,
+	0x65, 0x70, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
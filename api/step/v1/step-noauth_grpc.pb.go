@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StepNoauthService_GetNoauthStep_FullMethodName     = "/step.v1.StepNoauthService/GetNoauthStep"
-	StepNoauthService_SetCommentForStep_FullMethodName = "/step.v1.StepNoauthService/SetCommentForStep"
-	StepNoauthService_Decrypt_FullMethodName           = "/step.v1.StepNoauthService/Decrypt"
+	StepNoauthService_GetNoauthStep_FullMethodName       = "/step.v1.StepNoauthService/GetNoauthStep"
+	StepNoauthService_GetCommentChallenge_FullMethodName = "/step.v1.StepNoauthService/GetCommentChallenge"
+	StepNoauthService_GetCommentRubric_FullMethodName    = "/step.v1.StepNoauthService/GetCommentRubric"
+	StepNoauthService_SetCommentForStep_FullMethodName   = "/step.v1.StepNoauthService/SetCommentForStep"
+	StepNoauthService_Decrypt_FullMethodName             = "/step.v1.StepNoauthService/Decrypt"
 )
 
 // StepNoauthServiceClient is the client API for StepNoauthService service.
@@ -29,7 +31,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StepNoauthServiceClient interface {
 	GetNoauthStep(ctx context.Context, in *GetNoauthStepRequest, opts ...grpc.CallOption) (*GetNoauthStepReply, error)
+	// 评论前获取挑战，nonce只能使用一次
+	GetCommentChallenge(ctx context.Context, in *GetCommentChallengeRequest, opts ...grpc.CallOption) (*GetCommentChallengeReply, error)
+	// 评分标准，分享页按维度渲染评论表单，version为空时返回当前版本；
+	// 带上积累ID和share_to时返回积累所在顶层目标的评分标准
+	GetCommentRubric(ctx context.Context, in *GetCommentRubricRequest, opts ...grpc.CallOption) (*GetCommentRubricReply, error)
+	// 只用于朋友评论，share_to需要包含friend，并且带上挑战的解；
+	// 朋友没有账号，持有分享链接的朋友共用一个评分，已有朋友评分时返回COMMENT_ALREADY_EXISTS
 	SetCommentForStep(ctx context.Context, in *SetCommentForStepRequest, opts ...grpc.CallOption) (*SetCommentForStepReply, error)
+	// 只校验分享链接的share_to，返回分享的角色
 	Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*DecryptReply, error)
 }
 
@@ -51,6 +61,26 @@ func (c *stepNoauthServiceClient) GetNoauthStep(ctx context.Context, in *GetNoau
 	return out, nil
 }
 
+func (c *stepNoauthServiceClient) GetCommentChallenge(ctx context.Context, in *GetCommentChallengeRequest, opts ...grpc.CallOption) (*GetCommentChallengeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentChallengeReply)
+	err := c.cc.Invoke(ctx, StepNoauthService_GetCommentChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepNoauthServiceClient) GetCommentRubric(ctx context.Context, in *GetCommentRubricRequest, opts ...grpc.CallOption) (*GetCommentRubricReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentRubricReply)
+	err := c.cc.Invoke(ctx, StepNoauthService_GetCommentRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stepNoauthServiceClient) SetCommentForStep(ctx context.Context, in *SetCommentForStepRequest, opts ...grpc.CallOption) (*SetCommentForStepReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetCommentForStepReply)
@@ -76,7 +106,15 @@ func (c *stepNoauthServiceClient) Decrypt(ctx context.Context, in *DecryptReques
 // for forward compatibility.
 type StepNoauthServiceServer interface {
 	GetNoauthStep(context.Context, *GetNoauthStepRequest) (*GetNoauthStepReply, error)
+	// 评论前获取挑战，nonce只能使用一次
+	GetCommentChallenge(context.Context, *GetCommentChallengeRequest) (*GetCommentChallengeReply, error)
+	// 评分标准，分享页按维度渲染评论表单，version为空时返回当前版本；
+	// 带上积累ID和share_to时返回积累所在顶层目标的评分标准
+	GetCommentRubric(context.Context, *GetCommentRubricRequest) (*GetCommentRubricReply, error)
+	// 只用于朋友评论，share_to需要包含friend，并且带上挑战的解；
+	// 朋友没有账号，持有分享链接的朋友共用一个评分，已有朋友评分时返回COMMENT_ALREADY_EXISTS
 	SetCommentForStep(context.Context, *SetCommentForStepRequest) (*SetCommentForStepReply, error)
+	// 只校验分享链接的share_to，返回分享的角色
 	Decrypt(context.Context, *DecryptRequest) (*DecryptReply, error)
 	mustEmbedUnimplementedStepNoauthServiceServer()
 }
@@ -91,6 +129,12 @@ type UnimplementedStepNoauthServiceServer struct{}
 func (UnimplementedStepNoauthServiceServer) GetNoauthStep(context.Context, *GetNoauthStepRequest) (*GetNoauthStepReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNoauthStep not implemented")
 }
+func (UnimplementedStepNoauthServiceServer) GetCommentChallenge(context.Context, *GetCommentChallengeRequest) (*GetCommentChallengeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentChallenge not implemented")
+}
+func (UnimplementedStepNoauthServiceServer) GetCommentRubric(context.Context, *GetCommentRubricRequest) (*GetCommentRubricReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentRubric not implemented")
+}
 func (UnimplementedStepNoauthServiceServer) SetCommentForStep(context.Context, *SetCommentForStepRequest) (*SetCommentForStepReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetCommentForStep not implemented")
 }
@@ -136,6 +180,42 @@ func _StepNoauthService_GetNoauthStep_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StepNoauthService_GetCommentChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepNoauthServiceServer).GetCommentChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepNoauthService_GetCommentChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepNoauthServiceServer).GetCommentChallenge(ctx, req.(*GetCommentChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepNoauthService_GetCommentRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepNoauthServiceServer).GetCommentRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepNoauthService_GetCommentRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepNoauthServiceServer).GetCommentRubric(ctx, req.(*GetCommentRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StepNoauthService_SetCommentForStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetCommentForStepRequest)
 	if err := dec(in); err != nil {
@@ -183,6 +263,14 @@ var StepNoauthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNoauthStep",
 			Handler:    _StepNoauthService_GetNoauthStep_Handler,
 		},
+		{
+			MethodName: "GetCommentChallenge",
+			Handler:    _StepNoauthService_GetCommentChallenge_Handler,
+		},
+		{
+			MethodName: "GetCommentRubric",
+			Handler:    _StepNoauthService_GetCommentRubric_Handler,
+		},
 		{
 			MethodName: "SetCommentForStep",
 			Handler:    _StepNoauthService_SetCommentForStep_Handler,
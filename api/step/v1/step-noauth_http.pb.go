@@ -20,18 +20,30 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationStepNoauthServiceDecrypt = "/step.v1.StepNoauthService/Decrypt"
+const OperationStepNoauthServiceGetCommentChallenge = "/step.v1.StepNoauthService/GetCommentChallenge"
+const OperationStepNoauthServiceGetCommentRubric = "/step.v1.StepNoauthService/GetCommentRubric"
 const OperationStepNoauthServiceGetNoauthStep = "/step.v1.StepNoauthService/GetNoauthStep"
 const OperationStepNoauthServiceSetCommentForStep = "/step.v1.StepNoauthService/SetCommentForStep"
 
 type StepNoauthServiceHTTPServer interface {
+	// Decrypt 只校验分享链接的share_to，返回分享的角色
 	Decrypt(context.Context, *DecryptRequest) (*DecryptReply, error)
+	// GetCommentChallenge 评论前获取挑战，nonce只能使用一次
+	GetCommentChallenge(context.Context, *GetCommentChallengeRequest) (*GetCommentChallengeReply, error)
+	// GetCommentRubric 评分标准，分享页按维度渲染评论表单，version为空时返回当前版本；
+	// 带上积累ID和share_to时返回积累所在顶层目标的评分标准
+	GetCommentRubric(context.Context, *GetCommentRubricRequest) (*GetCommentRubricReply, error)
 	GetNoauthStep(context.Context, *GetNoauthStepRequest) (*GetNoauthStepReply, error)
+	// SetCommentForStep 只用于朋友评论，share_to需要包含friend，并且带上挑战的解；
+	// 朋友没有账号，持有分享链接的朋友共用一个评分，已有朋友评分时返回COMMENT_ALREADY_EXISTS
 	SetCommentForStep(context.Context, *SetCommentForStepRequest) (*SetCommentForStepReply, error)
 }
 
 func RegisterStepNoauthServiceHTTPServer(s *http.Server, srv StepNoauthServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/apis/step-go-noauth/step/{id}", _StepNoauthService_GetNoauthStep0_HTTP_Handler(srv))
+	r.GET("/apis/step-go-noauth/step/{id}/comment/challenge", _StepNoauthService_GetCommentChallenge0_HTTP_Handler(srv))
+	r.GET("/apis/step-go-noauth/comment/rubric", _StepNoauthService_GetCommentRubric0_HTTP_Handler(srv))
 	r.POST("/apis/step-go-noauth/step/{id}/comment", _StepNoauthService_SetCommentForStep0_HTTP_Handler(srv))
 	r.POST("/apis/step-go-noauth/step/{id}/decrypt", _StepNoauthService_Decrypt0_HTTP_Handler(srv))
 }
@@ -58,6 +70,47 @@ func _StepNoauthService_GetNoauthStep0_HTTP_Handler(srv StepNoauthServiceHTTPSer
 	}
 }
 
+func _StepNoauthService_GetCommentChallenge0_HTTP_Handler(srv StepNoauthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentChallengeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepNoauthServiceGetCommentChallenge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCommentChallenge(ctx, req.(*GetCommentChallengeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCommentChallengeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepNoauthService_GetCommentRubric0_HTTP_Handler(srv StepNoauthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentRubricRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepNoauthServiceGetCommentRubric)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCommentRubric(ctx, req.(*GetCommentRubricRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCommentRubricReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _StepNoauthService_SetCommentForStep0_HTTP_Handler(srv StepNoauthServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SetCommentForStepRequest
@@ -110,6 +163,8 @@ func _StepNoauthService_Decrypt0_HTTP_Handler(srv StepNoauthServiceHTTPServer) f
 
 type StepNoauthServiceHTTPClient interface {
 	Decrypt(ctx context.Context, req *DecryptRequest, opts ...http.CallOption) (rsp *DecryptReply, err error)
+	GetCommentChallenge(ctx context.Context, req *GetCommentChallengeRequest, opts ...http.CallOption) (rsp *GetCommentChallengeReply, err error)
+	GetCommentRubric(ctx context.Context, req *GetCommentRubricRequest, opts ...http.CallOption) (rsp *GetCommentRubricReply, err error)
 	GetNoauthStep(ctx context.Context, req *GetNoauthStepRequest, opts ...http.CallOption) (rsp *GetNoauthStepReply, err error)
 	SetCommentForStep(ctx context.Context, req *SetCommentForStepRequest, opts ...http.CallOption) (rsp *SetCommentForStepReply, err error)
 }
@@ -135,6 +190,32 @@ func (c *StepNoauthServiceHTTPClientImpl) Decrypt(ctx context.Context, in *Decry
 	return &out, nil
 }
 
+func (c *StepNoauthServiceHTTPClientImpl) GetCommentChallenge(ctx context.Context, in *GetCommentChallengeRequest, opts ...http.CallOption) (*GetCommentChallengeReply, error) {
+	var out GetCommentChallengeReply
+	pattern := "/apis/step-go-noauth/step/{id}/comment/challenge"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepNoauthServiceGetCommentChallenge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepNoauthServiceHTTPClientImpl) GetCommentRubric(ctx context.Context, in *GetCommentRubricRequest, opts ...http.CallOption) (*GetCommentRubricReply, error) {
+	var out GetCommentRubricReply
+	pattern := "/apis/step-go-noauth/comment/rubric"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepNoauthServiceGetCommentRubric))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *StepNoauthServiceHTTPClientImpl) GetNoauthStep(ctx context.Context, in *GetNoauthStepRequest, opts ...http.CallOption) (*GetNoauthStepReply, error) {
 	var out GetNoauthStepReply
 	pattern := "/apis/step-go-noauth/step/{id}"
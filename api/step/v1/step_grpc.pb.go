@@ -19,18 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StepService_CreateTarget_FullMethodName             = "/step.v1.StepService/CreateTarget"
-	StepService_UpdateTarget_FullMethodName             = "/step.v1.StepService/UpdateTarget"
-	StepService_GetTargets_FullMethodName               = "/step.v1.StepService/GetTargets"
-	StepService_DeleteTarget_FullMethodName             = "/step.v1.StepService/DeleteTarget"
-	StepService_DoneTarget_FullMethodName               = "/step.v1.StepService/DoneTarget"
-	StepService_GetTarget_FullMethodName                = "/step.v1.StepService/GetTarget"
-	StepService_GetTargetTree_FullMethodName            = "/step.v1.StepService/GetTargetTree"
-	StepService_AddTargetDirStep_FullMethodName         = "/step.v1.StepService/AddTargetDirStep"
-	StepService_GetTargetDirStepChildren_FullMethodName = "/step.v1.StepService/GetTargetDirStepChildren"
-	StepService_UpdateTargetStep_FullMethodName         = "/step.v1.StepService/UpdateTargetStep"
-	StepService_DeleteTargetStep_FullMethodName         = "/step.v1.StepService/DeleteTargetStep"
-	StepService_Encrypt_FullMethodName                  = "/step.v1.StepService/Encrypt"
+	StepService_CreateTarget_FullMethodName                = "/step.v1.StepService/CreateTarget"
+	StepService_UpdateTarget_FullMethodName                = "/step.v1.StepService/UpdateTarget"
+	StepService_GetTargets_FullMethodName                  = "/step.v1.StepService/GetTargets"
+	StepService_DeleteTarget_FullMethodName                = "/step.v1.StepService/DeleteTarget"
+	StepService_DoneTarget_FullMethodName                  = "/step.v1.StepService/DoneTarget"
+	StepService_GetTarget_FullMethodName                   = "/step.v1.StepService/GetTarget"
+	StepService_GetTargetTree_FullMethodName               = "/step.v1.StepService/GetTargetTree"
+	StepService_AddTargetDirStep_FullMethodName            = "/step.v1.StepService/AddTargetDirStep"
+	StepService_GetTargetDirStepChildren_FullMethodName    = "/step.v1.StepService/GetTargetDirStepChildren"
+	StepService_UpdateTargetStep_FullMethodName            = "/step.v1.StepService/UpdateTargetStep"
+	StepService_DeleteTargetStep_FullMethodName            = "/step.v1.StepService/DeleteTargetStep"
+	StepService_Encrypt_FullMethodName                     = "/step.v1.StepService/Encrypt"
+	StepService_GetTargetAdherence_FullMethodName          = "/step.v1.StepService/GetTargetAdherence"
+	StepService_AddTargetMeasurementStep_FullMethodName    = "/step.v1.StepService/AddTargetMeasurementStep"
+	StepService_CreateNoteStep_FullMethodName              = "/step.v1.StepService/CreateNoteStep"
+	StepService_UpdateNoteStep_FullMethodName              = "/step.v1.StepService/UpdateNoteStep"
+	StepService_CreateLinkStep_FullMethodName              = "/step.v1.StepService/CreateLinkStep"
+	StepService_UpdateLinkStep_FullMethodName              = "/step.v1.StepService/UpdateLinkStep"
+	StepService_RenameDirStep_FullMethodName               = "/step.v1.StepService/RenameDirStep"
+	StepService_MoveStep_FullMethodName                    = "/step.v1.StepService/MoveStep"
+	StepService_ReorderTargets_FullMethodName              = "/step.v1.StepService/ReorderTargets"
+	StepService_ReorderSteps_FullMethodName                = "/step.v1.StepService/ReorderSteps"
+	StepService_Search_FullMethodName                      = "/step.v1.StepService/Search"
+	StepService_CloneTarget_FullMethodName                 = "/step.v1.StepService/CloneTarget"
+	StepService_GetActivityTimeline_FullMethodName         = "/step.v1.StepService/GetActivityTimeline"
+	StepService_GetActivityHeatmap_FullMethodName          = "/step.v1.StepService/GetActivityHeatmap"
+	StepService_SetSelfAssessment_FullMethodName           = "/step.v1.StepService/SetSelfAssessment"
+	StepService_GetSelfAssessmentComparison_FullMethodName = "/step.v1.StepService/GetSelfAssessmentComparison"
 )
 
 // StepServiceClient is the client API for StepService service.
@@ -58,6 +74,38 @@ type StepServiceClient interface {
 	// 删除积累
 	DeleteTargetStep(ctx context.Context, in *DeleteTargetStepRequest, opts ...grpc.CallOption) (*DeleteTargetStepReply, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptReply, error)
+	// 获取目标按周期的打卡达成情况
+	GetTargetAdherence(ctx context.Context, in *GetTargetAdherenceRequest, opts ...grpc.CallOption) (*GetTargetAdherenceReply, error)
+	// 添加计量积累(for quantity target)
+	AddTargetMeasurementStep(ctx context.Context, in *AddTargetMeasurementStepRequest, opts ...grpc.CallOption) (*AddTargetMeasurementStepReply, error)
+	// 添加文字积累(markdown)
+	CreateNoteStep(ctx context.Context, in *CreateNoteStepRequest, opts ...grpc.CallOption) (*CreateNoteStepReply, error)
+	// 修改文字积累
+	UpdateNoteStep(ctx context.Context, in *UpdateNoteStepRequest, opts ...grpc.CallOption) (*UpdateNoteStepReply, error)
+	// 添加链接积累
+	CreateLinkStep(ctx context.Context, in *CreateLinkStepRequest, opts ...grpc.CallOption) (*CreateLinkStepReply, error)
+	// 修改链接积累
+	UpdateLinkStep(ctx context.Context, in *UpdateLinkStepRequest, opts ...grpc.CallOption) (*UpdateLinkStepReply, error)
+	// 重命名目录
+	RenameDirStep(ctx context.Context, in *RenameDirStepRequest, opts ...grpc.CallOption) (*RenameDirStepReply, error)
+	// 移动积累(目录连同其下所有积累)到其他目录或目标
+	MoveStep(ctx context.Context, in *MoveStepRequest, opts ...grpc.CallOption) (*MoveStepReply, error)
+	// 手动排序同级目标
+	ReorderTargets(ctx context.Context, in *ReorderTargetsRequest, opts ...grpc.CallOption) (*ReorderTargetsReply, error)
+	// 手动排序目标或目录下的积累
+	ReorderSteps(ctx context.Context, in *ReorderStepsRequest, opts ...grpc.CallOption) (*ReorderStepsReply, error)
+	// 全文搜索当前用户的目标、积累和评论
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	// 深拷贝目标树，可选是否复制积累和媒体文件
+	CloneTarget(ctx context.Context, in *CloneTargetRequest, opts ...grpc.CallOption) (*CloneTargetReply, error)
+	// 所有目标的积累按用户时区的自然日分组
+	GetActivityTimeline(ctx context.Context, in *GetActivityTimelineRequest, opts ...grpc.CallOption) (*GetActivityTimelineReply, error)
+	// 一年中每天的积累数，用于日历热力图
+	GetActivityHeatmap(ctx context.Context, in *GetActivityHeatmapRequest, opts ...grpc.CallOption) (*GetActivityHeatmapReply, error)
+	// 积累所有者按同一个评分标准自评，每个积累只能自评一次，之后通过CommentService.UpdateComment修改
+	SetSelfAssessment(ctx context.Context, in *SetSelfAssessmentRequest, opts ...grpc.CallOption) (*SetSelfAssessmentReply, error)
+	// 自评和老师、家长、朋友评分的对比，指定积累或者目标下所有同时有自评和他评的积累
+	GetSelfAssessmentComparison(ctx context.Context, in *GetSelfAssessmentComparisonRequest, opts ...grpc.CallOption) (*GetSelfAssessmentComparisonReply, error)
 }
 
 type stepServiceClient struct {
@@ -188,6 +236,166 @@ func (c *stepServiceClient) Encrypt(ctx context.Context, in *EncryptRequest, opt
 	return out, nil
 }
 
+func (c *stepServiceClient) GetTargetAdherence(ctx context.Context, in *GetTargetAdherenceRequest, opts ...grpc.CallOption) (*GetTargetAdherenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTargetAdherenceReply)
+	err := c.cc.Invoke(ctx, StepService_GetTargetAdherence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) AddTargetMeasurementStep(ctx context.Context, in *AddTargetMeasurementStepRequest, opts ...grpc.CallOption) (*AddTargetMeasurementStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTargetMeasurementStepReply)
+	err := c.cc.Invoke(ctx, StepService_AddTargetMeasurementStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) CreateNoteStep(ctx context.Context, in *CreateNoteStepRequest, opts ...grpc.CallOption) (*CreateNoteStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNoteStepReply)
+	err := c.cc.Invoke(ctx, StepService_CreateNoteStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) UpdateNoteStep(ctx context.Context, in *UpdateNoteStepRequest, opts ...grpc.CallOption) (*UpdateNoteStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNoteStepReply)
+	err := c.cc.Invoke(ctx, StepService_UpdateNoteStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) CreateLinkStep(ctx context.Context, in *CreateLinkStepRequest, opts ...grpc.CallOption) (*CreateLinkStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLinkStepReply)
+	err := c.cc.Invoke(ctx, StepService_CreateLinkStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) UpdateLinkStep(ctx context.Context, in *UpdateLinkStepRequest, opts ...grpc.CallOption) (*UpdateLinkStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLinkStepReply)
+	err := c.cc.Invoke(ctx, StepService_UpdateLinkStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) RenameDirStep(ctx context.Context, in *RenameDirStepRequest, opts ...grpc.CallOption) (*RenameDirStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameDirStepReply)
+	err := c.cc.Invoke(ctx, StepService_RenameDirStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) MoveStep(ctx context.Context, in *MoveStepRequest, opts ...grpc.CallOption) (*MoveStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveStepReply)
+	err := c.cc.Invoke(ctx, StepService_MoveStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) ReorderTargets(ctx context.Context, in *ReorderTargetsRequest, opts ...grpc.CallOption) (*ReorderTargetsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderTargetsReply)
+	err := c.cc.Invoke(ctx, StepService_ReorderTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) ReorderSteps(ctx context.Context, in *ReorderStepsRequest, opts ...grpc.CallOption) (*ReorderStepsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderStepsReply)
+	err := c.cc.Invoke(ctx, StepService_ReorderSteps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchReply)
+	err := c.cc.Invoke(ctx, StepService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) CloneTarget(ctx context.Context, in *CloneTargetRequest, opts ...grpc.CallOption) (*CloneTargetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneTargetReply)
+	err := c.cc.Invoke(ctx, StepService_CloneTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) GetActivityTimeline(ctx context.Context, in *GetActivityTimelineRequest, opts ...grpc.CallOption) (*GetActivityTimelineReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityTimelineReply)
+	err := c.cc.Invoke(ctx, StepService_GetActivityTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) GetActivityHeatmap(ctx context.Context, in *GetActivityHeatmapRequest, opts ...grpc.CallOption) (*GetActivityHeatmapReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityHeatmapReply)
+	err := c.cc.Invoke(ctx, StepService_GetActivityHeatmap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) SetSelfAssessment(ctx context.Context, in *SetSelfAssessmentRequest, opts ...grpc.CallOption) (*SetSelfAssessmentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSelfAssessmentReply)
+	err := c.cc.Invoke(ctx, StepService_SetSelfAssessment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stepServiceClient) GetSelfAssessmentComparison(ctx context.Context, in *GetSelfAssessmentComparisonRequest, opts ...grpc.CallOption) (*GetSelfAssessmentComparisonReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSelfAssessmentComparisonReply)
+	err := c.cc.Invoke(ctx, StepService_GetSelfAssessmentComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StepServiceServer is the server API for StepService service.
 // All implementations must embed UnimplementedStepServiceServer
 // for forward compatibility.
@@ -213,6 +421,38 @@ type StepServiceServer interface {
 	// 删除积累
 	DeleteTargetStep(context.Context, *DeleteTargetStepRequest) (*DeleteTargetStepReply, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptReply, error)
+	// 获取目标按周期的打卡达成情况
+	GetTargetAdherence(context.Context, *GetTargetAdherenceRequest) (*GetTargetAdherenceReply, error)
+	// 添加计量积累(for quantity target)
+	AddTargetMeasurementStep(context.Context, *AddTargetMeasurementStepRequest) (*AddTargetMeasurementStepReply, error)
+	// 添加文字积累(markdown)
+	CreateNoteStep(context.Context, *CreateNoteStepRequest) (*CreateNoteStepReply, error)
+	// 修改文字积累
+	UpdateNoteStep(context.Context, *UpdateNoteStepRequest) (*UpdateNoteStepReply, error)
+	// 添加链接积累
+	CreateLinkStep(context.Context, *CreateLinkStepRequest) (*CreateLinkStepReply, error)
+	// 修改链接积累
+	UpdateLinkStep(context.Context, *UpdateLinkStepRequest) (*UpdateLinkStepReply, error)
+	// 重命名目录
+	RenameDirStep(context.Context, *RenameDirStepRequest) (*RenameDirStepReply, error)
+	// 移动积累(目录连同其下所有积累)到其他目录或目标
+	MoveStep(context.Context, *MoveStepRequest) (*MoveStepReply, error)
+	// 手动排序同级目标
+	ReorderTargets(context.Context, *ReorderTargetsRequest) (*ReorderTargetsReply, error)
+	// 手动排序目标或目录下的积累
+	ReorderSteps(context.Context, *ReorderStepsRequest) (*ReorderStepsReply, error)
+	// 全文搜索当前用户的目标、积累和评论
+	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	// 深拷贝目标树，可选是否复制积累和媒体文件
+	CloneTarget(context.Context, *CloneTargetRequest) (*CloneTargetReply, error)
+	// 所有目标的积累按用户时区的自然日分组
+	GetActivityTimeline(context.Context, *GetActivityTimelineRequest) (*GetActivityTimelineReply, error)
+	// 一年中每天的积累数，用于日历热力图
+	GetActivityHeatmap(context.Context, *GetActivityHeatmapRequest) (*GetActivityHeatmapReply, error)
+	// 积累所有者按同一个评分标准自评，每个积累只能自评一次，之后通过CommentService.UpdateComment修改
+	SetSelfAssessment(context.Context, *SetSelfAssessmentRequest) (*SetSelfAssessmentReply, error)
+	// 自评和老师、家长、朋友评分的对比，指定积累或者目标下所有同时有自评和他评的积累
+	GetSelfAssessmentComparison(context.Context, *GetSelfAssessmentComparisonRequest) (*GetSelfAssessmentComparisonReply, error)
 	mustEmbedUnimplementedStepServiceServer()
 }
 
@@ -259,6 +499,54 @@ func (UnimplementedStepServiceServer) DeleteTargetStep(context.Context, *DeleteT
 func (UnimplementedStepServiceServer) Encrypt(context.Context, *EncryptRequest) (*EncryptReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
 }
+func (UnimplementedStepServiceServer) GetTargetAdherence(context.Context, *GetTargetAdherenceRequest) (*GetTargetAdherenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetAdherence not implemented")
+}
+func (UnimplementedStepServiceServer) AddTargetMeasurementStep(context.Context, *AddTargetMeasurementStepRequest) (*AddTargetMeasurementStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTargetMeasurementStep not implemented")
+}
+func (UnimplementedStepServiceServer) CreateNoteStep(context.Context, *CreateNoteStepRequest) (*CreateNoteStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNoteStep not implemented")
+}
+func (UnimplementedStepServiceServer) UpdateNoteStep(context.Context, *UpdateNoteStepRequest) (*UpdateNoteStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNoteStep not implemented")
+}
+func (UnimplementedStepServiceServer) CreateLinkStep(context.Context, *CreateLinkStepRequest) (*CreateLinkStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLinkStep not implemented")
+}
+func (UnimplementedStepServiceServer) UpdateLinkStep(context.Context, *UpdateLinkStepRequest) (*UpdateLinkStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLinkStep not implemented")
+}
+func (UnimplementedStepServiceServer) RenameDirStep(context.Context, *RenameDirStepRequest) (*RenameDirStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameDirStep not implemented")
+}
+func (UnimplementedStepServiceServer) MoveStep(context.Context, *MoveStepRequest) (*MoveStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveStep not implemented")
+}
+func (UnimplementedStepServiceServer) ReorderTargets(context.Context, *ReorderTargetsRequest) (*ReorderTargetsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderTargets not implemented")
+}
+func (UnimplementedStepServiceServer) ReorderSteps(context.Context, *ReorderStepsRequest) (*ReorderStepsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSteps not implemented")
+}
+func (UnimplementedStepServiceServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedStepServiceServer) CloneTarget(context.Context, *CloneTargetRequest) (*CloneTargetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneTarget not implemented")
+}
+func (UnimplementedStepServiceServer) GetActivityTimeline(context.Context, *GetActivityTimelineRequest) (*GetActivityTimelineReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityTimeline not implemented")
+}
+func (UnimplementedStepServiceServer) GetActivityHeatmap(context.Context, *GetActivityHeatmapRequest) (*GetActivityHeatmapReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityHeatmap not implemented")
+}
+func (UnimplementedStepServiceServer) SetSelfAssessment(context.Context, *SetSelfAssessmentRequest) (*SetSelfAssessmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSelfAssessment not implemented")
+}
+func (UnimplementedStepServiceServer) GetSelfAssessmentComparison(context.Context, *GetSelfAssessmentComparisonRequest) (*GetSelfAssessmentComparisonReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSelfAssessmentComparison not implemented")
+}
 func (UnimplementedStepServiceServer) mustEmbedUnimplementedStepServiceServer() {}
 func (UnimplementedStepServiceServer) testEmbeddedByValue()                     {}
 
@@ -496,200 +784,2454 @@ func _StepService_Encrypt_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-// StepService_ServiceDesc is the grpc.ServiceDesc for StepService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var StepService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "step.v1.StepService",
-	HandlerType: (*StepServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTarget",
-			Handler:    _StepService_CreateTarget_Handler,
-		},
-		{
-			MethodName: "UpdateTarget",
-			Handler:    _StepService_UpdateTarget_Handler,
-		},
-		{
-			MethodName: "GetTargets",
-			Handler:    _StepService_GetTargets_Handler,
-		},
-		{
-			MethodName: "DeleteTarget",
-			Handler:    _StepService_DeleteTarget_Handler,
-		},
-		{
-			MethodName: "DoneTarget",
-			Handler:    _StepService_DoneTarget_Handler,
-		},
-		{
-			MethodName: "GetTarget",
-			Handler:    _StepService_GetTarget_Handler,
-		},
-		{
-			MethodName: "GetTargetTree",
-			Handler:    _StepService_GetTargetTree_Handler,
-		},
-		{
-			MethodName: "AddTargetDirStep",
-			Handler:    _StepService_AddTargetDirStep_Handler,
-		},
-		{
-			MethodName: "GetTargetDirStepChildren",
-			Handler:    _StepService_GetTargetDirStepChildren_Handler,
-		},
-		{
-			MethodName: "UpdateTargetStep",
-			Handler:    _StepService_UpdateTargetStep_Handler,
-		},
-		{
-			MethodName: "DeleteTargetStep",
-			Handler:    _StepService_DeleteTargetStep_Handler,
-		},
-		{
-			MethodName: "Encrypt",
-			Handler:    _StepService_Encrypt_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "step/v1/step.proto",
-}
-
-const (
-	PortraitService_GetPortraitBasic_FullMethodName    = "/step.v1.PortraitService/GetPortraitBasic"
-	PortraitService_GetPortraitStepRate_FullMethodName = "/step.v1.PortraitService/GetPortraitStepRate"
-)
-
-// PortraitServiceClient is the client API for PortraitService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PortraitServiceClient interface {
-	GetPortraitBasic(ctx context.Context, in *GetPortraitBasicRequest, opts ...grpc.CallOption) (*GetPortraitBasicReply, error)
-	GetPortraitStepRate(ctx context.Context, in *GetPortraitStepRateRequest, opts ...grpc.CallOption) (*GetPortraitStepRateReply, error)
-}
-
-type portraitServiceClient struct {
-	cc grpc.ClientConnInterface
+func _StepService_GetTargetAdherence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetAdherenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).GetTargetAdherence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_GetTargetAdherence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).GetTargetAdherence(ctx, req.(*GetTargetAdherenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPortraitServiceClient(cc grpc.ClientConnInterface) PortraitServiceClient {
-	return &portraitServiceClient{cc}
+func _StepService_AddTargetMeasurementStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTargetMeasurementStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).AddTargetMeasurementStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_AddTargetMeasurementStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).AddTargetMeasurementStep(ctx, req.(*AddTargetMeasurementStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *portraitServiceClient) GetPortraitBasic(ctx context.Context, in *GetPortraitBasicRequest, opts ...grpc.CallOption) (*GetPortraitBasicReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPortraitBasicReply)
-	err := c.cc.Invoke(ctx, PortraitService_GetPortraitBasic_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _StepService_CreateNoteStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNoteStepRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StepServiceServer).CreateNoteStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_CreateNoteStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).CreateNoteStep(ctx, req.(*CreateNoteStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *portraitServiceClient) GetPortraitStepRate(ctx context.Context, in *GetPortraitStepRateRequest, opts ...grpc.CallOption) (*GetPortraitStepRateReply, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPortraitStepRateReply)
-	err := c.cc.Invoke(ctx, PortraitService_GetPortraitStepRate_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _StepService_UpdateNoteStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNoteStepRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StepServiceServer).UpdateNoteStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_UpdateNoteStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).UpdateNoteStep(ctx, req.(*UpdateNoteStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// PortraitServiceServer is the server API for PortraitService service.
+func _StepService_CreateLinkStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLinkStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).CreateLinkStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_CreateLinkStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).CreateLinkStep(ctx, req.(*CreateLinkStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_UpdateLinkStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLinkStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).UpdateLinkStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_UpdateLinkStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).UpdateLinkStep(ctx, req.(*UpdateLinkStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_RenameDirStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameDirStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).RenameDirStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_RenameDirStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).RenameDirStep(ctx, req.(*RenameDirStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_MoveStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).MoveStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_MoveStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).MoveStep(ctx, req.(*MoveStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_ReorderTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).ReorderTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_ReorderTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).ReorderTargets(ctx, req.(*ReorderTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_ReorderSteps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderStepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).ReorderSteps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_ReorderSteps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).ReorderSteps(ctx, req.(*ReorderStepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_CloneTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).CloneTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_CloneTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).CloneTarget(ctx, req.(*CloneTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_GetActivityTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).GetActivityTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_GetActivityTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).GetActivityTimeline(ctx, req.(*GetActivityTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_GetActivityHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).GetActivityHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_GetActivityHeatmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).GetActivityHeatmap(ctx, req.(*GetActivityHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_SetSelfAssessment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSelfAssessmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).SetSelfAssessment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_SetSelfAssessment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).SetSelfAssessment(ctx, req.(*SetSelfAssessmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StepService_GetSelfAssessmentComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSelfAssessmentComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StepServiceServer).GetSelfAssessmentComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StepService_GetSelfAssessmentComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StepServiceServer).GetSelfAssessmentComparison(ctx, req.(*GetSelfAssessmentComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StepService_ServiceDesc is the grpc.ServiceDesc for StepService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StepService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.StepService",
+	HandlerType: (*StepServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTarget",
+			Handler:    _StepService_CreateTarget_Handler,
+		},
+		{
+			MethodName: "UpdateTarget",
+			Handler:    _StepService_UpdateTarget_Handler,
+		},
+		{
+			MethodName: "GetTargets",
+			Handler:    _StepService_GetTargets_Handler,
+		},
+		{
+			MethodName: "DeleteTarget",
+			Handler:    _StepService_DeleteTarget_Handler,
+		},
+		{
+			MethodName: "DoneTarget",
+			Handler:    _StepService_DoneTarget_Handler,
+		},
+		{
+			MethodName: "GetTarget",
+			Handler:    _StepService_GetTarget_Handler,
+		},
+		{
+			MethodName: "GetTargetTree",
+			Handler:    _StepService_GetTargetTree_Handler,
+		},
+		{
+			MethodName: "AddTargetDirStep",
+			Handler:    _StepService_AddTargetDirStep_Handler,
+		},
+		{
+			MethodName: "GetTargetDirStepChildren",
+			Handler:    _StepService_GetTargetDirStepChildren_Handler,
+		},
+		{
+			MethodName: "UpdateTargetStep",
+			Handler:    _StepService_UpdateTargetStep_Handler,
+		},
+		{
+			MethodName: "DeleteTargetStep",
+			Handler:    _StepService_DeleteTargetStep_Handler,
+		},
+		{
+			MethodName: "Encrypt",
+			Handler:    _StepService_Encrypt_Handler,
+		},
+		{
+			MethodName: "GetTargetAdherence",
+			Handler:    _StepService_GetTargetAdherence_Handler,
+		},
+		{
+			MethodName: "AddTargetMeasurementStep",
+			Handler:    _StepService_AddTargetMeasurementStep_Handler,
+		},
+		{
+			MethodName: "CreateNoteStep",
+			Handler:    _StepService_CreateNoteStep_Handler,
+		},
+		{
+			MethodName: "UpdateNoteStep",
+			Handler:    _StepService_UpdateNoteStep_Handler,
+		},
+		{
+			MethodName: "CreateLinkStep",
+			Handler:    _StepService_CreateLinkStep_Handler,
+		},
+		{
+			MethodName: "UpdateLinkStep",
+			Handler:    _StepService_UpdateLinkStep_Handler,
+		},
+		{
+			MethodName: "RenameDirStep",
+			Handler:    _StepService_RenameDirStep_Handler,
+		},
+		{
+			MethodName: "MoveStep",
+			Handler:    _StepService_MoveStep_Handler,
+		},
+		{
+			MethodName: "ReorderTargets",
+			Handler:    _StepService_ReorderTargets_Handler,
+		},
+		{
+			MethodName: "ReorderSteps",
+			Handler:    _StepService_ReorderSteps_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _StepService_Search_Handler,
+		},
+		{
+			MethodName: "CloneTarget",
+			Handler:    _StepService_CloneTarget_Handler,
+		},
+		{
+			MethodName: "GetActivityTimeline",
+			Handler:    _StepService_GetActivityTimeline_Handler,
+		},
+		{
+			MethodName: "GetActivityHeatmap",
+			Handler:    _StepService_GetActivityHeatmap_Handler,
+		},
+		{
+			MethodName: "SetSelfAssessment",
+			Handler:    _StepService_SetSelfAssessment_Handler,
+		},
+		{
+			MethodName: "GetSelfAssessmentComparison",
+			Handler:    _StepService_GetSelfAssessmentComparison_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	TagService_CreateTag_FullMethodName   = "/step.v1.TagService/CreateTag"
+	TagService_UpdateTag_FullMethodName   = "/step.v1.TagService/UpdateTag"
+	TagService_DeleteTag_FullMethodName   = "/step.v1.TagService/DeleteTag"
+	TagService_GetTags_FullMethodName     = "/step.v1.TagService/GetTags"
+	TagService_AssignTag_FullMethodName   = "/step.v1.TagService/AssignTag"
+	TagService_UnassignTag_FullMethodName = "/step.v1.TagService/UnassignTag"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TagServiceClient interface {
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagReply, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagReply, error)
+	// 删除标签，同时解除与目标和积累的关联
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagReply, error)
+	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsReply, error)
+	// 给目标和积累打标签
+	AssignTag(ctx context.Context, in *AssignTagRequest, opts ...grpc.CallOption) (*AssignTagReply, error)
+	// 移除目标和积累的标签
+	UnassignTag(ctx context.Context, in *UnassignTagRequest, opts ...grpc.CallOption) (*UnassignTagReply, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTagReply)
+	err := c.cc.Invoke(ctx, TagService_CreateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagReply)
+	err := c.cc.Invoke(ctx, TagService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagReply)
+	err := c.cc.Invoke(ctx, TagService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagsReply)
+	err := c.cc.Invoke(ctx, TagService_GetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) AssignTag(ctx context.Context, in *AssignTagRequest, opts ...grpc.CallOption) (*AssignTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignTagReply)
+	err := c.cc.Invoke(ctx, TagService_AssignTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UnassignTag(ctx context.Context, in *UnassignTagRequest, opts ...grpc.CallOption) (*UnassignTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignTagReply)
+	err := c.cc.Invoke(ctx, TagService_UnassignTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility.
+type TagServiceServer interface {
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagReply, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagReply, error)
+	// 删除标签，同时解除与目标和积累的关联
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagReply, error)
+	GetTags(context.Context, *GetTagsRequest) (*GetTagsReply, error)
+	// 给目标和积累打标签
+	AssignTag(context.Context, *AssignTagRequest) (*AssignTagReply, error)
+	// 移除目标和积累的标签
+	UnassignTag(context.Context, *UnassignTagRequest) (*UnassignTagReply, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagServiceServer struct{}
+
+func (UnimplementedTagServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedTagServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedTagServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedTagServiceServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedTagServiceServer) AssignTag(context.Context, *AssignTagRequest) (*AssignTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTag not implemented")
+}
+func (UnimplementedTagServiceServer) UnassignTag(context.Context, *UnassignTagRequest) (*UnassignTagReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignTag not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_AssignTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).AssignTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_AssignTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).AssignTag(ctx, req.(*AssignTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UnassignTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UnassignTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UnassignTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UnassignTag(ctx, req.(*UnassignTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTag",
+			Handler:    _TagService_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _TagService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TagService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _TagService_GetTags_Handler,
+		},
+		{
+			MethodName: "AssignTag",
+			Handler:    _TagService_AssignTag_Handler,
+		},
+		{
+			MethodName: "UnassignTag",
+			Handler:    _TagService_UnassignTag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	TemplateService_CreateTemplate_FullMethodName      = "/step.v1.TemplateService/CreateTemplate"
+	TemplateService_UpdateTemplate_FullMethodName      = "/step.v1.TemplateService/UpdateTemplate"
+	TemplateService_DeleteTemplate_FullMethodName      = "/step.v1.TemplateService/DeleteTemplate"
+	TemplateService_GetTemplates_FullMethodName        = "/step.v1.TemplateService/GetTemplates"
+	TemplateService_GetTemplate_FullMethodName         = "/step.v1.TemplateService/GetTemplate"
+	TemplateService_GetSharedTemplate_FullMethodName   = "/step.v1.TemplateService/GetSharedTemplate"
+	TemplateService_InstantiateTemplate_FullMethodName = "/step.v1.TemplateService/InstantiateTemplate"
+)
+
+// TemplateServiceClient is the client API for TemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TemplateServiceClient interface {
+	// 把目标及其子目标、目录结构保存为模板
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateReply, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateReply, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateReply, error)
+	GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...grpc.CallOption) (*GetTemplatesReply, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateReply, error)
+	// 通过分享链接查看模板
+	GetSharedTemplate(ctx context.Context, in *GetSharedTemplateRequest, opts ...grpc.CallOption) (*GetSharedTemplateReply, error)
+	// 用模板创建新的目标树，自己的模板用id，分享的模板用share_token
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateReply, error)
+}
+
+type templateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTemplateServiceClient(cc grpc.ClientConnInterface) TemplateServiceClient {
+	return &templateServiceClient{cc}
+}
+
+func (c *templateServiceClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTemplateReply)
+	err := c.cc.Invoke(ctx, TemplateService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTemplateReply)
+	err := c.cc.Invoke(ctx, TemplateService_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTemplateReply)
+	err := c.cc.Invoke(ctx, TemplateService_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...grpc.CallOption) (*GetTemplatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplatesReply)
+	err := c.cc.Invoke(ctx, TemplateService_GetTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplateReply)
+	err := c.cc.Invoke(ctx, TemplateService_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) GetSharedTemplate(ctx context.Context, in *GetSharedTemplateRequest, opts ...grpc.CallOption) (*GetSharedTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedTemplateReply)
+	err := c.cc.Invoke(ctx, TemplateService_GetSharedTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*InstantiateTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstantiateTemplateReply)
+	err := c.cc.Invoke(ctx, TemplateService_InstantiateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TemplateServiceServer is the server API for TemplateService service.
+// All implementations must embed UnimplementedTemplateServiceServer
+// for forward compatibility.
+type TemplateServiceServer interface {
+	// 把目标及其子目标、目录结构保存为模板
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error)
+	GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesReply, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateReply, error)
+	// 通过分享链接查看模板
+	GetSharedTemplate(context.Context, *GetSharedTemplateRequest) (*GetSharedTemplateReply, error)
+	// 用模板创建新的目标树，自己的模板用id，分享的模板用share_token
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateReply, error)
+	mustEmbedUnimplementedTemplateServiceServer()
+}
+
+// UnimplementedTemplateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTemplateServiceServer struct{}
+
+func (UnimplementedTemplateServiceServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplates not implemented")
+}
+func (UnimplementedTemplateServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) GetSharedTemplate(context.Context, *GetSharedTemplateRequest) (*GetSharedTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedTemplateServiceServer) mustEmbedUnimplementedTemplateServiceServer() {}
+func (UnimplementedTemplateServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TemplateServiceServer will
+// result in compilation errors.
+type UnsafeTemplateServiceServer interface {
+	mustEmbedUnimplementedTemplateServiceServer()
+}
+
+func RegisterTemplateServiceServer(s grpc.ServiceRegistrar, srv TemplateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTemplateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TemplateService_ServiceDesc, srv)
+}
+
+func _TemplateService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_GetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).GetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_GetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).GetTemplates(ctx, req.(*GetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_GetSharedTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).GetSharedTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_GetSharedTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).GetSharedTemplate(ctx, req.(*GetSharedTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TemplateService_InstantiateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TemplateService_ServiceDesc is the grpc.ServiceDesc for TemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.TemplateService",
+	HandlerType: (*TemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _TemplateService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _TemplateService_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _TemplateService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplates",
+			Handler:    _TemplateService_GetTemplates_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _TemplateService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "GetSharedTemplate",
+			Handler:    _TemplateService_GetSharedTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _TemplateService_InstantiateTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	ExportService_CreateExport_FullMethodName = "/step.v1.ExportService/CreateExport"
+	ExportService_GetExport_FullMethodName    = "/step.v1.ExportService/GetExport"
+	ExportService_GetExports_FullMethodName   = "/step.v1.ExportService/GetExports"
+	ExportService_DeleteExport_FullMethodName = "/step.v1.ExportService/DeleteExport"
+)
+
+// ExportServiceClient is the client API for ExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExportServiceClient interface {
+	// 异步导出目标树为zip压缩包(媒体文件、manifest.json、index.html)
+	CreateExport(ctx context.Context, in *CreateExportRequest, opts ...grpc.CallOption) (*CreateExportReply, error)
+	// 导出任务状态，完成后返回下载链接
+	GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportReply, error)
+	GetExports(ctx context.Context, in *GetExportsRequest, opts ...grpc.CallOption) (*GetExportsReply, error)
+	// 删除导出任务和压缩包
+	DeleteExport(ctx context.Context, in *DeleteExportRequest, opts ...grpc.CallOption) (*DeleteExportReply, error)
+}
+
+type exportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportServiceClient(cc grpc.ClientConnInterface) ExportServiceClient {
+	return &exportServiceClient{cc}
+}
+
+func (c *exportServiceClient) CreateExport(ctx context.Context, in *CreateExportRequest, opts ...grpc.CallOption) (*CreateExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExportReply)
+	err := c.cc.Invoke(ctx, ExportService_CreateExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) GetExport(ctx context.Context, in *GetExportRequest, opts ...grpc.CallOption) (*GetExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportReply)
+	err := c.cc.Invoke(ctx, ExportService_GetExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) GetExports(ctx context.Context, in *GetExportsRequest, opts ...grpc.CallOption) (*GetExportsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExportsReply)
+	err := c.cc.Invoke(ctx, ExportService_GetExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) DeleteExport(ctx context.Context, in *DeleteExportRequest, opts ...grpc.CallOption) (*DeleteExportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExportReply)
+	err := c.cc.Invoke(ctx, ExportService_DeleteExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportServiceServer is the server API for ExportService service.
+// All implementations must embed UnimplementedExportServiceServer
+// for forward compatibility.
+type ExportServiceServer interface {
+	// 异步导出目标树为zip压缩包(媒体文件、manifest.json、index.html)
+	CreateExport(context.Context, *CreateExportRequest) (*CreateExportReply, error)
+	// 导出任务状态，完成后返回下载链接
+	GetExport(context.Context, *GetExportRequest) (*GetExportReply, error)
+	GetExports(context.Context, *GetExportsRequest) (*GetExportsReply, error)
+	// 删除导出任务和压缩包
+	DeleteExport(context.Context, *DeleteExportRequest) (*DeleteExportReply, error)
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+// UnimplementedExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExportServiceServer struct{}
+
+func (UnimplementedExportServiceServer) CreateExport(context.Context, *CreateExportRequest) (*CreateExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExport not implemented")
+}
+func (UnimplementedExportServiceServer) GetExport(context.Context, *GetExportRequest) (*GetExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedExportServiceServer) GetExports(context.Context, *GetExportsRequest) (*GetExportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExports not implemented")
+}
+func (UnimplementedExportServiceServer) DeleteExport(context.Context, *DeleteExportRequest) (*DeleteExportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExport not implemented")
+}
+func (UnimplementedExportServiceServer) mustEmbedUnimplementedExportServiceServer() {}
+func (UnimplementedExportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportServiceServer will
+// result in compilation errors.
+type UnsafeExportServiceServer interface {
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+func RegisterExportServiceServer(s grpc.ServiceRegistrar, srv ExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExportService_ServiceDesc, srv)
+}
+
+func _ExportService_CreateExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).CreateExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_CreateExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).CreateExport(ctx, req.(*CreateExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).GetExport(ctx, req.(*GetExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_GetExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).GetExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_GetExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).GetExports(ctx, req.(*GetExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_DeleteExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).DeleteExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_DeleteExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).DeleteExport(ctx, req.(*DeleteExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExportService_ServiceDesc is the grpc.ServiceDesc for ExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.ExportService",
+	HandlerType: (*ExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExport",
+			Handler:    _ExportService_CreateExport_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _ExportService_GetExport_Handler,
+		},
+		{
+			MethodName: "GetExports",
+			Handler:    _ExportService_GetExports_Handler,
+		},
+		{
+			MethodName: "DeleteExport",
+			Handler:    _ExportService_DeleteExport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	ImportService_GetImport_FullMethodName      = "/step.v1.ImportService/GetImport"
+	ImportService_GetImports_FullMethodName     = "/step.v1.ImportService/GetImports"
+	ImportService_GetImportItems_FullMethodName = "/step.v1.ImportService/GetImportItems"
+	ImportService_ResumeImport_FullMethodName   = "/step.v1.ImportService/ResumeImport"
+)
+
+// ImportServiceClient is the client API for ImportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 压缩包或文件夹通过 POST /import/upload (multipart) 上传后创建导入任务
+type ImportServiceClient interface {
+	GetImport(ctx context.Context, in *GetImportRequest, opts ...grpc.CallOption) (*GetImportReply, error)
+	GetImports(ctx context.Context, in *GetImportsRequest, opts ...grpc.CallOption) (*GetImportsReply, error)
+	// 导入项的处理结果，可以只查看失败的
+	GetImportItems(ctx context.Context, in *GetImportItemsRequest, opts ...grpc.CallOption) (*GetImportItemsReply, error)
+	// 继续未完成的导入，已完成的导入项不会重复创建
+	ResumeImport(ctx context.Context, in *ResumeImportRequest, opts ...grpc.CallOption) (*ResumeImportReply, error)
+}
+
+type importServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImportServiceClient(cc grpc.ClientConnInterface) ImportServiceClient {
+	return &importServiceClient{cc}
+}
+
+func (c *importServiceClient) GetImport(ctx context.Context, in *GetImportRequest, opts ...grpc.CallOption) (*GetImportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportReply)
+	err := c.cc.Invoke(ctx, ImportService_GetImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *importServiceClient) GetImports(ctx context.Context, in *GetImportsRequest, opts ...grpc.CallOption) (*GetImportsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportsReply)
+	err := c.cc.Invoke(ctx, ImportService_GetImports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *importServiceClient) GetImportItems(ctx context.Context, in *GetImportItemsRequest, opts ...grpc.CallOption) (*GetImportItemsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetImportItemsReply)
+	err := c.cc.Invoke(ctx, ImportService_GetImportItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *importServiceClient) ResumeImport(ctx context.Context, in *ResumeImportRequest, opts ...grpc.CallOption) (*ResumeImportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeImportReply)
+	err := c.cc.Invoke(ctx, ImportService_ResumeImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportServiceServer is the server API for ImportService service.
+// All implementations must embed UnimplementedImportServiceServer
+// for forward compatibility.
+//
+// 压缩包或文件夹通过 POST /import/upload (multipart) 上传后创建导入任务
+type ImportServiceServer interface {
+	GetImport(context.Context, *GetImportRequest) (*GetImportReply, error)
+	GetImports(context.Context, *GetImportsRequest) (*GetImportsReply, error)
+	// 导入项的处理结果，可以只查看失败的
+	GetImportItems(context.Context, *GetImportItemsRequest) (*GetImportItemsReply, error)
+	// 继续未完成的导入，已完成的导入项不会重复创建
+	ResumeImport(context.Context, *ResumeImportRequest) (*ResumeImportReply, error)
+	mustEmbedUnimplementedImportServiceServer()
+}
+
+// UnimplementedImportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImportServiceServer struct{}
+
+func (UnimplementedImportServiceServer) GetImport(context.Context, *GetImportRequest) (*GetImportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImport not implemented")
+}
+func (UnimplementedImportServiceServer) GetImports(context.Context, *GetImportsRequest) (*GetImportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImports not implemented")
+}
+func (UnimplementedImportServiceServer) GetImportItems(context.Context, *GetImportItemsRequest) (*GetImportItemsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportItems not implemented")
+}
+func (UnimplementedImportServiceServer) ResumeImport(context.Context, *ResumeImportRequest) (*ResumeImportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeImport not implemented")
+}
+func (UnimplementedImportServiceServer) mustEmbedUnimplementedImportServiceServer() {}
+func (UnimplementedImportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeImportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImportServiceServer will
+// result in compilation errors.
+type UnsafeImportServiceServer interface {
+	mustEmbedUnimplementedImportServiceServer()
+}
+
+func RegisterImportServiceServer(s grpc.ServiceRegistrar, srv ImportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedImportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImportService_ServiceDesc, srv)
+}
+
+func _ImportService_GetImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportServiceServer).GetImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImportService_GetImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportServiceServer).GetImport(ctx, req.(*GetImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImportService_GetImports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportServiceServer).GetImports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImportService_GetImports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportServiceServer).GetImports(ctx, req.(*GetImportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImportService_GetImportItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportServiceServer).GetImportItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImportService_GetImportItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportServiceServer).GetImportItems(ctx, req.(*GetImportItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImportService_ResumeImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportServiceServer).ResumeImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImportService_ResumeImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImportServiceServer).ResumeImport(ctx, req.(*ResumeImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImportService_ServiceDesc is the grpc.ServiceDesc for ImportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.ImportService",
+	HandlerType: (*ImportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetImport",
+			Handler:    _ImportService_GetImport_Handler,
+		},
+		{
+			MethodName: "GetImports",
+			Handler:    _ImportService_GetImports_Handler,
+		},
+		{
+			MethodName: "GetImportItems",
+			Handler:    _ImportService_GetImportItems_Handler,
+		},
+		{
+			MethodName: "ResumeImport",
+			Handler:    _ImportService_ResumeImport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	ReportService_CreateReport_FullMethodName = "/step.v1.ReportService/CreateReport"
+	ReportService_GetReport_FullMethodName    = "/step.v1.ReportService/GetReport"
+	ReportService_GetReports_FullMethodName   = "/step.v1.ReportService/GetReports"
+	ReportService_DeleteReport_FullMethodName = "/step.v1.ReportService/DeleteReport"
+)
+
+// ReportServiceClient is the client API for ReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReportServiceClient interface {
+	// 异步生成目标在日期范围内的进度报告(HTML和PDF)
+	CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*CreateReportReply, error)
+	// 报告状态，完成后返回HTML和PDF的下载链接
+	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportReply, error)
+	GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsReply, error)
+	// 删除报告和生成的文件
+	DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportReply, error)
+}
+
+type reportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportServiceClient(cc grpc.ClientConnInterface) ReportServiceClient {
+	return &reportServiceClient{cc}
+}
+
+func (c *reportServiceClient) CreateReport(ctx context.Context, in *CreateReportRequest, opts ...grpc.CallOption) (*CreateReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReportReply)
+	err := c.cc.Invoke(ctx, ReportService_CreateReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportReply)
+	err := c.cc.Invoke(ctx, ReportService_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportsReply)
+	err := c.cc.Invoke(ctx, ReportService_GetReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...grpc.CallOption) (*DeleteReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReportReply)
+	err := c.cc.Invoke(ctx, ReportService_DeleteReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServiceServer is the server API for ReportService service.
+// All implementations must embed UnimplementedReportServiceServer
+// for forward compatibility.
+type ReportServiceServer interface {
+	// 异步生成目标在日期范围内的进度报告(HTML和PDF)
+	CreateReport(context.Context, *CreateReportRequest) (*CreateReportReply, error)
+	// 报告状态，完成后返回HTML和PDF的下载链接
+	GetReport(context.Context, *GetReportRequest) (*GetReportReply, error)
+	GetReports(context.Context, *GetReportsRequest) (*GetReportsReply, error)
+	// 删除报告和生成的文件
+	DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportReply, error)
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+// UnimplementedReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportServiceServer struct{}
+
+func (UnimplementedReportServiceServer) CreateReport(context.Context, *CreateReportRequest) (*CreateReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReport not implemented")
+}
+func (UnimplementedReportServiceServer) GetReport(context.Context, *GetReportRequest) (*GetReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedReportServiceServer) GetReports(context.Context, *GetReportsRequest) (*GetReportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReports not implemented")
+}
+func (UnimplementedReportServiceServer) DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReport not implemented")
+}
+func (UnimplementedReportServiceServer) mustEmbedUnimplementedReportServiceServer() {}
+func (UnimplementedReportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServiceServer will
+// result in compilation errors.
+type UnsafeReportServiceServer interface {
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+func RegisterReportServiceServer(s grpc.ServiceRegistrar, srv ReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportService_ServiceDesc, srv)
+}
+
+func _ReportService_CreateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).CreateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_CreateReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).CreateReport(ctx, req.(*CreateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetReport(ctx, req.(*GetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetReports(ctx, req.(*GetReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_DeleteReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).DeleteReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_DeleteReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).DeleteReport(ctx, req.(*DeleteReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportService_ServiceDesc is the grpc.ServiceDesc for ReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.ReportService",
+	HandlerType: (*ReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReport",
+			Handler:    _ReportService_CreateReport_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _ReportService_GetReport_Handler,
+		},
+		{
+			MethodName: "GetReports",
+			Handler:    _ReportService_GetReports_Handler,
+		},
+		{
+			MethodName: "DeleteReport",
+			Handler:    _ReportService_DeleteReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	PortraitService_GetPortraitBasic_FullMethodName          = "/step.v1.PortraitService/GetPortraitBasic"
+	PortraitService_GetPortraitStepRate_FullMethodName       = "/step.v1.PortraitService/GetPortraitStepRate"
+	PortraitService_GetPortraitTargetProgress_FullMethodName = "/step.v1.PortraitService/GetPortraitTargetProgress"
+)
+
+// PortraitServiceClient is the client API for PortraitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PortraitServiceClient interface {
+	GetPortraitBasic(ctx context.Context, in *GetPortraitBasicRequest, opts ...grpc.CallOption) (*GetPortraitBasicReply, error)
+	GetPortraitStepRate(ctx context.Context, in *GetPortraitStepRateRequest, opts ...grpc.CallOption) (*GetPortraitStepRateReply, error)
+	// 计量目标的累计进度
+	GetPortraitTargetProgress(ctx context.Context, in *GetPortraitTargetProgressRequest, opts ...grpc.CallOption) (*GetPortraitTargetProgressReply, error)
+}
+
+type portraitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPortraitServiceClient(cc grpc.ClientConnInterface) PortraitServiceClient {
+	return &portraitServiceClient{cc}
+}
+
+func (c *portraitServiceClient) GetPortraitBasic(ctx context.Context, in *GetPortraitBasicRequest, opts ...grpc.CallOption) (*GetPortraitBasicReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortraitBasicReply)
+	err := c.cc.Invoke(ctx, PortraitService_GetPortraitBasic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portraitServiceClient) GetPortraitStepRate(ctx context.Context, in *GetPortraitStepRateRequest, opts ...grpc.CallOption) (*GetPortraitStepRateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortraitStepRateReply)
+	err := c.cc.Invoke(ctx, PortraitService_GetPortraitStepRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portraitServiceClient) GetPortraitTargetProgress(ctx context.Context, in *GetPortraitTargetProgressRequest, opts ...grpc.CallOption) (*GetPortraitTargetProgressReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortraitTargetProgressReply)
+	err := c.cc.Invoke(ctx, PortraitService_GetPortraitTargetProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PortraitServiceServer is the server API for PortraitService service.
 // All implementations must embed UnimplementedPortraitServiceServer
 // for forward compatibility.
-type PortraitServiceServer interface {
-	GetPortraitBasic(context.Context, *GetPortraitBasicRequest) (*GetPortraitBasicReply, error)
-	GetPortraitStepRate(context.Context, *GetPortraitStepRateRequest) (*GetPortraitStepRateReply, error)
-	mustEmbedUnimplementedPortraitServiceServer()
+type PortraitServiceServer interface {
+	GetPortraitBasic(context.Context, *GetPortraitBasicRequest) (*GetPortraitBasicReply, error)
+	GetPortraitStepRate(context.Context, *GetPortraitStepRateRequest) (*GetPortraitStepRateReply, error)
+	// 计量目标的累计进度
+	GetPortraitTargetProgress(context.Context, *GetPortraitTargetProgressRequest) (*GetPortraitTargetProgressReply, error)
+	mustEmbedUnimplementedPortraitServiceServer()
+}
+
+// UnimplementedPortraitServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPortraitServiceServer struct{}
+
+func (UnimplementedPortraitServiceServer) GetPortraitBasic(context.Context, *GetPortraitBasicRequest) (*GetPortraitBasicReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortraitBasic not implemented")
+}
+func (UnimplementedPortraitServiceServer) GetPortraitStepRate(context.Context, *GetPortraitStepRateRequest) (*GetPortraitStepRateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortraitStepRate not implemented")
+}
+func (UnimplementedPortraitServiceServer) GetPortraitTargetProgress(context.Context, *GetPortraitTargetProgressRequest) (*GetPortraitTargetProgressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortraitTargetProgress not implemented")
+}
+func (UnimplementedPortraitServiceServer) mustEmbedUnimplementedPortraitServiceServer() {}
+func (UnimplementedPortraitServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePortraitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PortraitServiceServer will
+// result in compilation errors.
+type UnsafePortraitServiceServer interface {
+	mustEmbedUnimplementedPortraitServiceServer()
+}
+
+func RegisterPortraitServiceServer(s grpc.ServiceRegistrar, srv PortraitServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPortraitServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PortraitService_ServiceDesc, srv)
+}
+
+func _PortraitService_GetPortraitBasic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortraitBasicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortraitServiceServer).GetPortraitBasic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortraitService_GetPortraitBasic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortraitServiceServer).GetPortraitBasic(ctx, req.(*GetPortraitBasicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortraitService_GetPortraitStepRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortraitStepRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortraitServiceServer).GetPortraitStepRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortraitService_GetPortraitStepRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortraitServiceServer).GetPortraitStepRate(ctx, req.(*GetPortraitStepRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortraitService_GetPortraitTargetProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortraitTargetProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortraitServiceServer).GetPortraitTargetProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortraitService_GetPortraitTargetProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortraitServiceServer).GetPortraitTargetProgress(ctx, req.(*GetPortraitTargetProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PortraitService_ServiceDesc is the grpc.ServiceDesc for PortraitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PortraitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.PortraitService",
+	HandlerType: (*PortraitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPortraitBasic",
+			Handler:    _PortraitService_GetPortraitBasic_Handler,
+		},
+		{
+			MethodName: "GetPortraitStepRate",
+			Handler:    _PortraitService_GetPortraitStepRate_Handler,
+		},
+		{
+			MethodName: "GetPortraitTargetProgress",
+			Handler:    _PortraitService_GetPortraitTargetProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	FeedbackService_GetFeedbackAwards_FullMethodName   = "/step.v1.FeedbackService/GetFeedbackAwards"
+	FeedbackService_GetFeedbackAward_FullMethodName    = "/step.v1.FeedbackService/GetFeedbackAward"
+	FeedbackService_DeleteFeedbackAward_FullMethodName = "/step.v1.FeedbackService/DeleteFeedbackAward"
+)
+
+// FeedbackServiceClient is the client API for FeedbackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FeedbackServiceClient interface {
+	GetFeedbackAwards(ctx context.Context, in *GetFeedbackAwardsRequest, opts ...grpc.CallOption) (*GetFeedbackAwardsReply, error)
+	GetFeedbackAward(ctx context.Context, in *GetFeedbackAwardRequest, opts ...grpc.CallOption) (*GetFeedbackAwardReply, error)
+	DeleteFeedbackAward(ctx context.Context, in *DeleteFeedbackAwardRequest, opts ...grpc.CallOption) (*DeleteFeedbackAwardReply, error)
+}
+
+type feedbackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeedbackServiceClient(cc grpc.ClientConnInterface) FeedbackServiceClient {
+	return &feedbackServiceClient{cc}
+}
+
+func (c *feedbackServiceClient) GetFeedbackAwards(ctx context.Context, in *GetFeedbackAwardsRequest, opts ...grpc.CallOption) (*GetFeedbackAwardsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedbackAwardsReply)
+	err := c.cc.Invoke(ctx, FeedbackService_GetFeedbackAwards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) GetFeedbackAward(ctx context.Context, in *GetFeedbackAwardRequest, opts ...grpc.CallOption) (*GetFeedbackAwardReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedbackAwardReply)
+	err := c.cc.Invoke(ctx, FeedbackService_GetFeedbackAward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *feedbackServiceClient) DeleteFeedbackAward(ctx context.Context, in *DeleteFeedbackAwardRequest, opts ...grpc.CallOption) (*DeleteFeedbackAwardReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeedbackAwardReply)
+	err := c.cc.Invoke(ctx, FeedbackService_DeleteFeedbackAward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FeedbackServiceServer is the server API for FeedbackService service.
+// All implementations must embed UnimplementedFeedbackServiceServer
+// for forward compatibility.
+type FeedbackServiceServer interface {
+	GetFeedbackAwards(context.Context, *GetFeedbackAwardsRequest) (*GetFeedbackAwardsReply, error)
+	GetFeedbackAward(context.Context, *GetFeedbackAwardRequest) (*GetFeedbackAwardReply, error)
+	DeleteFeedbackAward(context.Context, *DeleteFeedbackAwardRequest) (*DeleteFeedbackAwardReply, error)
+	mustEmbedUnimplementedFeedbackServiceServer()
+}
+
+// UnimplementedFeedbackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeedbackServiceServer struct{}
+
+func (UnimplementedFeedbackServiceServer) GetFeedbackAwards(context.Context, *GetFeedbackAwardsRequest) (*GetFeedbackAwardsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackAwards not implemented")
+}
+func (UnimplementedFeedbackServiceServer) GetFeedbackAward(context.Context, *GetFeedbackAwardRequest) (*GetFeedbackAwardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackAward not implemented")
+}
+func (UnimplementedFeedbackServiceServer) DeleteFeedbackAward(context.Context, *DeleteFeedbackAwardRequest) (*DeleteFeedbackAwardReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedbackAward not implemented")
+}
+func (UnimplementedFeedbackServiceServer) mustEmbedUnimplementedFeedbackServiceServer() {}
+func (UnimplementedFeedbackServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeFeedbackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeedbackServiceServer will
+// result in compilation errors.
+type UnsafeFeedbackServiceServer interface {
+	mustEmbedUnimplementedFeedbackServiceServer()
+}
+
+func RegisterFeedbackServiceServer(s grpc.ServiceRegistrar, srv FeedbackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeedbackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeedbackService_ServiceDesc, srv)
+}
+
+func _FeedbackService_GetFeedbackAwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedbackAwardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).GetFeedbackAwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_GetFeedbackAwards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).GetFeedbackAwards(ctx, req.(*GetFeedbackAwardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_GetFeedbackAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedbackAwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).GetFeedbackAward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_GetFeedbackAward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).GetFeedbackAward(ctx, req.(*GetFeedbackAwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeedbackService_DeleteFeedbackAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeedbackAwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeedbackServiceServer).DeleteFeedbackAward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeedbackService_DeleteFeedbackAward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeedbackServiceServer).DeleteFeedbackAward(ctx, req.(*DeleteFeedbackAwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FeedbackService_ServiceDesc is the grpc.ServiceDesc for FeedbackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeedbackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.FeedbackService",
+	HandlerType: (*FeedbackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFeedbackAwards",
+			Handler:    _FeedbackService_GetFeedbackAwards_Handler,
+		},
+		{
+			MethodName: "GetFeedbackAward",
+			Handler:    _FeedbackService_GetFeedbackAward_Handler,
+		},
+		{
+			MethodName: "DeleteFeedbackAward",
+			Handler:    _FeedbackService_DeleteFeedbackAward_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	ReviewerService_CreateReviewerInvite_FullMethodName = "/step.v1.ReviewerService/CreateReviewerInvite"
+	ReviewerService_AcceptReviewerInvite_FullMethodName = "/step.v1.ReviewerService/AcceptReviewerInvite"
+	ReviewerService_GetReviewers_FullMethodName         = "/step.v1.ReviewerService/GetReviewers"
+	ReviewerService_DeleteReviewer_FullMethodName       = "/step.v1.ReviewerService/DeleteReviewer"
+	ReviewerService_GetReviewerInbox_FullMethodName     = "/step.v1.ReviewerService/GetReviewerInbox"
+	ReviewerService_SetReviewerComment_FullMethodName   = "/step.v1.ReviewerService/SetReviewerComment"
+	ReviewerService_RequestReview_FullMethodName        = "/step.v1.ReviewerService/RequestReview"
+	ReviewerService_GetReviewRequests_FullMethodName    = "/step.v1.ReviewerService/GetReviewRequests"
+)
+
+// ReviewerServiceClient is the client API for ReviewerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewerServiceClient interface {
+	// 学生邀请老师或家长，对方用邀请码关联后以自己的账号评论
+	CreateReviewerInvite(ctx context.Context, in *CreateReviewerInviteRequest, opts ...grpc.CallOption) (*CreateReviewerInviteReply, error)
+	AcceptReviewerInvite(ctx context.Context, in *AcceptReviewerInviteRequest, opts ...grpc.CallOption) (*AcceptReviewerInviteReply, error)
+	// 学生查看邀请的评论人，评论人查看关联的学生
+	GetReviewers(ctx context.Context, in *GetReviewersRequest, opts ...grpc.CallOption) (*GetReviewersReply, error)
+	// 学生或评论人都可以解除关联，未接受的邀请同时失效
+	DeleteReviewer(ctx context.Context, in *DeleteReviewerRequest, opts ...grpc.CallOption) (*DeleteReviewerReply, error)
+	// 关联学生在关联之后新增的积累，默认只返回自己还没有评分的
+	GetReviewerInbox(ctx context.Context, in *GetReviewerInboxRequest, opts ...grpc.CallOption) (*GetReviewerInboxReply, error)
+	// 以关联的角色评论学生的积累
+	SetReviewerComment(ctx context.Context, in *SetReviewerCommentRequest, opts ...grpc.CallOption) (*SetReviewerCommentReply, error)
+	// 学生请求关联的评论人或者通过分享链接的角色评论积累或目录，未评论时提醒一次，过期后不再提醒
+	RequestReview(ctx context.Context, in *RequestReviewRequest, opts ...grpc.CallOption) (*RequestReviewReply, error)
+	// 学生查看发出的评论请求，按创建时间倒序
+	GetReviewRequests(ctx context.Context, in *GetReviewRequestsRequest, opts ...grpc.CallOption) (*GetReviewRequestsReply, error)
+}
+
+type reviewerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewerServiceClient(cc grpc.ClientConnInterface) ReviewerServiceClient {
+	return &reviewerServiceClient{cc}
+}
+
+func (c *reviewerServiceClient) CreateReviewerInvite(ctx context.Context, in *CreateReviewerInviteRequest, opts ...grpc.CallOption) (*CreateReviewerInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReviewerInviteReply)
+	err := c.cc.Invoke(ctx, ReviewerService_CreateReviewerInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) AcceptReviewerInvite(ctx context.Context, in *AcceptReviewerInviteRequest, opts ...grpc.CallOption) (*AcceptReviewerInviteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptReviewerInviteReply)
+	err := c.cc.Invoke(ctx, ReviewerService_AcceptReviewerInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetReviewers(ctx context.Context, in *GetReviewersRequest, opts ...grpc.CallOption) (*GetReviewersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewersReply)
+	err := c.cc.Invoke(ctx, ReviewerService_GetReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) DeleteReviewer(ctx context.Context, in *DeleteReviewerRequest, opts ...grpc.CallOption) (*DeleteReviewerReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewerReply)
+	err := c.cc.Invoke(ctx, ReviewerService_DeleteReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetReviewerInbox(ctx context.Context, in *GetReviewerInboxRequest, opts ...grpc.CallOption) (*GetReviewerInboxReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewerInboxReply)
+	err := c.cc.Invoke(ctx, ReviewerService_GetReviewerInbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) SetReviewerComment(ctx context.Context, in *SetReviewerCommentRequest, opts ...grpc.CallOption) (*SetReviewerCommentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReviewerCommentReply)
+	err := c.cc.Invoke(ctx, ReviewerService_SetReviewerComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) RequestReview(ctx context.Context, in *RequestReviewRequest, opts ...grpc.CallOption) (*RequestReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestReviewReply)
+	err := c.cc.Invoke(ctx, ReviewerService_RequestReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetReviewRequests(ctx context.Context, in *GetReviewRequestsRequest, opts ...grpc.CallOption) (*GetReviewRequestsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewRequestsReply)
+	err := c.cc.Invoke(ctx, ReviewerService_GetReviewRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewerServiceServer is the server API for ReviewerService service.
+// All implementations must embed UnimplementedReviewerServiceServer
+// for forward compatibility.
+type ReviewerServiceServer interface {
+	// 学生邀请老师或家长，对方用邀请码关联后以自己的账号评论
+	CreateReviewerInvite(context.Context, *CreateReviewerInviteRequest) (*CreateReviewerInviteReply, error)
+	AcceptReviewerInvite(context.Context, *AcceptReviewerInviteRequest) (*AcceptReviewerInviteReply, error)
+	// 学生查看邀请的评论人，评论人查看关联的学生
+	GetReviewers(context.Context, *GetReviewersRequest) (*GetReviewersReply, error)
+	// 学生或评论人都可以解除关联，未接受的邀请同时失效
+	DeleteReviewer(context.Context, *DeleteReviewerRequest) (*DeleteReviewerReply, error)
+	// 关联学生在关联之后新增的积累，默认只返回自己还没有评分的
+	GetReviewerInbox(context.Context, *GetReviewerInboxRequest) (*GetReviewerInboxReply, error)
+	// 以关联的角色评论学生的积累
+	SetReviewerComment(context.Context, *SetReviewerCommentRequest) (*SetReviewerCommentReply, error)
+	// 学生请求关联的评论人或者通过分享链接的角色评论积累或目录，未评论时提醒一次，过期后不再提醒
+	RequestReview(context.Context, *RequestReviewRequest) (*RequestReviewReply, error)
+	// 学生查看发出的评论请求，按创建时间倒序
+	GetReviewRequests(context.Context, *GetReviewRequestsRequest) (*GetReviewRequestsReply, error)
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+// UnimplementedReviewerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewerServiceServer struct{}
+
+func (UnimplementedReviewerServiceServer) CreateReviewerInvite(context.Context, *CreateReviewerInviteRequest) (*CreateReviewerInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewerInvite not implemented")
+}
+func (UnimplementedReviewerServiceServer) AcceptReviewerInvite(context.Context, *AcceptReviewerInviteRequest) (*AcceptReviewerInviteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptReviewerInvite not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetReviewers(context.Context, *GetReviewersRequest) (*GetReviewersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewers not implemented")
+}
+func (UnimplementedReviewerServiceServer) DeleteReviewer(context.Context, *DeleteReviewerRequest) (*DeleteReviewerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewer not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetReviewerInbox(context.Context, *GetReviewerInboxRequest) (*GetReviewerInboxReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerInbox not implemented")
+}
+func (UnimplementedReviewerServiceServer) SetReviewerComment(context.Context, *SetReviewerCommentRequest) (*SetReviewerCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReviewerComment not implemented")
+}
+func (UnimplementedReviewerServiceServer) RequestReview(context.Context, *RequestReviewRequest) (*RequestReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReview not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetReviewRequests(context.Context, *GetReviewRequestsRequest) (*GetReviewRequestsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewRequests not implemented")
+}
+func (UnimplementedReviewerServiceServer) mustEmbedUnimplementedReviewerServiceServer() {}
+func (UnimplementedReviewerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReviewerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewerServiceServer will
+// result in compilation errors.
+type UnsafeReviewerServiceServer interface {
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+func RegisterReviewerServiceServer(s grpc.ServiceRegistrar, srv ReviewerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewerService_ServiceDesc, srv)
+}
+
+func _ReviewerService_CreateReviewerInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewerInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).CreateReviewerInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_CreateReviewerInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).CreateReviewerInvite(ctx, req.(*CreateReviewerInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_AcceptReviewerInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptReviewerInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).AcceptReviewerInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_AcceptReviewerInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).AcceptReviewerInvite(ctx, req.(*AcceptReviewerInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedPortraitServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPortraitServiceServer struct{}
-
-func (UnimplementedPortraitServiceServer) GetPortraitBasic(context.Context, *GetPortraitBasicRequest) (*GetPortraitBasicReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortraitBasic not implemented")
+func _ReviewerService_GetReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetReviewers(ctx, req.(*GetReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPortraitServiceServer) GetPortraitStepRate(context.Context, *GetPortraitStepRateRequest) (*GetPortraitStepRateReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortraitStepRate not implemented")
+
+func _ReviewerService_DeleteReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).DeleteReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_DeleteReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).DeleteReviewer(ctx, req.(*DeleteReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPortraitServiceServer) mustEmbedUnimplementedPortraitServiceServer() {}
-func (UnimplementedPortraitServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafePortraitServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PortraitServiceServer will
-// result in compilation errors.
-type UnsafePortraitServiceServer interface {
-	mustEmbedUnimplementedPortraitServiceServer()
+func _ReviewerService_GetReviewerInbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewerInboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetReviewerInbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetReviewerInbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetReviewerInbox(ctx, req.(*GetReviewerInboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPortraitServiceServer(s grpc.ServiceRegistrar, srv PortraitServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPortraitServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ReviewerService_SetReviewerComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReviewerCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PortraitService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).SetReviewerComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_SetReviewerComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).SetReviewerComment(ctx, req.(*SetReviewerCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PortraitService_GetPortraitBasic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortraitBasicRequest)
+func _ReviewerService_RequestReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortraitServiceServer).GetPortraitBasic(ctx, in)
+		return srv.(ReviewerServiceServer).RequestReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortraitService_GetPortraitBasic_FullMethodName,
+		FullMethod: ReviewerService_RequestReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortraitServiceServer).GetPortraitBasic(ctx, req.(*GetPortraitBasicRequest))
+		return srv.(ReviewerServiceServer).RequestReview(ctx, req.(*RequestReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortraitService_GetPortraitStepRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortraitStepRateRequest)
+func _ReviewerService_GetReviewRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortraitServiceServer).GetPortraitStepRate(ctx, in)
+		return srv.(ReviewerServiceServer).GetReviewRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortraitService_GetPortraitStepRate_FullMethodName,
+		FullMethod: ReviewerService_GetReviewRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortraitServiceServer).GetPortraitStepRate(ctx, req.(*GetPortraitStepRateRequest))
+		return srv.(ReviewerServiceServer).GetReviewRequests(ctx, req.(*GetReviewRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PortraitService_ServiceDesc is the grpc.ServiceDesc for PortraitService service.
+// ReviewerService_ServiceDesc is the grpc.ServiceDesc for ReviewerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PortraitService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "step.v1.PortraitService",
-	HandlerType: (*PortraitServiceServer)(nil),
+var ReviewerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.ReviewerService",
+	HandlerType: (*ReviewerServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetPortraitBasic",
-			Handler:    _PortraitService_GetPortraitBasic_Handler,
+			MethodName: "CreateReviewerInvite",
+			Handler:    _ReviewerService_CreateReviewerInvite_Handler,
 		},
 		{
-			MethodName: "GetPortraitStepRate",
-			Handler:    _PortraitService_GetPortraitStepRate_Handler,
+			MethodName: "AcceptReviewerInvite",
+			Handler:    _ReviewerService_AcceptReviewerInvite_Handler,
+		},
+		{
+			MethodName: "GetReviewers",
+			Handler:    _ReviewerService_GetReviewers_Handler,
+		},
+		{
+			MethodName: "DeleteReviewer",
+			Handler:    _ReviewerService_DeleteReviewer_Handler,
+		},
+		{
+			MethodName: "GetReviewerInbox",
+			Handler:    _ReviewerService_GetReviewerInbox_Handler,
+		},
+		{
+			MethodName: "SetReviewerComment",
+			Handler:    _ReviewerService_SetReviewerComment_Handler,
+		},
+		{
+			MethodName: "RequestReview",
+			Handler:    _ReviewerService_RequestReview_Handler,
+		},
+		{
+			MethodName: "GetReviewRequests",
+			Handler:    _ReviewerService_GetReviewRequests_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -697,177 +3239,327 @@ var PortraitService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	FeedbackService_GetFeedbackAwards_FullMethodName   = "/step.v1.FeedbackService/GetFeedbackAwards"
-	FeedbackService_GetFeedbackAward_FullMethodName    = "/step.v1.FeedbackService/GetFeedbackAward"
-	FeedbackService_DeleteFeedbackAward_FullMethodName = "/step.v1.FeedbackService/DeleteFeedbackAward"
+	CommentService_GetStepComments_FullMethodName = "/step.v1.CommentService/GetStepComments"
+	CommentService_ReplyComment_FullMethodName    = "/step.v1.CommentService/ReplyComment"
+	CommentService_UpdateComment_FullMethodName   = "/step.v1.CommentService/UpdateComment"
 )
 
-// FeedbackServiceClient is the client API for FeedbackService service.
+// CommentServiceClient is the client API for CommentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type FeedbackServiceClient interface {
-	GetFeedbackAwards(ctx context.Context, in *GetFeedbackAwardsRequest, opts ...grpc.CallOption) (*GetFeedbackAwardsReply, error)
-	GetFeedbackAward(ctx context.Context, in *GetFeedbackAwardRequest, opts ...grpc.CallOption) (*GetFeedbackAwardReply, error)
-	DeleteFeedbackAward(ctx context.Context, in *DeleteFeedbackAwardRequest, opts ...grpc.CallOption) (*DeleteFeedbackAwardReply, error)
+type CommentServiceClient interface {
+	// 积累所有者和关联的老师、家长查看评论，回复挂在顶层评论下
+	GetStepComments(ctx context.Context, in *GetStepCommentsRequest, opts ...grpc.CallOption) (*GetStepCommentsReply, error)
+	// 积累所有者和关联的老师、家长回复顶层评论
+	ReplyComment(ctx context.Context, in *ReplyCommentRequest, opts ...grpc.CallOption) (*ReplyCommentReply, error)
+	// 作者修改评论，修改前的版本保存在历史中，修改评分后重新计算统计
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentReply, error)
 }
 
-type feedbackServiceClient struct {
+type commentServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewFeedbackServiceClient(cc grpc.ClientConnInterface) FeedbackServiceClient {
-	return &feedbackServiceClient{cc}
+func NewCommentServiceClient(cc grpc.ClientConnInterface) CommentServiceClient {
+	return &commentServiceClient{cc}
 }
 
-func (c *feedbackServiceClient) GetFeedbackAwards(ctx context.Context, in *GetFeedbackAwardsRequest, opts ...grpc.CallOption) (*GetFeedbackAwardsReply, error) {
+func (c *commentServiceClient) GetStepComments(ctx context.Context, in *GetStepCommentsRequest, opts ...grpc.CallOption) (*GetStepCommentsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFeedbackAwardsReply)
-	err := c.cc.Invoke(ctx, FeedbackService_GetFeedbackAwards_FullMethodName, in, out, cOpts...)
+	out := new(GetStepCommentsReply)
+	err := c.cc.Invoke(ctx, CommentService_GetStepComments_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *feedbackServiceClient) GetFeedbackAward(ctx context.Context, in *GetFeedbackAwardRequest, opts ...grpc.CallOption) (*GetFeedbackAwardReply, error) {
+func (c *commentServiceClient) ReplyComment(ctx context.Context, in *ReplyCommentRequest, opts ...grpc.CallOption) (*ReplyCommentReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFeedbackAwardReply)
-	err := c.cc.Invoke(ctx, FeedbackService_GetFeedbackAward_FullMethodName, in, out, cOpts...)
+	out := new(ReplyCommentReply)
+	err := c.cc.Invoke(ctx, CommentService_ReplyComment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *feedbackServiceClient) DeleteFeedbackAward(ctx context.Context, in *DeleteFeedbackAwardRequest, opts ...grpc.CallOption) (*DeleteFeedbackAwardReply, error) {
+func (c *commentServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteFeedbackAwardReply)
-	err := c.cc.Invoke(ctx, FeedbackService_DeleteFeedbackAward_FullMethodName, in, out, cOpts...)
+	out := new(UpdateCommentReply)
+	err := c.cc.Invoke(ctx, CommentService_UpdateComment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// FeedbackServiceServer is the server API for FeedbackService service.
-// All implementations must embed UnimplementedFeedbackServiceServer
+// CommentServiceServer is the server API for CommentService service.
+// All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility.
-type FeedbackServiceServer interface {
-	GetFeedbackAwards(context.Context, *GetFeedbackAwardsRequest) (*GetFeedbackAwardsReply, error)
-	GetFeedbackAward(context.Context, *GetFeedbackAwardRequest) (*GetFeedbackAwardReply, error)
-	DeleteFeedbackAward(context.Context, *DeleteFeedbackAwardRequest) (*DeleteFeedbackAwardReply, error)
-	mustEmbedUnimplementedFeedbackServiceServer()
+type CommentServiceServer interface {
+	// 积累所有者和关联的老师、家长查看评论，回复挂在顶层评论下
+	GetStepComments(context.Context, *GetStepCommentsRequest) (*GetStepCommentsReply, error)
+	// 积累所有者和关联的老师、家长回复顶层评论
+	ReplyComment(context.Context, *ReplyCommentRequest) (*ReplyCommentReply, error)
+	// 作者修改评论，修改前的版本保存在历史中，修改评分后重新计算统计
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error)
+	mustEmbedUnimplementedCommentServiceServer()
 }
 
-// UnimplementedFeedbackServiceServer must be embedded to have
+// UnimplementedCommentServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedFeedbackServiceServer struct{}
+type UnimplementedCommentServiceServer struct{}
 
-func (UnimplementedFeedbackServiceServer) GetFeedbackAwards(context.Context, *GetFeedbackAwardsRequest) (*GetFeedbackAwardsReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackAwards not implemented")
+func (UnimplementedCommentServiceServer) GetStepComments(context.Context, *GetStepCommentsRequest) (*GetStepCommentsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStepComments not implemented")
 }
-func (UnimplementedFeedbackServiceServer) GetFeedbackAward(context.Context, *GetFeedbackAwardRequest) (*GetFeedbackAwardReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackAward not implemented")
+func (UnimplementedCommentServiceServer) ReplyComment(context.Context, *ReplyCommentRequest) (*ReplyCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyComment not implemented")
 }
-func (UnimplementedFeedbackServiceServer) DeleteFeedbackAward(context.Context, *DeleteFeedbackAwardRequest) (*DeleteFeedbackAwardReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedbackAward not implemented")
+func (UnimplementedCommentServiceServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
 }
-func (UnimplementedFeedbackServiceServer) mustEmbedUnimplementedFeedbackServiceServer() {}
-func (UnimplementedFeedbackServiceServer) testEmbeddedByValue()                         {}
+func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
+func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeFeedbackServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FeedbackServiceServer will
+// UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentServiceServer will
 // result in compilation errors.
-type UnsafeFeedbackServiceServer interface {
-	mustEmbedUnimplementedFeedbackServiceServer()
+type UnsafeCommentServiceServer interface {
+	mustEmbedUnimplementedCommentServiceServer()
 }
 
-func RegisterFeedbackServiceServer(s grpc.ServiceRegistrar, srv FeedbackServiceServer) {
-	// If the following call pancis, it indicates UnimplementedFeedbackServiceServer was
+func RegisterCommentServiceServer(s grpc.ServiceRegistrar, srv CommentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&FeedbackService_ServiceDesc, srv)
+	s.RegisterService(&CommentService_ServiceDesc, srv)
 }
 
-func _FeedbackService_GetFeedbackAwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeedbackAwardsRequest)
+func _CommentService_GetStepComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStepCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedbackServiceServer).GetFeedbackAwards(ctx, in)
+		return srv.(CommentServiceServer).GetStepComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedbackService_GetFeedbackAwards_FullMethodName,
+		FullMethod: CommentService_GetStepComments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedbackServiceServer).GetFeedbackAwards(ctx, req.(*GetFeedbackAwardsRequest))
+		return srv.(CommentServiceServer).GetStepComments(ctx, req.(*GetStepCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedbackService_GetFeedbackAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeedbackAwardRequest)
+func _CommentService_ReplyComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedbackServiceServer).GetFeedbackAward(ctx, in)
+		return srv.(CommentServiceServer).ReplyComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedbackService_GetFeedbackAward_FullMethodName,
+		FullMethod: CommentService_ReplyComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedbackServiceServer).GetFeedbackAward(ctx, req.(*GetFeedbackAwardRequest))
+		return srv.(CommentServiceServer).ReplyComment(ctx, req.(*ReplyCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _FeedbackService_DeleteFeedbackAward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFeedbackAwardRequest)
+func _CommentService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FeedbackServiceServer).DeleteFeedbackAward(ctx, in)
+		return srv.(CommentServiceServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FeedbackService_DeleteFeedbackAward_FullMethodName,
+		FullMethod: CommentService_UpdateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FeedbackServiceServer).DeleteFeedbackAward(ctx, req.(*DeleteFeedbackAwardRequest))
+		return srv.(CommentServiceServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// FeedbackService_ServiceDesc is the grpc.ServiceDesc for FeedbackService service.
+// CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var FeedbackService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "step.v1.FeedbackService",
-	HandlerType: (*FeedbackServiceServer)(nil),
+var CommentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.CommentService",
+	HandlerType: (*CommentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetFeedbackAwards",
-			Handler:    _FeedbackService_GetFeedbackAwards_Handler,
+			MethodName: "GetStepComments",
+			Handler:    _CommentService_GetStepComments_Handler,
 		},
 		{
-			MethodName: "GetFeedbackAward",
-			Handler:    _FeedbackService_GetFeedbackAward_Handler,
+			MethodName: "ReplyComment",
+			Handler:    _CommentService_ReplyComment_Handler,
 		},
 		{
-			MethodName: "DeleteFeedbackAward",
-			Handler:    _FeedbackService_DeleteFeedbackAward_Handler,
+			MethodName: "UpdateComment",
+			Handler:    _CommentService_UpdateComment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "step/v1/step.proto",
+}
+
+const (
+	RubricService_GetTargetRubric_FullMethodName = "/step.v1.RubricService/GetTargetRubric"
+	RubricService_SetTargetRubric_FullMethodName = "/step.v1.RubricService/SetTargetRubric"
+)
+
+// RubricServiceClient is the client API for RubricService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RubricServiceClient interface {
+	// 顶层目标的评分标准，没有自定义时为默认评分标准；目标所有者和关联的老师、家长可以查看
+	GetTargetRubric(ctx context.Context, in *GetTargetRubricRequest, opts ...grpc.CallOption) (*GetTargetRubricReply, error)
+	// 自定义顶层目标的评分标准，criteria为空时恢复默认评分标准
+	SetTargetRubric(ctx context.Context, in *SetTargetRubricRequest, opts ...grpc.CallOption) (*SetTargetRubricReply, error)
+}
+
+type rubricServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRubricServiceClient(cc grpc.ClientConnInterface) RubricServiceClient {
+	return &rubricServiceClient{cc}
+}
+
+func (c *rubricServiceClient) GetTargetRubric(ctx context.Context, in *GetTargetRubricRequest, opts ...grpc.CallOption) (*GetTargetRubricReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTargetRubricReply)
+	err := c.cc.Invoke(ctx, RubricService_GetTargetRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rubricServiceClient) SetTargetRubric(ctx context.Context, in *SetTargetRubricRequest, opts ...grpc.CallOption) (*SetTargetRubricReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTargetRubricReply)
+	err := c.cc.Invoke(ctx, RubricService_SetTargetRubric_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RubricServiceServer is the server API for RubricService service.
+// All implementations must embed UnimplementedRubricServiceServer
+// for forward compatibility.
+type RubricServiceServer interface {
+	// 顶层目标的评分标准，没有自定义时为默认评分标准；目标所有者和关联的老师、家长可以查看
+	GetTargetRubric(context.Context, *GetTargetRubricRequest) (*GetTargetRubricReply, error)
+	// 自定义顶层目标的评分标准，criteria为空时恢复默认评分标准
+	SetTargetRubric(context.Context, *SetTargetRubricRequest) (*SetTargetRubricReply, error)
+	mustEmbedUnimplementedRubricServiceServer()
+}
+
+// UnimplementedRubricServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRubricServiceServer struct{}
+
+func (UnimplementedRubricServiceServer) GetTargetRubric(context.Context, *GetTargetRubricRequest) (*GetTargetRubricReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTargetRubric not implemented")
+}
+func (UnimplementedRubricServiceServer) SetTargetRubric(context.Context, *SetTargetRubricRequest) (*SetTargetRubricReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTargetRubric not implemented")
+}
+func (UnimplementedRubricServiceServer) mustEmbedUnimplementedRubricServiceServer() {}
+func (UnimplementedRubricServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRubricServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RubricServiceServer will
+// result in compilation errors.
+type UnsafeRubricServiceServer interface {
+	mustEmbedUnimplementedRubricServiceServer()
+}
+
+func RegisterRubricServiceServer(s grpc.ServiceRegistrar, srv RubricServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRubricServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RubricService_ServiceDesc, srv)
+}
+
+func _RubricService_GetTargetRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTargetRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubricServiceServer).GetTargetRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubricService_GetTargetRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubricServiceServer).GetTargetRubric(ctx, req.(*GetTargetRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RubricService_SetTargetRubric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTargetRubricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RubricServiceServer).SetTargetRubric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RubricService_SetTargetRubric_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RubricServiceServer).SetTargetRubric(ctx, req.(*SetTargetRubricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RubricService_ServiceDesc is the grpc.ServiceDesc for RubricService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RubricService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "step.v1.RubricService",
+	HandlerType: (*RubricServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTargetRubric",
+			Handler:    _RubricService_GetTargetRubric_Handler,
+		},
+		{
+			MethodName: "SetTargetRubric",
+			Handler:    _RubricService_SetTargetRubric_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
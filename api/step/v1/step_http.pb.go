@@ -20,21 +20,45 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationStepServiceAddTargetDirStep = "/step.v1.StepService/AddTargetDirStep"
+const OperationStepServiceAddTargetMeasurementStep = "/step.v1.StepService/AddTargetMeasurementStep"
+const OperationStepServiceCloneTarget = "/step.v1.StepService/CloneTarget"
+const OperationStepServiceCreateLinkStep = "/step.v1.StepService/CreateLinkStep"
+const OperationStepServiceCreateNoteStep = "/step.v1.StepService/CreateNoteStep"
 const OperationStepServiceCreateTarget = "/step.v1.StepService/CreateTarget"
 const OperationStepServiceDeleteTarget = "/step.v1.StepService/DeleteTarget"
 const OperationStepServiceDeleteTargetStep = "/step.v1.StepService/DeleteTargetStep"
 const OperationStepServiceDoneTarget = "/step.v1.StepService/DoneTarget"
 const OperationStepServiceEncrypt = "/step.v1.StepService/Encrypt"
+const OperationStepServiceGetActivityHeatmap = "/step.v1.StepService/GetActivityHeatmap"
+const OperationStepServiceGetActivityTimeline = "/step.v1.StepService/GetActivityTimeline"
+const OperationStepServiceGetSelfAssessmentComparison = "/step.v1.StepService/GetSelfAssessmentComparison"
 const OperationStepServiceGetTarget = "/step.v1.StepService/GetTarget"
+const OperationStepServiceGetTargetAdherence = "/step.v1.StepService/GetTargetAdherence"
 const OperationStepServiceGetTargetDirStepChildren = "/step.v1.StepService/GetTargetDirStepChildren"
 const OperationStepServiceGetTargetTree = "/step.v1.StepService/GetTargetTree"
 const OperationStepServiceGetTargets = "/step.v1.StepService/GetTargets"
+const OperationStepServiceMoveStep = "/step.v1.StepService/MoveStep"
+const OperationStepServiceRenameDirStep = "/step.v1.StepService/RenameDirStep"
+const OperationStepServiceReorderSteps = "/step.v1.StepService/ReorderSteps"
+const OperationStepServiceReorderTargets = "/step.v1.StepService/ReorderTargets"
+const OperationStepServiceSearch = "/step.v1.StepService/Search"
+const OperationStepServiceSetSelfAssessment = "/step.v1.StepService/SetSelfAssessment"
+const OperationStepServiceUpdateLinkStep = "/step.v1.StepService/UpdateLinkStep"
+const OperationStepServiceUpdateNoteStep = "/step.v1.StepService/UpdateNoteStep"
 const OperationStepServiceUpdateTarget = "/step.v1.StepService/UpdateTarget"
 const OperationStepServiceUpdateTargetStep = "/step.v1.StepService/UpdateTargetStep"
 
 type StepServiceHTTPServer interface {
 	// AddTargetDirStep 添加目标积累(for dir)
 	AddTargetDirStep(context.Context, *AddTargetDirStepRequest) (*AddTargetDirStepReply, error)
+	// AddTargetMeasurementStep 添加计量积累(for quantity target)
+	AddTargetMeasurementStep(context.Context, *AddTargetMeasurementStepRequest) (*AddTargetMeasurementStepReply, error)
+	// CloneTarget 深拷贝目标树，可选是否复制积累和媒体文件
+	CloneTarget(context.Context, *CloneTargetRequest) (*CloneTargetReply, error)
+	// CreateLinkStep 添加链接积累
+	CreateLinkStep(context.Context, *CreateLinkStepRequest) (*CreateLinkStepReply, error)
+	// CreateNoteStep 添加文字积累(markdown)
+	CreateNoteStep(context.Context, *CreateNoteStepRequest) (*CreateNoteStepReply, error)
 	CreateTarget(context.Context, *CreateTargetRequest) (*CreateTargetReply, error)
 	// DeleteTarget 删除目标下子目标以及目标下的积累
 	DeleteTarget(context.Context, *DeleteTargetRequest) (*DeleteTargetReply, error)
@@ -43,14 +67,38 @@ type StepServiceHTTPServer interface {
 	// DoneTarget 完成目标
 	DoneTarget(context.Context, *DoneTargetRequest) (*DoneTargetReply, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptReply, error)
+	// GetActivityHeatmap 一年中每天的积累数，用于日历热力图
+	GetActivityHeatmap(context.Context, *GetActivityHeatmapRequest) (*GetActivityHeatmapReply, error)
+	// GetActivityTimeline 所有目标的积累按用户时区的自然日分组
+	GetActivityTimeline(context.Context, *GetActivityTimelineRequest) (*GetActivityTimelineReply, error)
+	// GetSelfAssessmentComparison 自评和老师、家长、朋友评分的对比，指定积累或者目标下所有同时有自评和他评的积累
+	GetSelfAssessmentComparison(context.Context, *GetSelfAssessmentComparisonRequest) (*GetSelfAssessmentComparisonReply, error)
 	// GetTarget 获取目标及目标下的积累
 	GetTarget(context.Context, *GetTargetRequest) (*GetTargetReply, error)
+	// GetTargetAdherence 获取目标按周期的打卡达成情况
+	GetTargetAdherence(context.Context, *GetTargetAdherenceRequest) (*GetTargetAdherenceReply, error)
 	// GetTargetDirStepChildren 获取目录类型积累的子积累
 	GetTargetDirStepChildren(context.Context, *GetTargetDirStepChildrenRequest) (*GetTargetDirStepChildrenReply, error)
 	// GetTargetTree 获取目标树
 	GetTargetTree(context.Context, *GetTargetTreeRequest) (*GetTargetTreeReply, error)
 	// GetTargets 获取目标列表
 	GetTargets(context.Context, *GetTargetsRequest) (*GetTargetsReply, error)
+	// MoveStep 移动积累(目录连同其下所有积累)到其他目录或目标
+	MoveStep(context.Context, *MoveStepRequest) (*MoveStepReply, error)
+	// RenameDirStep 重命名目录
+	RenameDirStep(context.Context, *RenameDirStepRequest) (*RenameDirStepReply, error)
+	// ReorderSteps 手动排序目标或目录下的积累
+	ReorderSteps(context.Context, *ReorderStepsRequest) (*ReorderStepsReply, error)
+	// ReorderTargets 手动排序同级目标
+	ReorderTargets(context.Context, *ReorderTargetsRequest) (*ReorderTargetsReply, error)
+	// Search 全文搜索当前用户的目标、积累和评论
+	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	// SetSelfAssessment 积累所有者按同一个评分标准自评，每个积累只能自评一次，之后通过CommentService.UpdateComment修改
+	SetSelfAssessment(context.Context, *SetSelfAssessmentRequest) (*SetSelfAssessmentReply, error)
+	// UpdateLinkStep 修改链接积累
+	UpdateLinkStep(context.Context, *UpdateLinkStepRequest) (*UpdateLinkStepReply, error)
+	// UpdateNoteStep 修改文字积累
+	UpdateNoteStep(context.Context, *UpdateNoteStepRequest) (*UpdateNoteStepReply, error)
 	UpdateTarget(context.Context, *UpdateTargetRequest) (*UpdateTargetReply, error)
 	// UpdateTargetStep 修改积累
 	UpdateTargetStep(context.Context, *UpdateTargetStepRequest) (*UpdateTargetStepReply, error)
@@ -70,6 +118,22 @@ func RegisterStepServiceHTTPServer(s *http.Server, srv StepServiceHTTPServer) {
 	r.PUT("/step/{id}", _StepService_UpdateTargetStep0_HTTP_Handler(srv))
 	r.DELETE("/step/{id}", _StepService_DeleteTargetStep0_HTTP_Handler(srv))
 	r.POST("/step/{id}/encrypt", _StepService_Encrypt0_HTTP_Handler(srv))
+	r.GET("/target/{id}/adherence", _StepService_GetTargetAdherence0_HTTP_Handler(srv))
+	r.POST("/target/{id}/measurement", _StepService_AddTargetMeasurementStep0_HTTP_Handler(srv))
+	r.POST("/target/{id}/note", _StepService_CreateNoteStep0_HTTP_Handler(srv))
+	r.PUT("/step/{id}/note", _StepService_UpdateNoteStep0_HTTP_Handler(srv))
+	r.POST("/target/{id}/link", _StepService_CreateLinkStep0_HTTP_Handler(srv))
+	r.PUT("/step/{id}/link", _StepService_UpdateLinkStep0_HTTP_Handler(srv))
+	r.PUT("/step/{id}/rename", _StepService_RenameDirStep0_HTTP_Handler(srv))
+	r.POST("/step/{id}/move", _StepService_MoveStep0_HTTP_Handler(srv))
+	r.POST("/target/reorder", _StepService_ReorderTargets0_HTTP_Handler(srv))
+	r.POST("/target/{id}/reorder_steps", _StepService_ReorderSteps0_HTTP_Handler(srv))
+	r.GET("/search", _StepService_Search0_HTTP_Handler(srv))
+	r.POST("/target/{id}/clone", _StepService_CloneTarget0_HTTP_Handler(srv))
+	r.GET("/activity/timeline", _StepService_GetActivityTimeline0_HTTP_Handler(srv))
+	r.GET("/activity/heatmap", _StepService_GetActivityHeatmap0_HTTP_Handler(srv))
+	r.POST("/step/{id}/self_assessment", _StepService_SetSelfAssessment0_HTTP_Handler(srv))
+	r.GET("/self_assessment/comparison", _StepService_GetSelfAssessmentComparison0_HTTP_Handler(srv))
 }
 
 func _StepService_CreateTarget0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
@@ -348,86 +412,2366 @@ func _StepService_Encrypt0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http
 	}
 }
 
+func _StepService_GetTargetAdherence0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTargetAdherenceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceGetTargetAdherence)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTargetAdherence(ctx, req.(*GetTargetAdherenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTargetAdherenceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_AddTargetMeasurementStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddTargetMeasurementStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceAddTargetMeasurementStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddTargetMeasurementStep(ctx, req.(*AddTargetMeasurementStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddTargetMeasurementStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_CreateNoteStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateNoteStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceCreateNoteStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateNoteStep(ctx, req.(*CreateNoteStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateNoteStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_UpdateNoteStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateNoteStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceUpdateNoteStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateNoteStep(ctx, req.(*UpdateNoteStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateNoteStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_CreateLinkStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateLinkStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceCreateLinkStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateLinkStep(ctx, req.(*CreateLinkStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateLinkStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_UpdateLinkStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateLinkStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceUpdateLinkStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateLinkStep(ctx, req.(*UpdateLinkStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateLinkStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_RenameDirStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenameDirStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceRenameDirStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenameDirStep(ctx, req.(*RenameDirStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenameDirStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_MoveStep0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MoveStepRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceMoveStep)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveStep(ctx, req.(*MoveStepRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MoveStepReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_ReorderTargets0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderTargetsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceReorderTargets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderTargets(ctx, req.(*ReorderTargetsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReorderTargetsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_ReorderSteps0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReorderStepsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceReorderSteps)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReorderSteps(ctx, req.(*ReorderStepsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReorderStepsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_Search0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceSearch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Search(ctx, req.(*SearchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_CloneTarget0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CloneTargetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceCloneTarget)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CloneTarget(ctx, req.(*CloneTargetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CloneTargetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_GetActivityTimeline0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetActivityTimelineRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceGetActivityTimeline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetActivityTimeline(ctx, req.(*GetActivityTimelineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetActivityTimelineReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_GetActivityHeatmap0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetActivityHeatmapRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceGetActivityHeatmap)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetActivityHeatmap(ctx, req.(*GetActivityHeatmapRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetActivityHeatmapReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_SetSelfAssessment0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetSelfAssessmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceSetSelfAssessment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetSelfAssessment(ctx, req.(*SetSelfAssessmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetSelfAssessmentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _StepService_GetSelfAssessmentComparison0_HTTP_Handler(srv StepServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSelfAssessmentComparisonRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationStepServiceGetSelfAssessmentComparison)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSelfAssessmentComparison(ctx, req.(*GetSelfAssessmentComparisonRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSelfAssessmentComparisonReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type StepServiceHTTPClient interface {
 	AddTargetDirStep(ctx context.Context, req *AddTargetDirStepRequest, opts ...http.CallOption) (rsp *AddTargetDirStepReply, err error)
+	AddTargetMeasurementStep(ctx context.Context, req *AddTargetMeasurementStepRequest, opts ...http.CallOption) (rsp *AddTargetMeasurementStepReply, err error)
+	CloneTarget(ctx context.Context, req *CloneTargetRequest, opts ...http.CallOption) (rsp *CloneTargetReply, err error)
+	CreateLinkStep(ctx context.Context, req *CreateLinkStepRequest, opts ...http.CallOption) (rsp *CreateLinkStepReply, err error)
+	CreateNoteStep(ctx context.Context, req *CreateNoteStepRequest, opts ...http.CallOption) (rsp *CreateNoteStepReply, err error)
 	CreateTarget(ctx context.Context, req *CreateTargetRequest, opts ...http.CallOption) (rsp *CreateTargetReply, err error)
 	DeleteTarget(ctx context.Context, req *DeleteTargetRequest, opts ...http.CallOption) (rsp *DeleteTargetReply, err error)
 	DeleteTargetStep(ctx context.Context, req *DeleteTargetStepRequest, opts ...http.CallOption) (rsp *DeleteTargetStepReply, err error)
 	DoneTarget(ctx context.Context, req *DoneTargetRequest, opts ...http.CallOption) (rsp *DoneTargetReply, err error)
 	Encrypt(ctx context.Context, req *EncryptRequest, opts ...http.CallOption) (rsp *EncryptReply, err error)
+	GetActivityHeatmap(ctx context.Context, req *GetActivityHeatmapRequest, opts ...http.CallOption) (rsp *GetActivityHeatmapReply, err error)
+	GetActivityTimeline(ctx context.Context, req *GetActivityTimelineRequest, opts ...http.CallOption) (rsp *GetActivityTimelineReply, err error)
+	GetSelfAssessmentComparison(ctx context.Context, req *GetSelfAssessmentComparisonRequest, opts ...http.CallOption) (rsp *GetSelfAssessmentComparisonReply, err error)
 	GetTarget(ctx context.Context, req *GetTargetRequest, opts ...http.CallOption) (rsp *GetTargetReply, err error)
+	GetTargetAdherence(ctx context.Context, req *GetTargetAdherenceRequest, opts ...http.CallOption) (rsp *GetTargetAdherenceReply, err error)
 	GetTargetDirStepChildren(ctx context.Context, req *GetTargetDirStepChildrenRequest, opts ...http.CallOption) (rsp *GetTargetDirStepChildrenReply, err error)
 	GetTargetTree(ctx context.Context, req *GetTargetTreeRequest, opts ...http.CallOption) (rsp *GetTargetTreeReply, err error)
 	GetTargets(ctx context.Context, req *GetTargetsRequest, opts ...http.CallOption) (rsp *GetTargetsReply, err error)
+	MoveStep(ctx context.Context, req *MoveStepRequest, opts ...http.CallOption) (rsp *MoveStepReply, err error)
+	RenameDirStep(ctx context.Context, req *RenameDirStepRequest, opts ...http.CallOption) (rsp *RenameDirStepReply, err error)
+	ReorderSteps(ctx context.Context, req *ReorderStepsRequest, opts ...http.CallOption) (rsp *ReorderStepsReply, err error)
+	ReorderTargets(ctx context.Context, req *ReorderTargetsRequest, opts ...http.CallOption) (rsp *ReorderTargetsReply, err error)
+	Search(ctx context.Context, req *SearchRequest, opts ...http.CallOption) (rsp *SearchReply, err error)
+	SetSelfAssessment(ctx context.Context, req *SetSelfAssessmentRequest, opts ...http.CallOption) (rsp *SetSelfAssessmentReply, err error)
+	UpdateLinkStep(ctx context.Context, req *UpdateLinkStepRequest, opts ...http.CallOption) (rsp *UpdateLinkStepReply, err error)
+	UpdateNoteStep(ctx context.Context, req *UpdateNoteStepRequest, opts ...http.CallOption) (rsp *UpdateNoteStepReply, err error)
 	UpdateTarget(ctx context.Context, req *UpdateTargetRequest, opts ...http.CallOption) (rsp *UpdateTargetReply, err error)
 	UpdateTargetStep(ctx context.Context, req *UpdateTargetStepRequest, opts ...http.CallOption) (rsp *UpdateTargetStepReply, err error)
 }
 
-type StepServiceHTTPClientImpl struct {
+type StepServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewStepServiceHTTPClient(client *http.Client) StepServiceHTTPClient {
+	return &StepServiceHTTPClientImpl{client}
+}
+
+func (c *StepServiceHTTPClientImpl) AddTargetDirStep(ctx context.Context, in *AddTargetDirStepRequest, opts ...http.CallOption) (*AddTargetDirStepReply, error) {
+	var out AddTargetDirStepReply
+	pattern := "/target/{id}/dir"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceAddTargetDirStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) AddTargetMeasurementStep(ctx context.Context, in *AddTargetMeasurementStepRequest, opts ...http.CallOption) (*AddTargetMeasurementStepReply, error) {
+	var out AddTargetMeasurementStepReply
+	pattern := "/target/{id}/measurement"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceAddTargetMeasurementStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) CloneTarget(ctx context.Context, in *CloneTargetRequest, opts ...http.CallOption) (*CloneTargetReply, error) {
+	var out CloneTargetReply
+	pattern := "/target/{id}/clone"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceCloneTarget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) CreateLinkStep(ctx context.Context, in *CreateLinkStepRequest, opts ...http.CallOption) (*CreateLinkStepReply, error) {
+	var out CreateLinkStepReply
+	pattern := "/target/{id}/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceCreateLinkStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) CreateNoteStep(ctx context.Context, in *CreateNoteStepRequest, opts ...http.CallOption) (*CreateNoteStepReply, error) {
+	var out CreateNoteStepReply
+	pattern := "/target/{id}/note"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceCreateNoteStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) CreateTarget(ctx context.Context, in *CreateTargetRequest, opts ...http.CallOption) (*CreateTargetReply, error) {
+	var out CreateTargetReply
+	pattern := "/target"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceCreateTarget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) DeleteTarget(ctx context.Context, in *DeleteTargetRequest, opts ...http.CallOption) (*DeleteTargetReply, error) {
+	var out DeleteTargetReply
+	pattern := "/target/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceDeleteTarget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) DeleteTargetStep(ctx context.Context, in *DeleteTargetStepRequest, opts ...http.CallOption) (*DeleteTargetStepReply, error) {
+	var out DeleteTargetStepReply
+	pattern := "/step/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceDeleteTargetStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) DoneTarget(ctx context.Context, in *DoneTargetRequest, opts ...http.CallOption) (*DoneTargetReply, error) {
+	var out DoneTargetReply
+	pattern := "/target/{id}/done"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceDoneTarget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) Encrypt(ctx context.Context, in *EncryptRequest, opts ...http.CallOption) (*EncryptReply, error) {
+	var out EncryptReply
+	pattern := "/step/{id}/encrypt"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceEncrypt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetActivityHeatmap(ctx context.Context, in *GetActivityHeatmapRequest, opts ...http.CallOption) (*GetActivityHeatmapReply, error) {
+	var out GetActivityHeatmapReply
+	pattern := "/activity/heatmap"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetActivityHeatmap))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetActivityTimeline(ctx context.Context, in *GetActivityTimelineRequest, opts ...http.CallOption) (*GetActivityTimelineReply, error) {
+	var out GetActivityTimelineReply
+	pattern := "/activity/timeline"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetActivityTimeline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetSelfAssessmentComparison(ctx context.Context, in *GetSelfAssessmentComparisonRequest, opts ...http.CallOption) (*GetSelfAssessmentComparisonReply, error) {
+	var out GetSelfAssessmentComparisonReply
+	pattern := "/self_assessment/comparison"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetSelfAssessmentComparison))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetTarget(ctx context.Context, in *GetTargetRequest, opts ...http.CallOption) (*GetTargetReply, error) {
+	var out GetTargetReply
+	pattern := "/target/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetTarget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetTargetAdherence(ctx context.Context, in *GetTargetAdherenceRequest, opts ...http.CallOption) (*GetTargetAdherenceReply, error) {
+	var out GetTargetAdherenceReply
+	pattern := "/target/{id}/adherence"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetTargetAdherence))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetTargetDirStepChildren(ctx context.Context, in *GetTargetDirStepChildrenRequest, opts ...http.CallOption) (*GetTargetDirStepChildrenReply, error) {
+	var out GetTargetDirStepChildrenReply
+	pattern := "/step/{id}/dir_children"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetTargetDirStepChildren))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetTargetTree(ctx context.Context, in *GetTargetTreeRequest, opts ...http.CallOption) (*GetTargetTreeReply, error) {
+	var out GetTargetTreeReply
+	pattern := "/target/{id}/tree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetTargetTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) GetTargets(ctx context.Context, in *GetTargetsRequest, opts ...http.CallOption) (*GetTargetsReply, error) {
+	var out GetTargetsReply
+	pattern := "/targets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceGetTargets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) MoveStep(ctx context.Context, in *MoveStepRequest, opts ...http.CallOption) (*MoveStepReply, error) {
+	var out MoveStepReply
+	pattern := "/step/{id}/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceMoveStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) RenameDirStep(ctx context.Context, in *RenameDirStepRequest, opts ...http.CallOption) (*RenameDirStepReply, error) {
+	var out RenameDirStepReply
+	pattern := "/step/{id}/rename"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceRenameDirStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) ReorderSteps(ctx context.Context, in *ReorderStepsRequest, opts ...http.CallOption) (*ReorderStepsReply, error) {
+	var out ReorderStepsReply
+	pattern := "/target/{id}/reorder_steps"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceReorderSteps))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) ReorderTargets(ctx context.Context, in *ReorderTargetsRequest, opts ...http.CallOption) (*ReorderTargetsReply, error) {
+	var out ReorderTargetsReply
+	pattern := "/target/reorder"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceReorderTargets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) Search(ctx context.Context, in *SearchRequest, opts ...http.CallOption) (*SearchReply, error) {
+	var out SearchReply
+	pattern := "/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationStepServiceSearch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) SetSelfAssessment(ctx context.Context, in *SetSelfAssessmentRequest, opts ...http.CallOption) (*SetSelfAssessmentReply, error) {
+	var out SetSelfAssessmentReply
+	pattern := "/step/{id}/self_assessment"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceSetSelfAssessment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) UpdateLinkStep(ctx context.Context, in *UpdateLinkStepRequest, opts ...http.CallOption) (*UpdateLinkStepReply, error) {
+	var out UpdateLinkStepReply
+	pattern := "/step/{id}/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceUpdateLinkStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) UpdateNoteStep(ctx context.Context, in *UpdateNoteStepRequest, opts ...http.CallOption) (*UpdateNoteStepReply, error) {
+	var out UpdateNoteStepReply
+	pattern := "/step/{id}/note"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceUpdateNoteStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) UpdateTarget(ctx context.Context, in *UpdateTargetRequest, opts ...http.CallOption) (*UpdateTargetReply, error) {
+	var out UpdateTargetReply
+	pattern := "/target/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceUpdateTarget))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *StepServiceHTTPClientImpl) UpdateTargetStep(ctx context.Context, in *UpdateTargetStepRequest, opts ...http.CallOption) (*UpdateTargetStepReply, error) {
+	var out UpdateTargetStepReply
+	pattern := "/step/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationStepServiceUpdateTargetStep))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationTagServiceAssignTag = "/step.v1.TagService/AssignTag"
+const OperationTagServiceCreateTag = "/step.v1.TagService/CreateTag"
+const OperationTagServiceDeleteTag = "/step.v1.TagService/DeleteTag"
+const OperationTagServiceGetTags = "/step.v1.TagService/GetTags"
+const OperationTagServiceUnassignTag = "/step.v1.TagService/UnassignTag"
+const OperationTagServiceUpdateTag = "/step.v1.TagService/UpdateTag"
+
+type TagServiceHTTPServer interface {
+	// AssignTag 给目标和积累打标签
+	AssignTag(context.Context, *AssignTagRequest) (*AssignTagReply, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagReply, error)
+	// DeleteTag 删除标签，同时解除与目标和积累的关联
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagReply, error)
+	GetTags(context.Context, *GetTagsRequest) (*GetTagsReply, error)
+	// UnassignTag 移除目标和积累的标签
+	UnassignTag(context.Context, *UnassignTagRequest) (*UnassignTagReply, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagReply, error)
+}
+
+func RegisterTagServiceHTTPServer(s *http.Server, srv TagServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/tag", _TagService_CreateTag0_HTTP_Handler(srv))
+	r.PUT("/tag/{id}", _TagService_UpdateTag0_HTTP_Handler(srv))
+	r.DELETE("/tag/{id}", _TagService_DeleteTag0_HTTP_Handler(srv))
+	r.GET("/tags", _TagService_GetTags0_HTTP_Handler(srv))
+	r.POST("/tag/{id}/assign", _TagService_AssignTag0_HTTP_Handler(srv))
+	r.POST("/tag/{id}/unassign", _TagService_UnassignTag0_HTTP_Handler(srv))
+}
+
+func _TagService_CreateTag0_HTTP_Handler(srv TagServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTagServiceCreateTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTag(ctx, req.(*CreateTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TagService_UpdateTag0_HTTP_Handler(srv TagServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTagServiceUpdateTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTag(ctx, req.(*UpdateTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TagService_DeleteTag0_HTTP_Handler(srv TagServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTagRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTagServiceDeleteTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTag(ctx, req.(*DeleteTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TagService_GetTags0_HTTP_Handler(srv TagServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTagServiceGetTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTags(ctx, req.(*GetTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TagService_AssignTag0_HTTP_Handler(srv TagServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTagServiceAssignTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignTag(ctx, req.(*AssignTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AssignTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TagService_UnassignTag0_HTTP_Handler(srv TagServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnassignTagRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTagServiceUnassignTag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnassignTag(ctx, req.(*UnassignTagRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnassignTagReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type TagServiceHTTPClient interface {
+	AssignTag(ctx context.Context, req *AssignTagRequest, opts ...http.CallOption) (rsp *AssignTagReply, err error)
+	CreateTag(ctx context.Context, req *CreateTagRequest, opts ...http.CallOption) (rsp *CreateTagReply, err error)
+	DeleteTag(ctx context.Context, req *DeleteTagRequest, opts ...http.CallOption) (rsp *DeleteTagReply, err error)
+	GetTags(ctx context.Context, req *GetTagsRequest, opts ...http.CallOption) (rsp *GetTagsReply, err error)
+	UnassignTag(ctx context.Context, req *UnassignTagRequest, opts ...http.CallOption) (rsp *UnassignTagReply, err error)
+	UpdateTag(ctx context.Context, req *UpdateTagRequest, opts ...http.CallOption) (rsp *UpdateTagReply, err error)
+}
+
+type TagServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewTagServiceHTTPClient(client *http.Client) TagServiceHTTPClient {
+	return &TagServiceHTTPClientImpl{client}
+}
+
+func (c *TagServiceHTTPClientImpl) AssignTag(ctx context.Context, in *AssignTagRequest, opts ...http.CallOption) (*AssignTagReply, error) {
+	var out AssignTagReply
+	pattern := "/tag/{id}/assign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTagServiceAssignTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TagServiceHTTPClientImpl) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...http.CallOption) (*CreateTagReply, error) {
+	var out CreateTagReply
+	pattern := "/tag"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTagServiceCreateTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TagServiceHTTPClientImpl) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...http.CallOption) (*DeleteTagReply, error) {
+	var out DeleteTagReply
+	pattern := "/tag/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTagServiceDeleteTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TagServiceHTTPClientImpl) GetTags(ctx context.Context, in *GetTagsRequest, opts ...http.CallOption) (*GetTagsReply, error) {
+	var out GetTagsReply
+	pattern := "/tags"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTagServiceGetTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TagServiceHTTPClientImpl) UnassignTag(ctx context.Context, in *UnassignTagRequest, opts ...http.CallOption) (*UnassignTagReply, error) {
+	var out UnassignTagReply
+	pattern := "/tag/{id}/unassign"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTagServiceUnassignTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TagServiceHTTPClientImpl) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...http.CallOption) (*UpdateTagReply, error) {
+	var out UpdateTagReply
+	pattern := "/tag/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTagServiceUpdateTag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationTemplateServiceCreateTemplate = "/step.v1.TemplateService/CreateTemplate"
+const OperationTemplateServiceDeleteTemplate = "/step.v1.TemplateService/DeleteTemplate"
+const OperationTemplateServiceGetSharedTemplate = "/step.v1.TemplateService/GetSharedTemplate"
+const OperationTemplateServiceGetTemplate = "/step.v1.TemplateService/GetTemplate"
+const OperationTemplateServiceGetTemplates = "/step.v1.TemplateService/GetTemplates"
+const OperationTemplateServiceInstantiateTemplate = "/step.v1.TemplateService/InstantiateTemplate"
+const OperationTemplateServiceUpdateTemplate = "/step.v1.TemplateService/UpdateTemplate"
+
+type TemplateServiceHTTPServer interface {
+	// CreateTemplate 把目标及其子目标、目录结构保存为模板
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateReply, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error)
+	// GetSharedTemplate 通过分享链接查看模板
+	GetSharedTemplate(context.Context, *GetSharedTemplateRequest) (*GetSharedTemplateReply, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateReply, error)
+	GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesReply, error)
+	// InstantiateTemplate 用模板创建新的目标树，自己的模板用id，分享的模板用share_token
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*InstantiateTemplateReply, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateReply, error)
+}
+
+func RegisterTemplateServiceHTTPServer(s *http.Server, srv TemplateServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/template", _TemplateService_CreateTemplate0_HTTP_Handler(srv))
+	r.PUT("/template/{id}", _TemplateService_UpdateTemplate0_HTTP_Handler(srv))
+	r.DELETE("/template/{id}", _TemplateService_DeleteTemplate0_HTTP_Handler(srv))
+	r.GET("/templates", _TemplateService_GetTemplates0_HTTP_Handler(srv))
+	r.GET("/template/{id}", _TemplateService_GetTemplate0_HTTP_Handler(srv))
+	r.GET("/template/shared/{share_token}", _TemplateService_GetSharedTemplate0_HTTP_Handler(srv))
+	r.POST("/template/instantiate", _TemplateService_InstantiateTemplate0_HTTP_Handler(srv))
+}
+
+func _TemplateService_CreateTemplate0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceCreateTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTemplate(ctx, req.(*CreateTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TemplateService_UpdateTemplate0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceUpdateTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TemplateService_DeleteTemplate0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceDeleteTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TemplateService_GetTemplates0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTemplatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceGetTemplates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTemplates(ctx, req.(*GetTemplatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTemplatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TemplateService_GetTemplate0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceGetTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTemplate(ctx, req.(*GetTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TemplateService_GetSharedTemplate0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSharedTemplateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceGetSharedTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSharedTemplate(ctx, req.(*GetSharedTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSharedTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TemplateService_InstantiateTemplate0_HTTP_Handler(srv TemplateServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InstantiateTemplateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTemplateServiceInstantiateTemplate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InstantiateTemplateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type TemplateServiceHTTPClient interface {
+	CreateTemplate(ctx context.Context, req *CreateTemplateRequest, opts ...http.CallOption) (rsp *CreateTemplateReply, err error)
+	DeleteTemplate(ctx context.Context, req *DeleteTemplateRequest, opts ...http.CallOption) (rsp *DeleteTemplateReply, err error)
+	GetSharedTemplate(ctx context.Context, req *GetSharedTemplateRequest, opts ...http.CallOption) (rsp *GetSharedTemplateReply, err error)
+	GetTemplate(ctx context.Context, req *GetTemplateRequest, opts ...http.CallOption) (rsp *GetTemplateReply, err error)
+	GetTemplates(ctx context.Context, req *GetTemplatesRequest, opts ...http.CallOption) (rsp *GetTemplatesReply, err error)
+	InstantiateTemplate(ctx context.Context, req *InstantiateTemplateRequest, opts ...http.CallOption) (rsp *InstantiateTemplateReply, err error)
+	UpdateTemplate(ctx context.Context, req *UpdateTemplateRequest, opts ...http.CallOption) (rsp *UpdateTemplateReply, err error)
+}
+
+type TemplateServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewTemplateServiceHTTPClient(client *http.Client) TemplateServiceHTTPClient {
+	return &TemplateServiceHTTPClientImpl{client}
+}
+
+func (c *TemplateServiceHTTPClientImpl) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...http.CallOption) (*CreateTemplateReply, error) {
+	var out CreateTemplateReply
+	pattern := "/template"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTemplateServiceCreateTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TemplateServiceHTTPClientImpl) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...http.CallOption) (*DeleteTemplateReply, error) {
+	var out DeleteTemplateReply
+	pattern := "/template/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTemplateServiceDeleteTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TemplateServiceHTTPClientImpl) GetSharedTemplate(ctx context.Context, in *GetSharedTemplateRequest, opts ...http.CallOption) (*GetSharedTemplateReply, error) {
+	var out GetSharedTemplateReply
+	pattern := "/template/shared/{share_token}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTemplateServiceGetSharedTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TemplateServiceHTTPClientImpl) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...http.CallOption) (*GetTemplateReply, error) {
+	var out GetTemplateReply
+	pattern := "/template/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTemplateServiceGetTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TemplateServiceHTTPClientImpl) GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...http.CallOption) (*GetTemplatesReply, error) {
+	var out GetTemplatesReply
+	pattern := "/templates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTemplateServiceGetTemplates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TemplateServiceHTTPClientImpl) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...http.CallOption) (*InstantiateTemplateReply, error) {
+	var out InstantiateTemplateReply
+	pattern := "/template/instantiate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTemplateServiceInstantiateTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TemplateServiceHTTPClientImpl) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...http.CallOption) (*UpdateTemplateReply, error) {
+	var out UpdateTemplateReply
+	pattern := "/template/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTemplateServiceUpdateTemplate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationExportServiceCreateExport = "/step.v1.ExportService/CreateExport"
+const OperationExportServiceDeleteExport = "/step.v1.ExportService/DeleteExport"
+const OperationExportServiceGetExport = "/step.v1.ExportService/GetExport"
+const OperationExportServiceGetExports = "/step.v1.ExportService/GetExports"
+
+type ExportServiceHTTPServer interface {
+	// CreateExport 异步导出目标树为zip压缩包(媒体文件、manifest.json、index.html)
+	CreateExport(context.Context, *CreateExportRequest) (*CreateExportReply, error)
+	// DeleteExport 删除导出任务和压缩包
+	DeleteExport(context.Context, *DeleteExportRequest) (*DeleteExportReply, error)
+	// GetExport 导出任务状态，完成后返回下载链接
+	GetExport(context.Context, *GetExportRequest) (*GetExportReply, error)
+	GetExports(context.Context, *GetExportsRequest) (*GetExportsReply, error)
+}
+
+func RegisterExportServiceHTTPServer(s *http.Server, srv ExportServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/target/{target_id}/export", _ExportService_CreateExport0_HTTP_Handler(srv))
+	r.GET("/export/{id}", _ExportService_GetExport0_HTTP_Handler(srv))
+	r.GET("/exports", _ExportService_GetExports0_HTTP_Handler(srv))
+	r.DELETE("/export/{id}", _ExportService_DeleteExport0_HTTP_Handler(srv))
+}
+
+func _ExportService_CreateExport0_HTTP_Handler(srv ExportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateExportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExportServiceCreateExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateExport(ctx, req.(*CreateExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExportService_GetExport0_HTTP_Handler(srv ExportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExportServiceGetExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetExport(ctx, req.(*GetExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExportService_GetExports0_HTTP_Handler(srv ExportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExportServiceGetExports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetExports(ctx, req.(*GetExportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetExportsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ExportService_DeleteExport0_HTTP_Handler(srv ExportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteExportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationExportServiceDeleteExport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteExport(ctx, req.(*DeleteExportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteExportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ExportServiceHTTPClient interface {
+	CreateExport(ctx context.Context, req *CreateExportRequest, opts ...http.CallOption) (rsp *CreateExportReply, err error)
+	DeleteExport(ctx context.Context, req *DeleteExportRequest, opts ...http.CallOption) (rsp *DeleteExportReply, err error)
+	GetExport(ctx context.Context, req *GetExportRequest, opts ...http.CallOption) (rsp *GetExportReply, err error)
+	GetExports(ctx context.Context, req *GetExportsRequest, opts ...http.CallOption) (rsp *GetExportsReply, err error)
+}
+
+type ExportServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewExportServiceHTTPClient(client *http.Client) ExportServiceHTTPClient {
+	return &ExportServiceHTTPClientImpl{client}
+}
+
+func (c *ExportServiceHTTPClientImpl) CreateExport(ctx context.Context, in *CreateExportRequest, opts ...http.CallOption) (*CreateExportReply, error) {
+	var out CreateExportReply
+	pattern := "/target/{target_id}/export"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationExportServiceCreateExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExportServiceHTTPClientImpl) DeleteExport(ctx context.Context, in *DeleteExportRequest, opts ...http.CallOption) (*DeleteExportReply, error) {
+	var out DeleteExportReply
+	pattern := "/export/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExportServiceDeleteExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExportServiceHTTPClientImpl) GetExport(ctx context.Context, in *GetExportRequest, opts ...http.CallOption) (*GetExportReply, error) {
+	var out GetExportReply
+	pattern := "/export/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExportServiceGetExport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ExportServiceHTTPClientImpl) GetExports(ctx context.Context, in *GetExportsRequest, opts ...http.CallOption) (*GetExportsReply, error) {
+	var out GetExportsReply
+	pattern := "/exports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationExportServiceGetExports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationImportServiceGetImport = "/step.v1.ImportService/GetImport"
+const OperationImportServiceGetImportItems = "/step.v1.ImportService/GetImportItems"
+const OperationImportServiceGetImports = "/step.v1.ImportService/GetImports"
+const OperationImportServiceResumeImport = "/step.v1.ImportService/ResumeImport"
+
+type ImportServiceHTTPServer interface {
+	GetImport(context.Context, *GetImportRequest) (*GetImportReply, error)
+	// GetImportItems 导入项的处理结果，可以只查看失败的
+	GetImportItems(context.Context, *GetImportItemsRequest) (*GetImportItemsReply, error)
+	GetImports(context.Context, *GetImportsRequest) (*GetImportsReply, error)
+	// ResumeImport 继续未完成的导入，已完成的导入项不会重复创建
+	ResumeImport(context.Context, *ResumeImportRequest) (*ResumeImportReply, error)
+}
+
+func RegisterImportServiceHTTPServer(s *http.Server, srv ImportServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/import/{id}", _ImportService_GetImport0_HTTP_Handler(srv))
+	r.GET("/imports", _ImportService_GetImports0_HTTP_Handler(srv))
+	r.GET("/import/{id}/items", _ImportService_GetImportItems0_HTTP_Handler(srv))
+	r.POST("/import/{id}/resume", _ImportService_ResumeImport0_HTTP_Handler(srv))
+}
+
+func _ImportService_GetImport0_HTTP_Handler(srv ImportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationImportServiceGetImport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImport(ctx, req.(*GetImportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetImportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ImportService_GetImports0_HTTP_Handler(srv ImportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationImportServiceGetImports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImports(ctx, req.(*GetImportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetImportsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ImportService_GetImportItems0_HTTP_Handler(srv ImportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImportItemsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationImportServiceGetImportItems)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImportItems(ctx, req.(*GetImportItemsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetImportItemsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ImportService_ResumeImport0_HTTP_Handler(srv ImportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResumeImportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationImportServiceResumeImport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResumeImport(ctx, req.(*ResumeImportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResumeImportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ImportServiceHTTPClient interface {
+	GetImport(ctx context.Context, req *GetImportRequest, opts ...http.CallOption) (rsp *GetImportReply, err error)
+	GetImportItems(ctx context.Context, req *GetImportItemsRequest, opts ...http.CallOption) (rsp *GetImportItemsReply, err error)
+	GetImports(ctx context.Context, req *GetImportsRequest, opts ...http.CallOption) (rsp *GetImportsReply, err error)
+	ResumeImport(ctx context.Context, req *ResumeImportRequest, opts ...http.CallOption) (rsp *ResumeImportReply, err error)
+}
+
+type ImportServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewImportServiceHTTPClient(client *http.Client) ImportServiceHTTPClient {
+	return &ImportServiceHTTPClientImpl{client}
+}
+
+func (c *ImportServiceHTTPClientImpl) GetImport(ctx context.Context, in *GetImportRequest, opts ...http.CallOption) (*GetImportReply, error) {
+	var out GetImportReply
+	pattern := "/import/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationImportServiceGetImport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ImportServiceHTTPClientImpl) GetImportItems(ctx context.Context, in *GetImportItemsRequest, opts ...http.CallOption) (*GetImportItemsReply, error) {
+	var out GetImportItemsReply
+	pattern := "/import/{id}/items"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationImportServiceGetImportItems))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ImportServiceHTTPClientImpl) GetImports(ctx context.Context, in *GetImportsRequest, opts ...http.CallOption) (*GetImportsReply, error) {
+	var out GetImportsReply
+	pattern := "/imports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationImportServiceGetImports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ImportServiceHTTPClientImpl) ResumeImport(ctx context.Context, in *ResumeImportRequest, opts ...http.CallOption) (*ResumeImportReply, error) {
+	var out ResumeImportReply
+	pattern := "/import/{id}/resume"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationImportServiceResumeImport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationReportServiceCreateReport = "/step.v1.ReportService/CreateReport"
+const OperationReportServiceDeleteReport = "/step.v1.ReportService/DeleteReport"
+const OperationReportServiceGetReport = "/step.v1.ReportService/GetReport"
+const OperationReportServiceGetReports = "/step.v1.ReportService/GetReports"
+
+type ReportServiceHTTPServer interface {
+	// CreateReport 异步生成目标在日期范围内的进度报告(HTML和PDF)
+	CreateReport(context.Context, *CreateReportRequest) (*CreateReportReply, error)
+	// DeleteReport 删除报告和生成的文件
+	DeleteReport(context.Context, *DeleteReportRequest) (*DeleteReportReply, error)
+	// GetReport 报告状态，完成后返回HTML和PDF的下载链接
+	GetReport(context.Context, *GetReportRequest) (*GetReportReply, error)
+	GetReports(context.Context, *GetReportsRequest) (*GetReportsReply, error)
+}
+
+func RegisterReportServiceHTTPServer(s *http.Server, srv ReportServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/target/{target_id}/report", _ReportService_CreateReport0_HTTP_Handler(srv))
+	r.GET("/report/{id}", _ReportService_GetReport0_HTTP_Handler(srv))
+	r.GET("/reports", _ReportService_GetReports0_HTTP_Handler(srv))
+	r.DELETE("/report/{id}", _ReportService_DeleteReport0_HTTP_Handler(srv))
+}
+
+func _ReportService_CreateReport0_HTTP_Handler(srv ReportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReportRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReportServiceCreateReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReport(ctx, req.(*CreateReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReportService_GetReport0_HTTP_Handler(srv ReportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReportServiceGetReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReport(ctx, req.(*GetReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReportService_GetReports0_HTTP_Handler(srv ReportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReportServiceGetReports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReports(ctx, req.(*GetReportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReportsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReportService_DeleteReport0_HTTP_Handler(srv ReportServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReportServiceDeleteReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReport(ctx, req.(*DeleteReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type ReportServiceHTTPClient interface {
+	CreateReport(ctx context.Context, req *CreateReportRequest, opts ...http.CallOption) (rsp *CreateReportReply, err error)
+	DeleteReport(ctx context.Context, req *DeleteReportRequest, opts ...http.CallOption) (rsp *DeleteReportReply, err error)
+	GetReport(ctx context.Context, req *GetReportRequest, opts ...http.CallOption) (rsp *GetReportReply, err error)
+	GetReports(ctx context.Context, req *GetReportsRequest, opts ...http.CallOption) (rsp *GetReportsReply, err error)
+}
+
+type ReportServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewReportServiceHTTPClient(client *http.Client) ReportServiceHTTPClient {
+	return &ReportServiceHTTPClientImpl{client}
+}
+
+func (c *ReportServiceHTTPClientImpl) CreateReport(ctx context.Context, in *CreateReportRequest, opts ...http.CallOption) (*CreateReportReply, error) {
+	var out CreateReportReply
+	pattern := "/target/{target_id}/report"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReportServiceCreateReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReportServiceHTTPClientImpl) DeleteReport(ctx context.Context, in *DeleteReportRequest, opts ...http.CallOption) (*DeleteReportReply, error) {
+	var out DeleteReportReply
+	pattern := "/report/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReportServiceDeleteReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReportServiceHTTPClientImpl) GetReport(ctx context.Context, in *GetReportRequest, opts ...http.CallOption) (*GetReportReply, error) {
+	var out GetReportReply
+	pattern := "/report/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReportServiceGetReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReportServiceHTTPClientImpl) GetReports(ctx context.Context, in *GetReportsRequest, opts ...http.CallOption) (*GetReportsReply, error) {
+	var out GetReportsReply
+	pattern := "/reports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReportServiceGetReports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationPortraitServiceGetPortraitBasic = "/step.v1.PortraitService/GetPortraitBasic"
+const OperationPortraitServiceGetPortraitStepRate = "/step.v1.PortraitService/GetPortraitStepRate"
+const OperationPortraitServiceGetPortraitTargetProgress = "/step.v1.PortraitService/GetPortraitTargetProgress"
+
+type PortraitServiceHTTPServer interface {
+	GetPortraitBasic(context.Context, *GetPortraitBasicRequest) (*GetPortraitBasicReply, error)
+	GetPortraitStepRate(context.Context, *GetPortraitStepRateRequest) (*GetPortraitStepRateReply, error)
+	// GetPortraitTargetProgress 计量目标的累计进度
+	GetPortraitTargetProgress(context.Context, *GetPortraitTargetProgressRequest) (*GetPortraitTargetProgressReply, error)
+}
+
+func RegisterPortraitServiceHTTPServer(s *http.Server, srv PortraitServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/portrait/basic", _PortraitService_GetPortraitBasic0_HTTP_Handler(srv))
+	r.GET("/portrait/step_rate", _PortraitService_GetPortraitStepRate0_HTTP_Handler(srv))
+	r.GET("/portrait/target_progress", _PortraitService_GetPortraitTargetProgress0_HTTP_Handler(srv))
+}
+
+func _PortraitService_GetPortraitBasic0_HTTP_Handler(srv PortraitServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPortraitBasicRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPortraitServiceGetPortraitBasic)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPortraitBasic(ctx, req.(*GetPortraitBasicRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPortraitBasicReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PortraitService_GetPortraitStepRate0_HTTP_Handler(srv PortraitServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPortraitStepRateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPortraitServiceGetPortraitStepRate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPortraitStepRate(ctx, req.(*GetPortraitStepRateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPortraitStepRateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _PortraitService_GetPortraitTargetProgress0_HTTP_Handler(srv PortraitServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetPortraitTargetProgressRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPortraitServiceGetPortraitTargetProgress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPortraitTargetProgress(ctx, req.(*GetPortraitTargetProgressRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPortraitTargetProgressReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PortraitServiceHTTPClient interface {
+	GetPortraitBasic(ctx context.Context, req *GetPortraitBasicRequest, opts ...http.CallOption) (rsp *GetPortraitBasicReply, err error)
+	GetPortraitStepRate(ctx context.Context, req *GetPortraitStepRateRequest, opts ...http.CallOption) (rsp *GetPortraitStepRateReply, err error)
+	GetPortraitTargetProgress(ctx context.Context, req *GetPortraitTargetProgressRequest, opts ...http.CallOption) (rsp *GetPortraitTargetProgressReply, err error)
+}
+
+type PortraitServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewPortraitServiceHTTPClient(client *http.Client) PortraitServiceHTTPClient {
+	return &PortraitServiceHTTPClientImpl{client}
+}
+
+func (c *PortraitServiceHTTPClientImpl) GetPortraitBasic(ctx context.Context, in *GetPortraitBasicRequest, opts ...http.CallOption) (*GetPortraitBasicReply, error) {
+	var out GetPortraitBasicReply
+	pattern := "/portrait/basic"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPortraitServiceGetPortraitBasic))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PortraitServiceHTTPClientImpl) GetPortraitStepRate(ctx context.Context, in *GetPortraitStepRateRequest, opts ...http.CallOption) (*GetPortraitStepRateReply, error) {
+	var out GetPortraitStepRateReply
+	pattern := "/portrait/step_rate"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPortraitServiceGetPortraitStepRate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PortraitServiceHTTPClientImpl) GetPortraitTargetProgress(ctx context.Context, in *GetPortraitTargetProgressRequest, opts ...http.CallOption) (*GetPortraitTargetProgressReply, error) {
+	var out GetPortraitTargetProgressReply
+	pattern := "/portrait/target_progress"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPortraitServiceGetPortraitTargetProgress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationFeedbackServiceDeleteFeedbackAward = "/step.v1.FeedbackService/DeleteFeedbackAward"
+const OperationFeedbackServiceGetFeedbackAward = "/step.v1.FeedbackService/GetFeedbackAward"
+const OperationFeedbackServiceGetFeedbackAwards = "/step.v1.FeedbackService/GetFeedbackAwards"
+
+type FeedbackServiceHTTPServer interface {
+	DeleteFeedbackAward(context.Context, *DeleteFeedbackAwardRequest) (*DeleteFeedbackAwardReply, error)
+	GetFeedbackAward(context.Context, *GetFeedbackAwardRequest) (*GetFeedbackAwardReply, error)
+	GetFeedbackAwards(context.Context, *GetFeedbackAwardsRequest) (*GetFeedbackAwardsReply, error)
+}
+
+func RegisterFeedbackServiceHTTPServer(s *http.Server, srv FeedbackServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/feedback/awards", _FeedbackService_GetFeedbackAwards0_HTTP_Handler(srv))
+	r.GET("/feedback/award/{id}", _FeedbackService_GetFeedbackAward0_HTTP_Handler(srv))
+	r.DELETE("/feedback/award/{id}", _FeedbackService_DeleteFeedbackAward0_HTTP_Handler(srv))
+}
+
+func _FeedbackService_GetFeedbackAwards0_HTTP_Handler(srv FeedbackServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetFeedbackAwardsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFeedbackServiceGetFeedbackAwards)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFeedbackAwards(ctx, req.(*GetFeedbackAwardsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFeedbackAwardsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FeedbackService_GetFeedbackAward0_HTTP_Handler(srv FeedbackServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetFeedbackAwardRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFeedbackServiceGetFeedbackAward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFeedbackAward(ctx, req.(*GetFeedbackAwardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFeedbackAwardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _FeedbackService_DeleteFeedbackAward0_HTTP_Handler(srv FeedbackServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteFeedbackAwardRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFeedbackServiceDeleteFeedbackAward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteFeedbackAward(ctx, req.(*DeleteFeedbackAwardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteFeedbackAwardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type FeedbackServiceHTTPClient interface {
+	DeleteFeedbackAward(ctx context.Context, req *DeleteFeedbackAwardRequest, opts ...http.CallOption) (rsp *DeleteFeedbackAwardReply, err error)
+	GetFeedbackAward(ctx context.Context, req *GetFeedbackAwardRequest, opts ...http.CallOption) (rsp *GetFeedbackAwardReply, err error)
+	GetFeedbackAwards(ctx context.Context, req *GetFeedbackAwardsRequest, opts ...http.CallOption) (rsp *GetFeedbackAwardsReply, err error)
+}
+
+type FeedbackServiceHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewStepServiceHTTPClient(client *http.Client) StepServiceHTTPClient {
-	return &StepServiceHTTPClientImpl{client}
+func NewFeedbackServiceHTTPClient(client *http.Client) FeedbackServiceHTTPClient {
+	return &FeedbackServiceHTTPClientImpl{client}
 }
 
-func (c *StepServiceHTTPClientImpl) AddTargetDirStep(ctx context.Context, in *AddTargetDirStepRequest, opts ...http.CallOption) (*AddTargetDirStepReply, error) {
-	var out AddTargetDirStepReply
-	pattern := "/target/{id}/dir"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationStepServiceAddTargetDirStep))
+func (c *FeedbackServiceHTTPClientImpl) DeleteFeedbackAward(ctx context.Context, in *DeleteFeedbackAwardRequest, opts ...http.CallOption) (*DeleteFeedbackAwardReply, error) {
+	var out DeleteFeedbackAwardReply
+	pattern := "/feedback/award/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFeedbackServiceDeleteFeedbackAward))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return &out, nil
+	return &out, nil
+}
+
+func (c *FeedbackServiceHTTPClientImpl) GetFeedbackAward(ctx context.Context, in *GetFeedbackAwardRequest, opts ...http.CallOption) (*GetFeedbackAwardReply, error) {
+	var out GetFeedbackAwardReply
+	pattern := "/feedback/award/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFeedbackServiceGetFeedbackAward))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *FeedbackServiceHTTPClientImpl) GetFeedbackAwards(ctx context.Context, in *GetFeedbackAwardsRequest, opts ...http.CallOption) (*GetFeedbackAwardsReply, error) {
+	var out GetFeedbackAwardsReply
+	pattern := "/feedback/awards"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationFeedbackServiceGetFeedbackAwards))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+const OperationReviewerServiceAcceptReviewerInvite = "/step.v1.ReviewerService/AcceptReviewerInvite"
+const OperationReviewerServiceCreateReviewerInvite = "/step.v1.ReviewerService/CreateReviewerInvite"
+const OperationReviewerServiceDeleteReviewer = "/step.v1.ReviewerService/DeleteReviewer"
+const OperationReviewerServiceGetReviewRequests = "/step.v1.ReviewerService/GetReviewRequests"
+const OperationReviewerServiceGetReviewerInbox = "/step.v1.ReviewerService/GetReviewerInbox"
+const OperationReviewerServiceGetReviewers = "/step.v1.ReviewerService/GetReviewers"
+const OperationReviewerServiceRequestReview = "/step.v1.ReviewerService/RequestReview"
+const OperationReviewerServiceSetReviewerComment = "/step.v1.ReviewerService/SetReviewerComment"
+
+type ReviewerServiceHTTPServer interface {
+	AcceptReviewerInvite(context.Context, *AcceptReviewerInviteRequest) (*AcceptReviewerInviteReply, error)
+	// CreateReviewerInvite 学生邀请老师或家长，对方用邀请码关联后以自己的账号评论
+	CreateReviewerInvite(context.Context, *CreateReviewerInviteRequest) (*CreateReviewerInviteReply, error)
+	// DeleteReviewer 学生或评论人都可以解除关联，未接受的邀请同时失效
+	DeleteReviewer(context.Context, *DeleteReviewerRequest) (*DeleteReviewerReply, error)
+	// GetReviewRequests 学生查看发出的评论请求，按创建时间倒序
+	GetReviewRequests(context.Context, *GetReviewRequestsRequest) (*GetReviewRequestsReply, error)
+	// GetReviewerInbox 关联学生在关联之后新增的积累，默认只返回自己还没有评分的
+	GetReviewerInbox(context.Context, *GetReviewerInboxRequest) (*GetReviewerInboxReply, error)
+	// GetReviewers 学生查看邀请的评论人，评论人查看关联的学生
+	GetReviewers(context.Context, *GetReviewersRequest) (*GetReviewersReply, error)
+	// RequestReview 学生请求关联的评论人或者通过分享链接的角色评论积累或目录，未评论时提醒一次，过期后不再提醒
+	RequestReview(context.Context, *RequestReviewRequest) (*RequestReviewReply, error)
+	// SetReviewerComment 以关联的角色评论学生的积累
+	SetReviewerComment(context.Context, *SetReviewerCommentRequest) (*SetReviewerCommentReply, error)
+}
+
+func RegisterReviewerServiceHTTPServer(s *http.Server, srv ReviewerServiceHTTPServer) {
+	r := s.Route("/")
+	r.POST("/reviewer/invite", _ReviewerService_CreateReviewerInvite0_HTTP_Handler(srv))
+	r.POST("/reviewer/accept", _ReviewerService_AcceptReviewerInvite0_HTTP_Handler(srv))
+	r.GET("/reviewers", _ReviewerService_GetReviewers0_HTTP_Handler(srv))
+	r.DELETE("/reviewer/{id}", _ReviewerService_DeleteReviewer0_HTTP_Handler(srv))
+	r.GET("/reviewer/inbox", _ReviewerService_GetReviewerInbox0_HTTP_Handler(srv))
+	r.POST("/reviewer/step/{id}/comment", _ReviewerService_SetReviewerComment0_HTTP_Handler(srv))
+	r.POST("/reviewer/step/{id}/request", _ReviewerService_RequestReview0_HTTP_Handler(srv))
+	r.GET("/reviewer/requests", _ReviewerService_GetReviewRequests0_HTTP_Handler(srv))
+}
+
+func _ReviewerService_CreateReviewerInvite0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateReviewerInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceCreateReviewerInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateReviewerInvite(ctx, req.(*CreateReviewerInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateReviewerInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReviewerService_AcceptReviewerInvite0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptReviewerInviteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceAcceptReviewerInvite)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptReviewerInvite(ctx, req.(*AcceptReviewerInviteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcceptReviewerInviteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReviewerService_GetReviewers0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceGetReviewers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewers(ctx, req.(*GetReviewersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReviewerService_DeleteReviewer0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteReviewerRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceDeleteReviewer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteReviewer(ctx, req.(*DeleteReviewerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteReviewerReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReviewerService_GetReviewerInbox0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewerInboxRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceGetReviewerInbox)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewerInbox(ctx, req.(*GetReviewerInboxRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewerInboxReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ReviewerService_SetReviewerComment0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetReviewerCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceSetReviewerComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetReviewerComment(ctx, req.(*SetReviewerCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetReviewerCommentReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *StepServiceHTTPClientImpl) CreateTarget(ctx context.Context, in *CreateTargetRequest, opts ...http.CallOption) (*CreateTargetReply, error) {
-	var out CreateTargetReply
-	pattern := "/target"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationStepServiceCreateTarget))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _ReviewerService_RequestReview0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceRequestReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestReview(ctx, req.(*RequestReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RequestReviewReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) DeleteTarget(ctx context.Context, in *DeleteTargetRequest, opts ...http.CallOption) (*DeleteTargetReply, error) {
-	var out DeleteTargetReply
-	pattern := "/target/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationStepServiceDeleteTarget))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _ReviewerService_GetReviewRequests0_HTTP_Handler(srv ReviewerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetReviewRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewerServiceGetReviewRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetReviewRequests(ctx, req.(*GetReviewRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetReviewRequestsReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) DeleteTargetStep(ctx context.Context, in *DeleteTargetStepRequest, opts ...http.CallOption) (*DeleteTargetStepReply, error) {
-	var out DeleteTargetStepReply
-	pattern := "/step/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationStepServiceDeleteTargetStep))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &out, nil
+type ReviewerServiceHTTPClient interface {
+	AcceptReviewerInvite(ctx context.Context, req *AcceptReviewerInviteRequest, opts ...http.CallOption) (rsp *AcceptReviewerInviteReply, err error)
+	CreateReviewerInvite(ctx context.Context, req *CreateReviewerInviteRequest, opts ...http.CallOption) (rsp *CreateReviewerInviteReply, err error)
+	DeleteReviewer(ctx context.Context, req *DeleteReviewerRequest, opts ...http.CallOption) (rsp *DeleteReviewerReply, err error)
+	GetReviewRequests(ctx context.Context, req *GetReviewRequestsRequest, opts ...http.CallOption) (rsp *GetReviewRequestsReply, err error)
+	GetReviewerInbox(ctx context.Context, req *GetReviewerInboxRequest, opts ...http.CallOption) (rsp *GetReviewerInboxReply, err error)
+	GetReviewers(ctx context.Context, req *GetReviewersRequest, opts ...http.CallOption) (rsp *GetReviewersReply, err error)
+	RequestReview(ctx context.Context, req *RequestReviewRequest, opts ...http.CallOption) (rsp *RequestReviewReply, err error)
+	SetReviewerComment(ctx context.Context, req *SetReviewerCommentRequest, opts ...http.CallOption) (rsp *SetReviewerCommentReply, err error)
 }
 
-func (c *StepServiceHTTPClientImpl) DoneTarget(ctx context.Context, in *DoneTargetRequest, opts ...http.CallOption) (*DoneTargetReply, error) {
-	var out DoneTargetReply
-	pattern := "/target/{id}/done"
+type ReviewerServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewReviewerServiceHTTPClient(client *http.Client) ReviewerServiceHTTPClient {
+	return &ReviewerServiceHTTPClientImpl{client}
+}
+
+func (c *ReviewerServiceHTTPClientImpl) AcceptReviewerInvite(ctx context.Context, in *AcceptReviewerInviteRequest, opts ...http.CallOption) (*AcceptReviewerInviteReply, error) {
+	var out AcceptReviewerInviteReply
+	pattern := "/reviewer/accept"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationStepServiceDoneTarget))
+	opts = append(opts, http.Operation(OperationReviewerServiceAcceptReviewerInvite))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -436,11 +2780,11 @@ func (c *StepServiceHTTPClientImpl) DoneTarget(ctx context.Context, in *DoneTarg
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) Encrypt(ctx context.Context, in *EncryptRequest, opts ...http.CallOption) (*EncryptReply, error) {
-	var out EncryptReply
-	pattern := "/step/{id}/encrypt"
+func (c *ReviewerServiceHTTPClientImpl) CreateReviewerInvite(ctx context.Context, in *CreateReviewerInviteRequest, opts ...http.CallOption) (*CreateReviewerInviteReply, error) {
+	var out CreateReviewerInviteReply
+	pattern := "/reviewer/invite"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationStepServiceEncrypt))
+	opts = append(opts, http.Operation(OperationReviewerServiceCreateReviewerInvite))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -449,24 +2793,24 @@ func (c *StepServiceHTTPClientImpl) Encrypt(ctx context.Context, in *EncryptRequ
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) GetTarget(ctx context.Context, in *GetTargetRequest, opts ...http.CallOption) (*GetTargetReply, error) {
-	var out GetTargetReply
-	pattern := "/target/{id}"
+func (c *ReviewerServiceHTTPClientImpl) DeleteReviewer(ctx context.Context, in *DeleteReviewerRequest, opts ...http.CallOption) (*DeleteReviewerReply, error) {
+	var out DeleteReviewerReply
+	pattern := "/reviewer/{id}"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationStepServiceGetTarget))
+	opts = append(opts, http.Operation(OperationReviewerServiceDeleteReviewer))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) GetTargetDirStepChildren(ctx context.Context, in *GetTargetDirStepChildrenRequest, opts ...http.CallOption) (*GetTargetDirStepChildrenReply, error) {
-	var out GetTargetDirStepChildrenReply
-	pattern := "/step/{id}/dir_children"
+func (c *ReviewerServiceHTTPClientImpl) GetReviewRequests(ctx context.Context, in *GetReviewRequestsRequest, opts ...http.CallOption) (*GetReviewRequestsReply, error) {
+	var out GetReviewRequestsReply
+	pattern := "/reviewer/requests"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationStepServiceGetTargetDirStepChildren))
+	opts = append(opts, http.Operation(OperationReviewerServiceGetReviewRequests))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -475,11 +2819,11 @@ func (c *StepServiceHTTPClientImpl) GetTargetDirStepChildren(ctx context.Context
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) GetTargetTree(ctx context.Context, in *GetTargetTreeRequest, opts ...http.CallOption) (*GetTargetTreeReply, error) {
-	var out GetTargetTreeReply
-	pattern := "/target/{id}/tree"
+func (c *ReviewerServiceHTTPClientImpl) GetReviewerInbox(ctx context.Context, in *GetReviewerInboxRequest, opts ...http.CallOption) (*GetReviewerInboxReply, error) {
+	var out GetReviewerInboxReply
+	pattern := "/reviewer/inbox"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationStepServiceGetTargetTree))
+	opts = append(opts, http.Operation(OperationReviewerServiceGetReviewerInbox))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -488,11 +2832,11 @@ func (c *StepServiceHTTPClientImpl) GetTargetTree(ctx context.Context, in *GetTa
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) GetTargets(ctx context.Context, in *GetTargetsRequest, opts ...http.CallOption) (*GetTargetsReply, error) {
-	var out GetTargetsReply
-	pattern := "/targets"
+func (c *ReviewerServiceHTTPClientImpl) GetReviewers(ctx context.Context, in *GetReviewersRequest, opts ...http.CallOption) (*GetReviewersReply, error) {
+	var out GetReviewersReply
+	pattern := "/reviewers"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationStepServiceGetTargets))
+	opts = append(opts, http.Operation(OperationReviewerServiceGetReviewers))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -501,102 +2845,143 @@ func (c *StepServiceHTTPClientImpl) GetTargets(ctx context.Context, in *GetTarge
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) UpdateTarget(ctx context.Context, in *UpdateTargetRequest, opts ...http.CallOption) (*UpdateTargetReply, error) {
-	var out UpdateTargetReply
-	pattern := "/target/{id}"
+func (c *ReviewerServiceHTTPClientImpl) RequestReview(ctx context.Context, in *RequestReviewRequest, opts ...http.CallOption) (*RequestReviewReply, error) {
+	var out RequestReviewReply
+	pattern := "/reviewer/step/{id}/request"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationStepServiceUpdateTarget))
+	opts = append(opts, http.Operation(OperationReviewerServiceRequestReview))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *StepServiceHTTPClientImpl) UpdateTargetStep(ctx context.Context, in *UpdateTargetStepRequest, opts ...http.CallOption) (*UpdateTargetStepReply, error) {
-	var out UpdateTargetStepReply
-	pattern := "/step/{id}"
+func (c *ReviewerServiceHTTPClientImpl) SetReviewerComment(ctx context.Context, in *SetReviewerCommentRequest, opts ...http.CallOption) (*SetReviewerCommentReply, error) {
+	var out SetReviewerCommentReply
+	pattern := "/reviewer/step/{id}/comment"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationStepServiceUpdateTargetStep))
+	opts = append(opts, http.Operation(OperationReviewerServiceSetReviewerComment))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-const OperationPortraitServiceGetPortraitBasic = "/step.v1.PortraitService/GetPortraitBasic"
-const OperationPortraitServiceGetPortraitStepRate = "/step.v1.PortraitService/GetPortraitStepRate"
+const OperationCommentServiceGetStepComments = "/step.v1.CommentService/GetStepComments"
+const OperationCommentServiceReplyComment = "/step.v1.CommentService/ReplyComment"
+const OperationCommentServiceUpdateComment = "/step.v1.CommentService/UpdateComment"
 
-type PortraitServiceHTTPServer interface {
-	GetPortraitBasic(context.Context, *GetPortraitBasicRequest) (*GetPortraitBasicReply, error)
-	GetPortraitStepRate(context.Context, *GetPortraitStepRateRequest) (*GetPortraitStepRateReply, error)
+type CommentServiceHTTPServer interface {
+	// GetStepComments 积累所有者和关联的老师、家长查看评论，回复挂在顶层评论下
+	GetStepComments(context.Context, *GetStepCommentsRequest) (*GetStepCommentsReply, error)
+	// ReplyComment 积累所有者和关联的老师、家长回复顶层评论
+	ReplyComment(context.Context, *ReplyCommentRequest) (*ReplyCommentReply, error)
+	// UpdateComment 作者修改评论，修改前的版本保存在历史中，修改评分后重新计算统计
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentReply, error)
 }
 
-func RegisterPortraitServiceHTTPServer(s *http.Server, srv PortraitServiceHTTPServer) {
+func RegisterCommentServiceHTTPServer(s *http.Server, srv CommentServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/portrait/basic", _PortraitService_GetPortraitBasic0_HTTP_Handler(srv))
-	r.GET("/portrait/step_rate", _PortraitService_GetPortraitStepRate0_HTTP_Handler(srv))
+	r.GET("/step/{id}/comments", _CommentService_GetStepComments0_HTTP_Handler(srv))
+	r.POST("/comment/{id}/reply", _CommentService_ReplyComment0_HTTP_Handler(srv))
+	r.PUT("/comment/{id}", _CommentService_UpdateComment0_HTTP_Handler(srv))
 }
 
-func _PortraitService_GetPortraitBasic0_HTTP_Handler(srv PortraitServiceHTTPServer) func(ctx http.Context) error {
+func _CommentService_GetStepComments0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetPortraitBasicRequest
+		var in GetStepCommentsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationPortraitServiceGetPortraitBasic)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceGetStepComments)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetPortraitBasic(ctx, req.(*GetPortraitBasicRequest))
+			return srv.GetStepComments(ctx, req.(*GetStepCommentsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetPortraitBasicReply)
+		reply := out.(*GetStepCommentsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _PortraitService_GetPortraitStepRate0_HTTP_Handler(srv PortraitServiceHTTPServer) func(ctx http.Context) error {
+func _CommentService_ReplyComment0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetPortraitStepRateRequest
+		var in ReplyCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationPortraitServiceGetPortraitStepRate)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceReplyComment)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetPortraitStepRate(ctx, req.(*GetPortraitStepRateRequest))
+			return srv.ReplyComment(ctx, req.(*ReplyCommentRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetPortraitStepRateReply)
+		reply := out.(*ReplyCommentReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type PortraitServiceHTTPClient interface {
-	GetPortraitBasic(ctx context.Context, req *GetPortraitBasicRequest, opts ...http.CallOption) (rsp *GetPortraitBasicReply, err error)
-	GetPortraitStepRate(ctx context.Context, req *GetPortraitStepRateRequest, opts ...http.CallOption) (rsp *GetPortraitStepRateReply, err error)
+func _CommentService_UpdateComment0_HTTP_Handler(srv CommentServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCommentServiceUpdateComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateComment(ctx, req.(*UpdateCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCommentReply)
+		return ctx.Result(200, reply)
+	}
 }
 
-type PortraitServiceHTTPClientImpl struct {
+type CommentServiceHTTPClient interface {
+	GetStepComments(ctx context.Context, req *GetStepCommentsRequest, opts ...http.CallOption) (rsp *GetStepCommentsReply, err error)
+	ReplyComment(ctx context.Context, req *ReplyCommentRequest, opts ...http.CallOption) (rsp *ReplyCommentReply, err error)
+	UpdateComment(ctx context.Context, req *UpdateCommentRequest, opts ...http.CallOption) (rsp *UpdateCommentReply, err error)
+}
+
+type CommentServiceHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewPortraitServiceHTTPClient(client *http.Client) PortraitServiceHTTPClient {
-	return &PortraitServiceHTTPClientImpl{client}
+func NewCommentServiceHTTPClient(client *http.Client) CommentServiceHTTPClient {
+	return &CommentServiceHTTPClientImpl{client}
 }
 
-func (c *PortraitServiceHTTPClientImpl) GetPortraitBasic(ctx context.Context, in *GetPortraitBasicRequest, opts ...http.CallOption) (*GetPortraitBasicReply, error) {
-	var out GetPortraitBasicReply
-	pattern := "/portrait/basic"
+func (c *CommentServiceHTTPClientImpl) GetStepComments(ctx context.Context, in *GetStepCommentsRequest, opts ...http.CallOption) (*GetStepCommentsReply, error) {
+	var out GetStepCommentsReply
+	pattern := "/step/{id}/comments"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationPortraitServiceGetPortraitBasic))
+	opts = append(opts, http.Operation(OperationCommentServiceGetStepComments))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -605,131 +2990,113 @@ func (c *PortraitServiceHTTPClientImpl) GetPortraitBasic(ctx context.Context, in
 	return &out, nil
 }
 
-func (c *PortraitServiceHTTPClientImpl) GetPortraitStepRate(ctx context.Context, in *GetPortraitStepRateRequest, opts ...http.CallOption) (*GetPortraitStepRateReply, error) {
-	var out GetPortraitStepRateReply
-	pattern := "/portrait/step_rate"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationPortraitServiceGetPortraitStepRate))
+func (c *CommentServiceHTTPClientImpl) ReplyComment(ctx context.Context, in *ReplyCommentRequest, opts ...http.CallOption) (*ReplyCommentReply, error) {
+	var out ReplyCommentReply
+	pattern := "/comment/{id}/reply"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceReplyComment))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-const OperationFeedbackServiceDeleteFeedbackAward = "/step.v1.FeedbackService/DeleteFeedbackAward"
-const OperationFeedbackServiceGetFeedbackAward = "/step.v1.FeedbackService/GetFeedbackAward"
-const OperationFeedbackServiceGetFeedbackAwards = "/step.v1.FeedbackService/GetFeedbackAwards"
-
-type FeedbackServiceHTTPServer interface {
-	DeleteFeedbackAward(context.Context, *DeleteFeedbackAwardRequest) (*DeleteFeedbackAwardReply, error)
-	GetFeedbackAward(context.Context, *GetFeedbackAwardRequest) (*GetFeedbackAwardReply, error)
-	GetFeedbackAwards(context.Context, *GetFeedbackAwardsRequest) (*GetFeedbackAwardsReply, error)
+func (c *CommentServiceHTTPClientImpl) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...http.CallOption) (*UpdateCommentReply, error) {
+	var out UpdateCommentReply
+	pattern := "/comment/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCommentServiceUpdateComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func RegisterFeedbackServiceHTTPServer(s *http.Server, srv FeedbackServiceHTTPServer) {
-	r := s.Route("/")
-	r.GET("/feedback/awards", _FeedbackService_GetFeedbackAwards0_HTTP_Handler(srv))
-	r.GET("/feedback/award/{id}", _FeedbackService_GetFeedbackAward0_HTTP_Handler(srv))
-	r.DELETE("/feedback/award/{id}", _FeedbackService_DeleteFeedbackAward0_HTTP_Handler(srv))
+const OperationRubricServiceGetTargetRubric = "/step.v1.RubricService/GetTargetRubric"
+const OperationRubricServiceSetTargetRubric = "/step.v1.RubricService/SetTargetRubric"
+
+type RubricServiceHTTPServer interface {
+	// GetTargetRubric 顶层目标的评分标准，没有自定义时为默认评分标准；目标所有者和关联的老师、家长可以查看
+	GetTargetRubric(context.Context, *GetTargetRubricRequest) (*GetTargetRubricReply, error)
+	// SetTargetRubric 自定义顶层目标的评分标准，criteria为空时恢复默认评分标准
+	SetTargetRubric(context.Context, *SetTargetRubricRequest) (*SetTargetRubricReply, error)
 }
 
-func _FeedbackService_GetFeedbackAwards0_HTTP_Handler(srv FeedbackServiceHTTPServer) func(ctx http.Context) error {
-	return func(ctx http.Context) error {
-		var in GetFeedbackAwardsRequest
-		if err := ctx.BindQuery(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationFeedbackServiceGetFeedbackAwards)
-		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetFeedbackAwards(ctx, req.(*GetFeedbackAwardsRequest))
-		})
-		out, err := h(ctx, &in)
-		if err != nil {
-			return err
-		}
-		reply := out.(*GetFeedbackAwardsReply)
-		return ctx.Result(200, reply)
-	}
+func RegisterRubricServiceHTTPServer(s *http.Server, srv RubricServiceHTTPServer) {
+	r := s.Route("/")
+	r.GET("/target/{id}/rubric", _RubricService_GetTargetRubric0_HTTP_Handler(srv))
+	r.PUT("/target/{id}/rubric", _RubricService_SetTargetRubric0_HTTP_Handler(srv))
 }
 
-func _FeedbackService_GetFeedbackAward0_HTTP_Handler(srv FeedbackServiceHTTPServer) func(ctx http.Context) error {
+func _RubricService_GetTargetRubric0_HTTP_Handler(srv RubricServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetFeedbackAwardRequest
+		var in GetTargetRubricRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationFeedbackServiceGetFeedbackAward)
+		http.SetOperation(ctx, OperationRubricServiceGetTargetRubric)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetFeedbackAward(ctx, req.(*GetFeedbackAwardRequest))
+			return srv.GetTargetRubric(ctx, req.(*GetTargetRubricRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetFeedbackAwardReply)
+		reply := out.(*GetTargetRubricReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _FeedbackService_DeleteFeedbackAward0_HTTP_Handler(srv FeedbackServiceHTTPServer) func(ctx http.Context) error {
+func _RubricService_SetTargetRubric0_HTTP_Handler(srv RubricServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteFeedbackAwardRequest
+		var in SetTargetRubricRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationFeedbackServiceDeleteFeedbackAward)
+		http.SetOperation(ctx, OperationRubricServiceSetTargetRubric)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteFeedbackAward(ctx, req.(*DeleteFeedbackAwardRequest))
+			return srv.SetTargetRubric(ctx, req.(*SetTargetRubricRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteFeedbackAwardReply)
+		reply := out.(*SetTargetRubricReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-type FeedbackServiceHTTPClient interface {
-	DeleteFeedbackAward(ctx context.Context, req *DeleteFeedbackAwardRequest, opts ...http.CallOption) (rsp *DeleteFeedbackAwardReply, err error)
-	GetFeedbackAward(ctx context.Context, req *GetFeedbackAwardRequest, opts ...http.CallOption) (rsp *GetFeedbackAwardReply, err error)
-	GetFeedbackAwards(ctx context.Context, req *GetFeedbackAwardsRequest, opts ...http.CallOption) (rsp *GetFeedbackAwardsReply, err error)
+type RubricServiceHTTPClient interface {
+	GetTargetRubric(ctx context.Context, req *GetTargetRubricRequest, opts ...http.CallOption) (rsp *GetTargetRubricReply, err error)
+	SetTargetRubric(ctx context.Context, req *SetTargetRubricRequest, opts ...http.CallOption) (rsp *SetTargetRubricReply, err error)
 }
 
-type FeedbackServiceHTTPClientImpl struct {
+type RubricServiceHTTPClientImpl struct {
 	cc *http.Client
 }
 
-func NewFeedbackServiceHTTPClient(client *http.Client) FeedbackServiceHTTPClient {
-	return &FeedbackServiceHTTPClientImpl{client}
-}
-
-func (c *FeedbackServiceHTTPClientImpl) DeleteFeedbackAward(ctx context.Context, in *DeleteFeedbackAwardRequest, opts ...http.CallOption) (*DeleteFeedbackAwardReply, error) {
-	var out DeleteFeedbackAwardReply
-	pattern := "/feedback/award/{id}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationFeedbackServiceDeleteFeedbackAward))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &out, nil
+func NewRubricServiceHTTPClient(client *http.Client) RubricServiceHTTPClient {
+	return &RubricServiceHTTPClientImpl{client}
 }
 
-func (c *FeedbackServiceHTTPClientImpl) GetFeedbackAward(ctx context.Context, in *GetFeedbackAwardRequest, opts ...http.CallOption) (*GetFeedbackAwardReply, error) {
-	var out GetFeedbackAwardReply
-	pattern := "/feedback/award/{id}"
+func (c *RubricServiceHTTPClientImpl) GetTargetRubric(ctx context.Context, in *GetTargetRubricRequest, opts ...http.CallOption) (*GetTargetRubricReply, error) {
+	var out GetTargetRubricReply
+	pattern := "/target/{id}/rubric"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationFeedbackServiceGetFeedbackAward))
+	opts = append(opts, http.Operation(OperationRubricServiceGetTargetRubric))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -738,13 +3105,13 @@ func (c *FeedbackServiceHTTPClientImpl) GetFeedbackAward(ctx context.Context, in
 	return &out, nil
 }
 
-func (c *FeedbackServiceHTTPClientImpl) GetFeedbackAwards(ctx context.Context, in *GetFeedbackAwardsRequest, opts ...http.CallOption) (*GetFeedbackAwardsReply, error) {
-	var out GetFeedbackAwardsReply
-	pattern := "/feedback/awards"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationFeedbackServiceGetFeedbackAwards))
+func (c *RubricServiceHTTPClientImpl) SetTargetRubric(ctx context.Context, in *SetTargetRubricRequest, opts ...http.CallOption) (*SetTargetRubricReply, error) {
+	var out SetTargetRubricReply
+	pattern := "/target/{id}/rubric"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRubricServiceSetTargetRubric))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,8 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/http"
 
 	_ "go.uber.org/automaxprocs"
+	// 用户时区的数据，运行环境可能没有安装
+	_ "time/tzdata"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
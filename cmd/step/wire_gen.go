@@ -18,6 +18,7 @@ import (
 
 import (
 	_ "go.uber.org/automaxprocs"
+	_ "time/tzdata"
 )
 
 // Injectors from wire.go:
@@ -34,28 +35,82 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	minioRepo := data.NewMinioRepo(dataData, logger)
-	stepRepo := data.NewStepRepo(dataData, logger, minioRepo)
+	linkRepo := data.NewLinkRepo(logger)
+	stepRepo := data.NewStepRepo(dataData, logger, minioRepo, linkRepo)
 	encryptRepo := data.NewEncryptRepo(dataData, logger)
 	asynqEnqueueRepo := data.NewAsynqEnqueueRepo(dataData, logger)
 	stepUsecase := biz.NewStepUsecase(stepRepo, minioRepo, encryptRepo, asynqEnqueueRepo, client, logger)
-	stepService := service.NewStepService(stepUsecase)
+	searchIndex, cleanup2, err := data.NewSearchIndex(confData, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	tagRepo := data.NewTagRepo(dataData, logger)
+	searchUsecase := biz.NewSearchUsecase(searchIndex, tagRepo, logger)
+	stepService := service.NewStepService(stepUsecase, searchUsecase)
 	minioUsecase := biz.NewMinioUsecase(minioRepo, logger)
 	minioService := service.NewMinioService(minioUsecase)
 	stepNoauthRepo := data.NewStepNoauthRepo(dataData, logger, minioRepo, encryptRepo)
-	stepNoauthUsecase := biz.NewStepNoauthUsecase(stepNoauthRepo, encryptRepo, asynqEnqueueRepo, logger)
+	rubricRepo := data.NewRubricRepo(dataData, logger)
+	stepNoauthUsecase := biz.NewStepNoauthUsecase(stepNoauthRepo, encryptRepo, asynqEnqueueRepo, minioRepo, rubricRepo, logger)
 	stepNoauthService := service.NewStepNoauthService(stepNoauthUsecase)
-	portraitUsecase := biz.NewPortraitUsecase(stepRepo, client, logger)
+	cacheRepo := data.NewCacheRepo(dataData)
+	portraitUsecase := biz.NewPortraitUsecase(stepRepo, tagRepo, client, cacheRepo, logger)
 	portraitService := service.NewPortraitService(portraitUsecase)
 	feedbackUsecase := biz.NewFeedbackUsecase(stepRepo, minioRepo, client, confData, logger)
 	feedbackService := service.NewFeedbackService(feedbackUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, stepService, minioService, stepNoauthService, portraitService, feedbackService, logger)
-	httpServer := server.NewHTTPServer(confData, confServer, greeterService, stepService, minioService, stepNoauthService, portraitService, feedbackService, logger)
+	tagUsecase := biz.NewTagUsecase(tagRepo, logger)
+	tagService := service.NewTagService(tagUsecase)
+	templateRepo := data.NewTemplateRepo(dataData, logger)
+	templateUsecase := biz.NewTemplateUsecase(templateRepo, asynqEnqueueRepo, logger)
+	templateService := service.NewTemplateService(templateUsecase)
+	exportRepo := data.NewExportRepo(dataData, logger, minioRepo)
+	exportUsecase := biz.NewExportUsecase(exportRepo, asynqEnqueueRepo, logger)
+	exportService := service.NewExportService(exportUsecase)
 	statisticsRepo := data.NewStatisticsRepo(dataData, logger, stepRepo)
-	asynqStatisticsUsecase := biz.NewAsynqStatisticsUsecase(logger, statisticsRepo, asynqEnqueueRepo)
+	importRepo := data.NewImportRepo(dataData, logger, minioRepo, asynqEnqueueRepo, statisticsRepo)
+	importUsecase := biz.NewImportUsecase(importRepo, asynqEnqueueRepo, logger)
+	importService := service.NewImportService(importUsecase)
+	reportRepo := data.NewReportRepo(confData, dataData, logger, minioRepo)
+	reportUsecase := biz.NewReportUsecase(reportRepo, asynqEnqueueRepo, logger)
+	reportService := service.NewReportService(reportUsecase)
+	reviewerRepo := data.NewReviewerRepo(dataData, logger, minioRepo, encryptRepo)
+	reviewerUsecase := biz.NewReviewerUsecase(reviewerRepo, asynqEnqueueRepo, logger)
+	reviewerService := service.NewReviewerService(reviewerUsecase)
+	commentRepo := data.NewCommentRepo(dataData, logger)
+	commentUsecase := biz.NewCommentUsecase(commentRepo, asynqEnqueueRepo, logger)
+	commentService := service.NewCommentService(commentUsecase)
+	rubricUsecase := biz.NewRubricUsecase(rubricRepo, logger)
+	rubricService := service.NewRubricService(rubricUsecase)
+	noauthGuardRepo := data.NewNoauthGuardRepo(dataData)
+	grpcServer := server.NewGRPCServer(confData, confServer, greeterService, stepService, minioService, stepNoauthService, portraitService, feedbackService, tagService, templateService, exportService, importService, reportService, reviewerService, commentService, rubricService, noauthGuardRepo, logger)
+	httpServer := server.NewHTTPServer(confData, confServer, greeterService, stepService, minioService, stepNoauthService, portraitService, feedbackService, tagService, templateService, exportService, importService, reportService, reviewerService, commentService, rubricService, noauthGuardRepo, logger)
+	checkinNotifier, err := data.NewCheckinNotifier(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	asynqStatisticsUsecase := biz.NewAsynqStatisticsUsecase(logger, statisticsRepo, asynqEnqueueRepo, cacheRepo, checkinNotifier)
 	asynqFeedbackUsecase := biz.NewAsynqFeedbackUsecase(logger, client)
-	asynqServer := server.NewAsynqServer(confData, logger, asynqStatisticsUsecase, asynqFeedbackUsecase)
+	documentRepo := data.NewDocumentRepo(dataData, logger, minioRepo)
+	asynqDocumentUsecase := biz.NewAsynqDocumentUsecase(logger, documentRepo)
+	searchRepo := data.NewSearchRepo(dataData, logger)
+	asynqSearchUsecase := biz.NewAsynqSearchUsecase(logger, searchRepo, searchIndex)
+	asynqExportUsecase := biz.NewAsynqExportUsecase(logger, exportRepo)
+	asynqImportUsecase := biz.NewAsynqImportUsecase(logger, importRepo)
+	asynqReportUsecase := biz.NewAsynqReportUsecase(logger, reportRepo, portraitUsecase)
+	reviewNotifier, err := data.NewReviewNotifier(confData, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	asynqReviewUsecase := biz.NewAsynqReviewUsecase(logger, reviewerRepo, reviewNotifier, asynqEnqueueRepo)
+	asynqServer := server.NewAsynqServer(confData, logger, asynqStatisticsUsecase, asynqFeedbackUsecase, asynqDocumentUsecase, asynqSearchUsecase, asynqExportUsecase, asynqImportUsecase, asynqReportUsecase, asynqReviewUsecase)
 	app := newApp(logger, grpcServer, httpServer, asynqServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+type DocumentRepo interface {
+	HandleStepDocument(ctx context.Context, task *asynq.Task) error
+}
+
+// AsynqDocumentUsecase is a AsynqDocument usecase.
+type AsynqDocumentUsecase struct {
+	log          *log.Helper
+	documentRepo DocumentRepo
+}
+
+// NewAsynqDocumentUsecase new a AsynqDocument usecase.
+func NewAsynqDocumentUsecase(
+	logger log.Logger,
+	documentRepo DocumentRepo,
+) *AsynqDocumentUsecase {
+	return &AsynqDocumentUsecase{
+		log:          log.NewHelper(logger, log.WithMessageKey("asynqDocumentUsecase")),
+		documentRepo: documentRepo,
+	}
+}
+
+// HandleStepDocument 解析文档的页数并生成第一页的预览图
+func (uc *AsynqDocumentUsecase) HandleStepDocument(ctx context.Context, task *asynq.Task) error {
+	var payload objects.StepDocumentPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleStepDocument: %v", payload)
+
+	err = uc.documentRepo.HandleStepDocument(ctx, task)
+	if err != nil {
+		uc.log.Errorf("HandleStepDocument: %v", err)
+		return err
+	}
+
+	return nil
+}
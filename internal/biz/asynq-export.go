@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+// AsynqExportUsecase is a AsynqExport usecase.
+type AsynqExportUsecase struct {
+	log        *log.Helper
+	exportRepo ExportRepo
+}
+
+// NewAsynqExportUsecase new a AsynqExport usecase.
+func NewAsynqExportUsecase(
+	logger log.Logger,
+	exportRepo ExportRepo,
+) *AsynqExportUsecase {
+	return &AsynqExportUsecase{
+		log:        log.NewHelper(logger, log.WithMessageKey("asynqExportUsecase")),
+		exportRepo: exportRepo,
+	}
+}
+
+// HandleTargetExport 打包目标树的积累、评论和评分，上传到对象存储
+func (uc *AsynqExportUsecase) HandleTargetExport(ctx context.Context, task *asynq.Task) error {
+	var payload objects.TargetExportPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleTargetExport: %v", payload)
+
+	err = uc.exportRepo.HandleTargetExport(ctx, task)
+	if err != nil {
+		uc.log.Errorf("HandleTargetExport: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"step/internal/data/ent/award"
 	"step/internal/data/ent/portrait"
 	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
 	"step/internal/objects"
 	"time"
 
@@ -115,6 +116,28 @@ func (uc *AsynqFeedbackUsecase) handleFeedbackTargetChange(ctx context.Context,
 		curValue["skill_improvement"] = aggData[0].SkillImprovementAvg
 		curValue["difficulty"] = aggData[0].DifficultyAvg
 
+		// 自定义评分标准的评价项，阈值的维度为评价项的key
+		var criterionData []struct {
+			Key      string  `json:"key"`
+			ValueAvg float64 `json:"value_avg"`
+		}
+		err = uc.entClient.StepRateCriterion.Query().Where(stepratecriterion.TopTargetIDEQ(topTargetId)).Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(s.C(stepratecriterion.FieldKey), "key"),
+				sql.As(sql.Avg(s.C(stepratecriterion.FieldValue)), "value_avg"),
+			).
+			GroupBy(s.C(stepratecriterion.FieldKey))
+		}).Scan(ctx, &criterionData)
+		if err != nil {
+			uc.log.Errorf("handleFeedbackTargetChange: %v", err)
+			continue
+		}
+		for _, criterion := range criterionData {
+			if _, ok := curValue[criterion.Key]; !ok {
+				curValue[criterion.Key] = criterion.ValueAvg
+			}
+		}
+
 		awds, err := uc.entClient.Award.Query().
 			Where(award.UserID(uid)).
 			Where(award.StatusEQ(award.StatusSetted)).
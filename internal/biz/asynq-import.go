@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+// AsynqImportUsecase is a AsynqImport usecase.
+type AsynqImportUsecase struct {
+	log        *log.Helper
+	importRepo ImportRepo
+}
+
+// NewAsynqImportUsecase new a AsynqImport usecase.
+func NewAsynqImportUsecase(
+	logger log.Logger,
+	importRepo ImportRepo,
+) *AsynqImportUsecase {
+	return &AsynqImportUsecase{
+		log:        log.NewHelper(logger, log.WithMessageKey("asynqImportUsecase")),
+		importRepo: importRepo,
+	}
+}
+
+// HandleImport 按清单创建目标、目录和积累，完成后按时间先后发出统计事件
+func (uc *AsynqImportUsecase) HandleImport(ctx context.Context, task *asynq.Task) error {
+	var payload objects.ImportProcessPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleImport: %v", payload)
+
+	err = uc.importRepo.HandleImport(ctx, task)
+	if err != nil {
+		uc.log.Errorf("HandleImport: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"step/internal/objects"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+	"github.com/shopspring/decimal"
+)
+
+// AsynqReportUsecase is a AsynqReport usecase.
+type AsynqReportUsecase struct {
+	log             *log.Helper
+	reportRepo      ReportRepo
+	portraitUsecase *PortraitUsecase
+}
+
+// NewAsynqReportUsecase new a AsynqReport usecase.
+func NewAsynqReportUsecase(
+	logger log.Logger,
+	reportRepo ReportRepo,
+	portraitUsecase *PortraitUsecase,
+) *AsynqReportUsecase {
+	return &AsynqReportUsecase{
+		log:             log.NewHelper(logger, log.WithMessageKey("asynqReportUsecase")),
+		reportRepo:      reportRepo,
+		portraitUsecase: portraitUsecase,
+	}
+}
+
+// HandleReportGenerate 汇总目标树、评分趋势、画像、媒体和评论，生成HTML和PDF报告
+func (uc *AsynqReportUsecase) HandleReportGenerate(ctx context.Context, task *asynq.Task) error {
+	var payload objects.ReportGeneratePayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleReportGenerate: %v", payload)
+
+	job, err := uc.reportRepo.StartReport(ctx, payload.ReportID)
+	if err != nil {
+		uc.log.Errorf("HandleReportGenerate: %v", err)
+		return err
+	}
+	if job == nil {
+		return nil
+	}
+
+	err = uc.generate(ctx, job)
+	if err != nil {
+		uc.log.Errorf("HandleReportGenerate: %v", err)
+		if ferr := uc.reportRepo.FailReport(ctx, job.ID, err); ferr != nil {
+			uc.log.Errorf("FailReport: %v", ferr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (uc *AsynqReportUsecase) generate(ctx context.Context, job *objects.ReportJob) error {
+	content, err := uc.reportRepo.CollectReport(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	// 评分趋势和GetPortraitStepRate的统计相同
+	avgs, err := uc.portraitUsecase.stepRateUnitAvgs(ctx, job.UserID, &stepApi.GetPortraitStepRateRequest{
+		TopTargetId: job.TargetID,
+		StatUnit:    job.StatUnit,
+		StartDate:   job.StartDate,
+		EndDate:     job.EndDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	round := func(value float64) float64 {
+		return decimal.NewFromFloat(value).Round(2).InexactFloat64()
+	}
+	for _, avg := range avgs {
+		content.StepRates = append(content.StepRates, &objects.ReportStepRate{
+			Unit:                  avg.Unit,
+			WeightedValue:         round(avg.WeightValueAvg),
+			TargetReasonableness:  round(avg.TargetReasonablenessAvg),
+			TargetClarity:         round(avg.TargetClarityAvg),
+			TargetAchievement:     round(avg.TargetAchievementAvg),
+			ReflectionImprovement: round(avg.ReflectionImprovementAvg),
+			Innovation:            round(avg.InnovationAvg),
+			BasicReliability:      round(avg.BasicReliabilityAvg),
+			SkillImprovement:      round(avg.SkillImprovementAvg),
+			Difficulty:            round(avg.DifficultyAvg),
+		})
+	}
+
+	content.Portrait, err = uc.portraitUsecase.portraitDimensions(ctx, job.UserID)
+	if err != nil {
+		return err
+	}
+
+	content.StartDate = job.StartDate
+	content.EndDate = job.EndDate
+	content.StatUnit = job.StatUnit
+	content.GeneratedAt = time.Now().Local().Unix()
+
+	return uc.reportRepo.FinishReport(ctx, job, content)
+}
@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+// AsynqReviewUsecase is a AsynqReview usecase.
+type AsynqReviewUsecase struct {
+	log              *log.Helper
+	reviewerRepo     ReviewerRepo
+	notifier         ReviewNotifier
+	asynqEnqueueRepo AsynqEnqueueRepo
+}
+
+// NewAsynqReviewUsecase new a AsynqReview usecase.
+func NewAsynqReviewUsecase(
+	logger log.Logger,
+	reviewerRepo ReviewerRepo,
+	notifier ReviewNotifier,
+	asynqEnqueueRepo AsynqEnqueueRepo,
+) *AsynqReviewUsecase {
+	return &AsynqReviewUsecase{
+		log:              log.NewHelper(logger, log.WithMessageKey("asynqReviewUsecase")),
+		reviewerRepo:     reviewerRepo,
+		notifier:         notifier,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+	}
+}
+
+// HandleReviewRequestNotify 发送创建请求后的第一次通知，失败时重试
+func (uc *AsynqReviewUsecase) HandleReviewRequestNotify(ctx context.Context, task *asynq.Task) error {
+	var payload objects.ReviewRequestNotifyPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleReviewRequestNotify: %v", payload)
+
+	notification, err := uc.reviewerRepo.ReviewRequestNotification(ctx, payload.ReviewRequestID)
+	if err != nil {
+		uc.log.Errorf("HandleReviewRequestNotify: %v", err)
+		return err
+	}
+	if notification == nil {
+		return nil
+	}
+
+	err = uc.notifier.Notify(ctx, notification)
+	if err != nil {
+		uc.log.Errorf("HandleReviewRequestNotify notify: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// HandleReviewRequestRemind 到提醒时间还没有评论时提醒一次，再在过期时间处理一次把请求标记为过期
+func (uc *AsynqReviewUsecase) HandleReviewRequestRemind(ctx context.Context, task *asynq.Task) error {
+	var payload objects.ReviewRequestRemindPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleReviewRequestRemind: %v", payload)
+
+	notification, err := uc.reviewerRepo.ReviewRequestReminder(ctx, payload.ReviewRequestID)
+	if err != nil {
+		uc.log.Errorf("HandleReviewRequestRemind: %v", err)
+		return err
+	}
+	if notification == nil {
+		return nil
+	}
+
+	// 通知失败时重试，提醒成功后才记录
+	err = uc.notifier.Notify(ctx, notification)
+	if err != nil {
+		uc.log.Errorf("HandleReviewRequestRemind notify: %v", err)
+		return err
+	}
+
+	err = uc.reviewerRepo.SetReviewRequestReminded(ctx, payload.ReviewRequestID)
+	if err != nil {
+		return err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueReviewRequestRemind(ctx, payload.ReviewRequestID, notification.ExpiresAt)
+	if err != nil {
+		uc.log.Errorf("EnqueueReviewRequestRemind error: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+// AsynqSearchUsecase is a AsynqSearch usecase.
+type AsynqSearchUsecase struct {
+	log         *log.Helper
+	searchRepo  SearchRepo
+	searchIndex SearchIndex
+}
+
+// NewAsynqSearchUsecase new a AsynqSearch usecase.
+func NewAsynqSearchUsecase(
+	logger log.Logger,
+	searchRepo SearchRepo,
+	searchIndex SearchIndex,
+) *AsynqSearchUsecase {
+	return &AsynqSearchUsecase{
+		log:         log.NewHelper(logger, log.WithMessageKey("asynqSearchUsecase")),
+		searchRepo:  searchRepo,
+		searchIndex: searchIndex,
+	}
+}
+
+// HandleSearchIndex 按目标当前的内容重建它的搜索文档，目标已删除时清除
+func (uc *AsynqSearchUsecase) HandleSearchIndex(ctx context.Context, task *asynq.Task) error {
+	var payload objects.SearchIndexPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	uc.log.Infof("HandleSearchIndex: %v", payload)
+
+	targetID, docs, err := uc.searchRepo.TargetDocuments(ctx, payload.TargetID, payload.StepID)
+	if err != nil {
+		uc.log.Errorf("TargetDocuments: %v", err)
+		return err
+	}
+
+	if targetID == 0 {
+		return nil
+	}
+
+	err = uc.searchIndex.ReplaceTarget(ctx, targetID, docs)
+	if err != nil {
+		uc.log.Errorf("ReplaceTarget: %v", err)
+		return err
+	}
+
+	return nil
+}
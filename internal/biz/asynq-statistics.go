@@ -3,7 +3,9 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"step/internal/data/ent/portrait"
 	"step/internal/objects"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
@@ -13,6 +15,13 @@ type StatisticsRepo interface {
 	HandleTargetCreate(ctx context.Context, task *asynq.Task) (uid string, portraitChangeTypes []*objects.PortraitchangeType, err error)
 	HandleStepCreate(ctx context.Context, task *asynq.Task) (uid string, portraitChangeTypes []*objects.PortraitchangeType, err error)
 	HandleStepComment(ctx context.Context, task *asynq.Task) (uid string, portraitChangeTypes []*objects.PortraitchangeType, err error)
+	HandleTargetMissedCheckin(ctx context.Context, task *asynq.Task) (uids []string, err error)
+	HandleTargetCheckinReminder(ctx context.Context, task *asynq.Task) (reminders []*objects.CheckinReminder, err error)
+}
+
+// CheckinNotifier 打卡提醒的通知方式：日志或者webhook，和评论请求的通知分开配置
+type CheckinNotifier interface {
+	NotifyCheckinReminder(ctx context.Context, notification *objects.CheckinReminderNotification) error
 }
 
 // AsynqStatisticsUsecase is a AsynqStatistics usecase.
@@ -20,6 +29,8 @@ type AsynqStatisticsUsecase struct {
 	log            *log.Helper
 	statisticsRepo StatisticsRepo
 	asynqEnqueueRepo AsynqEnqueueRepo
+	cacheRepo      CacheRepo
+	notifier       CheckinNotifier
 }
 
 // NewAsynqStatisticsUsecase new a AsynqStatistics usecase.
@@ -27,11 +38,15 @@ func NewAsynqStatisticsUsecase(
 	logger log.Logger,
 	statisticsRepo StatisticsRepo,
 	asynqEnqueueRepo AsynqEnqueueRepo,
+	cacheRepo CacheRepo,
+	notifier CheckinNotifier,
 ) *AsynqStatisticsUsecase {
 	return &AsynqStatisticsUsecase{
 		log:            log.NewHelper(logger, log.WithMessageKey("asynqStatisticsUsecase")),
 		statisticsRepo: statisticsRepo,
 		asynqEnqueueRepo: asynqEnqueueRepo,
+		cacheRepo:      cacheRepo,
+		notifier:       notifier,
 	}
 }
 
@@ -49,6 +64,7 @@ func (uc *AsynqStatisticsUsecase) HandleTargetCreate(ctx context.Context, task *
 		uc.log.Errorf("HandleTargetCreate: %v", err)
 		return err
 	}
+	uc.cacheRepo.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
 
 	err = uc.asynqEnqueueRepo.EnqueueFeedbackPortraitChange(
 		ctx, uid,
@@ -76,6 +92,11 @@ func (uc *AsynqStatisticsUsecase) HandleStepCreate(ctx context.Context, task *as
 		uc.log.Errorf("HandleStepCreate: %v", err)
 		return err
 	}
+	// 已经计入过的积累没有变化
+	if len(portraitChangeTypes) == 0 {
+		return nil
+	}
+	uc.cacheRepo.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
 
 	err = uc.asynqEnqueueRepo.EnqueueFeedbackPortraitChange(
 		ctx, uid,
@@ -103,6 +124,7 @@ func (uc *AsynqStatisticsUsecase) HandleStepComment(ctx context.Context, task *a
 		uc.log.Errorf("HandleStepComment: %v", err)
 		return err
 	}
+	uc.cacheRepo.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
 
 	err = uc.asynqEnqueueRepo.EnqueueFeedbackPortraitChange(
 		ctx, uid,
@@ -115,3 +137,78 @@ func (uc *AsynqStatisticsUsecase) HandleStepComment(ctx context.Context, task *a
 
 	return nil
 }
+
+// HandleTargetMissedCheckin 定时器触发的任务没有日期，只按日期入队，由带日期的任务检查
+func (uc *AsynqStatisticsUsecase) HandleTargetMissedCheckin(ctx context.Context, task *asynq.Task) error {
+	uc.log.Infof("HandleTargetMissedCheckin: %s", string(task.Payload()))
+
+	var payload objects.TargetMissedCheckinPayload
+	if len(task.Payload()) > 0 {
+		err := json.Unmarshal(task.Payload(), &payload)
+		if err != nil {
+			return err
+		}
+	}
+	if payload.Date == "" {
+		date := objects.Day(time.Now().Local()).AddDate(0, 0, -1).Format(time.DateOnly)
+		return uc.asynqEnqueueRepo.EnqueueTargetMissedCheckin(ctx, date)
+	}
+
+	uids, err := uc.statisticsRepo.HandleTargetMissedCheckin(ctx, task)
+	if err != nil {
+		uc.log.Errorf("HandleTargetMissedCheckin: %v", err)
+		return err
+	}
+
+	for _, uid := range uids {
+		uc.cacheRepo.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
+		err = uc.asynqEnqueueRepo.EnqueueFeedbackPortraitChange(
+			ctx, uid,
+			[]*objects.PortraitchangeType{
+				{Type: "portrait", Scope: []string{portrait.DimensionSelfDiscipline.String()}},
+			},
+		)
+		if err != nil {
+			uc.log.Errorf("EnqueueFeedbackPortraitChange: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleTargetCheckinReminder 当天需要打卡的目标一次发送给通知渠道，发送失败时重试
+func (uc *AsynqStatisticsUsecase) HandleTargetCheckinReminder(ctx context.Context, task *asynq.Task) error {
+	var payload objects.TargetCheckinReminderPayload
+	if len(task.Payload()) > 0 {
+		err := json.Unmarshal(task.Payload(), &payload)
+		if err != nil {
+			return err
+		}
+	}
+	if payload.Date == "" {
+		return uc.asynqEnqueueRepo.EnqueueTargetCheckinReminder(ctx, time.Now().Local().Format(time.DateOnly))
+	}
+
+	reminders, err := uc.statisticsRepo.HandleTargetCheckinReminder(ctx, task)
+	if err != nil {
+		uc.log.Errorf("HandleTargetCheckinReminder: %v", err)
+		return err
+	}
+
+	if len(reminders) == 0 {
+		return nil
+	}
+
+	err = uc.notifier.NotifyCheckinReminder(ctx, &objects.CheckinReminderNotification{
+		Kind:      objects.CheckinReminderNotificationKind,
+		Date:      payload.Date,
+		Reminders: reminders,
+	})
+	if err != nil {
+		uc.log.Errorf("NotifyCheckinReminder: %v", err)
+		return err
+	}
+
+	return nil
+}
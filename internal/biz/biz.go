@@ -10,6 +10,21 @@ var ProviderSet = wire.NewSet(
 	NewStepNoauthUsecase,
 	NewAsynqStatisticsUsecase,
 	NewAsynqFeedbackUsecase,
+	NewAsynqDocumentUsecase,
 	NewPortraitUsecase,
 	NewFeedbackUsecase,
+	NewSearchUsecase,
+	NewAsynqSearchUsecase,
+	NewTagUsecase,
+	NewTemplateUsecase,
+	NewExportUsecase,
+	NewAsynqExportUsecase,
+	NewImportUsecase,
+	NewAsynqImportUsecase,
+	NewReportUsecase,
+	NewAsynqReportUsecase,
+	NewAsynqReviewUsecase,
+	NewReviewerUsecase,
+	NewCommentUsecase,
+	NewRubricUsecase,
 )
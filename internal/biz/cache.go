@@ -0,0 +1,30 @@
+package biz
+
+import (
+	"context"
+	"encoding/hex"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// CacheRepo Redis缓存，Redis不可用时读取视为未命中，写入和失效失败只记录日志
+type CacheRepo interface {
+	// GetUserCache 命中时解码到value
+	GetUserCache(ctx context.Context, uid string, kind string, field string, value proto.Message) bool
+	SetUserCache(ctx context.Context, uid string, kind string, field string, value proto.Message)
+	// InvalidateUserCache 删除用户这几类的所有缓存
+	InvalidateUserCache(ctx context.Context, uid string, kinds ...string)
+	GetPresignedUrl(ctx context.Context, objectName string, endpoint string) (string, bool)
+	SetPresignedUrl(ctx context.Context, objectName string, endpoint string, url string)
+	// InvalidatePresignedUrls 对象删除或移动后
+	InvalidatePresignedUrls(ctx context.Context, objectNames ...string)
+}
+
+// cacheField 用请求的确定性编码区分同一类缓存中的不同请求
+func cacheField(method string, req proto.Message) string {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	return method + ":" + hex.EncodeToString(b)
+}
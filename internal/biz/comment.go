@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"context"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type CommentRepo interface {
+	GetStepComments(ctx context.Context, req *stepApi.GetStepCommentsRequest) (*stepApi.GetStepCommentsReply, error)
+	ReplyComment(ctx context.Context, req *stepApi.ReplyCommentRequest) (*stepApi.ReplyCommentReply, error)
+	// UpdateComment 评分变化时返回true
+	UpdateComment(ctx context.Context, req *stepApi.UpdateCommentRequest) (*stepApi.UpdateCommentReply, bool, error)
+}
+
+type CommentUsecase struct {
+	repo             CommentRepo
+	asynqEnqueueRepo AsynqEnqueueRepo
+	log              *log.Helper
+}
+
+func NewCommentUsecase(repo CommentRepo, asynqEnqueueRepo AsynqEnqueueRepo, logger log.Logger) *CommentUsecase {
+	return &CommentUsecase{
+		repo:             repo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		log:              log.NewHelper(logger, log.WithMessageKey("commentUsecase")),
+	}
+}
+
+func (uc *CommentUsecase) GetStepComments(ctx context.Context, req *stepApi.GetStepCommentsRequest) (*stepApi.GetStepCommentsReply, error) {
+	return uc.repo.GetStepComments(ctx, req)
+}
+
+// ReplyComment 回复的内容也会被搜索，需要更新索引
+func (uc *CommentUsecase) ReplyComment(ctx context.Context, req *stepApi.ReplyCommentRequest) (*stepApi.ReplyCommentReply, error) {
+	reply, err := uc.repo.ReplyComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueSearchIndex(ctx, 0, reply.Comment.StepId)
+	if err != nil {
+		uc.log.Errorf("EnqueueSearchIndex error: %v", err)
+	}
+
+	return reply, nil
+}
+
+// UpdateComment 评分变化后重新计算积累的StepRate和画像，内容或评分变化都更新索引
+func (uc *CommentUsecase) UpdateComment(ctx context.Context, req *stepApi.UpdateCommentRequest) (*stepApi.UpdateCommentReply, error) {
+	reply, ratingChanged, err := uc.repo.UpdateComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ratingChanged {
+		err = uc.asynqEnqueueRepo.EnqueueStepComment(ctx, reply.Comment.StepId, reply.Comment.Role)
+		if err != nil {
+			uc.log.Errorf("EnqueueStepComment error: %v", err)
+		}
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueSearchIndex(ctx, 0, reply.Comment.StepId)
+	if err != nil {
+		uc.log.Errorf("EnqueueSearchIndex error: %v", err)
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"context"
+	"step/internal/objects"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+type ExportRepo interface {
+	// CreateExport 同一目标已有未完成的导出时返回该导出
+	CreateExport(ctx context.Context, req *stepApi.CreateExportRequest) (*stepApi.CreateExportReply, error)
+	GetExport(ctx context.Context, req *stepApi.GetExportRequest) (*stepApi.GetExportReply, error)
+	GetExports(ctx context.Context, req *stepApi.GetExportsRequest) (*stepApi.GetExportsReply, error)
+	DeleteExport(ctx context.Context, req *stepApi.DeleteExportRequest) (*stepApi.DeleteExportReply, error)
+	HandleTargetExport(ctx context.Context, task *asynq.Task) error
+}
+
+type ExportUsecase struct {
+	repo             ExportRepo
+	asynqEnqueueRepo AsynqEnqueueRepo
+	log              *log.Helper
+}
+
+func NewExportUsecase(repo ExportRepo, asynqEnqueueRepo AsynqEnqueueRepo, logger log.Logger) *ExportUsecase {
+	return &ExportUsecase{
+		repo:             repo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		log:              log.NewHelper(logger, log.WithMessageKey("exportUsecase")),
+	}
+}
+
+func (uc *ExportUsecase) CreateExport(ctx context.Context, req *stepApi.CreateExportRequest) (*stepApi.CreateExportReply, error) {
+	reply, err := uc.repo.CreateExport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 重复入队同一个导出会被忽略
+	if reply.Export.Status == objects.ExportStatusPending {
+		err = uc.asynqEnqueueRepo.EnqueueTargetExport(ctx, reply.Export.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reply, nil
+}
+
+func (uc *ExportUsecase) GetExport(ctx context.Context, req *stepApi.GetExportRequest) (*stepApi.GetExportReply, error) {
+	return uc.repo.GetExport(ctx, req)
+}
+
+func (uc *ExportUsecase) GetExports(ctx context.Context, req *stepApi.GetExportsRequest) (*stepApi.GetExportsReply, error) {
+	return uc.repo.GetExports(ctx, req)
+}
+
+func (uc *ExportUsecase) DeleteExport(ctx context.Context, req *stepApi.DeleteExportRequest) (*stepApi.DeleteExportReply, error) {
+	return uc.repo.DeleteExport(ctx, req)
+}
@@ -45,7 +45,7 @@ func NewFeedbackUsecase(
 func (uc *FeedbackUsecase) CreateFeedbackAward(ctx kratosHttp.Context) error {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return errors.New("uid is empty")
+		return stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	req := ctx.Request()
@@ -61,7 +61,7 @@ func (uc *FeedbackUsecase) CreateFeedbackAward(ctx kratosHttp.Context) error {
 	files, _ := multipartForm.File["files"]
 	// 允许没有文件
 	/* if !exists || len(files) == 0 {
-		return errors.New("no files provided")
+		return stepApi.ErrorUploadRejected("no files provided")
 	} */
 
 	description := req.FormValue("description")
@@ -112,7 +112,7 @@ func (uc *FeedbackUsecase) CreateFeedbackAward(ctx kratosHttp.Context) error {
 	}
 
 	/* if len(processedFiles) == 0 {
-		return errors.New("no valid files to process")
+		return stepApi.ErrorUploadRejected("no valid files to process")
 	} */
 	uc.log.Infof("successfully processed %d files: %v", len(processedFiles), processedFiles)
 
@@ -146,7 +146,7 @@ func (uc *FeedbackUsecase) CreateFeedbackAward(ctx kratosHttp.Context) error {
 func (uc *FeedbackUsecase) RealizeFeedbackAward(ctx kratosHttp.Context) error {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return errors.New("uid is empty")
+		return stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	req := ctx.Request()
@@ -160,7 +160,7 @@ func (uc *FeedbackUsecase) RealizeFeedbackAward(ctx kratosHttp.Context) error {
 	}
 
 	if awd.UserID != uid {
-		return errors.New("you are not the owner of this award")
+		return stepApi.ErrorNotOwner("you are not the owner of this award")
 	}
 
 	// 解析 multipart form 以处理多个文件
@@ -174,7 +174,7 @@ func (uc *FeedbackUsecase) RealizeFeedbackAward(ctx kratosHttp.Context) error {
 	files, _ := multipartForm.File["files"]
 	// 允许没有文件
 	/* if !exists || len(files) == 0 {
-		return errors.New("no files provided")
+		return stepApi.ErrorUploadRejected("no files provided")
 	} */
 
 	uc.log.Infof("realize feedback award with %d files: id=%d, time=%s", 
@@ -234,7 +234,7 @@ func (uc *FeedbackUsecase) RealizeFeedbackAward(ctx kratosHttp.Context) error {
 func (uc *FeedbackUsecase) GetFeedbackAwards(ctx context.Context, req *stepApi.GetFeedbackAwardsRequest) (*stepApi.GetFeedbackAwardsReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	page := cast.ToInt(req.Page)
@@ -300,7 +300,7 @@ func (uc *FeedbackUsecase) GetFeedbackAwards(ctx context.Context, req *stepApi.G
 func (uc *FeedbackUsecase) GetFeedbackAward(ctx context.Context, req *stepApi.GetFeedbackAwardRequest) (*stepApi.GetFeedbackAwardReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	awd, err := uc.entClient.Award.Get(ctx, req.Id)
@@ -309,7 +309,7 @@ func (uc *FeedbackUsecase) GetFeedbackAward(ctx context.Context, req *stepApi.Ge
 	}
 
 	if awd.UserID != uid {
-		return nil, errors.New("you are not the owner of this award")
+		return nil, stepApi.ErrorNotOwner("you are not the owner of this award")
 	}
 
 	fbAward := &stepApi.FeedbackAward{
@@ -352,7 +352,7 @@ func (uc *FeedbackUsecase) GetFeedbackAward(ctx context.Context, req *stepApi.Ge
 func (uc *FeedbackUsecase) DeleteFeedbackAward(ctx context.Context, req *stepApi.DeleteFeedbackAwardRequest) (*stepApi.DeleteFeedbackAwardReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	awd, err := uc.entClient.Award.Get(ctx, req.Id)
@@ -361,7 +361,7 @@ func (uc *FeedbackUsecase) DeleteFeedbackAward(ctx context.Context, req *stepApi
 	}
 
 	if awd.UserID != uid {
-		return nil, errors.New("you are not the owner of this award")
+		return nil, stepApi.ErrorNotOwner("you are not the owner of this award")
 	}
 
 	err = uc.entClient.Award.DeleteOneID(req.Id).Exec(ctx)
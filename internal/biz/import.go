@@ -0,0 +1,268 @@
+package biz
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"mime/multipart"
+	nethttp "net/http"
+	"os"
+	"step/internal/objects"
+	"step/internal/utils"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/hibiken/asynq"
+	"github.com/spf13/cast"
+)
+
+type ImportRepo interface {
+	// CreateImport 保存压缩包并创建导入任务
+	CreateImport(ctx context.Context, parentTargetID uint64, format string, archive io.Reader) (*stepApi.ImportJob, error)
+	GetImport(ctx context.Context, req *stepApi.GetImportRequest) (*stepApi.GetImportReply, error)
+	GetImports(ctx context.Context, req *stepApi.GetImportsRequest) (*stepApi.GetImportsReply, error)
+	GetImportItems(ctx context.Context, req *stepApi.GetImportItemsRequest) (*stepApi.GetImportItemsReply, error)
+	ResumeImport(ctx context.Context, req *stepApi.ResumeImportRequest) (*stepApi.ResumeImportReply, error)
+	HandleImport(ctx context.Context, task *asynq.Task) error
+}
+
+type ImportUsecase struct {
+	repo             ImportRepo
+	asynqEnqueueRepo AsynqEnqueueRepo
+	log              *log.Helper
+}
+
+func NewImportUsecase(repo ImportRepo, asynqEnqueueRepo AsynqEnqueueRepo, logger log.Logger) *ImportUsecase {
+	return &ImportUsecase{
+		repo:             repo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		log:              log.NewHelper(logger, log.WithMessageKey("importUsecase")),
+	}
+}
+
+// UploadImport 接收两种上传方式:
+// ● file: 包含manifest.json或manifest.csv的zip压缩包
+// ● manifest + files: 清单文件和文件夹中的文件，paths按顺序对应files在文件夹中的相对路径(不传时用文件名)，服务端打包为zip
+// parentTargetID为空时导入为顶层目标
+func (uc *ImportUsecase) UploadImport(ctx http.Context) error {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	req := ctx.Request()
+	req.Body = nethttp.MaxBytesReader(ctx.Response(), req.Body, objects.ImportMaxBytes)
+	err := req.ParseMultipartForm(32 << 20) // 32 MB max memory
+	if err != nil {
+		return err
+	}
+	defer req.MultipartForm.RemoveAll()
+
+	parentTargetID := cast.ToUint64(req.FormValue("parentTargetID"))
+
+	var archive *os.File
+	var format string
+	if files := req.MultipartForm.File["file"]; len(files) > 0 {
+		archive, format, err = openImportArchive(files[0])
+	} else {
+		archive, format, err = packImportFolder(req.MultipartForm)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		archive.Close()
+		os.Remove(archive.Name())
+	}()
+
+	job, err := uc.repo.CreateImport(ctx, parentTargetID, format, archive)
+	if err != nil {
+		return err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueImport(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+
+	response := ctx.Response()
+	response.Header().Set("Content-Type", "application/json")
+	resObj := gabs.New()
+	resObj.Set(job.Id, "id")
+	resObj.Set(job.Status, "status")
+	resObj.Set(job.Format, "format")
+	resObj.Set(job.ParentTargetId, "parentTargetID")
+	response.Write(resObj.Bytes())
+
+	return nil
+}
+
+// importManifestFormat 压缩包根目录中的清单文件决定格式
+func importManifestFormat(zr *zip.Reader) (string, error) {
+	for _, f := range zr.File {
+		switch f.Name {
+		case objects.ImportManifestJSON:
+			return "json", nil
+		case objects.ImportManifestCSV:
+			return "csv", nil
+		}
+	}
+	return "", stepApi.ErrorInvalidArgument("manifest.json or manifest.csv is required in the archive")
+}
+
+// openImportArchive 上传的压缩包复制到临时文件，校验包含清单
+func openImportArchive(fileHeader *multipart.FileHeader) (*os.File, string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	archive, err := os.CreateTemp("", "step-import-*.zip")
+	if err != nil {
+		return nil, "", err
+	}
+
+	format, err := func() (string, error) {
+		size, err := io.Copy(archive, file)
+		if err != nil {
+			return "", err
+		}
+
+		zr, err := zip.NewReader(archive, size)
+		if err != nil {
+			return "", err
+		}
+
+		format, err := importManifestFormat(zr)
+		if err != nil {
+			return "", err
+		}
+
+		_, err = archive.Seek(0, io.SeekStart)
+		return format, err
+	}()
+	if err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return nil, "", err
+	}
+
+	return archive, format, nil
+}
+
+// packImportFolder 把清单和文件夹中的文件打包为zip，和上传压缩包的处理方式一致
+func packImportFolder(form *multipart.Form) (*os.File, string, error) {
+	manifests := form.File["manifest"]
+	if len(manifests) == 0 {
+		return nil, "", stepApi.ErrorInvalidArgument("file or manifest is required")
+	}
+
+	manifestName := objects.ImportManifestJSON
+	format := "json"
+	mimeType := objects.DetectMimeType(manifests[0].Filename, manifests[0].Header.Get("Content-Type"))
+	if mimeType == "text/csv" || importFormValue(form, "format") == "csv" {
+		manifestName = objects.ImportManifestCSV
+		format = "csv"
+	}
+
+	archive, err := os.CreateTemp("", "step-import-*.zip")
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = func() error {
+		zw := zip.NewWriter(archive)
+
+		err := copyImportFile(zw, manifestName, manifests[0])
+		if err != nil {
+			return err
+		}
+
+		paths := form.Value["paths"]
+		for i, fileHeader := range form.File["files"] {
+			name := fileHeader.Filename
+			if i < len(paths) && paths[i] != "" {
+				name = paths[i]
+			}
+			name, err = objects.ImportFilePath(name)
+			if err != nil {
+				return err
+			}
+			err = copyImportFile(zw, name, fileHeader)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = zw.Close()
+		if err != nil {
+			return err
+		}
+
+		_, err = archive.Seek(0, io.SeekStart)
+		return err
+	}()
+	if err != nil {
+		archive.Close()
+		os.Remove(archive.Name())
+		return nil, "", err
+	}
+
+	return archive, format, nil
+}
+
+func importFormValue(form *multipart.Form, key string) string {
+	if values := form.Value[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func copyImportFile(zw *zip.Writer, name string, fileHeader *multipart.FileHeader) error {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:   name,
+		Method: zip.Store,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, file)
+	return err
+}
+
+func (uc *ImportUsecase) GetImport(ctx context.Context, req *stepApi.GetImportRequest) (*stepApi.GetImportReply, error) {
+	return uc.repo.GetImport(ctx, req)
+}
+
+func (uc *ImportUsecase) GetImports(ctx context.Context, req *stepApi.GetImportsRequest) (*stepApi.GetImportsReply, error) {
+	return uc.repo.GetImports(ctx, req)
+}
+
+func (uc *ImportUsecase) GetImportItems(ctx context.Context, req *stepApi.GetImportItemsRequest) (*stepApi.GetImportItemsReply, error) {
+	return uc.repo.GetImportItems(ctx, req)
+}
+
+func (uc *ImportUsecase) ResumeImport(ctx context.Context, req *stepApi.ResumeImportRequest) (*stepApi.ResumeImportReply, error) {
+	reply, err := uc.repo.ResumeImport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueImport(ctx, reply.Import.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
@@ -2,7 +2,9 @@ package biz
 
 import (
 	"context"
+	"io"
 	"mime/multipart"
+	"step/internal/objects"
 
 	v1 "step/api/minio/v1"
 
@@ -15,6 +17,11 @@ type MinioRepo interface {
 	GetDownloadPreSignedUrl(ctx context.Context, download_key string, endpoint string) (string, error)
 	UploadFile(ctx context.Context, upload_key string, file *multipart.File) error
 	RemoveFile(ctx context.Context, remove_key string) error
+	// 服务端复制，不经过本服务
+	CopyFile(ctx context.Context, src_key string, dst_key string) error
+	UploadObject(ctx context.Context, upload_key string, body io.Reader, content_type string) error
+	// byte_range为HTTP Range头，如: bytes=0-99，为空时读取整个对象
+	GetObject(ctx context.Context, download_key string, byte_range string) (*objects.ObjectContent, error)
 }
 
 type MinioUsecase struct {
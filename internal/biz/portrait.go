@@ -2,36 +2,47 @@ package biz
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"sort"
 	"step/internal/data/ent"
 	"step/internal/data/ent/portrait"
 	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
+	"step/internal/data/ent/target"
+	"step/internal/objects"
 	"step/internal/utils"
 	"time"
 
 	stepApi "step/api/step/v1"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Jeffail/gabs/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/shopspring/decimal"
+	"github.com/spf13/cast"
 )
 
 type PortraitUsecase struct {
 	stepRepo         StepRepo
+	tagRepo          TagRepo
 	entClient        *ent.Client
+	cacheRepo        CacheRepo
 	log              *log.Helper
 }
 
 func NewPortraitUsecase(
 	stepRepo StepRepo,
+	tagRepo TagRepo,
 	entClient *ent.Client,
+	cacheRepo CacheRepo,
 	logger log.Logger,
 ) *PortraitUsecase {
 	return &PortraitUsecase{
 		stepRepo:         stepRepo,
+		tagRepo:          tagRepo,
 		entClient:        entClient,
+		cacheRepo:        cacheRepo,
 		log:              log.NewHelper(logger, log.WithMessageKey("stepUsecase")),
 	}
 }
@@ -39,7 +50,13 @@ func NewPortraitUsecase(
 func (uc *PortraitUsecase) GetPortraitBasic(ctx context.Context, req *stepApi.GetPortraitBasicRequest) (*stepApi.GetPortraitBasicReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	field := cacheField("basic", req)
+	cached := &stepApi.GetPortraitBasicReply{}
+	if uc.cacheRepo.GetUserCache(ctx, uid, objects.CacheKindPortrait, field, cached) {
+		return cached, nil
 	}
 
 	query := uc.entClient.Portrait.Query().Where(portrait.UserIDEQ(uid))
@@ -52,7 +69,7 @@ func (uc *PortraitUsecase) GetPortraitBasic(ctx context.Context, req *stepApi.Ge
 		return nil, err
 	}
 	if len(portraits) == 0 {
-		return nil, errors.New("portrait not found")
+		return nil, stepApi.ErrorResourceNotFound("portrait not found")
 	}
 
 	value := gabs.New()
@@ -60,80 +77,35 @@ func (uc *PortraitUsecase) GetPortraitBasic(ctx context.Context, req *stepApi.Ge
 		value.Set(portrait.Value, portrait.Dimension.String())
 	}
 
-	return &stepApi.GetPortraitBasicReply{
+	reply := &stepApi.GetPortraitBasicReply{
 		Dimension: req.Dimension,
 		Value:     value.String(),
-	}, nil
+	}
+	uc.cacheRepo.SetUserCache(ctx, uid, objects.CacheKindPortrait, field, reply)
+	return reply, nil
 }
 
 func (uc *PortraitUsecase) GetPortraitStepRate(ctx context.Context, req *stepApi.GetPortraitStepRateRequest) (*stepApi.GetPortraitStepRateReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
-	if req.TopTargetId == 0 {
-		return nil, errors.New("top_target_id is empty")
+	if req.TopTargetId == 0 && len(req.TagIds) == 0 {
+		return nil, stepApi.ErrorInvalidArgument("top_target_id is empty")
 	}
 
 	if req.StatUnit == "" {
-		return nil, errors.New("stat_unit is empty")
+		return nil, stepApi.ErrorInvalidArgument("stat_unit is empty")
 	}
 
-	query := uc.entClient.StepRate.Query().Where(steprate.UserIDEQ(uid), steprate.TopTargetIDEQ(req.TopTargetId))
-	if req.StartDate != "" {
-		startDate, err := time.Parse("2006-01-02 15:04:05", req.StartDate + " 00:00:00")
-		if err != nil {
-			return nil, err
-		}
-		query = query.Where(steprate.DateGTE(startDate))
-	}
-	if req.EndDate != "" {
-		endDate, err := time.Parse("2006-01-02 15:04:05", req.EndDate + " 23:59:59")
-		if err != nil {
-			return nil, err
-		}
-		query = query.Where(steprate.DateLTE(endDate))
+	field := cacheField("step_rate", req)
+	cached := &stepApi.GetPortraitStepRateReply{}
+	if uc.cacheRepo.GetUserCache(ctx, uid, objects.CacheKindPortrait, field, cached) {
+		return cached, nil
 	}
 
-	// aggregation by unit
-	var aggData []struct {
-		Unit            string 	    		`json:"unit"`
-		WeightValueAvg  float64  			`json:"weight_value_avg"`
-		TargetReasonablenessAvg  float64  	`json:"target_reasonableness_avg"`
-		TargetClarityAvg  float64  			`json:"target_clarity_avg"`
-		TargetAchievementAvg  float64  		`json:"target_achievement_avg"`
-		ReflectionImprovementAvg  float64  	`json:"reflection_improvement_avg"`
-		InnovationAvg  float64  			`json:"innovation_avg"`
-		BasicReliabilityAvg  float64  		`json:"basic_reliability_avg"`
-		SkillImprovementAvg  float64  		`json:"skill_improvement_avg"`
-		DifficultyAvg  float64  			`json:"difficulty_avg"`
-	}
-
-	var unitExpr string
-	if req.StatUnit == "day" {
-		unitExpr = fmt.Sprintf("DATE_FORMAT(%s, '%s')", steprate.FieldDate, "%Y-%m-%d")
-	} else if req.StatUnit == "week" {
-		unitExpr = fmt.Sprintf("DATE_FORMAT(%s, '%s')", steprate.FieldDate, "%x-W%v")
-	} else if req.StatUnit == "month" {
-		unitExpr = fmt.Sprintf("DATE_FORMAT(%s, '%s')", steprate.FieldDate, "%Y-%m")
-	}
-	err := query.Modify(func(s *sql.Selector) {
-		s.Select(
-			sql.As(unitExpr, "unit"),
-			sql.As(sql.Avg(s.C(steprate.FieldWeightedValue)), "weight_value_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldTargetReasonableness)), "target_reasonableness_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldTargetClarity)), "target_clarity_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldTargetAchievement)), "target_achievement_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldReflectionImprovement)), "reflection_improvement_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldInnovation)), "innovation_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldBasicReliability)), "basic_reliability_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldSkillImprovement)), "skill_improvement_avg"),
-			sql.As(sql.Avg(s.C(steprate.FieldDifficulty)), "difficulty_avg"),
-		).
-		GroupBy("unit").
-		OrderBy(sql.Asc("unit"))
-	}).Scan(ctx, &aggData)
+	aggData, err := uc.stepRateUnitAvgs(ctx, uid, req)
 	if err != nil {
 		return nil, err
 	}
@@ -178,12 +150,365 @@ func (uc *PortraitUsecase) GetPortraitStepRate(ctx context.Context, req *stepApi
 		value.ArrayAppend(difficulty, "difficulty")
 	}
 
-	return &stepApi.GetPortraitStepRateReply{
+	// 顶层目标有自定义评分标准时，按评价项的key增加各自的趋势
+	var criteria []string
+	if req.TopTargetId != 0 {
+		topTarget, err := uc.entClient.Target.Query().
+			Where(target.ID(req.TopTargetId), target.UserID(uid)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if topTarget != nil && topTarget.Rubric.IsCustom() {
+			criterionAvgs, err := uc.stepRateCriterionUnitAvgs(ctx, uid, req)
+			if err != nil {
+				return nil, err
+			}
+			for _, criterion := range topTarget.Rubric.Criteria {
+				criteria = append(criteria, criterion.Key)
+				value.Array(criterion.Key)
+			}
+			for _, row := range criterionAvgs {
+				if !value.Exists(row.Key) {
+					continue
+				}
+				criterionValue := gabs.New()
+				criterionValue.Set(decimal.NewFromFloat(row.ValueAvg).Round(2).InexactFloat64(), row.Unit)
+				value.ArrayAppend(criterionValue, row.Key)
+			}
+		}
+	}
+
+	reply := &stepApi.GetPortraitStepRateReply{
 		TopTargetId:   req.TopTargetId,
 		StatUnit:      req.StatUnit,
+		TagIds:        req.TagIds,
 		Value:         value.String(),
+		Criteria:      criteria,
+	}
+	uc.cacheRepo.SetUserCache(ctx, uid, objects.CacheKindPortrait, field, reply)
+	return reply, nil
+}
+
+// stepRateUnitAvg 一个统计单位内step rate各项的平均值
+type stepRateUnitAvg struct {
+	Unit                     string  `json:"unit"`
+	WeightValueAvg           float64 `json:"weight_value_avg"`
+	TargetReasonablenessAvg  float64 `json:"target_reasonableness_avg"`
+	TargetClarityAvg         float64 `json:"target_clarity_avg"`
+	TargetAchievementAvg     float64 `json:"target_achievement_avg"`
+	ReflectionImprovementAvg float64 `json:"reflection_improvement_avg"`
+	InnovationAvg            float64 `json:"innovation_avg"`
+	BasicReliabilityAvg      float64 `json:"basic_reliability_avg"`
+	SkillImprovementAvg      float64 `json:"skill_improvement_avg"`
+	DifficultyAvg            float64 `json:"difficulty_avg"`
+}
+
+// stepRateUnitAvgs 按统计单位聚合用户的step rate，也用于异步生成报告(没有请求上下文中的uid)
+func (uc *PortraitUsecase) stepRateUnitAvgs(ctx context.Context, uid string, req *stepApi.GetPortraitStepRateRequest) ([]stepRateUnitAvg, error) {
+	query := uc.entClient.StepRate.Query().Where(steprate.UserIDEQ(uid))
+	if req.TopTargetId != 0 {
+		query = query.Where(steprate.TopTargetIDEQ(req.TopTargetId))
+	}
+	// 按标签筛选：带标签的积累，或带标签的目标下的积累
+	if len(req.TagIds) > 0 {
+		targetIDs, stepIDs, err := uc.tagRepo.TaggedIDs(ctx, uid, req.TagIds)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(steprate.Or(steprate.StepIDIn(stepIDs...), steprate.TargetIDIn(targetIDs...)))
+	}
+	query, err := stepRateDateRange(query, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// aggregation by unit
+	var aggData []stepRateUnitAvg
+
+	unitExpr, err := stepRateUnitExpr(req.StatUnit)
+	if err != nil {
+		return nil, err
+	}
+	err = query.Modify(func(s *sql.Selector) {
+		s.Select(
+			sql.As(unitExpr(s), "unit"),
+			sql.As(sql.Avg(s.C(steprate.FieldWeightedValue)), "weight_value_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldTargetReasonableness)), "target_reasonableness_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldTargetClarity)), "target_clarity_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldTargetAchievement)), "target_achievement_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldReflectionImprovement)), "reflection_improvement_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldInnovation)), "innovation_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldBasicReliability)), "basic_reliability_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldSkillImprovement)), "skill_improvement_avg"),
+			sql.As(sql.Avg(s.C(steprate.FieldDifficulty)), "difficulty_avg"),
+		).
+		GroupBy("unit").
+		OrderBy(sql.Asc("unit"))
+	}).Scan(ctx, &aggData)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggData, nil
+}
+
+// stepRateCriterionUnitAvg 一个统计单位内自定义评价项的平均值
+type stepRateCriterionUnitAvg struct {
+	Unit     string  `json:"unit"`
+	Key      string  `json:"key"`
+	ValueAvg float64 `json:"value_avg"`
+}
+
+// stepRateCriterionUnitAvgs 按统计单位和评价项聚合顶层目标的自定义评价项，只包含有评分的积累
+func (uc *PortraitUsecase) stepRateCriterionUnitAvgs(ctx context.Context, uid string, req *stepApi.GetPortraitStepRateRequest) ([]stepRateCriterionUnitAvg, error) {
+	query := uc.entClient.StepRateCriterion.Query().
+		Where(stepratecriterion.UserIDEQ(uid), stepratecriterion.TopTargetIDEQ(req.TopTargetId))
+	if len(req.TagIds) > 0 {
+		targetIDs, stepIDs, err := uc.tagRepo.TaggedIDs(ctx, uid, req.TagIds)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(stepratecriterion.Or(stepratecriterion.StepIDIn(stepIDs...), stepratecriterion.TargetIDIn(targetIDs...)))
+	}
+	query, err := stepRateCriterionDateRange(query, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	unitExpr, err := stepRateUnitExpr(req.StatUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	var aggData []stepRateCriterionUnitAvg
+	err = query.Modify(func(s *sql.Selector) {
+		s.Select(
+			sql.As(unitExpr(s), "unit"),
+			sql.As(s.C(stepratecriterion.FieldKey), "key"),
+			sql.As(sql.Avg(s.C(stepratecriterion.FieldValue)), "value_avg"),
+		).
+		GroupBy("unit", s.C(stepratecriterion.FieldKey)).
+		OrderBy(sql.Asc("unit"))
+	}).Scan(ctx, &aggData)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggData, nil
+}
+
+// portraitDimensions 用户画像各维度的值，按维度和名称排序
+func (uc *PortraitUsecase) portraitDimensions(ctx context.Context, uid string) ([]*objects.ReportPortraitDimension, error) {
+	portraits, err := uc.entClient.Portrait.Query().
+		Where(portrait.UserIDEQ(uid)).
+		Order(ent.Asc(portrait.FieldDimension)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions := make([]*objects.ReportPortraitDimension, 0, len(portraits))
+	for _, p := range portraits {
+		names := make([]string, 0, len(p.Value))
+		for name := range p.Value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		dimension := &objects.ReportPortraitDimension{
+			Dimension: p.Dimension.String(),
+		}
+		for _, name := range names {
+			dimension.Values = append(dimension.Values, &objects.ReportPortraitValue{
+				Name:  name,
+				Value: cast.ToFloat64(p.Value[name]),
+			})
+		}
+		dimensions = append(dimensions, dimension)
+	}
+
+	return dimensions, nil
+}
+
+// stepRateUnitLayouts 各数据库统计单位的日期格式：日、ISO周、月，和objects.StatUnitLabel一致
+var stepRateUnitLayouts = map[string]map[string]string{
+	dialect.MySQL:    {"day": "%Y-%m-%d", "week": "%x-W%v", "month": "%Y-%m"},
+	dialect.SQLite:   {"day": "%Y-%m-%d", "week": "%G-W%V", "month": "%Y-%m"},
+	dialect.Postgres: {"day": "YYYY-MM-DD", "week": `IYYY-"W"IW`, "month": "YYYY-MM"},
+}
+
+// stepRateUnitExpr step rate按统计单位分组的表达式，按查询的数据库方言生成
+func stepRateUnitExpr(statUnit string) (func(s *sql.Selector) string, error) {
+	if _, ok := stepRateUnitLayouts[dialect.MySQL][statUnit]; !ok {
+		return nil, stepApi.ErrorInvalidArgument("invalid stat_unit: %s", statUnit)
+	}
+	return func(s *sql.Selector) string {
+		layout := stepRateUnitLayouts[s.Dialect()][statUnit]
+		column := s.C(steprate.FieldDate)
+		switch s.Dialect() {
+		case dialect.SQLite:
+			// SQLite中保存的是带时区的时间文本，日期函数会转换为UTC，只取日期部分
+			return fmt.Sprintf("strftime('%s', substr(%s, 1, 10))", layout, column)
+		case dialect.Postgres:
+			return fmt.Sprintf("to_char(%s, '%s')", column, layout)
+		default:
+			return fmt.Sprintf("DATE_FORMAT(%s, '%s')", column, stepRateUnitLayouts[dialect.MySQL][statUnit])
+		}
 	}, nil
 }
 
+// stepRateDateRange 按日期范围过滤step rate, 格式: 2025-01-01
+func stepRateDateRange(query *ent.StepRateQuery, startDate string, endDate string) (*ent.StepRateQuery, error) {
+	if startDate != "" {
+		start, err := time.Parse("2006-01-02 15:04:05", startDate + " 00:00:00")
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(steprate.DateGTE(start))
+	}
+	if endDate != "" {
+		end, err := time.Parse("2006-01-02 15:04:05", endDate + " 23:59:59")
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(steprate.DateLTE(end))
+	}
+	return query, nil
+}
 
+// stepRateCriterionDateRange 按日期范围过滤自定义评价项, 格式: 2025-01-01
+func stepRateCriterionDateRange(query *ent.StepRateCriterionQuery, startDate string, endDate string) (*ent.StepRateCriterionQuery, error) {
+	if startDate != "" {
+		start, err := time.Parse("2006-01-02 15:04:05", startDate+" 00:00:00")
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(stepratecriterion.DateGTE(start))
+	}
+	if endDate != "" {
+		end, err := time.Parse("2006-01-02 15:04:05", endDate+" 23:59:59")
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(stepratecriterion.DateLTE(end))
+	}
+	return query, nil
+}
 
+// getSubTargetIDs 目标及其所有子目标的ID
+func (uc *PortraitUsecase) getSubTargetIDs(ctx context.Context, targetID uint64) ([]uint64, error) {
+	targetIDs := []uint64{targetID}
+	parentIDs := []uint64{targetID}
+	for len(parentIDs) > 0 {
+		childIDs, err := uc.entClient.Target.Query().
+			Where(target.ParentIDIn(parentIDs...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		targetIDs = append(targetIDs, childIDs...)
+		parentIDs = childIDs
+	}
+	return targetIDs, nil
+}
+
+func (uc *PortraitUsecase) GetPortraitTargetProgress(ctx context.Context, req *stepApi.GetPortraitTargetProgressRequest) (*stepApi.GetPortraitTargetProgressReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if req.TargetId == 0 {
+		return nil, stepApi.ErrorInvalidArgument("target_id is empty")
+	}
+
+	if req.StatUnit == "" {
+		return nil, stepApi.ErrorInvalidArgument("stat_unit is empty")
+	}
+
+	t, err := uc.entClient.Target.Get(ctx, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	if t.Mode != target.ModeQuantity {
+		return nil, stepApi.ErrorInvalidArgument("target is not a quantity target")
+	}
+
+	// 进度包含所有子目标的计量值
+	targetIDs, err := uc.getSubTargetIDs(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	unitExpr, err := stepRateUnitExpr(req.StatUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	// aggregation by unit, 不按日期过滤，起始日期之前的累计值也需要计算
+	var aggData []struct {
+		Unit  string  `json:"unit"`
+		Total float64 `json:"total"`
+	}
+	err = uc.entClient.StepRate.Query().
+		Where(steprate.UserIDEQ(uid), steprate.TargetIDIn(targetIDs...)).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(unitExpr(s), "unit"),
+				sql.As(sql.Sum(s.C(steprate.FieldMeasurement)), "total"),
+			).
+			GroupBy("unit").
+			OrderBy(sql.Asc("unit"))
+		}).Scan(ctx, &aggData)
+	if err != nil {
+		return nil, err
+	}
+
+	startUnit, endUnit := "", ""
+	if req.StartDate != "" {
+		startDate, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		startUnit = objects.StatUnitLabel(startDate, req.StatUnit)
+	}
+	if req.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		endUnit = objects.StatUnitLabel(endDate, req.StatUnit)
+	}
+
+	reply := &stepApi.GetPortraitTargetProgressReply{
+		TargetId:  t.ID,
+		StatUnit:  req.StatUnit,
+		Unit:      t.Unit,
+		GoalValue: t.GoalValue,
+		Buckets:   make([]*stepApi.TargetProgressBucket, 0),
+	}
+	cumulative := decimal.Zero
+	for _, row := range aggData {
+		cumulative = cumulative.Add(decimal.NewFromFloat(row.Total))
+		if (startUnit != "" && row.Unit < startUnit) || (endUnit != "" && row.Unit > endUnit) {
+			continue
+		}
+		reply.Buckets = append(reply.Buckets, &stepApi.TargetProgressBucket{
+			Unit:       row.Unit,
+			Total:      decimal.NewFromFloat(row.Total).Round(2).InexactFloat64(),
+			Cumulative: cumulative.Round(2).InexactFloat64(),
+		})
+	}
+	reply.Total = cumulative.Round(2).InexactFloat64()
+	if t.GoalValue > 0 {
+		reply.Percent = cumulative.Div(decimal.NewFromFloat(t.GoalValue)).Mul(decimal.NewFromInt(100)).Round(2).InexactFloat64()
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"context"
+	"step/internal/objects"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type ReportRepo interface {
+	CreateReport(ctx context.Context, req *stepApi.CreateReportRequest) (*stepApi.CreateReportReply, error)
+	GetReport(ctx context.Context, req *stepApi.GetReportRequest) (*stepApi.GetReportReply, error)
+	GetReports(ctx context.Context, req *stepApi.GetReportsRequest) (*stepApi.GetReportsReply, error)
+	DeleteReport(ctx context.Context, req *stepApi.DeleteReportRequest) (*stepApi.DeleteReportReply, error)
+	// StartReport 标记为生成中，报告已删除或已完成时返回nil
+	StartReport(ctx context.Context, reportID uint64) (*objects.ReportJob, error)
+	// CollectReport 目标树和打卡数、老师和家长的评论、媒体缩略图
+	CollectReport(ctx context.Context, job *objects.ReportJob) (*objects.ReportContent, error)
+	// FinishReport 渲染HTML和PDF并上传
+	FinishReport(ctx context.Context, job *objects.ReportJob, content *objects.ReportContent) error
+	FailReport(ctx context.Context, reportID uint64, reason error) error
+}
+
+type ReportUsecase struct {
+	repo             ReportRepo
+	asynqEnqueueRepo AsynqEnqueueRepo
+	log              *log.Helper
+}
+
+func NewReportUsecase(repo ReportRepo, asynqEnqueueRepo AsynqEnqueueRepo, logger log.Logger) *ReportUsecase {
+	return &ReportUsecase{
+		repo:             repo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		log:              log.NewHelper(logger, log.WithMessageKey("reportUsecase")),
+	}
+}
+
+func (uc *ReportUsecase) CreateReport(ctx context.Context, req *stepApi.CreateReportRequest) (*stepApi.CreateReportReply, error) {
+	_, _, err := objects.ReportDateRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+
+	if req.StatUnit == "" {
+		req.StatUnit = "week"
+	}
+	if _, err := stepRateUnitExpr(req.StatUnit); err != nil {
+		return nil, err
+	}
+
+	if len(req.MediaStepIds) > objects.ReportMaxMedia {
+		return nil, stepApi.ErrorInvalidArgument("too many media steps, max %d", objects.ReportMaxMedia)
+	}
+
+	reply, err := uc.repo.CreateReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueReportGenerate(ctx, reply.Report.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (uc *ReportUsecase) GetReport(ctx context.Context, req *stepApi.GetReportRequest) (*stepApi.GetReportReply, error) {
+	return uc.repo.GetReport(ctx, req)
+}
+
+func (uc *ReportUsecase) GetReports(ctx context.Context, req *stepApi.GetReportsRequest) (*stepApi.GetReportsReply, error) {
+	return uc.repo.GetReports(ctx, req)
+}
+
+func (uc *ReportUsecase) DeleteReport(ctx context.Context, req *stepApi.DeleteReportRequest) (*stepApi.DeleteReportReply, error) {
+	return uc.repo.DeleteReport(ctx, req)
+}
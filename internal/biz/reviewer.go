@@ -0,0 +1,109 @@
+package biz
+
+import (
+	"context"
+	"step/internal/objects"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type ReviewerRepo interface {
+	CreateReviewerInvite(ctx context.Context, req *stepApi.CreateReviewerInviteRequest) (*stepApi.CreateReviewerInviteReply, error)
+	AcceptReviewerInvite(ctx context.Context, req *stepApi.AcceptReviewerInviteRequest) (*stepApi.AcceptReviewerInviteReply, error)
+	GetReviewers(ctx context.Context, req *stepApi.GetReviewersRequest) (*stepApi.GetReviewersReply, error)
+	DeleteReviewer(ctx context.Context, req *stepApi.DeleteReviewerRequest) (*stepApi.DeleteReviewerReply, error)
+	GetReviewerInbox(ctx context.Context, req *stepApi.GetReviewerInboxRequest) (*stepApi.GetReviewerInboxReply, error)
+	SetReviewerComment(ctx context.Context, req *stepApi.SetReviewerCommentRequest) (*stepApi.SetReviewerCommentReply, error)
+	RequestReview(ctx context.Context, req *stepApi.RequestReviewRequest) (*stepApi.RequestReviewReply, error)
+	GetReviewRequests(ctx context.Context, req *stepApi.GetReviewRequestsRequest) (*stepApi.GetReviewRequestsReply, error)
+	// ReviewRequestNotification 不需要通知时返回nil
+	ReviewRequestNotification(ctx context.Context, id uint64) (*objects.ReviewNotification, error)
+	// ReviewRequestReminder 不需要提醒时返回nil
+	ReviewRequestReminder(ctx context.Context, id uint64) (*objects.ReviewNotification, error)
+	SetReviewRequestReminded(ctx context.Context, id uint64) error
+}
+
+// ReviewNotifier 评论请求的通知方式：日志、邮件或者webhook
+type ReviewNotifier interface {
+	Notify(ctx context.Context, notification *objects.ReviewNotification) error
+}
+
+type ReviewerUsecase struct {
+	repo             ReviewerRepo
+	asynqEnqueueRepo AsynqEnqueueRepo
+	log              *log.Helper
+}
+
+func NewReviewerUsecase(repo ReviewerRepo, asynqEnqueueRepo AsynqEnqueueRepo, logger log.Logger) *ReviewerUsecase {
+	return &ReviewerUsecase{
+		repo:             repo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		log:              log.NewHelper(logger, log.WithMessageKey("reviewerUsecase")),
+	}
+}
+
+func (uc *ReviewerUsecase) CreateReviewerInvite(ctx context.Context, req *stepApi.CreateReviewerInviteRequest) (*stepApi.CreateReviewerInviteReply, error) {
+	return uc.repo.CreateReviewerInvite(ctx, req)
+}
+
+func (uc *ReviewerUsecase) AcceptReviewerInvite(ctx context.Context, req *stepApi.AcceptReviewerInviteRequest) (*stepApi.AcceptReviewerInviteReply, error) {
+	return uc.repo.AcceptReviewerInvite(ctx, req)
+}
+
+func (uc *ReviewerUsecase) GetReviewers(ctx context.Context, req *stepApi.GetReviewersRequest) (*stepApi.GetReviewersReply, error) {
+	return uc.repo.GetReviewers(ctx, req)
+}
+
+func (uc *ReviewerUsecase) DeleteReviewer(ctx context.Context, req *stepApi.DeleteReviewerRequest) (*stepApi.DeleteReviewerReply, error) {
+	return uc.repo.DeleteReviewer(ctx, req)
+}
+
+func (uc *ReviewerUsecase) GetReviewerInbox(ctx context.Context, req *stepApi.GetReviewerInboxRequest) (*stepApi.GetReviewerInboxReply, error) {
+	return uc.repo.GetReviewerInbox(ctx, req)
+}
+
+// SetReviewerComment 和分享链接的评论一样，更新评分统计和索引
+func (uc *ReviewerUsecase) SetReviewerComment(ctx context.Context, req *stepApi.SetReviewerCommentRequest) (*stepApi.SetReviewerCommentReply, error) {
+	reply, err := uc.repo.SetReviewerComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueStepComment(ctx, reply.Id, reply.Role)
+	if err != nil {
+		uc.log.Errorf("EnqueueStepComment error: %v", err)
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueSearchIndex(ctx, 0, reply.Id)
+	if err != nil {
+		uc.log.Errorf("EnqueueSearchIndex error: %v", err)
+	}
+
+	return reply, nil
+}
+
+// RequestReview 通知在任务中发送，失败不影响请求，评论人仍然可以在收件箱或者通过分享链接看到
+func (uc *ReviewerUsecase) RequestReview(ctx context.Context, req *stepApi.RequestReviewRequest) (*stepApi.RequestReviewReply, error) {
+	reply, err := uc.repo.RequestReview(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueReviewRequestNotify(ctx, reply.Request.Id)
+	if err != nil {
+		uc.log.Errorf("EnqueueReviewRequestNotify error: %v", err)
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueReviewRequestRemind(ctx, reply.Request.Id, reply.Request.RemindAt)
+	if err != nil {
+		uc.log.Errorf("EnqueueReviewRequestRemind error: %v", err)
+	}
+
+	return reply, nil
+}
+
+func (uc *ReviewerUsecase) GetReviewRequests(ctx context.Context, req *stepApi.GetReviewRequestsRequest) (*stepApi.GetReviewRequestsReply, error) {
+	return uc.repo.GetReviewRequests(ctx, req)
+}
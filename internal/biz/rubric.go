@@ -0,0 +1,38 @@
+package biz
+
+import (
+	"context"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type RubricRepo interface {
+	// GetCommentRubric 注册的评分标准，version为空时为当前版本
+	GetCommentRubric(ctx context.Context, version string) (*stepApi.CommentRubric, error)
+	// GetStepRubric 积累所在顶层目标的评分标准，调用前需要校验访问权限
+	GetStepRubric(ctx context.Context, stepId uint64) (*stepApi.CommentRubric, error)
+	GetTargetRubric(ctx context.Context, req *stepApi.GetTargetRubricRequest) (*stepApi.GetTargetRubricReply, error)
+	SetTargetRubric(ctx context.Context, req *stepApi.SetTargetRubricRequest) (*stepApi.SetTargetRubricReply, error)
+}
+
+type RubricUsecase struct {
+	repo RubricRepo
+	log  *log.Helper
+}
+
+func NewRubricUsecase(repo RubricRepo, logger log.Logger) *RubricUsecase {
+	return &RubricUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger, log.WithMessageKey("rubricUsecase")),
+	}
+}
+
+func (uc *RubricUsecase) GetTargetRubric(ctx context.Context, req *stepApi.GetTargetRubricRequest) (*stepApi.GetTargetRubricReply, error) {
+	return uc.repo.GetTargetRubric(ctx, req)
+}
+
+func (uc *RubricUsecase) SetTargetRubric(ctx context.Context, req *stepApi.SetTargetRubricRequest) (*stepApi.SetTargetRubricReply, error) {
+	return uc.repo.SetTargetRubric(ctx, req)
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	stepApi "step/api/step/v1"
+	"step/internal/objects"
+	"step/internal/utils"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 搜索每页最多返回的数量
+const searchMaxPageSize = 50
+
+// SearchIndex 可替换的全文索引，默认为数据库全文索引(MySQL FULLTEXT / SQLite FTS5)，也可以使用内嵌的Bleve
+type SearchIndex interface {
+	// ReplaceTarget 用docs替换索引中该目标的全部文档，docs为空时删除
+	ReplaceTarget(ctx context.Context, targetID uint64, docs []*objects.SearchDocument) error
+	Search(ctx context.Context, query *objects.SearchQuery) (hits []*objects.SearchHit, total int, err error)
+}
+
+type SearchRepo interface {
+	// TargetDocuments 目标及其积累、评论的搜索文档，targetID为0时按stepID查找所属目标；
+	// 目标不存在时docs为空，所属目标无法确定时返回的targetID为0
+	TargetDocuments(ctx context.Context, targetID uint64, stepID uint64) (uint64, []*objects.SearchDocument, error)
+}
+
+type SearchUsecase struct {
+	index   SearchIndex
+	tagRepo TagRepo
+	log     *log.Helper
+}
+
+func NewSearchUsecase(index SearchIndex, tagRepo TagRepo, logger log.Logger) *SearchUsecase {
+	return &SearchUsecase{
+		index:   index,
+		tagRepo: tagRepo,
+		log:     log.NewHelper(logger, log.WithMessageKey("searchUsecase")),
+	}
+}
+
+func (uc *SearchUsecase) Search(ctx context.Context, req *stepApi.SearchRequest) (*stepApi.SearchReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, stepApi.ErrorInvalidArgument("query is required")
+	}
+
+	for _, kind := range req.Kinds {
+		if !objects.ValidSearchKind(kind) {
+			return nil, stepApi.ErrorInvalidArgument("invalid kind: %s", kind)
+		}
+	}
+
+	page := req.Page
+	if page == 0 {
+		page = 1
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 10
+	}
+	pageSize = min(pageSize, searchMaxPageSize)
+
+	searchQuery := &objects.SearchQuery{
+		UserID: uid,
+		Query:  query,
+		Kinds:  req.Kinds,
+		Offset: int((page - 1) * pageSize),
+		Limit:  int(pageSize),
+	}
+
+	if len(req.TagIds) > 0 {
+		targetIDs, stepIDs, err := uc.tagRepo.TaggedIDs(ctx, uid, req.TagIds)
+		if err != nil {
+			return nil, err
+		}
+		searchQuery.Tagged = &objects.SearchTagged{
+			TargetIDs: targetIDs,
+			StepIDs:   stepIDs,
+		}
+		if searchQuery.Tagged.Empty() {
+			return &stepApi.SearchReply{}, nil
+		}
+	}
+
+	hits, total, err := uc.index.Search(ctx, searchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &stepApi.SearchReply{
+		Total: uint64(total),
+		Hits:  make([]*stepApi.SearchHit, len(hits)),
+	}
+	for i, hit := range hits {
+		reply.Hits[i] = &stepApi.SearchHit{
+			Kind:        hit.Document.Kind,
+			TargetId:    hit.Document.TargetID,
+			StepId:      hit.Document.StepID,
+			CommentRole: hit.Document.CommentRole,
+			Title:       hit.Document.Title,
+			Snippet:     objects.SearchSnippet(hit.Document.Content, query),
+			Score:       hit.Score,
+			CreatedAt:   hit.Document.CreatedAt,
+		}
+	}
+
+	return reply, nil
+}
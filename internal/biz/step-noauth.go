@@ -2,20 +2,46 @@ package biz
 
 import (
 	"context"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
 
 	stepApi "step/api/step/v1"
+	"step/internal/data/ent"
+	"step/internal/objects"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/spf13/cast"
 )
 
 type EncryptRepo interface {
-	Encrypt(ctx context.Context, stepId uint64, data string) (string, error)
-	Decrypt(ctx context.Context, stepId uint64, data string) (string, error)
+	// Encrypt 生成分享链接签名的share_to，data为逗号分隔的评论角色，expiresAt为0时使用默认有效期
+	Encrypt(ctx context.Context, stepId uint64, data string, expiresAt int64) (string, error)
+	// VerifyShareToken 校验分享链接的share_to，返回分享的评论角色
+	VerifyShareToken(ctx context.Context, stepId uint64, token string) ([]string, error)
+	// NewCommentChallenge 签名的nonce和工作量证明的难度
+	NewCommentChallenge(ctx context.Context, stepId uint64) (*stepApi.GetCommentChallengeReply, error)
+	// VerifyCommentChallenge 校验签名、有效期和解，nonce只能使用一次
+	VerifyCommentChallenge(ctx context.Context, stepId uint64, nonce string, solution string) error
+}
+
+// NoauthGuardRepo 免登录接口的防滥用，多个实例通过Redis共享，Redis不可用时使用进程内的状态
+type NoauthGuardRepo interface {
+	// Allow 令牌桶，每分钟perMinute个令牌，最多积累burst个
+	Allow(ctx context.Context, key string, perMinute uint32, burst uint32) bool
+	// UseNonce 在ttl内第一次使用时返回true
+	UseNonce(ctx context.Context, nonce string, ttl time.Duration) bool
 }
 
 type StepNoauthRepo interface {
 	GetNoauthStep(ctx context.Context, req *stepApi.GetNoauthStepRequest) (*stepApi.GetNoauthStepReply, error)
 	SetCommentForStep(ctx context.Context, req *stepApi.SetCommentForStepRequest) (*stepApi.SetCommentForStepReply, error)
+	GetSharedStep(ctx context.Context, id uint64, childId uint64, shareTo string) (*ent.Step, error)
 }
 
 type StepNoauthUsecase struct {
@@ -23,18 +49,24 @@ type StepNoauthUsecase struct {
 	log              *log.Helper
 	encryptRepo      EncryptRepo
 	asynqEnqueueRepo AsynqEnqueueRepo
+	minioRepo        MinioRepo
+	rubricRepo       RubricRepo
 }
 
 func NewStepNoauthUsecase(
 	repo StepNoauthRepo,
 	encryptRepo EncryptRepo,
 	asynqEnqueueRepo AsynqEnqueueRepo,
+	minioRepo MinioRepo,
+	rubricRepo RubricRepo,
 	logger log.Logger,
 ) *StepNoauthUsecase {
 	return &StepNoauthUsecase{
 		repo:             repo,
 		encryptRepo:      encryptRepo,
 		asynqEnqueueRepo: asynqEnqueueRepo,
+		minioRepo:        minioRepo,
+		rubricRepo:       rubricRepo,
 		log:              log.NewHelper(logger, log.WithMessageKey("stepNoauthUsecase")),
 	}
 }
@@ -43,7 +75,57 @@ func (uc *StepNoauthUsecase) GetNoauthStep(ctx context.Context, req *stepApi.Get
 	return uc.repo.GetNoauthStep(ctx, req)
 }
 
+func (uc *StepNoauthUsecase) GetCommentChallenge(ctx context.Context, req *stepApi.GetCommentChallengeRequest) (*stepApi.GetCommentChallengeReply, error) {
+	_, err := uc.encryptRepo.VerifyShareToken(ctx, req.Id, req.ShareTo)
+	if err != nil {
+		return nil, err
+	}
+	return uc.encryptRepo.NewCommentChallenge(ctx, req.Id)
+}
+
+// GetCommentRubric 注册的评分标准是公开的，顶层目标的自定义评分标准需要分享链接
+func (uc *StepNoauthUsecase) GetCommentRubric(ctx context.Context, req *stepApi.GetCommentRubricRequest) (*stepApi.GetCommentRubricReply, error) {
+	var rubric *stepApi.CommentRubric
+	var err error
+	if req.Id > 0 {
+		_, err = uc.encryptRepo.VerifyShareToken(ctx, req.Id, req.ShareTo)
+		if err != nil {
+			return nil, err
+		}
+		rubric, err = uc.rubricRepo.GetStepRubric(ctx, req.Id)
+	} else {
+		rubric, err = uc.rubricRepo.GetCommentRubric(ctx, req.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetCommentRubricReply{
+		Rubric:         rubric,
+		CurrentVersion: objects.CurrentRubricVersion,
+	}, nil
+}
+
 func (uc *StepNoauthUsecase) SetCommentForStep(ctx context.Context, req *stepApi.SetCommentForStepRequest) (*stepApi.SetCommentForStepReply, error) {
+	// 老师和家长关联账号后通过SetReviewerComment评论，分享链接不能证明身份
+	if req.Type != objects.CommentRoleFriend {
+		return nil, stepApi.ErrorInvalidCommentType("%s must comment with a linked reviewer account", req.Type)
+	}
+
+	roles, err := uc.encryptRepo.VerifyShareToken(ctx, req.Id, req.ShareTo)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, req.Type) {
+		return nil, stepApi.ErrorInvalidShareToken("step is not shared to %s", req.Type)
+	}
+
+	// 先校验分享角色再消耗nonce
+	err = uc.encryptRepo.VerifyCommentChallenge(ctx, req.Id, req.Nonce, req.Solution)
+	if err != nil {
+		return nil, err
+	}
+
 	reply, err := uc.repo.SetCommentForStep(ctx, req)
 	if err != nil {
 		return nil, err
@@ -55,13 +137,74 @@ func (uc *StepNoauthUsecase) SetCommentForStep(ctx context.Context, req *stepApi
 		//return err
 	}
 
+	err = uc.asynqEnqueueRepo.EnqueueSearchIndex(ctx, 0, reply.Id)
+	if err != nil {
+		uc.log.Errorf("EnqueueSearchIndex error: %v", err)
+	}
+
 	return reply, nil
 }
 
 func (uc *StepNoauthUsecase) Decrypt(ctx context.Context, req *stepApi.DecryptRequest) (*stepApi.DecryptReply, error) {
-	decrypted, err := uc.encryptRepo.Decrypt(ctx, req.Id, req.Data)
+	roles, err := uc.encryptRepo.VerifyShareToken(ctx, req.Id, req.Data)
 	if err != nil {
 		return nil, err
 	}
-	return &stepApi.DecryptReply{Data: decrypted}, nil
+	return &stepApi.DecryptReply{Data: strings.Join(roles, ",")}, nil
+}
+
+// Download 通过分享链接下载积累文件，支持Range分段下载(文档、音视频)
+func (uc *StepNoauthUsecase) Download(ctx http.Context) error {
+	req := ctx.Request()
+	id := cast.ToUint64(ctx.Vars().Get("id"))
+	childId := cast.ToUint64(req.URL.Query().Get("child_id"))
+	shareTo := req.URL.Query().Get("share_to")
+	if id == 0 || shareTo == "" {
+		return stepApi.ErrorInvalidArgument("id and share_to are required")
+	}
+
+	step, err := uc.repo.GetSharedStep(ctx, id, childId, shareTo)
+	if err != nil {
+		return err
+	}
+
+	if step.ObjectName == "" {
+		return stepApi.ErrorInvalidArgument("step has no file")
+	}
+
+	object, err := uc.minioRepo.GetObject(ctx, step.ObjectName, req.Header.Get("Range"))
+	if err != nil {
+		return err
+	}
+	defer object.Body.Close()
+
+	contentType := step.MimeType
+	if contentType == "" {
+		contentType = object.ContentType
+	}
+
+	response := ctx.Response()
+	response.Header().Set("Content-Type", contentType)
+	response.Header().Set("Content-Length", fmt.Sprint(object.ContentLength))
+	response.Header().Set("Accept-Ranges", "bytes")
+	// 上传时的Content-Type由用户提供，不允许浏览器猜测类型；只有媒体和PDF直接打开，其余都作为附件下载
+	response.Header().Set("X-Content-Type-Options", "nosniff")
+	disposition := "attachment"
+	if objects.IsInlineMimeType(contentType) {
+		disposition = "inline"
+	}
+	response.Header().Set("Content-Disposition", objects.ContentDisposition(disposition, path.Base(step.ObjectName)))
+	if object.ContentRange != "" {
+		response.Header().Set("Content-Range", object.ContentRange)
+		response.WriteHeader(nethttp.StatusPartialContent)
+	} else {
+		response.WriteHeader(nethttp.StatusOK)
+	}
+
+	_, err = io.Copy(response, object.Body)
+	if err != nil {
+		uc.log.Errorf("Download %s error: %v", step.ObjectName, err)
+	}
+
+	return nil
 }
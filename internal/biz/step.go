@@ -2,7 +2,6 @@ package biz
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -24,6 +23,16 @@ type AsynqEnqueueRepo interface {
 	EnqueueTargetCreate(ctx context.Context, targetID uint64) error
 	EnqueueStepCreate(ctx context.Context, stepID uint64) error
 	EnqueueStepComment(ctx context.Context, stepID uint64, commentType string) error
+	EnqueueStepDocument(ctx context.Context, stepID uint64) error
+	EnqueueSearchIndex(ctx context.Context, targetID uint64, stepID uint64) error
+	EnqueueTargetExport(ctx context.Context, exportID uint64) error
+	EnqueueImport(ctx context.Context, importID uint64) error
+	EnqueueReportGenerate(ctx context.Context, reportID uint64) error
+	// EnqueueReviewRequestRemind processAt为Unix时间
+	EnqueueReviewRequestRemind(ctx context.Context, reviewRequestID uint64, processAt int64) error
+	EnqueueReviewRequestNotify(ctx context.Context, reviewRequestID uint64) error
+	EnqueueTargetMissedCheckin(ctx context.Context, date string) error
+	EnqueueTargetCheckinReminder(ctx context.Context, date string) error
 	EnqueueFeedbackPortraitChange(ctx context.Context, userID string, portraitChangeTypes []*objects.PortraitchangeType) error
 }
 
@@ -42,6 +51,27 @@ type StepRepo interface {
 	SetTargetStatusRecursively(ctx context.Context, targetID uint64, status entTarget.Status) error
 	GetTargetByStepIDRecursively(ctx context.Context, stepID uint64, rootStepID uint64) (*ent.Target, error)
 	GetTopTargetByTargetID(ctx context.Context, targetID uint64) (*ent.Target, error)
+	GetTargetCheckins(ctx context.Context, targetID uint64) ([]time.Time, error)
+	GetTargetAdherence(ctx context.Context, req *stepApi.GetTargetAdherenceRequest) (*stepApi.GetTargetAdherenceReply, error)
+	AddTargetMeasurementStep(ctx context.Context, req *stepApi.AddTargetMeasurementStepRequest) (*stepApi.AddTargetMeasurementStepReply, error)
+	CreateNoteStep(ctx context.Context, req *stepApi.CreateNoteStepRequest) (*stepApi.CreateNoteStepReply, error)
+	UpdateNoteStep(ctx context.Context, req *stepApi.UpdateNoteStepRequest) (*stepApi.UpdateNoteStepReply, error)
+	CreateLinkStep(ctx context.Context, req *stepApi.CreateLinkStepRequest) (*stepApi.CreateLinkStepReply, error)
+	UpdateLinkStep(ctx context.Context, req *stepApi.UpdateLinkStepRequest) (*stepApi.UpdateLinkStepReply, error)
+	RenameDirStep(ctx context.Context, req *stepApi.RenameDirStepRequest) (*stepApi.RenameDirStepReply, error)
+	MoveStep(ctx context.Context, req *stepApi.MoveStepRequest) (*stepApi.MoveStepReply, error)
+	ReorderTargets(ctx context.Context, req *stepApi.ReorderTargetsRequest) (*stepApi.ReorderTargetsReply, error)
+	ReorderSteps(ctx context.Context, req *stepApi.ReorderStepsRequest) (*stepApi.ReorderStepsReply, error)
+	// CloneTarget 返回新创建的所有目标ID
+	CloneTarget(ctx context.Context, req *stepApi.CloneTargetRequest) (*stepApi.CloneTargetReply, []uint64, error)
+	GetActivityTimeline(ctx context.Context, req *stepApi.GetActivityTimelineRequest) (*stepApi.GetActivityTimelineReply, error)
+	GetActivityHeatmap(ctx context.Context, req *stepApi.GetActivityHeatmapRequest) (*stepApi.GetActivityHeatmapReply, error)
+	SetSelfAssessment(ctx context.Context, req *stepApi.SetSelfAssessmentRequest) (*stepApi.SetSelfAssessmentReply, error)
+	GetSelfAssessmentComparison(ctx context.Context, req *stepApi.GetSelfAssessmentComparisonRequest) (*stepApi.GetSelfAssessmentComparisonReply, error)
+}
+
+type LinkRepo interface {
+	FetchLinkMeta(ctx context.Context, url string) (*objects.LinkMeta, error)
 }
 
 type StepUsecase struct {
@@ -74,11 +104,11 @@ func NewStepUsecase(
 func (uc *StepUsecase) Upload(ctx http.Context) error {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return errors.New("uid is empty")
+		return stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	req := ctx.Request()
-	file, _, err := req.FormFile("file")
+	file, fileHeader, err := req.FormFile("file")
 	if err != nil {
 		return err
 	}
@@ -86,7 +116,7 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 	targetID := req.FormValue("targetID")
 	parentID := req.FormValue("parentID")
 	if parentID == "" && targetID == "" {
-		return errors.New("parentID or targetID is required")
+		return stepApi.ErrorInvalidArgument("parentID or targetID is required")
 	}
 	targetIDUint64 := cast.ToUint64(targetID)
 	parentIDUint64 := cast.ToUint64(parentID)
@@ -107,12 +137,21 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 	}
 
 	if target.UserID != uid {
-		return errors.New("not owner")
+		return stepApi.ErrorNotOwner("not owner")
+	}
+
+	stepPath := objects.RootPath
+	if parentIDUint64 != 0 {
+		parentStep, err := uc.entClient.Step.Get(ctx, parentIDUint64)
+		if err != nil {
+			return err
+		}
+		stepPath = objects.ChildPath(parentStep.Path, parentStep.ID)
 	}
 
 	objectName := req.FormValue("objectName")
 	if objectName == "" {
-		return errors.New("objectName is required")
+		return stepApi.ErrorUploadRejected("objectName is required")
 	}
 	if targetIDUint64 != 0 {
 		objectName = fmt.Sprintf("%s/target_%d/%s", uid, targetIDUint64, objectName)
@@ -122,7 +161,21 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 
 	fileType := req.FormValue("fileType")
 	if fileType == "" {
-		return errors.New("fileType is required")
+		return stepApi.ErrorUploadRejected("fileType is required")
+	}
+
+	// 文档根据MIME类型判断，避免被标记为图片或视频
+	mimeType := objects.DetectMimeType(fileHeader.Filename, fileHeader.Header.Get("Content-Type"))
+	if objects.IsDocumentMimeType(mimeType) {
+		fileType = entStep.TypeDocument.String()
+	} else if fileType == entStep.TypeDocument.String() {
+		return stepApi.ErrorUploadRejected("document type %s is not allowed", mimeType)
+	}
+	// 上传只能创建文件类的积累，目录、笔记、链接等有各自的接口
+	switch entStep.Type(fileType) {
+	case entStep.TypeImage, entStep.TypeVideo, entStep.TypeAudio, entStep.TypeDocument:
+	default:
+		return stepApi.ErrorUploadRejected("fileType %s is not allowed", fileType)
 	}
 
 	isChallenge := req.FormValue("isChallenge")
@@ -138,6 +191,19 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 	title := req.FormValue("title")
 	description := req.FormValue("description")
 
+	// 计量目标可以在上传时同时记录计量值
+	var measurement float64
+	measurementStr := req.FormValue("measurement")
+	if measurementStr != "" {
+		if target.Mode != entTarget.ModeQuantity {
+			return stepApi.ErrorInvalidArgument("measurement is only for quantity target")
+		}
+		measurement, err = cast.ToFloat64E(measurementStr)
+		if err != nil {
+			return err
+		}
+	}
+
 	var stepTime int64
 	stepTimeStr := req.FormValue("stepTime")
 	if stepTimeStr != "" {
@@ -151,7 +217,7 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 		return err
 	}
 
-	stepCreate := uc.entClient.Step.Create()
+	stepCreate := uc.entClient.Step.Create().SetPath(stepPath)
 	if parentIDUint64 != 0 {
 		stepCreate.SetParentID(parentIDUint64)
 	}
@@ -165,6 +231,8 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 		SetType(entStep.Type(fileType)).
 		SetObjectName(objectName).
 		SetCreatedAt(stepTime).
+		SetMeasurement(measurement).
+		SetMimeType(mimeType).
 		Save(ctx)
 	if err != nil {
 		return err
@@ -198,6 +266,8 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 	resObj.Set(objectName, "objectName")
 	resObj.Set(presignedUrl, "presignedUrl")
 	resObj.Set(step.CreatedAt, "createdAt")
+	resObj.Set(step.Measurement, "measurement")
+	resObj.Set(step.MimeType, "mimeType")
 	response.Write(resObj.Bytes())
 
 	err = uc.asynqEnqueueRepo.EnqueueStepCreate(ctx, step.ID)
@@ -206,6 +276,16 @@ func (uc *StepUsecase) Upload(ctx http.Context) error {
 		//return err
 	}
 
+	uc.enqueueSearchIndex(ctx, target.ID, 0)
+
+	if step.Type == entStep.TypeDocument {
+		err = uc.asynqEnqueueRepo.EnqueueStepDocument(ctx, step.ID)
+		if err != nil {
+			uc.log.Errorf("EnqueueStepDocument error: %v", err)
+			//return err
+		}
+	}
+
 	return nil
 }
 
@@ -221,19 +301,46 @@ func (uc *StepUsecase) CreateTarget(ctx context.Context, req *stepApi.CreateTarg
 		//return err
 	}
 
+	uc.enqueueSearchIndex(ctx, reply.Id, 0)
+
 	return reply, nil
 }
 
+// enqueueSearchIndex 目标或积累变化后异步更新搜索索引，targetID为0时按stepID查找所属目标
+func (uc *StepUsecase) enqueueSearchIndex(ctx context.Context, targetID uint64, stepID uint64) {
+	err := uc.asynqEnqueueRepo.EnqueueSearchIndex(ctx, targetID, stepID)
+	if err != nil {
+		uc.log.Errorf("EnqueueSearchIndex error: %v", err)
+	}
+}
+
 func (uc *StepUsecase) UpdateTarget(ctx context.Context, req *stepApi.UpdateTargetRequest) (*stepApi.UpdateTargetReply, error) {
-	return uc.repo.UpdateTarget(ctx, req)
+	reply, err := uc.repo.UpdateTarget(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, req.Id, 0)
+
+	return reply, nil
 }
 
 func (uc *StepUsecase) GetTargets(ctx context.Context, req *stepApi.GetTargetsRequest) (*stepApi.GetTargetsReply, error) {
+	if !objects.ValidSort(req.Sort) {
+		return nil, stepApi.ErrorInvalidArgument("invalid sort: %s", req.Sort)
+	}
 	return uc.repo.GetTargets(ctx, req)
 }
 
 func (uc *StepUsecase) DeleteTarget(ctx context.Context, req *stepApi.DeleteTargetRequest) (*stepApi.DeleteTargetReply, error) {
-	return uc.repo.DeleteTarget(ctx, req)
+	reply, err := uc.repo.DeleteTarget(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, reply.Id, 0)
+
+	return reply, nil
 }
 
 func (uc *StepUsecase) DoneTarget(ctx context.Context, req *stepApi.DoneTargetRequest) (*stepApi.DoneTargetReply, error) {
@@ -241,33 +348,244 @@ func (uc *StepUsecase) DoneTarget(ctx context.Context, req *stepApi.DoneTargetRe
 }
 
 func (uc *StepUsecase) GetTarget(ctx context.Context, req *stepApi.GetTargetRequest) (*stepApi.GetTargetReply, error) {
+	if !objects.ValidSort(req.Sort) {
+		return nil, stepApi.ErrorInvalidArgument("invalid sort: %s", req.Sort)
+	}
 	return uc.repo.GetTarget(ctx, req)
 }
 
+func (uc *StepUsecase) GetActivityTimeline(ctx context.Context, req *stepApi.GetActivityTimelineRequest) (*stepApi.GetActivityTimelineReply, error) {
+	return uc.repo.GetActivityTimeline(ctx, req)
+}
+
+func (uc *StepUsecase) GetActivityHeatmap(ctx context.Context, req *stepApi.GetActivityHeatmapRequest) (*stepApi.GetActivityHeatmapReply, error) {
+	return uc.repo.GetActivityHeatmap(ctx, req)
+}
+
+// SetSelfAssessment 自评按较低的权重计入评分统计
+func (uc *StepUsecase) SetSelfAssessment(ctx context.Context, req *stepApi.SetSelfAssessmentRequest) (*stepApi.SetSelfAssessmentReply, error) {
+	reply, err := uc.repo.SetSelfAssessment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueStepComment(ctx, reply.Id, objects.CommentRoleStudent)
+	if err != nil {
+		uc.log.Errorf("EnqueueStepComment error: %v", err)
+	}
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) GetSelfAssessmentComparison(ctx context.Context, req *stepApi.GetSelfAssessmentComparisonRequest) (*stepApi.GetSelfAssessmentComparisonReply, error) {
+	return uc.repo.GetSelfAssessmentComparison(ctx, req)
+}
+
+func (uc *StepUsecase) GetTargetAdherence(ctx context.Context, req *stepApi.GetTargetAdherenceRequest) (*stepApi.GetTargetAdherenceReply, error) {
+	return uc.repo.GetTargetAdherence(ctx, req)
+}
+
+// afterStepCreate 积累创建之后，更新目标状态并触发统计
+func (uc *StepUsecase) afterStepCreate(ctx context.Context, targetID uint64, stepID uint64, isChallenge bool) error {
+	status := entTarget.StatusStep
+	if isChallenge {
+		status = entTarget.StatusStepHard
+	}
+
+	err := uc.repo.SetTargetStatusRecursively(ctx, targetID, status)
+	if err != nil {
+		return err
+	}
+
+	err = uc.asynqEnqueueRepo.EnqueueStepCreate(ctx, stepID)
+	if err != nil {
+		uc.log.Errorf("EnqueueStepCreate error: %v", err)
+		//return err
+	}
+
+	uc.enqueueSearchIndex(ctx, targetID, 0)
+
+	return nil
+}
+
+func (uc *StepUsecase) AddTargetMeasurementStep(ctx context.Context, req *stepApi.AddTargetMeasurementStepRequest) (*stepApi.AddTargetMeasurementStepReply, error) {
+	reply, err := uc.repo.AddTargetMeasurementStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.afterStepCreate(ctx, req.Id, reply.Id, req.IsChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) CreateNoteStep(ctx context.Context, req *stepApi.CreateNoteStepRequest) (*stepApi.CreateNoteStepReply, error) {
+	reply, err := uc.repo.CreateNoteStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.afterStepCreate(ctx, req.Id, reply.Id, req.IsChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) UpdateNoteStep(ctx context.Context, req *stepApi.UpdateNoteStepRequest) (*stepApi.UpdateNoteStepReply, error) {
+	reply, err := uc.repo.UpdateNoteStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, 0, req.Id)
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) CreateLinkStep(ctx context.Context, req *stepApi.CreateLinkStepRequest) (*stepApi.CreateLinkStepReply, error) {
+	reply, err := uc.repo.CreateLinkStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.afterStepCreate(ctx, req.Id, reply.Id, req.IsChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) UpdateLinkStep(ctx context.Context, req *stepApi.UpdateLinkStepRequest) (*stepApi.UpdateLinkStepReply, error) {
+	reply, err := uc.repo.UpdateLinkStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, 0, req.Id)
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) RenameDirStep(ctx context.Context, req *stepApi.RenameDirStepRequest) (*stepApi.RenameDirStepReply, error) {
+	reply, err := uc.repo.RenameDirStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, 0, req.Id)
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) MoveStep(ctx context.Context, req *stepApi.MoveStepRequest) (*stepApi.MoveStepReply, error) {
+	// 移动前的目标也需要更新索引
+	fromTarget, err := uc.repo.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := uc.repo.MoveStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromTarget.ID != reply.TargetId {
+		uc.enqueueSearchIndex(ctx, fromTarget.ID, 0)
+	}
+	uc.enqueueSearchIndex(ctx, reply.TargetId, 0)
+
+	return reply, nil
+}
+
+func (uc *StepUsecase) ReorderTargets(ctx context.Context, req *stepApi.ReorderTargetsRequest) (*stepApi.ReorderTargetsReply, error) {
+	return uc.repo.ReorderTargets(ctx, req)
+}
+
+func (uc *StepUsecase) ReorderSteps(ctx context.Context, req *stepApi.ReorderStepsRequest) (*stepApi.ReorderStepsReply, error) {
+	return uc.repo.ReorderSteps(ctx, req)
+}
+
+// CloneTarget 复制出的目标按新建目标统计，复制的积累不算新的积累
+func (uc *StepUsecase) CloneTarget(ctx context.Context, req *stepApi.CloneTargetRequest) (*stepApi.CloneTargetReply, error) {
+	reply, targetIDs, err := uc.repo.CloneTarget(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, targetID := range targetIDs {
+		err = uc.asynqEnqueueRepo.EnqueueTargetCreate(ctx, targetID)
+		if err != nil {
+			uc.log.Errorf("EnqueueTargetCreate error: %v", err)
+		}
+		uc.enqueueSearchIndex(ctx, targetID, 0)
+	}
+
+	return reply, nil
+}
+
 func (uc *StepUsecase) GetTargetTree(ctx context.Context, req *stepApi.GetTargetTreeRequest) (*stepApi.GetTargetTreeReply, error) {
+	if !objects.ValidSort(req.Sort) {
+		return nil, stepApi.ErrorInvalidArgument("invalid sort: %s", req.Sort)
+	}
 	return uc.repo.GetTargetTree(ctx, req)
 }
 
 func (uc *StepUsecase) AddTargetDirStep(ctx context.Context, req *stepApi.AddTargetDirStepRequest) (*stepApi.AddTargetDirStepReply, error) {
-	return uc.repo.AddTargetDirStep(ctx, req)
+	reply, err := uc.repo.AddTargetDirStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, req.Id, 0)
+
+	return reply, nil
 }
 
 func (uc *StepUsecase) GetTargetDirStepChildren(ctx context.Context, req *stepApi.GetTargetDirStepChildrenRequest) (*stepApi.GetTargetDirStepChildrenReply, error) {
+	if !objects.ValidSort(req.Sort) {
+		return nil, stepApi.ErrorInvalidArgument("invalid sort: %s", req.Sort)
+	}
 	return uc.repo.GetTargetDirStepChildren(ctx, req)
 }
 
 func (uc *StepUsecase) UpdateTargetStep(ctx context.Context, req *stepApi.UpdateTargetStepRequest) (*stepApi.UpdateTargetStepReply, error) {
-	return uc.repo.UpdateTargetStep(ctx, req)
+	reply, err := uc.repo.UpdateTargetStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, 0, req.Id)
+
+	return reply, nil
 }
 
 func (uc *StepUsecase) DeleteTargetStep(ctx context.Context, req *stepApi.DeleteTargetStepRequest) (*stepApi.DeleteTargetStepReply, error) {
-	return uc.repo.DeleteTargetStep(ctx, req)
+	// 删除后无法再查找所属目标
+	target, err := uc.repo.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := uc.repo.DeleteTargetStep(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.enqueueSearchIndex(ctx, target.ID, 0)
+
+	return reply, nil
 }
 
 func (uc *StepUsecase) Encrypt(ctx context.Context, req *stepApi.EncryptRequest) (*stepApi.EncryptReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	target, err := uc.repo.GetTargetByStepIDRecursively(ctx, req.Id, 0)
@@ -276,10 +594,10 @@ func (uc *StepUsecase) Encrypt(ctx context.Context, req *stepApi.EncryptRequest)
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, stepApi.ErrorNotOwner("not owner")
 	}
 
-	encrypted, err := uc.encryptRepo.Encrypt(ctx, req.Id, req.Data)
+	encrypted, err := uc.encryptRepo.Encrypt(ctx, req.Id, req.Data, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"context"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type TagRepo interface {
+	CreateTag(ctx context.Context, req *stepApi.CreateTagRequest) (*stepApi.CreateTagReply, error)
+	UpdateTag(ctx context.Context, req *stepApi.UpdateTagRequest) (*stepApi.UpdateTagReply, error)
+	DeleteTag(ctx context.Context, req *stepApi.DeleteTagRequest) (*stepApi.DeleteTagReply, error)
+	GetTags(ctx context.Context, req *stepApi.GetTagsRequest) (*stepApi.GetTagsReply, error)
+	AssignTag(ctx context.Context, req *stepApi.AssignTagRequest) (*stepApi.AssignTagReply, error)
+	UnassignTag(ctx context.Context, req *stepApi.UnassignTagRequest) (*stepApi.UnassignTagReply, error)
+	// TaggedIDs 用户带有任一标签的目标和积累
+	TaggedIDs(ctx context.Context, uid string, tagIDs []uint64) (targetIDs []uint64, stepIDs []uint64, err error)
+}
+
+type TagUsecase struct {
+	repo TagRepo
+	log  *log.Helper
+}
+
+func NewTagUsecase(repo TagRepo, logger log.Logger) *TagUsecase {
+	return &TagUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger, log.WithMessageKey("tagUsecase")),
+	}
+}
+
+func (uc *TagUsecase) CreateTag(ctx context.Context, req *stepApi.CreateTagRequest) (*stepApi.CreateTagReply, error) {
+	return uc.repo.CreateTag(ctx, req)
+}
+
+func (uc *TagUsecase) UpdateTag(ctx context.Context, req *stepApi.UpdateTagRequest) (*stepApi.UpdateTagReply, error) {
+	return uc.repo.UpdateTag(ctx, req)
+}
+
+func (uc *TagUsecase) DeleteTag(ctx context.Context, req *stepApi.DeleteTagRequest) (*stepApi.DeleteTagReply, error) {
+	return uc.repo.DeleteTag(ctx, req)
+}
+
+func (uc *TagUsecase) GetTags(ctx context.Context, req *stepApi.GetTagsRequest) (*stepApi.GetTagsReply, error) {
+	return uc.repo.GetTags(ctx, req)
+}
+
+func (uc *TagUsecase) AssignTag(ctx context.Context, req *stepApi.AssignTagRequest) (*stepApi.AssignTagReply, error) {
+	return uc.repo.AssignTag(ctx, req)
+}
+
+func (uc *TagUsecase) UnassignTag(ctx context.Context, req *stepApi.UnassignTagRequest) (*stepApi.UnassignTagReply, error) {
+	return uc.repo.UnassignTag(ctx, req)
+}
@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"context"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type TemplateRepo interface {
+	CreateTemplate(ctx context.Context, req *stepApi.CreateTemplateRequest) (*stepApi.CreateTemplateReply, error)
+	UpdateTemplate(ctx context.Context, req *stepApi.UpdateTemplateRequest) (*stepApi.UpdateTemplateReply, error)
+	DeleteTemplate(ctx context.Context, req *stepApi.DeleteTemplateRequest) (*stepApi.DeleteTemplateReply, error)
+	GetTemplates(ctx context.Context, req *stepApi.GetTemplatesRequest) (*stepApi.GetTemplatesReply, error)
+	GetTemplate(ctx context.Context, req *stepApi.GetTemplateRequest) (*stepApi.GetTemplateReply, error)
+	GetSharedTemplate(ctx context.Context, req *stepApi.GetSharedTemplateRequest) (*stepApi.GetSharedTemplateReply, error)
+	// InstantiateTemplate 返回新创建的所有目标ID
+	InstantiateTemplate(ctx context.Context, req *stepApi.InstantiateTemplateRequest) (*stepApi.InstantiateTemplateReply, []uint64, error)
+}
+
+type TemplateUsecase struct {
+	repo             TemplateRepo
+	asynqEnqueueRepo AsynqEnqueueRepo
+	log              *log.Helper
+}
+
+func NewTemplateUsecase(repo TemplateRepo, asynqEnqueueRepo AsynqEnqueueRepo, logger log.Logger) *TemplateUsecase {
+	return &TemplateUsecase{
+		repo:             repo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		log:              log.NewHelper(logger, log.WithMessageKey("templateUsecase")),
+	}
+}
+
+func (uc *TemplateUsecase) CreateTemplate(ctx context.Context, req *stepApi.CreateTemplateRequest) (*stepApi.CreateTemplateReply, error) {
+	return uc.repo.CreateTemplate(ctx, req)
+}
+
+func (uc *TemplateUsecase) UpdateTemplate(ctx context.Context, req *stepApi.UpdateTemplateRequest) (*stepApi.UpdateTemplateReply, error) {
+	return uc.repo.UpdateTemplate(ctx, req)
+}
+
+func (uc *TemplateUsecase) DeleteTemplate(ctx context.Context, req *stepApi.DeleteTemplateRequest) (*stepApi.DeleteTemplateReply, error) {
+	return uc.repo.DeleteTemplate(ctx, req)
+}
+
+func (uc *TemplateUsecase) GetTemplates(ctx context.Context, req *stepApi.GetTemplatesRequest) (*stepApi.GetTemplatesReply, error) {
+	return uc.repo.GetTemplates(ctx, req)
+}
+
+func (uc *TemplateUsecase) GetTemplate(ctx context.Context, req *stepApi.GetTemplateRequest) (*stepApi.GetTemplateReply, error) {
+	return uc.repo.GetTemplate(ctx, req)
+}
+
+func (uc *TemplateUsecase) GetSharedTemplate(ctx context.Context, req *stepApi.GetSharedTemplateRequest) (*stepApi.GetSharedTemplateReply, error) {
+	return uc.repo.GetSharedTemplate(ctx, req)
+}
+
+// InstantiateTemplate 和CreateTarget一样，新目标需要统计和建立索引
+func (uc *TemplateUsecase) InstantiateTemplate(ctx context.Context, req *stepApi.InstantiateTemplateRequest) (*stepApi.InstantiateTemplateReply, error) {
+	reply, targetIDs, err := uc.repo.InstantiateTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, targetID := range targetIDs {
+		err = uc.asynqEnqueueRepo.EnqueueTargetCreate(ctx, targetID)
+		if err != nil {
+			uc.log.Errorf("EnqueueTargetCreate error: %v", err)
+		}
+		err = uc.asynqEnqueueRepo.EnqueueSearchIndex(ctx, targetID, 0)
+		if err != nil {
+			uc.log.Errorf("EnqueueSearchIndex error: %v", err)
+		}
+	}
+
+	return reply, nil
+}
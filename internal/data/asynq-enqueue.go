@@ -3,8 +3,11 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"step/internal/biz"
 	"step/internal/objects"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
@@ -69,6 +72,197 @@ func (r *asynqEnqueueRepo) EnqueueStepComment(ctx context.Context, stepID uint64
 	return err
 }
 
+func (r *asynqEnqueueRepo) EnqueueStepDocument(ctx context.Context, stepID uint64) error {
+	payload := objects.StepDocumentPayload{
+		StepID: stepID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeStepDocument, jsonPayload)
+
+	_, err = r.data.asynq_client.Enqueue(task, asynq.Queue(objects.QueueLow))
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueSearchIndex(ctx context.Context, targetID uint64, stepID uint64) error {
+	payload := objects.SearchIndexPayload{
+		TargetID: targetID,
+		StepID:   stepID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeSearchIndex, jsonPayload)
+
+	// 延迟处理并去重，处理时读取的是最新的数据
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueLow),
+		asynq.ProcessIn(objects.SearchIndexDebounce),
+		asynq.Unique(objects.SearchIndexDebounce),
+	)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		return nil
+	}
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueTargetExport(ctx context.Context, exportID uint64) error {
+	payload := objects.TargetExportPayload{
+		ExportID: exportID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeTargetExport, jsonPayload)
+
+	// 以导出ID作为任务ID，同一个导出只会入队一次
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueLow),
+		asynq.TaskID(fmt.Sprintf("%s:%d", objects.TypeTargetExport, exportID)),
+		asynq.Timeout(objects.TargetExportTimeout),
+		asynq.MaxRetry(objects.TargetExportMaxRetry),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueReportGenerate(ctx context.Context, reportID uint64) error {
+	payload := objects.ReportGeneratePayload{
+		ReportID: reportID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeReportGenerate, jsonPayload)
+
+	// 以报告ID作为任务ID，同一个报告只会入队一次
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueLow),
+		asynq.TaskID(fmt.Sprintf("%s:%d", objects.TypeReportGenerate, reportID)),
+		asynq.Timeout(objects.ReportGenerateTimeout),
+		asynq.MaxRetry(objects.ReportGenerateMaxRetry),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueReviewRequestRemind(ctx context.Context, reviewRequestID uint64, processAt int64) error {
+	payload := objects.ReviewRequestRemindPayload{
+		ReviewRequestID: reviewRequestID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeReviewRequestRemind, jsonPayload)
+
+	// 同一个请求的同一个时间点只入队一次
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueLow),
+		asynq.ProcessAt(time.Unix(processAt, 0)),
+		asynq.Timeout(objects.ReviewNotifyTaskTimeout),
+		asynq.TaskID(fmt.Sprintf("%s:%d:%d", objects.TypeReviewRequestRemind, reviewRequestID, processAt)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueReviewRequestNotify(ctx context.Context, reviewRequestID uint64) error {
+	payload := objects.ReviewRequestNotifyPayload{
+		ReviewRequestID: reviewRequestID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeReviewRequestNotify, jsonPayload)
+
+	// 每个请求只发送一次，发送超时时重试
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueDefault),
+		asynq.Timeout(objects.ReviewNotifyTaskTimeout),
+		asynq.TaskID(fmt.Sprintf("%s:%d", objects.TypeReviewRequestNotify, reviewRequestID)),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueTargetMissedCheckin(ctx context.Context, date string) error {
+	payload := objects.TargetMissedCheckinPayload{
+		Date: date,
+	}
+	return r.enqueueDaily(objects.TypeTargetMissedCheckin, payload, date)
+}
+
+func (r *asynqEnqueueRepo) EnqueueTargetCheckinReminder(ctx context.Context, date string) error {
+	payload := objects.TargetCheckinReminderPayload{
+		Date: date,
+	}
+	return r.enqueueDaily(objects.TypeTargetCheckinReminder, payload, date)
+}
+
+// enqueueDaily 以类型和日期作为任务ID，多个实例的定时器同时触发时每天只执行一次
+func (r *asynqEnqueueRepo) enqueueDaily(typename string, payload any, date string) error {
+	task, err := newPayloadTask(typename, payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueLow),
+		asynq.TaskID(fmt.Sprintf("%s:%s", typename, date)),
+		asynq.Retention(objects.CheckinTaskRetention),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+func (r *asynqEnqueueRepo) EnqueueImport(ctx context.Context, importID uint64) error {
+	payload := objects.ImportProcessPayload{
+		ImportID: importID,
+	}
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(objects.TypeImportProcess, jsonPayload)
+
+	// 恢复导入时会再次入队，不能用固定的任务ID(失败归档的任务会一直占用)，
+	// 用唯一锁保证同一个导入不会同时处理
+	_, err = r.data.asynq_client.Enqueue(task,
+		asynq.Queue(objects.QueueLow),
+		asynq.Unique(objects.ImportProcessUnique),
+		asynq.Timeout(objects.ImportProcessTimeout),
+		asynq.MaxRetry(objects.ImportProcessMaxRetry),
+	)
+	if errors.Is(err, asynq.ErrDuplicateTask) {
+		return nil
+	}
+	return err
+}
+
 func (r *asynqEnqueueRepo) EnqueueFeedbackPortraitChange(
 	ctx context.Context,
 	userID string,
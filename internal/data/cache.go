@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"encoding/binary"
+	"step/internal/biz"
+	"step/internal/objects"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const cacheKeyPrefix = "step:cache:"
+
+// cacheRepo 用户缓存按类型存在一个hash中(step:cache:<kind>:<uid>)，失效时删除整个hash；
+// 值前8字节为写入时间，读取时超过有效期视为未命中，hash的过期时间只用于回收内存
+type cacheRepo struct {
+	redisBreaker
+}
+
+func newCacheRepo(client *redis.Client, logger log.Logger) *cacheRepo {
+	r := &cacheRepo{}
+	r.client = client
+	r.log = log.NewHelper(logger, log.WithMessageKey("cacheRepo"))
+	return r
+}
+
+// NewCacheRepo .
+func NewCacheRepo(data *Data) biz.CacheRepo {
+	return data.cache
+}
+
+func userCacheKey(uid string, kind string) string {
+	return cacheKeyPrefix + kind + ":" + uid
+}
+
+func presignedUrlCacheKey(objectName string) string {
+	return cacheKeyPrefix + "presigned_url:" + objectName
+}
+
+// available 没有缓存时(如基准测试)视为不可用
+func (r *cacheRepo) available() bool {
+	return r != nil && r.redisBreaker.available()
+}
+
+func (r *cacheRepo) hget(ctx context.Context, key string, field string, ttl time.Duration) ([]byte, bool) {
+	if !r.available() {
+		return nil, false
+	}
+
+	b, err := r.client.HGet(ctx, key, field).Bytes()
+	if err != nil {
+		r.fail(err)
+		return nil, false
+	}
+	if len(b) < 8 {
+		return nil, false
+	}
+
+	writtenAt := time.Unix(0, int64(binary.BigEndian.Uint64(b[:8])))
+	if time.Since(writtenAt) > ttl {
+		return nil, false
+	}
+	return b[8:], true
+}
+
+func (r *cacheRepo) hset(ctx context.Context, key string, field string, value []byte, ttl time.Duration) {
+	if !r.available() {
+		return
+	}
+
+	b := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(value)), uint64(time.Now().UnixNano()))
+	b = append(b, value...)
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, field, b)
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	r.fail(err)
+}
+
+func (r *cacheRepo) del(ctx context.Context, keys ...string) {
+	if !r.available() || len(keys) == 0 {
+		return
+	}
+
+	err := r.client.Del(ctx, keys...).Err()
+	r.fail(err)
+}
+
+func (r *cacheRepo) GetUserCache(ctx context.Context, uid string, kind string, field string, value proto.Message) bool {
+	if uid == "" || field == "" {
+		return false
+	}
+
+	b, ok := r.hget(ctx, userCacheKey(uid, kind), field, objects.UserCacheTTL)
+	if !ok {
+		return false
+	}
+
+	err := proto.Unmarshal(b, value)
+	if err != nil {
+		r.log.Warnf("unmarshal %s cache of %s error: %v", kind, uid, err)
+		return false
+	}
+	return true
+}
+
+func (r *cacheRepo) SetUserCache(ctx context.Context, uid string, kind string, field string, value proto.Message) {
+	if uid == "" || field == "" || !r.available() {
+		return
+	}
+
+	b, err := proto.Marshal(value)
+	if err != nil {
+		r.log.Warnf("marshal %s cache of %s error: %v", kind, uid, err)
+		return
+	}
+	r.hset(ctx, userCacheKey(uid, kind), field, b, objects.UserCacheTTL)
+}
+
+func (r *cacheRepo) InvalidateUserCache(ctx context.Context, uid string, kinds ...string) {
+	if uid == "" {
+		return
+	}
+
+	keys := make([]string, len(kinds))
+	for i, kind := range kinds {
+		keys[i] = userCacheKey(uid, kind)
+	}
+	r.del(ctx, keys...)
+}
+
+// GetPresignedUrl 同一个对象在不同endpoint下的地址是hash中的不同字段
+func (r *cacheRepo) GetPresignedUrl(ctx context.Context, objectName string, endpoint string) (string, bool) {
+	b, ok := r.hget(ctx, presignedUrlCacheKey(objectName), endpoint, objects.PresignedUrlCacheTTL)
+	if !ok {
+		return "", false
+	}
+	return string(b), true
+}
+
+func (r *cacheRepo) SetPresignedUrl(ctx context.Context, objectName string, endpoint string, url string) {
+	r.hset(ctx, presignedUrlCacheKey(objectName), endpoint, []byte(url), objects.PresignedUrlCacheTTL)
+}
+
+func (r *cacheRepo) InvalidatePresignedUrls(ctx context.Context, objectNames ...string) {
+	keys := make([]string, len(objectNames))
+	for i, objectName := range objectNames {
+		keys[i] = presignedUrlCacheKey(objectName)
+	}
+	r.del(ctx, keys...)
+}
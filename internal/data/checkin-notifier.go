@@ -0,0 +1,105 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"step/internal/biz"
+	"step/internal/conf"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewCheckinNotifier 根据配置选择打卡提醒的通知方式，默认只记录日志
+func NewCheckinNotifier(c *conf.Data, logger log.Logger) (biz.CheckinNotifier, error) {
+	checkin := c.GetCheckin()
+	notifier := objects.CheckinNotifierLog
+	if checkin.GetNotifier() != "" {
+		notifier = checkin.GetNotifier()
+	}
+
+	switch notifier {
+	case objects.CheckinNotifierLog:
+		return newLogCheckinNotifier(logger), nil
+	case objects.CheckinNotifierWebhook:
+		if checkin.GetWebhook().GetUrl() == "" {
+			return nil, fmt.Errorf("webhook url is required for checkin notifier %s", notifier)
+		}
+		return newWebhookCheckinNotifier(checkin.Webhook, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported checkin notifier: %s", notifier)
+	}
+}
+
+// logCheckinNotifier 只记录日志
+type logCheckinNotifier struct {
+	log *log.Helper
+}
+
+func newLogCheckinNotifier(logger log.Logger) *logCheckinNotifier {
+	return &logCheckinNotifier{
+		log: log.NewHelper(logger, log.WithMessageKey("logCheckinNotifier")),
+	}
+}
+
+func (n *logCheckinNotifier) NotifyCheckinReminder(ctx context.Context, notification *objects.CheckinReminderNotification) error {
+	for _, reminder := range notification.Reminders {
+		n.log.Infof("checkin reminder %s: user %s target %d(%s)",
+			notification.Date, reminder.UserID, reminder.TargetID, reminder.Title)
+	}
+	return nil
+}
+
+// webhookCheckinNotifier 一天的提醒以JSON发送到配置的地址，由接收方按User ID转发
+type webhookCheckinNotifier struct {
+	log    *log.Helper
+	conf   *conf.Data_Checkin_Webhook
+	client *http.Client
+}
+
+func newWebhookCheckinNotifier(c *conf.Data_Checkin_Webhook, logger log.Logger) *webhookCheckinNotifier {
+	timeout := c.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = objects.CheckinWebhookTimeout
+	}
+	return &webhookCheckinNotifier{
+		log:    log.NewHelper(logger, log.WithMessageKey("webhookCheckinNotifier")),
+		conf:   c,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *webhookCheckinNotifier) NotifyCheckinReminder(ctx context.Context, notification *objects.CheckinReminderNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.conf.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.conf.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(n.conf.Secret))
+		mac.Write(body)
+		req.Header.Set(objects.CheckinSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("checkin webhook responded %s", resp.Status)
+	}
+	return nil
+}
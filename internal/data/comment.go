@@ -0,0 +1,540 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
+	"step/internal/data/ent/predicate"
+	entReviewer "step/internal/data/ent/reviewer"
+	entStep "step/internal/data/ent/step"
+	"step/internal/objects"
+	"step/internal/utils"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type commentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCommentRepo .
+func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
+	return &commentRepo{
+		data: data,
+		log:  log.NewHelper(logger, log.WithMessageKey("commentRepo")),
+	}
+}
+
+// parseRating 评分需要符合积累所在顶层目标的评分标准，原样保存提交的json
+func parseRating(rating string, targetRubric *objects.TargetRubric) (map[string]any, error) {
+	ratingMap := make(map[string]any)
+	err := json.Unmarshal([]byte(rating), &ratingMap)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("invalid comment: %v", err)
+	}
+	_, err = objects.ParseTargetRating(ratingMap, targetRubric)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("invalid comment: %v", err)
+	}
+	return ratingMap, nil
+}
+
+func toApiComment(comment *ent.Comment) (*stepApi.Comment, error) {
+	var rating []byte
+	var err error
+	if comment.Rating != nil {
+		rating, err = json.Marshal(comment.Rating)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	history := make([]*stepApi.CommentRevision, len(comment.History))
+	for i, revision := range comment.History {
+		var revisionRating []byte
+		if revision.Rating != nil {
+			revisionRating, err = json.Marshal(revision.Rating)
+			if err != nil {
+				return nil, err
+			}
+		}
+		history[i] = &stepApi.CommentRevision{
+			Rating:    string(revisionRating),
+			Content:   revision.Content,
+			UpdatedAt: revision.UpdatedAt,
+		}
+	}
+
+	return &stepApi.Comment{
+		Id:        comment.ID,
+		StepId:    comment.StepID,
+		AuthorId:  comment.AuthorID,
+		Role:      comment.Role.String(),
+		Rating:    string(rating),
+		Content:   comment.Content,
+		ParentId:  comment.ParentID,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		History:   history,
+	}, nil
+}
+
+// commentAuthor 分享链接的朋友评论没有作者
+func commentAuthor(authorId string) predicate.Comment {
+	if authorId == "" {
+		return entComment.AuthorIDIsNil()
+	}
+	return entComment.AuthorID(authorId)
+}
+
+// commentThread 顶层评论和它按时间先后的回复
+type commentThread struct {
+	comment *ent.Comment
+	replies []*ent.Comment
+}
+
+// text 评分中的文字、评论内容和回复内容
+func (t *commentThread) text() string {
+	texts := []string{objects.CommentText(t.comment.Rating), t.comment.Content}
+	for _, reply := range t.replies {
+		texts = append(texts, reply.Content)
+	}
+	return joinSearchText(texts...)
+}
+
+// stepCommentThreads 按积累分组的评论，顶层评论按时间先后
+func stepCommentThreads(ctx context.Context, client *ent.Client, stepIds []uint64) (map[uint64][]*commentThread, error) {
+	threads := make(map[uint64][]*commentThread)
+	if len(stepIds) == 0 {
+		return threads, nil
+	}
+
+	comments, err := client.Comment.Query().
+		Where(entComment.StepIDIn(stepIds...)).
+		Order(ent.Asc(entComment.FieldCreatedAt), ent.Asc(entComment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tops := make(map[uint64]*commentThread)
+	for _, comment := range comments {
+		if comment.ParentID == 0 {
+			thread := &commentThread{comment: comment}
+			tops[comment.ID] = thread
+			threads[comment.StepID] = append(threads[comment.StepID], thread)
+		}
+	}
+	for _, comment := range comments {
+		if thread, ok := tops[comment.ParentID]; ok && comment.ParentID != 0 {
+			thread.replies = append(thread.replies, comment)
+		}
+	}
+	return threads, nil
+}
+
+// setStepRating 积累上的评论字段已废弃，只为兼容Step接口中的teacher_comment等字段保存该角色最新的评分；
+// 统计、报告、搜索、导出和筛选都读取comments表
+func setStepRating(update *ent.StepUpdateOne, role string, rating map[string]any, authorId string) {
+	switch role {
+	case objects.CommentRoleTeacher:
+		update.SetTeacherComment(rating).SetTeacherReviewerID(authorId)
+	case objects.CommentRoleParent:
+		update.SetParentComment(rating).SetParentReviewerID(authorId)
+	case objects.CommentRoleFriend:
+		update.SetFriendComment(rating)
+	}
+}
+
+// createRatedComment 每个作者对同一个积累只能评分一次，之后通过UpdateComment修改；
+// 朋友没有账号，authorId为空，持有分享链接的所有朋友共用一个评分，先提交的生效；积累所有者的评分是自评
+func createRatedComment(ctx context.Context, client *ent.Client, logger *log.Helper, stepId uint64, role string, rating string, content string, authorId string) (uint64, error) {
+	if !objects.ValidRatingRole(role) {
+		return 0, stepApi.ErrorInvalidCommentType("invalid comment type")
+	}
+
+	targetRubric, err := stepTargetRubric(ctx, client, stepId)
+	if err != nil {
+		return 0, err
+	}
+
+	ratingMap, err := parseRating(rating, targetRubric)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rollback := func(err error) (uint64, error) {
+		if rerr := tx.Rollback(); rerr != nil {
+			logger.Errorf("rollback error: %v", rerr)
+		}
+		return 0, err
+	}
+
+	// 锁住积累后再检查，同一作者并发提交时只有一个能创建
+	_, err = tx.Step.Query().Where(entStep.ID(stepId)).Modify(lockForUpdate).Only(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+	exist, err := tx.Comment.Query().
+		Where(
+			entComment.StepID(stepId),
+			entComment.RoleEQ(entComment.Role(role)),
+			entComment.ParentIDIsNil(),
+			commentAuthor(authorId),
+		).
+		Exist(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+	if exist {
+		return rollback(stepApi.ErrorCommentAlreadyExists("step already has %s comment", role))
+	}
+
+	createComment := tx.Comment.Create().
+		SetStepID(stepId).
+		SetRole(entComment.Role(role)).
+		SetRating(ratingMap).
+		SetContent(content)
+	if authorId != "" {
+		createComment.SetAuthorID(authorId)
+	}
+	comment, err := createComment.Save(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+
+	updateStep := tx.Step.UpdateOneID(stepId)
+	setStepRating(updateStep, role, ratingMap, authorId)
+	err = updateStep.Exec(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+
+	err = answerReviewRequests(ctx, tx, stepId, role, authorId, comment.ID)
+	if err != nil {
+		return rollback(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return comment.ID, nil
+}
+
+// stepCommentRole 积累所有者为student，关联的老师或家长为关联的角色，其他用户不能查看和回复
+func stepCommentRole(ctx context.Context, client *ent.Client, stepId uint64, uid string) (string, error) {
+	step, err := client.Step.Query().Where(entStep.ID(stepId)).WithTarget().First(ctx)
+	if err != nil {
+		return "", err
+	}
+	if step.Edges.Target == nil {
+		return "", stepApi.ErrorTargetNotFound("can't find target of this step")
+	}
+
+	return studentViewerRole(ctx, client, step.Edges.Target.UserID, uid)
+}
+
+// studentViewerRole 学生本人为student，关联的老师或家长为关联的角色，其他用户返回NOT_REVIEWER
+func studentViewerRole(ctx context.Context, client *ent.Client, studentId string, uid string) (string, error) {
+	if studentId == uid {
+		return objects.CommentRoleStudent, nil
+	}
+
+	reviewer, err := client.Reviewer.Query().
+		Where(
+			entReviewer.StudentID(studentId),
+			entReviewer.ReviewerID(uid),
+			entReviewer.StatusEQ(entReviewer.StatusActive),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", stepApi.ErrorNotReviewer("not a reviewer of the step owner")
+		}
+		return "", err
+	}
+
+	return reviewer.Role.String(), nil
+}
+
+func (r *commentRepo) GetStepComments(ctx context.Context, req *stepApi.GetStepCommentsRequest) (*stepApi.GetStepCommentsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	_, err := stepCommentRole(ctx, r.data.ent_client, req.Id, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := r.data.ent_client.Comment.Query().
+		Where(entComment.StepID(req.Id)).
+		Order(ent.Asc(entComment.FieldCreatedAt), ent.Asc(entComment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	topComments := make([]*stepApi.Comment, 0)
+	topByID := make(map[uint64]*stepApi.Comment)
+	replies := make([]*stepApi.Comment, 0)
+	for _, comment := range comments {
+		commentApi, err := toApiComment(comment)
+		if err != nil {
+			return nil, err
+		}
+		if comment.ParentID == 0 {
+			topComments = append(topComments, commentApi)
+			topByID[comment.ID] = commentApi
+		} else {
+			replies = append(replies, commentApi)
+		}
+	}
+	for _, reply := range replies {
+		if parent, ok := topByID[reply.ParentId]; ok {
+			parent.Replies = append(parent.Replies, reply)
+		}
+	}
+
+	return &stepApi.GetStepCommentsReply{
+		Comments: topComments,
+	}, nil
+}
+
+// ReplyComment 回复只有一层，挂在顶层评论下
+func (r *commentRepo) ReplyComment(ctx context.Context, req *stepApi.ReplyCommentRequest) (*stepApi.ReplyCommentReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	parent, err := r.data.ent_client.Comment.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ParentID != 0 {
+		return nil, stepApi.ErrorInvalidArgument("can only reply to a top-level comment")
+	}
+
+	role, err := stepCommentRole(ctx, r.data.ent_client, parent.StepID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := r.data.ent_client.Comment.Create().
+		SetStepID(parent.StepID).
+		SetAuthorID(uid).
+		SetRole(entComment.Role(role)).
+		SetContent(req.Content).
+		SetParentID(parent.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commentApi, err := toApiComment(comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.ReplyCommentReply{
+		Comment: commentApi,
+	}, nil
+}
+
+func sameRating(a map[string]any, b map[string]any) bool {
+	jsonA, errA := json.Marshal(a)
+	jsonB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(jsonA, jsonB)
+}
+
+// UpdateComment 只有作者可以修改，修改前的版本追加到历史中。评分变化时返回true
+func (r *commentRepo) UpdateComment(ctx context.Context, req *stepApi.UpdateCommentRequest) (*stepApi.UpdateCommentReply, bool, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, false, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if req.Content == nil && req.Rating == nil {
+		return nil, false, stepApi.ErrorInvalidArgument("content or rating is required")
+	}
+
+	comment, err := r.data.ent_client.Comment.Get(ctx, req.Id)
+	if err != nil {
+		return nil, false, err
+	}
+	if comment.AuthorID == "" || comment.AuthorID != uid {
+		return nil, false, stepApi.ErrorNotOwner("not author")
+	}
+
+	// 解除关联后不能再修改
+	_, err = stepCommentRole(ctx, r.data.ent_client, comment.StepID, uid)
+	if err != nil {
+		return nil, false, err
+	}
+
+	content := comment.Content
+	if req.Content != nil {
+		content = req.Content.Value
+	}
+	rating := comment.Rating
+	if req.Rating != nil {
+		if comment.ParentID != 0 || comment.Rating == nil {
+			return nil, false, stepApi.ErrorInvalidArgument("only rated top-level comments can change rating")
+		}
+		targetRubric, err := stepTargetRubric(ctx, r.data.ent_client, comment.StepID)
+		if err != nil {
+			return nil, false, err
+		}
+		rating, err = parseRating(req.Rating.Value, targetRubric)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	ratingChanged := !sameRating(rating, comment.Rating)
+	if !ratingChanged && content == comment.Content {
+		commentApi, err := toApiComment(comment)
+		if err != nil {
+			return nil, false, err
+		}
+		return &stepApi.UpdateCommentReply{Comment: commentApi}, false, nil
+	}
+
+	history := append(comment.History, objects.CommentRevision{
+		Rating:    comment.Rating,
+		Content:   comment.Content,
+		UpdatedAt: comment.UpdatedAt,
+	})
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	rollback := func(err error) (*stepApi.UpdateCommentReply, bool, error) {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("rollback error: %v", rerr)
+		}
+		return nil, false, err
+	}
+
+	updateComment := tx.Comment.UpdateOneID(comment.ID).
+		SetContent(content).
+		SetHistory(history).
+		SetUpdatedAt(time.Now().Local().Unix())
+	if rating != nil {
+		updateComment.SetRating(rating)
+	}
+	comment, err = updateComment.Save(ctx)
+	if err != nil {
+		return rollback(err)
+	}
+
+	if ratingChanged {
+		updateStep := tx.Step.UpdateOneID(comment.StepID)
+		setStepRating(updateStep, comment.Role.String(), rating, comment.AuthorID)
+		err = updateStep.Exec(ctx)
+		if err != nil {
+			return rollback(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	commentApi, err := toApiComment(comment)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &stepApi.UpdateCommentReply{
+		Comment: commentApi,
+	}, ratingChanged, nil
+}
+
+// backfillComments 把评论实体之前保存在积累上的评分转换为评论，已经有评论时不做处理
+func backfillComments(ctx context.Context, client *ent.Client, logger *log.Helper) (int, error) {
+	exist, err := client.Comment.Query().Exist(ctx)
+	if err != nil || exist {
+		return 0, err
+	}
+
+	steps, err := client.Step.Query().
+		Where(entStep.Or(
+			entStep.TeacherCommentNotNil(),
+			entStep.ParentCommentNotNil(),
+			entStep.FriendCommentNotNil(),
+		)).
+		All(ctx)
+	if err != nil || len(steps) == 0 {
+		return 0, err
+	}
+
+	// 在一个事务中完成，中断后下次启动重新转换
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	creates := make([]*ent.CommentCreate, 0, len(steps))
+	for _, step := range steps {
+		ratings := []struct {
+			role     entComment.Role
+			rating   map[string]any
+			authorId string
+		}{
+			{entComment.RoleTeacher, step.TeacherComment, step.TeacherReviewerID},
+			{entComment.RoleParent, step.ParentComment, step.ParentReviewerID},
+			{entComment.RoleFriend, step.FriendComment, ""},
+		}
+		for _, rating := range ratings {
+			if rating.rating == nil {
+				continue
+			}
+			create := tx.Comment.Create().
+				SetStepID(step.ID).
+				SetRole(rating.role).
+				SetRating(rating.rating).
+				SetCreatedAt(step.CreatedAt).
+				SetUpdatedAt(step.CreatedAt)
+			if rating.authorId != "" {
+				create.SetAuthorID(rating.authorId)
+			}
+			creates = append(creates, create)
+		}
+	}
+
+	for chunk := range slices.Chunk(creates, treePathBatchSize) {
+		err = tx.Comment.CreateBulk(chunk...).Exec(ctx)
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				logger.Errorf("rollback error: %v", rerr)
+			}
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(creates), nil
+}
@@ -21,6 +21,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
 	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
 )
 
 // ProviderSet is data providers.
@@ -35,6 +36,22 @@ var ProviderSet = wire.NewSet(
 	NewEncryptRepo,
 	NewAsynqEnqueueRepo,
 	NewStatisticsRepo,
+	NewLinkRepo,
+	NewDocumentRepo,
+	NewSearchRepo,
+	NewSearchIndex,
+	NewTagRepo,
+	NewTemplateRepo,
+	NewExportRepo,
+	NewImportRepo,
+	NewReportRepo,
+	NewCacheRepo,
+	NewNoauthGuardRepo,
+	NewReviewerRepo,
+	NewCommentRepo,
+	NewRubricRepo,
+	NewReviewNotifier,
+	NewCheckinNotifier,
 )
 
 // Data .
@@ -50,8 +67,17 @@ type Data struct {
 	minio_bucket_name     string
 
 	secret string
+	// 免登录接口的限流和评论挑战
+	noauth *conf.Data_Noauth
+	// 评论请求的提醒和过期时间
+	review *conf.Data_Review
 
 	asynq_client *asynq.Client
+
+	// Redis不可用时为未命中
+	cache *cacheRepo
+	// Redis不可用时使用进程内的状态
+	guard *noauthGuardRepo
 }
 
 // NewData .
@@ -83,9 +109,20 @@ func NewData(c *conf.Data, logger log.Logger, ent_client *ent.Client) (*Data, fu
 		DB:       int(c.Redis.AsynqDb),
 	})
 
+	redisClient := redis.NewClient(&redis.Options{
+		Network:      c.Redis.Network,
+		Addr:         c.Redis.Addr,
+		Password:     c.Redis.Password,
+		DB:           int(c.Redis.Db),
+		DialTimeout:  redisDialTimeout,
+		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
+		WriteTimeout: c.Redis.WriteTimeout.AsDuration(),
+	})
+
 	cleanup := func() {
 		helper.Info("closing the data resources")
 		asynqClient.Close()
+		redisClient.Close()
 	}
 	return &Data{
 		helper:                helper,
@@ -96,7 +133,11 @@ func NewData(c *conf.Data, logger log.Logger, ent_client *ent.Client) (*Data, fu
 		minio_endpoint_remote: c.Minio.EndpointRemote,
 		minio_bucket_name:     c.Minio.BucketName,
 		secret:                c.Secret,
+		noauth:                c.Noauth,
+		review:                c.Review,
 		asynq_client:          asynqClient,
+		cache:                 newCacheRepo(redisClient, logger),
+		guard:                 newNoauthGuardRepo(redisClient, logger),
 	}, cleanup, nil
 }
 
@@ -109,6 +150,22 @@ func NewEntClient(drv dialect.Driver, logger log.Logger) *ent.Client {
 		logs.Fatalf("failed opening connection to db: %v", err)
 	}
 	logs.Info("database automatic migration success!")
+	// 补全添加路径字段之前的数据
+	updated, err := backfillTreePaths(context.Background(), client)
+	if err != nil {
+		logs.Fatalf("failed backfilling tree paths: %v", err)
+	}
+	if updated > 0 {
+		logs.Infof("backfilled tree paths of %d targets and steps", updated)
+	}
+	// 评论实体之前的评分保存在积累上
+	created, err := backfillComments(context.Background(), client, logs)
+	if err != nil {
+		logs.Fatalf("failed backfilling comments: %v", err)
+	}
+	if created > 0 {
+		logs.Infof("backfilled %d comments from steps", created)
+	}
 	return client
 }
 
@@ -142,3 +199,10 @@ func NewDriver(cd *conf.Data) dialect.Driver {
 		),
 	)
 }
+
+// lockForUpdate 在事务中锁住查询到的行，SQLite不支持FOR UPDATE，写事务本身是串行的
+func lockForUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"step/internal/biz"
+	"step/internal/data/ent/step"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+type documentRepo struct {
+	data      *Data
+	log       *log.Helper
+	minioRepo biz.MinioRepo
+}
+
+func NewDocumentRepo(data *Data, logger log.Logger, minioRepo biz.MinioRepo) biz.DocumentRepo {
+	return &documentRepo{
+		data:      data,
+		log:       log.NewHelper(logger, log.WithMessageKey("documentRepo")),
+		minioRepo: minioRepo,
+	}
+}
+
+// ● 解析文档页数：pdf统计页面对象，office/odf读取文档属性
+// ● 生成预览图：office/odf使用内嵌的缩略图，pdf在安装了pdftoppm时渲染第一页
+func (r *documentRepo) HandleStepDocument(ctx context.Context, task *asynq.Task) error {
+	var payload objects.StepDocumentPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	s, err := r.data.ent_client.Step.Get(ctx, payload.StepID)
+	if err != nil {
+		return err
+	}
+
+	if s.Type != step.TypeDocument || s.ObjectName == "" {
+		return nil
+	}
+
+	object, err := r.minioRepo.GetObject(ctx, s.ObjectName, "")
+	if err != nil {
+		return err
+	}
+	defer object.Body.Close()
+
+	if object.ContentLength > objects.DocumentInspectMaxBytes {
+		r.log.Warnf("document %s is too large to inspect: %d", s.ObjectName, object.ContentLength)
+		return nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(object.Body, objects.DocumentInspectMaxBytes))
+	if err != nil {
+		return err
+	}
+
+	updateStep := s.Update().SetPageCount(objects.DocumentPageCount(s.MimeType, data))
+
+	preview, previewContentType := objects.DocumentThumbnail(s.MimeType, data)
+	if preview == nil && s.MimeType == objects.MimeTypePdf {
+		preview, err = renderPdfFirstPage(ctx, data)
+		if err != nil {
+			r.log.Warnf("render first page of %s error: %v", s.ObjectName, err)
+		}
+		previewContentType = "image/png"
+	}
+
+	if preview != nil {
+		previewObjectName := s.ObjectName + ".preview.png"
+		if previewContentType == "image/jpeg" {
+			previewObjectName = s.ObjectName + ".preview.jpg"
+		}
+
+		err = r.minioRepo.UploadObject(ctx, previewObjectName, bytes.NewReader(preview), previewContentType)
+		if err != nil {
+			return err
+		}
+		updateStep.SetPreviewObjectName(previewObjectName)
+	}
+
+	_, err = updateStep.Save(ctx)
+	return err
+}
+
+// renderPdfFirstPage 使用poppler的pdftoppm渲染第一页，没有安装时不生成预览图
+func renderPdfFirstPage(ctx context.Context, data []byte) ([]byte, error) {
+	pdftoppm, err := exec.LookPath("pdftoppm")
+	if err != nil {
+		return nil, nil
+	}
+
+	dir, err := os.MkdirTemp("", "step-document-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input.pdf")
+	err = os.WriteFile(input, data, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	output := filepath.Join(dir, "preview")
+	cmd := exec.CommandContext(ctx, pdftoppm, "-png", "-f", "1", "-l", "1", "-scale-to", "800", "-singlefile", input, output)
+	err = cmd.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(output + ".png")
+}
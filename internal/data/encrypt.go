@@ -4,46 +4,32 @@ import (
 	"context"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
-	"errors"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"time"
 
+	stepApi "step/api/step/v1"
 	"step/internal/biz"
 	entStep "step/internal/data/ent/step"
+	"step/internal/objects"
 	"step/internal/utils"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
-// PKCS7 padding implementation
-func pkcs7Pad(data []byte, blockSize int) []byte {
-	padding := blockSize - len(data)%blockSize
-	padText := make([]byte, padding)
-	for i := range padText {
-		padText[i] = byte(padding)
-	}
-	return append(data, padText...)
-}
-
-// PKCS7 unpadding implementation
-func pkcs7Unpad(data []byte, blockSize int) ([]byte, error) {
-	length := len(data)
-	if length == 0 {
-		return nil, errors.New("invalid padding size")
-	}
+// 评论挑战nonce的payload: 积累ID(8) 过期时间(8) 难度(1) 随机数(16)
+const commentChallengePayloadSize = 33
 
-	padding := int(data[length-1])
-	if padding > blockSize || padding == 0 {
-		return nil, errors.New("invalid padding size")
-	}
-
-	for i := length - padding; i < length; i++ {
-		if data[i] != byte(padding) {
-			return nil, errors.New("invalid padding")
-		}
-	}
-
-	return data[:length-padding], nil
-}
+// 签名用途的前缀
+const (
+	shareTokenPurpose       = "share-token"
+	commentChallengePurpose = "comment-challenge"
+)
 
 type encryptRepo struct {
 	data *Data
@@ -58,10 +44,12 @@ func NewEncryptRepo(data *Data, logger log.Logger) biz.EncryptRepo {
 	}
 }
 
-func (r *encryptRepo) Encrypt(ctx context.Context, stepId uint64, data string) (string, error) {
+// Encrypt share_to为payload.签名，payload为积累ID|评论角色|过期时间，签名防止修改角色和有效期；
+// expiresAt为0时使用默认有效期，超过最长有效期时截断
+func (r *encryptRepo) Encrypt(ctx context.Context, stepId uint64, data string, expiresAt int64) (string, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return "", errors.New("uid is empty")
+		return "", stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	s, err := r.data.ent_client.Step.Query().Where(entStep.ID(stepId)).WithTarget().First(ctx)
@@ -70,60 +58,183 @@ func (r *encryptRepo) Encrypt(ctx context.Context, stepId uint64, data string) (
 	}
 
 	if s.Edges.Target.UserID != uid {
-		return "", errors.New("step not found")
+		return "", stepApi.ErrorStepNotFound("step not found")
 	}
 
-	// 使用aes加密
-	secretKey := r.data.secret
-	block, err := aes.NewCipher([]byte(secretKey))
-	if err != nil {
-		return "", err
+	// 只用于生成分享链接，避免成为任意数据的签名服务
+	roles, ok := objects.ParseShareRoles(data)
+	if !ok {
+		return "", stepApi.ErrorInvalidArgument("data must be comment roles such as teacher,parent")
 	}
 
-	plaintext := []byte(data)
-	plaintext = pkcs7Pad(plaintext, block.BlockSize())
+	now := time.Now()
+	if expiresAt == 0 {
+		expiresAt = now.Add(objects.ShareTokenTTL).Unix()
+	}
+	if expiresAt <= now.Unix() {
+		return "", stepApi.ErrorInvalidArgument("expiresAt must be in the future")
+	}
+	expiresAt = min(expiresAt, now.Add(objects.ShareTokenMaxTTL).Unix())
+
+	payload := strings.Join([]string{
+		strconv.FormatUint(stepId, 10),
+		strings.Join(roles, ","),
+		strconv.FormatInt(expiresAt, 10),
+	}, "|")
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + r.sign(shareTokenPurpose, encoded), nil
+}
 
-	// 使用stepId作为IV的基础
-	iv := make([]byte, block.BlockSize())
-	for i := 0; i < 8 && i < block.BlockSize(); i++ {
-		iv[i] = byte(stepId >> uint(i*8))
+// VerifyShareToken 没有签名的share_to是之前AES加密的分享链接，只在legacy_share_token_until之前接受
+func (r *encryptRepo) VerifyShareToken(ctx context.Context, stepId uint64, token string) ([]string, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return r.verifyLegacyShareToken(stepId, token)
+	}
+	if !hmac.Equal([]byte(signature), []byte(r.sign(shareTokenPurpose, encoded))) {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
 	}
 
-	stream := cipher.NewCFBEncrypter(block, iv)
-	stream.XORKeyStream(plaintext, plaintext)
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to").WithCause(err)
+	}
+	parts := strings.Split(string(payload), "|")
+	if len(parts) != 3 {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
+	}
 
-	encrypted := base64.StdEncoding.EncodeToString(plaintext)
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || id != stepId {
+		return nil, stepApi.ErrorInvalidShareToken("share_to is not for this step")
+	}
+	expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
+	}
+	if time.Now().Unix() >= expiresAt {
+		return nil, stepApi.ErrorInvalidShareToken("share_to is expired")
+	}
 
-	return encrypted, nil
+	roles, ok := objects.ParseShareRoles(parts[1])
+	if !ok {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
+	}
+	return roles, nil
 }
 
-func (r *encryptRepo) Decrypt(ctx context.Context, stepId uint64, data string) (string, error) {
-	secretKey := r.data.secret
-	block, err := aes.NewCipher([]byte(secretKey))
-	if err != nil {
-		return "", err
+// verifyLegacyShareToken 旧的share_to是评论角色的AES-CFB密文，没有有效期也不能防篡改，
+// 只为已经发出的链接保留到配置的截止时间
+func (r *encryptRepo) verifyLegacyShareToken(stepId uint64, token string) ([]string, error) {
+	until := r.data.noauth.GetLegacyShareTokenUntil()
+	if until == nil || !time.Now().Before(until.AsTime()) {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
 	}
 
-	encrypted, err := base64.StdEncoding.DecodeString(data)
+	block, err := aes.NewCipher([]byte(r.data.secret))
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(token)
+	if err != nil || len(encrypted) == 0 || len(encrypted)%block.BlockSize() != 0 {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
 	}
 
-	// 使用相同的stepId重建IV
+	// 和之前一样用stepId作为IV
 	iv := make([]byte, block.BlockSize())
-	for i := 0; i < 8 && i < block.BlockSize(); i++ {
+	for i := 0; i < 8; i++ {
 		iv[i] = byte(stepId >> uint(i*8))
 	}
+	cipher.NewCFBDecrypter(block, iv).XORKeyStream(encrypted, encrypted)
+
+	// PKCS7 padding
+	padding := int(encrypted[len(encrypted)-1])
+	if padding == 0 || padding > block.BlockSize() {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
+	}
+	for _, b := range encrypted[len(encrypted)-padding:] {
+		if int(b) != padding {
+			return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
+		}
+	}
+
+	roles, ok := objects.ParseShareRoles(string(encrypted[:len(encrypted)-padding]))
+	if !ok {
+		return nil, stepApi.ErrorInvalidShareToken("invalid share_to")
+	}
+	return roles, nil
+}
+
+func (r *encryptRepo) commentChallengeTTL() time.Duration {
+	if ttl := r.data.noauth.GetChallengeTtl().AsDuration(); ttl > 0 {
+		return ttl
+	}
+	return objects.CommentChallengeTTL
+}
+
+func (r *encryptRepo) commentChallengeDifficulty() uint32 {
+	difficulty := r.data.noauth.GetCommentDifficulty()
+	if difficulty == 0 {
+		return objects.CommentChallengeDifficulty
+	}
+	return min(difficulty, objects.CommentChallengeMaxDifficulty)
+}
+
+// sign share_to和评论挑战nonce的签名，使用同一个secret，加前缀区分用途
+func (r *encryptRepo) sign(purpose string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(r.data.secret))
+	mac.Write([]byte(purpose + ":" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
 
-	stream := cipher.NewCFBDecrypter(block, iv)
-	stream.XORKeyStream(encrypted, encrypted)
+// NewCommentChallenge nonce为payload.签名，payload包含积累ID、过期时间、难度和随机数
+func (r *encryptRepo) NewCommentChallenge(ctx context.Context, stepId uint64) (*stepApi.GetCommentChallengeReply, error) {
+	expiresAt := time.Now().Add(r.commentChallengeTTL()).Unix()
+	difficulty := r.commentChallengeDifficulty()
 
-	plaintext, err := pkcs7Unpad(encrypted, block.BlockSize())
+	payload := make([]byte, commentChallengePayloadSize)
+	binary.BigEndian.PutUint64(payload[0:8], stepId)
+	binary.BigEndian.PutUint64(payload[8:16], uint64(expiresAt))
+	payload[16] = byte(difficulty)
+	_, err := rand.Read(payload[17:])
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return &stepApi.GetCommentChallengeReply{
+		Nonce:      encoded + "." + r.sign(commentChallengePurpose, encoded),
+		Difficulty: difficulty,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+func (r *encryptRepo) VerifyCommentChallenge(ctx context.Context, stepId uint64, nonce string, solution string) error {
+	encoded, signature, ok := strings.Cut(nonce, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(r.sign(commentChallengePurpose, encoded))) {
+		return stepApi.ErrorInvalidChallenge("invalid nonce")
 	}
 
-	decrypted := string(plaintext)
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(payload) != commentChallengePayloadSize {
+		return stepApi.ErrorInvalidChallenge("invalid nonce")
+	}
+	if binary.BigEndian.Uint64(payload[0:8]) != stepId {
+		return stepApi.ErrorInvalidChallenge("nonce is not for this step")
+	}
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[8:16])), 0)
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return stepApi.ErrorInvalidChallenge("nonce is expired")
+	}
 
-	return decrypted, nil
+	sum := sha256.Sum256([]byte(nonce + solution))
+	if !objects.ChallengeSolved(sum[:], uint32(payload[16])) {
+		return stepApi.ErrorInvalidChallenge("solution does not meet the difficulty")
+	}
+
+	if !r.data.guard.UseNonce(ctx, signature, ttl) {
+		return stepApi.ErrorInvalidChallenge("nonce is already used")
+	}
+	return nil
 }
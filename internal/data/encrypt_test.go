@@ -0,0 +1,131 @@
+package data
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	stepApi "step/api/step/v1"
+	"step/internal/conf"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// legacyShareToken 之前的分享链接：评论角色PKCS7填充后用stepId作为IV的AES-CFB加密
+func legacyShareToken(t *testing.T, secret string, stepId uint64, data string) string {
+	t.Helper()
+	block, err := aes.NewCipher([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	padding := block.BlockSize() - len(data)%block.BlockSize()
+	plaintext := append([]byte(data), slices.Repeat([]byte{byte(padding)}, padding)...)
+	iv := make([]byte, block.BlockSize())
+	for i := 0; i < 8; i++ {
+		iv[i] = byte(stepId >> uint(i*8))
+	}
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(plaintext, plaintext)
+	return base64.StdEncoding.EncodeToString(plaintext)
+}
+
+// shareTokenExpiresAt 签名的share_to中的过期时间
+func shareTokenExpiresAt(t *testing.T, token string) int64 {
+	t.Helper()
+	encoded, _, _ := strings.Cut(token, ".")
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(string(payload), "|")
+	expiresAt, err := strconv.ParseInt(parts[len(parts)-1], 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return expiresAt
+}
+
+func TestShareToken(t *testing.T) {
+	stepRepo, ctx := newBenchStepRepo(t)
+	stepRepo.data.secret = "0123456789abcdef0123456789abcdef"
+	stepRepo.data.noauth = &conf.Data_Noauth{}
+	r := NewEncryptRepo(stepRepo.data, log.DefaultLogger).(*encryptRepo)
+
+	targetID := benchCreateTarget(t, stepRepo, ctx, 0, "shared")
+	step, err := stepRepo.CreateNoteStep(ctx, &stepApi.CreateNoteStepRequest{Id: targetID, Content: "note"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("expires at", func(t *testing.T) {
+		now := time.Now()
+		cases := []struct {
+			name      string
+			expiresAt int64
+			want      time.Duration
+			err       string
+		}{
+			{name: "default", want: objects.ShareTokenTTL},
+			{name: "within max", expiresAt: now.Add(time.Hour).Unix(), want: time.Hour},
+			{name: "clamped to max", expiresAt: now.AddDate(10, 0, 0).Unix(), want: objects.ShareTokenMaxTTL},
+			{name: "in the past", expiresAt: now.Add(-time.Hour).Unix(), err: "must be in the future"},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				token, err := r.Encrypt(ctx, step.Id, "friend", c.expiresAt)
+				if c.err != "" {
+					if err == nil || !strings.Contains(err.Error(), c.err) {
+						t.Fatalf("error = %v, want %s", err, c.err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := time.Duration(shareTokenExpiresAt(t, token)-now.Unix()) * time.Second
+				if got < c.want-time.Minute || got > c.want+time.Minute {
+					t.Errorf("ttl = %v, want %v", got, c.want)
+				}
+			})
+		}
+	})
+
+	t.Run("legacy", func(t *testing.T) {
+		legacy := legacyShareToken(t, stepRepo.data.secret, step.Id, "teacher,parent")
+		cases := []struct {
+			name  string
+			until *timestamppb.Timestamp
+			token string
+			roles []string
+		}{
+			{name: "not configured", token: legacy},
+			{name: "after the deadline", until: timestamppb.New(time.Now().Add(-time.Hour)), token: legacy},
+			{name: "before the deadline", until: timestamppb.New(time.Now().Add(time.Hour)), token: legacy, roles: []string{"teacher", "parent"}},
+			{name: "another step", until: timestamppb.New(time.Now().Add(time.Hour)), token: legacyShareToken(t, stepRepo.data.secret, step.Id+1, "teacher,parent")},
+		}
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				r.data.noauth.LegacyShareTokenUntil = c.until
+				roles, err := r.VerifyShareToken(ctx, step.Id, c.token)
+				if c.roles == nil {
+					if !stepApi.IsInvalidShareToken(err) {
+						t.Fatalf("error = %v, want invalid share token", err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !slices.Equal(roles, c.roles) {
+					t.Errorf("roles = %v, want %v", roles, c.roles)
+				}
+			})
+		}
+	})
+}
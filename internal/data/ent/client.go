@@ -12,17 +12,30 @@ import (
 	"step/internal/data/ent/migrate"
 
 	"step/internal/data/ent/award"
+	"step/internal/data/ent/comment"
+	"step/internal/data/ent/export"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
 	"step/internal/data/ent/portrait"
+	"step/internal/data/ent/report"
+	"step/internal/data/ent/reviewer"
+	"step/internal/data/ent/reviewrequest"
+	"step/internal/data/ent/searchdocument"
 	"step/internal/data/ent/show"
 	"step/internal/data/ent/showreserve"
 	"step/internal/data/ent/step"
 	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
+	"step/internal/data/ent/template"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,8 +45,24 @@ type Client struct {
 	Schema *migrate.Schema
 	// Award is the client for interacting with the Award builders.
 	Award *AwardClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// Export is the client for interacting with the Export builders.
+	Export *ExportClient
+	// ImportItem is the client for interacting with the ImportItem builders.
+	ImportItem *ImportItemClient
+	// ImportJob is the client for interacting with the ImportJob builders.
+	ImportJob *ImportJobClient
 	// Portrait is the client for interacting with the Portrait builders.
 	Portrait *PortraitClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// ReviewRequest is the client for interacting with the ReviewRequest builders.
+	ReviewRequest *ReviewRequestClient
+	// Reviewer is the client for interacting with the Reviewer builders.
+	Reviewer *ReviewerClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// Show is the client for interacting with the Show builders.
 	Show *ShowClient
 	// ShowReserve is the client for interacting with the ShowReserve builders.
@@ -42,8 +71,14 @@ type Client struct {
 	Step *StepClient
 	// StepRate is the client for interacting with the StepRate builders.
 	StepRate *StepRateClient
+	// StepRateCriterion is the client for interacting with the StepRateCriterion builders.
+	StepRateCriterion *StepRateCriterionClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// Target is the client for interacting with the Target builders.
 	Target *TargetClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -56,12 +91,23 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Award = NewAwardClient(c.config)
+	c.Comment = NewCommentClient(c.config)
+	c.Export = NewExportClient(c.config)
+	c.ImportItem = NewImportItemClient(c.config)
+	c.ImportJob = NewImportJobClient(c.config)
 	c.Portrait = NewPortraitClient(c.config)
+	c.Report = NewReportClient(c.config)
+	c.ReviewRequest = NewReviewRequestClient(c.config)
+	c.Reviewer = NewReviewerClient(c.config)
+	c.SearchDocument = NewSearchDocumentClient(c.config)
 	c.Show = NewShowClient(c.config)
 	c.ShowReserve = NewShowReserveClient(c.config)
 	c.Step = NewStepClient(c.config)
 	c.StepRate = NewStepRateClient(c.config)
+	c.StepRateCriterion = NewStepRateCriterionClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.Target = NewTargetClient(c.config)
+	c.Template = NewTemplateClient(c.config)
 }
 
 type (
@@ -152,15 +198,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Award:       NewAwardClient(cfg),
-		Portrait:    NewPortraitClient(cfg),
-		Show:        NewShowClient(cfg),
-		ShowReserve: NewShowReserveClient(cfg),
-		Step:        NewStepClient(cfg),
-		StepRate:    NewStepRateClient(cfg),
-		Target:      NewTargetClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Award:             NewAwardClient(cfg),
+		Comment:           NewCommentClient(cfg),
+		Export:            NewExportClient(cfg),
+		ImportItem:        NewImportItemClient(cfg),
+		ImportJob:         NewImportJobClient(cfg),
+		Portrait:          NewPortraitClient(cfg),
+		Report:            NewReportClient(cfg),
+		ReviewRequest:     NewReviewRequestClient(cfg),
+		Reviewer:          NewReviewerClient(cfg),
+		SearchDocument:    NewSearchDocumentClient(cfg),
+		Show:              NewShowClient(cfg),
+		ShowReserve:       NewShowReserveClient(cfg),
+		Step:              NewStepClient(cfg),
+		StepRate:          NewStepRateClient(cfg),
+		StepRateCriterion: NewStepRateCriterionClient(cfg),
+		Tag:               NewTagClient(cfg),
+		Target:            NewTargetClient(cfg),
+		Template:          NewTemplateClient(cfg),
 	}, nil
 }
 
@@ -178,15 +235,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Award:       NewAwardClient(cfg),
-		Portrait:    NewPortraitClient(cfg),
-		Show:        NewShowClient(cfg),
-		ShowReserve: NewShowReserveClient(cfg),
-		Step:        NewStepClient(cfg),
-		StepRate:    NewStepRateClient(cfg),
-		Target:      NewTargetClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Award:             NewAwardClient(cfg),
+		Comment:           NewCommentClient(cfg),
+		Export:            NewExportClient(cfg),
+		ImportItem:        NewImportItemClient(cfg),
+		ImportJob:         NewImportJobClient(cfg),
+		Portrait:          NewPortraitClient(cfg),
+		Report:            NewReportClient(cfg),
+		ReviewRequest:     NewReviewRequestClient(cfg),
+		Reviewer:          NewReviewerClient(cfg),
+		SearchDocument:    NewSearchDocumentClient(cfg),
+		Show:              NewShowClient(cfg),
+		ShowReserve:       NewShowReserveClient(cfg),
+		Step:              NewStepClient(cfg),
+		StepRate:          NewStepRateClient(cfg),
+		StepRateCriterion: NewStepRateCriterionClient(cfg),
+		Tag:               NewTagClient(cfg),
+		Target:            NewTargetClient(cfg),
+		Template:          NewTemplateClient(cfg),
 	}, nil
 }
 
@@ -216,7 +284,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Award, c.Portrait, c.Show, c.ShowReserve, c.Step, c.StepRate, c.Target,
+		c.Award, c.Comment, c.Export, c.ImportItem, c.ImportJob, c.Portrait, c.Report,
+		c.ReviewRequest, c.Reviewer, c.SearchDocument, c.Show, c.ShowReserve, c.Step,
+		c.StepRate, c.StepRateCriterion, c.Tag, c.Target, c.Template,
 	} {
 		n.Use(hooks...)
 	}
@@ -226,7 +296,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Award, c.Portrait, c.Show, c.ShowReserve, c.Step, c.StepRate, c.Target,
+		c.Award, c.Comment, c.Export, c.ImportItem, c.ImportJob, c.Portrait, c.Report,
+		c.ReviewRequest, c.Reviewer, c.SearchDocument, c.Show, c.ShowReserve, c.Step,
+		c.StepRate, c.StepRateCriterion, c.Tag, c.Target, c.Template,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -237,8 +309,24 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AwardMutation:
 		return c.Award.mutate(ctx, m)
+	case *CommentMutation:
+		return c.Comment.mutate(ctx, m)
+	case *ExportMutation:
+		return c.Export.mutate(ctx, m)
+	case *ImportItemMutation:
+		return c.ImportItem.mutate(ctx, m)
+	case *ImportJobMutation:
+		return c.ImportJob.mutate(ctx, m)
 	case *PortraitMutation:
 		return c.Portrait.mutate(ctx, m)
+	case *ReportMutation:
+		return c.Report.mutate(ctx, m)
+	case *ReviewRequestMutation:
+		return c.ReviewRequest.mutate(ctx, m)
+	case *ReviewerMutation:
+		return c.Reviewer.mutate(ctx, m)
+	case *SearchDocumentMutation:
+		return c.SearchDocument.mutate(ctx, m)
 	case *ShowMutation:
 		return c.Show.mutate(ctx, m)
 	case *ShowReserveMutation:
@@ -247,8 +335,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Step.mutate(ctx, m)
 	case *StepRateMutation:
 		return c.StepRate.mutate(ctx, m)
+	case *StepRateCriterionMutation:
+		return c.StepRateCriterion.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *TargetMutation:
 		return c.Target.mutate(ctx, m)
+	case *TemplateMutation:
+		return c.Template.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -387,107 +481,107 @@ func (c *AwardClient) mutate(ctx context.Context, m *AwardMutation) (Value, erro
 	}
 }
 
-// PortraitClient is a client for the Portrait schema.
-type PortraitClient struct {
+// CommentClient is a client for the Comment schema.
+type CommentClient struct {
 	config
 }
 
-// NewPortraitClient returns a client for the Portrait from the given config.
-func NewPortraitClient(c config) *PortraitClient {
-	return &PortraitClient{config: c}
+// NewCommentClient returns a client for the Comment from the given config.
+func NewCommentClient(c config) *CommentClient {
+	return &CommentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `portrait.Hooks(f(g(h())))`.
-func (c *PortraitClient) Use(hooks ...Hook) {
-	c.hooks.Portrait = append(c.hooks.Portrait, hooks...)
+// A call to `Use(f, g, h)` equals to `comment.Hooks(f(g(h())))`.
+func (c *CommentClient) Use(hooks ...Hook) {
+	c.hooks.Comment = append(c.hooks.Comment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `portrait.Intercept(f(g(h())))`.
-func (c *PortraitClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Portrait = append(c.inters.Portrait, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `comment.Intercept(f(g(h())))`.
+func (c *CommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Comment = append(c.inters.Comment, interceptors...)
 }
 
-// Create returns a builder for creating a Portrait entity.
-func (c *PortraitClient) Create() *PortraitCreate {
-	mutation := newPortraitMutation(c.config, OpCreate)
-	return &PortraitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Comment entity.
+func (c *CommentClient) Create() *CommentCreate {
+	mutation := newCommentMutation(c.config, OpCreate)
+	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Portrait entities.
-func (c *PortraitClient) CreateBulk(builders ...*PortraitCreate) *PortraitCreateBulk {
-	return &PortraitCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PortraitClient) MapCreateBulk(slice any, setFunc func(*PortraitCreate, int)) *PortraitCreateBulk {
+func (c *CommentClient) MapCreateBulk(slice any, setFunc func(*CommentCreate, int)) *CommentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PortraitCreateBulk{err: fmt.Errorf("calling to PortraitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommentCreateBulk{err: fmt.Errorf("calling to CommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PortraitCreate, rv.Len())
+	builders := make([]*CommentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PortraitCreateBulk{config: c.config, builders: builders}
+	return &CommentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Portrait.
-func (c *PortraitClient) Update() *PortraitUpdate {
-	mutation := newPortraitMutation(c.config, OpUpdate)
-	return &PortraitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Comment.
+func (c *CommentClient) Update() *CommentUpdate {
+	mutation := newCommentMutation(c.config, OpUpdate)
+	return &CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PortraitClient) UpdateOne(po *Portrait) *PortraitUpdateOne {
-	mutation := newPortraitMutation(c.config, OpUpdateOne, withPortrait(po))
-	return &PortraitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentClient) UpdateOne(co *Comment) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withComment(co))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PortraitClient) UpdateOneID(id uint64) *PortraitUpdateOne {
-	mutation := newPortraitMutation(c.config, OpUpdateOne, withPortraitID(id))
-	return &PortraitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentClient) UpdateOneID(id uint64) *CommentUpdateOne {
+	mutation := newCommentMutation(c.config, OpUpdateOne, withCommentID(id))
+	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Portrait.
-func (c *PortraitClient) Delete() *PortraitDelete {
-	mutation := newPortraitMutation(c.config, OpDelete)
-	return &PortraitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Comment.
+func (c *CommentClient) Delete() *CommentDelete {
+	mutation := newCommentMutation(c.config, OpDelete)
+	return &CommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PortraitClient) DeleteOne(po *Portrait) *PortraitDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *CommentClient) DeleteOne(co *Comment) *CommentDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PortraitClient) DeleteOneID(id uint64) *PortraitDeleteOne {
-	builder := c.Delete().Where(portrait.ID(id))
+func (c *CommentClient) DeleteOneID(id uint64) *CommentDeleteOne {
+	builder := c.Delete().Where(comment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PortraitDeleteOne{builder}
+	return &CommentDeleteOne{builder}
 }
 
-// Query returns a query builder for Portrait.
-func (c *PortraitClient) Query() *PortraitQuery {
-	return &PortraitQuery{
+// Query returns a query builder for Comment.
+func (c *CommentClient) Query() *CommentQuery {
+	return &CommentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePortrait},
+		ctx:    &QueryContext{Type: TypeComment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Portrait entity by its id.
-func (c *PortraitClient) Get(ctx context.Context, id uint64) (*Portrait, error) {
-	return c.Query().Where(portrait.ID(id)).Only(ctx)
+// Get returns a Comment entity by its id.
+func (c *CommentClient) Get(ctx context.Context, id uint64) (*Comment, error) {
+	return c.Query().Where(comment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PortraitClient) GetX(ctx context.Context, id uint64) *Portrait {
+func (c *CommentClient) GetX(ctx context.Context, id uint64) *Comment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -496,131 +590,131 @@ func (c *PortraitClient) GetX(ctx context.Context, id uint64) *Portrait {
 }
 
 // Hooks returns the client hooks.
-func (c *PortraitClient) Hooks() []Hook {
-	return c.hooks.Portrait
+func (c *CommentClient) Hooks() []Hook {
+	return c.hooks.Comment
 }
 
 // Interceptors returns the client interceptors.
-func (c *PortraitClient) Interceptors() []Interceptor {
-	return c.inters.Portrait
+func (c *CommentClient) Interceptors() []Interceptor {
+	return c.inters.Comment
 }
 
-func (c *PortraitClient) mutate(ctx context.Context, m *PortraitMutation) (Value, error) {
+func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PortraitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PortraitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PortraitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PortraitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Portrait mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Comment mutation op: %q", m.Op())
 	}
 }
 
-// ShowClient is a client for the Show schema.
-type ShowClient struct {
+// ExportClient is a client for the Export schema.
+type ExportClient struct {
 	config
 }
 
-// NewShowClient returns a client for the Show from the given config.
-func NewShowClient(c config) *ShowClient {
-	return &ShowClient{config: c}
+// NewExportClient returns a client for the Export from the given config.
+func NewExportClient(c config) *ExportClient {
+	return &ExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `show.Hooks(f(g(h())))`.
-func (c *ShowClient) Use(hooks ...Hook) {
-	c.hooks.Show = append(c.hooks.Show, hooks...)
+// A call to `Use(f, g, h)` equals to `export.Hooks(f(g(h())))`.
+func (c *ExportClient) Use(hooks ...Hook) {
+	c.hooks.Export = append(c.hooks.Export, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `show.Intercept(f(g(h())))`.
-func (c *ShowClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Show = append(c.inters.Show, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `export.Intercept(f(g(h())))`.
+func (c *ExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Export = append(c.inters.Export, interceptors...)
 }
 
-// Create returns a builder for creating a Show entity.
-func (c *ShowClient) Create() *ShowCreate {
-	mutation := newShowMutation(c.config, OpCreate)
-	return &ShowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Export entity.
+func (c *ExportClient) Create() *ExportCreate {
+	mutation := newExportMutation(c.config, OpCreate)
+	return &ExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Show entities.
-func (c *ShowClient) CreateBulk(builders ...*ShowCreate) *ShowCreateBulk {
-	return &ShowCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Export entities.
+func (c *ExportClient) CreateBulk(builders ...*ExportCreate) *ExportCreateBulk {
+	return &ExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ShowClient) MapCreateBulk(slice any, setFunc func(*ShowCreate, int)) *ShowCreateBulk {
+func (c *ExportClient) MapCreateBulk(slice any, setFunc func(*ExportCreate, int)) *ExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ShowCreateBulk{err: fmt.Errorf("calling to ShowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExportCreateBulk{err: fmt.Errorf("calling to ExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ShowCreate, rv.Len())
+	builders := make([]*ExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ShowCreateBulk{config: c.config, builders: builders}
+	return &ExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Show.
-func (c *ShowClient) Update() *ShowUpdate {
-	mutation := newShowMutation(c.config, OpUpdate)
-	return &ShowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Export.
+func (c *ExportClient) Update() *ExportUpdate {
+	mutation := newExportMutation(c.config, OpUpdate)
+	return &ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ShowClient) UpdateOne(s *Show) *ShowUpdateOne {
-	mutation := newShowMutation(c.config, OpUpdateOne, withShow(s))
-	return &ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExportClient) UpdateOne(e *Export) *ExportUpdateOne {
+	mutation := newExportMutation(c.config, OpUpdateOne, withExport(e))
+	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ShowClient) UpdateOneID(id uint64) *ShowUpdateOne {
-	mutation := newShowMutation(c.config, OpUpdateOne, withShowID(id))
-	return &ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExportClient) UpdateOneID(id uint64) *ExportUpdateOne {
+	mutation := newExportMutation(c.config, OpUpdateOne, withExportID(id))
+	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Show.
-func (c *ShowClient) Delete() *ShowDelete {
-	mutation := newShowMutation(c.config, OpDelete)
-	return &ShowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Export.
+func (c *ExportClient) Delete() *ExportDelete {
+	mutation := newExportMutation(c.config, OpDelete)
+	return &ExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ShowClient) DeleteOne(s *Show) *ShowDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *ExportClient) DeleteOne(e *Export) *ExportDeleteOne {
+	return c.DeleteOneID(e.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ShowClient) DeleteOneID(id uint64) *ShowDeleteOne {
-	builder := c.Delete().Where(show.ID(id))
+func (c *ExportClient) DeleteOneID(id uint64) *ExportDeleteOne {
+	builder := c.Delete().Where(export.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ShowDeleteOne{builder}
+	return &ExportDeleteOne{builder}
 }
 
-// Query returns a query builder for Show.
-func (c *ShowClient) Query() *ShowQuery {
-	return &ShowQuery{
+// Query returns a query builder for Export.
+func (c *ExportClient) Query() *ExportQuery {
+	return &ExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeShow},
+		ctx:    &QueryContext{Type: TypeExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Show entity by its id.
-func (c *ShowClient) Get(ctx context.Context, id uint64) (*Show, error) {
-	return c.Query().Where(show.ID(id)).Only(ctx)
+// Get returns a Export entity by its id.
+func (c *ExportClient) Get(ctx context.Context, id uint64) (*Export, error) {
+	return c.Query().Where(export.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ShowClient) GetX(ctx context.Context, id uint64) *Show {
+func (c *ExportClient) GetX(ctx context.Context, id uint64) *Export {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -628,148 +722,132 @@ func (c *ShowClient) GetX(ctx context.Context, id uint64) *Show {
 	return obj
 }
 
-// QueryShowReserves queries the show_reserves edge of a Show.
-func (c *ShowClient) QueryShowReserves(s *Show) *ShowReserveQuery {
-	query := (&ShowReserveClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(show.Table, show.FieldID, id),
-			sqlgraph.To(showreserve.Table, showreserve.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, show.ShowReservesTable, show.ShowReservesColumn),
-		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ShowClient) Hooks() []Hook {
-	return c.hooks.Show
+func (c *ExportClient) Hooks() []Hook {
+	return c.hooks.Export
 }
 
 // Interceptors returns the client interceptors.
-func (c *ShowClient) Interceptors() []Interceptor {
-	return c.inters.Show
+func (c *ExportClient) Interceptors() []Interceptor {
+	return c.inters.Export
 }
 
-func (c *ShowClient) mutate(ctx context.Context, m *ShowMutation) (Value, error) {
+func (c *ExportClient) mutate(ctx context.Context, m *ExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ShowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ShowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ShowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Show mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Export mutation op: %q", m.Op())
 	}
 }
 
-// ShowReserveClient is a client for the ShowReserve schema.
-type ShowReserveClient struct {
+// ImportItemClient is a client for the ImportItem schema.
+type ImportItemClient struct {
 	config
 }
 
-// NewShowReserveClient returns a client for the ShowReserve from the given config.
-func NewShowReserveClient(c config) *ShowReserveClient {
-	return &ShowReserveClient{config: c}
+// NewImportItemClient returns a client for the ImportItem from the given config.
+func NewImportItemClient(c config) *ImportItemClient {
+	return &ImportItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `showreserve.Hooks(f(g(h())))`.
-func (c *ShowReserveClient) Use(hooks ...Hook) {
-	c.hooks.ShowReserve = append(c.hooks.ShowReserve, hooks...)
+// A call to `Use(f, g, h)` equals to `importitem.Hooks(f(g(h())))`.
+func (c *ImportItemClient) Use(hooks ...Hook) {
+	c.hooks.ImportItem = append(c.hooks.ImportItem, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `showreserve.Intercept(f(g(h())))`.
-func (c *ShowReserveClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ShowReserve = append(c.inters.ShowReserve, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `importitem.Intercept(f(g(h())))`.
+func (c *ImportItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportItem = append(c.inters.ImportItem, interceptors...)
 }
 
-// Create returns a builder for creating a ShowReserve entity.
-func (c *ShowReserveClient) Create() *ShowReserveCreate {
-	mutation := newShowReserveMutation(c.config, OpCreate)
-	return &ShowReserveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ImportItem entity.
+func (c *ImportItemClient) Create() *ImportItemCreate {
+	mutation := newImportItemMutation(c.config, OpCreate)
+	return &ImportItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ShowReserve entities.
-func (c *ShowReserveClient) CreateBulk(builders ...*ShowReserveCreate) *ShowReserveCreateBulk {
-	return &ShowReserveCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ImportItem entities.
+func (c *ImportItemClient) CreateBulk(builders ...*ImportItemCreate) *ImportItemCreateBulk {
+	return &ImportItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ShowReserveClient) MapCreateBulk(slice any, setFunc func(*ShowReserveCreate, int)) *ShowReserveCreateBulk {
+func (c *ImportItemClient) MapCreateBulk(slice any, setFunc func(*ImportItemCreate, int)) *ImportItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ShowReserveCreateBulk{err: fmt.Errorf("calling to ShowReserveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImportItemCreateBulk{err: fmt.Errorf("calling to ImportItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ShowReserveCreate, rv.Len())
+	builders := make([]*ImportItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ShowReserveCreateBulk{config: c.config, builders: builders}
+	return &ImportItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ShowReserve.
-func (c *ShowReserveClient) Update() *ShowReserveUpdate {
-	mutation := newShowReserveMutation(c.config, OpUpdate)
-	return &ShowReserveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ImportItem.
+func (c *ImportItemClient) Update() *ImportItemUpdate {
+	mutation := newImportItemMutation(c.config, OpUpdate)
+	return &ImportItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ShowReserveClient) UpdateOne(sr *ShowReserve) *ShowReserveUpdateOne {
-	mutation := newShowReserveMutation(c.config, OpUpdateOne, withShowReserve(sr))
-	return &ShowReserveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImportItemClient) UpdateOne(ii *ImportItem) *ImportItemUpdateOne {
+	mutation := newImportItemMutation(c.config, OpUpdateOne, withImportItem(ii))
+	return &ImportItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ShowReserveClient) UpdateOneID(id uint64) *ShowReserveUpdateOne {
-	mutation := newShowReserveMutation(c.config, OpUpdateOne, withShowReserveID(id))
-	return &ShowReserveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImportItemClient) UpdateOneID(id uint64) *ImportItemUpdateOne {
+	mutation := newImportItemMutation(c.config, OpUpdateOne, withImportItemID(id))
+	return &ImportItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ShowReserve.
-func (c *ShowReserveClient) Delete() *ShowReserveDelete {
-	mutation := newShowReserveMutation(c.config, OpDelete)
-	return &ShowReserveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ImportItem.
+func (c *ImportItemClient) Delete() *ImportItemDelete {
+	mutation := newImportItemMutation(c.config, OpDelete)
+	return &ImportItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ShowReserveClient) DeleteOne(sr *ShowReserve) *ShowReserveDeleteOne {
-	return c.DeleteOneID(sr.ID)
+func (c *ImportItemClient) DeleteOne(ii *ImportItem) *ImportItemDeleteOne {
+	return c.DeleteOneID(ii.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ShowReserveClient) DeleteOneID(id uint64) *ShowReserveDeleteOne {
-	builder := c.Delete().Where(showreserve.ID(id))
+func (c *ImportItemClient) DeleteOneID(id uint64) *ImportItemDeleteOne {
+	builder := c.Delete().Where(importitem.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ShowReserveDeleteOne{builder}
+	return &ImportItemDeleteOne{builder}
 }
 
-// Query returns a query builder for ShowReserve.
-func (c *ShowReserveClient) Query() *ShowReserveQuery {
-	return &ShowReserveQuery{
+// Query returns a query builder for ImportItem.
+func (c *ImportItemClient) Query() *ImportItemQuery {
+	return &ImportItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeShowReserve},
+		ctx:    &QueryContext{Type: TypeImportItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ShowReserve entity by its id.
-func (c *ShowReserveClient) Get(ctx context.Context, id uint64) (*ShowReserve, error) {
-	return c.Query().Where(showreserve.ID(id)).Only(ctx)
+// Get returns a ImportItem entity by its id.
+func (c *ImportItemClient) Get(ctx context.Context, id uint64) (*ImportItem, error) {
+	return c.Query().Where(importitem.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ShowReserveClient) GetX(ctx context.Context, id uint64) *ShowReserve {
+func (c *ImportItemClient) GetX(ctx context.Context, id uint64) *ImportItem {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -777,148 +855,148 @@ func (c *ShowReserveClient) GetX(ctx context.Context, id uint64) *ShowReserve {
 	return obj
 }
 
-// QueryShow queries the show edge of a ShowReserve.
-func (c *ShowReserveClient) QueryShow(sr *ShowReserve) *ShowQuery {
-	query := (&ShowClient{config: c.config}).Query()
+// QueryJob queries the job edge of a ImportItem.
+func (c *ImportItemClient) QueryJob(ii *ImportItem) *ImportJobQuery {
+	query := (&ImportJobClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := sr.ID
+		id := ii.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(showreserve.Table, showreserve.FieldID, id),
-			sqlgraph.To(show.Table, show.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, showreserve.ShowTable, showreserve.ShowColumn),
+			sqlgraph.From(importitem.Table, importitem.FieldID, id),
+			sqlgraph.To(importjob.Table, importjob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, importitem.JobTable, importitem.JobColumn),
 		)
-		fromV = sqlgraph.Neighbors(sr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ii.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ShowReserveClient) Hooks() []Hook {
-	return c.hooks.ShowReserve
+func (c *ImportItemClient) Hooks() []Hook {
+	return c.hooks.ImportItem
 }
 
 // Interceptors returns the client interceptors.
-func (c *ShowReserveClient) Interceptors() []Interceptor {
-	return c.inters.ShowReserve
+func (c *ImportItemClient) Interceptors() []Interceptor {
+	return c.inters.ImportItem
 }
 
-func (c *ShowReserveClient) mutate(ctx context.Context, m *ShowReserveMutation) (Value, error) {
+func (c *ImportItemClient) mutate(ctx context.Context, m *ImportItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ShowReserveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImportItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ShowReserveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImportItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ShowReserveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImportItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ShowReserveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImportItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ShowReserve mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ImportItem mutation op: %q", m.Op())
 	}
 }
 
-// StepClient is a client for the Step schema.
-type StepClient struct {
+// ImportJobClient is a client for the ImportJob schema.
+type ImportJobClient struct {
 	config
 }
 
-// NewStepClient returns a client for the Step from the given config.
-func NewStepClient(c config) *StepClient {
-	return &StepClient{config: c}
+// NewImportJobClient returns a client for the ImportJob from the given config.
+func NewImportJobClient(c config) *ImportJobClient {
+	return &ImportJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `step.Hooks(f(g(h())))`.
-func (c *StepClient) Use(hooks ...Hook) {
-	c.hooks.Step = append(c.hooks.Step, hooks...)
+// A call to `Use(f, g, h)` equals to `importjob.Hooks(f(g(h())))`.
+func (c *ImportJobClient) Use(hooks ...Hook) {
+	c.hooks.ImportJob = append(c.hooks.ImportJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `step.Intercept(f(g(h())))`.
-func (c *StepClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Step = append(c.inters.Step, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `importjob.Intercept(f(g(h())))`.
+func (c *ImportJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImportJob = append(c.inters.ImportJob, interceptors...)
 }
 
-// Create returns a builder for creating a Step entity.
-func (c *StepClient) Create() *StepCreate {
-	mutation := newStepMutation(c.config, OpCreate)
-	return &StepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ImportJob entity.
+func (c *ImportJobClient) Create() *ImportJobCreate {
+	mutation := newImportJobMutation(c.config, OpCreate)
+	return &ImportJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Step entities.
-func (c *StepClient) CreateBulk(builders ...*StepCreate) *StepCreateBulk {
-	return &StepCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ImportJob entities.
+func (c *ImportJobClient) CreateBulk(builders ...*ImportJobCreate) *ImportJobCreateBulk {
+	return &ImportJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *StepClient) MapCreateBulk(slice any, setFunc func(*StepCreate, int)) *StepCreateBulk {
+func (c *ImportJobClient) MapCreateBulk(slice any, setFunc func(*ImportJobCreate, int)) *ImportJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &StepCreateBulk{err: fmt.Errorf("calling to StepClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImportJobCreateBulk{err: fmt.Errorf("calling to ImportJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*StepCreate, rv.Len())
+	builders := make([]*ImportJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &StepCreateBulk{config: c.config, builders: builders}
+	return &ImportJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Step.
-func (c *StepClient) Update() *StepUpdate {
-	mutation := newStepMutation(c.config, OpUpdate)
-	return &StepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ImportJob.
+func (c *ImportJobClient) Update() *ImportJobUpdate {
+	mutation := newImportJobMutation(c.config, OpUpdate)
+	return &ImportJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *StepClient) UpdateOne(s *Step) *StepUpdateOne {
-	mutation := newStepMutation(c.config, OpUpdateOne, withStep(s))
-	return &StepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImportJobClient) UpdateOne(ij *ImportJob) *ImportJobUpdateOne {
+	mutation := newImportJobMutation(c.config, OpUpdateOne, withImportJob(ij))
+	return &ImportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *StepClient) UpdateOneID(id uint64) *StepUpdateOne {
-	mutation := newStepMutation(c.config, OpUpdateOne, withStepID(id))
-	return &StepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImportJobClient) UpdateOneID(id uint64) *ImportJobUpdateOne {
+	mutation := newImportJobMutation(c.config, OpUpdateOne, withImportJobID(id))
+	return &ImportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Step.
-func (c *StepClient) Delete() *StepDelete {
-	mutation := newStepMutation(c.config, OpDelete)
-	return &StepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ImportJob.
+func (c *ImportJobClient) Delete() *ImportJobDelete {
+	mutation := newImportJobMutation(c.config, OpDelete)
+	return &ImportJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *StepClient) DeleteOne(s *Step) *StepDeleteOne {
-	return c.DeleteOneID(s.ID)
+func (c *ImportJobClient) DeleteOne(ij *ImportJob) *ImportJobDeleteOne {
+	return c.DeleteOneID(ij.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *StepClient) DeleteOneID(id uint64) *StepDeleteOne {
-	builder := c.Delete().Where(step.ID(id))
+func (c *ImportJobClient) DeleteOneID(id uint64) *ImportJobDeleteOne {
+	builder := c.Delete().Where(importjob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &StepDeleteOne{builder}
+	return &ImportJobDeleteOne{builder}
 }
 
-// Query returns a query builder for Step.
-func (c *StepClient) Query() *StepQuery {
-	return &StepQuery{
+// Query returns a query builder for ImportJob.
+func (c *ImportJobClient) Query() *ImportJobQuery {
+	return &ImportJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeStep},
+		ctx:    &QueryContext{Type: TypeImportJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Step entity by its id.
-func (c *StepClient) Get(ctx context.Context, id uint64) (*Step, error) {
-	return c.Query().Where(step.ID(id)).Only(ctx)
+// Get returns a ImportJob entity by its id.
+func (c *ImportJobClient) Get(ctx context.Context, id uint64) (*ImportJob, error) {
+	return c.Query().Where(importjob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *StepClient) GetX(ctx context.Context, id uint64) *Step {
+func (c *ImportJobClient) GetX(ctx context.Context, id uint64) *ImportJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -926,180 +1004,1574 @@ func (c *StepClient) GetX(ctx context.Context, id uint64) *Step {
 	return obj
 }
 
-// QueryTarget queries the target edge of a Step.
-func (c *StepClient) QueryTarget(s *Step) *TargetQuery {
-	query := (&TargetClient{config: c.config}).Query()
+// QueryItems queries the items edge of a ImportJob.
+func (c *ImportJobClient) QueryItems(ij *ImportJob) *ImportItemQuery {
+	query := (&ImportItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
+		id := ij.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(step.Table, step.FieldID, id),
+			sqlgraph.From(importjob.Table, importjob.FieldID, id),
+			sqlgraph.To(importitem.Table, importitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, importjob.ItemsTable, importjob.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ij.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImportJobClient) Hooks() []Hook {
+	return c.hooks.ImportJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImportJobClient) Interceptors() []Interceptor {
+	return c.inters.ImportJob
+}
+
+func (c *ImportJobClient) mutate(ctx context.Context, m *ImportJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImportJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImportJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImportJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImportJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImportJob mutation op: %q", m.Op())
+	}
+}
+
+// PortraitClient is a client for the Portrait schema.
+type PortraitClient struct {
+	config
+}
+
+// NewPortraitClient returns a client for the Portrait from the given config.
+func NewPortraitClient(c config) *PortraitClient {
+	return &PortraitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `portrait.Hooks(f(g(h())))`.
+func (c *PortraitClient) Use(hooks ...Hook) {
+	c.hooks.Portrait = append(c.hooks.Portrait, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `portrait.Intercept(f(g(h())))`.
+func (c *PortraitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Portrait = append(c.inters.Portrait, interceptors...)
+}
+
+// Create returns a builder for creating a Portrait entity.
+func (c *PortraitClient) Create() *PortraitCreate {
+	mutation := newPortraitMutation(c.config, OpCreate)
+	return &PortraitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Portrait entities.
+func (c *PortraitClient) CreateBulk(builders ...*PortraitCreate) *PortraitCreateBulk {
+	return &PortraitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PortraitClient) MapCreateBulk(slice any, setFunc func(*PortraitCreate, int)) *PortraitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PortraitCreateBulk{err: fmt.Errorf("calling to PortraitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PortraitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PortraitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Portrait.
+func (c *PortraitClient) Update() *PortraitUpdate {
+	mutation := newPortraitMutation(c.config, OpUpdate)
+	return &PortraitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PortraitClient) UpdateOne(po *Portrait) *PortraitUpdateOne {
+	mutation := newPortraitMutation(c.config, OpUpdateOne, withPortrait(po))
+	return &PortraitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PortraitClient) UpdateOneID(id uint64) *PortraitUpdateOne {
+	mutation := newPortraitMutation(c.config, OpUpdateOne, withPortraitID(id))
+	return &PortraitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Portrait.
+func (c *PortraitClient) Delete() *PortraitDelete {
+	mutation := newPortraitMutation(c.config, OpDelete)
+	return &PortraitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PortraitClient) DeleteOne(po *Portrait) *PortraitDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PortraitClient) DeleteOneID(id uint64) *PortraitDeleteOne {
+	builder := c.Delete().Where(portrait.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PortraitDeleteOne{builder}
+}
+
+// Query returns a query builder for Portrait.
+func (c *PortraitClient) Query() *PortraitQuery {
+	return &PortraitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePortrait},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Portrait entity by its id.
+func (c *PortraitClient) Get(ctx context.Context, id uint64) (*Portrait, error) {
+	return c.Query().Where(portrait.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PortraitClient) GetX(ctx context.Context, id uint64) *Portrait {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PortraitClient) Hooks() []Hook {
+	return c.hooks.Portrait
+}
+
+// Interceptors returns the client interceptors.
+func (c *PortraitClient) Interceptors() []Interceptor {
+	return c.inters.Portrait
+}
+
+func (c *PortraitClient) mutate(ctx context.Context, m *PortraitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PortraitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PortraitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PortraitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PortraitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Portrait mutation op: %q", m.Op())
+	}
+}
+
+// ReportClient is a client for the Report schema.
+type ReportClient struct {
+	config
+}
+
+// NewReportClient returns a client for the Report from the given config.
+func NewReportClient(c config) *ReportClient {
+	return &ReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `report.Hooks(f(g(h())))`.
+func (c *ReportClient) Use(hooks ...Hook) {
+	c.hooks.Report = append(c.hooks.Report, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `report.Intercept(f(g(h())))`.
+func (c *ReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Report = append(c.inters.Report, interceptors...)
+}
+
+// Create returns a builder for creating a Report entity.
+func (c *ReportClient) Create() *ReportCreate {
+	mutation := newReportMutation(c.config, OpCreate)
+	return &ReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Report entities.
+func (c *ReportClient) CreateBulk(builders ...*ReportCreate) *ReportCreateBulk {
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReportClient) MapCreateBulk(slice any, setFunc func(*ReportCreate, int)) *ReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReportCreateBulk{err: fmt.Errorf("calling to ReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Report.
+func (c *ReportClient) Update() *ReportUpdate {
+	mutation := newReportMutation(c.config, OpUpdate)
+	return &ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReportClient) UpdateOne(r *Report) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReport(r))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReportClient) UpdateOneID(id uint64) *ReportUpdateOne {
+	mutation := newReportMutation(c.config, OpUpdateOne, withReportID(id))
+	return &ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Report.
+func (c *ReportClient) Delete() *ReportDelete {
+	mutation := newReportMutation(c.config, OpDelete)
+	return &ReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReportClient) DeleteOne(r *Report) *ReportDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReportClient) DeleteOneID(id uint64) *ReportDeleteOne {
+	builder := c.Delete().Where(report.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReportDeleteOne{builder}
+}
+
+// Query returns a query builder for Report.
+func (c *ReportClient) Query() *ReportQuery {
+	return &ReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Report entity by its id.
+func (c *ReportClient) Get(ctx context.Context, id uint64) (*Report, error) {
+	return c.Query().Where(report.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReportClient) GetX(ctx context.Context, id uint64) *Report {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReportClient) Hooks() []Hook {
+	return c.hooks.Report
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReportClient) Interceptors() []Interceptor {
+	return c.inters.Report
+}
+
+func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Report mutation op: %q", m.Op())
+	}
+}
+
+// ReviewRequestClient is a client for the ReviewRequest schema.
+type ReviewRequestClient struct {
+	config
+}
+
+// NewReviewRequestClient returns a client for the ReviewRequest from the given config.
+func NewReviewRequestClient(c config) *ReviewRequestClient {
+	return &ReviewRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewrequest.Hooks(f(g(h())))`.
+func (c *ReviewRequestClient) Use(hooks ...Hook) {
+	c.hooks.ReviewRequest = append(c.hooks.ReviewRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reviewrequest.Intercept(f(g(h())))`.
+func (c *ReviewRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReviewRequest = append(c.inters.ReviewRequest, interceptors...)
+}
+
+// Create returns a builder for creating a ReviewRequest entity.
+func (c *ReviewRequestClient) Create() *ReviewRequestCreate {
+	mutation := newReviewRequestMutation(c.config, OpCreate)
+	return &ReviewRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReviewRequest entities.
+func (c *ReviewRequestClient) CreateBulk(builders ...*ReviewRequestCreate) *ReviewRequestCreateBulk {
+	return &ReviewRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewRequestClient) MapCreateBulk(slice any, setFunc func(*ReviewRequestCreate, int)) *ReviewRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewRequestCreateBulk{err: fmt.Errorf("calling to ReviewRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReviewRequest.
+func (c *ReviewRequestClient) Update() *ReviewRequestUpdate {
+	mutation := newReviewRequestMutation(c.config, OpUpdate)
+	return &ReviewRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewRequestClient) UpdateOne(rr *ReviewRequest) *ReviewRequestUpdateOne {
+	mutation := newReviewRequestMutation(c.config, OpUpdateOne, withReviewRequest(rr))
+	return &ReviewRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewRequestClient) UpdateOneID(id uint64) *ReviewRequestUpdateOne {
+	mutation := newReviewRequestMutation(c.config, OpUpdateOne, withReviewRequestID(id))
+	return &ReviewRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReviewRequest.
+func (c *ReviewRequestClient) Delete() *ReviewRequestDelete {
+	mutation := newReviewRequestMutation(c.config, OpDelete)
+	return &ReviewRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewRequestClient) DeleteOne(rr *ReviewRequest) *ReviewRequestDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewRequestClient) DeleteOneID(id uint64) *ReviewRequestDeleteOne {
+	builder := c.Delete().Where(reviewrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for ReviewRequest.
+func (c *ReviewRequestClient) Query() *ReviewRequestQuery {
+	return &ReviewRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReviewRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReviewRequest entity by its id.
+func (c *ReviewRequestClient) Get(ctx context.Context, id uint64) (*ReviewRequest, error) {
+	return c.Query().Where(reviewrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewRequestClient) GetX(ctx context.Context, id uint64) *ReviewRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewRequestClient) Hooks() []Hook {
+	return c.hooks.ReviewRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewRequestClient) Interceptors() []Interceptor {
+	return c.inters.ReviewRequest
+}
+
+func (c *ReviewRequestClient) mutate(ctx context.Context, m *ReviewRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReviewRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReviewRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReviewRequest mutation op: %q", m.Op())
+	}
+}
+
+// ReviewerClient is a client for the Reviewer schema.
+type ReviewerClient struct {
+	config
+}
+
+// NewReviewerClient returns a client for the Reviewer from the given config.
+func NewReviewerClient(c config) *ReviewerClient {
+	return &ReviewerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reviewer.Hooks(f(g(h())))`.
+func (c *ReviewerClient) Use(hooks ...Hook) {
+	c.hooks.Reviewer = append(c.hooks.Reviewer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reviewer.Intercept(f(g(h())))`.
+func (c *ReviewerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reviewer = append(c.inters.Reviewer, interceptors...)
+}
+
+// Create returns a builder for creating a Reviewer entity.
+func (c *ReviewerClient) Create() *ReviewerCreate {
+	mutation := newReviewerMutation(c.config, OpCreate)
+	return &ReviewerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reviewer entities.
+func (c *ReviewerClient) CreateBulk(builders ...*ReviewerCreate) *ReviewerCreateBulk {
+	return &ReviewerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewerClient) MapCreateBulk(slice any, setFunc func(*ReviewerCreate, int)) *ReviewerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewerCreateBulk{err: fmt.Errorf("calling to ReviewerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reviewer.
+func (c *ReviewerClient) Update() *ReviewerUpdate {
+	mutation := newReviewerMutation(c.config, OpUpdate)
+	return &ReviewerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewerClient) UpdateOne(r *Reviewer) *ReviewerUpdateOne {
+	mutation := newReviewerMutation(c.config, OpUpdateOne, withReviewer(r))
+	return &ReviewerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewerClient) UpdateOneID(id uint64) *ReviewerUpdateOne {
+	mutation := newReviewerMutation(c.config, OpUpdateOne, withReviewerID(id))
+	return &ReviewerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reviewer.
+func (c *ReviewerClient) Delete() *ReviewerDelete {
+	mutation := newReviewerMutation(c.config, OpDelete)
+	return &ReviewerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewerClient) DeleteOne(r *Reviewer) *ReviewerDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewerClient) DeleteOneID(id uint64) *ReviewerDeleteOne {
+	builder := c.Delete().Where(reviewer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewerDeleteOne{builder}
+}
+
+// Query returns a query builder for Reviewer.
+func (c *ReviewerClient) Query() *ReviewerQuery {
+	return &ReviewerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReviewer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reviewer entity by its id.
+func (c *ReviewerClient) Get(ctx context.Context, id uint64) (*Reviewer, error) {
+	return c.Query().Where(reviewer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewerClient) GetX(ctx context.Context, id uint64) *Reviewer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewerClient) Hooks() []Hook {
+	return c.hooks.Reviewer
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewerClient) Interceptors() []Interceptor {
+	return c.inters.Reviewer
+}
+
+func (c *ReviewerClient) mutate(ctx context.Context, m *ReviewerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReviewerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReviewerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reviewer mutation op: %q", m.Op())
+	}
+}
+
+// SearchDocumentClient is a client for the SearchDocument schema.
+type SearchDocumentClient struct {
+	config
+}
+
+// NewSearchDocumentClient returns a client for the SearchDocument from the given config.
+func NewSearchDocumentClient(c config) *SearchDocumentClient {
+	return &SearchDocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchdocument.Hooks(f(g(h())))`.
+func (c *SearchDocumentClient) Use(hooks ...Hook) {
+	c.hooks.SearchDocument = append(c.hooks.SearchDocument, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchdocument.Intercept(f(g(h())))`.
+func (c *SearchDocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchDocument = append(c.inters.SearchDocument, interceptors...)
+}
+
+// Create returns a builder for creating a SearchDocument entity.
+func (c *SearchDocumentClient) Create() *SearchDocumentCreate {
+	mutation := newSearchDocumentMutation(c.config, OpCreate)
+	return &SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchDocument entities.
+func (c *SearchDocumentClient) CreateBulk(builders ...*SearchDocumentCreate) *SearchDocumentCreateBulk {
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchDocumentClient) MapCreateBulk(slice any, setFunc func(*SearchDocumentCreate, int)) *SearchDocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchDocumentCreateBulk{err: fmt.Errorf("calling to SearchDocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchDocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchDocument.
+func (c *SearchDocumentClient) Update() *SearchDocumentUpdate {
+	mutation := newSearchDocumentMutation(c.config, OpUpdate)
+	return &SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchDocumentClient) UpdateOne(sd *SearchDocument) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocument(sd))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchDocumentClient) UpdateOneID(id uint64) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocumentID(id))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchDocument.
+func (c *SearchDocumentClient) Delete() *SearchDocumentDelete {
+	mutation := newSearchDocumentMutation(c.config, OpDelete)
+	return &SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchDocumentClient) DeleteOne(sd *SearchDocument) *SearchDocumentDeleteOne {
+	return c.DeleteOneID(sd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchDocumentClient) DeleteOneID(id uint64) *SearchDocumentDeleteOne {
+	builder := c.Delete().Where(searchdocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchDocument.
+func (c *SearchDocumentClient) Query() *SearchDocumentQuery {
+	return &SearchDocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchDocument entity by its id.
+func (c *SearchDocumentClient) Get(ctx context.Context, id uint64) (*SearchDocument, error) {
+	return c.Query().Where(searchdocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchDocumentClient) GetX(ctx context.Context, id uint64) *SearchDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchDocumentClient) Hooks() []Hook {
+	return c.hooks.SearchDocument
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchDocumentClient) Interceptors() []Interceptor {
+	return c.inters.SearchDocument
+}
+
+func (c *SearchDocumentClient) mutate(ctx context.Context, m *SearchDocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchDocument mutation op: %q", m.Op())
+	}
+}
+
+// ShowClient is a client for the Show schema.
+type ShowClient struct {
+	config
+}
+
+// NewShowClient returns a client for the Show from the given config.
+func NewShowClient(c config) *ShowClient {
+	return &ShowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `show.Hooks(f(g(h())))`.
+func (c *ShowClient) Use(hooks ...Hook) {
+	c.hooks.Show = append(c.hooks.Show, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `show.Intercept(f(g(h())))`.
+func (c *ShowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Show = append(c.inters.Show, interceptors...)
+}
+
+// Create returns a builder for creating a Show entity.
+func (c *ShowClient) Create() *ShowCreate {
+	mutation := newShowMutation(c.config, OpCreate)
+	return &ShowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Show entities.
+func (c *ShowClient) CreateBulk(builders ...*ShowCreate) *ShowCreateBulk {
+	return &ShowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShowClient) MapCreateBulk(slice any, setFunc func(*ShowCreate, int)) *ShowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShowCreateBulk{err: fmt.Errorf("calling to ShowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Show.
+func (c *ShowClient) Update() *ShowUpdate {
+	mutation := newShowMutation(c.config, OpUpdate)
+	return &ShowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShowClient) UpdateOne(s *Show) *ShowUpdateOne {
+	mutation := newShowMutation(c.config, OpUpdateOne, withShow(s))
+	return &ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShowClient) UpdateOneID(id uint64) *ShowUpdateOne {
+	mutation := newShowMutation(c.config, OpUpdateOne, withShowID(id))
+	return &ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Show.
+func (c *ShowClient) Delete() *ShowDelete {
+	mutation := newShowMutation(c.config, OpDelete)
+	return &ShowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShowClient) DeleteOne(s *Show) *ShowDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShowClient) DeleteOneID(id uint64) *ShowDeleteOne {
+	builder := c.Delete().Where(show.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShowDeleteOne{builder}
+}
+
+// Query returns a query builder for Show.
+func (c *ShowClient) Query() *ShowQuery {
+	return &ShowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Show entity by its id.
+func (c *ShowClient) Get(ctx context.Context, id uint64) (*Show, error) {
+	return c.Query().Where(show.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShowClient) GetX(ctx context.Context, id uint64) *Show {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShowReserves queries the show_reserves edge of a Show.
+func (c *ShowClient) QueryShowReserves(s *Show) *ShowReserveQuery {
+	query := (&ShowReserveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(show.Table, show.FieldID, id),
+			sqlgraph.To(showreserve.Table, showreserve.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, show.ShowReservesTable, show.ShowReservesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShowClient) Hooks() []Hook {
+	return c.hooks.Show
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShowClient) Interceptors() []Interceptor {
+	return c.inters.Show
+}
+
+func (c *ShowClient) mutate(ctx context.Context, m *ShowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Show mutation op: %q", m.Op())
+	}
+}
+
+// ShowReserveClient is a client for the ShowReserve schema.
+type ShowReserveClient struct {
+	config
+}
+
+// NewShowReserveClient returns a client for the ShowReserve from the given config.
+func NewShowReserveClient(c config) *ShowReserveClient {
+	return &ShowReserveClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `showreserve.Hooks(f(g(h())))`.
+func (c *ShowReserveClient) Use(hooks ...Hook) {
+	c.hooks.ShowReserve = append(c.hooks.ShowReserve, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `showreserve.Intercept(f(g(h())))`.
+func (c *ShowReserveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShowReserve = append(c.inters.ShowReserve, interceptors...)
+}
+
+// Create returns a builder for creating a ShowReserve entity.
+func (c *ShowReserveClient) Create() *ShowReserveCreate {
+	mutation := newShowReserveMutation(c.config, OpCreate)
+	return &ShowReserveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShowReserve entities.
+func (c *ShowReserveClient) CreateBulk(builders ...*ShowReserveCreate) *ShowReserveCreateBulk {
+	return &ShowReserveCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShowReserveClient) MapCreateBulk(slice any, setFunc func(*ShowReserveCreate, int)) *ShowReserveCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShowReserveCreateBulk{err: fmt.Errorf("calling to ShowReserveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShowReserveCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShowReserveCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShowReserve.
+func (c *ShowReserveClient) Update() *ShowReserveUpdate {
+	mutation := newShowReserveMutation(c.config, OpUpdate)
+	return &ShowReserveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShowReserveClient) UpdateOne(sr *ShowReserve) *ShowReserveUpdateOne {
+	mutation := newShowReserveMutation(c.config, OpUpdateOne, withShowReserve(sr))
+	return &ShowReserveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShowReserveClient) UpdateOneID(id uint64) *ShowReserveUpdateOne {
+	mutation := newShowReserveMutation(c.config, OpUpdateOne, withShowReserveID(id))
+	return &ShowReserveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShowReserve.
+func (c *ShowReserveClient) Delete() *ShowReserveDelete {
+	mutation := newShowReserveMutation(c.config, OpDelete)
+	return &ShowReserveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShowReserveClient) DeleteOne(sr *ShowReserve) *ShowReserveDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShowReserveClient) DeleteOneID(id uint64) *ShowReserveDeleteOne {
+	builder := c.Delete().Where(showreserve.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShowReserveDeleteOne{builder}
+}
+
+// Query returns a query builder for ShowReserve.
+func (c *ShowReserveClient) Query() *ShowReserveQuery {
+	return &ShowReserveQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShowReserve},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShowReserve entity by its id.
+func (c *ShowReserveClient) Get(ctx context.Context, id uint64) (*ShowReserve, error) {
+	return c.Query().Where(showreserve.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShowReserveClient) GetX(ctx context.Context, id uint64) *ShowReserve {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryShow queries the show edge of a ShowReserve.
+func (c *ShowReserveClient) QueryShow(sr *ShowReserve) *ShowQuery {
+	query := (&ShowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(showreserve.Table, showreserve.FieldID, id),
+			sqlgraph.To(show.Table, show.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, showreserve.ShowTable, showreserve.ShowColumn),
+		)
+		fromV = sqlgraph.Neighbors(sr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShowReserveClient) Hooks() []Hook {
+	return c.hooks.ShowReserve
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShowReserveClient) Interceptors() []Interceptor {
+	return c.inters.ShowReserve
+}
+
+func (c *ShowReserveClient) mutate(ctx context.Context, m *ShowReserveMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShowReserveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShowReserveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShowReserveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShowReserveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShowReserve mutation op: %q", m.Op())
+	}
+}
+
+// StepClient is a client for the Step schema.
+type StepClient struct {
+	config
+}
+
+// NewStepClient returns a client for the Step from the given config.
+func NewStepClient(c config) *StepClient {
+	return &StepClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `step.Hooks(f(g(h())))`.
+func (c *StepClient) Use(hooks ...Hook) {
+	c.hooks.Step = append(c.hooks.Step, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `step.Intercept(f(g(h())))`.
+func (c *StepClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Step = append(c.inters.Step, interceptors...)
+}
+
+// Create returns a builder for creating a Step entity.
+func (c *StepClient) Create() *StepCreate {
+	mutation := newStepMutation(c.config, OpCreate)
+	return &StepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Step entities.
+func (c *StepClient) CreateBulk(builders ...*StepCreate) *StepCreateBulk {
+	return &StepCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StepClient) MapCreateBulk(slice any, setFunc func(*StepCreate, int)) *StepCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StepCreateBulk{err: fmt.Errorf("calling to StepClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StepCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StepCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Step.
+func (c *StepClient) Update() *StepUpdate {
+	mutation := newStepMutation(c.config, OpUpdate)
+	return &StepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StepClient) UpdateOne(s *Step) *StepUpdateOne {
+	mutation := newStepMutation(c.config, OpUpdateOne, withStep(s))
+	return &StepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StepClient) UpdateOneID(id uint64) *StepUpdateOne {
+	mutation := newStepMutation(c.config, OpUpdateOne, withStepID(id))
+	return &StepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Step.
+func (c *StepClient) Delete() *StepDelete {
+	mutation := newStepMutation(c.config, OpDelete)
+	return &StepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StepClient) DeleteOne(s *Step) *StepDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StepClient) DeleteOneID(id uint64) *StepDeleteOne {
+	builder := c.Delete().Where(step.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StepDeleteOne{builder}
+}
+
+// Query returns a query builder for Step.
+func (c *StepClient) Query() *StepQuery {
+	return &StepQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStep},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Step entity by its id.
+func (c *StepClient) Get(ctx context.Context, id uint64) (*Step, error) {
+	return c.Query().Where(step.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StepClient) GetX(ctx context.Context, id uint64) *Step {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTarget queries the target edge of a Step.
+func (c *StepClient) QueryTarget(s *Step) *TargetQuery {
+	query := (&TargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(step.Table, step.FieldID, id),
 			sqlgraph.To(target.Table, target.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, step.TargetTable, step.TargetColumn),
 		)
 		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// QueryParent queries the parent edge of a Step.
+func (c *StepClient) QueryParent(s *Step) *StepQuery {
+	query := (&StepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(step.Table, step.FieldID, id),
+			sqlgraph.To(step.Table, step.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, step.ParentTable, step.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Step.
+func (c *StepClient) QueryChildren(s *Step) *StepQuery {
+	query := (&StepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(step.Table, step.FieldID, id),
+			sqlgraph.To(step.Table, step.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, step.ChildrenTable, step.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Step.
+func (c *StepClient) QueryTags(s *Step) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(step.Table, step.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, step.TagsTable, step.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StepClient) Hooks() []Hook {
+	return c.hooks.Step
+}
+
+// Interceptors returns the client interceptors.
+func (c *StepClient) Interceptors() []Interceptor {
+	return c.inters.Step
+}
+
+func (c *StepClient) mutate(ctx context.Context, m *StepMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Step mutation op: %q", m.Op())
+	}
+}
+
+// StepRateClient is a client for the StepRate schema.
+type StepRateClient struct {
+	config
+}
+
+// NewStepRateClient returns a client for the StepRate from the given config.
+func NewStepRateClient(c config) *StepRateClient {
+	return &StepRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `steprate.Hooks(f(g(h())))`.
+func (c *StepRateClient) Use(hooks ...Hook) {
+	c.hooks.StepRate = append(c.hooks.StepRate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `steprate.Intercept(f(g(h())))`.
+func (c *StepRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StepRate = append(c.inters.StepRate, interceptors...)
+}
+
+// Create returns a builder for creating a StepRate entity.
+func (c *StepRateClient) Create() *StepRateCreate {
+	mutation := newStepRateMutation(c.config, OpCreate)
+	return &StepRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StepRate entities.
+func (c *StepRateClient) CreateBulk(builders ...*StepRateCreate) *StepRateCreateBulk {
+	return &StepRateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StepRateClient) MapCreateBulk(slice any, setFunc func(*StepRateCreate, int)) *StepRateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StepRateCreateBulk{err: fmt.Errorf("calling to StepRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StepRateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StepRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StepRate.
+func (c *StepRateClient) Update() *StepRateUpdate {
+	mutation := newStepRateMutation(c.config, OpUpdate)
+	return &StepRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StepRateClient) UpdateOne(sr *StepRate) *StepRateUpdateOne {
+	mutation := newStepRateMutation(c.config, OpUpdateOne, withStepRate(sr))
+	return &StepRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StepRateClient) UpdateOneID(id uint64) *StepRateUpdateOne {
+	mutation := newStepRateMutation(c.config, OpUpdateOne, withStepRateID(id))
+	return &StepRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StepRate.
+func (c *StepRateClient) Delete() *StepRateDelete {
+	mutation := newStepRateMutation(c.config, OpDelete)
+	return &StepRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StepRateClient) DeleteOne(sr *StepRate) *StepRateDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StepRateClient) DeleteOneID(id uint64) *StepRateDeleteOne {
+	builder := c.Delete().Where(steprate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StepRateDeleteOne{builder}
+}
+
+// Query returns a query builder for StepRate.
+func (c *StepRateClient) Query() *StepRateQuery {
+	return &StepRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStepRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StepRate entity by its id.
+func (c *StepRateClient) Get(ctx context.Context, id uint64) (*StepRate, error) {
+	return c.Query().Where(steprate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StepRateClient) GetX(ctx context.Context, id uint64) *StepRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StepRateClient) Hooks() []Hook {
+	return c.hooks.StepRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *StepRateClient) Interceptors() []Interceptor {
+	return c.inters.StepRate
+}
+
+func (c *StepRateClient) mutate(ctx context.Context, m *StepRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StepRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StepRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StepRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StepRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StepRate mutation op: %q", m.Op())
+	}
+}
+
+// StepRateCriterionClient is a client for the StepRateCriterion schema.
+type StepRateCriterionClient struct {
+	config
+}
+
+// NewStepRateCriterionClient returns a client for the StepRateCriterion from the given config.
+func NewStepRateCriterionClient(c config) *StepRateCriterionClient {
+	return &StepRateCriterionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stepratecriterion.Hooks(f(g(h())))`.
+func (c *StepRateCriterionClient) Use(hooks ...Hook) {
+	c.hooks.StepRateCriterion = append(c.hooks.StepRateCriterion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stepratecriterion.Intercept(f(g(h())))`.
+func (c *StepRateCriterionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StepRateCriterion = append(c.inters.StepRateCriterion, interceptors...)
+}
+
+// Create returns a builder for creating a StepRateCriterion entity.
+func (c *StepRateCriterionClient) Create() *StepRateCriterionCreate {
+	mutation := newStepRateCriterionMutation(c.config, OpCreate)
+	return &StepRateCriterionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StepRateCriterion entities.
+func (c *StepRateCriterionClient) CreateBulk(builders ...*StepRateCriterionCreate) *StepRateCriterionCreateBulk {
+	return &StepRateCriterionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StepRateCriterionClient) MapCreateBulk(slice any, setFunc func(*StepRateCriterionCreate, int)) *StepRateCriterionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StepRateCriterionCreateBulk{err: fmt.Errorf("calling to StepRateCriterionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StepRateCriterionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StepRateCriterionCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryParent queries the parent edge of a Step.
-func (c *StepClient) QueryParent(s *Step) *StepQuery {
-	query := (&StepClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(step.Table, step.FieldID, id),
-			sqlgraph.To(step.Table, step.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, step.ParentTable, step.ParentColumn),
-		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for StepRateCriterion.
+func (c *StepRateCriterionClient) Update() *StepRateCriterionUpdate {
+	mutation := newStepRateCriterionMutation(c.config, OpUpdate)
+	return &StepRateCriterionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StepRateCriterionClient) UpdateOne(src *StepRateCriterion) *StepRateCriterionUpdateOne {
+	mutation := newStepRateCriterionMutation(c.config, OpUpdateOne, withStepRateCriterion(src))
+	return &StepRateCriterionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StepRateCriterionClient) UpdateOneID(id uint64) *StepRateCriterionUpdateOne {
+	mutation := newStepRateCriterionMutation(c.config, OpUpdateOne, withStepRateCriterionID(id))
+	return &StepRateCriterionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StepRateCriterion.
+func (c *StepRateCriterionClient) Delete() *StepRateCriterionDelete {
+	mutation := newStepRateCriterionMutation(c.config, OpDelete)
+	return &StepRateCriterionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StepRateCriterionClient) DeleteOne(src *StepRateCriterion) *StepRateCriterionDeleteOne {
+	return c.DeleteOneID(src.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StepRateCriterionClient) DeleteOneID(id uint64) *StepRateCriterionDeleteOne {
+	builder := c.Delete().Where(stepratecriterion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StepRateCriterionDeleteOne{builder}
+}
+
+// Query returns a query builder for StepRateCriterion.
+func (c *StepRateCriterionClient) Query() *StepRateCriterionQuery {
+	return &StepRateCriterionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStepRateCriterion},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryChildren queries the children edge of a Step.
-func (c *StepClient) QueryChildren(s *Step) *StepQuery {
-	query := (&StepClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := s.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(step.Table, step.FieldID, id),
-			sqlgraph.To(step.Table, step.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, step.ChildrenTable, step.ChildrenColumn),
-		)
-		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a StepRateCriterion entity by its id.
+func (c *StepRateCriterionClient) Get(ctx context.Context, id uint64) (*StepRateCriterion, error) {
+	return c.Query().Where(stepratecriterion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StepRateCriterionClient) GetX(ctx context.Context, id uint64) *StepRateCriterion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *StepClient) Hooks() []Hook {
-	return c.hooks.Step
+func (c *StepRateCriterionClient) Hooks() []Hook {
+	return c.hooks.StepRateCriterion
 }
 
 // Interceptors returns the client interceptors.
-func (c *StepClient) Interceptors() []Interceptor {
-	return c.inters.Step
+func (c *StepRateCriterionClient) Interceptors() []Interceptor {
+	return c.inters.StepRateCriterion
 }
 
-func (c *StepClient) mutate(ctx context.Context, m *StepMutation) (Value, error) {
+func (c *StepRateCriterionClient) mutate(ctx context.Context, m *StepRateCriterionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&StepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StepRateCriterionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&StepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StepRateCriterionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&StepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&StepRateCriterionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&StepRateCriterionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Step mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown StepRateCriterion mutation op: %q", m.Op())
 	}
 }
 
-// StepRateClient is a client for the StepRate schema.
-type StepRateClient struct {
+// TagClient is a client for the Tag schema.
+type TagClient struct {
 	config
 }
 
-// NewStepRateClient returns a client for the StepRate from the given config.
-func NewStepRateClient(c config) *StepRateClient {
-	return &StepRateClient{config: c}
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `steprate.Hooks(f(g(h())))`.
-func (c *StepRateClient) Use(hooks ...Hook) {
-	c.hooks.StepRate = append(c.hooks.StepRate, hooks...)
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `steprate.Intercept(f(g(h())))`.
-func (c *StepRateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.StepRate = append(c.inters.StepRate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
 }
 
-// Create returns a builder for creating a StepRate entity.
-func (c *StepRateClient) Create() *StepRateCreate {
-	mutation := newStepRateMutation(c.config, OpCreate)
-	return &StepRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of StepRate entities.
-func (c *StepRateClient) CreateBulk(builders ...*StepRateCreate) *StepRateCreateBulk {
-	return &StepRateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *StepRateClient) MapCreateBulk(slice any, setFunc func(*StepRateCreate, int)) *StepRateCreateBulk {
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &StepRateCreateBulk{err: fmt.Errorf("calling to StepRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*StepRateCreate, rv.Len())
+	builders := make([]*TagCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &StepRateCreateBulk{config: c.config, builders: builders}
+	return &TagCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for StepRate.
-func (c *StepRateClient) Update() *StepRateUpdate {
-	mutation := newStepRateMutation(c.config, OpUpdate)
-	return &StepRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *StepRateClient) UpdateOne(sr *StepRate) *StepRateUpdateOne {
-	mutation := newStepRateMutation(c.config, OpUpdateOne, withStepRate(sr))
-	return &StepRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOne(t *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(t))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *StepRateClient) UpdateOneID(id uint64) *StepRateUpdateOne {
-	mutation := newStepRateMutation(c.config, OpUpdateOne, withStepRateID(id))
-	return &StepRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id uint64) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for StepRate.
-func (c *StepRateClient) Delete() *StepRateDelete {
-	mutation := newStepRateMutation(c.config, OpDelete)
-	return &StepRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *StepRateClient) DeleteOne(sr *StepRate) *StepRateDeleteOne {
-	return c.DeleteOneID(sr.ID)
+func (c *TagClient) DeleteOne(t *Tag) *TagDeleteOne {
+	return c.DeleteOneID(t.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *StepRateClient) DeleteOneID(id uint64) *StepRateDeleteOne {
-	builder := c.Delete().Where(steprate.ID(id))
+func (c *TagClient) DeleteOneID(id uint64) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &StepRateDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for StepRate.
-func (c *StepRateClient) Query() *StepRateQuery {
-	return &StepRateQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeStepRate},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a StepRate entity by its id.
-func (c *StepRateClient) Get(ctx context.Context, id uint64) (*StepRate, error) {
-	return c.Query().Where(steprate.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uint64) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *StepRateClient) GetX(ctx context.Context, id uint64) *StepRate {
+func (c *TagClient) GetX(ctx context.Context, id uint64) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1107,28 +2579,60 @@ func (c *StepRateClient) GetX(ctx context.Context, id uint64) *StepRate {
 	return obj
 }
 
+// QueryTargets queries the targets edge of a Tag.
+func (c *TagClient) QueryTargets(t *Tag) *TargetQuery {
+	query := (&TargetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(target.Table, target.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.TargetsTable, tag.TargetsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySteps queries the steps edge of a Tag.
+func (c *TagClient) QuerySteps(t *Tag) *StepQuery {
+	query := (&StepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(step.Table, step.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.StepsTable, tag.StepsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *StepRateClient) Hooks() []Hook {
-	return c.hooks.StepRate
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *StepRateClient) Interceptors() []Interceptor {
-	return c.inters.StepRate
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *StepRateClient) mutate(ctx context.Context, m *StepRateMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&StepRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&StepRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&StepRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StepRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown StepRate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
@@ -1288,6 +2792,22 @@ func (c *TargetClient) QuerySteps(t *Target) *StepQuery {
 	return query
 }
 
+// QueryTags queries the tags edge of a Target.
+func (c *TargetClient) QueryTags(t *Target) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(target.Table, target.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, target.TagsTable, target.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TargetClient) Hooks() []Hook {
 	return c.hooks.Target
@@ -1313,12 +2833,173 @@ func (c *TargetClient) mutate(ctx context.Context, m *TargetMutation) (Value, er
 	}
 }
 
+// TemplateClient is a client for the Template schema.
+type TemplateClient struct {
+	config
+}
+
+// NewTemplateClient returns a client for the Template from the given config.
+func NewTemplateClient(c config) *TemplateClient {
+	return &TemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `template.Hooks(f(g(h())))`.
+func (c *TemplateClient) Use(hooks ...Hook) {
+	c.hooks.Template = append(c.hooks.Template, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `template.Intercept(f(g(h())))`.
+func (c *TemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Template = append(c.inters.Template, interceptors...)
+}
+
+// Create returns a builder for creating a Template entity.
+func (c *TemplateClient) Create() *TemplateCreate {
+	mutation := newTemplateMutation(c.config, OpCreate)
+	return &TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Template entities.
+func (c *TemplateClient) CreateBulk(builders ...*TemplateCreate) *TemplateCreateBulk {
+	return &TemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TemplateClient) MapCreateBulk(slice any, setFunc func(*TemplateCreate, int)) *TemplateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TemplateCreateBulk{err: fmt.Errorf("calling to TemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TemplateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Template.
+func (c *TemplateClient) Update() *TemplateUpdate {
+	mutation := newTemplateMutation(c.config, OpUpdate)
+	return &TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TemplateClient) UpdateOne(t *Template) *TemplateUpdateOne {
+	mutation := newTemplateMutation(c.config, OpUpdateOne, withTemplate(t))
+	return &TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TemplateClient) UpdateOneID(id uint64) *TemplateUpdateOne {
+	mutation := newTemplateMutation(c.config, OpUpdateOne, withTemplateID(id))
+	return &TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Template.
+func (c *TemplateClient) Delete() *TemplateDelete {
+	mutation := newTemplateMutation(c.config, OpDelete)
+	return &TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TemplateClient) DeleteOne(t *Template) *TemplateDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TemplateClient) DeleteOneID(id uint64) *TemplateDeleteOne {
+	builder := c.Delete().Where(template.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for Template.
+func (c *TemplateClient) Query() *TemplateQuery {
+	return &TemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Template entity by its id.
+func (c *TemplateClient) Get(ctx context.Context, id uint64) (*Template, error) {
+	return c.Query().Where(template.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TemplateClient) GetX(ctx context.Context, id uint64) *Template {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TemplateClient) Hooks() []Hook {
+	return c.hooks.Template
+}
+
+// Interceptors returns the client interceptors.
+func (c *TemplateClient) Interceptors() []Interceptor {
+	return c.inters.Template
+}
+
+func (c *TemplateClient) mutate(ctx context.Context, m *TemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Template mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Award, Portrait, Show, ShowReserve, Step, StepRate, Target []ent.Hook
+		Award, Comment, Export, ImportItem, ImportJob, Portrait, Report, ReviewRequest,
+		Reviewer, SearchDocument, Show, ShowReserve, Step, StepRate, StepRateCriterion,
+		Tag, Target, Template []ent.Hook
 	}
 	inters struct {
-		Award, Portrait, Show, ShowReserve, Step, StepRate, Target []ent.Interceptor
+		Award, Comment, Export, ImportItem, ImportJob, Portrait, Report, ReviewRequest,
+		Reviewer, SearchDocument, Show, ShowReserve, Step, StepRate, StepRateCriterion,
+		Tag, Target, Template []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
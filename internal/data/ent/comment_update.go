@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/comment"
+	"step/internal/data/ent/predicate"
+	"step/internal/objects"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentUpdate is the builder for updating Comment entities.
+type CommentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetStepID sets the "step_id" field.
+func (cu *CommentUpdate) SetStepID(u uint64) *CommentUpdate {
+	cu.mutation.ResetStepID()
+	cu.mutation.SetStepID(u)
+	return cu
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableStepID(u *uint64) *CommentUpdate {
+	if u != nil {
+		cu.SetStepID(*u)
+	}
+	return cu
+}
+
+// AddStepID adds u to the "step_id" field.
+func (cu *CommentUpdate) AddStepID(u int64) *CommentUpdate {
+	cu.mutation.AddStepID(u)
+	return cu
+}
+
+// SetAuthorID sets the "author_id" field.
+func (cu *CommentUpdate) SetAuthorID(s string) *CommentUpdate {
+	cu.mutation.SetAuthorID(s)
+	return cu
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableAuthorID(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetAuthorID(*s)
+	}
+	return cu
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (cu *CommentUpdate) ClearAuthorID() *CommentUpdate {
+	cu.mutation.ClearAuthorID()
+	return cu
+}
+
+// SetRole sets the "role" field.
+func (cu *CommentUpdate) SetRole(c comment.Role) *CommentUpdate {
+	cu.mutation.SetRole(c)
+	return cu
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableRole(c *comment.Role) *CommentUpdate {
+	if c != nil {
+		cu.SetRole(*c)
+	}
+	return cu
+}
+
+// SetRating sets the "rating" field.
+func (cu *CommentUpdate) SetRating(m map[string]interface{}) *CommentUpdate {
+	cu.mutation.SetRating(m)
+	return cu
+}
+
+// ClearRating clears the value of the "rating" field.
+func (cu *CommentUpdate) ClearRating() *CommentUpdate {
+	cu.mutation.ClearRating()
+	return cu
+}
+
+// SetContent sets the "content" field.
+func (cu *CommentUpdate) SetContent(s string) *CommentUpdate {
+	cu.mutation.SetContent(s)
+	return cu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableContent(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetContent(*s)
+	}
+	return cu
+}
+
+// ClearContent clears the value of the "content" field.
+func (cu *CommentUpdate) ClearContent() *CommentUpdate {
+	cu.mutation.ClearContent()
+	return cu
+}
+
+// SetParentID sets the "parent_id" field.
+func (cu *CommentUpdate) SetParentID(u uint64) *CommentUpdate {
+	cu.mutation.ResetParentID()
+	cu.mutation.SetParentID(u)
+	return cu
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableParentID(u *uint64) *CommentUpdate {
+	if u != nil {
+		cu.SetParentID(*u)
+	}
+	return cu
+}
+
+// AddParentID adds u to the "parent_id" field.
+func (cu *CommentUpdate) AddParentID(u int64) *CommentUpdate {
+	cu.mutation.AddParentID(u)
+	return cu
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cu *CommentUpdate) ClearParentID() *CommentUpdate {
+	cu.mutation.ClearParentID()
+	return cu
+}
+
+// SetHistory sets the "history" field.
+func (cu *CommentUpdate) SetHistory(or []objects.CommentRevision) *CommentUpdate {
+	cu.mutation.SetHistory(or)
+	return cu
+}
+
+// AppendHistory appends or to the "history" field.
+func (cu *CommentUpdate) AppendHistory(or []objects.CommentRevision) *CommentUpdate {
+	cu.mutation.AppendHistory(or)
+	return cu
+}
+
+// ClearHistory clears the value of the "history" field.
+func (cu *CommentUpdate) ClearHistory() *CommentUpdate {
+	cu.mutation.ClearHistory()
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CommentUpdate) SetUpdatedAt(i int64) *CommentUpdate {
+	cu.mutation.ResetUpdatedAt()
+	cu.mutation.SetUpdatedAt(i)
+	return cu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableUpdatedAt(i *int64) *CommentUpdate {
+	if i != nil {
+		cu.SetUpdatedAt(*i)
+	}
+	return cu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (cu *CommentUpdate) AddUpdatedAt(i int64) *CommentUpdate {
+	cu.mutation.AddUpdatedAt(i)
+	return cu
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CommentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CommentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	if v, ok := cu.mutation.Role(); ok {
+		if err := comment.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Comment.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cu *CommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdate {
+	cu.modifiers = append(cu.modifiers, modifiers...)
+	return cu
+}
+
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint64))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.StepID(); ok {
+		_spec.SetField(comment.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := cu.mutation.AddedStepID(); ok {
+		_spec.AddField(comment.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := cu.mutation.AuthorID(); ok {
+		_spec.SetField(comment.FieldAuthorID, field.TypeString, value)
+	}
+	if cu.mutation.AuthorIDCleared() {
+		_spec.ClearField(comment.FieldAuthorID, field.TypeString)
+	}
+	if value, ok := cu.mutation.Role(); ok {
+		_spec.SetField(comment.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.Rating(); ok {
+		_spec.SetField(comment.FieldRating, field.TypeJSON, value)
+	}
+	if cu.mutation.RatingCleared() {
+		_spec.ClearField(comment.FieldRating, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+	}
+	if cu.mutation.ContentCleared() {
+		_spec.ClearField(comment.FieldContent, field.TypeString)
+	}
+	if value, ok := cu.mutation.ParentID(); ok {
+		_spec.SetField(comment.FieldParentID, field.TypeUint64, value)
+	}
+	if value, ok := cu.mutation.AddedParentID(); ok {
+		_spec.AddField(comment.FieldParentID, field.TypeUint64, value)
+	}
+	if cu.mutation.ParentIDCleared() {
+		_spec.ClearField(comment.FieldParentID, field.TypeUint64)
+	}
+	if value, ok := cu.mutation.History(); ok {
+		_spec.SetField(comment.FieldHistory, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldHistory, value)
+		})
+	}
+	if cu.mutation.HistoryCleared() {
+		_spec.ClearField(comment.FieldHistory, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(comment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(cu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CommentUpdateOne is the builder for updating a single Comment entity.
+type CommentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStepID sets the "step_id" field.
+func (cuo *CommentUpdateOne) SetStepID(u uint64) *CommentUpdateOne {
+	cuo.mutation.ResetStepID()
+	cuo.mutation.SetStepID(u)
+	return cuo
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableStepID(u *uint64) *CommentUpdateOne {
+	if u != nil {
+		cuo.SetStepID(*u)
+	}
+	return cuo
+}
+
+// AddStepID adds u to the "step_id" field.
+func (cuo *CommentUpdateOne) AddStepID(u int64) *CommentUpdateOne {
+	cuo.mutation.AddStepID(u)
+	return cuo
+}
+
+// SetAuthorID sets the "author_id" field.
+func (cuo *CommentUpdateOne) SetAuthorID(s string) *CommentUpdateOne {
+	cuo.mutation.SetAuthorID(s)
+	return cuo
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableAuthorID(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetAuthorID(*s)
+	}
+	return cuo
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (cuo *CommentUpdateOne) ClearAuthorID() *CommentUpdateOne {
+	cuo.mutation.ClearAuthorID()
+	return cuo
+}
+
+// SetRole sets the "role" field.
+func (cuo *CommentUpdateOne) SetRole(c comment.Role) *CommentUpdateOne {
+	cuo.mutation.SetRole(c)
+	return cuo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableRole(c *comment.Role) *CommentUpdateOne {
+	if c != nil {
+		cuo.SetRole(*c)
+	}
+	return cuo
+}
+
+// SetRating sets the "rating" field.
+func (cuo *CommentUpdateOne) SetRating(m map[string]interface{}) *CommentUpdateOne {
+	cuo.mutation.SetRating(m)
+	return cuo
+}
+
+// ClearRating clears the value of the "rating" field.
+func (cuo *CommentUpdateOne) ClearRating() *CommentUpdateOne {
+	cuo.mutation.ClearRating()
+	return cuo
+}
+
+// SetContent sets the "content" field.
+func (cuo *CommentUpdateOne) SetContent(s string) *CommentUpdateOne {
+	cuo.mutation.SetContent(s)
+	return cuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableContent(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetContent(*s)
+	}
+	return cuo
+}
+
+// ClearContent clears the value of the "content" field.
+func (cuo *CommentUpdateOne) ClearContent() *CommentUpdateOne {
+	cuo.mutation.ClearContent()
+	return cuo
+}
+
+// SetParentID sets the "parent_id" field.
+func (cuo *CommentUpdateOne) SetParentID(u uint64) *CommentUpdateOne {
+	cuo.mutation.ResetParentID()
+	cuo.mutation.SetParentID(u)
+	return cuo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableParentID(u *uint64) *CommentUpdateOne {
+	if u != nil {
+		cuo.SetParentID(*u)
+	}
+	return cuo
+}
+
+// AddParentID adds u to the "parent_id" field.
+func (cuo *CommentUpdateOne) AddParentID(u int64) *CommentUpdateOne {
+	cuo.mutation.AddParentID(u)
+	return cuo
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (cuo *CommentUpdateOne) ClearParentID() *CommentUpdateOne {
+	cuo.mutation.ClearParentID()
+	return cuo
+}
+
+// SetHistory sets the "history" field.
+func (cuo *CommentUpdateOne) SetHistory(or []objects.CommentRevision) *CommentUpdateOne {
+	cuo.mutation.SetHistory(or)
+	return cuo
+}
+
+// AppendHistory appends or to the "history" field.
+func (cuo *CommentUpdateOne) AppendHistory(or []objects.CommentRevision) *CommentUpdateOne {
+	cuo.mutation.AppendHistory(or)
+	return cuo
+}
+
+// ClearHistory clears the value of the "history" field.
+func (cuo *CommentUpdateOne) ClearHistory() *CommentUpdateOne {
+	cuo.mutation.ClearHistory()
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CommentUpdateOne) SetUpdatedAt(i int64) *CommentUpdateOne {
+	cuo.mutation.ResetUpdatedAt()
+	cuo.mutation.SetUpdatedAt(i)
+	return cuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableUpdatedAt(i *int64) *CommentUpdateOne {
+	if i != nil {
+		cuo.SetUpdatedAt(*i)
+	}
+	return cuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (cuo *CommentUpdateOne) AddUpdatedAt(i int64) *CommentUpdateOne {
+	cuo.mutation.AddUpdatedAt(i)
+	return cuo
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CommentUpdateOne) Select(field string, fields ...string) *CommentUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Comment entity.
+func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	if v, ok := cuo.mutation.Role(); ok {
+		if err := comment.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Comment.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cuo *CommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CommentUpdateOne {
+	cuo.modifiers = append(cuo.modifiers, modifiers...)
+	return cuo
+}
+
+func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUint64))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+		for _, f := range fields {
+			if !comment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != comment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.StepID(); ok {
+		_spec.SetField(comment.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := cuo.mutation.AddedStepID(); ok {
+		_spec.AddField(comment.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := cuo.mutation.AuthorID(); ok {
+		_spec.SetField(comment.FieldAuthorID, field.TypeString, value)
+	}
+	if cuo.mutation.AuthorIDCleared() {
+		_spec.ClearField(comment.FieldAuthorID, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Role(); ok {
+		_spec.SetField(comment.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.Rating(); ok {
+		_spec.SetField(comment.FieldRating, field.TypeJSON, value)
+	}
+	if cuo.mutation.RatingCleared() {
+		_spec.ClearField(comment.FieldRating, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Content(); ok {
+		_spec.SetField(comment.FieldContent, field.TypeString, value)
+	}
+	if cuo.mutation.ContentCleared() {
+		_spec.ClearField(comment.FieldContent, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ParentID(); ok {
+		_spec.SetField(comment.FieldParentID, field.TypeUint64, value)
+	}
+	if value, ok := cuo.mutation.AddedParentID(); ok {
+		_spec.AddField(comment.FieldParentID, field.TypeUint64, value)
+	}
+	if cuo.mutation.ParentIDCleared() {
+		_spec.ClearField(comment.FieldParentID, field.TypeUint64)
+	}
+	if value, ok := cuo.mutation.History(); ok {
+		_spec.SetField(comment.FieldHistory, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedHistory(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldHistory, value)
+		})
+	}
+	if cuo.mutation.HistoryCleared() {
+		_spec.ClearField(comment.FieldHistory, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(comment.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(cuo.modifiers...)
+	_node = &Comment{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
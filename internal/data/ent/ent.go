@@ -8,12 +8,23 @@ import (
 	"fmt"
 	"reflect"
 	"step/internal/data/ent/award"
+	"step/internal/data/ent/comment"
+	"step/internal/data/ent/export"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
 	"step/internal/data/ent/portrait"
+	"step/internal/data/ent/report"
+	"step/internal/data/ent/reviewer"
+	"step/internal/data/ent/reviewrequest"
+	"step/internal/data/ent/searchdocument"
 	"step/internal/data/ent/show"
 	"step/internal/data/ent/showreserve"
 	"step/internal/data/ent/step"
 	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
+	"step/internal/data/ent/template"
 	"sync"
 
 	"entgo.io/ent"
@@ -79,13 +90,24 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			award.Table:       award.ValidColumn,
-			portrait.Table:    portrait.ValidColumn,
-			show.Table:        show.ValidColumn,
-			showreserve.Table: showreserve.ValidColumn,
-			step.Table:        step.ValidColumn,
-			steprate.Table:    steprate.ValidColumn,
-			target.Table:      target.ValidColumn,
+			award.Table:             award.ValidColumn,
+			comment.Table:           comment.ValidColumn,
+			export.Table:            export.ValidColumn,
+			importitem.Table:        importitem.ValidColumn,
+			importjob.Table:         importjob.ValidColumn,
+			portrait.Table:          portrait.ValidColumn,
+			report.Table:            report.ValidColumn,
+			reviewrequest.Table:     reviewrequest.ValidColumn,
+			reviewer.Table:          reviewer.ValidColumn,
+			searchdocument.Table:    searchdocument.ValidColumn,
+			show.Table:              show.ValidColumn,
+			showreserve.Table:       showreserve.ValidColumn,
+			step.Table:              step.ValidColumn,
+			steprate.Table:          steprate.ValidColumn,
+			stepratecriterion.Table: stepratecriterion.ValidColumn,
+			tag.Table:               tag.ValidColumn,
+			target.Table:            target.ValidColumn,
+			template.Table:          template.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
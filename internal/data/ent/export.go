@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"step/internal/data/ent/export"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Export is the model entity for the Export schema.
+type Export struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// User ID
+	UserID string `json:"user_id,omitempty"`
+	// 导出的目标ID
+	TargetID uint64 `json:"target_id,omitempty"`
+	// 状态
+	Status export.Status `json:"status,omitempty"`
+	// 压缩包对象名
+	ObjectName string `json:"object_name,omitempty"`
+	// 压缩包大小
+	Size int64 `json:"size,omitempty"`
+	// 导出的目标数
+	Targets uint32 `json:"targets,omitempty"`
+	// 导出的积累数
+	Steps uint32 `json:"steps,omitempty"`
+	// 失败原因
+	Error string `json:"error,omitempty"`
+	// 创建时间
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// 完成时间
+	FinishedAt   int64 `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Export) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case export.FieldID, export.FieldTargetID, export.FieldSize, export.FieldTargets, export.FieldSteps, export.FieldCreatedAt, export.FieldFinishedAt:
+			values[i] = new(sql.NullInt64)
+		case export.FieldUserID, export.FieldStatus, export.FieldObjectName, export.FieldError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Export fields.
+func (e *Export) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case export.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			e.ID = uint64(value.Int64)
+		case export.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				e.UserID = value.String
+			}
+		case export.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				e.TargetID = uint64(value.Int64)
+			}
+		case export.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				e.Status = export.Status(value.String)
+			}
+		case export.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_name", values[i])
+			} else if value.Valid {
+				e.ObjectName = value.String
+			}
+		case export.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				e.Size = value.Int64
+			}
+		case export.FieldTargets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field targets", values[i])
+			} else if value.Valid {
+				e.Targets = uint32(value.Int64)
+			}
+		case export.FieldSteps:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field steps", values[i])
+			} else if value.Valid {
+				e.Steps = uint32(value.Int64)
+			}
+		case export.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				e.Error = value.String
+			}
+		case export.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Int64
+			}
+		case export.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				e.FinishedAt = value.Int64
+			}
+		default:
+			e.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Export.
+// This includes values selected through modifiers, order, etc.
+func (e *Export) Value(name string) (ent.Value, error) {
+	return e.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Export.
+// Note that you need to call Export.Unwrap() before calling this method if this Export
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Export) Update() *ExportUpdateOne {
+	return NewExportClient(e.config).UpdateOne(e)
+}
+
+// Unwrap unwraps the Export entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (e *Export) Unwrap() *Export {
+	_tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Export is not a transactional entity")
+	}
+	e.config.driver = _tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Export) String() string {
+	var builder strings.Builder
+	builder.WriteString("Export(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(e.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", e.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", e.Status))
+	builder.WriteString(", ")
+	builder.WriteString("object_name=")
+	builder.WriteString(e.ObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", e.Size))
+	builder.WriteString(", ")
+	builder.WriteString("targets=")
+	builder.WriteString(fmt.Sprintf("%v", e.Targets))
+	builder.WriteString(", ")
+	builder.WriteString("steps=")
+	builder.WriteString(fmt.Sprintf("%v", e.Steps))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(e.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", e.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(fmt.Sprintf("%v", e.FinishedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Exports is a parsable slice of Export.
+type Exports []*Export
@@ -0,0 +1,599 @@
+// Code generated by ent, DO NOT EDIT.
+
+package export
+
+import (
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldUserID, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldTargetID, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldObjectName, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSize, v))
+}
+
+// Targets applies equality check predicate on the "targets" field. It's identical to TargetsEQ.
+func Targets(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldTargets, v))
+}
+
+// Steps applies equality check predicate on the "steps" field. It's identical to StepsEQ.
+func Steps(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSteps, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...uint64) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...uint64) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v uint64) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldTargetID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameIsNil applies the IsNil predicate on the "object_name" field.
+func ObjectNameIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldObjectName))
+}
+
+// ObjectNameNotNil applies the NotNil predicate on the "object_name" field.
+func ObjectNameNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldObjectName))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSize, v))
+}
+
+// TargetsEQ applies the EQ predicate on the "targets" field.
+func TargetsEQ(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldTargets, v))
+}
+
+// TargetsNEQ applies the NEQ predicate on the "targets" field.
+func TargetsNEQ(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldTargets, v))
+}
+
+// TargetsIn applies the In predicate on the "targets" field.
+func TargetsIn(vs ...uint32) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldTargets, vs...))
+}
+
+// TargetsNotIn applies the NotIn predicate on the "targets" field.
+func TargetsNotIn(vs ...uint32) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldTargets, vs...))
+}
+
+// TargetsGT applies the GT predicate on the "targets" field.
+func TargetsGT(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldTargets, v))
+}
+
+// TargetsGTE applies the GTE predicate on the "targets" field.
+func TargetsGTE(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldTargets, v))
+}
+
+// TargetsLT applies the LT predicate on the "targets" field.
+func TargetsLT(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldTargets, v))
+}
+
+// TargetsLTE applies the LTE predicate on the "targets" field.
+func TargetsLTE(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldTargets, v))
+}
+
+// StepsEQ applies the EQ predicate on the "steps" field.
+func StepsEQ(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSteps, v))
+}
+
+// StepsNEQ applies the NEQ predicate on the "steps" field.
+func StepsNEQ(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSteps, v))
+}
+
+// StepsIn applies the In predicate on the "steps" field.
+func StepsIn(vs ...uint32) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSteps, vs...))
+}
+
+// StepsNotIn applies the NotIn predicate on the "steps" field.
+func StepsNotIn(vs ...uint32) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSteps, vs...))
+}
+
+// StepsGT applies the GT predicate on the "steps" field.
+func StepsGT(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSteps, v))
+}
+
+// StepsGTE applies the GTE predicate on the "steps" field.
+func StepsGTE(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSteps, v))
+}
+
+// StepsLT applies the LT predicate on the "steps" field.
+func StepsLT(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSteps, v))
+}
+
+// StepsLTE applies the LTE predicate on the "steps" field.
+func StepsLTE(v uint32) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSteps, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v int64) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...int64) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v int64) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Export) predicate.Export {
+	return predicate.Export(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Export) predicate.Export {
+	return predicate.Export(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Export) predicate.Export {
+	return predicate.Export(sql.NotPredicates(p))
+}
@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/export"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportCreate is the builder for creating a Export entity.
+type ExportCreate struct {
+	config
+	mutation *ExportMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ec *ExportCreate) SetUserID(s string) *ExportCreate {
+	ec.mutation.SetUserID(s)
+	return ec
+}
+
+// SetTargetID sets the "target_id" field.
+func (ec *ExportCreate) SetTargetID(u uint64) *ExportCreate {
+	ec.mutation.SetTargetID(u)
+	return ec
+}
+
+// SetStatus sets the "status" field.
+func (ec *ExportCreate) SetStatus(e export.Status) *ExportCreate {
+	ec.mutation.SetStatus(e)
+	return ec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableStatus(e *export.Status) *ExportCreate {
+	if e != nil {
+		ec.SetStatus(*e)
+	}
+	return ec
+}
+
+// SetObjectName sets the "object_name" field.
+func (ec *ExportCreate) SetObjectName(s string) *ExportCreate {
+	ec.mutation.SetObjectName(s)
+	return ec
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableObjectName(s *string) *ExportCreate {
+	if s != nil {
+		ec.SetObjectName(*s)
+	}
+	return ec
+}
+
+// SetSize sets the "size" field.
+func (ec *ExportCreate) SetSize(i int64) *ExportCreate {
+	ec.mutation.SetSize(i)
+	return ec
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableSize(i *int64) *ExportCreate {
+	if i != nil {
+		ec.SetSize(*i)
+	}
+	return ec
+}
+
+// SetTargets sets the "targets" field.
+func (ec *ExportCreate) SetTargets(u uint32) *ExportCreate {
+	ec.mutation.SetTargets(u)
+	return ec
+}
+
+// SetNillableTargets sets the "targets" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableTargets(u *uint32) *ExportCreate {
+	if u != nil {
+		ec.SetTargets(*u)
+	}
+	return ec
+}
+
+// SetSteps sets the "steps" field.
+func (ec *ExportCreate) SetSteps(u uint32) *ExportCreate {
+	ec.mutation.SetSteps(u)
+	return ec
+}
+
+// SetNillableSteps sets the "steps" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableSteps(u *uint32) *ExportCreate {
+	if u != nil {
+		ec.SetSteps(*u)
+	}
+	return ec
+}
+
+// SetError sets the "error" field.
+func (ec *ExportCreate) SetError(s string) *ExportCreate {
+	ec.mutation.SetError(s)
+	return ec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableError(s *string) *ExportCreate {
+	if s != nil {
+		ec.SetError(*s)
+	}
+	return ec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ec *ExportCreate) SetCreatedAt(i int64) *ExportCreate {
+	ec.mutation.SetCreatedAt(i)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableCreatedAt(i *int64) *ExportCreate {
+	if i != nil {
+		ec.SetCreatedAt(*i)
+	}
+	return ec
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ec *ExportCreate) SetFinishedAt(i int64) *ExportCreate {
+	ec.mutation.SetFinishedAt(i)
+	return ec
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableFinishedAt(i *int64) *ExportCreate {
+	if i != nil {
+		ec.SetFinishedAt(*i)
+	}
+	return ec
+}
+
+// SetID sets the "id" field.
+func (ec *ExportCreate) SetID(u uint64) *ExportCreate {
+	ec.mutation.SetID(u)
+	return ec
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (ec *ExportCreate) Mutation() *ExportMutation {
+	return ec.mutation
+}
+
+// Save creates the Export in the database.
+func (ec *ExportCreate) Save(ctx context.Context) (*Export, error) {
+	ec.defaults()
+	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *ExportCreate) SaveX(ctx context.Context) *Export {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *ExportCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *ExportCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ec *ExportCreate) defaults() {
+	if _, ok := ec.mutation.Status(); !ok {
+		v := export.DefaultStatus
+		ec.mutation.SetStatus(v)
+	}
+	if _, ok := ec.mutation.Size(); !ok {
+		v := export.DefaultSize
+		ec.mutation.SetSize(v)
+	}
+	if _, ok := ec.mutation.Targets(); !ok {
+		v := export.DefaultTargets
+		ec.mutation.SetTargets(v)
+	}
+	if _, ok := ec.mutation.Steps(); !ok {
+		v := export.DefaultSteps
+		ec.mutation.SetSteps(v)
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := export.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *ExportCreate) check() error {
+	if _, ok := ec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Export.user_id"`)}
+	}
+	if _, ok := ec.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Export.target_id"`)}
+	}
+	if _, ok := ec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Export.status"`)}
+	}
+	if v, ok := ec.mutation.Status(); ok {
+		if err := export.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Export.size"`)}
+	}
+	if _, ok := ec.mutation.Targets(); !ok {
+		return &ValidationError{Name: "targets", err: errors.New(`ent: missing required field "Export.targets"`)}
+	}
+	if _, ok := ec.mutation.Steps(); !ok {
+		return &ValidationError{Name: "steps", err: errors.New(`ent: missing required field "Export.steps"`)}
+	}
+	if v, ok := ec.mutation.Error(); ok {
+		if err := export.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "Export.error": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Export.created_at"`)}
+	}
+	return nil
+}
+
+func (ec *ExportCreate) sqlSave(ctx context.Context) (*Export, error) {
+	if err := ec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	ec.mutation.id = &_node.ID
+	ec.mutation.done = true
+	return _node, nil
+}
+
+func (ec *ExportCreate) createSpec() (*Export, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Export{config: ec.config}
+		_spec = sqlgraph.NewCreateSpec(export.Table, sqlgraph.NewFieldSpec(export.FieldID, field.TypeUint64))
+	)
+	if id, ok := ec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ec.mutation.UserID(); ok {
+		_spec.SetField(export.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := ec.mutation.TargetID(); ok {
+		_spec.SetField(export.FieldTargetID, field.TypeUint64, value)
+		_node.TargetID = value
+	}
+	if value, ok := ec.mutation.Status(); ok {
+		_spec.SetField(export.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ec.mutation.ObjectName(); ok {
+		_spec.SetField(export.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = value
+	}
+	if value, ok := ec.mutation.Size(); ok {
+		_spec.SetField(export.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ec.mutation.Targets(); ok {
+		_spec.SetField(export.FieldTargets, field.TypeUint32, value)
+		_node.Targets = value
+	}
+	if value, ok := ec.mutation.Steps(); ok {
+		_spec.SetField(export.FieldSteps, field.TypeUint32, value)
+		_node.Steps = value
+	}
+	if value, ok := ec.mutation.Error(); ok {
+		_spec.SetField(export.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(export.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ec.mutation.FinishedAt(); ok {
+		_spec.SetField(export.FieldFinishedAt, field.TypeInt64, value)
+		_node.FinishedAt = value
+	}
+	return _node, _spec
+}
+
+// ExportCreateBulk is the builder for creating many Export entities in bulk.
+type ExportCreateBulk struct {
+	config
+	err      error
+	builders []*ExportCreate
+}
+
+// Save creates the Export entities in the database.
+func (ecb *ExportCreateBulk) Save(ctx context.Context) ([]*Export, error) {
+	if ecb.err != nil {
+		return nil, ecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Export, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *ExportCreateBulk) SaveX(ctx context.Context) []*Export {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *ExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *ExportCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/export"
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExportUpdate is the builder for updating Export entities.
+type ExportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ExportUpdate builder.
+func (eu *ExportUpdate) Where(ps ...predicate.Export) *ExportUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetUserID sets the "user_id" field.
+func (eu *ExportUpdate) SetUserID(s string) *ExportUpdate {
+	eu.mutation.SetUserID(s)
+	return eu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableUserID(s *string) *ExportUpdate {
+	if s != nil {
+		eu.SetUserID(*s)
+	}
+	return eu
+}
+
+// SetTargetID sets the "target_id" field.
+func (eu *ExportUpdate) SetTargetID(u uint64) *ExportUpdate {
+	eu.mutation.ResetTargetID()
+	eu.mutation.SetTargetID(u)
+	return eu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableTargetID(u *uint64) *ExportUpdate {
+	if u != nil {
+		eu.SetTargetID(*u)
+	}
+	return eu
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (eu *ExportUpdate) AddTargetID(u int64) *ExportUpdate {
+	eu.mutation.AddTargetID(u)
+	return eu
+}
+
+// SetStatus sets the "status" field.
+func (eu *ExportUpdate) SetStatus(e export.Status) *ExportUpdate {
+	eu.mutation.SetStatus(e)
+	return eu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableStatus(e *export.Status) *ExportUpdate {
+	if e != nil {
+		eu.SetStatus(*e)
+	}
+	return eu
+}
+
+// SetObjectName sets the "object_name" field.
+func (eu *ExportUpdate) SetObjectName(s string) *ExportUpdate {
+	eu.mutation.SetObjectName(s)
+	return eu
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableObjectName(s *string) *ExportUpdate {
+	if s != nil {
+		eu.SetObjectName(*s)
+	}
+	return eu
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (eu *ExportUpdate) ClearObjectName() *ExportUpdate {
+	eu.mutation.ClearObjectName()
+	return eu
+}
+
+// SetSize sets the "size" field.
+func (eu *ExportUpdate) SetSize(i int64) *ExportUpdate {
+	eu.mutation.ResetSize()
+	eu.mutation.SetSize(i)
+	return eu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableSize(i *int64) *ExportUpdate {
+	if i != nil {
+		eu.SetSize(*i)
+	}
+	return eu
+}
+
+// AddSize adds i to the "size" field.
+func (eu *ExportUpdate) AddSize(i int64) *ExportUpdate {
+	eu.mutation.AddSize(i)
+	return eu
+}
+
+// SetTargets sets the "targets" field.
+func (eu *ExportUpdate) SetTargets(u uint32) *ExportUpdate {
+	eu.mutation.ResetTargets()
+	eu.mutation.SetTargets(u)
+	return eu
+}
+
+// SetNillableTargets sets the "targets" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableTargets(u *uint32) *ExportUpdate {
+	if u != nil {
+		eu.SetTargets(*u)
+	}
+	return eu
+}
+
+// AddTargets adds u to the "targets" field.
+func (eu *ExportUpdate) AddTargets(u int32) *ExportUpdate {
+	eu.mutation.AddTargets(u)
+	return eu
+}
+
+// SetSteps sets the "steps" field.
+func (eu *ExportUpdate) SetSteps(u uint32) *ExportUpdate {
+	eu.mutation.ResetSteps()
+	eu.mutation.SetSteps(u)
+	return eu
+}
+
+// SetNillableSteps sets the "steps" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableSteps(u *uint32) *ExportUpdate {
+	if u != nil {
+		eu.SetSteps(*u)
+	}
+	return eu
+}
+
+// AddSteps adds u to the "steps" field.
+func (eu *ExportUpdate) AddSteps(u int32) *ExportUpdate {
+	eu.mutation.AddSteps(u)
+	return eu
+}
+
+// SetError sets the "error" field.
+func (eu *ExportUpdate) SetError(s string) *ExportUpdate {
+	eu.mutation.SetError(s)
+	return eu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableError(s *string) *ExportUpdate {
+	if s != nil {
+		eu.SetError(*s)
+	}
+	return eu
+}
+
+// ClearError clears the value of the "error" field.
+func (eu *ExportUpdate) ClearError() *ExportUpdate {
+	eu.mutation.ClearError()
+	return eu
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (eu *ExportUpdate) SetFinishedAt(i int64) *ExportUpdate {
+	eu.mutation.ResetFinishedAt()
+	eu.mutation.SetFinishedAt(i)
+	return eu
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableFinishedAt(i *int64) *ExportUpdate {
+	if i != nil {
+		eu.SetFinishedAt(*i)
+	}
+	return eu
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (eu *ExportUpdate) AddFinishedAt(i int64) *ExportUpdate {
+	eu.mutation.AddFinishedAt(i)
+	return eu
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (eu *ExportUpdate) ClearFinishedAt() *ExportUpdate {
+	eu.mutation.ClearFinishedAt()
+	return eu
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (eu *ExportUpdate) Mutation() *ExportMutation {
+	return eu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *ExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *ExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *ExportUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *ExportUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *ExportUpdate) check() error {
+	if v, ok := eu.mutation.Status(); ok {
+		if err := export.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.Error(); ok {
+		if err := export.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "Export.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (eu *ExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportUpdate {
+	eu.modifiers = append(eu.modifiers, modifiers...)
+	return eu
+}
+
+func (eu *ExportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(export.Table, export.Columns, sqlgraph.NewFieldSpec(export.FieldID, field.TypeUint64))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.UserID(); ok {
+		_spec.SetField(export.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := eu.mutation.TargetID(); ok {
+		_spec.SetField(export.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := eu.mutation.AddedTargetID(); ok {
+		_spec.AddField(export.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := eu.mutation.Status(); ok {
+		_spec.SetField(export.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := eu.mutation.ObjectName(); ok {
+		_spec.SetField(export.FieldObjectName, field.TypeString, value)
+	}
+	if eu.mutation.ObjectNameCleared() {
+		_spec.ClearField(export.FieldObjectName, field.TypeString)
+	}
+	if value, ok := eu.mutation.Size(); ok {
+		_spec.SetField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := eu.mutation.AddedSize(); ok {
+		_spec.AddField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := eu.mutation.Targets(); ok {
+		_spec.SetField(export.FieldTargets, field.TypeUint32, value)
+	}
+	if value, ok := eu.mutation.AddedTargets(); ok {
+		_spec.AddField(export.FieldTargets, field.TypeUint32, value)
+	}
+	if value, ok := eu.mutation.Steps(); ok {
+		_spec.SetField(export.FieldSteps, field.TypeUint32, value)
+	}
+	if value, ok := eu.mutation.AddedSteps(); ok {
+		_spec.AddField(export.FieldSteps, field.TypeUint32, value)
+	}
+	if value, ok := eu.mutation.Error(); ok {
+		_spec.SetField(export.FieldError, field.TypeString, value)
+	}
+	if eu.mutation.ErrorCleared() {
+		_spec.ClearField(export.FieldError, field.TypeString)
+	}
+	if value, ok := eu.mutation.FinishedAt(); ok {
+		_spec.SetField(export.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := eu.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(export.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if eu.mutation.FinishedAtCleared() {
+		_spec.ClearField(export.FieldFinishedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(eu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// ExportUpdateOne is the builder for updating a single Export entity.
+type ExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (euo *ExportUpdateOne) SetUserID(s string) *ExportUpdateOne {
+	euo.mutation.SetUserID(s)
+	return euo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableUserID(s *string) *ExportUpdateOne {
+	if s != nil {
+		euo.SetUserID(*s)
+	}
+	return euo
+}
+
+// SetTargetID sets the "target_id" field.
+func (euo *ExportUpdateOne) SetTargetID(u uint64) *ExportUpdateOne {
+	euo.mutation.ResetTargetID()
+	euo.mutation.SetTargetID(u)
+	return euo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableTargetID(u *uint64) *ExportUpdateOne {
+	if u != nil {
+		euo.SetTargetID(*u)
+	}
+	return euo
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (euo *ExportUpdateOne) AddTargetID(u int64) *ExportUpdateOne {
+	euo.mutation.AddTargetID(u)
+	return euo
+}
+
+// SetStatus sets the "status" field.
+func (euo *ExportUpdateOne) SetStatus(e export.Status) *ExportUpdateOne {
+	euo.mutation.SetStatus(e)
+	return euo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableStatus(e *export.Status) *ExportUpdateOne {
+	if e != nil {
+		euo.SetStatus(*e)
+	}
+	return euo
+}
+
+// SetObjectName sets the "object_name" field.
+func (euo *ExportUpdateOne) SetObjectName(s string) *ExportUpdateOne {
+	euo.mutation.SetObjectName(s)
+	return euo
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableObjectName(s *string) *ExportUpdateOne {
+	if s != nil {
+		euo.SetObjectName(*s)
+	}
+	return euo
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (euo *ExportUpdateOne) ClearObjectName() *ExportUpdateOne {
+	euo.mutation.ClearObjectName()
+	return euo
+}
+
+// SetSize sets the "size" field.
+func (euo *ExportUpdateOne) SetSize(i int64) *ExportUpdateOne {
+	euo.mutation.ResetSize()
+	euo.mutation.SetSize(i)
+	return euo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableSize(i *int64) *ExportUpdateOne {
+	if i != nil {
+		euo.SetSize(*i)
+	}
+	return euo
+}
+
+// AddSize adds i to the "size" field.
+func (euo *ExportUpdateOne) AddSize(i int64) *ExportUpdateOne {
+	euo.mutation.AddSize(i)
+	return euo
+}
+
+// SetTargets sets the "targets" field.
+func (euo *ExportUpdateOne) SetTargets(u uint32) *ExportUpdateOne {
+	euo.mutation.ResetTargets()
+	euo.mutation.SetTargets(u)
+	return euo
+}
+
+// SetNillableTargets sets the "targets" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableTargets(u *uint32) *ExportUpdateOne {
+	if u != nil {
+		euo.SetTargets(*u)
+	}
+	return euo
+}
+
+// AddTargets adds u to the "targets" field.
+func (euo *ExportUpdateOne) AddTargets(u int32) *ExportUpdateOne {
+	euo.mutation.AddTargets(u)
+	return euo
+}
+
+// SetSteps sets the "steps" field.
+func (euo *ExportUpdateOne) SetSteps(u uint32) *ExportUpdateOne {
+	euo.mutation.ResetSteps()
+	euo.mutation.SetSteps(u)
+	return euo
+}
+
+// SetNillableSteps sets the "steps" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableSteps(u *uint32) *ExportUpdateOne {
+	if u != nil {
+		euo.SetSteps(*u)
+	}
+	return euo
+}
+
+// AddSteps adds u to the "steps" field.
+func (euo *ExportUpdateOne) AddSteps(u int32) *ExportUpdateOne {
+	euo.mutation.AddSteps(u)
+	return euo
+}
+
+// SetError sets the "error" field.
+func (euo *ExportUpdateOne) SetError(s string) *ExportUpdateOne {
+	euo.mutation.SetError(s)
+	return euo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableError(s *string) *ExportUpdateOne {
+	if s != nil {
+		euo.SetError(*s)
+	}
+	return euo
+}
+
+// ClearError clears the value of the "error" field.
+func (euo *ExportUpdateOne) ClearError() *ExportUpdateOne {
+	euo.mutation.ClearError()
+	return euo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (euo *ExportUpdateOne) SetFinishedAt(i int64) *ExportUpdateOne {
+	euo.mutation.ResetFinishedAt()
+	euo.mutation.SetFinishedAt(i)
+	return euo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableFinishedAt(i *int64) *ExportUpdateOne {
+	if i != nil {
+		euo.SetFinishedAt(*i)
+	}
+	return euo
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (euo *ExportUpdateOne) AddFinishedAt(i int64) *ExportUpdateOne {
+	euo.mutation.AddFinishedAt(i)
+	return euo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (euo *ExportUpdateOne) ClearFinishedAt() *ExportUpdateOne {
+	euo.mutation.ClearFinishedAt()
+	return euo
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (euo *ExportUpdateOne) Mutation() *ExportMutation {
+	return euo.mutation
+}
+
+// Where appends a list predicates to the ExportUpdate builder.
+func (euo *ExportUpdateOne) Where(ps ...predicate.Export) *ExportUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *ExportUpdateOne) Select(field string, fields ...string) *ExportUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Export entity.
+func (euo *ExportUpdateOne) Save(ctx context.Context) (*Export, error) {
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *ExportUpdateOne) SaveX(ctx context.Context) *Export {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *ExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *ExportUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *ExportUpdateOne) check() error {
+	if v, ok := euo.mutation.Status(); ok {
+		if err := export.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Error(); ok {
+		if err := export.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "Export.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (euo *ExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ExportUpdateOne {
+	euo.modifiers = append(euo.modifiers, modifiers...)
+	return euo
+}
+
+func (euo *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(export.Table, export.Columns, sqlgraph.NewFieldSpec(export.FieldID, field.TypeUint64))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Export.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, export.FieldID)
+		for _, f := range fields {
+			if !export.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != export.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.UserID(); ok {
+		_spec.SetField(export.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := euo.mutation.TargetID(); ok {
+		_spec.SetField(export.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := euo.mutation.AddedTargetID(); ok {
+		_spec.AddField(export.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := euo.mutation.Status(); ok {
+		_spec.SetField(export.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := euo.mutation.ObjectName(); ok {
+		_spec.SetField(export.FieldObjectName, field.TypeString, value)
+	}
+	if euo.mutation.ObjectNameCleared() {
+		_spec.ClearField(export.FieldObjectName, field.TypeString)
+	}
+	if value, ok := euo.mutation.Size(); ok {
+		_spec.SetField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := euo.mutation.AddedSize(); ok {
+		_spec.AddField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := euo.mutation.Targets(); ok {
+		_spec.SetField(export.FieldTargets, field.TypeUint32, value)
+	}
+	if value, ok := euo.mutation.AddedTargets(); ok {
+		_spec.AddField(export.FieldTargets, field.TypeUint32, value)
+	}
+	if value, ok := euo.mutation.Steps(); ok {
+		_spec.SetField(export.FieldSteps, field.TypeUint32, value)
+	}
+	if value, ok := euo.mutation.AddedSteps(); ok {
+		_spec.AddField(export.FieldSteps, field.TypeUint32, value)
+	}
+	if value, ok := euo.mutation.Error(); ok {
+		_spec.SetField(export.FieldError, field.TypeString, value)
+	}
+	if euo.mutation.ErrorCleared() {
+		_spec.ClearField(export.FieldError, field.TypeString)
+	}
+	if value, ok := euo.mutation.FinishedAt(); ok {
+		_spec.SetField(export.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := euo.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(export.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if euo.mutation.FinishedAtCleared() {
+		_spec.ClearField(export.FieldFinishedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(euo.modifiers...)
+	_node = &Export{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
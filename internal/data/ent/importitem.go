@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/objects"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ImportItem is the model entity for the ImportItem schema.
+type ImportItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// 导入任务ID
+	JobID uint64 `json:"job_id,omitempty"`
+	// 处理顺序
+	Seq uint32 `json:"seq,omitempty"`
+	// 类型
+	Kind importitem.Kind `json:"kind,omitempty"`
+	// 清单中的引用
+	Ref string `json:"ref,omitempty"`
+	// 父目标或父目录的引用
+	ParentRef string `json:"parent_ref,omitempty"`
+	// 所属目标的引用
+	TargetRef string `json:"target_ref,omitempty"`
+	// 原始创建时间
+	ItemTime int64 `json:"item_time,omitempty"`
+	// 导入内容
+	Data *objects.ImportItemData `json:"data,omitempty"`
+	// 状态
+	Status importitem.Status `json:"status,omitempty"`
+	// 创建的目标或积累ID
+	EntityID uint64 `json:"entity_id,omitempty"`
+	// 失败原因
+	Error string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportItemQuery when eager-loading is set.
+	Edges        ImportItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportItemEdges holds the relations/edges for other nodes in the graph.
+type ImportItemEdges struct {
+	// Job holds the value of the job edge.
+	Job *ImportJob `json:"job,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// JobOrErr returns the Job value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportItemEdges) JobOrErr() (*ImportJob, error) {
+	if e.Job != nil {
+		return e.Job, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: importjob.Label}
+	}
+	return nil, &NotLoadedError{edge: "job"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importitem.FieldData:
+			values[i] = new([]byte)
+		case importitem.FieldID, importitem.FieldJobID, importitem.FieldSeq, importitem.FieldItemTime, importitem.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case importitem.FieldKind, importitem.FieldRef, importitem.FieldParentRef, importitem.FieldTargetRef, importitem.FieldStatus, importitem.FieldError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportItem fields.
+func (ii *ImportItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ii.ID = uint64(value.Int64)
+		case importitem.FieldJobID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				ii.JobID = uint64(value.Int64)
+			}
+		case importitem.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				ii.Seq = uint32(value.Int64)
+			}
+		case importitem.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ii.Kind = importitem.Kind(value.String)
+			}
+		case importitem.FieldRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ref", values[i])
+			} else if value.Valid {
+				ii.Ref = value.String
+			}
+		case importitem.FieldParentRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_ref", values[i])
+			} else if value.Valid {
+				ii.ParentRef = value.String
+			}
+		case importitem.FieldTargetRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_ref", values[i])
+			} else if value.Valid {
+				ii.TargetRef = value.String
+			}
+		case importitem.FieldItemTime:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_time", values[i])
+			} else if value.Valid {
+				ii.ItemTime = value.Int64
+			}
+		case importitem.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ii.Data); err != nil {
+					return fmt.Errorf("unmarshal field data: %w", err)
+				}
+			}
+		case importitem.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ii.Status = importitem.Status(value.String)
+			}
+		case importitem.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				ii.EntityID = uint64(value.Int64)
+			}
+		case importitem.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ii.Error = value.String
+			}
+		default:
+			ii.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportItem.
+// This includes values selected through modifiers, order, etc.
+func (ii *ImportItem) Value(name string) (ent.Value, error) {
+	return ii.selectValues.Get(name)
+}
+
+// QueryJob queries the "job" edge of the ImportItem entity.
+func (ii *ImportItem) QueryJob() *ImportJobQuery {
+	return NewImportItemClient(ii.config).QueryJob(ii)
+}
+
+// Update returns a builder for updating this ImportItem.
+// Note that you need to call ImportItem.Unwrap() before calling this method if this ImportItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ii *ImportItem) Update() *ImportItemUpdateOne {
+	return NewImportItemClient(ii.config).UpdateOne(ii)
+}
+
+// Unwrap unwraps the ImportItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ii *ImportItem) Unwrap() *ImportItem {
+	_tx, ok := ii.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportItem is not a transactional entity")
+	}
+	ii.config.driver = _tx.drv
+	return ii
+}
+
+// String implements the fmt.Stringer.
+func (ii *ImportItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ii.ID))
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", ii.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("ref=")
+	builder.WriteString(ii.Ref)
+	builder.WriteString(", ")
+	builder.WriteString("parent_ref=")
+	builder.WriteString(ii.ParentRef)
+	builder.WriteString(", ")
+	builder.WriteString("target_ref=")
+	builder.WriteString(ii.TargetRef)
+	builder.WriteString(", ")
+	builder.WriteString("item_time=")
+	builder.WriteString(fmt.Sprintf("%v", ii.ItemTime))
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Data))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ii.Status))
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", ii.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ii.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportItems is a parsable slice of ImportItem.
+type ImportItems []*ImportItem
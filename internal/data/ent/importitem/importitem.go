@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importitem
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importitem type in the database.
+	Label = "import_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldRef holds the string denoting the ref field in the database.
+	FieldRef = "ref"
+	// FieldParentRef holds the string denoting the parent_ref field in the database.
+	FieldParentRef = "parent_ref"
+	// FieldTargetRef holds the string denoting the target_ref field in the database.
+	FieldTargetRef = "target_ref"
+	// FieldItemTime holds the string denoting the item_time field in the database.
+	FieldItemTime = "item_time"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// EdgeJob holds the string denoting the job edge name in mutations.
+	EdgeJob = "job"
+	// Table holds the table name of the importitem in the database.
+	Table = "import_items"
+	// JobTable is the table that holds the job relation/edge.
+	JobTable = "import_items"
+	// JobInverseTable is the table name for the ImportJob entity.
+	// It exists in this package in order to avoid circular dependency with the "importjob" package.
+	JobInverseTable = "import_jobs"
+	// JobColumn is the table column denoting the job relation/edge.
+	JobColumn = "job_id"
+)
+
+// Columns holds all SQL columns for importitem fields.
+var Columns = []string{
+	FieldID,
+	FieldJobID,
+	FieldSeq,
+	FieldKind,
+	FieldRef,
+	FieldParentRef,
+	FieldTargetRef,
+	FieldItemTime,
+	FieldData,
+	FieldStatus,
+	FieldEntityID,
+	FieldError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RefValidator is a validator for the "ref" field. It is called by the builders before save.
+	RefValidator func(string) error
+	// ParentRefValidator is a validator for the "parent_ref" field. It is called by the builders before save.
+	ParentRefValidator func(string) error
+	// TargetRefValidator is a validator for the "target_ref" field. It is called by the builders before save.
+	TargetRefValidator func(string) error
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindTarget Kind = "target"
+	KindDir    Kind = "dir"
+	KindStep   Kind = "step"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindTarget, KindDir, KindStep:
+		return nil
+	default:
+		return fmt.Errorf("importitem: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusCreated Status = "created"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusCreated, StatusDone, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("importitem: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ImportItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByRef orders the results by the ref field.
+func ByRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRef, opts...).ToFunc()
+}
+
+// ByParentRef orders the results by the parent_ref field.
+func ByParentRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentRef, opts...).ToFunc()
+}
+
+// ByTargetRef orders the results by the target_ref field.
+func ByTargetRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetRef, opts...).ToFunc()
+}
+
+// ByItemTime orders the results by the item_time field.
+func ByItemTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemTime, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByJobField orders the results by job field.
+func ByJobField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newJobStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, JobTable, JobColumn),
+	)
+}
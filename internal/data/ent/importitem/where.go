@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importitem
+
+import (
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldID, id))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldJobID, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldSeq, v))
+}
+
+// Ref applies equality check predicate on the "ref" field. It's identical to RefEQ.
+func Ref(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldRef, v))
+}
+
+// ParentRef applies equality check predicate on the "parent_ref" field. It's identical to ParentRefEQ.
+func ParentRef(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldParentRef, v))
+}
+
+// TargetRef applies equality check predicate on the "target_ref" field. It's identical to TargetRefEQ.
+func TargetRef(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldTargetRef, v))
+}
+
+// ItemTime applies equality check predicate on the "item_time" field. It's identical to ItemTimeEQ.
+func ItemTime(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldItemTime, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldEntityID, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldError, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v uint32) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldSeq, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// RefEQ applies the EQ predicate on the "ref" field.
+func RefEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldRef, v))
+}
+
+// RefNEQ applies the NEQ predicate on the "ref" field.
+func RefNEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldRef, v))
+}
+
+// RefIn applies the In predicate on the "ref" field.
+func RefIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldRef, vs...))
+}
+
+// RefNotIn applies the NotIn predicate on the "ref" field.
+func RefNotIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldRef, vs...))
+}
+
+// RefGT applies the GT predicate on the "ref" field.
+func RefGT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldRef, v))
+}
+
+// RefGTE applies the GTE predicate on the "ref" field.
+func RefGTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldRef, v))
+}
+
+// RefLT applies the LT predicate on the "ref" field.
+func RefLT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldRef, v))
+}
+
+// RefLTE applies the LTE predicate on the "ref" field.
+func RefLTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldRef, v))
+}
+
+// RefContains applies the Contains predicate on the "ref" field.
+func RefContains(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContains(FieldRef, v))
+}
+
+// RefHasPrefix applies the HasPrefix predicate on the "ref" field.
+func RefHasPrefix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasPrefix(FieldRef, v))
+}
+
+// RefHasSuffix applies the HasSuffix predicate on the "ref" field.
+func RefHasSuffix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasSuffix(FieldRef, v))
+}
+
+// RefEqualFold applies the EqualFold predicate on the "ref" field.
+func RefEqualFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEqualFold(FieldRef, v))
+}
+
+// RefContainsFold applies the ContainsFold predicate on the "ref" field.
+func RefContainsFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContainsFold(FieldRef, v))
+}
+
+// ParentRefEQ applies the EQ predicate on the "parent_ref" field.
+func ParentRefEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldParentRef, v))
+}
+
+// ParentRefNEQ applies the NEQ predicate on the "parent_ref" field.
+func ParentRefNEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldParentRef, v))
+}
+
+// ParentRefIn applies the In predicate on the "parent_ref" field.
+func ParentRefIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldParentRef, vs...))
+}
+
+// ParentRefNotIn applies the NotIn predicate on the "parent_ref" field.
+func ParentRefNotIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldParentRef, vs...))
+}
+
+// ParentRefGT applies the GT predicate on the "parent_ref" field.
+func ParentRefGT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldParentRef, v))
+}
+
+// ParentRefGTE applies the GTE predicate on the "parent_ref" field.
+func ParentRefGTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldParentRef, v))
+}
+
+// ParentRefLT applies the LT predicate on the "parent_ref" field.
+func ParentRefLT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldParentRef, v))
+}
+
+// ParentRefLTE applies the LTE predicate on the "parent_ref" field.
+func ParentRefLTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldParentRef, v))
+}
+
+// ParentRefContains applies the Contains predicate on the "parent_ref" field.
+func ParentRefContains(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContains(FieldParentRef, v))
+}
+
+// ParentRefHasPrefix applies the HasPrefix predicate on the "parent_ref" field.
+func ParentRefHasPrefix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasPrefix(FieldParentRef, v))
+}
+
+// ParentRefHasSuffix applies the HasSuffix predicate on the "parent_ref" field.
+func ParentRefHasSuffix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasSuffix(FieldParentRef, v))
+}
+
+// ParentRefIsNil applies the IsNil predicate on the "parent_ref" field.
+func ParentRefIsNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIsNull(FieldParentRef))
+}
+
+// ParentRefNotNil applies the NotNil predicate on the "parent_ref" field.
+func ParentRefNotNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotNull(FieldParentRef))
+}
+
+// ParentRefEqualFold applies the EqualFold predicate on the "parent_ref" field.
+func ParentRefEqualFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEqualFold(FieldParentRef, v))
+}
+
+// ParentRefContainsFold applies the ContainsFold predicate on the "parent_ref" field.
+func ParentRefContainsFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContainsFold(FieldParentRef, v))
+}
+
+// TargetRefEQ applies the EQ predicate on the "target_ref" field.
+func TargetRefEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldTargetRef, v))
+}
+
+// TargetRefNEQ applies the NEQ predicate on the "target_ref" field.
+func TargetRefNEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldTargetRef, v))
+}
+
+// TargetRefIn applies the In predicate on the "target_ref" field.
+func TargetRefIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldTargetRef, vs...))
+}
+
+// TargetRefNotIn applies the NotIn predicate on the "target_ref" field.
+func TargetRefNotIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldTargetRef, vs...))
+}
+
+// TargetRefGT applies the GT predicate on the "target_ref" field.
+func TargetRefGT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldTargetRef, v))
+}
+
+// TargetRefGTE applies the GTE predicate on the "target_ref" field.
+func TargetRefGTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldTargetRef, v))
+}
+
+// TargetRefLT applies the LT predicate on the "target_ref" field.
+func TargetRefLT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldTargetRef, v))
+}
+
+// TargetRefLTE applies the LTE predicate on the "target_ref" field.
+func TargetRefLTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldTargetRef, v))
+}
+
+// TargetRefContains applies the Contains predicate on the "target_ref" field.
+func TargetRefContains(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContains(FieldTargetRef, v))
+}
+
+// TargetRefHasPrefix applies the HasPrefix predicate on the "target_ref" field.
+func TargetRefHasPrefix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasPrefix(FieldTargetRef, v))
+}
+
+// TargetRefHasSuffix applies the HasSuffix predicate on the "target_ref" field.
+func TargetRefHasSuffix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasSuffix(FieldTargetRef, v))
+}
+
+// TargetRefIsNil applies the IsNil predicate on the "target_ref" field.
+func TargetRefIsNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIsNull(FieldTargetRef))
+}
+
+// TargetRefNotNil applies the NotNil predicate on the "target_ref" field.
+func TargetRefNotNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotNull(FieldTargetRef))
+}
+
+// TargetRefEqualFold applies the EqualFold predicate on the "target_ref" field.
+func TargetRefEqualFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEqualFold(FieldTargetRef, v))
+}
+
+// TargetRefContainsFold applies the ContainsFold predicate on the "target_ref" field.
+func TargetRefContainsFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContainsFold(FieldTargetRef, v))
+}
+
+// ItemTimeEQ applies the EQ predicate on the "item_time" field.
+func ItemTimeEQ(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldItemTime, v))
+}
+
+// ItemTimeNEQ applies the NEQ predicate on the "item_time" field.
+func ItemTimeNEQ(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldItemTime, v))
+}
+
+// ItemTimeIn applies the In predicate on the "item_time" field.
+func ItemTimeIn(vs ...int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldItemTime, vs...))
+}
+
+// ItemTimeNotIn applies the NotIn predicate on the "item_time" field.
+func ItemTimeNotIn(vs ...int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldItemTime, vs...))
+}
+
+// ItemTimeGT applies the GT predicate on the "item_time" field.
+func ItemTimeGT(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldItemTime, v))
+}
+
+// ItemTimeGTE applies the GTE predicate on the "item_time" field.
+func ItemTimeGTE(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldItemTime, v))
+}
+
+// ItemTimeLT applies the LT predicate on the "item_time" field.
+func ItemTimeLT(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldItemTime, v))
+}
+
+// ItemTimeLTE applies the LTE predicate on the "item_time" field.
+func ItemTimeLTE(v int64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldItemTime, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v uint64) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldEntityID, v))
+}
+
+// EntityIDIsNil applies the IsNil predicate on the "entity_id" field.
+func EntityIDIsNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIsNull(FieldEntityID))
+}
+
+// EntityIDNotNil applies the NotNil predicate on the "entity_id" field.
+func EntityIDNotNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotNull(FieldEntityID))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ImportItem {
+	return predicate.ImportItem(sql.FieldContainsFold(FieldError, v))
+}
+
+// HasJob applies the HasEdge predicate on the "job" edge.
+func HasJob() predicate.ImportItem {
+	return predicate.ImportItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, JobTable, JobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobWith applies the HasEdge predicate on the "job" edge with a given conditions (other predicates).
+func HasJobWith(preds ...predicate.ImportJob) predicate.ImportItem {
+	return predicate.ImportItem(func(s *sql.Selector) {
+		step := newJobStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportItem) predicate.ImportItem {
+	return predicate.ImportItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportItem) predicate.ImportItem {
+	return predicate.ImportItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportItem) predicate.ImportItem {
+	return predicate.ImportItem(sql.NotPredicates(p))
+}
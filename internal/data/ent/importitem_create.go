@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/objects"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportItemCreate is the builder for creating a ImportItem entity.
+type ImportItemCreate struct {
+	config
+	mutation *ImportItemMutation
+	hooks    []Hook
+}
+
+// SetJobID sets the "job_id" field.
+func (iic *ImportItemCreate) SetJobID(u uint64) *ImportItemCreate {
+	iic.mutation.SetJobID(u)
+	return iic
+}
+
+// SetSeq sets the "seq" field.
+func (iic *ImportItemCreate) SetSeq(u uint32) *ImportItemCreate {
+	iic.mutation.SetSeq(u)
+	return iic
+}
+
+// SetKind sets the "kind" field.
+func (iic *ImportItemCreate) SetKind(i importitem.Kind) *ImportItemCreate {
+	iic.mutation.SetKind(i)
+	return iic
+}
+
+// SetRef sets the "ref" field.
+func (iic *ImportItemCreate) SetRef(s string) *ImportItemCreate {
+	iic.mutation.SetRef(s)
+	return iic
+}
+
+// SetParentRef sets the "parent_ref" field.
+func (iic *ImportItemCreate) SetParentRef(s string) *ImportItemCreate {
+	iic.mutation.SetParentRef(s)
+	return iic
+}
+
+// SetNillableParentRef sets the "parent_ref" field if the given value is not nil.
+func (iic *ImportItemCreate) SetNillableParentRef(s *string) *ImportItemCreate {
+	if s != nil {
+		iic.SetParentRef(*s)
+	}
+	return iic
+}
+
+// SetTargetRef sets the "target_ref" field.
+func (iic *ImportItemCreate) SetTargetRef(s string) *ImportItemCreate {
+	iic.mutation.SetTargetRef(s)
+	return iic
+}
+
+// SetNillableTargetRef sets the "target_ref" field if the given value is not nil.
+func (iic *ImportItemCreate) SetNillableTargetRef(s *string) *ImportItemCreate {
+	if s != nil {
+		iic.SetTargetRef(*s)
+	}
+	return iic
+}
+
+// SetItemTime sets the "item_time" field.
+func (iic *ImportItemCreate) SetItemTime(i int64) *ImportItemCreate {
+	iic.mutation.SetItemTime(i)
+	return iic
+}
+
+// SetData sets the "data" field.
+func (iic *ImportItemCreate) SetData(oid *objects.ImportItemData) *ImportItemCreate {
+	iic.mutation.SetData(oid)
+	return iic
+}
+
+// SetStatus sets the "status" field.
+func (iic *ImportItemCreate) SetStatus(i importitem.Status) *ImportItemCreate {
+	iic.mutation.SetStatus(i)
+	return iic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iic *ImportItemCreate) SetNillableStatus(i *importitem.Status) *ImportItemCreate {
+	if i != nil {
+		iic.SetStatus(*i)
+	}
+	return iic
+}
+
+// SetEntityID sets the "entity_id" field.
+func (iic *ImportItemCreate) SetEntityID(u uint64) *ImportItemCreate {
+	iic.mutation.SetEntityID(u)
+	return iic
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (iic *ImportItemCreate) SetNillableEntityID(u *uint64) *ImportItemCreate {
+	if u != nil {
+		iic.SetEntityID(*u)
+	}
+	return iic
+}
+
+// SetError sets the "error" field.
+func (iic *ImportItemCreate) SetError(s string) *ImportItemCreate {
+	iic.mutation.SetError(s)
+	return iic
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iic *ImportItemCreate) SetNillableError(s *string) *ImportItemCreate {
+	if s != nil {
+		iic.SetError(*s)
+	}
+	return iic
+}
+
+// SetID sets the "id" field.
+func (iic *ImportItemCreate) SetID(u uint64) *ImportItemCreate {
+	iic.mutation.SetID(u)
+	return iic
+}
+
+// SetJob sets the "job" edge to the ImportJob entity.
+func (iic *ImportItemCreate) SetJob(i *ImportJob) *ImportItemCreate {
+	return iic.SetJobID(i.ID)
+}
+
+// Mutation returns the ImportItemMutation object of the builder.
+func (iic *ImportItemCreate) Mutation() *ImportItemMutation {
+	return iic.mutation
+}
+
+// Save creates the ImportItem in the database.
+func (iic *ImportItemCreate) Save(ctx context.Context) (*ImportItem, error) {
+	iic.defaults()
+	return withHooks(ctx, iic.sqlSave, iic.mutation, iic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iic *ImportItemCreate) SaveX(ctx context.Context) *ImportItem {
+	v, err := iic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iic *ImportItemCreate) Exec(ctx context.Context) error {
+	_, err := iic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iic *ImportItemCreate) ExecX(ctx context.Context) {
+	if err := iic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iic *ImportItemCreate) defaults() {
+	if _, ok := iic.mutation.Status(); !ok {
+		v := importitem.DefaultStatus
+		iic.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iic *ImportItemCreate) check() error {
+	if _, ok := iic.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "ImportItem.job_id"`)}
+	}
+	if _, ok := iic.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "ImportItem.seq"`)}
+	}
+	if _, ok := iic.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ImportItem.kind"`)}
+	}
+	if v, ok := iic.mutation.Kind(); ok {
+		if err := importitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ImportItem.kind": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.Ref(); !ok {
+		return &ValidationError{Name: "ref", err: errors.New(`ent: missing required field "ImportItem.ref"`)}
+	}
+	if v, ok := iic.mutation.Ref(); ok {
+		if err := importitem.RefValidator(v); err != nil {
+			return &ValidationError{Name: "ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.ref": %w`, err)}
+		}
+	}
+	if v, ok := iic.mutation.ParentRef(); ok {
+		if err := importitem.ParentRefValidator(v); err != nil {
+			return &ValidationError{Name: "parent_ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.parent_ref": %w`, err)}
+		}
+	}
+	if v, ok := iic.mutation.TargetRef(); ok {
+		if err := importitem.TargetRefValidator(v); err != nil {
+			return &ValidationError{Name: "target_ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.target_ref": %w`, err)}
+		}
+	}
+	if _, ok := iic.mutation.ItemTime(); !ok {
+		return &ValidationError{Name: "item_time", err: errors.New(`ent: missing required field "ImportItem.item_time"`)}
+	}
+	if _, ok := iic.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "ImportItem.data"`)}
+	}
+	if _, ok := iic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportItem.status"`)}
+	}
+	if v, ok := iic.mutation.Status(); ok {
+		if err := importitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportItem.status": %w`, err)}
+		}
+	}
+	if v, ok := iic.mutation.Error(); ok {
+		if err := importitem.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ImportItem.error": %w`, err)}
+		}
+	}
+	if len(iic.mutation.JobIDs()) == 0 {
+		return &ValidationError{Name: "job", err: errors.New(`ent: missing required edge "ImportItem.job"`)}
+	}
+	return nil
+}
+
+func (iic *ImportItemCreate) sqlSave(ctx context.Context) (*ImportItem, error) {
+	if err := iic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	iic.mutation.id = &_node.ID
+	iic.mutation.done = true
+	return _node, nil
+}
+
+func (iic *ImportItemCreate) createSpec() (*ImportItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportItem{config: iic.config}
+		_spec = sqlgraph.NewCreateSpec(importitem.Table, sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64))
+	)
+	if id, ok := iic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := iic.mutation.Seq(); ok {
+		_spec.SetField(importitem.FieldSeq, field.TypeUint32, value)
+		_node.Seq = value
+	}
+	if value, ok := iic.mutation.Kind(); ok {
+		_spec.SetField(importitem.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := iic.mutation.Ref(); ok {
+		_spec.SetField(importitem.FieldRef, field.TypeString, value)
+		_node.Ref = value
+	}
+	if value, ok := iic.mutation.ParentRef(); ok {
+		_spec.SetField(importitem.FieldParentRef, field.TypeString, value)
+		_node.ParentRef = value
+	}
+	if value, ok := iic.mutation.TargetRef(); ok {
+		_spec.SetField(importitem.FieldTargetRef, field.TypeString, value)
+		_node.TargetRef = value
+	}
+	if value, ok := iic.mutation.ItemTime(); ok {
+		_spec.SetField(importitem.FieldItemTime, field.TypeInt64, value)
+		_node.ItemTime = value
+	}
+	if value, ok := iic.mutation.Data(); ok {
+		_spec.SetField(importitem.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := iic.mutation.Status(); ok {
+		_spec.SetField(importitem.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := iic.mutation.EntityID(); ok {
+		_spec.SetField(importitem.FieldEntityID, field.TypeUint64, value)
+		_node.EntityID = value
+	}
+	if value, ok := iic.mutation.Error(); ok {
+		_spec.SetField(importitem.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := iic.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importitem.JobTable,
+			Columns: []string{importitem.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.JobID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImportItemCreateBulk is the builder for creating many ImportItem entities in bulk.
+type ImportItemCreateBulk struct {
+	config
+	err      error
+	builders []*ImportItemCreate
+}
+
+// Save creates the ImportItem entities in the database.
+func (iicb *ImportItemCreateBulk) Save(ctx context.Context) ([]*ImportItem, error) {
+	if iicb.err != nil {
+		return nil, iicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iicb.builders))
+	nodes := make([]*ImportItem, len(iicb.builders))
+	mutators := make([]Mutator, len(iicb.builders))
+	for i := range iicb.builders {
+		func(i int, root context.Context) {
+			builder := iicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iicb *ImportItemCreateBulk) SaveX(ctx context.Context) []*ImportItem {
+	v, err := iicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iicb *ImportItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := iicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iicb *ImportItemCreateBulk) ExecX(ctx context.Context) {
+	if err := iicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
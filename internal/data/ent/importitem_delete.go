@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportItemDelete is the builder for deleting a ImportItem entity.
+type ImportItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *ImportItemMutation
+}
+
+// Where appends a list predicates to the ImportItemDelete builder.
+func (iid *ImportItemDelete) Where(ps ...predicate.ImportItem) *ImportItemDelete {
+	iid.mutation.Where(ps...)
+	return iid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (iid *ImportItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, iid.sqlExec, iid.mutation, iid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iid *ImportItemDelete) ExecX(ctx context.Context) int {
+	n, err := iid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (iid *ImportItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(importitem.Table, sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64))
+	if ps := iid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, iid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	iid.mutation.done = true
+	return affected, err
+}
+
+// ImportItemDeleteOne is the builder for deleting a single ImportItem entity.
+type ImportItemDeleteOne struct {
+	iid *ImportItemDelete
+}
+
+// Where appends a list predicates to the ImportItemDelete builder.
+func (iido *ImportItemDeleteOne) Where(ps ...predicate.ImportItem) *ImportItemDeleteOne {
+	iido.iid.mutation.Where(ps...)
+	return iido
+}
+
+// Exec executes the deletion query.
+func (iido *ImportItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := iido.iid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{importitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iido *ImportItemDeleteOne) ExecX(ctx context.Context) {
+	if err := iido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,628 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportItemQuery is the builder for querying ImportItem entities.
+type ImportItemQuery struct {
+	config
+	ctx        *QueryContext
+	order      []importitem.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ImportItem
+	withJob    *ImportJobQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ImportItemQuery builder.
+func (iiq *ImportItemQuery) Where(ps ...predicate.ImportItem) *ImportItemQuery {
+	iiq.predicates = append(iiq.predicates, ps...)
+	return iiq
+}
+
+// Limit the number of records to be returned by this query.
+func (iiq *ImportItemQuery) Limit(limit int) *ImportItemQuery {
+	iiq.ctx.Limit = &limit
+	return iiq
+}
+
+// Offset to start from.
+func (iiq *ImportItemQuery) Offset(offset int) *ImportItemQuery {
+	iiq.ctx.Offset = &offset
+	return iiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (iiq *ImportItemQuery) Unique(unique bool) *ImportItemQuery {
+	iiq.ctx.Unique = &unique
+	return iiq
+}
+
+// Order specifies how the records should be ordered.
+func (iiq *ImportItemQuery) Order(o ...importitem.OrderOption) *ImportItemQuery {
+	iiq.order = append(iiq.order, o...)
+	return iiq
+}
+
+// QueryJob chains the current query on the "job" edge.
+func (iiq *ImportItemQuery) QueryJob() *ImportJobQuery {
+	query := (&ImportJobClient{config: iiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(importitem.Table, importitem.FieldID, selector),
+			sqlgraph.To(importjob.Table, importjob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, importitem.JobTable, importitem.JobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ImportItem entity from the query.
+// Returns a *NotFoundError when no ImportItem was found.
+func (iiq *ImportItemQuery) First(ctx context.Context) (*ImportItem, error) {
+	nodes, err := iiq.Limit(1).All(setContextOp(ctx, iiq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{importitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iiq *ImportItemQuery) FirstX(ctx context.Context) *ImportItem {
+	node, err := iiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ImportItem ID from the query.
+// Returns a *NotFoundError when no ImportItem ID was found.
+func (iiq *ImportItemQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = iiq.Limit(1).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{importitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (iiq *ImportItemQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := iiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ImportItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ImportItem entity is found.
+// Returns a *NotFoundError when no ImportItem entities are found.
+func (iiq *ImportItemQuery) Only(ctx context.Context) (*ImportItem, error) {
+	nodes, err := iiq.Limit(2).All(setContextOp(ctx, iiq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{importitem.Label}
+	default:
+		return nil, &NotSingularError{importitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iiq *ImportItemQuery) OnlyX(ctx context.Context) *ImportItem {
+	node, err := iiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ImportItem ID in the query.
+// Returns a *NotSingularError when more than one ImportItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (iiq *ImportItemQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = iiq.Limit(2).IDs(setContextOp(ctx, iiq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{importitem.Label}
+	default:
+		err = &NotSingularError{importitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (iiq *ImportItemQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := iiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ImportItems.
+func (iiq *ImportItemQuery) All(ctx context.Context) ([]*ImportItem, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryAll)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ImportItem, *ImportItemQuery]()
+	return withInterceptors[[]*ImportItem](ctx, iiq, qr, iiq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iiq *ImportItemQuery) AllX(ctx context.Context) []*ImportItem {
+	nodes, err := iiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ImportItem IDs.
+func (iiq *ImportItemQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if iiq.ctx.Unique == nil && iiq.path != nil {
+		iiq.Unique(true)
+	}
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryIDs)
+	if err = iiq.Select(importitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iiq *ImportItemQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := iiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iiq *ImportItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryCount)
+	if err := iiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, iiq, querierCount[*ImportItemQuery](), iiq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iiq *ImportItemQuery) CountX(ctx context.Context) int {
+	count, err := iiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iiq *ImportItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, iiq.ctx, ent.OpQueryExist)
+	switch _, err := iiq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iiq *ImportItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := iiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ImportItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iiq *ImportItemQuery) Clone() *ImportItemQuery {
+	if iiq == nil {
+		return nil
+	}
+	return &ImportItemQuery{
+		config:     iiq.config,
+		ctx:        iiq.ctx.Clone(),
+		order:      append([]importitem.OrderOption{}, iiq.order...),
+		inters:     append([]Interceptor{}, iiq.inters...),
+		predicates: append([]predicate.ImportItem{}, iiq.predicates...),
+		withJob:    iiq.withJob.Clone(),
+		// clone intermediate query.
+		sql:  iiq.sql.Clone(),
+		path: iiq.path,
+	}
+}
+
+// WithJob tells the query-builder to eager-load the nodes that are connected to
+// the "job" edge. The optional arguments are used to configure the query builder of the edge.
+func (iiq *ImportItemQuery) WithJob(opts ...func(*ImportJobQuery)) *ImportItemQuery {
+	query := (&ImportJobClient{config: iiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iiq.withJob = query
+	return iiq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		JobID uint64 `json:"job_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ImportItem.Query().
+//		GroupBy(importitem.FieldJobID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (iiq *ImportItemQuery) GroupBy(field string, fields ...string) *ImportItemGroupBy {
+	iiq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ImportItemGroupBy{build: iiq}
+	grbuild.flds = &iiq.ctx.Fields
+	grbuild.label = importitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		JobID uint64 `json:"job_id,omitempty"`
+//	}
+//
+//	client.ImportItem.Query().
+//		Select(importitem.FieldJobID).
+//		Scan(ctx, &v)
+func (iiq *ImportItemQuery) Select(fields ...string) *ImportItemSelect {
+	iiq.ctx.Fields = append(iiq.ctx.Fields, fields...)
+	sbuild := &ImportItemSelect{ImportItemQuery: iiq}
+	sbuild.label = importitem.Label
+	sbuild.flds, sbuild.scan = &iiq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ImportItemSelect configured with the given aggregations.
+func (iiq *ImportItemQuery) Aggregate(fns ...AggregateFunc) *ImportItemSelect {
+	return iiq.Select().Aggregate(fns...)
+}
+
+func (iiq *ImportItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range iiq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, iiq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range iiq.ctx.Fields {
+		if !importitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if iiq.path != nil {
+		prev, err := iiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iiq.sql = prev
+	}
+	return nil
+}
+
+func (iiq *ImportItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ImportItem, error) {
+	var (
+		nodes       = []*ImportItem{}
+		_spec       = iiq.querySpec()
+		loadedTypes = [1]bool{
+			iiq.withJob != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ImportItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ImportItem{config: iiq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := iiq.withJob; query != nil {
+		if err := iiq.loadJob(ctx, query, nodes, nil,
+			func(n *ImportItem, e *ImportJob) { n.Edges.Job = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (iiq *ImportItemQuery) loadJob(ctx context.Context, query *ImportJobQuery, nodes []*ImportItem, init func(*ImportItem), assign func(*ImportItem, *ImportJob)) error {
+	ids := make([]uint64, 0, len(nodes))
+	nodeids := make(map[uint64][]*ImportItem)
+	for i := range nodes {
+		fk := nodes[i].JobID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(importjob.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "job_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (iiq *ImportItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := iiq.querySpec()
+	if len(iiq.modifiers) > 0 {
+		_spec.Modifiers = iiq.modifiers
+	}
+	_spec.Node.Columns = iiq.ctx.Fields
+	if len(iiq.ctx.Fields) > 0 {
+		_spec.Unique = iiq.ctx.Unique != nil && *iiq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, iiq.driver, _spec)
+}
+
+func (iiq *ImportItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(importitem.Table, importitem.Columns, sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64))
+	_spec.From = iiq.sql
+	if unique := iiq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if iiq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := iiq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importitem.FieldID)
+		for i := range fields {
+			if fields[i] != importitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if iiq.withJob != nil {
+			_spec.Node.AddColumnOnce(importitem.FieldJobID)
+		}
+	}
+	if ps := iiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iiq *ImportItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(iiq.driver.Dialect())
+	t1 := builder.Table(importitem.Table)
+	columns := iiq.ctx.Fields
+	if len(columns) == 0 {
+		columns = importitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if iiq.sql != nil {
+		selector = iiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if iiq.ctx.Unique != nil && *iiq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range iiq.modifiers {
+		m(selector)
+	}
+	for _, p := range iiq.predicates {
+		p(selector)
+	}
+	for _, p := range iiq.order {
+		p(selector)
+	}
+	if offset := iiq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iiq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iiq *ImportItemQuery) Modify(modifiers ...func(s *sql.Selector)) *ImportItemSelect {
+	iiq.modifiers = append(iiq.modifiers, modifiers...)
+	return iiq.Select()
+}
+
+// ImportItemGroupBy is the group-by builder for ImportItem entities.
+type ImportItemGroupBy struct {
+	selector
+	build *ImportItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iigb *ImportItemGroupBy) Aggregate(fns ...AggregateFunc) *ImportItemGroupBy {
+	iigb.fns = append(iigb.fns, fns...)
+	return iigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iigb *ImportItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iigb.build.ctx, ent.OpQueryGroupBy)
+	if err := iigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ImportItemQuery, *ImportItemGroupBy](ctx, iigb.build, iigb, iigb.build.inters, v)
+}
+
+func (iigb *ImportItemGroupBy) sqlScan(ctx context.Context, root *ImportItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iigb.fns))
+	for _, fn := range iigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iigb.flds)+len(iigb.fns))
+		for _, f := range *iigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ImportItemSelect is the builder for selecting fields of ImportItem entities.
+type ImportItemSelect struct {
+	*ImportItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (iis *ImportItemSelect) Aggregate(fns ...AggregateFunc) *ImportItemSelect {
+	iis.fns = append(iis.fns, fns...)
+	return iis
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iis *ImportItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iis.ctx, ent.OpQuerySelect)
+	if err := iis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ImportItemQuery, *ImportItemSelect](ctx, iis.ImportItemQuery, iis, iis.inters, v)
+}
+
+func (iis *ImportItemSelect) sqlScan(ctx context.Context, root *ImportItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(iis.fns))
+	for _, fn := range iis.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*iis.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iis *ImportItemSelect) Modify(modifiers ...func(s *sql.Selector)) *ImportItemSelect {
+	iis.modifiers = append(iis.modifiers, modifiers...)
+	return iis
+}
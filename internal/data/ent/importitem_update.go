@@ -0,0 +1,839 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/data/ent/predicate"
+	"step/internal/objects"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportItemUpdate is the builder for updating ImportItem entities.
+type ImportItemUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportItemUpdate builder.
+func (iiu *ImportItemUpdate) Where(ps ...predicate.ImportItem) *ImportItemUpdate {
+	iiu.mutation.Where(ps...)
+	return iiu
+}
+
+// SetJobID sets the "job_id" field.
+func (iiu *ImportItemUpdate) SetJobID(u uint64) *ImportItemUpdate {
+	iiu.mutation.SetJobID(u)
+	return iiu
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableJobID(u *uint64) *ImportItemUpdate {
+	if u != nil {
+		iiu.SetJobID(*u)
+	}
+	return iiu
+}
+
+// SetSeq sets the "seq" field.
+func (iiu *ImportItemUpdate) SetSeq(u uint32) *ImportItemUpdate {
+	iiu.mutation.ResetSeq()
+	iiu.mutation.SetSeq(u)
+	return iiu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableSeq(u *uint32) *ImportItemUpdate {
+	if u != nil {
+		iiu.SetSeq(*u)
+	}
+	return iiu
+}
+
+// AddSeq adds u to the "seq" field.
+func (iiu *ImportItemUpdate) AddSeq(u int32) *ImportItemUpdate {
+	iiu.mutation.AddSeq(u)
+	return iiu
+}
+
+// SetKind sets the "kind" field.
+func (iiu *ImportItemUpdate) SetKind(i importitem.Kind) *ImportItemUpdate {
+	iiu.mutation.SetKind(i)
+	return iiu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableKind(i *importitem.Kind) *ImportItemUpdate {
+	if i != nil {
+		iiu.SetKind(*i)
+	}
+	return iiu
+}
+
+// SetRef sets the "ref" field.
+func (iiu *ImportItemUpdate) SetRef(s string) *ImportItemUpdate {
+	iiu.mutation.SetRef(s)
+	return iiu
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableRef(s *string) *ImportItemUpdate {
+	if s != nil {
+		iiu.SetRef(*s)
+	}
+	return iiu
+}
+
+// SetParentRef sets the "parent_ref" field.
+func (iiu *ImportItemUpdate) SetParentRef(s string) *ImportItemUpdate {
+	iiu.mutation.SetParentRef(s)
+	return iiu
+}
+
+// SetNillableParentRef sets the "parent_ref" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableParentRef(s *string) *ImportItemUpdate {
+	if s != nil {
+		iiu.SetParentRef(*s)
+	}
+	return iiu
+}
+
+// ClearParentRef clears the value of the "parent_ref" field.
+func (iiu *ImportItemUpdate) ClearParentRef() *ImportItemUpdate {
+	iiu.mutation.ClearParentRef()
+	return iiu
+}
+
+// SetTargetRef sets the "target_ref" field.
+func (iiu *ImportItemUpdate) SetTargetRef(s string) *ImportItemUpdate {
+	iiu.mutation.SetTargetRef(s)
+	return iiu
+}
+
+// SetNillableTargetRef sets the "target_ref" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableTargetRef(s *string) *ImportItemUpdate {
+	if s != nil {
+		iiu.SetTargetRef(*s)
+	}
+	return iiu
+}
+
+// ClearTargetRef clears the value of the "target_ref" field.
+func (iiu *ImportItemUpdate) ClearTargetRef() *ImportItemUpdate {
+	iiu.mutation.ClearTargetRef()
+	return iiu
+}
+
+// SetItemTime sets the "item_time" field.
+func (iiu *ImportItemUpdate) SetItemTime(i int64) *ImportItemUpdate {
+	iiu.mutation.ResetItemTime()
+	iiu.mutation.SetItemTime(i)
+	return iiu
+}
+
+// SetNillableItemTime sets the "item_time" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableItemTime(i *int64) *ImportItemUpdate {
+	if i != nil {
+		iiu.SetItemTime(*i)
+	}
+	return iiu
+}
+
+// AddItemTime adds i to the "item_time" field.
+func (iiu *ImportItemUpdate) AddItemTime(i int64) *ImportItemUpdate {
+	iiu.mutation.AddItemTime(i)
+	return iiu
+}
+
+// SetData sets the "data" field.
+func (iiu *ImportItemUpdate) SetData(oid *objects.ImportItemData) *ImportItemUpdate {
+	iiu.mutation.SetData(oid)
+	return iiu
+}
+
+// SetStatus sets the "status" field.
+func (iiu *ImportItemUpdate) SetStatus(i importitem.Status) *ImportItemUpdate {
+	iiu.mutation.SetStatus(i)
+	return iiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableStatus(i *importitem.Status) *ImportItemUpdate {
+	if i != nil {
+		iiu.SetStatus(*i)
+	}
+	return iiu
+}
+
+// SetEntityID sets the "entity_id" field.
+func (iiu *ImportItemUpdate) SetEntityID(u uint64) *ImportItemUpdate {
+	iiu.mutation.ResetEntityID()
+	iiu.mutation.SetEntityID(u)
+	return iiu
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableEntityID(u *uint64) *ImportItemUpdate {
+	if u != nil {
+		iiu.SetEntityID(*u)
+	}
+	return iiu
+}
+
+// AddEntityID adds u to the "entity_id" field.
+func (iiu *ImportItemUpdate) AddEntityID(u int64) *ImportItemUpdate {
+	iiu.mutation.AddEntityID(u)
+	return iiu
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (iiu *ImportItemUpdate) ClearEntityID() *ImportItemUpdate {
+	iiu.mutation.ClearEntityID()
+	return iiu
+}
+
+// SetError sets the "error" field.
+func (iiu *ImportItemUpdate) SetError(s string) *ImportItemUpdate {
+	iiu.mutation.SetError(s)
+	return iiu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iiu *ImportItemUpdate) SetNillableError(s *string) *ImportItemUpdate {
+	if s != nil {
+		iiu.SetError(*s)
+	}
+	return iiu
+}
+
+// ClearError clears the value of the "error" field.
+func (iiu *ImportItemUpdate) ClearError() *ImportItemUpdate {
+	iiu.mutation.ClearError()
+	return iiu
+}
+
+// SetJob sets the "job" edge to the ImportJob entity.
+func (iiu *ImportItemUpdate) SetJob(i *ImportJob) *ImportItemUpdate {
+	return iiu.SetJobID(i.ID)
+}
+
+// Mutation returns the ImportItemMutation object of the builder.
+func (iiu *ImportItemUpdate) Mutation() *ImportItemMutation {
+	return iiu.mutation
+}
+
+// ClearJob clears the "job" edge to the ImportJob entity.
+func (iiu *ImportItemUpdate) ClearJob() *ImportItemUpdate {
+	iiu.mutation.ClearJob()
+	return iiu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iiu *ImportItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iiu.sqlSave, iiu.mutation, iiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiu *ImportItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := iiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iiu *ImportItemUpdate) Exec(ctx context.Context) error {
+	_, err := iiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiu *ImportItemUpdate) ExecX(ctx context.Context) {
+	if err := iiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiu *ImportItemUpdate) check() error {
+	if v, ok := iiu.mutation.Kind(); ok {
+		if err := importitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ImportItem.kind": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.Ref(); ok {
+		if err := importitem.RefValidator(v); err != nil {
+			return &ValidationError{Name: "ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.ref": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.ParentRef(); ok {
+		if err := importitem.ParentRefValidator(v); err != nil {
+			return &ValidationError{Name: "parent_ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.parent_ref": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.TargetRef(); ok {
+		if err := importitem.TargetRefValidator(v); err != nil {
+			return &ValidationError{Name: "target_ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.target_ref": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.Status(); ok {
+		if err := importitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportItem.status": %w`, err)}
+		}
+	}
+	if v, ok := iiu.mutation.Error(); ok {
+		if err := importitem.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ImportItem.error": %w`, err)}
+		}
+	}
+	if iiu.mutation.JobCleared() && len(iiu.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportItem.job"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiu *ImportItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportItemUpdate {
+	iiu.modifiers = append(iiu.modifiers, modifiers...)
+	return iiu
+}
+
+func (iiu *ImportItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iiu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importitem.Table, importitem.Columns, sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64))
+	if ps := iiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiu.mutation.Seq(); ok {
+		_spec.SetField(importitem.FieldSeq, field.TypeUint32, value)
+	}
+	if value, ok := iiu.mutation.AddedSeq(); ok {
+		_spec.AddField(importitem.FieldSeq, field.TypeUint32, value)
+	}
+	if value, ok := iiu.mutation.Kind(); ok {
+		_spec.SetField(importitem.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := iiu.mutation.Ref(); ok {
+		_spec.SetField(importitem.FieldRef, field.TypeString, value)
+	}
+	if value, ok := iiu.mutation.ParentRef(); ok {
+		_spec.SetField(importitem.FieldParentRef, field.TypeString, value)
+	}
+	if iiu.mutation.ParentRefCleared() {
+		_spec.ClearField(importitem.FieldParentRef, field.TypeString)
+	}
+	if value, ok := iiu.mutation.TargetRef(); ok {
+		_spec.SetField(importitem.FieldTargetRef, field.TypeString, value)
+	}
+	if iiu.mutation.TargetRefCleared() {
+		_spec.ClearField(importitem.FieldTargetRef, field.TypeString)
+	}
+	if value, ok := iiu.mutation.ItemTime(); ok {
+		_spec.SetField(importitem.FieldItemTime, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.AddedItemTime(); ok {
+		_spec.AddField(importitem.FieldItemTime, field.TypeInt64, value)
+	}
+	if value, ok := iiu.mutation.Data(); ok {
+		_spec.SetField(importitem.FieldData, field.TypeJSON, value)
+	}
+	if value, ok := iiu.mutation.Status(); ok {
+		_spec.SetField(importitem.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iiu.mutation.EntityID(); ok {
+		_spec.SetField(importitem.FieldEntityID, field.TypeUint64, value)
+	}
+	if value, ok := iiu.mutation.AddedEntityID(); ok {
+		_spec.AddField(importitem.FieldEntityID, field.TypeUint64, value)
+	}
+	if iiu.mutation.EntityIDCleared() {
+		_spec.ClearField(importitem.FieldEntityID, field.TypeUint64)
+	}
+	if value, ok := iiu.mutation.Error(); ok {
+		_spec.SetField(importitem.FieldError, field.TypeString, value)
+	}
+	if iiu.mutation.ErrorCleared() {
+		_spec.ClearField(importitem.FieldError, field.TypeString)
+	}
+	if iiu.mutation.JobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importitem.JobTable,
+			Columns: []string{importitem.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiu.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importitem.JobTable,
+			Columns: []string{importitem.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iiu.mutation.done = true
+	return n, nil
+}
+
+// ImportItemUpdateOne is the builder for updating a single ImportItem entity.
+type ImportItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetJobID sets the "job_id" field.
+func (iiuo *ImportItemUpdateOne) SetJobID(u uint64) *ImportItemUpdateOne {
+	iiuo.mutation.SetJobID(u)
+	return iiuo
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableJobID(u *uint64) *ImportItemUpdateOne {
+	if u != nil {
+		iiuo.SetJobID(*u)
+	}
+	return iiuo
+}
+
+// SetSeq sets the "seq" field.
+func (iiuo *ImportItemUpdateOne) SetSeq(u uint32) *ImportItemUpdateOne {
+	iiuo.mutation.ResetSeq()
+	iiuo.mutation.SetSeq(u)
+	return iiuo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableSeq(u *uint32) *ImportItemUpdateOne {
+	if u != nil {
+		iiuo.SetSeq(*u)
+	}
+	return iiuo
+}
+
+// AddSeq adds u to the "seq" field.
+func (iiuo *ImportItemUpdateOne) AddSeq(u int32) *ImportItemUpdateOne {
+	iiuo.mutation.AddSeq(u)
+	return iiuo
+}
+
+// SetKind sets the "kind" field.
+func (iiuo *ImportItemUpdateOne) SetKind(i importitem.Kind) *ImportItemUpdateOne {
+	iiuo.mutation.SetKind(i)
+	return iiuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableKind(i *importitem.Kind) *ImportItemUpdateOne {
+	if i != nil {
+		iiuo.SetKind(*i)
+	}
+	return iiuo
+}
+
+// SetRef sets the "ref" field.
+func (iiuo *ImportItemUpdateOne) SetRef(s string) *ImportItemUpdateOne {
+	iiuo.mutation.SetRef(s)
+	return iiuo
+}
+
+// SetNillableRef sets the "ref" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableRef(s *string) *ImportItemUpdateOne {
+	if s != nil {
+		iiuo.SetRef(*s)
+	}
+	return iiuo
+}
+
+// SetParentRef sets the "parent_ref" field.
+func (iiuo *ImportItemUpdateOne) SetParentRef(s string) *ImportItemUpdateOne {
+	iiuo.mutation.SetParentRef(s)
+	return iiuo
+}
+
+// SetNillableParentRef sets the "parent_ref" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableParentRef(s *string) *ImportItemUpdateOne {
+	if s != nil {
+		iiuo.SetParentRef(*s)
+	}
+	return iiuo
+}
+
+// ClearParentRef clears the value of the "parent_ref" field.
+func (iiuo *ImportItemUpdateOne) ClearParentRef() *ImportItemUpdateOne {
+	iiuo.mutation.ClearParentRef()
+	return iiuo
+}
+
+// SetTargetRef sets the "target_ref" field.
+func (iiuo *ImportItemUpdateOne) SetTargetRef(s string) *ImportItemUpdateOne {
+	iiuo.mutation.SetTargetRef(s)
+	return iiuo
+}
+
+// SetNillableTargetRef sets the "target_ref" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableTargetRef(s *string) *ImportItemUpdateOne {
+	if s != nil {
+		iiuo.SetTargetRef(*s)
+	}
+	return iiuo
+}
+
+// ClearTargetRef clears the value of the "target_ref" field.
+func (iiuo *ImportItemUpdateOne) ClearTargetRef() *ImportItemUpdateOne {
+	iiuo.mutation.ClearTargetRef()
+	return iiuo
+}
+
+// SetItemTime sets the "item_time" field.
+func (iiuo *ImportItemUpdateOne) SetItemTime(i int64) *ImportItemUpdateOne {
+	iiuo.mutation.ResetItemTime()
+	iiuo.mutation.SetItemTime(i)
+	return iiuo
+}
+
+// SetNillableItemTime sets the "item_time" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableItemTime(i *int64) *ImportItemUpdateOne {
+	if i != nil {
+		iiuo.SetItemTime(*i)
+	}
+	return iiuo
+}
+
+// AddItemTime adds i to the "item_time" field.
+func (iiuo *ImportItemUpdateOne) AddItemTime(i int64) *ImportItemUpdateOne {
+	iiuo.mutation.AddItemTime(i)
+	return iiuo
+}
+
+// SetData sets the "data" field.
+func (iiuo *ImportItemUpdateOne) SetData(oid *objects.ImportItemData) *ImportItemUpdateOne {
+	iiuo.mutation.SetData(oid)
+	return iiuo
+}
+
+// SetStatus sets the "status" field.
+func (iiuo *ImportItemUpdateOne) SetStatus(i importitem.Status) *ImportItemUpdateOne {
+	iiuo.mutation.SetStatus(i)
+	return iiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableStatus(i *importitem.Status) *ImportItemUpdateOne {
+	if i != nil {
+		iiuo.SetStatus(*i)
+	}
+	return iiuo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (iiuo *ImportItemUpdateOne) SetEntityID(u uint64) *ImportItemUpdateOne {
+	iiuo.mutation.ResetEntityID()
+	iiuo.mutation.SetEntityID(u)
+	return iiuo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableEntityID(u *uint64) *ImportItemUpdateOne {
+	if u != nil {
+		iiuo.SetEntityID(*u)
+	}
+	return iiuo
+}
+
+// AddEntityID adds u to the "entity_id" field.
+func (iiuo *ImportItemUpdateOne) AddEntityID(u int64) *ImportItemUpdateOne {
+	iiuo.mutation.AddEntityID(u)
+	return iiuo
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (iiuo *ImportItemUpdateOne) ClearEntityID() *ImportItemUpdateOne {
+	iiuo.mutation.ClearEntityID()
+	return iiuo
+}
+
+// SetError sets the "error" field.
+func (iiuo *ImportItemUpdateOne) SetError(s string) *ImportItemUpdateOne {
+	iiuo.mutation.SetError(s)
+	return iiuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iiuo *ImportItemUpdateOne) SetNillableError(s *string) *ImportItemUpdateOne {
+	if s != nil {
+		iiuo.SetError(*s)
+	}
+	return iiuo
+}
+
+// ClearError clears the value of the "error" field.
+func (iiuo *ImportItemUpdateOne) ClearError() *ImportItemUpdateOne {
+	iiuo.mutation.ClearError()
+	return iiuo
+}
+
+// SetJob sets the "job" edge to the ImportJob entity.
+func (iiuo *ImportItemUpdateOne) SetJob(i *ImportJob) *ImportItemUpdateOne {
+	return iiuo.SetJobID(i.ID)
+}
+
+// Mutation returns the ImportItemMutation object of the builder.
+func (iiuo *ImportItemUpdateOne) Mutation() *ImportItemMutation {
+	return iiuo.mutation
+}
+
+// ClearJob clears the "job" edge to the ImportJob entity.
+func (iiuo *ImportItemUpdateOne) ClearJob() *ImportItemUpdateOne {
+	iiuo.mutation.ClearJob()
+	return iiuo
+}
+
+// Where appends a list predicates to the ImportItemUpdate builder.
+func (iiuo *ImportItemUpdateOne) Where(ps ...predicate.ImportItem) *ImportItemUpdateOne {
+	iiuo.mutation.Where(ps...)
+	return iiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iiuo *ImportItemUpdateOne) Select(field string, fields ...string) *ImportItemUpdateOne {
+	iiuo.fields = append([]string{field}, fields...)
+	return iiuo
+}
+
+// Save executes the query and returns the updated ImportItem entity.
+func (iiuo *ImportItemUpdateOne) Save(ctx context.Context) (*ImportItem, error) {
+	return withHooks(ctx, iiuo.sqlSave, iiuo.mutation, iiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iiuo *ImportItemUpdateOne) SaveX(ctx context.Context) *ImportItem {
+	node, err := iiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iiuo *ImportItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := iiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iiuo *ImportItemUpdateOne) ExecX(ctx context.Context) {
+	if err := iiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iiuo *ImportItemUpdateOne) check() error {
+	if v, ok := iiuo.mutation.Kind(); ok {
+		if err := importitem.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ImportItem.kind": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.Ref(); ok {
+		if err := importitem.RefValidator(v); err != nil {
+			return &ValidationError{Name: "ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.ref": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.ParentRef(); ok {
+		if err := importitem.ParentRefValidator(v); err != nil {
+			return &ValidationError{Name: "parent_ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.parent_ref": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.TargetRef(); ok {
+		if err := importitem.TargetRefValidator(v); err != nil {
+			return &ValidationError{Name: "target_ref", err: fmt.Errorf(`ent: validator failed for field "ImportItem.target_ref": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.Status(); ok {
+		if err := importitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportItem.status": %w`, err)}
+		}
+	}
+	if v, ok := iiuo.mutation.Error(); ok {
+		if err := importitem.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ImportItem.error": %w`, err)}
+		}
+	}
+	if iiuo.mutation.JobCleared() && len(iiuo.mutation.JobIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ImportItem.job"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iiuo *ImportItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportItemUpdateOne {
+	iiuo.modifiers = append(iiuo.modifiers, modifiers...)
+	return iiuo
+}
+
+func (iiuo *ImportItemUpdateOne) sqlSave(ctx context.Context) (_node *ImportItem, err error) {
+	if err := iiuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importitem.Table, importitem.Columns, sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64))
+	id, ok := iiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importitem.FieldID)
+		for _, f := range fields {
+			if !importitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iiuo.mutation.Seq(); ok {
+		_spec.SetField(importitem.FieldSeq, field.TypeUint32, value)
+	}
+	if value, ok := iiuo.mutation.AddedSeq(); ok {
+		_spec.AddField(importitem.FieldSeq, field.TypeUint32, value)
+	}
+	if value, ok := iiuo.mutation.Kind(); ok {
+		_spec.SetField(importitem.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := iiuo.mutation.Ref(); ok {
+		_spec.SetField(importitem.FieldRef, field.TypeString, value)
+	}
+	if value, ok := iiuo.mutation.ParentRef(); ok {
+		_spec.SetField(importitem.FieldParentRef, field.TypeString, value)
+	}
+	if iiuo.mutation.ParentRefCleared() {
+		_spec.ClearField(importitem.FieldParentRef, field.TypeString)
+	}
+	if value, ok := iiuo.mutation.TargetRef(); ok {
+		_spec.SetField(importitem.FieldTargetRef, field.TypeString, value)
+	}
+	if iiuo.mutation.TargetRefCleared() {
+		_spec.ClearField(importitem.FieldTargetRef, field.TypeString)
+	}
+	if value, ok := iiuo.mutation.ItemTime(); ok {
+		_spec.SetField(importitem.FieldItemTime, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.AddedItemTime(); ok {
+		_spec.AddField(importitem.FieldItemTime, field.TypeInt64, value)
+	}
+	if value, ok := iiuo.mutation.Data(); ok {
+		_spec.SetField(importitem.FieldData, field.TypeJSON, value)
+	}
+	if value, ok := iiuo.mutation.Status(); ok {
+		_spec.SetField(importitem.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iiuo.mutation.EntityID(); ok {
+		_spec.SetField(importitem.FieldEntityID, field.TypeUint64, value)
+	}
+	if value, ok := iiuo.mutation.AddedEntityID(); ok {
+		_spec.AddField(importitem.FieldEntityID, field.TypeUint64, value)
+	}
+	if iiuo.mutation.EntityIDCleared() {
+		_spec.ClearField(importitem.FieldEntityID, field.TypeUint64)
+	}
+	if value, ok := iiuo.mutation.Error(); ok {
+		_spec.SetField(importitem.FieldError, field.TypeString, value)
+	}
+	if iiuo.mutation.ErrorCleared() {
+		_spec.ClearField(importitem.FieldError, field.TypeString)
+	}
+	if iiuo.mutation.JobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importitem.JobTable,
+			Columns: []string{importitem.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iiuo.mutation.JobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importitem.JobTable,
+			Columns: []string{importitem.JobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iiuo.modifiers...)
+	_node = &ImportItem{config: iiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iiuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"step/internal/data/ent/importjob"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ImportJob is the model entity for the ImportJob schema.
+type ImportJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// User ID
+	UserID string `json:"user_id,omitempty"`
+	// 导入到的父目标ID, 0为顶层目标
+	ParentTargetID uint64 `json:"parent_target_id,omitempty"`
+	// 状态
+	Status importjob.Status `json:"status,omitempty"`
+	// 清单格式
+	Format importjob.Format `json:"format,omitempty"`
+	// 压缩包对象名
+	ObjectName string `json:"object_name,omitempty"`
+	// 导入项总数
+	Total uint32 `json:"total,omitempty"`
+	// 成功数
+	Succeeded uint32 `json:"succeeded,omitempty"`
+	// 失败数
+	Failed uint32 `json:"failed,omitempty"`
+	// 失败原因
+	Error string `json:"error,omitempty"`
+	// 创建时间
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// 完成时间
+	FinishedAt int64 `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportJobQuery when eager-loading is set.
+	Edges        ImportJobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImportJobEdges holds the relations/edges for other nodes in the graph.
+type ImportJobEdges struct {
+	// Items holds the value of the items edge.
+	Items []*ImportItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImportJobEdges) ItemsOrErr() ([]*ImportItem, error) {
+	if e.loadedTypes[0] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldID, importjob.FieldParentTargetID, importjob.FieldTotal, importjob.FieldSucceeded, importjob.FieldFailed, importjob.FieldCreatedAt, importjob.FieldFinishedAt:
+			values[i] = new(sql.NullInt64)
+		case importjob.FieldUserID, importjob.FieldStatus, importjob.FieldFormat, importjob.FieldObjectName, importjob.FieldError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportJob fields.
+func (ij *ImportJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ij.ID = uint64(value.Int64)
+		case importjob.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ij.UserID = value.String
+			}
+		case importjob.FieldParentTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_target_id", values[i])
+			} else if value.Valid {
+				ij.ParentTargetID = uint64(value.Int64)
+			}
+		case importjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ij.Status = importjob.Status(value.String)
+			}
+		case importjob.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				ij.Format = importjob.Format(value.String)
+			}
+		case importjob.FieldObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_name", values[i])
+			} else if value.Valid {
+				ij.ObjectName = value.String
+			}
+		case importjob.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				ij.Total = uint32(value.Int64)
+			}
+		case importjob.FieldSucceeded:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field succeeded", values[i])
+			} else if value.Valid {
+				ij.Succeeded = uint32(value.Int64)
+			}
+		case importjob.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				ij.Failed = uint32(value.Int64)
+			}
+		case importjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ij.Error = value.String
+			}
+		case importjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ij.CreatedAt = value.Int64
+			}
+		case importjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				ij.FinishedAt = value.Int64
+			}
+		default:
+			ij.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportJob.
+// This includes values selected through modifiers, order, etc.
+func (ij *ImportJob) Value(name string) (ent.Value, error) {
+	return ij.selectValues.Get(name)
+}
+
+// QueryItems queries the "items" edge of the ImportJob entity.
+func (ij *ImportJob) QueryItems() *ImportItemQuery {
+	return NewImportJobClient(ij.config).QueryItems(ij)
+}
+
+// Update returns a builder for updating this ImportJob.
+// Note that you need to call ImportJob.Unwrap() before calling this method if this ImportJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ij *ImportJob) Update() *ImportJobUpdateOne {
+	return NewImportJobClient(ij.config).UpdateOne(ij)
+}
+
+// Unwrap unwraps the ImportJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ij *ImportJob) Unwrap() *ImportJob {
+	_tx, ok := ij.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportJob is not a transactional entity")
+	}
+	ij.config.driver = _tx.drv
+	return ij
+}
+
+// String implements the fmt.Stringer.
+func (ij *ImportJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ij.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(ij.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("parent_target_id=")
+	builder.WriteString(fmt.Sprintf("%v", ij.ParentTargetID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ij.Status))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", ij.Format))
+	builder.WriteString(", ")
+	builder.WriteString("object_name=")
+	builder.WriteString(ij.ObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", ij.Total))
+	builder.WriteString(", ")
+	builder.WriteString("succeeded=")
+	builder.WriteString(fmt.Sprintf("%v", ij.Succeeded))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", ij.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ij.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", ij.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(fmt.Sprintf("%v", ij.FinishedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportJobs is a parsable slice of ImportJob.
+type ImportJobs []*ImportJob
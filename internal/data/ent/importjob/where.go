@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importjob
+
+import (
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// ParentTargetID applies equality check predicate on the "parent_target_id" field. It's identical to ParentTargetIDEQ.
+func ParentTargetID(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldParentTargetID, v))
+}
+
+// ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
+func ObjectName(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldObjectName, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// Succeeded applies equality check predicate on the "succeeded" field. It's identical to SucceededEQ.
+func Succeeded(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldSucceeded, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ParentTargetIDEQ applies the EQ predicate on the "parent_target_id" field.
+func ParentTargetIDEQ(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldParentTargetID, v))
+}
+
+// ParentTargetIDNEQ applies the NEQ predicate on the "parent_target_id" field.
+func ParentTargetIDNEQ(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldParentTargetID, v))
+}
+
+// ParentTargetIDIn applies the In predicate on the "parent_target_id" field.
+func ParentTargetIDIn(vs ...uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldParentTargetID, vs...))
+}
+
+// ParentTargetIDNotIn applies the NotIn predicate on the "parent_target_id" field.
+func ParentTargetIDNotIn(vs ...uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldParentTargetID, vs...))
+}
+
+// ParentTargetIDGT applies the GT predicate on the "parent_target_id" field.
+func ParentTargetIDGT(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldParentTargetID, v))
+}
+
+// ParentTargetIDGTE applies the GTE predicate on the "parent_target_id" field.
+func ParentTargetIDGTE(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldParentTargetID, v))
+}
+
+// ParentTargetIDLT applies the LT predicate on the "parent_target_id" field.
+func ParentTargetIDLT(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldParentTargetID, v))
+}
+
+// ParentTargetIDLTE applies the LTE predicate on the "parent_target_id" field.
+func ParentTargetIDLTE(v uint64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldParentTargetID, v))
+}
+
+// ParentTargetIDIsNil applies the IsNil predicate on the "parent_target_id" field.
+func ParentTargetIDIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldParentTargetID))
+}
+
+// ParentTargetIDNotNil applies the NotNil predicate on the "parent_target_id" field.
+func ParentTargetIDNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldParentTargetID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// ObjectNameEQ applies the EQ predicate on the "object_name" field.
+func ObjectNameEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldObjectName, v))
+}
+
+// ObjectNameNEQ applies the NEQ predicate on the "object_name" field.
+func ObjectNameNEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldObjectName, v))
+}
+
+// ObjectNameIn applies the In predicate on the "object_name" field.
+func ObjectNameIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldObjectName, vs...))
+}
+
+// ObjectNameNotIn applies the NotIn predicate on the "object_name" field.
+func ObjectNameNotIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldObjectName, vs...))
+}
+
+// ObjectNameGT applies the GT predicate on the "object_name" field.
+func ObjectNameGT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldObjectName, v))
+}
+
+// ObjectNameGTE applies the GTE predicate on the "object_name" field.
+func ObjectNameGTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldObjectName, v))
+}
+
+// ObjectNameLT applies the LT predicate on the "object_name" field.
+func ObjectNameLT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldObjectName, v))
+}
+
+// ObjectNameLTE applies the LTE predicate on the "object_name" field.
+func ObjectNameLTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldObjectName, v))
+}
+
+// ObjectNameContains applies the Contains predicate on the "object_name" field.
+func ObjectNameContains(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContains(FieldObjectName, v))
+}
+
+// ObjectNameHasPrefix applies the HasPrefix predicate on the "object_name" field.
+func ObjectNameHasPrefix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasPrefix(FieldObjectName, v))
+}
+
+// ObjectNameHasSuffix applies the HasSuffix predicate on the "object_name" field.
+func ObjectNameHasSuffix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasSuffix(FieldObjectName, v))
+}
+
+// ObjectNameEqualFold applies the EqualFold predicate on the "object_name" field.
+func ObjectNameEqualFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEqualFold(FieldObjectName, v))
+}
+
+// ObjectNameContainsFold applies the ContainsFold predicate on the "object_name" field.
+func ObjectNameContainsFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContainsFold(FieldObjectName, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldTotal, v))
+}
+
+// SucceededEQ applies the EQ predicate on the "succeeded" field.
+func SucceededEQ(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldSucceeded, v))
+}
+
+// SucceededNEQ applies the NEQ predicate on the "succeeded" field.
+func SucceededNEQ(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldSucceeded, v))
+}
+
+// SucceededIn applies the In predicate on the "succeeded" field.
+func SucceededIn(vs ...uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldSucceeded, vs...))
+}
+
+// SucceededNotIn applies the NotIn predicate on the "succeeded" field.
+func SucceededNotIn(vs ...uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldSucceeded, vs...))
+}
+
+// SucceededGT applies the GT predicate on the "succeeded" field.
+func SucceededGT(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldSucceeded, v))
+}
+
+// SucceededGTE applies the GTE predicate on the "succeeded" field.
+func SucceededGTE(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldSucceeded, v))
+}
+
+// SucceededLT applies the LT predicate on the "succeeded" field.
+func SucceededLT(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldSucceeded, v))
+}
+
+// SucceededLTE applies the LTE predicate on the "succeeded" field.
+func SucceededLTE(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldSucceeded, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v uint32) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldFailed, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v int64) predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ImportJob {
+	return predicate.ImportJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.ImportJob {
+	return predicate.ImportJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.ImportItem) predicate.ImportJob {
+	return predicate.ImportJob(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImportJob) predicate.ImportJob {
+	return predicate.ImportJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImportJob) predicate.ImportJob {
+	return predicate.ImportJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImportJob) predicate.ImportJob {
+	return predicate.ImportJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJobCreate is the builder for creating a ImportJob entity.
+type ImportJobCreate struct {
+	config
+	mutation *ImportJobMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ijc *ImportJobCreate) SetUserID(s string) *ImportJobCreate {
+	ijc.mutation.SetUserID(s)
+	return ijc
+}
+
+// SetParentTargetID sets the "parent_target_id" field.
+func (ijc *ImportJobCreate) SetParentTargetID(u uint64) *ImportJobCreate {
+	ijc.mutation.SetParentTargetID(u)
+	return ijc
+}
+
+// SetNillableParentTargetID sets the "parent_target_id" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableParentTargetID(u *uint64) *ImportJobCreate {
+	if u != nil {
+		ijc.SetParentTargetID(*u)
+	}
+	return ijc
+}
+
+// SetStatus sets the "status" field.
+func (ijc *ImportJobCreate) SetStatus(i importjob.Status) *ImportJobCreate {
+	ijc.mutation.SetStatus(i)
+	return ijc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableStatus(i *importjob.Status) *ImportJobCreate {
+	if i != nil {
+		ijc.SetStatus(*i)
+	}
+	return ijc
+}
+
+// SetFormat sets the "format" field.
+func (ijc *ImportJobCreate) SetFormat(i importjob.Format) *ImportJobCreate {
+	ijc.mutation.SetFormat(i)
+	return ijc
+}
+
+// SetObjectName sets the "object_name" field.
+func (ijc *ImportJobCreate) SetObjectName(s string) *ImportJobCreate {
+	ijc.mutation.SetObjectName(s)
+	return ijc
+}
+
+// SetTotal sets the "total" field.
+func (ijc *ImportJobCreate) SetTotal(u uint32) *ImportJobCreate {
+	ijc.mutation.SetTotal(u)
+	return ijc
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableTotal(u *uint32) *ImportJobCreate {
+	if u != nil {
+		ijc.SetTotal(*u)
+	}
+	return ijc
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (ijc *ImportJobCreate) SetSucceeded(u uint32) *ImportJobCreate {
+	ijc.mutation.SetSucceeded(u)
+	return ijc
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableSucceeded(u *uint32) *ImportJobCreate {
+	if u != nil {
+		ijc.SetSucceeded(*u)
+	}
+	return ijc
+}
+
+// SetFailed sets the "failed" field.
+func (ijc *ImportJobCreate) SetFailed(u uint32) *ImportJobCreate {
+	ijc.mutation.SetFailed(u)
+	return ijc
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableFailed(u *uint32) *ImportJobCreate {
+	if u != nil {
+		ijc.SetFailed(*u)
+	}
+	return ijc
+}
+
+// SetError sets the "error" field.
+func (ijc *ImportJobCreate) SetError(s string) *ImportJobCreate {
+	ijc.mutation.SetError(s)
+	return ijc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableError(s *string) *ImportJobCreate {
+	if s != nil {
+		ijc.SetError(*s)
+	}
+	return ijc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ijc *ImportJobCreate) SetCreatedAt(i int64) *ImportJobCreate {
+	ijc.mutation.SetCreatedAt(i)
+	return ijc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableCreatedAt(i *int64) *ImportJobCreate {
+	if i != nil {
+		ijc.SetCreatedAt(*i)
+	}
+	return ijc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ijc *ImportJobCreate) SetFinishedAt(i int64) *ImportJobCreate {
+	ijc.mutation.SetFinishedAt(i)
+	return ijc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ijc *ImportJobCreate) SetNillableFinishedAt(i *int64) *ImportJobCreate {
+	if i != nil {
+		ijc.SetFinishedAt(*i)
+	}
+	return ijc
+}
+
+// SetID sets the "id" field.
+func (ijc *ImportJobCreate) SetID(u uint64) *ImportJobCreate {
+	ijc.mutation.SetID(u)
+	return ijc
+}
+
+// AddItemIDs adds the "items" edge to the ImportItem entity by IDs.
+func (ijc *ImportJobCreate) AddItemIDs(ids ...uint64) *ImportJobCreate {
+	ijc.mutation.AddItemIDs(ids...)
+	return ijc
+}
+
+// AddItems adds the "items" edges to the ImportItem entity.
+func (ijc *ImportJobCreate) AddItems(i ...*ImportItem) *ImportJobCreate {
+	ids := make([]uint64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ijc.AddItemIDs(ids...)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (ijc *ImportJobCreate) Mutation() *ImportJobMutation {
+	return ijc.mutation
+}
+
+// Save creates the ImportJob in the database.
+func (ijc *ImportJobCreate) Save(ctx context.Context) (*ImportJob, error) {
+	ijc.defaults()
+	return withHooks(ctx, ijc.sqlSave, ijc.mutation, ijc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ijc *ImportJobCreate) SaveX(ctx context.Context) *ImportJob {
+	v, err := ijc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ijc *ImportJobCreate) Exec(ctx context.Context) error {
+	_, err := ijc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ijc *ImportJobCreate) ExecX(ctx context.Context) {
+	if err := ijc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ijc *ImportJobCreate) defaults() {
+	if _, ok := ijc.mutation.Status(); !ok {
+		v := importjob.DefaultStatus
+		ijc.mutation.SetStatus(v)
+	}
+	if _, ok := ijc.mutation.Total(); !ok {
+		v := importjob.DefaultTotal
+		ijc.mutation.SetTotal(v)
+	}
+	if _, ok := ijc.mutation.Succeeded(); !ok {
+		v := importjob.DefaultSucceeded
+		ijc.mutation.SetSucceeded(v)
+	}
+	if _, ok := ijc.mutation.Failed(); !ok {
+		v := importjob.DefaultFailed
+		ijc.mutation.SetFailed(v)
+	}
+	if _, ok := ijc.mutation.CreatedAt(); !ok {
+		v := importjob.DefaultCreatedAt()
+		ijc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ijc *ImportJobCreate) check() error {
+	if _, ok := ijc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ImportJob.user_id"`)}
+	}
+	if _, ok := ijc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportJob.status"`)}
+	}
+	if v, ok := ijc.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if _, ok := ijc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ImportJob.format"`)}
+	}
+	if v, ok := ijc.mutation.Format(); ok {
+		if err := importjob.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ImportJob.format": %w`, err)}
+		}
+	}
+	if _, ok := ijc.mutation.ObjectName(); !ok {
+		return &ValidationError{Name: "object_name", err: errors.New(`ent: missing required field "ImportJob.object_name"`)}
+	}
+	if _, ok := ijc.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "ImportJob.total"`)}
+	}
+	if _, ok := ijc.mutation.Succeeded(); !ok {
+		return &ValidationError{Name: "succeeded", err: errors.New(`ent: missing required field "ImportJob.succeeded"`)}
+	}
+	if _, ok := ijc.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "ImportJob.failed"`)}
+	}
+	if v, ok := ijc.mutation.Error(); ok {
+		if err := importjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ImportJob.error": %w`, err)}
+		}
+	}
+	if _, ok := ijc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ImportJob.created_at"`)}
+	}
+	return nil
+}
+
+func (ijc *ImportJobCreate) sqlSave(ctx context.Context) (*ImportJob, error) {
+	if err := ijc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ijc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ijc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	ijc.mutation.id = &_node.ID
+	ijc.mutation.done = true
+	return _node, nil
+}
+
+func (ijc *ImportJobCreate) createSpec() (*ImportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportJob{config: ijc.config}
+		_spec = sqlgraph.NewCreateSpec(importjob.Table, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64))
+	)
+	if id, ok := ijc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ijc.mutation.UserID(); ok {
+		_spec.SetField(importjob.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := ijc.mutation.ParentTargetID(); ok {
+		_spec.SetField(importjob.FieldParentTargetID, field.TypeUint64, value)
+		_node.ParentTargetID = value
+	}
+	if value, ok := ijc.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ijc.mutation.Format(); ok {
+		_spec.SetField(importjob.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := ijc.mutation.ObjectName(); ok {
+		_spec.SetField(importjob.FieldObjectName, field.TypeString, value)
+		_node.ObjectName = value
+	}
+	if value, ok := ijc.mutation.Total(); ok {
+		_spec.SetField(importjob.FieldTotal, field.TypeUint32, value)
+		_node.Total = value
+	}
+	if value, ok := ijc.mutation.Succeeded(); ok {
+		_spec.SetField(importjob.FieldSucceeded, field.TypeUint32, value)
+		_node.Succeeded = value
+	}
+	if value, ok := ijc.mutation.Failed(); ok {
+		_spec.SetField(importjob.FieldFailed, field.TypeUint32, value)
+		_node.Failed = value
+	}
+	if value, ok := ijc.mutation.Error(); ok {
+		_spec.SetField(importjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := ijc.mutation.CreatedAt(); ok {
+		_spec.SetField(importjob.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ijc.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeInt64, value)
+		_node.FinishedAt = value
+	}
+	if nodes := ijc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImportJobCreateBulk is the builder for creating many ImportJob entities in bulk.
+type ImportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ImportJobCreate
+}
+
+// Save creates the ImportJob entities in the database.
+func (ijcb *ImportJobCreateBulk) Save(ctx context.Context) ([]*ImportJob, error) {
+	if ijcb.err != nil {
+		return nil, ijcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ijcb.builders))
+	nodes := make([]*ImportJob, len(ijcb.builders))
+	mutators := make([]Mutator, len(ijcb.builders))
+	for i := range ijcb.builders {
+		func(i int, root context.Context) {
+			builder := ijcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ijcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ijcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ijcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ijcb *ImportJobCreateBulk) SaveX(ctx context.Context) []*ImportJob {
+	v, err := ijcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ijcb *ImportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := ijcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ijcb *ImportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := ijcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
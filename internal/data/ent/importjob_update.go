@@ -0,0 +1,894 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJobUpdate is the builder for updating ImportJob entities.
+type ImportJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImportJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (iju *ImportJobUpdate) Where(ps ...predicate.ImportJob) *ImportJobUpdate {
+	iju.mutation.Where(ps...)
+	return iju
+}
+
+// SetUserID sets the "user_id" field.
+func (iju *ImportJobUpdate) SetUserID(s string) *ImportJobUpdate {
+	iju.mutation.SetUserID(s)
+	return iju
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableUserID(s *string) *ImportJobUpdate {
+	if s != nil {
+		iju.SetUserID(*s)
+	}
+	return iju
+}
+
+// SetParentTargetID sets the "parent_target_id" field.
+func (iju *ImportJobUpdate) SetParentTargetID(u uint64) *ImportJobUpdate {
+	iju.mutation.ResetParentTargetID()
+	iju.mutation.SetParentTargetID(u)
+	return iju
+}
+
+// SetNillableParentTargetID sets the "parent_target_id" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableParentTargetID(u *uint64) *ImportJobUpdate {
+	if u != nil {
+		iju.SetParentTargetID(*u)
+	}
+	return iju
+}
+
+// AddParentTargetID adds u to the "parent_target_id" field.
+func (iju *ImportJobUpdate) AddParentTargetID(u int64) *ImportJobUpdate {
+	iju.mutation.AddParentTargetID(u)
+	return iju
+}
+
+// ClearParentTargetID clears the value of the "parent_target_id" field.
+func (iju *ImportJobUpdate) ClearParentTargetID() *ImportJobUpdate {
+	iju.mutation.ClearParentTargetID()
+	return iju
+}
+
+// SetStatus sets the "status" field.
+func (iju *ImportJobUpdate) SetStatus(i importjob.Status) *ImportJobUpdate {
+	iju.mutation.SetStatus(i)
+	return iju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableStatus(i *importjob.Status) *ImportJobUpdate {
+	if i != nil {
+		iju.SetStatus(*i)
+	}
+	return iju
+}
+
+// SetFormat sets the "format" field.
+func (iju *ImportJobUpdate) SetFormat(i importjob.Format) *ImportJobUpdate {
+	iju.mutation.SetFormat(i)
+	return iju
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableFormat(i *importjob.Format) *ImportJobUpdate {
+	if i != nil {
+		iju.SetFormat(*i)
+	}
+	return iju
+}
+
+// SetObjectName sets the "object_name" field.
+func (iju *ImportJobUpdate) SetObjectName(s string) *ImportJobUpdate {
+	iju.mutation.SetObjectName(s)
+	return iju
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableObjectName(s *string) *ImportJobUpdate {
+	if s != nil {
+		iju.SetObjectName(*s)
+	}
+	return iju
+}
+
+// SetTotal sets the "total" field.
+func (iju *ImportJobUpdate) SetTotal(u uint32) *ImportJobUpdate {
+	iju.mutation.ResetTotal()
+	iju.mutation.SetTotal(u)
+	return iju
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableTotal(u *uint32) *ImportJobUpdate {
+	if u != nil {
+		iju.SetTotal(*u)
+	}
+	return iju
+}
+
+// AddTotal adds u to the "total" field.
+func (iju *ImportJobUpdate) AddTotal(u int32) *ImportJobUpdate {
+	iju.mutation.AddTotal(u)
+	return iju
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (iju *ImportJobUpdate) SetSucceeded(u uint32) *ImportJobUpdate {
+	iju.mutation.ResetSucceeded()
+	iju.mutation.SetSucceeded(u)
+	return iju
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableSucceeded(u *uint32) *ImportJobUpdate {
+	if u != nil {
+		iju.SetSucceeded(*u)
+	}
+	return iju
+}
+
+// AddSucceeded adds u to the "succeeded" field.
+func (iju *ImportJobUpdate) AddSucceeded(u int32) *ImportJobUpdate {
+	iju.mutation.AddSucceeded(u)
+	return iju
+}
+
+// SetFailed sets the "failed" field.
+func (iju *ImportJobUpdate) SetFailed(u uint32) *ImportJobUpdate {
+	iju.mutation.ResetFailed()
+	iju.mutation.SetFailed(u)
+	return iju
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableFailed(u *uint32) *ImportJobUpdate {
+	if u != nil {
+		iju.SetFailed(*u)
+	}
+	return iju
+}
+
+// AddFailed adds u to the "failed" field.
+func (iju *ImportJobUpdate) AddFailed(u int32) *ImportJobUpdate {
+	iju.mutation.AddFailed(u)
+	return iju
+}
+
+// SetError sets the "error" field.
+func (iju *ImportJobUpdate) SetError(s string) *ImportJobUpdate {
+	iju.mutation.SetError(s)
+	return iju
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableError(s *string) *ImportJobUpdate {
+	if s != nil {
+		iju.SetError(*s)
+	}
+	return iju
+}
+
+// ClearError clears the value of the "error" field.
+func (iju *ImportJobUpdate) ClearError() *ImportJobUpdate {
+	iju.mutation.ClearError()
+	return iju
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (iju *ImportJobUpdate) SetFinishedAt(i int64) *ImportJobUpdate {
+	iju.mutation.ResetFinishedAt()
+	iju.mutation.SetFinishedAt(i)
+	return iju
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (iju *ImportJobUpdate) SetNillableFinishedAt(i *int64) *ImportJobUpdate {
+	if i != nil {
+		iju.SetFinishedAt(*i)
+	}
+	return iju
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (iju *ImportJobUpdate) AddFinishedAt(i int64) *ImportJobUpdate {
+	iju.mutation.AddFinishedAt(i)
+	return iju
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (iju *ImportJobUpdate) ClearFinishedAt() *ImportJobUpdate {
+	iju.mutation.ClearFinishedAt()
+	return iju
+}
+
+// AddItemIDs adds the "items" edge to the ImportItem entity by IDs.
+func (iju *ImportJobUpdate) AddItemIDs(ids ...uint64) *ImportJobUpdate {
+	iju.mutation.AddItemIDs(ids...)
+	return iju
+}
+
+// AddItems adds the "items" edges to the ImportItem entity.
+func (iju *ImportJobUpdate) AddItems(i ...*ImportItem) *ImportJobUpdate {
+	ids := make([]uint64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iju.AddItemIDs(ids...)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (iju *ImportJobUpdate) Mutation() *ImportJobMutation {
+	return iju.mutation
+}
+
+// ClearItems clears all "items" edges to the ImportItem entity.
+func (iju *ImportJobUpdate) ClearItems() *ImportJobUpdate {
+	iju.mutation.ClearItems()
+	return iju
+}
+
+// RemoveItemIDs removes the "items" edge to ImportItem entities by IDs.
+func (iju *ImportJobUpdate) RemoveItemIDs(ids ...uint64) *ImportJobUpdate {
+	iju.mutation.RemoveItemIDs(ids...)
+	return iju
+}
+
+// RemoveItems removes "items" edges to ImportItem entities.
+func (iju *ImportJobUpdate) RemoveItems(i ...*ImportItem) *ImportJobUpdate {
+	ids := make([]uint64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iju.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iju *ImportJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iju.sqlSave, iju.mutation, iju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iju *ImportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := iju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iju *ImportJobUpdate) Exec(ctx context.Context) error {
+	_, err := iju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iju *ImportJobUpdate) ExecX(ctx context.Context) {
+	if err := iju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iju *ImportJobUpdate) check() error {
+	if v, ok := iju.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if v, ok := iju.mutation.Format(); ok {
+		if err := importjob.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ImportJob.format": %w`, err)}
+		}
+	}
+	if v, ok := iju.mutation.Error(); ok {
+		if err := importjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ImportJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (iju *ImportJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportJobUpdate {
+	iju.modifiers = append(iju.modifiers, modifiers...)
+	return iju
+}
+
+func (iju *ImportJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64))
+	if ps := iju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iju.mutation.UserID(); ok {
+		_spec.SetField(importjob.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := iju.mutation.ParentTargetID(); ok {
+		_spec.SetField(importjob.FieldParentTargetID, field.TypeUint64, value)
+	}
+	if value, ok := iju.mutation.AddedParentTargetID(); ok {
+		_spec.AddField(importjob.FieldParentTargetID, field.TypeUint64, value)
+	}
+	if iju.mutation.ParentTargetIDCleared() {
+		_spec.ClearField(importjob.FieldParentTargetID, field.TypeUint64)
+	}
+	if value, ok := iju.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iju.mutation.Format(); ok {
+		_spec.SetField(importjob.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := iju.mutation.ObjectName(); ok {
+		_spec.SetField(importjob.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := iju.mutation.Total(); ok {
+		_spec.SetField(importjob.FieldTotal, field.TypeUint32, value)
+	}
+	if value, ok := iju.mutation.AddedTotal(); ok {
+		_spec.AddField(importjob.FieldTotal, field.TypeUint32, value)
+	}
+	if value, ok := iju.mutation.Succeeded(); ok {
+		_spec.SetField(importjob.FieldSucceeded, field.TypeUint32, value)
+	}
+	if value, ok := iju.mutation.AddedSucceeded(); ok {
+		_spec.AddField(importjob.FieldSucceeded, field.TypeUint32, value)
+	}
+	if value, ok := iju.mutation.Failed(); ok {
+		_spec.SetField(importjob.FieldFailed, field.TypeUint32, value)
+	}
+	if value, ok := iju.mutation.AddedFailed(); ok {
+		_spec.AddField(importjob.FieldFailed, field.TypeUint32, value)
+	}
+	if value, ok := iju.mutation.Error(); ok {
+		_spec.SetField(importjob.FieldError, field.TypeString, value)
+	}
+	if iju.mutation.ErrorCleared() {
+		_spec.ClearField(importjob.FieldError, field.TypeString)
+	}
+	if value, ok := iju.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := iju.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(importjob.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if iju.mutation.FinishedAtCleared() {
+		_spec.ClearField(importjob.FieldFinishedAt, field.TypeInt64)
+	}
+	if iju.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iju.mutation.RemovedItemsIDs(); len(nodes) > 0 && !iju.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iju.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(iju.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, iju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iju.mutation.done = true
+	return n, nil
+}
+
+// ImportJobUpdateOne is the builder for updating a single ImportJob entity.
+type ImportJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImportJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (ijuo *ImportJobUpdateOne) SetUserID(s string) *ImportJobUpdateOne {
+	ijuo.mutation.SetUserID(s)
+	return ijuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableUserID(s *string) *ImportJobUpdateOne {
+	if s != nil {
+		ijuo.SetUserID(*s)
+	}
+	return ijuo
+}
+
+// SetParentTargetID sets the "parent_target_id" field.
+func (ijuo *ImportJobUpdateOne) SetParentTargetID(u uint64) *ImportJobUpdateOne {
+	ijuo.mutation.ResetParentTargetID()
+	ijuo.mutation.SetParentTargetID(u)
+	return ijuo
+}
+
+// SetNillableParentTargetID sets the "parent_target_id" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableParentTargetID(u *uint64) *ImportJobUpdateOne {
+	if u != nil {
+		ijuo.SetParentTargetID(*u)
+	}
+	return ijuo
+}
+
+// AddParentTargetID adds u to the "parent_target_id" field.
+func (ijuo *ImportJobUpdateOne) AddParentTargetID(u int64) *ImportJobUpdateOne {
+	ijuo.mutation.AddParentTargetID(u)
+	return ijuo
+}
+
+// ClearParentTargetID clears the value of the "parent_target_id" field.
+func (ijuo *ImportJobUpdateOne) ClearParentTargetID() *ImportJobUpdateOne {
+	ijuo.mutation.ClearParentTargetID()
+	return ijuo
+}
+
+// SetStatus sets the "status" field.
+func (ijuo *ImportJobUpdateOne) SetStatus(i importjob.Status) *ImportJobUpdateOne {
+	ijuo.mutation.SetStatus(i)
+	return ijuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableStatus(i *importjob.Status) *ImportJobUpdateOne {
+	if i != nil {
+		ijuo.SetStatus(*i)
+	}
+	return ijuo
+}
+
+// SetFormat sets the "format" field.
+func (ijuo *ImportJobUpdateOne) SetFormat(i importjob.Format) *ImportJobUpdateOne {
+	ijuo.mutation.SetFormat(i)
+	return ijuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableFormat(i *importjob.Format) *ImportJobUpdateOne {
+	if i != nil {
+		ijuo.SetFormat(*i)
+	}
+	return ijuo
+}
+
+// SetObjectName sets the "object_name" field.
+func (ijuo *ImportJobUpdateOne) SetObjectName(s string) *ImportJobUpdateOne {
+	ijuo.mutation.SetObjectName(s)
+	return ijuo
+}
+
+// SetNillableObjectName sets the "object_name" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableObjectName(s *string) *ImportJobUpdateOne {
+	if s != nil {
+		ijuo.SetObjectName(*s)
+	}
+	return ijuo
+}
+
+// SetTotal sets the "total" field.
+func (ijuo *ImportJobUpdateOne) SetTotal(u uint32) *ImportJobUpdateOne {
+	ijuo.mutation.ResetTotal()
+	ijuo.mutation.SetTotal(u)
+	return ijuo
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableTotal(u *uint32) *ImportJobUpdateOne {
+	if u != nil {
+		ijuo.SetTotal(*u)
+	}
+	return ijuo
+}
+
+// AddTotal adds u to the "total" field.
+func (ijuo *ImportJobUpdateOne) AddTotal(u int32) *ImportJobUpdateOne {
+	ijuo.mutation.AddTotal(u)
+	return ijuo
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (ijuo *ImportJobUpdateOne) SetSucceeded(u uint32) *ImportJobUpdateOne {
+	ijuo.mutation.ResetSucceeded()
+	ijuo.mutation.SetSucceeded(u)
+	return ijuo
+}
+
+// SetNillableSucceeded sets the "succeeded" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableSucceeded(u *uint32) *ImportJobUpdateOne {
+	if u != nil {
+		ijuo.SetSucceeded(*u)
+	}
+	return ijuo
+}
+
+// AddSucceeded adds u to the "succeeded" field.
+func (ijuo *ImportJobUpdateOne) AddSucceeded(u int32) *ImportJobUpdateOne {
+	ijuo.mutation.AddSucceeded(u)
+	return ijuo
+}
+
+// SetFailed sets the "failed" field.
+func (ijuo *ImportJobUpdateOne) SetFailed(u uint32) *ImportJobUpdateOne {
+	ijuo.mutation.ResetFailed()
+	ijuo.mutation.SetFailed(u)
+	return ijuo
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableFailed(u *uint32) *ImportJobUpdateOne {
+	if u != nil {
+		ijuo.SetFailed(*u)
+	}
+	return ijuo
+}
+
+// AddFailed adds u to the "failed" field.
+func (ijuo *ImportJobUpdateOne) AddFailed(u int32) *ImportJobUpdateOne {
+	ijuo.mutation.AddFailed(u)
+	return ijuo
+}
+
+// SetError sets the "error" field.
+func (ijuo *ImportJobUpdateOne) SetError(s string) *ImportJobUpdateOne {
+	ijuo.mutation.SetError(s)
+	return ijuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableError(s *string) *ImportJobUpdateOne {
+	if s != nil {
+		ijuo.SetError(*s)
+	}
+	return ijuo
+}
+
+// ClearError clears the value of the "error" field.
+func (ijuo *ImportJobUpdateOne) ClearError() *ImportJobUpdateOne {
+	ijuo.mutation.ClearError()
+	return ijuo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ijuo *ImportJobUpdateOne) SetFinishedAt(i int64) *ImportJobUpdateOne {
+	ijuo.mutation.ResetFinishedAt()
+	ijuo.mutation.SetFinishedAt(i)
+	return ijuo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ijuo *ImportJobUpdateOne) SetNillableFinishedAt(i *int64) *ImportJobUpdateOne {
+	if i != nil {
+		ijuo.SetFinishedAt(*i)
+	}
+	return ijuo
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (ijuo *ImportJobUpdateOne) AddFinishedAt(i int64) *ImportJobUpdateOne {
+	ijuo.mutation.AddFinishedAt(i)
+	return ijuo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (ijuo *ImportJobUpdateOne) ClearFinishedAt() *ImportJobUpdateOne {
+	ijuo.mutation.ClearFinishedAt()
+	return ijuo
+}
+
+// AddItemIDs adds the "items" edge to the ImportItem entity by IDs.
+func (ijuo *ImportJobUpdateOne) AddItemIDs(ids ...uint64) *ImportJobUpdateOne {
+	ijuo.mutation.AddItemIDs(ids...)
+	return ijuo
+}
+
+// AddItems adds the "items" edges to the ImportItem entity.
+func (ijuo *ImportJobUpdateOne) AddItems(i ...*ImportItem) *ImportJobUpdateOne {
+	ids := make([]uint64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ijuo.AddItemIDs(ids...)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (ijuo *ImportJobUpdateOne) Mutation() *ImportJobMutation {
+	return ijuo.mutation
+}
+
+// ClearItems clears all "items" edges to the ImportItem entity.
+func (ijuo *ImportJobUpdateOne) ClearItems() *ImportJobUpdateOne {
+	ijuo.mutation.ClearItems()
+	return ijuo
+}
+
+// RemoveItemIDs removes the "items" edge to ImportItem entities by IDs.
+func (ijuo *ImportJobUpdateOne) RemoveItemIDs(ids ...uint64) *ImportJobUpdateOne {
+	ijuo.mutation.RemoveItemIDs(ids...)
+	return ijuo
+}
+
+// RemoveItems removes "items" edges to ImportItem entities.
+func (ijuo *ImportJobUpdateOne) RemoveItems(i ...*ImportItem) *ImportJobUpdateOne {
+	ids := make([]uint64, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ijuo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (ijuo *ImportJobUpdateOne) Where(ps ...predicate.ImportJob) *ImportJobUpdateOne {
+	ijuo.mutation.Where(ps...)
+	return ijuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ijuo *ImportJobUpdateOne) Select(field string, fields ...string) *ImportJobUpdateOne {
+	ijuo.fields = append([]string{field}, fields...)
+	return ijuo
+}
+
+// Save executes the query and returns the updated ImportJob entity.
+func (ijuo *ImportJobUpdateOne) Save(ctx context.Context) (*ImportJob, error) {
+	return withHooks(ctx, ijuo.sqlSave, ijuo.mutation, ijuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ijuo *ImportJobUpdateOne) SaveX(ctx context.Context) *ImportJob {
+	node, err := ijuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ijuo *ImportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := ijuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ijuo *ImportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := ijuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ijuo *ImportJobUpdateOne) check() error {
+	if v, ok := ijuo.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if v, ok := ijuo.mutation.Format(); ok {
+		if err := importjob.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ImportJob.format": %w`, err)}
+		}
+	}
+	if v, ok := ijuo.mutation.Error(); ok {
+		if err := importjob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ImportJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ijuo *ImportJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImportJobUpdateOne {
+	ijuo.modifiers = append(ijuo.modifiers, modifiers...)
+	return ijuo
+}
+
+func (ijuo *ImportJobUpdateOne) sqlSave(ctx context.Context) (_node *ImportJob, err error) {
+	if err := ijuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeUint64))
+	id, ok := ijuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ijuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importjob.FieldID)
+		for _, f := range fields {
+			if !importjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ijuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ijuo.mutation.UserID(); ok {
+		_spec.SetField(importjob.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ijuo.mutation.ParentTargetID(); ok {
+		_spec.SetField(importjob.FieldParentTargetID, field.TypeUint64, value)
+	}
+	if value, ok := ijuo.mutation.AddedParentTargetID(); ok {
+		_spec.AddField(importjob.FieldParentTargetID, field.TypeUint64, value)
+	}
+	if ijuo.mutation.ParentTargetIDCleared() {
+		_spec.ClearField(importjob.FieldParentTargetID, field.TypeUint64)
+	}
+	if value, ok := ijuo.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ijuo.mutation.Format(); ok {
+		_spec.SetField(importjob.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := ijuo.mutation.ObjectName(); ok {
+		_spec.SetField(importjob.FieldObjectName, field.TypeString, value)
+	}
+	if value, ok := ijuo.mutation.Total(); ok {
+		_spec.SetField(importjob.FieldTotal, field.TypeUint32, value)
+	}
+	if value, ok := ijuo.mutation.AddedTotal(); ok {
+		_spec.AddField(importjob.FieldTotal, field.TypeUint32, value)
+	}
+	if value, ok := ijuo.mutation.Succeeded(); ok {
+		_spec.SetField(importjob.FieldSucceeded, field.TypeUint32, value)
+	}
+	if value, ok := ijuo.mutation.AddedSucceeded(); ok {
+		_spec.AddField(importjob.FieldSucceeded, field.TypeUint32, value)
+	}
+	if value, ok := ijuo.mutation.Failed(); ok {
+		_spec.SetField(importjob.FieldFailed, field.TypeUint32, value)
+	}
+	if value, ok := ijuo.mutation.AddedFailed(); ok {
+		_spec.AddField(importjob.FieldFailed, field.TypeUint32, value)
+	}
+	if value, ok := ijuo.mutation.Error(); ok {
+		_spec.SetField(importjob.FieldError, field.TypeString, value)
+	}
+	if ijuo.mutation.ErrorCleared() {
+		_spec.ClearField(importjob.FieldError, field.TypeString)
+	}
+	if value, ok := ijuo.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := ijuo.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(importjob.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if ijuo.mutation.FinishedAtCleared() {
+		_spec.ClearField(importjob.FieldFinishedAt, field.TypeInt64)
+	}
+	if ijuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ijuo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !ijuo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ijuo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importjob.ItemsTable,
+			Columns: []string{importjob.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importitem.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ijuo.modifiers...)
+	_node = &ImportJob{config: ijuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ijuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ijuo.mutation.done = true
+	return _node, nil
+}
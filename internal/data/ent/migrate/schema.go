@@ -30,6 +30,128 @@ var (
 		Columns:    AwardsColumns,
 		PrimaryKey: []*schema.Column{AwardsColumns[0]},
 	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "step_id", Type: field.TypeUint64},
+		{Name: "author_id", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"teacher", "parent", "friend", "student"}},
+		{Name: "rating", Type: field.TypeJSON, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "parent_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "history", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_step_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[1]},
+			},
+			{
+				Name:    "comment_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[6]},
+			},
+		},
+	}
+	// ExportsColumns holds the columns for the "exports" table.
+	ExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeUint64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "object_name", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "targets", Type: field.TypeUint32, Default: 0},
+		{Name: "steps", Type: field.TypeUint32, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "finished_at", Type: field.TypeInt64, Nullable: true},
+	}
+	// ExportsTable holds the schema information for the "exports" table.
+	ExportsTable = &schema.Table{
+		Name:       "exports",
+		Columns:    ExportsColumns,
+		PrimaryKey: []*schema.Column{ExportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "export_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExportsColumns[1]},
+			},
+		},
+	}
+	// ImportItemsColumns holds the columns for the "import_items" table.
+	ImportItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "seq", Type: field.TypeUint32},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"target", "dir", "step"}},
+		{Name: "ref", Type: field.TypeString, Size: 1024},
+		{Name: "parent_ref", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "target_ref", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "item_time", Type: field.TypeInt64},
+		{Name: "data", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "created", "done", "failed"}, Default: "pending"},
+		{Name: "entity_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "job_id", Type: field.TypeUint64},
+	}
+	// ImportItemsTable holds the schema information for the "import_items" table.
+	ImportItemsTable = &schema.Table{
+		Name:       "import_items",
+		Columns:    ImportItemsColumns,
+		PrimaryKey: []*schema.Column{ImportItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_items_import_jobs_items",
+				Columns:    []*schema.Column{ImportItemsColumns[11]},
+				RefColumns: []*schema.Column{ImportJobsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importitem_job_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{ImportItemsColumns[11], ImportItemsColumns[1]},
+			},
+		},
+	}
+	// ImportJobsColumns holds the columns for the "import_jobs" table.
+	ImportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "parent_target_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"json", "csv"}},
+		{Name: "object_name", Type: field.TypeString},
+		{Name: "total", Type: field.TypeUint32, Default: 0},
+		{Name: "succeeded", Type: field.TypeUint32, Default: 0},
+		{Name: "failed", Type: field.TypeUint32, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "finished_at", Type: field.TypeInt64, Nullable: true},
+	}
+	// ImportJobsTable holds the schema information for the "import_jobs" table.
+	ImportJobsTable = &schema.Table{
+		Name:       "import_jobs",
+		Columns:    ImportJobsColumns,
+		PrimaryKey: []*schema.Column{ImportJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importjob_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ImportJobsColumns[1]},
+			},
+		},
+	}
 	// PortraitsColumns holds the columns for the "portraits" table.
 	PortraitsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -43,6 +165,131 @@ var (
 		Columns:    PortraitsColumns,
 		PrimaryKey: []*schema.Column{PortraitsColumns[0]},
 	}
+	// ReportsColumns holds the columns for the "reports" table.
+	ReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeUint64},
+		{Name: "start_date", Type: field.TypeString, Size: 10},
+		{Name: "end_date", Type: field.TypeString, Size: 10},
+		{Name: "stat_unit", Type: field.TypeEnum, Enums: []string{"day", "week", "month"}, Default: "week"},
+		{Name: "media_step_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "html_object_name", Type: field.TypeString, Nullable: true},
+		{Name: "pdf_object_name", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "finished_at", Type: field.TypeInt64, Nullable: true},
+	}
+	// ReportsTable holds the schema information for the "reports" table.
+	ReportsTable = &schema.Table{
+		Name:       "reports",
+		Columns:    ReportsColumns,
+		PrimaryKey: []*schema.Column{ReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "report_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReportsColumns[1]},
+			},
+		},
+	}
+	// ReviewRequestsColumns holds the columns for the "review_requests" table.
+	ReviewRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "step_id", Type: field.TypeUint64},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "reviewer_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "emails", Type: field.TypeJSON, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "share_to", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "commented", "expired"}, Default: "pending"},
+		{Name: "remind_at", Type: field.TypeInt64},
+		{Name: "reminded_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "expires_at", Type: field.TypeInt64},
+		{Name: "comment_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "commented_at", Type: field.TypeInt64, Nullable: true},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// ReviewRequestsTable holds the schema information for the "review_requests" table.
+	ReviewRequestsTable = &schema.Table{
+		Name:       "review_requests",
+		Columns:    ReviewRequestsColumns,
+		PrimaryKey: []*schema.Column{ReviewRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewrequest_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewRequestsColumns[1], ReviewRequestsColumns[14]},
+			},
+			{
+				Name:    "reviewrequest_step_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewRequestsColumns[2], ReviewRequestsColumns[8]},
+			},
+		},
+	}
+	// ReviewersColumns holds the columns for the "reviewers" table.
+	ReviewersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "student_id", Type: field.TypeString},
+		{Name: "reviewer_id", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"teacher", "parent"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active"}, Default: "pending"},
+		{Name: "invite_code", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "invite_expires_at", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "accepted_at", Type: field.TypeInt64, Nullable: true},
+	}
+	// ReviewersTable holds the schema information for the "reviewers" table.
+	ReviewersTable = &schema.Table{
+		Name:       "reviewers",
+		Columns:    ReviewersColumns,
+		PrimaryKey: []*schema.Column{ReviewersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reviewer_student_id_reviewer_id",
+				Unique:  true,
+				Columns: []*schema.Column{ReviewersColumns[1], ReviewersColumns[2]},
+			},
+			{
+				Name:    "reviewer_reviewer_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewersColumns[2]},
+			},
+		},
+	}
+	// SearchDocumentsColumns holds the columns for the "search_documents" table.
+	SearchDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"target", "step", "comment"}},
+		{Name: "target_id", Type: field.TypeUint64},
+		{Name: "step_id", Type: field.TypeUint64, Default: 0},
+		{Name: "comment_role", Type: field.TypeString, Default: ""},
+		{Name: "title", Type: field.TypeString, Default: ""},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// SearchDocumentsTable holds the schema information for the "search_documents" table.
+	SearchDocumentsTable = &schema.Table{
+		Name:       "search_documents",
+		Columns:    SearchDocumentsColumns,
+		PrimaryKey: []*schema.Column{SearchDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchdocument_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentsColumns[1]},
+			},
+			{
+				Name:    "searchdocument_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentsColumns[3]},
+			},
+		},
+	}
 	// ShowsColumns holds the columns for the "shows" table.
 	ShowsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -90,9 +337,21 @@ var (
 		{Name: "teacher_comment", Type: field.TypeJSON, Nullable: true},
 		{Name: "parent_comment", Type: field.TypeJSON, Nullable: true},
 		{Name: "friend_comment", Type: field.TypeJSON, Nullable: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"image", "video", "audio", "dir"}},
+		{Name: "teacher_reviewer_id", Type: field.TypeString, Nullable: true},
+		{Name: "parent_reviewer_id", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"image", "video", "audio", "dir", "measurement", "note", "link", "document"}},
 		{Name: "object_name", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeInt64, Default: 1759113438},
+		{Name: "measurement", Type: field.TypeFloat64, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "link_title", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "link_description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "page_count", Type: field.TypeUint32, Nullable: true},
+		{Name: "preview_object_name", Type: field.TypeString, Nullable: true},
+		{Name: "sort_order", Type: field.TypeFloat64, Default: 0},
+		{Name: "path", Type: field.TypeString, Default: ""},
 		{Name: "parent_id", Type: field.TypeUint64, Nullable: true},
 		{Name: "ref_target_id", Type: field.TypeUint64, Nullable: true},
 	}
@@ -104,17 +363,24 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "steps_steps_children",
-				Columns:    []*schema.Column{StepsColumns[10]},
+				Columns:    []*schema.Column{StepsColumns[22]},
 				RefColumns: []*schema.Column{StepsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "steps_targets_steps",
-				Columns:    []*schema.Column{StepsColumns[11]},
+				Columns:    []*schema.Column{StepsColumns[23]},
 				RefColumns: []*schema.Column{TargetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "step_path",
+				Unique:  false,
+				Columns: []*schema.Column{StepsColumns[21]},
+			},
+		},
 	}
 	// StepRatesColumns holds the columns for the "step_rates" table.
 	StepRatesColumns = []*schema.Column{
@@ -132,6 +398,9 @@ var (
 		{Name: "basic_reliability", Type: field.TypeInt32},
 		{Name: "skill_improvement", Type: field.TypeInt32},
 		{Name: "difficulty", Type: field.TypeInt32},
+		{Name: "self_scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "measurement", Type: field.TypeFloat64, Default: 0},
+		{Name: "create_counted", Type: field.TypeBool, Default: true},
 		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "date", "postgres": "date", "sqlite3": "date"}},
 	}
 	// StepRatesTable holds the schema information for the "step_rates" table.
@@ -140,6 +409,56 @@ var (
 		Columns:    StepRatesColumns,
 		PrimaryKey: []*schema.Column{StepRatesColumns[0]},
 	}
+	// StepRateCriterionsColumns holds the columns for the "step_rate_criterions" table.
+	StepRateCriterionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "top_target_id", Type: field.TypeUint64},
+		{Name: "target_id", Type: field.TypeUint64},
+		{Name: "step_id", Type: field.TypeUint64},
+		{Name: "key", Type: field.TypeString},
+		{Name: "value", Type: field.TypeFloat64},
+		{Name: "date", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "date", "postgres": "date", "sqlite3": "date"}},
+	}
+	// StepRateCriterionsTable holds the schema information for the "step_rate_criterions" table.
+	StepRateCriterionsTable = &schema.Table{
+		Name:       "step_rate_criterions",
+		Columns:    StepRateCriterionsColumns,
+		PrimaryKey: []*schema.Column{StepRateCriterionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stepratecriterion_step_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{StepRateCriterionsColumns[4], StepRateCriterionsColumns[5]},
+			},
+			{
+				Name:    "stepratecriterion_top_target_id_key",
+				Unique:  false,
+				Columns: []*schema.Column{StepRateCriterionsColumns[2], StepRateCriterionsColumns[5]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Size: 30},
+		{Name: "color", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "created_at", Type: field.TypeInt64},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[1], TagsColumns[2]},
+			},
+		},
+	}
 	// TargetsColumns holds the columns for the "targets" table.
 	TargetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint64, Increment: true},
@@ -153,6 +472,15 @@ var (
 		{Name: "done_at", Type: field.TypeInt64, Nullable: true},
 		{Name: "layer", Type: field.TypeUint32, Default: 0},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"init", "step", "step_hard", "done"}, Default: "init"},
+		{Name: "schedule_type", Type: field.TypeEnum, Enums: []string{"none", "daily", "weekdays", "times_per_week"}, Default: "none"},
+		{Name: "schedule_weekdays", Type: field.TypeJSON, Nullable: true},
+		{Name: "schedule_times", Type: field.TypeUint32, Nullable: true},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"check_in", "quantity"}, Default: "check_in"},
+		{Name: "unit", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "goal_value", Type: field.TypeFloat64, Nullable: true},
+		{Name: "sort_order", Type: field.TypeFloat64, Default: 0},
+		{Name: "path", Type: field.TypeString, Default: ""},
+		{Name: "rubric", Type: field.TypeJSON, Nullable: true},
 		{Name: "parent_id", Type: field.TypeUint64, Nullable: true},
 	}
 	// TargetsTable holds the schema information for the "targets" table.
@@ -163,27 +491,128 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "targets_targets_children",
-				Columns:    []*schema.Column{TargetsColumns[11]},
+				Columns:    []*schema.Column{TargetsColumns[20]},
 				RefColumns: []*schema.Column{TargetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "target_path",
+				Unique:  false,
+				Columns: []*schema.Column{TargetsColumns[18]},
+			},
+		},
+	}
+	// TemplatesColumns holds the columns for the "templates" table.
+	TemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint64, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString, Size: 50},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "link"}, Default: "private"},
+		{Name: "share_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "content", Type: field.TypeJSON},
+		{Name: "source_target_id", Type: field.TypeUint64, Nullable: true},
+		{Name: "created_at", Type: field.TypeInt64},
+		{Name: "updated_at", Type: field.TypeInt64},
+	}
+	// TemplatesTable holds the schema information for the "templates" table.
+	TemplatesTable = &schema.Table{
+		Name:       "templates",
+		Columns:    TemplatesColumns,
+		PrimaryKey: []*schema.Column{TemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "template_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TemplatesColumns[1]},
+			},
+		},
+	}
+	// TagTargetsColumns holds the columns for the "tag_targets" table.
+	TagTargetsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUint64},
+		{Name: "target_id", Type: field.TypeUint64},
+	}
+	// TagTargetsTable holds the schema information for the "tag_targets" table.
+	TagTargetsTable = &schema.Table{
+		Name:       "tag_targets",
+		Columns:    TagTargetsColumns,
+		PrimaryKey: []*schema.Column{TagTargetsColumns[0], TagTargetsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_targets_tag_id",
+				Columns:    []*schema.Column{TagTargetsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_targets_target_id",
+				Columns:    []*schema.Column{TagTargetsColumns[1]},
+				RefColumns: []*schema.Column{TargetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagStepsColumns holds the columns for the "tag_steps" table.
+	TagStepsColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUint64},
+		{Name: "step_id", Type: field.TypeUint64},
+	}
+	// TagStepsTable holds the schema information for the "tag_steps" table.
+	TagStepsTable = &schema.Table{
+		Name:       "tag_steps",
+		Columns:    TagStepsColumns,
+		PrimaryKey: []*schema.Column{TagStepsColumns[0], TagStepsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_steps_tag_id",
+				Columns:    []*schema.Column{TagStepsColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_steps_step_id",
+				Columns:    []*schema.Column{TagStepsColumns[1]},
+				RefColumns: []*schema.Column{StepsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AwardsTable,
+		CommentsTable,
+		ExportsTable,
+		ImportItemsTable,
+		ImportJobsTable,
 		PortraitsTable,
+		ReportsTable,
+		ReviewRequestsTable,
+		ReviewersTable,
+		SearchDocumentsTable,
 		ShowsTable,
 		ShowReservesTable,
 		StepsTable,
 		StepRatesTable,
+		StepRateCriterionsTable,
+		TagsTable,
 		TargetsTable,
+		TemplatesTable,
+		TagTargetsTable,
+		TagStepsTable,
 	}
 )
 
 func init() {
+	ImportItemsTable.ForeignKeys[0].RefTable = ImportJobsTable
 	ShowReservesTable.ForeignKeys[0].RefTable = ShowsTable
 	StepsTable.ForeignKeys[0].RefTable = StepsTable
 	StepsTable.ForeignKeys[1].RefTable = TargetsTable
 	TargetsTable.ForeignKeys[0].RefTable = TargetsTable
+	TagTargetsTable.ForeignKeys[0].RefTable = TagsTable
+	TagTargetsTable.ForeignKeys[1].RefTable = TargetsTable
+	TagStepsTable.ForeignKeys[0].RefTable = TagsTable
+	TagStepsTable.ForeignKeys[1].RefTable = StepsTable
 }
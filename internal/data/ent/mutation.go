@@ -7,13 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"step/internal/data/ent/award"
+	"step/internal/data/ent/comment"
+	"step/internal/data/ent/export"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
 	"step/internal/data/ent/portrait"
 	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/report"
+	"step/internal/data/ent/reviewer"
+	"step/internal/data/ent/reviewrequest"
+	"step/internal/data/ent/searchdocument"
 	"step/internal/data/ent/show"
 	"step/internal/data/ent/showreserve"
 	"step/internal/data/ent/step"
 	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
+	"step/internal/data/ent/template"
+	"step/internal/objects"
 	"sync"
 	"time"
 
@@ -30,13 +42,24 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAward       = "Award"
-	TypePortrait    = "Portrait"
-	TypeShow        = "Show"
-	TypeShowReserve = "ShowReserve"
-	TypeStep        = "Step"
-	TypeStepRate    = "StepRate"
-	TypeTarget      = "Target"
+	TypeAward             = "Award"
+	TypeComment           = "Comment"
+	TypeExport            = "Export"
+	TypeImportItem        = "ImportItem"
+	TypeImportJob         = "ImportJob"
+	TypePortrait          = "Portrait"
+	TypeReport            = "Report"
+	TypeReviewRequest     = "ReviewRequest"
+	TypeReviewer          = "Reviewer"
+	TypeSearchDocument    = "SearchDocument"
+	TypeShow              = "Show"
+	TypeShowReserve       = "ShowReserve"
+	TypeStep              = "Step"
+	TypeStepRate          = "StepRate"
+	TypeStepRateCriterion = "StepRateCriterion"
+	TypeTag               = "Tag"
+	TypeTarget            = "Target"
+	TypeTemplate          = "Template"
 )
 
 // AwardMutation represents an operation that mutates the Award nodes in the graph.
@@ -1215,32 +1238,43 @@ func (m *AwardMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Award edge %s", name)
 }
 
-// PortraitMutation represents an operation that mutates the Portrait nodes in the graph.
-type PortraitMutation struct {
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uint64
-	user_id       *string
-	dimension     *portrait.Dimension
-	value         *map[string]interface{}
+	step_id       *uint64
+	addstep_id    *int64
+	author_id     *string
+	role          *comment.Role
+	rating        *map[string]interface{}
+	content       *string
+	parent_id     *uint64
+	addparent_id  *int64
+	history       *[]objects.CommentRevision
+	appendhistory []objects.CommentRevision
+	created_at    *int64
+	addcreated_at *int64
+	updated_at    *int64
+	addupdated_at *int64
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Portrait, error)
-	predicates    []predicate.Portrait
+	oldValue      func(context.Context) (*Comment, error)
+	predicates    []predicate.Comment
 }
 
-var _ ent.Mutation = (*PortraitMutation)(nil)
+var _ ent.Mutation = (*CommentMutation)(nil)
 
-// portraitOption allows management of the mutation configuration using functional options.
-type portraitOption func(*PortraitMutation)
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
 
-// newPortraitMutation creates new mutation for the Portrait entity.
-func newPortraitMutation(c config, op Op, opts ...portraitOption) *PortraitMutation {
-	m := &PortraitMutation{
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePortrait,
+		typ:           TypeComment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1249,20 +1283,20 @@ func newPortraitMutation(c config, op Op, opts ...portraitOption) *PortraitMutat
 	return m
 }
 
-// withPortraitID sets the ID field of the mutation.
-func withPortraitID(id uint64) portraitOption {
-	return func(m *PortraitMutation) {
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id uint64) commentOption {
+	return func(m *CommentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Portrait
+			value *Comment
 		)
-		m.oldValue = func(ctx context.Context) (*Portrait, error) {
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Portrait.Get(ctx, id)
+					value, err = m.Client().Comment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1271,10 +1305,10 @@ func withPortraitID(id uint64) portraitOption {
 	}
 }
 
-// withPortrait sets the old Portrait of the mutation.
-func withPortrait(node *Portrait) portraitOption {
-	return func(m *PortraitMutation) {
-		m.oldValue = func(context.Context) (*Portrait, error) {
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1283,7 +1317,7 @@ func withPortrait(node *Portrait) portraitOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PortraitMutation) Client() *Client {
+func (m CommentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1291,7 +1325,7 @@ func (m PortraitMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PortraitMutation) Tx() (*Tx, error) {
+func (m CommentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1301,14 +1335,14 @@ func (m PortraitMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Portrait entities.
-func (m *PortraitMutation) SetID(id uint64) {
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PortraitMutation) ID() (id uint64, exists bool) {
+func (m *CommentMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1319,7 +1353,7 @@ func (m *PortraitMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PortraitMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *CommentMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1328,1337 +1362,1431 @@ func (m *PortraitMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Portrait.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PortraitMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetStepID sets the "step_id" field.
+func (m *CommentMutation) SetStepID(u uint64) {
+	m.step_id = &u
+	m.addstep_id = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PortraitMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// StepID returns the value of the "step_id" field in the mutation.
+func (m *CommentMutation) StepID() (r uint64, exists bool) {
+	v := m.step_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Portrait entity.
-// If the Portrait object wasn't provided to the builder, the object is fetched from the database.
+// OldStepID returns the old "step_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortraitMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldStepID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldStepID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.StepID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PortraitMutation) ResetUserID() {
-	m.user_id = nil
+// AddStepID adds u to the "step_id" field.
+func (m *CommentMutation) AddStepID(u int64) {
+	if m.addstep_id != nil {
+		*m.addstep_id += u
+	} else {
+		m.addstep_id = &u
+	}
 }
 
-// SetDimension sets the "dimension" field.
-func (m *PortraitMutation) SetDimension(po portrait.Dimension) {
-	m.dimension = &po
+// AddedStepID returns the value that was added to the "step_id" field in this mutation.
+func (m *CommentMutation) AddedStepID() (r int64, exists bool) {
+	v := m.addstep_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Dimension returns the value of the "dimension" field in the mutation.
-func (m *PortraitMutation) Dimension() (r portrait.Dimension, exists bool) {
-	v := m.dimension
+// ResetStepID resets all changes to the "step_id" field.
+func (m *CommentMutation) ResetStepID() {
+	m.step_id = nil
+	m.addstep_id = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *CommentMutation) SetAuthorID(s string) {
+	m.author_id = &s
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *CommentMutation) AuthorID() (r string, exists bool) {
+	v := m.author_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDimension returns the old "dimension" field's value of the Portrait entity.
-// If the Portrait object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthorID returns the old "author_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortraitMutation) OldDimension(ctx context.Context) (v portrait.Dimension, err error) {
+func (m *CommentMutation) OldAuthorID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDimension is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDimension requires an ID field in the mutation")
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDimension: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
 	}
-	return oldValue.Dimension, nil
+	return oldValue.AuthorID, nil
 }
 
-// ResetDimension resets all changes to the "dimension" field.
-func (m *PortraitMutation) ResetDimension() {
-	m.dimension = nil
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *CommentMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[comment.FieldAuthorID] = struct{}{}
 }
 
-// SetValue sets the "value" field.
-func (m *PortraitMutation) SetValue(value map[string]interface{}) {
-	m.value = &value
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *CommentMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldAuthorID]
+	return ok
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *PortraitMutation) Value() (r map[string]interface{}, exists bool) {
-	v := m.value
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *CommentMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, comment.FieldAuthorID)
+}
+
+// SetRole sets the "role" field.
+func (m *CommentMutation) SetRole(c comment.Role) {
+	m.role = &c
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *CommentMutation) Role() (r comment.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Portrait entity.
-// If the Portrait object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortraitMutation) OldValue(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *CommentMutation) OldRole(ctx context.Context) (v comment.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Role, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *PortraitMutation) ResetValue() {
-	m.value = nil
+// ResetRole resets all changes to the "role" field.
+func (m *CommentMutation) ResetRole() {
+	m.role = nil
 }
 
-// Where appends a list predicates to the PortraitMutation builder.
-func (m *PortraitMutation) Where(ps ...predicate.Portrait) {
-	m.predicates = append(m.predicates, ps...)
+// SetRating sets the "rating" field.
+func (m *CommentMutation) SetRating(value map[string]interface{}) {
+	m.rating = &value
 }
 
-// WhereP appends storage-level predicates to the PortraitMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PortraitMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Portrait, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Rating returns the value of the "rating" field in the mutation.
+func (m *CommentMutation) Rating() (r map[string]interface{}, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PortraitMutation) Op() Op {
-	return m.op
+// OldRating returns the old "rating" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldRating(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PortraitMutation) SetOp(op Op) {
-	m.op = op
+// ClearRating clears the value of the "rating" field.
+func (m *CommentMutation) ClearRating() {
+	m.rating = nil
+	m.clearedFields[comment.FieldRating] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Portrait).
-func (m *PortraitMutation) Type() string {
-	return m.typ
+// RatingCleared returns if the "rating" field was cleared in this mutation.
+func (m *CommentMutation) RatingCleared() bool {
+	_, ok := m.clearedFields[comment.FieldRating]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PortraitMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.user_id != nil {
-		fields = append(fields, portrait.FieldUserID)
-	}
-	if m.dimension != nil {
-		fields = append(fields, portrait.FieldDimension)
-	}
-	if m.value != nil {
-		fields = append(fields, portrait.FieldValue)
-	}
-	return fields
+// ResetRating resets all changes to the "rating" field.
+func (m *CommentMutation) ResetRating() {
+	m.rating = nil
+	delete(m.clearedFields, comment.FieldRating)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PortraitMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case portrait.FieldUserID:
-		return m.UserID()
-	case portrait.FieldDimension:
-		return m.Dimension()
-	case portrait.FieldValue:
-		return m.Value()
-	}
-	return nil, false
+// SetContent sets the "content" field.
+func (m *CommentMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PortraitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case portrait.FieldUserID:
-		return m.OldUserID(ctx)
-	case portrait.FieldDimension:
-		return m.OldDimension(ctx)
-	case portrait.FieldValue:
-		return m.OldValue(ctx)
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Portrait field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PortraitMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case portrait.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case portrait.FieldDimension:
-		v, ok := value.(portrait.Dimension)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDimension(v)
-		return nil
-	case portrait.FieldValue:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValue(v)
-		return nil
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Portrait field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PortraitMutation) AddedFields() []string {
-	return nil
+// ClearContent clears the value of the "content" field.
+func (m *CommentMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[comment.FieldContent] = struct{}{}
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PortraitMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *CommentMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[comment.FieldContent]
+	return ok
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PortraitMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Portrait numeric field %s", name)
+// ResetContent resets all changes to the "content" field.
+func (m *CommentMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, comment.FieldContent)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PortraitMutation) ClearedFields() []string {
-	return nil
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(u uint64) {
+	m.parent_id = &u
+	m.addparent_id = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PortraitMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r uint64, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PortraitMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Portrait nullable field %s", name)
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PortraitMutation) ResetField(name string) error {
-	switch name {
-	case portrait.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case portrait.FieldDimension:
-		m.ResetDimension()
-		return nil
-	case portrait.FieldValue:
-		m.ResetValue()
-		return nil
+// AddParentID adds u to the "parent_id" field.
+func (m *CommentMutation) AddParentID(u int64) {
+	if m.addparent_id != nil {
+		*m.addparent_id += u
+	} else {
+		m.addparent_id = &u
 	}
-	return fmt.Errorf("unknown Portrait field %s", name)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PortraitMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
+func (m *CommentMutation) AddedParentID() (r int64, exists bool) {
+	v := m.addparent_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PortraitMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+	m.clearedFields[comment.FieldParentID] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PortraitMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PortraitMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+	delete(m.clearedFields, comment.FieldParentID)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PortraitMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetHistory sets the "history" field.
+func (m *CommentMutation) SetHistory(or []objects.CommentRevision) {
+	m.history = &or
+	m.appendhistory = nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PortraitMutation) EdgeCleared(name string) bool {
-	return false
+// History returns the value of the "history" field in the mutation.
+func (m *CommentMutation) History() (r []objects.CommentRevision, exists bool) {
+	v := m.history
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PortraitMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Portrait unique edge %s", name)
+// OldHistory returns the old "history" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldHistory(ctx context.Context) (v []objects.CommentRevision, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistory: %w", err)
+	}
+	return oldValue.History, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PortraitMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Portrait edge %s", name)
+// AppendHistory adds or to the "history" field.
+func (m *CommentMutation) AppendHistory(or []objects.CommentRevision) {
+	m.appendhistory = append(m.appendhistory, or...)
 }
 
-// ShowMutation represents an operation that mutates the Show nodes in the graph.
-type ShowMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *uint64
-	_type                *string
-	poster               *string
-	content              *string
-	media_files          *[]string
-	appendmedia_files    []string
-	created_at           *int64
-	addcreated_at        *int64
-	clearedFields        map[string]struct{}
-	show_reserves        map[uint64]struct{}
-	removedshow_reserves map[uint64]struct{}
-	clearedshow_reserves bool
-	done                 bool
-	oldValue             func(context.Context) (*Show, error)
-	predicates           []predicate.Show
+// AppendedHistory returns the list of values that were appended to the "history" field in this mutation.
+func (m *CommentMutation) AppendedHistory() ([]objects.CommentRevision, bool) {
+	if len(m.appendhistory) == 0 {
+		return nil, false
+	}
+	return m.appendhistory, true
 }
 
-var _ ent.Mutation = (*ShowMutation)(nil)
-
-// showOption allows management of the mutation configuration using functional options.
-type showOption func(*ShowMutation)
+// ClearHistory clears the value of the "history" field.
+func (m *CommentMutation) ClearHistory() {
+	m.history = nil
+	m.appendhistory = nil
+	m.clearedFields[comment.FieldHistory] = struct{}{}
+}
 
-// newShowMutation creates new mutation for the Show entity.
-func newShowMutation(c config, op Op, opts ...showOption) *ShowMutation {
-	m := &ShowMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeShow,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// HistoryCleared returns if the "history" field was cleared in this mutation.
+func (m *CommentMutation) HistoryCleared() bool {
+	_, ok := m.clearedFields[comment.FieldHistory]
+	return ok
 }
 
-// withShowID sets the ID field of the mutation.
-func withShowID(id uint64) showOption {
-	return func(m *ShowMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Show
-		)
-		m.oldValue = func(ctx context.Context) (*Show, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Show.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetHistory resets all changes to the "history" field.
+func (m *CommentMutation) ResetHistory() {
+	m.history = nil
+	m.appendhistory = nil
+	delete(m.clearedFields, comment.FieldHistory)
 }
 
-// withShow sets the old Show of the mutation.
-func withShow(node *Show) showOption {
-	return func(m *ShowMutation) {
-		m.oldValue = func(context.Context) (*Show, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ShowMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ShowMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Show entities.
-func (m *ShowMutation) SetID(id uint64) {
-	m.id = &id
+// AddCreatedAt adds i to the "created_at" field.
+func (m *CommentMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ShowMutation) ID() (id uint64, exists bool) {
-	if m.id == nil {
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *CommentMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ShowMutation) IDs(ctx context.Context) ([]uint64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Show.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
 }
 
-// SetType sets the "type" field.
-func (m *ShowMutation) SetType(s string) {
-	m._type = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ShowMutation) GetType() (r string, exists bool) {
-	v := m._type
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Show entity.
-// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ShowMutation) ResetType() {
-	m._type = nil
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *CommentMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
+	}
 }
 
-// SetPoster sets the "poster" field.
-func (m *ShowMutation) SetPoster(s string) {
-	m.poster = &s
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *CommentMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Poster returns the value of the "poster" field in the mutation.
-func (m *ShowMutation) Poster() (r string, exists bool) {
-	v := m.poster
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
 }
 
-// OldPoster returns the old "poster" field's value of the Show entity.
-// If the Show object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowMutation) OldPoster(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPoster is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPoster requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPoster: %w", err)
-	}
-	return oldValue.Poster, nil
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearPoster clears the value of the "poster" field.
-func (m *ShowMutation) ClearPoster() {
-	m.poster = nil
-	m.clearedFields[show.FieldPoster] = struct{}{}
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// PosterCleared returns if the "poster" field was cleared in this mutation.
-func (m *ShowMutation) PosterCleared() bool {
-	_, ok := m.clearedFields[show.FieldPoster]
-	return ok
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
 }
 
-// ResetPoster resets all changes to the "poster" field.
-func (m *ShowMutation) ResetPoster() {
-	m.poster = nil
-	delete(m.clearedFields, show.FieldPoster)
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetContent sets the "content" field.
-func (m *ShowMutation) SetContent(s string) {
-	m.content = &s
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ShowMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.step_id != nil {
+		fields = append(fields, comment.FieldStepID)
 	}
-	return *v, true
-}
-
-// OldContent returns the old "content" field's value of the Show entity.
-// If the Show object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	if m.author_id != nil {
+		fields = append(fields, comment.FieldAuthorID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+	if m.role != nil {
+		fields = append(fields, comment.FieldRole)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	if m.rating != nil {
+		fields = append(fields, comment.FieldRating)
 	}
-	return oldValue.Content, nil
+	if m.content != nil {
+		fields = append(fields, comment.FieldContent)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.history != nil {
+		fields = append(fields, comment.FieldHistory)
+	}
+	if m.created_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ShowMutation) ResetContent() {
-	m.content = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldStepID:
+		return m.StepID()
+	case comment.FieldAuthorID:
+		return m.AuthorID()
+	case comment.FieldRole:
+		return m.Role()
+	case comment.FieldRating:
+		return m.Rating()
+	case comment.FieldContent:
+		return m.Content()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldHistory:
+		return m.History()
+	case comment.FieldCreatedAt:
+		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SetMediaFiles sets the "media_files" field.
-func (m *ShowMutation) SetMediaFiles(s []string) {
-	m.media_files = &s
-	m.appendmedia_files = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldStepID:
+		return m.OldStepID(ctx)
+	case comment.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case comment.FieldRole:
+		return m.OldRole(ctx)
+	case comment.FieldRating:
+		return m.OldRating(ctx)
+	case comment.FieldContent:
+		return m.OldContent(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldHistory:
+		return m.OldHistory(ctx)
+	case comment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
 }
 
-// MediaFiles returns the value of the "media_files" field in the mutation.
-func (m *ShowMutation) MediaFiles() (r []string, exists bool) {
-	v := m.media_files
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldStepID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepID(v)
+		return nil
+	case comment.FieldAuthorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case comment.FieldRole:
+		v, ok := value.(comment.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case comment.FieldRating:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case comment.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case comment.FieldHistory:
+		v, ok := value.([]objects.CommentRevision)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHistory(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
-// OldMediaFiles returns the old "media_files" field's value of the Show entity.
-// If the Show object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowMutation) OldMediaFiles(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMediaFiles is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	var fields []string
+	if m.addstep_id != nil {
+		fields = append(fields, comment.FieldStepID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMediaFiles requires an ID field in the mutation")
+	if m.addparent_id != nil {
+		fields = append(fields, comment.FieldParentID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMediaFiles: %w", err)
+	if m.addcreated_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
 	}
-	return oldValue.MediaFiles, nil
+	if m.addupdated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// AppendMediaFiles adds s to the "media_files" field.
-func (m *ShowMutation) AppendMediaFiles(s []string) {
-	m.appendmedia_files = append(m.appendmedia_files, s...)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldStepID:
+		return m.AddedStepID()
+	case comment.FieldParentID:
+		return m.AddedParentID()
+	case comment.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
+	}
+	return nil, false
 }
 
-// AppendedMediaFiles returns the list of values that were appended to the "media_files" field in this mutation.
-func (m *ShowMutation) AppendedMediaFiles() ([]string, bool) {
-	if len(m.appendmedia_files) == 0 {
-		return nil, false
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldStepID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepID(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentID(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedAt(v)
+		return nil
 	}
-	return m.appendmedia_files, true
+	return fmt.Errorf("unknown Comment numeric field %s", name)
 }
 
-// ClearMediaFiles clears the value of the "media_files" field.
-func (m *ShowMutation) ClearMediaFiles() {
-	m.media_files = nil
-	m.appendmedia_files = nil
-	m.clearedFields[show.FieldMediaFiles] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldAuthorID) {
+		fields = append(fields, comment.FieldAuthorID)
+	}
+	if m.FieldCleared(comment.FieldRating) {
+		fields = append(fields, comment.FieldRating)
+	}
+	if m.FieldCleared(comment.FieldContent) {
+		fields = append(fields, comment.FieldContent)
+	}
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.FieldCleared(comment.FieldHistory) {
+		fields = append(fields, comment.FieldHistory)
+	}
+	return fields
 }
 
-// MediaFilesCleared returns if the "media_files" field was cleared in this mutation.
-func (m *ShowMutation) MediaFilesCleared() bool {
-	_, ok := m.clearedFields[show.FieldMediaFiles]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetMediaFiles resets all changes to the "media_files" field.
-func (m *ShowMutation) ResetMediaFiles() {
-	m.media_files = nil
-	m.appendmedia_files = nil
-	delete(m.clearedFields, show.FieldMediaFiles)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *ShowMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ShowMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case comment.FieldRating:
+		m.ClearRating()
+		return nil
+	case comment.FieldContent:
+		m.ClearContent()
+		return nil
+	case comment.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case comment.FieldHistory:
+		m.ClearHistory()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Comment nullable field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Show entity.
-// If the Show object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldStepID:
+		m.ResetStepID()
+		return nil
+	case comment.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case comment.FieldRole:
+		m.ResetRole()
+		return nil
+	case comment.FieldRating:
+		m.ResetRating()
+		return nil
+	case comment.FieldContent:
+		m.ResetContent()
+		return nil
+	case comment.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case comment.FieldHistory:
+		m.ResetHistory()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown Comment field %s", name)
 }
 
-// AddCreatedAt adds i to the "created_at" field.
-func (m *ShowMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
-	} else {
-		m.addcreated_at = &i
-	}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *ShowMutation) AddedCreatedAt() (r int64, exists bool) {
-	v := m.addcreated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ShowMutation) ResetCreatedAt() {
-	m.created_at = nil
-	m.addcreated_at = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddShowReserfIDs adds the "show_reserves" edge to the ShowReserve entity by ids.
-func (m *ShowMutation) AddShowReserfIDs(ids ...uint64) {
-	if m.show_reserves == nil {
-		m.show_reserves = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.show_reserves[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearShowReserves clears the "show_reserves" edge to the ShowReserve entity.
-func (m *ShowMutation) ClearShowReserves() {
-	m.clearedshow_reserves = true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ShowReservesCleared reports if the "show_reserves" edge to the ShowReserve entity was cleared.
-func (m *ShowMutation) ShowReservesCleared() bool {
-	return m.clearedshow_reserves
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// RemoveShowReserfIDs removes the "show_reserves" edge to the ShowReserve entity by IDs.
-func (m *ShowMutation) RemoveShowReserfIDs(ids ...uint64) {
-	if m.removedshow_reserves == nil {
-		m.removedshow_reserves = make(map[uint64]struct{})
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// ExportMutation represents an operation that mutates the Export nodes in the graph.
+type ExportMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint64
+	user_id        *string
+	target_id      *uint64
+	addtarget_id   *int64
+	status         *export.Status
+	object_name    *string
+	size           *int64
+	addsize        *int64
+	targets        *uint32
+	addtargets     *int32
+	steps          *uint32
+	addsteps       *int32
+	error          *string
+	created_at     *int64
+	addcreated_at  *int64
+	finished_at    *int64
+	addfinished_at *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Export, error)
+	predicates     []predicate.Export
+}
+
+var _ ent.Mutation = (*ExportMutation)(nil)
+
+// exportOption allows management of the mutation configuration using functional options.
+type exportOption func(*ExportMutation)
+
+// newExportMutation creates new mutation for the Export entity.
+func newExportMutation(c config, op Op, opts ...exportOption) *ExportMutation {
+	m := &ExportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExport,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.show_reserves, ids[i])
-		m.removedshow_reserves[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedShowReserves returns the removed IDs of the "show_reserves" edge to the ShowReserve entity.
-func (m *ShowMutation) RemovedShowReservesIDs() (ids []uint64) {
-	for id := range m.removedshow_reserves {
-		ids = append(ids, id)
+// withExportID sets the ID field of the mutation.
+func withExportID(id uint64) exportOption {
+	return func(m *ExportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Export
+		)
+		m.oldValue = func(ctx context.Context) (*Export, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Export.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// ShowReservesIDs returns the "show_reserves" edge IDs in the mutation.
-func (m *ShowMutation) ShowReservesIDs() (ids []uint64) {
-	for id := range m.show_reserves {
-		ids = append(ids, id)
+// withExport sets the old Export of the mutation.
+func withExport(node *Export) exportOption {
+	return func(m *ExportMutation) {
+		m.oldValue = func(context.Context) (*Export, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetShowReserves resets all changes to the "show_reserves" edge.
-func (m *ShowMutation) ResetShowReserves() {
-	m.show_reserves = nil
-	m.clearedshow_reserves = false
-	m.removedshow_reserves = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// Where appends a list predicates to the ShowMutation builder.
-func (m *ShowMutation) Where(ps ...predicate.Show) {
-	m.predicates = append(m.predicates, ps...)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// WhereP appends storage-level predicates to the ShowMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ShowMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Show, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Export entities.
+func (m *ExportMutation) SetID(id uint64) {
+	m.id = &id
 }
 
-// Op returns the operation name.
-func (m *ShowMutation) Op() Op {
-	return m.op
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExportMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ShowMutation) SetOp(op Op) {
-	m.op = op
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExportMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Export.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Type returns the node type of this mutation (Show).
-func (m *ShowMutation) Type() string {
-	return m.typ
+// SetUserID sets the "user_id" field.
+func (m *ExportMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ShowMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m._type != nil {
-		fields = append(fields, show.FieldType)
-	}
-	if m.poster != nil {
-		fields = append(fields, show.FieldPoster)
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ExportMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
-	if m.content != nil {
-		fields = append(fields, show.FieldContent)
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	if m.media_files != nil {
-		fields = append(fields, show.FieldMediaFiles)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, show.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return fields
+	return oldValue.UserID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ShowMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case show.FieldType:
-		return m.GetType()
-	case show.FieldPoster:
-		return m.Poster()
-	case show.FieldContent:
-		return m.Content()
-	case show.FieldMediaFiles:
-		return m.MediaFiles()
-	case show.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ExportMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ShowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case show.FieldType:
-		return m.OldType(ctx)
-	case show.FieldPoster:
-		return m.OldPoster(ctx)
-	case show.FieldContent:
-		return m.OldContent(ctx)
-	case show.FieldMediaFiles:
-		return m.OldMediaFiles(ctx)
-	case show.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Show field %s", name)
+// SetTargetID sets the "target_id" field.
+func (m *ExportMutation) SetTargetID(u uint64) {
+	m.target_id = &u
+	m.addtarget_id = nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ShowMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case show.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case show.FieldPoster:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPoster(v)
-		return nil
-	case show.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case show.FieldMediaFiles:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMediaFiles(v)
-		return nil
-	case show.FieldCreatedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ExportMutation) TargetID() (r uint64, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Show field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ShowMutation) AddedFields() []string {
-	var fields []string
-	if m.addcreated_at != nil {
-		fields = append(fields, show.FieldCreatedAt)
+// OldTargetID returns the old "target_id" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ShowMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case show.FieldCreatedAt:
-		return m.AddedCreatedAt()
+// AddTargetID adds u to the "target_id" field.
+func (m *ExportMutation) AddTargetID(u int64) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += u
+	} else {
+		m.addtarget_id = &u
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ShowMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case show.FieldCreatedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreatedAt(v)
-		return nil
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *ExportMutation) AddedTargetID() (r int64, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Show numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ShowMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(show.FieldPoster) {
-		fields = append(fields, show.FieldPoster)
-	}
-	if m.FieldCleared(show.FieldMediaFiles) {
-		fields = append(fields, show.FieldMediaFiles)
-	}
-	return fields
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *ExportMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ShowMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetStatus sets the "status" field.
+func (m *ExportMutation) SetStatus(e export.Status) {
+	m.status = &e
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ShowMutation) ClearField(name string) error {
-	switch name {
-	case show.FieldPoster:
-		m.ClearPoster()
-		return nil
-	case show.FieldMediaFiles:
-		m.ClearMediaFiles()
-		return nil
+// Status returns the value of the "status" field in the mutation.
+func (m *ExportMutation) Status() (r export.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Show nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ShowMutation) ResetField(name string) error {
-	switch name {
-	case show.FieldType:
-		m.ResetType()
-		return nil
-	case show.FieldPoster:
-		m.ResetPoster()
-		return nil
-	case show.FieldContent:
-		m.ResetContent()
-		return nil
-	case show.FieldMediaFiles:
-		m.ResetMediaFiles()
-		return nil
-	case show.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// OldStatus returns the old "status" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldStatus(ctx context.Context) (v export.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Show field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ShowMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.show_reserves != nil {
-		edges = append(edges, show.EdgeShowReserves)
-	}
-	return edges
+// ResetStatus resets all changes to the "status" field.
+func (m *ExportMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ShowMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case show.EdgeShowReserves:
-		ids := make([]ent.Value, 0, len(m.show_reserves))
-		for id := range m.show_reserves {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// SetObjectName sets the "object_name" field.
+func (m *ExportMutation) SetObjectName(s string) {
+	m.object_name = &s
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ShowMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedshow_reserves != nil {
-		edges = append(edges, show.EdgeShowReserves)
+// ObjectName returns the value of the "object_name" field in the mutation.
+func (m *ExportMutation) ObjectName() (r string, exists bool) {
+	v := m.object_name
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ShowMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case show.EdgeShowReserves:
-		ids := make([]ent.Value, 0, len(m.removedshow_reserves))
-		for id := range m.removedshow_reserves {
-			ids = append(ids, id)
-		}
-		return ids
+// OldObjectName returns the old "object_name" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+	}
+	return oldValue.ObjectName, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ShowMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedshow_reserves {
-		edges = append(edges, show.EdgeShowReserves)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ShowMutation) EdgeCleared(name string) bool {
-	switch name {
-	case show.EdgeShowReserves:
-		return m.clearedshow_reserves
-	}
-	return false
+// ClearObjectName clears the value of the "object_name" field.
+func (m *ExportMutation) ClearObjectName() {
+	m.object_name = nil
+	m.clearedFields[export.FieldObjectName] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ShowMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Show unique edge %s", name)
+// ObjectNameCleared returns if the "object_name" field was cleared in this mutation.
+func (m *ExportMutation) ObjectNameCleared() bool {
+	_, ok := m.clearedFields[export.FieldObjectName]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ShowMutation) ResetEdge(name string) error {
-	switch name {
-	case show.EdgeShowReserves:
-		m.ResetShowReserves()
-		return nil
-	}
-	return fmt.Errorf("unknown Show edge %s", name)
+// ResetObjectName resets all changes to the "object_name" field.
+func (m *ExportMutation) ResetObjectName() {
+	m.object_name = nil
+	delete(m.clearedFields, export.FieldObjectName)
 }
 
-// ShowReserveMutation represents an operation that mutates the ShowReserve nodes in the graph.
-type ShowReserveMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uint64
-	user_id        *string
-	status         *showreserve.Status
-	memories       *[]string
-	appendmemories []string
-	created_at     *int64
-	addcreated_at  *int64
-	clearedFields  map[string]struct{}
-	show           *uint64
-	clearedshow    bool
-	done           bool
-	oldValue       func(context.Context) (*ShowReserve, error)
-	predicates     []predicate.ShowReserve
+// SetSize sets the "size" field.
+func (m *ExportMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-var _ ent.Mutation = (*ShowReserveMutation)(nil)
-
-// showreserveOption allows management of the mutation configuration using functional options.
-type showreserveOption func(*ShowReserveMutation)
-
-// newShowReserveMutation creates new mutation for the ShowReserve entity.
-func newShowReserveMutation(c config, op Op, opts ...showreserveOption) *ShowReserveMutation {
-	m := &ShowReserveMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeShowReserve,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// Size returns the value of the "size" field in the mutation.
+func (m *ExportMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withShowReserveID sets the ID field of the mutation.
-func withShowReserveID(id uint64) showreserveOption {
-	return func(m *ShowReserveMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ShowReserve
-		)
-		m.oldValue = func(ctx context.Context) (*ShowReserve, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ShowReserve.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldSize returns the old "size" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
-}
-
-// withShowReserve sets the old ShowReserve of the mutation.
-func withShowReserve(node *ShowReserve) showreserveOption {
-	return func(m *ShowReserveMutation) {
-		m.oldValue = func(context.Context) (*ShowReserve, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ShowReserveMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ShowReserveMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.Size, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ShowReserve entities.
-func (m *ShowReserveMutation) SetID(id uint64) {
-	m.id = &id
+// AddSize adds i to the "size" field.
+func (m *ExportMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ShowReserveMutation) ID() (id uint64, exists bool) {
-	if m.id == nil {
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ExportMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ShowReserveMutation) IDs(ctx context.Context) ([]uint64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uint64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ShowReserve.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetSize resets all changes to the "size" field.
+func (m *ExportMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ShowReserveMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetTargets sets the "targets" field.
+func (m *ExportMutation) SetTargets(u uint32) {
+	m.targets = &u
+	m.addtargets = nil
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ShowReserveMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// Targets returns the value of the "targets" field in the mutation.
+func (m *ExportMutation) Targets() (r uint32, exists bool) {
+	v := m.targets
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the ShowReserve entity.
-// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// OldTargets returns the old "targets" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowReserveMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ExportMutation) OldTargets(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargets is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldTargets requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargets: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Targets, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ShowReserveMutation) ResetUserID() {
-	m.user_id = nil
+// AddTargets adds u to the "targets" field.
+func (m *ExportMutation) AddTargets(u int32) {
+	if m.addtargets != nil {
+		*m.addtargets += u
+	} else {
+		m.addtargets = &u
+	}
 }
 
-// SetStatus sets the "status" field.
-func (m *ShowReserveMutation) SetStatus(s showreserve.Status) {
-	m.status = &s
+// AddedTargets returns the value that was added to the "targets" field in this mutation.
+func (m *ExportMutation) AddedTargets() (r int32, exists bool) {
+	v := m.addtargets
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ShowReserveMutation) Status() (r showreserve.Status, exists bool) {
-	v := m.status
+// ResetTargets resets all changes to the "targets" field.
+func (m *ExportMutation) ResetTargets() {
+	m.targets = nil
+	m.addtargets = nil
+}
+
+// SetSteps sets the "steps" field.
+func (m *ExportMutation) SetSteps(u uint32) {
+	m.steps = &u
+	m.addsteps = nil
+}
+
+// Steps returns the value of the "steps" field in the mutation.
+func (m *ExportMutation) Steps() (r uint32, exists bool) {
+	v := m.steps
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the ShowReserve entity.
-// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// OldSteps returns the old "steps" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowReserveMutation) OldStatus(ctx context.Context) (v showreserve.Status, err error) {
+func (m *ExportMutation) OldSteps(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldSteps is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldSteps requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldSteps: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Steps, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ShowReserveMutation) ResetStatus() {
-	m.status = nil
+// AddSteps adds u to the "steps" field.
+func (m *ExportMutation) AddSteps(u int32) {
+	if m.addsteps != nil {
+		*m.addsteps += u
+	} else {
+		m.addsteps = &u
+	}
 }
 
-// SetMemories sets the "memories" field.
-func (m *ShowReserveMutation) SetMemories(s []string) {
-	m.memories = &s
-	m.appendmemories = nil
+// AddedSteps returns the value that was added to the "steps" field in this mutation.
+func (m *ExportMutation) AddedSteps() (r int32, exists bool) {
+	v := m.addsteps
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Memories returns the value of the "memories" field in the mutation.
-func (m *ShowReserveMutation) Memories() (r []string, exists bool) {
-	v := m.memories
+// ResetSteps resets all changes to the "steps" field.
+func (m *ExportMutation) ResetSteps() {
+	m.steps = nil
+	m.addsteps = nil
+}
+
+// SetError sets the "error" field.
+func (m *ExportMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ExportMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMemories returns the old "memories" field's value of the ShowReserve entity.
-// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowReserveMutation) OldMemories(ctx context.Context) (v []string, err error) {
+func (m *ExportMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMemories is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMemories requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMemories: %w", err)
-	}
-	return oldValue.Memories, nil
-}
-
-// AppendMemories adds s to the "memories" field.
-func (m *ShowReserveMutation) AppendMemories(s []string) {
-	m.appendmemories = append(m.appendmemories, s...)
-}
-
-// AppendedMemories returns the list of values that were appended to the "memories" field in this mutation.
-func (m *ShowReserveMutation) AppendedMemories() ([]string, bool) {
-	if len(m.appendmemories) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return m.appendmemories, true
+	return oldValue.Error, nil
 }
 
-// ClearMemories clears the value of the "memories" field.
-func (m *ShowReserveMutation) ClearMemories() {
-	m.memories = nil
-	m.appendmemories = nil
-	m.clearedFields[showreserve.FieldMemories] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *ExportMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[export.FieldError] = struct{}{}
 }
 
-// MemoriesCleared returns if the "memories" field was cleared in this mutation.
-func (m *ShowReserveMutation) MemoriesCleared() bool {
-	_, ok := m.clearedFields[showreserve.FieldMemories]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ExportMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[export.FieldError]
 	return ok
 }
 
-// ResetMemories resets all changes to the "memories" field.
-func (m *ShowReserveMutation) ResetMemories() {
-	m.memories = nil
-	m.appendmemories = nil
-	delete(m.clearedFields, showreserve.FieldMemories)
+// ResetError resets all changes to the "error" field.
+func (m *ExportMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, export.FieldError)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ShowReserveMutation) SetCreatedAt(i int64) {
+func (m *ExportMutation) SetCreatedAt(i int64) {
 	m.created_at = &i
 	m.addcreated_at = nil
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ShowReserveMutation) CreatedAt() (r int64, exists bool) {
+func (m *ExportMutation) CreatedAt() (r int64, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2666,10 +2794,10 @@ func (m *ShowReserveMutation) CreatedAt() (r int64, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ShowReserve entity.
-// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowReserveMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *ExportMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2684,7 +2812,7 @@ func (m *ShowReserveMutation) OldCreatedAt(ctx context.Context) (v int64, err er
 }
 
 // AddCreatedAt adds i to the "created_at" field.
-func (m *ShowReserveMutation) AddCreatedAt(i int64) {
+func (m *ExportMutation) AddCreatedAt(i int64) {
 	if m.addcreated_at != nil {
 		*m.addcreated_at += i
 	} else {
@@ -2693,7 +2821,7 @@ func (m *ShowReserveMutation) AddCreatedAt(i int64) {
 }
 
 // AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *ShowReserveMutation) AddedCreatedAt() (r int64, exists bool) {
+func (m *ExportMutation) AddedCreatedAt() (r int64, exists bool) {
 	v := m.addcreated_at
 	if v == nil {
 		return
@@ -2702,109 +2830,90 @@ func (m *ShowReserveMutation) AddedCreatedAt() (r int64, exists bool) {
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ShowReserveMutation) ResetCreatedAt() {
+func (m *ExportMutation) ResetCreatedAt() {
 	m.created_at = nil
 	m.addcreated_at = nil
 }
 
-// SetRefShowID sets the "ref_show_id" field.
-func (m *ShowReserveMutation) SetRefShowID(u uint64) {
-	m.show = &u
+// SetFinishedAt sets the "finished_at" field.
+func (m *ExportMutation) SetFinishedAt(i int64) {
+	m.finished_at = &i
+	m.addfinished_at = nil
 }
 
-// RefShowID returns the value of the "ref_show_id" field in the mutation.
-func (m *ShowReserveMutation) RefShowID() (r uint64, exists bool) {
-	v := m.show
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ExportMutation) FinishedAt() (r int64, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefShowID returns the old "ref_show_id" field's value of the ShowReserve entity.
-// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ShowReserveMutation) OldRefShowID(ctx context.Context) (v uint64, err error) {
+func (m *ExportMutation) OldFinishedAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefShowID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefShowID requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefShowID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.RefShowID, nil
-}
-
-// ClearRefShowID clears the value of the "ref_show_id" field.
-func (m *ShowReserveMutation) ClearRefShowID() {
-	m.show = nil
-	m.clearedFields[showreserve.FieldRefShowID] = struct{}{}
-}
-
-// RefShowIDCleared returns if the "ref_show_id" field was cleared in this mutation.
-func (m *ShowReserveMutation) RefShowIDCleared() bool {
-	_, ok := m.clearedFields[showreserve.FieldRefShowID]
-	return ok
-}
-
-// ResetRefShowID resets all changes to the "ref_show_id" field.
-func (m *ShowReserveMutation) ResetRefShowID() {
-	m.show = nil
-	delete(m.clearedFields, showreserve.FieldRefShowID)
-}
-
-// SetShowID sets the "show" edge to the Show entity by id.
-func (m *ShowReserveMutation) SetShowID(id uint64) {
-	m.show = &id
+	return oldValue.FinishedAt, nil
 }
 
-// ClearShow clears the "show" edge to the Show entity.
-func (m *ShowReserveMutation) ClearShow() {
-	m.clearedshow = true
-	m.clearedFields[showreserve.FieldRefShowID] = struct{}{}
+// AddFinishedAt adds i to the "finished_at" field.
+func (m *ExportMutation) AddFinishedAt(i int64) {
+	if m.addfinished_at != nil {
+		*m.addfinished_at += i
+	} else {
+		m.addfinished_at = &i
+	}
 }
 
-// ShowCleared reports if the "show" edge to the Show entity was cleared.
-func (m *ShowReserveMutation) ShowCleared() bool {
-	return m.RefShowIDCleared() || m.clearedshow
+// AddedFinishedAt returns the value that was added to the "finished_at" field in this mutation.
+func (m *ExportMutation) AddedFinishedAt() (r int64, exists bool) {
+	v := m.addfinished_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ShowID returns the "show" edge ID in the mutation.
-func (m *ShowReserveMutation) ShowID() (id uint64, exists bool) {
-	if m.show != nil {
-		return *m.show, true
-	}
-	return
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ExportMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+	m.clearedFields[export.FieldFinishedAt] = struct{}{}
 }
 
-// ShowIDs returns the "show" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ShowID instead. It exists only for internal usage by the builders.
-func (m *ShowReserveMutation) ShowIDs() (ids []uint64) {
-	if id := m.show; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ExportMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[export.FieldFinishedAt]
+	return ok
 }
 
-// ResetShow resets all changes to the "show" edge.
-func (m *ShowReserveMutation) ResetShow() {
-	m.show = nil
-	m.clearedshow = false
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ExportMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+	delete(m.clearedFields, export.FieldFinishedAt)
 }
 
-// Where appends a list predicates to the ShowReserveMutation builder.
-func (m *ShowReserveMutation) Where(ps ...predicate.ShowReserve) {
+// Where appends a list predicates to the ExportMutation builder.
+func (m *ExportMutation) Where(ps ...predicate.Export) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ShowReserveMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ExportMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ShowReserveMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ShowReserve, len(ps))
+func (m *ExportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Export, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2812,39 +2921,54 @@ func (m *ShowReserveMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ShowReserveMutation) Op() Op {
+func (m *ExportMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ShowReserveMutation) SetOp(op Op) {
+func (m *ExportMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ShowReserve).
-func (m *ShowReserveMutation) Type() string {
+// Type returns the node type of this mutation (Export).
+func (m *ExportMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ShowReserveMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *ExportMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.user_id != nil {
-		fields = append(fields, showreserve.FieldUserID)
+		fields = append(fields, export.FieldUserID)
+	}
+	if m.target_id != nil {
+		fields = append(fields, export.FieldTargetID)
 	}
 	if m.status != nil {
-		fields = append(fields, showreserve.FieldStatus)
+		fields = append(fields, export.FieldStatus)
 	}
-	if m.memories != nil {
-		fields = append(fields, showreserve.FieldMemories)
+	if m.object_name != nil {
+		fields = append(fields, export.FieldObjectName)
+	}
+	if m.size != nil {
+		fields = append(fields, export.FieldSize)
+	}
+	if m.targets != nil {
+		fields = append(fields, export.FieldTargets)
+	}
+	if m.steps != nil {
+		fields = append(fields, export.FieldSteps)
+	}
+	if m.error != nil {
+		fields = append(fields, export.FieldError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, showreserve.FieldCreatedAt)
+		fields = append(fields, export.FieldCreatedAt)
 	}
-	if m.show != nil {
-		fields = append(fields, showreserve.FieldRefShowID)
+	if m.finished_at != nil {
+		fields = append(fields, export.FieldFinishedAt)
 	}
 	return fields
 }
@@ -2852,18 +2976,28 @@ func (m *ShowReserveMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ShowReserveMutation) Field(name string) (ent.Value, bool) {
+func (m *ExportMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case showreserve.FieldUserID:
+	case export.FieldUserID:
 		return m.UserID()
-	case showreserve.FieldStatus:
+	case export.FieldTargetID:
+		return m.TargetID()
+	case export.FieldStatus:
 		return m.Status()
-	case showreserve.FieldMemories:
-		return m.Memories()
-	case showreserve.FieldCreatedAt:
+	case export.FieldObjectName:
+		return m.ObjectName()
+	case export.FieldSize:
+		return m.Size()
+	case export.FieldTargets:
+		return m.Targets()
+	case export.FieldSteps:
+		return m.Steps()
+	case export.FieldError:
+		return m.Error()
+	case export.FieldCreatedAt:
 		return m.CreatedAt()
-	case showreserve.FieldRefShowID:
-		return m.RefShowID()
+	case export.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -2871,72 +3005,132 @@ func (m *ShowReserveMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ShowReserveMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case showreserve.FieldUserID:
+	case export.FieldUserID:
 		return m.OldUserID(ctx)
-	case showreserve.FieldStatus:
+	case export.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case export.FieldStatus:
 		return m.OldStatus(ctx)
-	case showreserve.FieldMemories:
-		return m.OldMemories(ctx)
-	case showreserve.FieldCreatedAt:
+	case export.FieldObjectName:
+		return m.OldObjectName(ctx)
+	case export.FieldSize:
+		return m.OldSize(ctx)
+	case export.FieldTargets:
+		return m.OldTargets(ctx)
+	case export.FieldSteps:
+		return m.OldSteps(ctx)
+	case export.FieldError:
+		return m.OldError(ctx)
+	case export.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case showreserve.FieldRefShowID:
-		return m.OldRefShowID(ctx)
+	case export.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ShowReserve field %s", name)
+	return nil, fmt.Errorf("unknown Export field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShowReserveMutation) SetField(name string, value ent.Value) error {
+func (m *ExportMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case showreserve.FieldUserID:
+	case export.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case showreserve.FieldStatus:
-		v, ok := value.(showreserve.Status)
+	case export.FieldTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case export.FieldStatus:
+		v, ok := value.(export.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case showreserve.FieldMemories:
-		v, ok := value.([]string)
+	case export.FieldObjectName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMemories(v)
+		m.SetObjectName(v)
 		return nil
-	case showreserve.FieldCreatedAt:
+	case export.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case export.FieldTargets:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargets(v)
+		return nil
+	case export.FieldSteps:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteps(v)
+		return nil
+	case export.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case export.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case showreserve.FieldRefShowID:
-		v, ok := value.(uint64)
+	case export.FieldFinishedAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefShowID(v)
+		m.SetFinishedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ShowReserve field %s", name)
+	return fmt.Errorf("unknown Export field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ShowReserveMutation) AddedFields() []string {
+func (m *ExportMutation) AddedFields() []string {
 	var fields []string
+	if m.addtarget_id != nil {
+		fields = append(fields, export.FieldTargetID)
+	}
+	if m.addsize != nil {
+		fields = append(fields, export.FieldSize)
+	}
+	if m.addtargets != nil {
+		fields = append(fields, export.FieldTargets)
+	}
+	if m.addsteps != nil {
+		fields = append(fields, export.FieldSteps)
+	}
 	if m.addcreated_at != nil {
-		fields = append(fields, showreserve.FieldCreatedAt)
+		fields = append(fields, export.FieldCreatedAt)
+	}
+	if m.addfinished_at != nil {
+		fields = append(fields, export.FieldFinishedAt)
 	}
 	return fields
 }
@@ -2944,10 +3138,20 @@ func (m *ShowReserveMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ShowReserveMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExportMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case showreserve.FieldCreatedAt:
+	case export.FieldTargetID:
+		return m.AddedTargetID()
+	case export.FieldSize:
+		return m.AddedSize()
+	case export.FieldTargets:
+		return m.AddedTargets()
+	case export.FieldSteps:
+		return m.AddedSteps()
+	case export.FieldCreatedAt:
 		return m.AddedCreatedAt()
+	case export.FieldFinishedAt:
+		return m.AddedFinishedAt()
 	}
 	return nil, false
 }
@@ -2955,190 +3159,218 @@ func (m *ShowReserveMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ShowReserveMutation) AddField(name string, value ent.Value) error {
+func (m *ExportMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case showreserve.FieldCreatedAt:
+	case export.FieldTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case export.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case export.FieldTargets:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargets(v)
+		return nil
+	case export.FieldSteps:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSteps(v)
+		return nil
+	case export.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddCreatedAt(v)
 		return nil
+	case export.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFinishedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ShowReserve numeric field %s", name)
+	return fmt.Errorf("unknown Export numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ShowReserveMutation) ClearedFields() []string {
+func (m *ExportMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(showreserve.FieldMemories) {
-		fields = append(fields, showreserve.FieldMemories)
+	if m.FieldCleared(export.FieldObjectName) {
+		fields = append(fields, export.FieldObjectName)
 	}
-	if m.FieldCleared(showreserve.FieldRefShowID) {
-		fields = append(fields, showreserve.FieldRefShowID)
+	if m.FieldCleared(export.FieldError) {
+		fields = append(fields, export.FieldError)
+	}
+	if m.FieldCleared(export.FieldFinishedAt) {
+		fields = append(fields, export.FieldFinishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ShowReserveMutation) FieldCleared(name string) bool {
+func (m *ExportMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ShowReserveMutation) ClearField(name string) error {
+func (m *ExportMutation) ClearField(name string) error {
 	switch name {
-	case showreserve.FieldMemories:
-		m.ClearMemories()
+	case export.FieldObjectName:
+		m.ClearObjectName()
 		return nil
-	case showreserve.FieldRefShowID:
-		m.ClearRefShowID()
+	case export.FieldError:
+		m.ClearError()
+		return nil
+	case export.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ShowReserve nullable field %s", name)
+	return fmt.Errorf("unknown Export nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ShowReserveMutation) ResetField(name string) error {
+func (m *ExportMutation) ResetField(name string) error {
 	switch name {
-	case showreserve.FieldUserID:
+	case export.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case showreserve.FieldStatus:
+	case export.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case export.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case showreserve.FieldMemories:
-		m.ResetMemories()
+	case export.FieldObjectName:
+		m.ResetObjectName()
 		return nil
-	case showreserve.FieldCreatedAt:
+	case export.FieldSize:
+		m.ResetSize()
+		return nil
+	case export.FieldTargets:
+		m.ResetTargets()
+		return nil
+	case export.FieldSteps:
+		m.ResetSteps()
+		return nil
+	case export.FieldError:
+		m.ResetError()
+		return nil
+	case export.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case showreserve.FieldRefShowID:
-		m.ResetRefShowID()
+	case export.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ShowReserve field %s", name)
+	return fmt.Errorf("unknown Export field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ShowReserveMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.show != nil {
-		edges = append(edges, showreserve.EdgeShow)
-	}
+func (m *ExportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ShowReserveMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case showreserve.EdgeShow:
-		if id := m.show; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ExportMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ShowReserveMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ExportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ShowReserveMutation) RemovedIDs(name string) []ent.Value {
+func (m *ExportMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ShowReserveMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedshow {
-		edges = append(edges, showreserve.EdgeShow)
-	}
+func (m *ExportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ShowReserveMutation) EdgeCleared(name string) bool {
-	switch name {
-	case showreserve.EdgeShow:
-		return m.clearedshow
-	}
+func (m *ExportMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ShowReserveMutation) ClearEdge(name string) error {
-	switch name {
-	case showreserve.EdgeShow:
-		m.ClearShow()
-		return nil
-	}
-	return fmt.Errorf("unknown ShowReserve unique edge %s", name)
+func (m *ExportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Export unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ShowReserveMutation) ResetEdge(name string) error {
-	switch name {
-	case showreserve.EdgeShow:
-		m.ResetShow()
-		return nil
-	}
-	return fmt.Errorf("unknown ShowReserve edge %s", name)
+func (m *ExportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Export edge %s", name)
 }
 
-// StepMutation represents an operation that mutates the Step nodes in the graph.
-type StepMutation struct {
+// ImportItemMutation represents an operation that mutates the ImportItem nodes in the graph.
+type ImportItemMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uint64
-	title           *string
-	description     *string
-	is_challenge    *bool
-	teacher_comment *map[string]interface{}
-	parent_comment  *map[string]interface{}
-	friend_comment  *map[string]interface{}
-	_type           *step.Type
-	object_name     *string
-	created_at      *int64
-	addcreated_at   *int64
-	clearedFields   map[string]struct{}
-	target          *uint64
-	clearedtarget   bool
-	parent          *uint64
-	clearedparent   bool
-	children        map[uint64]struct{}
-	removedchildren map[uint64]struct{}
-	clearedchildren bool
-	done            bool
-	oldValue        func(context.Context) (*Step, error)
-	predicates      []predicate.Step
+	op            Op
+	typ           string
+	id            *uint64
+	seq           *uint32
+	addseq        *int32
+	kind          *importitem.Kind
+	ref           *string
+	parent_ref    *string
+	target_ref    *string
+	item_time     *int64
+	additem_time  *int64
+	data          **objects.ImportItemData
+	status        *importitem.Status
+	entity_id     *uint64
+	addentity_id  *int64
+	error         *string
+	clearedFields map[string]struct{}
+	job           *uint64
+	clearedjob    bool
+	done          bool
+	oldValue      func(context.Context) (*ImportItem, error)
+	predicates    []predicate.ImportItem
 }
 
-var _ ent.Mutation = (*StepMutation)(nil)
+var _ ent.Mutation = (*ImportItemMutation)(nil)
 
-// stepOption allows management of the mutation configuration using functional options.
-type stepOption func(*StepMutation)
+// importitemOption allows management of the mutation configuration using functional options.
+type importitemOption func(*ImportItemMutation)
 
-// newStepMutation creates new mutation for the Step entity.
-func newStepMutation(c config, op Op, opts ...stepOption) *StepMutation {
-	m := &StepMutation{
+// newImportItemMutation creates new mutation for the ImportItem entity.
+func newImportItemMutation(c config, op Op, opts ...importitemOption) *ImportItemMutation {
+	m := &ImportItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStep,
+		typ:           TypeImportItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3147,20 +3379,20 @@ func newStepMutation(c config, op Op, opts ...stepOption) *StepMutation {
 	return m
 }
 
-// withStepID sets the ID field of the mutation.
-func withStepID(id uint64) stepOption {
-	return func(m *StepMutation) {
+// withImportItemID sets the ID field of the mutation.
+func withImportItemID(id uint64) importitemOption {
+	return func(m *ImportItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Step
+			value *ImportItem
 		)
-		m.oldValue = func(ctx context.Context) (*Step, error) {
+		m.oldValue = func(ctx context.Context) (*ImportItem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Step.Get(ctx, id)
+					value, err = m.Client().ImportItem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3169,10 +3401,10 @@ func withStepID(id uint64) stepOption {
 	}
 }
 
-// withStep sets the old Step of the mutation.
-func withStep(node *Step) stepOption {
-	return func(m *StepMutation) {
-		m.oldValue = func(context.Context) (*Step, error) {
+// withImportItem sets the old ImportItem of the mutation.
+func withImportItem(node *ImportItem) importitemOption {
+	return func(m *ImportItemMutation) {
+		m.oldValue = func(context.Context) (*ImportItem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3181,7 +3413,7 @@ func withStep(node *Step) stepOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StepMutation) Client() *Client {
+func (m ImportItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3189,7 +3421,7 @@ func (m StepMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StepMutation) Tx() (*Tx, error) {
+func (m ImportItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3199,14 +3431,14 @@ func (m StepMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Step entities.
-func (m *StepMutation) SetID(id uint64) {
+// operation is only accepted on creation of ImportItem entities.
+func (m *ImportItemMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StepMutation) ID() (id uint64, exists bool) {
+func (m *ImportItemMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3217,7 +3449,7 @@ func (m *StepMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StepMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *ImportItemMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3226,662 +3458,557 @@ func (m *StepMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Step.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ImportItem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *StepMutation) SetTitle(s string) {
-	m.title = &s
+// SetJobID sets the "job_id" field.
+func (m *ImportItemMutation) SetJobID(u uint64) {
+	m.job = &u
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *StepMutation) Title() (r string, exists bool) {
-	v := m.title
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *ImportItemMutation) JobID() (r uint64, exists bool) {
+	v := m.job
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *ImportItemMutation) OldJobID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.Title, nil
-}
-
-// ClearTitle clears the value of the "title" field.
-func (m *StepMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[step.FieldTitle] = struct{}{}
-}
-
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *StepMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[step.FieldTitle]
-	return ok
+	return oldValue.JobID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *StepMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, step.FieldTitle)
+// ResetJobID resets all changes to the "job_id" field.
+func (m *ImportItemMutation) ResetJobID() {
+	m.job = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *StepMutation) SetDescription(s string) {
-	m.description = &s
+// SetSeq sets the "seq" field.
+func (m *ImportItemMutation) SetSeq(u uint32) {
+	m.seq = &u
+	m.addseq = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *StepMutation) Description() (r string, exists bool) {
-	v := m.description
+// Seq returns the value of the "seq" field in the mutation.
+func (m *ImportItemMutation) Seq() (r uint32, exists bool) {
+	v := m.seq
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ImportItemMutation) OldSeq(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldSeq requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Seq, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *StepMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[step.FieldDescription] = struct{}{}
+// AddSeq adds u to the "seq" field.
+func (m *ImportItemMutation) AddSeq(u int32) {
+	if m.addseq != nil {
+		*m.addseq += u
+	} else {
+		m.addseq = &u
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *StepMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[step.FieldDescription]
-	return ok
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *ImportItemMutation) AddedSeq() (r int32, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *StepMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, step.FieldDescription)
+// ResetSeq resets all changes to the "seq" field.
+func (m *ImportItemMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
 }
 
-// SetIsChallenge sets the "is_challenge" field.
-func (m *StepMutation) SetIsChallenge(b bool) {
-	m.is_challenge = &b
+// SetKind sets the "kind" field.
+func (m *ImportItemMutation) SetKind(i importitem.Kind) {
+	m.kind = &i
 }
 
-// IsChallenge returns the value of the "is_challenge" field in the mutation.
-func (m *StepMutation) IsChallenge() (r bool, exists bool) {
-	v := m.is_challenge
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ImportItemMutation) Kind() (r importitem.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsChallenge returns the old "is_challenge" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldIsChallenge(ctx context.Context) (v bool, err error) {
+func (m *ImportItemMutation) OldKind(ctx context.Context) (v importitem.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsChallenge is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsChallenge requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsChallenge: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.IsChallenge, nil
+	return oldValue.Kind, nil
 }
 
-// ResetIsChallenge resets all changes to the "is_challenge" field.
-func (m *StepMutation) ResetIsChallenge() {
-	m.is_challenge = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *ImportItemMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetTeacherComment sets the "teacher_comment" field.
-func (m *StepMutation) SetTeacherComment(value map[string]interface{}) {
-	m.teacher_comment = &value
+// SetRef sets the "ref" field.
+func (m *ImportItemMutation) SetRef(s string) {
+	m.ref = &s
 }
 
-// TeacherComment returns the value of the "teacher_comment" field in the mutation.
-func (m *StepMutation) TeacherComment() (r map[string]interface{}, exists bool) {
-	v := m.teacher_comment
+// Ref returns the value of the "ref" field in the mutation.
+func (m *ImportItemMutation) Ref() (r string, exists bool) {
+	v := m.ref
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTeacherComment returns the old "teacher_comment" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldRef returns the old "ref" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldTeacherComment(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ImportItemMutation) OldRef(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeacherComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldRef is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeacherComment requires an ID field in the mutation")
+		return v, errors.New("OldRef requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeacherComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldRef: %w", err)
 	}
-	return oldValue.TeacherComment, nil
-}
-
-// ClearTeacherComment clears the value of the "teacher_comment" field.
-func (m *StepMutation) ClearTeacherComment() {
-	m.teacher_comment = nil
-	m.clearedFields[step.FieldTeacherComment] = struct{}{}
-}
-
-// TeacherCommentCleared returns if the "teacher_comment" field was cleared in this mutation.
-func (m *StepMutation) TeacherCommentCleared() bool {
-	_, ok := m.clearedFields[step.FieldTeacherComment]
-	return ok
+	return oldValue.Ref, nil
 }
 
-// ResetTeacherComment resets all changes to the "teacher_comment" field.
-func (m *StepMutation) ResetTeacherComment() {
-	m.teacher_comment = nil
-	delete(m.clearedFields, step.FieldTeacherComment)
+// ResetRef resets all changes to the "ref" field.
+func (m *ImportItemMutation) ResetRef() {
+	m.ref = nil
 }
 
-// SetParentComment sets the "parent_comment" field.
-func (m *StepMutation) SetParentComment(value map[string]interface{}) {
-	m.parent_comment = &value
+// SetParentRef sets the "parent_ref" field.
+func (m *ImportItemMutation) SetParentRef(s string) {
+	m.parent_ref = &s
 }
 
-// ParentComment returns the value of the "parent_comment" field in the mutation.
-func (m *StepMutation) ParentComment() (r map[string]interface{}, exists bool) {
-	v := m.parent_comment
+// ParentRef returns the value of the "parent_ref" field in the mutation.
+func (m *ImportItemMutation) ParentRef() (r string, exists bool) {
+	v := m.parent_ref
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentComment returns the old "parent_comment" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldParentRef returns the old "parent_ref" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldParentComment(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ImportItemMutation) OldParentRef(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentRef is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentComment requires an ID field in the mutation")
+		return v, errors.New("OldParentRef requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentRef: %w", err)
 	}
-	return oldValue.ParentComment, nil
+	return oldValue.ParentRef, nil
 }
 
-// ClearParentComment clears the value of the "parent_comment" field.
-func (m *StepMutation) ClearParentComment() {
-	m.parent_comment = nil
-	m.clearedFields[step.FieldParentComment] = struct{}{}
+// ClearParentRef clears the value of the "parent_ref" field.
+func (m *ImportItemMutation) ClearParentRef() {
+	m.parent_ref = nil
+	m.clearedFields[importitem.FieldParentRef] = struct{}{}
 }
 
-// ParentCommentCleared returns if the "parent_comment" field was cleared in this mutation.
-func (m *StepMutation) ParentCommentCleared() bool {
-	_, ok := m.clearedFields[step.FieldParentComment]
+// ParentRefCleared returns if the "parent_ref" field was cleared in this mutation.
+func (m *ImportItemMutation) ParentRefCleared() bool {
+	_, ok := m.clearedFields[importitem.FieldParentRef]
 	return ok
 }
 
-// ResetParentComment resets all changes to the "parent_comment" field.
-func (m *StepMutation) ResetParentComment() {
-	m.parent_comment = nil
-	delete(m.clearedFields, step.FieldParentComment)
+// ResetParentRef resets all changes to the "parent_ref" field.
+func (m *ImportItemMutation) ResetParentRef() {
+	m.parent_ref = nil
+	delete(m.clearedFields, importitem.FieldParentRef)
 }
 
-// SetFriendComment sets the "friend_comment" field.
-func (m *StepMutation) SetFriendComment(value map[string]interface{}) {
-	m.friend_comment = &value
+// SetTargetRef sets the "target_ref" field.
+func (m *ImportItemMutation) SetTargetRef(s string) {
+	m.target_ref = &s
 }
 
-// FriendComment returns the value of the "friend_comment" field in the mutation.
-func (m *StepMutation) FriendComment() (r map[string]interface{}, exists bool) {
-	v := m.friend_comment
+// TargetRef returns the value of the "target_ref" field in the mutation.
+func (m *ImportItemMutation) TargetRef() (r string, exists bool) {
+	v := m.target_ref
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFriendComment returns the old "friend_comment" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetRef returns the old "target_ref" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldFriendComment(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ImportItemMutation) OldTargetRef(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFriendComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetRef is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFriendComment requires an ID field in the mutation")
+		return v, errors.New("OldTargetRef requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFriendComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetRef: %w", err)
 	}
-	return oldValue.FriendComment, nil
+	return oldValue.TargetRef, nil
 }
 
-// ClearFriendComment clears the value of the "friend_comment" field.
-func (m *StepMutation) ClearFriendComment() {
-	m.friend_comment = nil
-	m.clearedFields[step.FieldFriendComment] = struct{}{}
+// ClearTargetRef clears the value of the "target_ref" field.
+func (m *ImportItemMutation) ClearTargetRef() {
+	m.target_ref = nil
+	m.clearedFields[importitem.FieldTargetRef] = struct{}{}
 }
 
-// FriendCommentCleared returns if the "friend_comment" field was cleared in this mutation.
-func (m *StepMutation) FriendCommentCleared() bool {
-	_, ok := m.clearedFields[step.FieldFriendComment]
+// TargetRefCleared returns if the "target_ref" field was cleared in this mutation.
+func (m *ImportItemMutation) TargetRefCleared() bool {
+	_, ok := m.clearedFields[importitem.FieldTargetRef]
 	return ok
 }
 
-// ResetFriendComment resets all changes to the "friend_comment" field.
-func (m *StepMutation) ResetFriendComment() {
-	m.friend_comment = nil
-	delete(m.clearedFields, step.FieldFriendComment)
+// ResetTargetRef resets all changes to the "target_ref" field.
+func (m *ImportItemMutation) ResetTargetRef() {
+	m.target_ref = nil
+	delete(m.clearedFields, importitem.FieldTargetRef)
 }
 
-// SetType sets the "type" field.
-func (m *StepMutation) SetType(s step.Type) {
-	m._type = &s
+// SetItemTime sets the "item_time" field.
+func (m *ImportItemMutation) SetItemTime(i int64) {
+	m.item_time = &i
+	m.additem_time = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *StepMutation) GetType() (r step.Type, exists bool) {
-	v := m._type
+// ItemTime returns the value of the "item_time" field in the mutation.
+func (m *ImportItemMutation) ItemTime() (r int64, exists bool) {
+	v := m.item_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldItemTime returns the old "item_time" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldType(ctx context.Context) (v step.Type, err error) {
+func (m *ImportItemMutation) OldItemTime(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldItemTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemTime: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.ItemTime, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *StepMutation) ResetType() {
-	m._type = nil
+// AddItemTime adds i to the "item_time" field.
+func (m *ImportItemMutation) AddItemTime(i int64) {
+	if m.additem_time != nil {
+		*m.additem_time += i
+	} else {
+		m.additem_time = &i
+	}
 }
 
-// SetObjectName sets the "object_name" field.
-func (m *StepMutation) SetObjectName(s string) {
-	m.object_name = &s
+// AddedItemTime returns the value that was added to the "item_time" field in this mutation.
+func (m *ImportItemMutation) AddedItemTime() (r int64, exists bool) {
+	v := m.additem_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ObjectName returns the value of the "object_name" field in the mutation.
-func (m *StepMutation) ObjectName() (r string, exists bool) {
-	v := m.object_name
+// ResetItemTime resets all changes to the "item_time" field.
+func (m *ImportItemMutation) ResetItemTime() {
+	m.item_time = nil
+	m.additem_time = nil
+}
+
+// SetData sets the "data" field.
+func (m *ImportItemMutation) SetData(oid *objects.ImportItemData) {
+	m.data = &oid
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ImportItemMutation) Data() (r *objects.ImportItemData, exists bool) {
+	v := m.data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldObjectName returns the old "object_name" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldData returns the old "data" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldObjectName(ctx context.Context) (v string, err error) {
+func (m *ImportItemMutation) OldData(ctx context.Context) (v *objects.ImportItemData, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldObjectName requires an ID field in the mutation")
+		return v, errors.New("OldData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
 	}
-	return oldValue.ObjectName, nil
+	return oldValue.Data, nil
 }
 
-// ClearObjectName clears the value of the "object_name" field.
-func (m *StepMutation) ClearObjectName() {
-	m.object_name = nil
-	m.clearedFields[step.FieldObjectName] = struct{}{}
+// ResetData resets all changes to the "data" field.
+func (m *ImportItemMutation) ResetData() {
+	m.data = nil
 }
 
-// ObjectNameCleared returns if the "object_name" field was cleared in this mutation.
-func (m *StepMutation) ObjectNameCleared() bool {
-	_, ok := m.clearedFields[step.FieldObjectName]
-	return ok
+// SetStatus sets the "status" field.
+func (m *ImportItemMutation) SetStatus(i importitem.Status) {
+	m.status = &i
 }
 
-// ResetObjectName resets all changes to the "object_name" field.
-func (m *StepMutation) ResetObjectName() {
-	m.object_name = nil
-	delete(m.clearedFields, step.FieldObjectName)
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportItemMutation) Status() (r importitem.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StepMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StepMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *ImportItemMutation) OldStatus(ctx context.Context) (v importitem.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// AddCreatedAt adds i to the "created_at" field.
-func (m *StepMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
-	} else {
-		m.addcreated_at = &i
-	}
-}
-
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *StepMutation) AddedCreatedAt() (r int64, exists bool) {
-	v := m.addcreated_at
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return *v, true
+	return oldValue.Status, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StepMutation) ResetCreatedAt() {
-	m.created_at = nil
-	m.addcreated_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportItemMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetRefTargetID sets the "ref_target_id" field.
-func (m *StepMutation) SetRefTargetID(u uint64) {
-	m.target = &u
+// SetEntityID sets the "entity_id" field.
+func (m *ImportItemMutation) SetEntityID(u uint64) {
+	m.entity_id = &u
+	m.addentity_id = nil
 }
 
-// RefTargetID returns the value of the "ref_target_id" field in the mutation.
-func (m *StepMutation) RefTargetID() (r uint64, exists bool) {
-	v := m.target
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *ImportItemMutation) EntityID() (r uint64, exists bool) {
+	v := m.entity_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefTargetID returns the old "ref_target_id" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldEntityID returns the old "entity_id" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldRefTargetID(ctx context.Context) (v uint64, err error) {
+func (m *ImportItemMutation) OldEntityID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefTargetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefTargetID requires an ID field in the mutation")
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefTargetID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
 	}
-	return oldValue.RefTargetID, nil
+	return oldValue.EntityID, nil
 }
 
-// ClearRefTargetID clears the value of the "ref_target_id" field.
-func (m *StepMutation) ClearRefTargetID() {
-	m.target = nil
-	m.clearedFields[step.FieldRefTargetID] = struct{}{}
+// AddEntityID adds u to the "entity_id" field.
+func (m *ImportItemMutation) AddEntityID(u int64) {
+	if m.addentity_id != nil {
+		*m.addentity_id += u
+	} else {
+		m.addentity_id = &u
+	}
 }
 
-// RefTargetIDCleared returns if the "ref_target_id" field was cleared in this mutation.
-func (m *StepMutation) RefTargetIDCleared() bool {
-	_, ok := m.clearedFields[step.FieldRefTargetID]
+// AddedEntityID returns the value that was added to the "entity_id" field in this mutation.
+func (m *ImportItemMutation) AddedEntityID() (r int64, exists bool) {
+	v := m.addentity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (m *ImportItemMutation) ClearEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+	m.clearedFields[importitem.FieldEntityID] = struct{}{}
+}
+
+// EntityIDCleared returns if the "entity_id" field was cleared in this mutation.
+func (m *ImportItemMutation) EntityIDCleared() bool {
+	_, ok := m.clearedFields[importitem.FieldEntityID]
 	return ok
 }
 
-// ResetRefTargetID resets all changes to the "ref_target_id" field.
-func (m *StepMutation) ResetRefTargetID() {
-	m.target = nil
-	delete(m.clearedFields, step.FieldRefTargetID)
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *ImportItemMutation) ResetEntityID() {
+	m.entity_id = nil
+	m.addentity_id = nil
+	delete(m.clearedFields, importitem.FieldEntityID)
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *StepMutation) SetParentID(u uint64) {
-	m.parent = &u
+// SetError sets the "error" field.
+func (m *ImportItemMutation) SetError(s string) {
+	m.error = &s
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *StepMutation) ParentID() (r uint64, exists bool) {
-	v := m.parent
+// Error returns the value of the "error" field in the mutation.
+func (m *ImportItemMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Step entity.
-// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the ImportItem entity.
+// If the ImportItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepMutation) OldParentID(ctx context.Context) (v uint64, err error) {
+func (m *ImportItemMutation) OldError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.Error, nil
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *StepMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[step.FieldParentID] = struct{}{}
+// ClearError clears the value of the "error" field.
+func (m *ImportItemMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[importitem.FieldError] = struct{}{}
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *StepMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[step.FieldParentID]
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ImportItemMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[importitem.FieldError]
 	return ok
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *StepMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, step.FieldParentID)
-}
-
-// SetTargetID sets the "target" edge to the Target entity by id.
-func (m *StepMutation) SetTargetID(id uint64) {
-	m.target = &id
-}
-
-// ClearTarget clears the "target" edge to the Target entity.
-func (m *StepMutation) ClearTarget() {
-	m.clearedtarget = true
-	m.clearedFields[step.FieldRefTargetID] = struct{}{}
-}
-
-// TargetCleared reports if the "target" edge to the Target entity was cleared.
-func (m *StepMutation) TargetCleared() bool {
-	return m.RefTargetIDCleared() || m.clearedtarget
-}
-
-// TargetID returns the "target" edge ID in the mutation.
-func (m *StepMutation) TargetID() (id uint64, exists bool) {
-	if m.target != nil {
-		return *m.target, true
-	}
-	return
-}
-
-// TargetIDs returns the "target" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetID instead. It exists only for internal usage by the builders.
-func (m *StepMutation) TargetIDs() (ids []uint64) {
-	if id := m.target; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTarget resets all changes to the "target" edge.
-func (m *StepMutation) ResetTarget() {
-	m.target = nil
-	m.clearedtarget = false
+// ResetError resets all changes to the "error" field.
+func (m *ImportItemMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, importitem.FieldError)
 }
 
-// ClearParent clears the "parent" edge to the Step entity.
-func (m *StepMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[step.FieldParentID] = struct{}{}
+// ClearJob clears the "job" edge to the ImportJob entity.
+func (m *ImportItemMutation) ClearJob() {
+	m.clearedjob = true
+	m.clearedFields[importitem.FieldJobID] = struct{}{}
 }
 
-// ParentCleared reports if the "parent" edge to the Step entity was cleared.
-func (m *StepMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// JobCleared reports if the "job" edge to the ImportJob entity was cleared.
+func (m *ImportItemMutation) JobCleared() bool {
+	return m.clearedjob
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
+// JobIDs returns the "job" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *StepMutation) ParentIDs() (ids []uint64) {
-	if id := m.parent; id != nil {
+// JobID instead. It exists only for internal usage by the builders.
+func (m *ImportItemMutation) JobIDs() (ids []uint64) {
+	if id := m.job; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *StepMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
-}
-
-// AddChildIDs adds the "children" edge to the Step entity by ids.
-func (m *StepMutation) AddChildIDs(ids ...uint64) {
-	if m.children == nil {
-		m.children = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
-	}
-}
-
-// ClearChildren clears the "children" edge to the Step entity.
-func (m *StepMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the Step entity was cleared.
-func (m *StepMutation) ChildrenCleared() bool {
-	return m.clearedchildren
-}
-
-// RemoveChildIDs removes the "children" edge to the Step entity by IDs.
-func (m *StepMutation) RemoveChildIDs(ids ...uint64) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedChildren returns the removed IDs of the "children" edge to the Step entity.
-func (m *StepMutation) RemovedChildrenIDs() (ids []uint64) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *StepMutation) ChildrenIDs() (ids []uint64) {
-	for id := range m.children {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetChildren resets all changes to the "children" edge.
-func (m *StepMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetJob resets all changes to the "job" edge.
+func (m *ImportItemMutation) ResetJob() {
+	m.job = nil
+	m.clearedjob = false
 }
 
-// Where appends a list predicates to the StepMutation builder.
-func (m *StepMutation) Where(ps ...predicate.Step) {
+// Where appends a list predicates to the ImportItemMutation builder.
+func (m *ImportItemMutation) Where(ps ...predicate.ImportItem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StepMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ImportItemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StepMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Step, len(ps))
+func (m *ImportItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportItem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3889,57 +4016,57 @@ func (m *StepMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StepMutation) Op() Op {
+func (m *ImportItemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StepMutation) SetOp(op Op) {
+func (m *ImportItemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Step).
-func (m *StepMutation) Type() string {
+// Type returns the node type of this mutation (ImportItem).
+func (m *ImportItemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StepMutation) Fields() []string {
+func (m *ImportItemMutation) Fields() []string {
 	fields := make([]string, 0, 11)
-	if m.title != nil {
-		fields = append(fields, step.FieldTitle)
+	if m.job != nil {
+		fields = append(fields, importitem.FieldJobID)
 	}
-	if m.description != nil {
-		fields = append(fields, step.FieldDescription)
+	if m.seq != nil {
+		fields = append(fields, importitem.FieldSeq)
 	}
-	if m.is_challenge != nil {
-		fields = append(fields, step.FieldIsChallenge)
+	if m.kind != nil {
+		fields = append(fields, importitem.FieldKind)
 	}
-	if m.teacher_comment != nil {
-		fields = append(fields, step.FieldTeacherComment)
+	if m.ref != nil {
+		fields = append(fields, importitem.FieldRef)
 	}
-	if m.parent_comment != nil {
-		fields = append(fields, step.FieldParentComment)
+	if m.parent_ref != nil {
+		fields = append(fields, importitem.FieldParentRef)
 	}
-	if m.friend_comment != nil {
-		fields = append(fields, step.FieldFriendComment)
+	if m.target_ref != nil {
+		fields = append(fields, importitem.FieldTargetRef)
 	}
-	if m._type != nil {
-		fields = append(fields, step.FieldType)
+	if m.item_time != nil {
+		fields = append(fields, importitem.FieldItemTime)
 	}
-	if m.object_name != nil {
-		fields = append(fields, step.FieldObjectName)
+	if m.data != nil {
+		fields = append(fields, importitem.FieldData)
 	}
-	if m.created_at != nil {
-		fields = append(fields, step.FieldCreatedAt)
+	if m.status != nil {
+		fields = append(fields, importitem.FieldStatus)
 	}
-	if m.target != nil {
-		fields = append(fields, step.FieldRefTargetID)
+	if m.entity_id != nil {
+		fields = append(fields, importitem.FieldEntityID)
 	}
-	if m.parent != nil {
-		fields = append(fields, step.FieldParentID)
+	if m.error != nil {
+		fields = append(fields, importitem.FieldError)
 	}
 	return fields
 }
@@ -3947,30 +4074,30 @@ func (m *StepMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StepMutation) Field(name string) (ent.Value, bool) {
+func (m *ImportItemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case step.FieldTitle:
-		return m.Title()
-	case step.FieldDescription:
-		return m.Description()
-	case step.FieldIsChallenge:
-		return m.IsChallenge()
-	case step.FieldTeacherComment:
-		return m.TeacherComment()
-	case step.FieldParentComment:
-		return m.ParentComment()
-	case step.FieldFriendComment:
-		return m.FriendComment()
-	case step.FieldType:
-		return m.GetType()
-	case step.FieldObjectName:
-		return m.ObjectName()
-	case step.FieldCreatedAt:
-		return m.CreatedAt()
-	case step.FieldRefTargetID:
-		return m.RefTargetID()
-	case step.FieldParentID:
-		return m.ParentID()
+	case importitem.FieldJobID:
+		return m.JobID()
+	case importitem.FieldSeq:
+		return m.Seq()
+	case importitem.FieldKind:
+		return m.Kind()
+	case importitem.FieldRef:
+		return m.Ref()
+	case importitem.FieldParentRef:
+		return m.ParentRef()
+	case importitem.FieldTargetRef:
+		return m.TargetRef()
+	case importitem.FieldItemTime:
+		return m.ItemTime()
+	case importitem.FieldData:
+		return m.Data()
+	case importitem.FieldStatus:
+		return m.Status()
+	case importitem.FieldEntityID:
+		return m.EntityID()
+	case importitem.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -3978,126 +4105,132 @@ func (m *StepMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StepMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ImportItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case step.FieldTitle:
-		return m.OldTitle(ctx)
-	case step.FieldDescription:
-		return m.OldDescription(ctx)
-	case step.FieldIsChallenge:
-		return m.OldIsChallenge(ctx)
-	case step.FieldTeacherComment:
-		return m.OldTeacherComment(ctx)
-	case step.FieldParentComment:
-		return m.OldParentComment(ctx)
-	case step.FieldFriendComment:
-		return m.OldFriendComment(ctx)
-	case step.FieldType:
-		return m.OldType(ctx)
-	case step.FieldObjectName:
-		return m.OldObjectName(ctx)
-	case step.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case step.FieldRefTargetID:
-		return m.OldRefTargetID(ctx)
-	case step.FieldParentID:
-		return m.OldParentID(ctx)
+	case importitem.FieldJobID:
+		return m.OldJobID(ctx)
+	case importitem.FieldSeq:
+		return m.OldSeq(ctx)
+	case importitem.FieldKind:
+		return m.OldKind(ctx)
+	case importitem.FieldRef:
+		return m.OldRef(ctx)
+	case importitem.FieldParentRef:
+		return m.OldParentRef(ctx)
+	case importitem.FieldTargetRef:
+		return m.OldTargetRef(ctx)
+	case importitem.FieldItemTime:
+		return m.OldItemTime(ctx)
+	case importitem.FieldData:
+		return m.OldData(ctx)
+	case importitem.FieldStatus:
+		return m.OldStatus(ctx)
+	case importitem.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case importitem.FieldError:
+		return m.OldError(ctx)
 	}
-	return nil, fmt.Errorf("unknown Step field %s", name)
+	return nil, fmt.Errorf("unknown ImportItem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StepMutation) SetField(name string, value ent.Value) error {
+func (m *ImportItemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case step.FieldTitle:
-		v, ok := value.(string)
+	case importitem.FieldJobID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetJobID(v)
 		return nil
-	case step.FieldDescription:
-		v, ok := value.(string)
+	case importitem.FieldSeq:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetSeq(v)
 		return nil
-	case step.FieldIsChallenge:
-		v, ok := value.(bool)
+	case importitem.FieldKind:
+		v, ok := value.(importitem.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsChallenge(v)
+		m.SetKind(v)
 		return nil
-	case step.FieldTeacherComment:
-		v, ok := value.(map[string]interface{})
+	case importitem.FieldRef:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTeacherComment(v)
+		m.SetRef(v)
 		return nil
-	case step.FieldParentComment:
-		v, ok := value.(map[string]interface{})
+	case importitem.FieldParentRef:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentComment(v)
+		m.SetParentRef(v)
 		return nil
-	case step.FieldFriendComment:
-		v, ok := value.(map[string]interface{})
+	case importitem.FieldTargetRef:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFriendComment(v)
+		m.SetTargetRef(v)
 		return nil
-	case step.FieldType:
-		v, ok := value.(step.Type)
+	case importitem.FieldItemTime:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetItemTime(v)
 		return nil
-	case step.FieldObjectName:
-		v, ok := value.(string)
+	case importitem.FieldData:
+		v, ok := value.(*objects.ImportItemData)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetObjectName(v)
+		m.SetData(v)
 		return nil
-	case step.FieldCreatedAt:
-		v, ok := value.(int64)
+	case importitem.FieldStatus:
+		v, ok := value.(importitem.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetStatus(v)
 		return nil
-	case step.FieldRefTargetID:
+	case importitem.FieldEntityID:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefTargetID(v)
+		m.SetEntityID(v)
 		return nil
-	case step.FieldParentID:
-		v, ok := value.(uint64)
+	case importitem.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Step field %s", name)
+	return fmt.Errorf("unknown ImportItem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StepMutation) AddedFields() []string {
+func (m *ImportItemMutation) AddedFields() []string {
 	var fields []string
-	if m.addcreated_at != nil {
-		fields = append(fields, step.FieldCreatedAt)
+	if m.addseq != nil {
+		fields = append(fields, importitem.FieldSeq)
+	}
+	if m.additem_time != nil {
+		fields = append(fields, importitem.FieldItemTime)
+	}
+	if m.addentity_id != nil {
+		fields = append(fields, importitem.FieldEntityID)
 	}
 	return fields
 }
@@ -4105,10 +4238,14 @@ func (m *StepMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StepMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ImportItemMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case step.FieldCreatedAt:
-		return m.AddedCreatedAt()
+	case importitem.FieldSeq:
+		return m.AddedSeq()
+	case importitem.FieldItemTime:
+		return m.AddedItemTime()
+	case importitem.FieldEntityID:
+		return m.AddedEntityID()
 	}
 	return nil, false
 }
@@ -4116,299 +4253,237 @@ func (m *StepMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StepMutation) AddField(name string, value ent.Value) error {
+func (m *ImportItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case step.FieldCreatedAt:
+	case importitem.FieldSeq:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	case importitem.FieldItemTime:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCreatedAt(v)
+		m.AddItemTime(v)
+		return nil
+	case importitem.FieldEntityID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntityID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Step numeric field %s", name)
+	return fmt.Errorf("unknown ImportItem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StepMutation) ClearedFields() []string {
+func (m *ImportItemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(step.FieldTitle) {
-		fields = append(fields, step.FieldTitle)
-	}
-	if m.FieldCleared(step.FieldDescription) {
-		fields = append(fields, step.FieldDescription)
-	}
-	if m.FieldCleared(step.FieldTeacherComment) {
-		fields = append(fields, step.FieldTeacherComment)
-	}
-	if m.FieldCleared(step.FieldParentComment) {
-		fields = append(fields, step.FieldParentComment)
-	}
-	if m.FieldCleared(step.FieldFriendComment) {
-		fields = append(fields, step.FieldFriendComment)
+	if m.FieldCleared(importitem.FieldParentRef) {
+		fields = append(fields, importitem.FieldParentRef)
 	}
-	if m.FieldCleared(step.FieldObjectName) {
-		fields = append(fields, step.FieldObjectName)
+	if m.FieldCleared(importitem.FieldTargetRef) {
+		fields = append(fields, importitem.FieldTargetRef)
 	}
-	if m.FieldCleared(step.FieldRefTargetID) {
-		fields = append(fields, step.FieldRefTargetID)
+	if m.FieldCleared(importitem.FieldEntityID) {
+		fields = append(fields, importitem.FieldEntityID)
 	}
-	if m.FieldCleared(step.FieldParentID) {
-		fields = append(fields, step.FieldParentID)
+	if m.FieldCleared(importitem.FieldError) {
+		fields = append(fields, importitem.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StepMutation) FieldCleared(name string) bool {
+func (m *ImportItemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StepMutation) ClearField(name string) error {
+func (m *ImportItemMutation) ClearField(name string) error {
 	switch name {
-	case step.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case step.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case step.FieldTeacherComment:
-		m.ClearTeacherComment()
+	case importitem.FieldParentRef:
+		m.ClearParentRef()
 		return nil
-	case step.FieldParentComment:
-		m.ClearParentComment()
-		return nil
-	case step.FieldFriendComment:
-		m.ClearFriendComment()
-		return nil
-	case step.FieldObjectName:
-		m.ClearObjectName()
+	case importitem.FieldTargetRef:
+		m.ClearTargetRef()
 		return nil
-	case step.FieldRefTargetID:
-		m.ClearRefTargetID()
+	case importitem.FieldEntityID:
+		m.ClearEntityID()
 		return nil
-	case step.FieldParentID:
-		m.ClearParentID()
+	case importitem.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown Step nullable field %s", name)
+	return fmt.Errorf("unknown ImportItem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StepMutation) ResetField(name string) error {
+func (m *ImportItemMutation) ResetField(name string) error {
 	switch name {
-	case step.FieldTitle:
-		m.ResetTitle()
+	case importitem.FieldJobID:
+		m.ResetJobID()
 		return nil
-	case step.FieldDescription:
-		m.ResetDescription()
+	case importitem.FieldSeq:
+		m.ResetSeq()
 		return nil
-	case step.FieldIsChallenge:
-		m.ResetIsChallenge()
+	case importitem.FieldKind:
+		m.ResetKind()
 		return nil
-	case step.FieldTeacherComment:
-		m.ResetTeacherComment()
+	case importitem.FieldRef:
+		m.ResetRef()
 		return nil
-	case step.FieldParentComment:
-		m.ResetParentComment()
+	case importitem.FieldParentRef:
+		m.ResetParentRef()
 		return nil
-	case step.FieldFriendComment:
-		m.ResetFriendComment()
+	case importitem.FieldTargetRef:
+		m.ResetTargetRef()
 		return nil
-	case step.FieldType:
-		m.ResetType()
+	case importitem.FieldItemTime:
+		m.ResetItemTime()
 		return nil
-	case step.FieldObjectName:
-		m.ResetObjectName()
+	case importitem.FieldData:
+		m.ResetData()
 		return nil
-	case step.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case importitem.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case step.FieldRefTargetID:
-		m.ResetRefTargetID()
+	case importitem.FieldEntityID:
+		m.ResetEntityID()
 		return nil
-	case step.FieldParentID:
-		m.ResetParentID()
+	case importitem.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown Step field %s", name)
+	return fmt.Errorf("unknown ImportItem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StepMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.target != nil {
-		edges = append(edges, step.EdgeTarget)
-	}
-	if m.parent != nil {
-		edges = append(edges, step.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, step.EdgeChildren)
+func (m *ImportItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.job != nil {
+		edges = append(edges, importitem.EdgeJob)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StepMutation) AddedIDs(name string) []ent.Value {
+func (m *ImportItemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case step.EdgeTarget:
-		if id := m.target; id != nil {
-			return []ent.Value{*id}
-		}
-	case step.EdgeParent:
-		if id := m.parent; id != nil {
+	case importitem.EdgeJob:
+		if id := m.job; id != nil {
 			return []ent.Value{*id}
 		}
-	case step.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StepMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedchildren != nil {
-		edges = append(edges, step.EdgeChildren)
-	}
+func (m *ImportItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StepMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case step.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ImportItemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StepMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedtarget {
-		edges = append(edges, step.EdgeTarget)
-	}
-	if m.clearedparent {
-		edges = append(edges, step.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, step.EdgeChildren)
+func (m *ImportItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedjob {
+		edges = append(edges, importitem.EdgeJob)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StepMutation) EdgeCleared(name string) bool {
+func (m *ImportItemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case step.EdgeTarget:
-		return m.clearedtarget
-	case step.EdgeParent:
-		return m.clearedparent
-	case step.EdgeChildren:
-		return m.clearedchildren
+	case importitem.EdgeJob:
+		return m.clearedjob
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StepMutation) ClearEdge(name string) error {
+func (m *ImportItemMutation) ClearEdge(name string) error {
 	switch name {
-	case step.EdgeTarget:
-		m.ClearTarget()
-		return nil
-	case step.EdgeParent:
-		m.ClearParent()
+	case importitem.EdgeJob:
+		m.ClearJob()
 		return nil
 	}
-	return fmt.Errorf("unknown Step unique edge %s", name)
+	return fmt.Errorf("unknown ImportItem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StepMutation) ResetEdge(name string) error {
+func (m *ImportItemMutation) ResetEdge(name string) error {
 	switch name {
-	case step.EdgeTarget:
-		m.ResetTarget()
-		return nil
-	case step.EdgeParent:
-		m.ResetParent()
-		return nil
-	case step.EdgeChildren:
-		m.ResetChildren()
+	case importitem.EdgeJob:
+		m.ResetJob()
 		return nil
 	}
-	return fmt.Errorf("unknown Step edge %s", name)
+	return fmt.Errorf("unknown ImportItem edge %s", name)
 }
 
-// StepRateMutation represents an operation that mutates the StepRate nodes in the graph.
-type StepRateMutation struct {
+// ImportJobMutation represents an operation that mutates the ImportJob nodes in the graph.
+type ImportJobMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *uint64
-	user_id                   *string
-	top_target_id             *uint64
-	addtop_target_id          *int64
-	target_id                 *uint64
-	addtarget_id              *int64
-	step_id                   *uint64
-	addstep_id                *int64
-	weighted_value            *float64
-	addweighted_value         *float64
-	target_reasonableness     *int32
-	addtarget_reasonableness  *int32
-	target_clarity            *int32
-	addtarget_clarity         *int32
-	target_achievement        *int32
-	addtarget_achievement     *int32
-	reflection_improvement    *int32
-	addreflection_improvement *int32
-	innovation                *int32
-	addinnovation             *int32
-	basic_reliability         *int32
-	addbasic_reliability      *int32
-	skill_improvement         *int32
-	addskill_improvement      *int32
-	difficulty                *int32
-	adddifficulty             *int32
-	date                      *time.Time
-	clearedFields             map[string]struct{}
-	done                      bool
-	oldValue                  func(context.Context) (*StepRate, error)
-	predicates                []predicate.StepRate
-}
-
-var _ ent.Mutation = (*StepRateMutation)(nil)
-
-// steprateOption allows management of the mutation configuration using functional options.
-type steprateOption func(*StepRateMutation)
-
-// newStepRateMutation creates new mutation for the StepRate entity.
-func newStepRateMutation(c config, op Op, opts ...steprateOption) *StepRateMutation {
-	m := &StepRateMutation{
+	op                  Op
+	typ                 string
+	id                  *uint64
+	user_id             *string
+	parent_target_id    *uint64
+	addparent_target_id *int64
+	status              *importjob.Status
+	format              *importjob.Format
+	object_name         *string
+	total               *uint32
+	addtotal            *int32
+	succeeded           *uint32
+	addsucceeded        *int32
+	failed              *uint32
+	addfailed           *int32
+	error               *string
+	created_at          *int64
+	addcreated_at       *int64
+	finished_at         *int64
+	addfinished_at      *int64
+	clearedFields       map[string]struct{}
+	items               map[uint64]struct{}
+	removeditems        map[uint64]struct{}
+	cleareditems        bool
+	done                bool
+	oldValue            func(context.Context) (*ImportJob, error)
+	predicates          []predicate.ImportJob
+}
+
+var _ ent.Mutation = (*ImportJobMutation)(nil)
+
+// importjobOption allows management of the mutation configuration using functional options.
+type importjobOption func(*ImportJobMutation)
+
+// newImportJobMutation creates new mutation for the ImportJob entity.
+func newImportJobMutation(c config, op Op, opts ...importjobOption) *ImportJobMutation {
+	m := &ImportJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStepRate,
+		typ:           TypeImportJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4417,20 +4492,20 @@ func newStepRateMutation(c config, op Op, opts ...steprateOption) *StepRateMutat
 	return m
 }
 
-// withStepRateID sets the ID field of the mutation.
-func withStepRateID(id uint64) steprateOption {
-	return func(m *StepRateMutation) {
+// withImportJobID sets the ID field of the mutation.
+func withImportJobID(id uint64) importjobOption {
+	return func(m *ImportJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StepRate
+			value *ImportJob
 		)
-		m.oldValue = func(ctx context.Context) (*StepRate, error) {
+		m.oldValue = func(ctx context.Context) (*ImportJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().StepRate.Get(ctx, id)
+					value, err = m.Client().ImportJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4439,10 +4514,10 @@ func withStepRateID(id uint64) steprateOption {
 	}
 }
 
-// withStepRate sets the old StepRate of the mutation.
-func withStepRate(node *StepRate) steprateOption {
-	return func(m *StepRateMutation) {
-		m.oldValue = func(context.Context) (*StepRate, error) {
+// withImportJob sets the old ImportJob of the mutation.
+func withImportJob(node *ImportJob) importjobOption {
+	return func(m *ImportJobMutation) {
+		m.oldValue = func(context.Context) (*ImportJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4451,7 +4526,7 @@ func withStepRate(node *StepRate) steprateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StepRateMutation) Client() *Client {
+func (m ImportJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4459,7 +4534,7 @@ func (m StepRateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StepRateMutation) Tx() (*Tx, error) {
+func (m ImportJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4469,14 +4544,14 @@ func (m StepRateMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of StepRate entities.
-func (m *StepRateMutation) SetID(id uint64) {
+// operation is only accepted on creation of ImportJob entities.
+func (m *ImportJobMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StepRateMutation) ID() (id uint64, exists bool) {
+func (m *ImportJobMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4487,7 +4562,7 @@ func (m *StepRateMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StepRateMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *ImportJobMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4496,765 +4571,14117 @@ func (m *StepRateMutation) IDs(ctx context.Context) ([]uint64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StepRate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ImportJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *StepRateMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetUserID sets the "user_id" field.
+func (m *ImportJobMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ImportJobMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ImportJobMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetParentTargetID sets the "parent_target_id" field.
+func (m *ImportJobMutation) SetParentTargetID(u uint64) {
+	m.parent_target_id = &u
+	m.addparent_target_id = nil
+}
+
+// ParentTargetID returns the value of the "parent_target_id" field in the mutation.
+func (m *ImportJobMutation) ParentTargetID() (r uint64, exists bool) {
+	v := m.parent_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentTargetID returns the old "parent_target_id" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldParentTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentTargetID: %w", err)
+	}
+	return oldValue.ParentTargetID, nil
+}
+
+// AddParentTargetID adds u to the "parent_target_id" field.
+func (m *ImportJobMutation) AddParentTargetID(u int64) {
+	if m.addparent_target_id != nil {
+		*m.addparent_target_id += u
+	} else {
+		m.addparent_target_id = &u
+	}
+}
+
+// AddedParentTargetID returns the value that was added to the "parent_target_id" field in this mutation.
+func (m *ImportJobMutation) AddedParentTargetID() (r int64, exists bool) {
+	v := m.addparent_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearParentTargetID clears the value of the "parent_target_id" field.
+func (m *ImportJobMutation) ClearParentTargetID() {
+	m.parent_target_id = nil
+	m.addparent_target_id = nil
+	m.clearedFields[importjob.FieldParentTargetID] = struct{}{}
+}
+
+// ParentTargetIDCleared returns if the "parent_target_id" field was cleared in this mutation.
+func (m *ImportJobMutation) ParentTargetIDCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldParentTargetID]
+	return ok
+}
+
+// ResetParentTargetID resets all changes to the "parent_target_id" field.
+func (m *ImportJobMutation) ResetParentTargetID() {
+	m.parent_target_id = nil
+	m.addparent_target_id = nil
+	delete(m.clearedFields, importjob.FieldParentTargetID)
+}
+
+// SetStatus sets the "status" field.
+func (m *ImportJobMutation) SetStatus(i importjob.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportJobMutation) Status() (r importjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldStatus(ctx context.Context) (v importjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *ImportJobMutation) SetFormat(i importjob.Format) {
+	m.format = &i
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ImportJobMutation) Format() (r importjob.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFormat(ctx context.Context) (v importjob.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *ImportJobMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetObjectName sets the "object_name" field.
+func (m *ImportJobMutation) SetObjectName(s string) {
+	m.object_name = &s
+}
+
+// ObjectName returns the value of the "object_name" field in the mutation.
+func (m *ImportJobMutation) ObjectName() (r string, exists bool) {
+	v := m.object_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectName returns the old "object_name" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+	}
+	return oldValue.ObjectName, nil
+}
+
+// ResetObjectName resets all changes to the "object_name" field.
+func (m *ImportJobMutation) ResetObjectName() {
+	m.object_name = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *ImportJobMutation) SetTotal(u uint32) {
+	m.total = &u
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *ImportJobMutation) Total() (r uint32, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldTotal(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds u to the "total" field.
+func (m *ImportJobMutation) AddTotal(u int32) {
+	if m.addtotal != nil {
+		*m.addtotal += u
+	} else {
+		m.addtotal = &u
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *ImportJobMutation) AddedTotal() (r int32, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *ImportJobMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetSucceeded sets the "succeeded" field.
+func (m *ImportJobMutation) SetSucceeded(u uint32) {
+	m.succeeded = &u
+	m.addsucceeded = nil
+}
+
+// Succeeded returns the value of the "succeeded" field in the mutation.
+func (m *ImportJobMutation) Succeeded() (r uint32, exists bool) {
+	v := m.succeeded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSucceeded returns the old "succeeded" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldSucceeded(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSucceeded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSucceeded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSucceeded: %w", err)
+	}
+	return oldValue.Succeeded, nil
+}
+
+// AddSucceeded adds u to the "succeeded" field.
+func (m *ImportJobMutation) AddSucceeded(u int32) {
+	if m.addsucceeded != nil {
+		*m.addsucceeded += u
+	} else {
+		m.addsucceeded = &u
+	}
+}
+
+// AddedSucceeded returns the value that was added to the "succeeded" field in this mutation.
+func (m *ImportJobMutation) AddedSucceeded() (r int32, exists bool) {
+	v := m.addsucceeded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSucceeded resets all changes to the "succeeded" field.
+func (m *ImportJobMutation) ResetSucceeded() {
+	m.succeeded = nil
+	m.addsucceeded = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *ImportJobMutation) SetFailed(u uint32) {
+	m.failed = &u
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *ImportJobMutation) Failed() (r uint32, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFailed(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds u to the "failed" field.
+func (m *ImportJobMutation) AddFailed(u int32) {
+	if m.addfailed != nil {
+		*m.addfailed += u
+	} else {
+		m.addfailed = &u
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *ImportJobMutation) AddedFailed() (r int32, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *ImportJobMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetError sets the "error" field.
+func (m *ImportJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ImportJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ImportJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[importjob.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ImportJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ImportJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, importjob.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImportJobMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImportJobMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ImportJobMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ImportJobMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImportJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ImportJobMutation) SetFinishedAt(i int64) {
+	m.finished_at = &i
+	m.addfinished_at = nil
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ImportJobMutation) FinishedAt() (r int64, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFinishedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (m *ImportJobMutation) AddFinishedAt(i int64) {
+	if m.addfinished_at != nil {
+		*m.addfinished_at += i
+	} else {
+		m.addfinished_at = &i
+	}
+}
+
+// AddedFinishedAt returns the value that was added to the "finished_at" field in this mutation.
+func (m *ImportJobMutation) AddedFinishedAt() (r int64, exists bool) {
+	v := m.addfinished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ImportJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+	m.clearedFields[importjob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ImportJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ImportJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+	delete(m.clearedFields, importjob.FieldFinishedAt)
+}
+
+// AddItemIDs adds the "items" edge to the ImportItem entity by ids.
+func (m *ImportJobMutation) AddItemIDs(ids ...uint64) {
+	if m.items == nil {
+		m.items = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the ImportItem entity.
+func (m *ImportJobMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the ImportItem entity was cleared.
+func (m *ImportJobMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the ImportItem entity by IDs.
+func (m *ImportJobMutation) RemoveItemIDs(ids ...uint64) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the ImportItem entity.
+func (m *ImportJobMutation) RemovedItemsIDs() (ids []uint64) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *ImportJobMutation) ItemsIDs() (ids []uint64) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *ImportJobMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the ImportJobMutation builder.
+func (m *ImportJobMutation) Where(ps ...predicate.ImportJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportJob).
+func (m *ImportJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportJobMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user_id != nil {
+		fields = append(fields, importjob.FieldUserID)
+	}
+	if m.parent_target_id != nil {
+		fields = append(fields, importjob.FieldParentTargetID)
+	}
+	if m.status != nil {
+		fields = append(fields, importjob.FieldStatus)
+	}
+	if m.format != nil {
+		fields = append(fields, importjob.FieldFormat)
+	}
+	if m.object_name != nil {
+		fields = append(fields, importjob.FieldObjectName)
+	}
+	if m.total != nil {
+		fields = append(fields, importjob.FieldTotal)
+	}
+	if m.succeeded != nil {
+		fields = append(fields, importjob.FieldSucceeded)
+	}
+	if m.failed != nil {
+		fields = append(fields, importjob.FieldFailed)
+	}
+	if m.error != nil {
+		fields = append(fields, importjob.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, importjob.FieldCreatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldUserID:
+		return m.UserID()
+	case importjob.FieldParentTargetID:
+		return m.ParentTargetID()
+	case importjob.FieldStatus:
+		return m.Status()
+	case importjob.FieldFormat:
+		return m.Format()
+	case importjob.FieldObjectName:
+		return m.ObjectName()
+	case importjob.FieldTotal:
+		return m.Total()
+	case importjob.FieldSucceeded:
+		return m.Succeeded()
+	case importjob.FieldFailed:
+		return m.Failed()
+	case importjob.FieldError:
+		return m.Error()
+	case importjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case importjob.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importjob.FieldUserID:
+		return m.OldUserID(ctx)
+	case importjob.FieldParentTargetID:
+		return m.OldParentTargetID(ctx)
+	case importjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case importjob.FieldFormat:
+		return m.OldFormat(ctx)
+	case importjob.FieldObjectName:
+		return m.OldObjectName(ctx)
+	case importjob.FieldTotal:
+		return m.OldTotal(ctx)
+	case importjob.FieldSucceeded:
+		return m.OldSucceeded(ctx)
+	case importjob.FieldFailed:
+		return m.OldFailed(ctx)
+	case importjob.FieldError:
+		return m.OldError(ctx)
+	case importjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case importjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case importjob.FieldParentTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentTargetID(v)
+		return nil
+	case importjob.FieldStatus:
+		v, ok := value.(importjob.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importjob.FieldFormat:
+		v, ok := value.(importjob.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case importjob.FieldObjectName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectName(v)
+		return nil
+	case importjob.FieldTotal:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case importjob.FieldSucceeded:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSucceeded(v)
+		return nil
+	case importjob.FieldFailed:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailed(v)
+		return nil
+	case importjob.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case importjob.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case importjob.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addparent_target_id != nil {
+		fields = append(fields, importjob.FieldParentTargetID)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, importjob.FieldTotal)
+	}
+	if m.addsucceeded != nil {
+		fields = append(fields, importjob.FieldSucceeded)
+	}
+	if m.addfailed != nil {
+		fields = append(fields, importjob.FieldFailed)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, importjob.FieldCreatedAt)
+	}
+	if m.addfinished_at != nil {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldParentTargetID:
+		return m.AddedParentTargetID()
+	case importjob.FieldTotal:
+		return m.AddedTotal()
+	case importjob.FieldSucceeded:
+		return m.AddedSucceeded()
+	case importjob.FieldFailed:
+		return m.AddedFailed()
+	case importjob.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case importjob.FieldFinishedAt:
+		return m.AddedFinishedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldParentTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentTargetID(v)
+		return nil
+	case importjob.FieldTotal:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case importjob.FieldSucceeded:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSucceeded(v)
+		return nil
+	case importjob.FieldFailed:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailed(v)
+		return nil
+	case importjob.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case importjob.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importjob.FieldParentTargetID) {
+		fields = append(fields, importjob.FieldParentTargetID)
+	}
+	if m.FieldCleared(importjob.FieldError) {
+		fields = append(fields, importjob.FieldError)
+	}
+	if m.FieldCleared(importjob.FieldFinishedAt) {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportJobMutation) ClearField(name string) error {
+	switch name {
+	case importjob.FieldParentTargetID:
+		m.ClearParentTargetID()
+		return nil
+	case importjob.FieldError:
+		m.ClearError()
+		return nil
+	case importjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportJobMutation) ResetField(name string) error {
+	switch name {
+	case importjob.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case importjob.FieldParentTargetID:
+		m.ResetParentTargetID()
+		return nil
+	case importjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importjob.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case importjob.FieldObjectName:
+		m.ResetObjectName()
+		return nil
+	case importjob.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case importjob.FieldSucceeded:
+		m.ResetSucceeded()
+		return nil
+	case importjob.FieldFailed:
+		m.ResetFailed()
+		return nil
+	case importjob.FieldError:
+		m.ResetError()
+		return nil
+	case importjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case importjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.items != nil {
+		edges = append(edges, importjob.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importjob.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeditems != nil {
+		edges = append(edges, importjob.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportJobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case importjob.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditems {
+		edges = append(edges, importjob.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importjob.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportJobMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ImportJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportJobMutation) ResetEdge(name string) error {
+	switch name {
+	case importjob.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob edge %s", name)
+}
+
+// PortraitMutation represents an operation that mutates the Portrait nodes in the graph.
+type PortraitMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	user_id       *string
+	dimension     *portrait.Dimension
+	value         *map[string]interface{}
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Portrait, error)
+	predicates    []predicate.Portrait
+}
+
+var _ ent.Mutation = (*PortraitMutation)(nil)
+
+// portraitOption allows management of the mutation configuration using functional options.
+type portraitOption func(*PortraitMutation)
+
+// newPortraitMutation creates new mutation for the Portrait entity.
+func newPortraitMutation(c config, op Op, opts ...portraitOption) *PortraitMutation {
+	m := &PortraitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePortrait,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPortraitID sets the ID field of the mutation.
+func withPortraitID(id uint64) portraitOption {
+	return func(m *PortraitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Portrait
+		)
+		m.oldValue = func(ctx context.Context) (*Portrait, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Portrait.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPortrait sets the old Portrait of the mutation.
+func withPortrait(node *Portrait) portraitOption {
+	return func(m *PortraitMutation) {
+		m.oldValue = func(context.Context) (*Portrait, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PortraitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PortraitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Portrait entities.
+func (m *PortraitMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PortraitMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PortraitMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Portrait.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PortraitMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PortraitMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Portrait entity.
+// If the Portrait object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortraitMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PortraitMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetDimension sets the "dimension" field.
+func (m *PortraitMutation) SetDimension(po portrait.Dimension) {
+	m.dimension = &po
+}
+
+// Dimension returns the value of the "dimension" field in the mutation.
+func (m *PortraitMutation) Dimension() (r portrait.Dimension, exists bool) {
+	v := m.dimension
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDimension returns the old "dimension" field's value of the Portrait entity.
+// If the Portrait object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortraitMutation) OldDimension(ctx context.Context) (v portrait.Dimension, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDimension is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDimension requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDimension: %w", err)
+	}
+	return oldValue.Dimension, nil
+}
+
+// ResetDimension resets all changes to the "dimension" field.
+func (m *PortraitMutation) ResetDimension() {
+	m.dimension = nil
+}
+
+// SetValue sets the "value" field.
+func (m *PortraitMutation) SetValue(value map[string]interface{}) {
+	m.value = &value
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *PortraitMutation) Value() (r map[string]interface{}, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Portrait entity.
+// If the Portrait object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortraitMutation) OldValue(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *PortraitMutation) ResetValue() {
+	m.value = nil
+}
+
+// Where appends a list predicates to the PortraitMutation builder.
+func (m *PortraitMutation) Where(ps ...predicate.Portrait) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PortraitMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PortraitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Portrait, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PortraitMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PortraitMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Portrait).
+func (m *PortraitMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PortraitMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, portrait.FieldUserID)
+	}
+	if m.dimension != nil {
+		fields = append(fields, portrait.FieldDimension)
+	}
+	if m.value != nil {
+		fields = append(fields, portrait.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PortraitMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case portrait.FieldUserID:
+		return m.UserID()
+	case portrait.FieldDimension:
+		return m.Dimension()
+	case portrait.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PortraitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case portrait.FieldUserID:
+		return m.OldUserID(ctx)
+	case portrait.FieldDimension:
+		return m.OldDimension(ctx)
+	case portrait.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown Portrait field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PortraitMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case portrait.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case portrait.FieldDimension:
+		v, ok := value.(portrait.Dimension)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDimension(v)
+		return nil
+	case portrait.FieldValue:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Portrait field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PortraitMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PortraitMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PortraitMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Portrait numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PortraitMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PortraitMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PortraitMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Portrait nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PortraitMutation) ResetField(name string) error {
+	switch name {
+	case portrait.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case portrait.FieldDimension:
+		m.ResetDimension()
+		return nil
+	case portrait.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown Portrait field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PortraitMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PortraitMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PortraitMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PortraitMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PortraitMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PortraitMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PortraitMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Portrait unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PortraitMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Portrait edge %s", name)
+}
+
+// ReportMutation represents an operation that mutates the Report nodes in the graph.
+type ReportMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint64
+	user_id              *string
+	target_id            *uint64
+	addtarget_id         *int64
+	start_date           *string
+	end_date             *string
+	stat_unit            *report.StatUnit
+	media_step_ids       *[]uint64
+	appendmedia_step_ids []uint64
+	status               *report.Status
+	html_object_name     *string
+	pdf_object_name      *string
+	error                *string
+	created_at           *int64
+	addcreated_at        *int64
+	finished_at          *int64
+	addfinished_at       *int64
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*Report, error)
+	predicates           []predicate.Report
+}
+
+var _ ent.Mutation = (*ReportMutation)(nil)
+
+// reportOption allows management of the mutation configuration using functional options.
+type reportOption func(*ReportMutation)
+
+// newReportMutation creates new mutation for the Report entity.
+func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
+	m := &ReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportID sets the ID field of the mutation.
+func withReportID(id uint64) reportOption {
+	return func(m *ReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Report
+		)
+		m.oldValue = func(ctx context.Context) (*Report, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Report.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReport sets the old Report of the mutation.
+func withReport(node *Report) reportOption {
+	return func(m *ReportMutation) {
+		m.oldValue = func(context.Context) (*Report, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Report entities.
+func (m *ReportMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReportMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReportMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReportMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *ReportMutation) SetTargetID(u uint64) {
+	m.target_id = &u
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *ReportMutation) TargetID() (r uint64, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (m *ReportMutation) AddTargetID(u int64) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += u
+	} else {
+		m.addtarget_id = &u
+	}
+}
+
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *ReportMutation) AddedTargetID() (r int64, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *ReportMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *ReportMutation) SetStartDate(s string) {
+	m.start_date = &s
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *ReportMutation) StartDate() (r string, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStartDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *ReportMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *ReportMutation) SetEndDate(s string) {
+	m.end_date = &s
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *ReportMutation) EndDate() (r string, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldEndDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *ReportMutation) ResetEndDate() {
+	m.end_date = nil
+}
+
+// SetStatUnit sets the "stat_unit" field.
+func (m *ReportMutation) SetStatUnit(ru report.StatUnit) {
+	m.stat_unit = &ru
+}
+
+// StatUnit returns the value of the "stat_unit" field in the mutation.
+func (m *ReportMutation) StatUnit() (r report.StatUnit, exists bool) {
+	v := m.stat_unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatUnit returns the old "stat_unit" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStatUnit(ctx context.Context) (v report.StatUnit, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatUnit: %w", err)
+	}
+	return oldValue.StatUnit, nil
+}
+
+// ResetStatUnit resets all changes to the "stat_unit" field.
+func (m *ReportMutation) ResetStatUnit() {
+	m.stat_unit = nil
+}
+
+// SetMediaStepIds sets the "media_step_ids" field.
+func (m *ReportMutation) SetMediaStepIds(u []uint64) {
+	m.media_step_ids = &u
+	m.appendmedia_step_ids = nil
+}
+
+// MediaStepIds returns the value of the "media_step_ids" field in the mutation.
+func (m *ReportMutation) MediaStepIds() (r []uint64, exists bool) {
+	v := m.media_step_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaStepIds returns the old "media_step_ids" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldMediaStepIds(ctx context.Context) (v []uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaStepIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaStepIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaStepIds: %w", err)
+	}
+	return oldValue.MediaStepIds, nil
+}
+
+// AppendMediaStepIds adds u to the "media_step_ids" field.
+func (m *ReportMutation) AppendMediaStepIds(u []uint64) {
+	m.appendmedia_step_ids = append(m.appendmedia_step_ids, u...)
+}
+
+// AppendedMediaStepIds returns the list of values that were appended to the "media_step_ids" field in this mutation.
+func (m *ReportMutation) AppendedMediaStepIds() ([]uint64, bool) {
+	if len(m.appendmedia_step_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmedia_step_ids, true
+}
+
+// ClearMediaStepIds clears the value of the "media_step_ids" field.
+func (m *ReportMutation) ClearMediaStepIds() {
+	m.media_step_ids = nil
+	m.appendmedia_step_ids = nil
+	m.clearedFields[report.FieldMediaStepIds] = struct{}{}
+}
+
+// MediaStepIdsCleared returns if the "media_step_ids" field was cleared in this mutation.
+func (m *ReportMutation) MediaStepIdsCleared() bool {
+	_, ok := m.clearedFields[report.FieldMediaStepIds]
+	return ok
+}
+
+// ResetMediaStepIds resets all changes to the "media_step_ids" field.
+func (m *ReportMutation) ResetMediaStepIds() {
+	m.media_step_ids = nil
+	m.appendmedia_step_ids = nil
+	delete(m.clearedFields, report.FieldMediaStepIds)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReportMutation) SetStatus(r report.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReportMutation) Status() (r report.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStatus(ctx context.Context) (v report.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetHTMLObjectName sets the "html_object_name" field.
+func (m *ReportMutation) SetHTMLObjectName(s string) {
+	m.html_object_name = &s
+}
+
+// HTMLObjectName returns the value of the "html_object_name" field in the mutation.
+func (m *ReportMutation) HTMLObjectName() (r string, exists bool) {
+	v := m.html_object_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHTMLObjectName returns the old "html_object_name" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldHTMLObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHTMLObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHTMLObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHTMLObjectName: %w", err)
+	}
+	return oldValue.HTMLObjectName, nil
+}
+
+// ClearHTMLObjectName clears the value of the "html_object_name" field.
+func (m *ReportMutation) ClearHTMLObjectName() {
+	m.html_object_name = nil
+	m.clearedFields[report.FieldHTMLObjectName] = struct{}{}
+}
+
+// HTMLObjectNameCleared returns if the "html_object_name" field was cleared in this mutation.
+func (m *ReportMutation) HTMLObjectNameCleared() bool {
+	_, ok := m.clearedFields[report.FieldHTMLObjectName]
+	return ok
+}
+
+// ResetHTMLObjectName resets all changes to the "html_object_name" field.
+func (m *ReportMutation) ResetHTMLObjectName() {
+	m.html_object_name = nil
+	delete(m.clearedFields, report.FieldHTMLObjectName)
+}
+
+// SetPdfObjectName sets the "pdf_object_name" field.
+func (m *ReportMutation) SetPdfObjectName(s string) {
+	m.pdf_object_name = &s
+}
+
+// PdfObjectName returns the value of the "pdf_object_name" field in the mutation.
+func (m *ReportMutation) PdfObjectName() (r string, exists bool) {
+	v := m.pdf_object_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPdfObjectName returns the old "pdf_object_name" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldPdfObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPdfObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPdfObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPdfObjectName: %w", err)
+	}
+	return oldValue.PdfObjectName, nil
+}
+
+// ClearPdfObjectName clears the value of the "pdf_object_name" field.
+func (m *ReportMutation) ClearPdfObjectName() {
+	m.pdf_object_name = nil
+	m.clearedFields[report.FieldPdfObjectName] = struct{}{}
+}
+
+// PdfObjectNameCleared returns if the "pdf_object_name" field was cleared in this mutation.
+func (m *ReportMutation) PdfObjectNameCleared() bool {
+	_, ok := m.clearedFields[report.FieldPdfObjectName]
+	return ok
+}
+
+// ResetPdfObjectName resets all changes to the "pdf_object_name" field.
+func (m *ReportMutation) ResetPdfObjectName() {
+	m.pdf_object_name = nil
+	delete(m.clearedFields, report.FieldPdfObjectName)
+}
+
+// SetError sets the "error" field.
+func (m *ReportMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ReportMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ReportMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[report.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ReportMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[report.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ReportMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, report.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ReportMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReportMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ReportMutation) SetFinishedAt(i int64) {
+	m.finished_at = &i
+	m.addfinished_at = nil
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ReportMutation) FinishedAt() (r int64, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldFinishedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (m *ReportMutation) AddFinishedAt(i int64) {
+	if m.addfinished_at != nil {
+		*m.addfinished_at += i
+	} else {
+		m.addfinished_at = &i
+	}
+}
+
+// AddedFinishedAt returns the value that was added to the "finished_at" field in this mutation.
+func (m *ReportMutation) AddedFinishedAt() (r int64, exists bool) {
+	v := m.addfinished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ReportMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+	m.clearedFields[report.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ReportMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[report.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ReportMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	m.addfinished_at = nil
+	delete(m.clearedFields, report.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the ReportMutation builder.
+func (m *ReportMutation) Where(ps ...predicate.Report) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Report, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Report).
+func (m *ReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.user_id != nil {
+		fields = append(fields, report.FieldUserID)
+	}
+	if m.target_id != nil {
+		fields = append(fields, report.FieldTargetID)
+	}
+	if m.start_date != nil {
+		fields = append(fields, report.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, report.FieldEndDate)
+	}
+	if m.stat_unit != nil {
+		fields = append(fields, report.FieldStatUnit)
+	}
+	if m.media_step_ids != nil {
+		fields = append(fields, report.FieldMediaStepIds)
+	}
+	if m.status != nil {
+		fields = append(fields, report.FieldStatus)
+	}
+	if m.html_object_name != nil {
+		fields = append(fields, report.FieldHTMLObjectName)
+	}
+	if m.pdf_object_name != nil {
+		fields = append(fields, report.FieldPdfObjectName)
+	}
+	if m.error != nil {
+		fields = append(fields, report.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, report.FieldCreatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, report.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldUserID:
+		return m.UserID()
+	case report.FieldTargetID:
+		return m.TargetID()
+	case report.FieldStartDate:
+		return m.StartDate()
+	case report.FieldEndDate:
+		return m.EndDate()
+	case report.FieldStatUnit:
+		return m.StatUnit()
+	case report.FieldMediaStepIds:
+		return m.MediaStepIds()
+	case report.FieldStatus:
+		return m.Status()
+	case report.FieldHTMLObjectName:
+		return m.HTMLObjectName()
+	case report.FieldPdfObjectName:
+		return m.PdfObjectName()
+	case report.FieldError:
+		return m.Error()
+	case report.FieldCreatedAt:
+		return m.CreatedAt()
+	case report.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case report.FieldUserID:
+		return m.OldUserID(ctx)
+	case report.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case report.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case report.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case report.FieldStatUnit:
+		return m.OldStatUnit(ctx)
+	case report.FieldMediaStepIds:
+		return m.OldMediaStepIds(ctx)
+	case report.FieldStatus:
+		return m.OldStatus(ctx)
+	case report.FieldHTMLObjectName:
+		return m.OldHTMLObjectName(ctx)
+	case report.FieldPdfObjectName:
+		return m.OldPdfObjectName(ctx)
+	case report.FieldError:
+		return m.OldError(ctx)
+	case report.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case report.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Report field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case report.FieldTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case report.FieldStartDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case report.FieldEndDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case report.FieldStatUnit:
+		v, ok := value.(report.StatUnit)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatUnit(v)
+		return nil
+	case report.FieldMediaStepIds:
+		v, ok := value.([]uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaStepIds(v)
+		return nil
+	case report.FieldStatus:
+		v, ok := value.(report.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case report.FieldHTMLObjectName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHTMLObjectName(v)
+		return nil
+	case report.FieldPdfObjectName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPdfObjectName(v)
+		return nil
+	case report.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case report.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case report.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_id != nil {
+		fields = append(fields, report.FieldTargetID)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, report.FieldCreatedAt)
+	}
+	if m.addfinished_at != nil {
+		fields = append(fields, report.FieldFinishedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldTargetID:
+		return m.AddedTargetID()
+	case report.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case report.FieldFinishedAt:
+		return m.AddedFinishedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case report.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case report.FieldFinishedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(report.FieldMediaStepIds) {
+		fields = append(fields, report.FieldMediaStepIds)
+	}
+	if m.FieldCleared(report.FieldHTMLObjectName) {
+		fields = append(fields, report.FieldHTMLObjectName)
+	}
+	if m.FieldCleared(report.FieldPdfObjectName) {
+		fields = append(fields, report.FieldPdfObjectName)
+	}
+	if m.FieldCleared(report.FieldError) {
+		fields = append(fields, report.FieldError)
+	}
+	if m.FieldCleared(report.FieldFinishedAt) {
+		fields = append(fields, report.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportMutation) ClearField(name string) error {
+	switch name {
+	case report.FieldMediaStepIds:
+		m.ClearMediaStepIds()
+		return nil
+	case report.FieldHTMLObjectName:
+		m.ClearHTMLObjectName()
+		return nil
+	case report.FieldPdfObjectName:
+		m.ClearPdfObjectName()
+		return nil
+	case report.FieldError:
+		m.ClearError()
+		return nil
+	case report.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportMutation) ResetField(name string) error {
+	switch name {
+	case report.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case report.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case report.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case report.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case report.FieldStatUnit:
+		m.ResetStatUnit()
+		return nil
+	case report.FieldMediaStepIds:
+		m.ResetMediaStepIds()
+		return nil
+	case report.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case report.FieldHTMLObjectName:
+		m.ResetHTMLObjectName()
+		return nil
+	case report.FieldPdfObjectName:
+		m.ResetPdfObjectName()
+		return nil
+	case report.FieldError:
+		m.ResetError()
+		return nil
+	case report.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case report.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Report unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Report edge %s", name)
+}
+
+// ReviewRequestMutation represents an operation that mutates the ReviewRequest nodes in the graph.
+type ReviewRequestMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uint64
+	user_id            *string
+	step_id            *uint64
+	addstep_id         *int64
+	roles              *[]string
+	appendroles        []string
+	reviewer_ids       *[]string
+	appendreviewer_ids []string
+	emails             *[]string
+	appendemails       []string
+	message            *string
+	share_to           *string
+	status             *reviewrequest.Status
+	remind_at          *int64
+	addremind_at       *int64
+	reminded_at        *int64
+	addreminded_at     *int64
+	expires_at         *int64
+	addexpires_at      *int64
+	comment_id         *uint64
+	addcomment_id      *int64
+	commented_at       *int64
+	addcommented_at    *int64
+	created_at         *int64
+	addcreated_at      *int64
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ReviewRequest, error)
+	predicates         []predicate.ReviewRequest
+}
+
+var _ ent.Mutation = (*ReviewRequestMutation)(nil)
+
+// reviewrequestOption allows management of the mutation configuration using functional options.
+type reviewrequestOption func(*ReviewRequestMutation)
+
+// newReviewRequestMutation creates new mutation for the ReviewRequest entity.
+func newReviewRequestMutation(c config, op Op, opts ...reviewrequestOption) *ReviewRequestMutation {
+	m := &ReviewRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewRequestID sets the ID field of the mutation.
+func withReviewRequestID(id uint64) reviewrequestOption {
+	return func(m *ReviewRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReviewRequest
+		)
+		m.oldValue = func(ctx context.Context) (*ReviewRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReviewRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReviewRequest sets the old ReviewRequest of the mutation.
+func withReviewRequest(node *ReviewRequest) reviewrequestOption {
+	return func(m *ReviewRequestMutation) {
+		m.oldValue = func(context.Context) (*ReviewRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReviewRequest entities.
+func (m *ReviewRequestMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewRequestMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewRequestMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReviewRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReviewRequestMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReviewRequestMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReviewRequestMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetStepID sets the "step_id" field.
+func (m *ReviewRequestMutation) SetStepID(u uint64) {
+	m.step_id = &u
+	m.addstep_id = nil
+}
+
+// StepID returns the value of the "step_id" field in the mutation.
+func (m *ReviewRequestMutation) StepID() (r uint64, exists bool) {
+	v := m.step_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepID returns the old "step_id" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldStepID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
+	}
+	return oldValue.StepID, nil
+}
+
+// AddStepID adds u to the "step_id" field.
+func (m *ReviewRequestMutation) AddStepID(u int64) {
+	if m.addstep_id != nil {
+		*m.addstep_id += u
+	} else {
+		m.addstep_id = &u
+	}
+}
+
+// AddedStepID returns the value that was added to the "step_id" field in this mutation.
+func (m *ReviewRequestMutation) AddedStepID() (r int64, exists bool) {
+	v := m.addstep_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepID resets all changes to the "step_id" field.
+func (m *ReviewRequestMutation) ResetStepID() {
+	m.step_id = nil
+	m.addstep_id = nil
+}
+
+// SetRoles sets the "roles" field.
+func (m *ReviewRequestMutation) SetRoles(s []string) {
+	m.roles = &s
+	m.appendroles = nil
+}
+
+// Roles returns the value of the "roles" field in the mutation.
+func (m *ReviewRequestMutation) Roles() (r []string, exists bool) {
+	v := m.roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoles returns the old "roles" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldRoles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
+	}
+	return oldValue.Roles, nil
+}
+
+// AppendRoles adds s to the "roles" field.
+func (m *ReviewRequestMutation) AppendRoles(s []string) {
+	m.appendroles = append(m.appendroles, s...)
+}
+
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *ReviewRequestMutation) AppendedRoles() ([]string, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
+	}
+	return m.appendroles, true
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (m *ReviewRequestMutation) ClearRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	m.clearedFields[reviewrequest.FieldRoles] = struct{}{}
+}
+
+// RolesCleared returns if the "roles" field was cleared in this mutation.
+func (m *ReviewRequestMutation) RolesCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldRoles]
+	return ok
+}
+
+// ResetRoles resets all changes to the "roles" field.
+func (m *ReviewRequestMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	delete(m.clearedFields, reviewrequest.FieldRoles)
+}
+
+// SetReviewerIds sets the "reviewer_ids" field.
+func (m *ReviewRequestMutation) SetReviewerIds(s []string) {
+	m.reviewer_ids = &s
+	m.appendreviewer_ids = nil
+}
+
+// ReviewerIds returns the value of the "reviewer_ids" field in the mutation.
+func (m *ReviewRequestMutation) ReviewerIds() (r []string, exists bool) {
+	v := m.reviewer_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerIds returns the old "reviewer_ids" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldReviewerIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerIds: %w", err)
+	}
+	return oldValue.ReviewerIds, nil
+}
+
+// AppendReviewerIds adds s to the "reviewer_ids" field.
+func (m *ReviewRequestMutation) AppendReviewerIds(s []string) {
+	m.appendreviewer_ids = append(m.appendreviewer_ids, s...)
+}
+
+// AppendedReviewerIds returns the list of values that were appended to the "reviewer_ids" field in this mutation.
+func (m *ReviewRequestMutation) AppendedReviewerIds() ([]string, bool) {
+	if len(m.appendreviewer_ids) == 0 {
+		return nil, false
+	}
+	return m.appendreviewer_ids, true
+}
+
+// ClearReviewerIds clears the value of the "reviewer_ids" field.
+func (m *ReviewRequestMutation) ClearReviewerIds() {
+	m.reviewer_ids = nil
+	m.appendreviewer_ids = nil
+	m.clearedFields[reviewrequest.FieldReviewerIds] = struct{}{}
+}
+
+// ReviewerIdsCleared returns if the "reviewer_ids" field was cleared in this mutation.
+func (m *ReviewRequestMutation) ReviewerIdsCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldReviewerIds]
+	return ok
+}
+
+// ResetReviewerIds resets all changes to the "reviewer_ids" field.
+func (m *ReviewRequestMutation) ResetReviewerIds() {
+	m.reviewer_ids = nil
+	m.appendreviewer_ids = nil
+	delete(m.clearedFields, reviewrequest.FieldReviewerIds)
+}
+
+// SetEmails sets the "emails" field.
+func (m *ReviewRequestMutation) SetEmails(s []string) {
+	m.emails = &s
+	m.appendemails = nil
+}
+
+// Emails returns the value of the "emails" field in the mutation.
+func (m *ReviewRequestMutation) Emails() (r []string, exists bool) {
+	v := m.emails
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmails returns the old "emails" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldEmails(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmails: %w", err)
+	}
+	return oldValue.Emails, nil
+}
+
+// AppendEmails adds s to the "emails" field.
+func (m *ReviewRequestMutation) AppendEmails(s []string) {
+	m.appendemails = append(m.appendemails, s...)
+}
+
+// AppendedEmails returns the list of values that were appended to the "emails" field in this mutation.
+func (m *ReviewRequestMutation) AppendedEmails() ([]string, bool) {
+	if len(m.appendemails) == 0 {
+		return nil, false
+	}
+	return m.appendemails, true
+}
+
+// ClearEmails clears the value of the "emails" field.
+func (m *ReviewRequestMutation) ClearEmails() {
+	m.emails = nil
+	m.appendemails = nil
+	m.clearedFields[reviewrequest.FieldEmails] = struct{}{}
+}
+
+// EmailsCleared returns if the "emails" field was cleared in this mutation.
+func (m *ReviewRequestMutation) EmailsCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldEmails]
+	return ok
+}
+
+// ResetEmails resets all changes to the "emails" field.
+func (m *ReviewRequestMutation) ResetEmails() {
+	m.emails = nil
+	m.appendemails = nil
+	delete(m.clearedFields, reviewrequest.FieldEmails)
+}
+
+// SetMessage sets the "message" field.
+func (m *ReviewRequestMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ReviewRequestMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ReviewRequestMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[reviewrequest.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ReviewRequestMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ReviewRequestMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, reviewrequest.FieldMessage)
+}
+
+// SetShareTo sets the "share_to" field.
+func (m *ReviewRequestMutation) SetShareTo(s string) {
+	m.share_to = &s
+}
+
+// ShareTo returns the value of the "share_to" field in the mutation.
+func (m *ReviewRequestMutation) ShareTo() (r string, exists bool) {
+	v := m.share_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareTo returns the old "share_to" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldShareTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareTo: %w", err)
+	}
+	return oldValue.ShareTo, nil
+}
+
+// ClearShareTo clears the value of the "share_to" field.
+func (m *ReviewRequestMutation) ClearShareTo() {
+	m.share_to = nil
+	m.clearedFields[reviewrequest.FieldShareTo] = struct{}{}
+}
+
+// ShareToCleared returns if the "share_to" field was cleared in this mutation.
+func (m *ReviewRequestMutation) ShareToCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldShareTo]
+	return ok
+}
+
+// ResetShareTo resets all changes to the "share_to" field.
+func (m *ReviewRequestMutation) ResetShareTo() {
+	m.share_to = nil
+	delete(m.clearedFields, reviewrequest.FieldShareTo)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReviewRequestMutation) SetStatus(r reviewrequest.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReviewRequestMutation) Status() (r reviewrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldStatus(ctx context.Context) (v reviewrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReviewRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (m *ReviewRequestMutation) SetRemindAt(i int64) {
+	m.remind_at = &i
+	m.addremind_at = nil
+}
+
+// RemindAt returns the value of the "remind_at" field in the mutation.
+func (m *ReviewRequestMutation) RemindAt() (r int64, exists bool) {
+	v := m.remind_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemindAt returns the old "remind_at" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldRemindAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemindAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemindAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemindAt: %w", err)
+	}
+	return oldValue.RemindAt, nil
+}
+
+// AddRemindAt adds i to the "remind_at" field.
+func (m *ReviewRequestMutation) AddRemindAt(i int64) {
+	if m.addremind_at != nil {
+		*m.addremind_at += i
+	} else {
+		m.addremind_at = &i
+	}
+}
+
+// AddedRemindAt returns the value that was added to the "remind_at" field in this mutation.
+func (m *ReviewRequestMutation) AddedRemindAt() (r int64, exists bool) {
+	v := m.addremind_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRemindAt resets all changes to the "remind_at" field.
+func (m *ReviewRequestMutation) ResetRemindAt() {
+	m.remind_at = nil
+	m.addremind_at = nil
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (m *ReviewRequestMutation) SetRemindedAt(i int64) {
+	m.reminded_at = &i
+	m.addreminded_at = nil
+}
+
+// RemindedAt returns the value of the "reminded_at" field in the mutation.
+func (m *ReviewRequestMutation) RemindedAt() (r int64, exists bool) {
+	v := m.reminded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemindedAt returns the old "reminded_at" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldRemindedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemindedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemindedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemindedAt: %w", err)
+	}
+	return oldValue.RemindedAt, nil
+}
+
+// AddRemindedAt adds i to the "reminded_at" field.
+func (m *ReviewRequestMutation) AddRemindedAt(i int64) {
+	if m.addreminded_at != nil {
+		*m.addreminded_at += i
+	} else {
+		m.addreminded_at = &i
+	}
+}
+
+// AddedRemindedAt returns the value that was added to the "reminded_at" field in this mutation.
+func (m *ReviewRequestMutation) AddedRemindedAt() (r int64, exists bool) {
+	v := m.addreminded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (m *ReviewRequestMutation) ClearRemindedAt() {
+	m.reminded_at = nil
+	m.addreminded_at = nil
+	m.clearedFields[reviewrequest.FieldRemindedAt] = struct{}{}
+}
+
+// RemindedAtCleared returns if the "reminded_at" field was cleared in this mutation.
+func (m *ReviewRequestMutation) RemindedAtCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldRemindedAt]
+	return ok
+}
+
+// ResetRemindedAt resets all changes to the "reminded_at" field.
+func (m *ReviewRequestMutation) ResetRemindedAt() {
+	m.reminded_at = nil
+	m.addreminded_at = nil
+	delete(m.clearedFields, reviewrequest.FieldRemindedAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ReviewRequestMutation) SetExpiresAt(i int64) {
+	m.expires_at = &i
+	m.addexpires_at = nil
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ReviewRequestMutation) ExpiresAt() (r int64, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldExpiresAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (m *ReviewRequestMutation) AddExpiresAt(i int64) {
+	if m.addexpires_at != nil {
+		*m.addexpires_at += i
+	} else {
+		m.addexpires_at = &i
+	}
+}
+
+// AddedExpiresAt returns the value that was added to the "expires_at" field in this mutation.
+func (m *ReviewRequestMutation) AddedExpiresAt() (r int64, exists bool) {
+	v := m.addexpires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ReviewRequestMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	m.addexpires_at = nil
+}
+
+// SetCommentID sets the "comment_id" field.
+func (m *ReviewRequestMutation) SetCommentID(u uint64) {
+	m.comment_id = &u
+	m.addcomment_id = nil
+}
+
+// CommentID returns the value of the "comment_id" field in the mutation.
+func (m *ReviewRequestMutation) CommentID() (r uint64, exists bool) {
+	v := m.comment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentID returns the old "comment_id" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldCommentID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentID: %w", err)
+	}
+	return oldValue.CommentID, nil
+}
+
+// AddCommentID adds u to the "comment_id" field.
+func (m *ReviewRequestMutation) AddCommentID(u int64) {
+	if m.addcomment_id != nil {
+		*m.addcomment_id += u
+	} else {
+		m.addcomment_id = &u
+	}
+}
+
+// AddedCommentID returns the value that was added to the "comment_id" field in this mutation.
+func (m *ReviewRequestMutation) AddedCommentID() (r int64, exists bool) {
+	v := m.addcomment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (m *ReviewRequestMutation) ClearCommentID() {
+	m.comment_id = nil
+	m.addcomment_id = nil
+	m.clearedFields[reviewrequest.FieldCommentID] = struct{}{}
+}
+
+// CommentIDCleared returns if the "comment_id" field was cleared in this mutation.
+func (m *ReviewRequestMutation) CommentIDCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldCommentID]
+	return ok
+}
+
+// ResetCommentID resets all changes to the "comment_id" field.
+func (m *ReviewRequestMutation) ResetCommentID() {
+	m.comment_id = nil
+	m.addcomment_id = nil
+	delete(m.clearedFields, reviewrequest.FieldCommentID)
+}
+
+// SetCommentedAt sets the "commented_at" field.
+func (m *ReviewRequestMutation) SetCommentedAt(i int64) {
+	m.commented_at = &i
+	m.addcommented_at = nil
+}
+
+// CommentedAt returns the value of the "commented_at" field in the mutation.
+func (m *ReviewRequestMutation) CommentedAt() (r int64, exists bool) {
+	v := m.commented_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentedAt returns the old "commented_at" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldCommentedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentedAt: %w", err)
+	}
+	return oldValue.CommentedAt, nil
+}
+
+// AddCommentedAt adds i to the "commented_at" field.
+func (m *ReviewRequestMutation) AddCommentedAt(i int64) {
+	if m.addcommented_at != nil {
+		*m.addcommented_at += i
+	} else {
+		m.addcommented_at = &i
+	}
+}
+
+// AddedCommentedAt returns the value that was added to the "commented_at" field in this mutation.
+func (m *ReviewRequestMutation) AddedCommentedAt() (r int64, exists bool) {
+	v := m.addcommented_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCommentedAt clears the value of the "commented_at" field.
+func (m *ReviewRequestMutation) ClearCommentedAt() {
+	m.commented_at = nil
+	m.addcommented_at = nil
+	m.clearedFields[reviewrequest.FieldCommentedAt] = struct{}{}
+}
+
+// CommentedAtCleared returns if the "commented_at" field was cleared in this mutation.
+func (m *ReviewRequestMutation) CommentedAtCleared() bool {
+	_, ok := m.clearedFields[reviewrequest.FieldCommentedAt]
+	return ok
+}
+
+// ResetCommentedAt resets all changes to the "commented_at" field.
+func (m *ReviewRequestMutation) ResetCommentedAt() {
+	m.commented_at = nil
+	m.addcommented_at = nil
+	delete(m.clearedFields, reviewrequest.FieldCommentedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewRequestMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewRequestMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReviewRequest entity.
+// If the ReviewRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewRequestMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ReviewRequestMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewRequestMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the ReviewRequestMutation builder.
+func (m *ReviewRequestMutation) Where(ps ...predicate.ReviewRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReviewRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReviewRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReviewRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReviewRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReviewRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReviewRequest).
+func (m *ReviewRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewRequestMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user_id != nil {
+		fields = append(fields, reviewrequest.FieldUserID)
+	}
+	if m.step_id != nil {
+		fields = append(fields, reviewrequest.FieldStepID)
+	}
+	if m.roles != nil {
+		fields = append(fields, reviewrequest.FieldRoles)
+	}
+	if m.reviewer_ids != nil {
+		fields = append(fields, reviewrequest.FieldReviewerIds)
+	}
+	if m.emails != nil {
+		fields = append(fields, reviewrequest.FieldEmails)
+	}
+	if m.message != nil {
+		fields = append(fields, reviewrequest.FieldMessage)
+	}
+	if m.share_to != nil {
+		fields = append(fields, reviewrequest.FieldShareTo)
+	}
+	if m.status != nil {
+		fields = append(fields, reviewrequest.FieldStatus)
+	}
+	if m.remind_at != nil {
+		fields = append(fields, reviewrequest.FieldRemindAt)
+	}
+	if m.reminded_at != nil {
+		fields = append(fields, reviewrequest.FieldRemindedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, reviewrequest.FieldExpiresAt)
+	}
+	if m.comment_id != nil {
+		fields = append(fields, reviewrequest.FieldCommentID)
+	}
+	if m.commented_at != nil {
+		fields = append(fields, reviewrequest.FieldCommentedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reviewrequest.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reviewrequest.FieldUserID:
+		return m.UserID()
+	case reviewrequest.FieldStepID:
+		return m.StepID()
+	case reviewrequest.FieldRoles:
+		return m.Roles()
+	case reviewrequest.FieldReviewerIds:
+		return m.ReviewerIds()
+	case reviewrequest.FieldEmails:
+		return m.Emails()
+	case reviewrequest.FieldMessage:
+		return m.Message()
+	case reviewrequest.FieldShareTo:
+		return m.ShareTo()
+	case reviewrequest.FieldStatus:
+		return m.Status()
+	case reviewrequest.FieldRemindAt:
+		return m.RemindAt()
+	case reviewrequest.FieldRemindedAt:
+		return m.RemindedAt()
+	case reviewrequest.FieldExpiresAt:
+		return m.ExpiresAt()
+	case reviewrequest.FieldCommentID:
+		return m.CommentID()
+	case reviewrequest.FieldCommentedAt:
+		return m.CommentedAt()
+	case reviewrequest.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reviewrequest.FieldUserID:
+		return m.OldUserID(ctx)
+	case reviewrequest.FieldStepID:
+		return m.OldStepID(ctx)
+	case reviewrequest.FieldRoles:
+		return m.OldRoles(ctx)
+	case reviewrequest.FieldReviewerIds:
+		return m.OldReviewerIds(ctx)
+	case reviewrequest.FieldEmails:
+		return m.OldEmails(ctx)
+	case reviewrequest.FieldMessage:
+		return m.OldMessage(ctx)
+	case reviewrequest.FieldShareTo:
+		return m.OldShareTo(ctx)
+	case reviewrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case reviewrequest.FieldRemindAt:
+		return m.OldRemindAt(ctx)
+	case reviewrequest.FieldRemindedAt:
+		return m.OldRemindedAt(ctx)
+	case reviewrequest.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case reviewrequest.FieldCommentID:
+		return m.OldCommentID(ctx)
+	case reviewrequest.FieldCommentedAt:
+		return m.OldCommentedAt(ctx)
+	case reviewrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReviewRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reviewrequest.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case reviewrequest.FieldStepID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepID(v)
+		return nil
+	case reviewrequest.FieldRoles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoles(v)
+		return nil
+	case reviewrequest.FieldReviewerIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerIds(v)
+		return nil
+	case reviewrequest.FieldEmails:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmails(v)
+		return nil
+	case reviewrequest.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case reviewrequest.FieldShareTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareTo(v)
+		return nil
+	case reviewrequest.FieldStatus:
+		v, ok := value.(reviewrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reviewrequest.FieldRemindAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemindAt(v)
+		return nil
+	case reviewrequest.FieldRemindedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemindedAt(v)
+		return nil
+	case reviewrequest.FieldExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case reviewrequest.FieldCommentID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentID(v)
+		return nil
+	case reviewrequest.FieldCommentedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentedAt(v)
+		return nil
+	case reviewrequest.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addstep_id != nil {
+		fields = append(fields, reviewrequest.FieldStepID)
+	}
+	if m.addremind_at != nil {
+		fields = append(fields, reviewrequest.FieldRemindAt)
+	}
+	if m.addreminded_at != nil {
+		fields = append(fields, reviewrequest.FieldRemindedAt)
+	}
+	if m.addexpires_at != nil {
+		fields = append(fields, reviewrequest.FieldExpiresAt)
+	}
+	if m.addcomment_id != nil {
+		fields = append(fields, reviewrequest.FieldCommentID)
+	}
+	if m.addcommented_at != nil {
+		fields = append(fields, reviewrequest.FieldCommentedAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, reviewrequest.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reviewrequest.FieldStepID:
+		return m.AddedStepID()
+	case reviewrequest.FieldRemindAt:
+		return m.AddedRemindAt()
+	case reviewrequest.FieldRemindedAt:
+		return m.AddedRemindedAt()
+	case reviewrequest.FieldExpiresAt:
+		return m.AddedExpiresAt()
+	case reviewrequest.FieldCommentID:
+		return m.AddedCommentID()
+	case reviewrequest.FieldCommentedAt:
+		return m.AddedCommentedAt()
+	case reviewrequest.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reviewrequest.FieldStepID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepID(v)
+		return nil
+	case reviewrequest.FieldRemindAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemindAt(v)
+		return nil
+	case reviewrequest.FieldRemindedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemindedAt(v)
+		return nil
+	case reviewrequest.FieldExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiresAt(v)
+		return nil
+	case reviewrequest.FieldCommentID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommentID(v)
+		return nil
+	case reviewrequest.FieldCommentedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommentedAt(v)
+		return nil
+	case reviewrequest.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reviewrequest.FieldRoles) {
+		fields = append(fields, reviewrequest.FieldRoles)
+	}
+	if m.FieldCleared(reviewrequest.FieldReviewerIds) {
+		fields = append(fields, reviewrequest.FieldReviewerIds)
+	}
+	if m.FieldCleared(reviewrequest.FieldEmails) {
+		fields = append(fields, reviewrequest.FieldEmails)
+	}
+	if m.FieldCleared(reviewrequest.FieldMessage) {
+		fields = append(fields, reviewrequest.FieldMessage)
+	}
+	if m.FieldCleared(reviewrequest.FieldShareTo) {
+		fields = append(fields, reviewrequest.FieldShareTo)
+	}
+	if m.FieldCleared(reviewrequest.FieldRemindedAt) {
+		fields = append(fields, reviewrequest.FieldRemindedAt)
+	}
+	if m.FieldCleared(reviewrequest.FieldCommentID) {
+		fields = append(fields, reviewrequest.FieldCommentID)
+	}
+	if m.FieldCleared(reviewrequest.FieldCommentedAt) {
+		fields = append(fields, reviewrequest.FieldCommentedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewRequestMutation) ClearField(name string) error {
+	switch name {
+	case reviewrequest.FieldRoles:
+		m.ClearRoles()
+		return nil
+	case reviewrequest.FieldReviewerIds:
+		m.ClearReviewerIds()
+		return nil
+	case reviewrequest.FieldEmails:
+		m.ClearEmails()
+		return nil
+	case reviewrequest.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case reviewrequest.FieldShareTo:
+		m.ClearShareTo()
+		return nil
+	case reviewrequest.FieldRemindedAt:
+		m.ClearRemindedAt()
+		return nil
+	case reviewrequest.FieldCommentID:
+		m.ClearCommentID()
+		return nil
+	case reviewrequest.FieldCommentedAt:
+		m.ClearCommentedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewRequestMutation) ResetField(name string) error {
+	switch name {
+	case reviewrequest.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case reviewrequest.FieldStepID:
+		m.ResetStepID()
+		return nil
+	case reviewrequest.FieldRoles:
+		m.ResetRoles()
+		return nil
+	case reviewrequest.FieldReviewerIds:
+		m.ResetReviewerIds()
+		return nil
+	case reviewrequest.FieldEmails:
+		m.ResetEmails()
+		return nil
+	case reviewrequest.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case reviewrequest.FieldShareTo:
+		m.ResetShareTo()
+		return nil
+	case reviewrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reviewrequest.FieldRemindAt:
+		m.ResetRemindAt()
+		return nil
+	case reviewrequest.FieldRemindedAt:
+		m.ResetRemindedAt()
+		return nil
+	case reviewrequest.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case reviewrequest.FieldCommentID:
+		m.ResetCommentID()
+		return nil
+	case reviewrequest.FieldCommentedAt:
+		m.ResetCommentedAt()
+		return nil
+	case reviewrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReviewRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewRequestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewRequestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewRequestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ReviewRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewRequestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ReviewRequest edge %s", name)
+}
+
+// ReviewerMutation represents an operation that mutates the Reviewer nodes in the graph.
+type ReviewerMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint64
+	student_id           *string
+	reviewer_id          *string
+	role                 *reviewer.Role
+	status               *reviewer.Status
+	invite_code          *string
+	invite_expires_at    *int64
+	addinvite_expires_at *int64
+	created_at           *int64
+	addcreated_at        *int64
+	accepted_at          *int64
+	addaccepted_at       *int64
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*Reviewer, error)
+	predicates           []predicate.Reviewer
+}
+
+var _ ent.Mutation = (*ReviewerMutation)(nil)
+
+// reviewerOption allows management of the mutation configuration using functional options.
+type reviewerOption func(*ReviewerMutation)
+
+// newReviewerMutation creates new mutation for the Reviewer entity.
+func newReviewerMutation(c config, op Op, opts ...reviewerOption) *ReviewerMutation {
+	m := &ReviewerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReviewer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewerID sets the ID field of the mutation.
+func withReviewerID(id uint64) reviewerOption {
+	return func(m *ReviewerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reviewer
+		)
+		m.oldValue = func(ctx context.Context) (*Reviewer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reviewer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReviewer sets the old Reviewer of the mutation.
+func withReviewer(node *Reviewer) reviewerOption {
+	return func(m *ReviewerMutation) {
+		m.oldValue = func(context.Context) (*Reviewer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reviewer entities.
+func (m *ReviewerMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewerMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewerMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reviewer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStudentID sets the "student_id" field.
+func (m *ReviewerMutation) SetStudentID(s string) {
+	m.student_id = &s
+}
+
+// StudentID returns the value of the "student_id" field in the mutation.
+func (m *ReviewerMutation) StudentID() (r string, exists bool) {
+	v := m.student_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStudentID returns the old "student_id" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldStudentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStudentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
+	}
+	return oldValue.StudentID, nil
+}
+
+// ResetStudentID resets all changes to the "student_id" field.
+func (m *ReviewerMutation) ResetStudentID() {
+	m.student_id = nil
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (m *ReviewerMutation) SetReviewerID(s string) {
+	m.reviewer_id = &s
+}
+
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *ReviewerMutation) ReviewerID() (r string, exists bool) {
+	v := m.reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerID returns the old "reviewer_id" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldReviewerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+	}
+	return oldValue.ReviewerID, nil
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (m *ReviewerMutation) ClearReviewerID() {
+	m.reviewer_id = nil
+	m.clearedFields[reviewer.FieldReviewerID] = struct{}{}
+}
+
+// ReviewerIDCleared returns if the "reviewer_id" field was cleared in this mutation.
+func (m *ReviewerMutation) ReviewerIDCleared() bool {
+	_, ok := m.clearedFields[reviewer.FieldReviewerID]
+	return ok
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *ReviewerMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+	delete(m.clearedFields, reviewer.FieldReviewerID)
+}
+
+// SetRole sets the "role" field.
+func (m *ReviewerMutation) SetRole(r reviewer.Role) {
+	m.role = &r
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *ReviewerMutation) Role() (r reviewer.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldRole(ctx context.Context) (v reviewer.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *ReviewerMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReviewerMutation) SetStatus(r reviewer.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReviewerMutation) Status() (r reviewer.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldStatus(ctx context.Context) (v reviewer.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReviewerMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (m *ReviewerMutation) SetInviteCode(s string) {
+	m.invite_code = &s
+}
+
+// InviteCode returns the value of the "invite_code" field in the mutation.
+func (m *ReviewerMutation) InviteCode() (r string, exists bool) {
+	v := m.invite_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInviteCode returns the old "invite_code" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldInviteCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInviteCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInviteCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInviteCode: %w", err)
+	}
+	return oldValue.InviteCode, nil
+}
+
+// ClearInviteCode clears the value of the "invite_code" field.
+func (m *ReviewerMutation) ClearInviteCode() {
+	m.invite_code = nil
+	m.clearedFields[reviewer.FieldInviteCode] = struct{}{}
+}
+
+// InviteCodeCleared returns if the "invite_code" field was cleared in this mutation.
+func (m *ReviewerMutation) InviteCodeCleared() bool {
+	_, ok := m.clearedFields[reviewer.FieldInviteCode]
+	return ok
+}
+
+// ResetInviteCode resets all changes to the "invite_code" field.
+func (m *ReviewerMutation) ResetInviteCode() {
+	m.invite_code = nil
+	delete(m.clearedFields, reviewer.FieldInviteCode)
+}
+
+// SetInviteExpiresAt sets the "invite_expires_at" field.
+func (m *ReviewerMutation) SetInviteExpiresAt(i int64) {
+	m.invite_expires_at = &i
+	m.addinvite_expires_at = nil
+}
+
+// InviteExpiresAt returns the value of the "invite_expires_at" field in the mutation.
+func (m *ReviewerMutation) InviteExpiresAt() (r int64, exists bool) {
+	v := m.invite_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInviteExpiresAt returns the old "invite_expires_at" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldInviteExpiresAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInviteExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInviteExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInviteExpiresAt: %w", err)
+	}
+	return oldValue.InviteExpiresAt, nil
+}
+
+// AddInviteExpiresAt adds i to the "invite_expires_at" field.
+func (m *ReviewerMutation) AddInviteExpiresAt(i int64) {
+	if m.addinvite_expires_at != nil {
+		*m.addinvite_expires_at += i
+	} else {
+		m.addinvite_expires_at = &i
+	}
+}
+
+// AddedInviteExpiresAt returns the value that was added to the "invite_expires_at" field in this mutation.
+func (m *ReviewerMutation) AddedInviteExpiresAt() (r int64, exists bool) {
+	v := m.addinvite_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInviteExpiresAt resets all changes to the "invite_expires_at" field.
+func (m *ReviewerMutation) ResetInviteExpiresAt() {
+	m.invite_expires_at = nil
+	m.addinvite_expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewerMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewerMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ReviewerMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ReviewerMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewerMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (m *ReviewerMutation) SetAcceptedAt(i int64) {
+	m.accepted_at = &i
+	m.addaccepted_at = nil
+}
+
+// AcceptedAt returns the value of the "accepted_at" field in the mutation.
+func (m *ReviewerMutation) AcceptedAt() (r int64, exists bool) {
+	v := m.accepted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcceptedAt returns the old "accepted_at" field's value of the Reviewer entity.
+// If the Reviewer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewerMutation) OldAcceptedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedAt: %w", err)
+	}
+	return oldValue.AcceptedAt, nil
+}
+
+// AddAcceptedAt adds i to the "accepted_at" field.
+func (m *ReviewerMutation) AddAcceptedAt(i int64) {
+	if m.addaccepted_at != nil {
+		*m.addaccepted_at += i
+	} else {
+		m.addaccepted_at = &i
+	}
+}
+
+// AddedAcceptedAt returns the value that was added to the "accepted_at" field in this mutation.
+func (m *ReviewerMutation) AddedAcceptedAt() (r int64, exists bool) {
+	v := m.addaccepted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (m *ReviewerMutation) ClearAcceptedAt() {
+	m.accepted_at = nil
+	m.addaccepted_at = nil
+	m.clearedFields[reviewer.FieldAcceptedAt] = struct{}{}
+}
+
+// AcceptedAtCleared returns if the "accepted_at" field was cleared in this mutation.
+func (m *ReviewerMutation) AcceptedAtCleared() bool {
+	_, ok := m.clearedFields[reviewer.FieldAcceptedAt]
+	return ok
+}
+
+// ResetAcceptedAt resets all changes to the "accepted_at" field.
+func (m *ReviewerMutation) ResetAcceptedAt() {
+	m.accepted_at = nil
+	m.addaccepted_at = nil
+	delete(m.clearedFields, reviewer.FieldAcceptedAt)
+}
+
+// Where appends a list predicates to the ReviewerMutation builder.
+func (m *ReviewerMutation) Where(ps ...predicate.Reviewer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReviewerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReviewerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reviewer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReviewerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReviewerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Reviewer).
+func (m *ReviewerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewerMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.student_id != nil {
+		fields = append(fields, reviewer.FieldStudentID)
+	}
+	if m.reviewer_id != nil {
+		fields = append(fields, reviewer.FieldReviewerID)
+	}
+	if m.role != nil {
+		fields = append(fields, reviewer.FieldRole)
+	}
+	if m.status != nil {
+		fields = append(fields, reviewer.FieldStatus)
+	}
+	if m.invite_code != nil {
+		fields = append(fields, reviewer.FieldInviteCode)
+	}
+	if m.invite_expires_at != nil {
+		fields = append(fields, reviewer.FieldInviteExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reviewer.FieldCreatedAt)
+	}
+	if m.accepted_at != nil {
+		fields = append(fields, reviewer.FieldAcceptedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reviewer.FieldStudentID:
+		return m.StudentID()
+	case reviewer.FieldReviewerID:
+		return m.ReviewerID()
+	case reviewer.FieldRole:
+		return m.Role()
+	case reviewer.FieldStatus:
+		return m.Status()
+	case reviewer.FieldInviteCode:
+		return m.InviteCode()
+	case reviewer.FieldInviteExpiresAt:
+		return m.InviteExpiresAt()
+	case reviewer.FieldCreatedAt:
+		return m.CreatedAt()
+	case reviewer.FieldAcceptedAt:
+		return m.AcceptedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reviewer.FieldStudentID:
+		return m.OldStudentID(ctx)
+	case reviewer.FieldReviewerID:
+		return m.OldReviewerID(ctx)
+	case reviewer.FieldRole:
+		return m.OldRole(ctx)
+	case reviewer.FieldStatus:
+		return m.OldStatus(ctx)
+	case reviewer.FieldInviteCode:
+		return m.OldInviteCode(ctx)
+	case reviewer.FieldInviteExpiresAt:
+		return m.OldInviteExpiresAt(ctx)
+	case reviewer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case reviewer.FieldAcceptedAt:
+		return m.OldAcceptedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reviewer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reviewer.FieldStudentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStudentID(v)
+		return nil
+	case reviewer.FieldReviewerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerID(v)
+		return nil
+	case reviewer.FieldRole:
+		v, ok := value.(reviewer.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case reviewer.FieldStatus:
+		v, ok := value.(reviewer.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reviewer.FieldInviteCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInviteCode(v)
+		return nil
+	case reviewer.FieldInviteExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInviteExpiresAt(v)
+		return nil
+	case reviewer.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case reviewer.FieldAcceptedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcceptedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reviewer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewerMutation) AddedFields() []string {
+	var fields []string
+	if m.addinvite_expires_at != nil {
+		fields = append(fields, reviewer.FieldInviteExpiresAt)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, reviewer.FieldCreatedAt)
+	}
+	if m.addaccepted_at != nil {
+		fields = append(fields, reviewer.FieldAcceptedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reviewer.FieldInviteExpiresAt:
+		return m.AddedInviteExpiresAt()
+	case reviewer.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case reviewer.FieldAcceptedAt:
+		return m.AddedAcceptedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reviewer.FieldInviteExpiresAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInviteExpiresAt(v)
+		return nil
+	case reviewer.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case reviewer.FieldAcceptedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAcceptedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reviewer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reviewer.FieldReviewerID) {
+		fields = append(fields, reviewer.FieldReviewerID)
+	}
+	if m.FieldCleared(reviewer.FieldInviteCode) {
+		fields = append(fields, reviewer.FieldInviteCode)
+	}
+	if m.FieldCleared(reviewer.FieldAcceptedAt) {
+		fields = append(fields, reviewer.FieldAcceptedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewerMutation) ClearField(name string) error {
+	switch name {
+	case reviewer.FieldReviewerID:
+		m.ClearReviewerID()
+		return nil
+	case reviewer.FieldInviteCode:
+		m.ClearInviteCode()
+		return nil
+	case reviewer.FieldAcceptedAt:
+		m.ClearAcceptedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reviewer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewerMutation) ResetField(name string) error {
+	switch name {
+	case reviewer.FieldStudentID:
+		m.ResetStudentID()
+		return nil
+	case reviewer.FieldReviewerID:
+		m.ResetReviewerID()
+		return nil
+	case reviewer.FieldRole:
+		m.ResetRole()
+		return nil
+	case reviewer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reviewer.FieldInviteCode:
+		m.ResetInviteCode()
+		return nil
+	case reviewer.FieldInviteExpiresAt:
+		m.ResetInviteExpiresAt()
+		return nil
+	case reviewer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case reviewer.FieldAcceptedAt:
+		m.ResetAcceptedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reviewer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewerMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewerMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Reviewer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Reviewer edge %s", name)
+}
+
+// SearchDocumentMutation represents an operation that mutates the SearchDocument nodes in the graph.
+type SearchDocumentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uint64
+	user_id       *string
+	kind          *searchdocument.Kind
+	target_id     *uint64
+	addtarget_id  *int64
+	step_id       *uint64
+	addstep_id    *int64
+	comment_role  *string
+	title         *string
+	content       *string
+	created_at    *int64
+	addcreated_at *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SearchDocument, error)
+	predicates    []predicate.SearchDocument
+}
+
+var _ ent.Mutation = (*SearchDocumentMutation)(nil)
+
+// searchdocumentOption allows management of the mutation configuration using functional options.
+type searchdocumentOption func(*SearchDocumentMutation)
+
+// newSearchDocumentMutation creates new mutation for the SearchDocument entity.
+func newSearchDocumentMutation(c config, op Op, opts ...searchdocumentOption) *SearchDocumentMutation {
+	m := &SearchDocumentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchDocument,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSearchDocumentID sets the ID field of the mutation.
+func withSearchDocumentID(id uint64) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchDocument
+		)
+		m.oldValue = func(ctx context.Context) (*SearchDocument, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchDocument.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSearchDocument sets the old SearchDocument of the mutation.
+func withSearchDocument(node *SearchDocument) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
+		m.oldValue = func(context.Context) (*SearchDocument, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchDocumentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchDocumentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SearchDocument entities.
+func (m *SearchDocumentMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchDocumentMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchDocumentMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchDocument.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SearchDocumentMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SearchDocumentMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SearchDocumentMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *SearchDocumentMutation) SetKind(s searchdocument.Kind) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *SearchDocumentMutation) Kind() (r searchdocument.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldKind(ctx context.Context) (v searchdocument.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *SearchDocumentMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *SearchDocumentMutation) SetTargetID(u uint64) {
+	m.target_id = &u
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *SearchDocumentMutation) TargetID() (r uint64, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (m *SearchDocumentMutation) AddTargetID(u int64) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += u
+	} else {
+		m.addtarget_id = &u
+	}
+}
+
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *SearchDocumentMutation) AddedTargetID() (r int64, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *SearchDocumentMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+}
+
+// SetStepID sets the "step_id" field.
+func (m *SearchDocumentMutation) SetStepID(u uint64) {
+	m.step_id = &u
+	m.addstep_id = nil
+}
+
+// StepID returns the value of the "step_id" field in the mutation.
+func (m *SearchDocumentMutation) StepID() (r uint64, exists bool) {
+	v := m.step_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepID returns the old "step_id" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldStepID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
+	}
+	return oldValue.StepID, nil
+}
+
+// AddStepID adds u to the "step_id" field.
+func (m *SearchDocumentMutation) AddStepID(u int64) {
+	if m.addstep_id != nil {
+		*m.addstep_id += u
+	} else {
+		m.addstep_id = &u
+	}
+}
+
+// AddedStepID returns the value that was added to the "step_id" field in this mutation.
+func (m *SearchDocumentMutation) AddedStepID() (r int64, exists bool) {
+	v := m.addstep_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepID resets all changes to the "step_id" field.
+func (m *SearchDocumentMutation) ResetStepID() {
+	m.step_id = nil
+	m.addstep_id = nil
+}
+
+// SetCommentRole sets the "comment_role" field.
+func (m *SearchDocumentMutation) SetCommentRole(s string) {
+	m.comment_role = &s
+}
+
+// CommentRole returns the value of the "comment_role" field in the mutation.
+func (m *SearchDocumentMutation) CommentRole() (r string, exists bool) {
+	v := m.comment_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentRole returns the old "comment_role" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldCommentRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentRole: %w", err)
+	}
+	return oldValue.CommentRole, nil
+}
+
+// ResetCommentRole resets all changes to the "comment_role" field.
+func (m *SearchDocumentMutation) ResetCommentRole() {
+	m.comment_role = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *SearchDocumentMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SearchDocumentMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SearchDocumentMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetContent sets the "content" field.
+func (m *SearchDocumentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *SearchDocumentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *SearchDocumentMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SearchDocumentMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SearchDocumentMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *SearchDocumentMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *SearchDocumentMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SearchDocumentMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// Where appends a list predicates to the SearchDocumentMutation builder.
+func (m *SearchDocumentMutation) Where(ps ...predicate.SearchDocument) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SearchDocumentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SearchDocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchDocument, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SearchDocumentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SearchDocumentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SearchDocument).
+func (m *SearchDocumentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SearchDocumentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, searchdocument.FieldUserID)
+	}
+	if m.kind != nil {
+		fields = append(fields, searchdocument.FieldKind)
+	}
+	if m.target_id != nil {
+		fields = append(fields, searchdocument.FieldTargetID)
+	}
+	if m.step_id != nil {
+		fields = append(fields, searchdocument.FieldStepID)
+	}
+	if m.comment_role != nil {
+		fields = append(fields, searchdocument.FieldCommentRole)
+	}
+	if m.title != nil {
+		fields = append(fields, searchdocument.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, searchdocument.FieldContent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, searchdocument.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SearchDocumentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case searchdocument.FieldUserID:
+		return m.UserID()
+	case searchdocument.FieldKind:
+		return m.Kind()
+	case searchdocument.FieldTargetID:
+		return m.TargetID()
+	case searchdocument.FieldStepID:
+		return m.StepID()
+	case searchdocument.FieldCommentRole:
+		return m.CommentRole()
+	case searchdocument.FieldTitle:
+		return m.Title()
+	case searchdocument.FieldContent:
+		return m.Content()
+	case searchdocument.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SearchDocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case searchdocument.FieldUserID:
+		return m.OldUserID(ctx)
+	case searchdocument.FieldKind:
+		return m.OldKind(ctx)
+	case searchdocument.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case searchdocument.FieldStepID:
+		return m.OldStepID(ctx)
+	case searchdocument.FieldCommentRole:
+		return m.OldCommentRole(ctx)
+	case searchdocument.FieldTitle:
+		return m.OldTitle(ctx)
+	case searchdocument.FieldContent:
+		return m.OldContent(ctx)
+	case searchdocument.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SearchDocument field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchDocumentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case searchdocument.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case searchdocument.FieldKind:
+		v, ok := value.(searchdocument.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case searchdocument.FieldTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case searchdocument.FieldStepID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepID(v)
+		return nil
+	case searchdocument.FieldCommentRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentRole(v)
+		return nil
+	case searchdocument.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case searchdocument.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case searchdocument.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchDocument field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SearchDocumentMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_id != nil {
+		fields = append(fields, searchdocument.FieldTargetID)
+	}
+	if m.addstep_id != nil {
+		fields = append(fields, searchdocument.FieldStepID)
+	}
+	if m.addcreated_at != nil {
+		fields = append(fields, searchdocument.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SearchDocumentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case searchdocument.FieldTargetID:
+		return m.AddedTargetID()
+	case searchdocument.FieldStepID:
+		return m.AddedStepID()
+	case searchdocument.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchDocumentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case searchdocument.FieldTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case searchdocument.FieldStepID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepID(v)
+		return nil
+	case searchdocument.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchDocument numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SearchDocumentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SearchDocumentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SearchDocumentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SearchDocument nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SearchDocumentMutation) ResetField(name string) error {
+	switch name {
+	case searchdocument.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case searchdocument.FieldKind:
+		m.ResetKind()
+		return nil
+	case searchdocument.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case searchdocument.FieldStepID:
+		m.ResetStepID()
+		return nil
+	case searchdocument.FieldCommentRole:
+		m.ResetCommentRole()
+		return nil
+	case searchdocument.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case searchdocument.FieldContent:
+		m.ResetContent()
+		return nil
+	case searchdocument.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchDocument field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SearchDocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SearchDocumentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SearchDocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SearchDocumentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SearchDocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SearchDocumentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SearchDocumentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SearchDocumentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument edge %s", name)
+}
+
+// ShowMutation represents an operation that mutates the Show nodes in the graph.
+type ShowMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uint64
+	_type                *string
+	poster               *string
+	content              *string
+	media_files          *[]string
+	appendmedia_files    []string
+	created_at           *int64
+	addcreated_at        *int64
+	clearedFields        map[string]struct{}
+	show_reserves        map[uint64]struct{}
+	removedshow_reserves map[uint64]struct{}
+	clearedshow_reserves bool
+	done                 bool
+	oldValue             func(context.Context) (*Show, error)
+	predicates           []predicate.Show
+}
+
+var _ ent.Mutation = (*ShowMutation)(nil)
+
+// showOption allows management of the mutation configuration using functional options.
+type showOption func(*ShowMutation)
+
+// newShowMutation creates new mutation for the Show entity.
+func newShowMutation(c config, op Op, opts ...showOption) *ShowMutation {
+	m := &ShowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShowID sets the ID field of the mutation.
+func withShowID(id uint64) showOption {
+	return func(m *ShowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Show
+		)
+		m.oldValue = func(ctx context.Context) (*Show, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Show.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShow sets the old Show of the mutation.
+func withShow(node *Show) showOption {
+	return func(m *ShowMutation) {
+		m.oldValue = func(context.Context) (*Show, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Show entities.
+func (m *ShowMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShowMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShowMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Show.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *ShowMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ShowMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ShowMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPoster sets the "poster" field.
+func (m *ShowMutation) SetPoster(s string) {
+	m.poster = &s
+}
+
+// Poster returns the value of the "poster" field in the mutation.
+func (m *ShowMutation) Poster() (r string, exists bool) {
+	v := m.poster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoster returns the old "poster" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldPoster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoster: %w", err)
+	}
+	return oldValue.Poster, nil
+}
+
+// ClearPoster clears the value of the "poster" field.
+func (m *ShowMutation) ClearPoster() {
+	m.poster = nil
+	m.clearedFields[show.FieldPoster] = struct{}{}
+}
+
+// PosterCleared returns if the "poster" field was cleared in this mutation.
+func (m *ShowMutation) PosterCleared() bool {
+	_, ok := m.clearedFields[show.FieldPoster]
+	return ok
+}
+
+// ResetPoster resets all changes to the "poster" field.
+func (m *ShowMutation) ResetPoster() {
+	m.poster = nil
+	delete(m.clearedFields, show.FieldPoster)
+}
+
+// SetContent sets the "content" field.
+func (m *ShowMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ShowMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ShowMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetMediaFiles sets the "media_files" field.
+func (m *ShowMutation) SetMediaFiles(s []string) {
+	m.media_files = &s
+	m.appendmedia_files = nil
+}
+
+// MediaFiles returns the value of the "media_files" field in the mutation.
+func (m *ShowMutation) MediaFiles() (r []string, exists bool) {
+	v := m.media_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaFiles returns the old "media_files" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldMediaFiles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaFiles: %w", err)
+	}
+	return oldValue.MediaFiles, nil
+}
+
+// AppendMediaFiles adds s to the "media_files" field.
+func (m *ShowMutation) AppendMediaFiles(s []string) {
+	m.appendmedia_files = append(m.appendmedia_files, s...)
+}
+
+// AppendedMediaFiles returns the list of values that were appended to the "media_files" field in this mutation.
+func (m *ShowMutation) AppendedMediaFiles() ([]string, bool) {
+	if len(m.appendmedia_files) == 0 {
+		return nil, false
+	}
+	return m.appendmedia_files, true
+}
+
+// ClearMediaFiles clears the value of the "media_files" field.
+func (m *ShowMutation) ClearMediaFiles() {
+	m.media_files = nil
+	m.appendmedia_files = nil
+	m.clearedFields[show.FieldMediaFiles] = struct{}{}
+}
+
+// MediaFilesCleared returns if the "media_files" field was cleared in this mutation.
+func (m *ShowMutation) MediaFilesCleared() bool {
+	_, ok := m.clearedFields[show.FieldMediaFiles]
+	return ok
+}
+
+// ResetMediaFiles resets all changes to the "media_files" field.
+func (m *ShowMutation) ResetMediaFiles() {
+	m.media_files = nil
+	m.appendmedia_files = nil
+	delete(m.clearedFields, show.FieldMediaFiles)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShowMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShowMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Show entity.
+// If the Show object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ShowMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ShowMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShowMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// AddShowReserfIDs adds the "show_reserves" edge to the ShowReserve entity by ids.
+func (m *ShowMutation) AddShowReserfIDs(ids ...uint64) {
+	if m.show_reserves == nil {
+		m.show_reserves = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.show_reserves[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShowReserves clears the "show_reserves" edge to the ShowReserve entity.
+func (m *ShowMutation) ClearShowReserves() {
+	m.clearedshow_reserves = true
+}
+
+// ShowReservesCleared reports if the "show_reserves" edge to the ShowReserve entity was cleared.
+func (m *ShowMutation) ShowReservesCleared() bool {
+	return m.clearedshow_reserves
+}
+
+// RemoveShowReserfIDs removes the "show_reserves" edge to the ShowReserve entity by IDs.
+func (m *ShowMutation) RemoveShowReserfIDs(ids ...uint64) {
+	if m.removedshow_reserves == nil {
+		m.removedshow_reserves = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.show_reserves, ids[i])
+		m.removedshow_reserves[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShowReserves returns the removed IDs of the "show_reserves" edge to the ShowReserve entity.
+func (m *ShowMutation) RemovedShowReservesIDs() (ids []uint64) {
+	for id := range m.removedshow_reserves {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ShowReservesIDs returns the "show_reserves" edge IDs in the mutation.
+func (m *ShowMutation) ShowReservesIDs() (ids []uint64) {
+	for id := range m.show_reserves {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShowReserves resets all changes to the "show_reserves" edge.
+func (m *ShowMutation) ResetShowReserves() {
+	m.show_reserves = nil
+	m.clearedshow_reserves = false
+	m.removedshow_reserves = nil
+}
+
+// Where appends a list predicates to the ShowMutation builder.
+func (m *ShowMutation) Where(ps ...predicate.Show) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Show, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Show).
+func (m *ShowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShowMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m._type != nil {
+		fields = append(fields, show.FieldType)
+	}
+	if m.poster != nil {
+		fields = append(fields, show.FieldPoster)
+	}
+	if m.content != nil {
+		fields = append(fields, show.FieldContent)
+	}
+	if m.media_files != nil {
+		fields = append(fields, show.FieldMediaFiles)
+	}
+	if m.created_at != nil {
+		fields = append(fields, show.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case show.FieldType:
+		return m.GetType()
+	case show.FieldPoster:
+		return m.Poster()
+	case show.FieldContent:
+		return m.Content()
+	case show.FieldMediaFiles:
+		return m.MediaFiles()
+	case show.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case show.FieldType:
+		return m.OldType(ctx)
+	case show.FieldPoster:
+		return m.OldPoster(ctx)
+	case show.FieldContent:
+		return m.OldContent(ctx)
+	case show.FieldMediaFiles:
+		return m.OldMediaFiles(ctx)
+	case show.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Show field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case show.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case show.FieldPoster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoster(v)
+		return nil
+	case show.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case show.FieldMediaFiles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaFiles(v)
+		return nil
+	case show.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Show field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShowMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, show.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case show.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case show.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Show numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(show.FieldPoster) {
+		fields = append(fields, show.FieldPoster)
+	}
+	if m.FieldCleared(show.FieldMediaFiles) {
+		fields = append(fields, show.FieldMediaFiles)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShowMutation) ClearField(name string) error {
+	switch name {
+	case show.FieldPoster:
+		m.ClearPoster()
+		return nil
+	case show.FieldMediaFiles:
+		m.ClearMediaFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Show nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShowMutation) ResetField(name string) error {
+	switch name {
+	case show.FieldType:
+		m.ResetType()
+		return nil
+	case show.FieldPoster:
+		m.ResetPoster()
+		return nil
+	case show.FieldContent:
+		m.ResetContent()
+		return nil
+	case show.FieldMediaFiles:
+		m.ResetMediaFiles()
+		return nil
+	case show.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Show field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.show_reserves != nil {
+		edges = append(edges, show.EdgeShowReserves)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case show.EdgeShowReserves:
+		ids := make([]ent.Value, 0, len(m.show_reserves))
+		for id := range m.show_reserves {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedshow_reserves != nil {
+		edges = append(edges, show.EdgeShowReserves)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShowMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case show.EdgeShowReserves:
+		ids := make([]ent.Value, 0, len(m.removedshow_reserves))
+		for id := range m.removedshow_reserves {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedshow_reserves {
+		edges = append(edges, show.EdgeShowReserves)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case show.EdgeShowReserves:
+		return m.clearedshow_reserves
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShowMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Show unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShowMutation) ResetEdge(name string) error {
+	switch name {
+	case show.EdgeShowReserves:
+		m.ResetShowReserves()
+		return nil
+	}
+	return fmt.Errorf("unknown Show edge %s", name)
+}
+
+// ShowReserveMutation represents an operation that mutates the ShowReserve nodes in the graph.
+type ShowReserveMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint64
+	user_id        *string
+	status         *showreserve.Status
+	memories       *[]string
+	appendmemories []string
+	created_at     *int64
+	addcreated_at  *int64
+	clearedFields  map[string]struct{}
+	show           *uint64
+	clearedshow    bool
+	done           bool
+	oldValue       func(context.Context) (*ShowReserve, error)
+	predicates     []predicate.ShowReserve
+}
+
+var _ ent.Mutation = (*ShowReserveMutation)(nil)
+
+// showreserveOption allows management of the mutation configuration using functional options.
+type showreserveOption func(*ShowReserveMutation)
+
+// newShowReserveMutation creates new mutation for the ShowReserve entity.
+func newShowReserveMutation(c config, op Op, opts ...showreserveOption) *ShowReserveMutation {
+	m := &ShowReserveMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShowReserve,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShowReserveID sets the ID field of the mutation.
+func withShowReserveID(id uint64) showreserveOption {
+	return func(m *ShowReserveMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ShowReserve
+		)
+		m.oldValue = func(ctx context.Context) (*ShowReserve, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ShowReserve.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShowReserve sets the old ShowReserve of the mutation.
+func withShowReserve(node *ShowReserve) showreserveOption {
+	return func(m *ShowReserveMutation) {
+		m.oldValue = func(context.Context) (*ShowReserve, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShowReserveMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShowReserveMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ShowReserve entities.
+func (m *ShowReserveMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShowReserveMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShowReserveMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ShowReserve.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ShowReserveMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ShowReserveMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ShowReserve entity.
+// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowReserveMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ShowReserveMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ShowReserveMutation) SetStatus(s showreserve.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ShowReserveMutation) Status() (r showreserve.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ShowReserve entity.
+// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowReserveMutation) OldStatus(ctx context.Context) (v showreserve.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ShowReserveMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMemories sets the "memories" field.
+func (m *ShowReserveMutation) SetMemories(s []string) {
+	m.memories = &s
+	m.appendmemories = nil
+}
+
+// Memories returns the value of the "memories" field in the mutation.
+func (m *ShowReserveMutation) Memories() (r []string, exists bool) {
+	v := m.memories
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemories returns the old "memories" field's value of the ShowReserve entity.
+// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowReserveMutation) OldMemories(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemories is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemories requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemories: %w", err)
+	}
+	return oldValue.Memories, nil
+}
+
+// AppendMemories adds s to the "memories" field.
+func (m *ShowReserveMutation) AppendMemories(s []string) {
+	m.appendmemories = append(m.appendmemories, s...)
+}
+
+// AppendedMemories returns the list of values that were appended to the "memories" field in this mutation.
+func (m *ShowReserveMutation) AppendedMemories() ([]string, bool) {
+	if len(m.appendmemories) == 0 {
+		return nil, false
+	}
+	return m.appendmemories, true
+}
+
+// ClearMemories clears the value of the "memories" field.
+func (m *ShowReserveMutation) ClearMemories() {
+	m.memories = nil
+	m.appendmemories = nil
+	m.clearedFields[showreserve.FieldMemories] = struct{}{}
+}
+
+// MemoriesCleared returns if the "memories" field was cleared in this mutation.
+func (m *ShowReserveMutation) MemoriesCleared() bool {
+	_, ok := m.clearedFields[showreserve.FieldMemories]
+	return ok
+}
+
+// ResetMemories resets all changes to the "memories" field.
+func (m *ShowReserveMutation) ResetMemories() {
+	m.memories = nil
+	m.appendmemories = nil
+	delete(m.clearedFields, showreserve.FieldMemories)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShowReserveMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShowReserveMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ShowReserve entity.
+// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowReserveMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *ShowReserveMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *ShowReserveMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShowReserveMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetRefShowID sets the "ref_show_id" field.
+func (m *ShowReserveMutation) SetRefShowID(u uint64) {
+	m.show = &u
+}
+
+// RefShowID returns the value of the "ref_show_id" field in the mutation.
+func (m *ShowReserveMutation) RefShowID() (r uint64, exists bool) {
+	v := m.show
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefShowID returns the old "ref_show_id" field's value of the ShowReserve entity.
+// If the ShowReserve object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShowReserveMutation) OldRefShowID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefShowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefShowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefShowID: %w", err)
+	}
+	return oldValue.RefShowID, nil
+}
+
+// ClearRefShowID clears the value of the "ref_show_id" field.
+func (m *ShowReserveMutation) ClearRefShowID() {
+	m.show = nil
+	m.clearedFields[showreserve.FieldRefShowID] = struct{}{}
+}
+
+// RefShowIDCleared returns if the "ref_show_id" field was cleared in this mutation.
+func (m *ShowReserveMutation) RefShowIDCleared() bool {
+	_, ok := m.clearedFields[showreserve.FieldRefShowID]
+	return ok
+}
+
+// ResetRefShowID resets all changes to the "ref_show_id" field.
+func (m *ShowReserveMutation) ResetRefShowID() {
+	m.show = nil
+	delete(m.clearedFields, showreserve.FieldRefShowID)
+}
+
+// SetShowID sets the "show" edge to the Show entity by id.
+func (m *ShowReserveMutation) SetShowID(id uint64) {
+	m.show = &id
+}
+
+// ClearShow clears the "show" edge to the Show entity.
+func (m *ShowReserveMutation) ClearShow() {
+	m.clearedshow = true
+	m.clearedFields[showreserve.FieldRefShowID] = struct{}{}
+}
+
+// ShowCleared reports if the "show" edge to the Show entity was cleared.
+func (m *ShowReserveMutation) ShowCleared() bool {
+	return m.RefShowIDCleared() || m.clearedshow
+}
+
+// ShowID returns the "show" edge ID in the mutation.
+func (m *ShowReserveMutation) ShowID() (id uint64, exists bool) {
+	if m.show != nil {
+		return *m.show, true
+	}
+	return
+}
+
+// ShowIDs returns the "show" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShowID instead. It exists only for internal usage by the builders.
+func (m *ShowReserveMutation) ShowIDs() (ids []uint64) {
+	if id := m.show; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShow resets all changes to the "show" edge.
+func (m *ShowReserveMutation) ResetShow() {
+	m.show = nil
+	m.clearedshow = false
+}
+
+// Where appends a list predicates to the ShowReserveMutation builder.
+func (m *ShowReserveMutation) Where(ps ...predicate.ShowReserve) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShowReserveMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShowReserveMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShowReserve, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShowReserveMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShowReserveMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShowReserve).
+func (m *ShowReserveMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShowReserveMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, showreserve.FieldUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, showreserve.FieldStatus)
+	}
+	if m.memories != nil {
+		fields = append(fields, showreserve.FieldMemories)
+	}
+	if m.created_at != nil {
+		fields = append(fields, showreserve.FieldCreatedAt)
+	}
+	if m.show != nil {
+		fields = append(fields, showreserve.FieldRefShowID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShowReserveMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case showreserve.FieldUserID:
+		return m.UserID()
+	case showreserve.FieldStatus:
+		return m.Status()
+	case showreserve.FieldMemories:
+		return m.Memories()
+	case showreserve.FieldCreatedAt:
+		return m.CreatedAt()
+	case showreserve.FieldRefShowID:
+		return m.RefShowID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShowReserveMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case showreserve.FieldUserID:
+		return m.OldUserID(ctx)
+	case showreserve.FieldStatus:
+		return m.OldStatus(ctx)
+	case showreserve.FieldMemories:
+		return m.OldMemories(ctx)
+	case showreserve.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case showreserve.FieldRefShowID:
+		return m.OldRefShowID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ShowReserve field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShowReserveMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case showreserve.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case showreserve.FieldStatus:
+		v, ok := value.(showreserve.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case showreserve.FieldMemories:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemories(v)
+		return nil
+	case showreserve.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case showreserve.FieldRefShowID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefShowID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShowReserve field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShowReserveMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, showreserve.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShowReserveMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case showreserve.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShowReserveMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case showreserve.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShowReserve numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShowReserveMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(showreserve.FieldMemories) {
+		fields = append(fields, showreserve.FieldMemories)
+	}
+	if m.FieldCleared(showreserve.FieldRefShowID) {
+		fields = append(fields, showreserve.FieldRefShowID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShowReserveMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShowReserveMutation) ClearField(name string) error {
+	switch name {
+	case showreserve.FieldMemories:
+		m.ClearMemories()
+		return nil
+	case showreserve.FieldRefShowID:
+		m.ClearRefShowID()
+		return nil
+	}
+	return fmt.Errorf("unknown ShowReserve nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShowReserveMutation) ResetField(name string) error {
+	switch name {
+	case showreserve.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case showreserve.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case showreserve.FieldMemories:
+		m.ResetMemories()
+		return nil
+	case showreserve.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case showreserve.FieldRefShowID:
+		m.ResetRefShowID()
+		return nil
+	}
+	return fmt.Errorf("unknown ShowReserve field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShowReserveMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.show != nil {
+		edges = append(edges, showreserve.EdgeShow)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShowReserveMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case showreserve.EdgeShow:
+		if id := m.show; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShowReserveMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShowReserveMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShowReserveMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedshow {
+		edges = append(edges, showreserve.EdgeShow)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShowReserveMutation) EdgeCleared(name string) bool {
+	switch name {
+	case showreserve.EdgeShow:
+		return m.clearedshow
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShowReserveMutation) ClearEdge(name string) error {
+	switch name {
+	case showreserve.EdgeShow:
+		m.ClearShow()
+		return nil
+	}
+	return fmt.Errorf("unknown ShowReserve unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShowReserveMutation) ResetEdge(name string) error {
+	switch name {
+	case showreserve.EdgeShow:
+		m.ResetShow()
+		return nil
+	}
+	return fmt.Errorf("unknown ShowReserve edge %s", name)
+}
+
+// StepMutation represents an operation that mutates the Step nodes in the graph.
+type StepMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uint64
+	title               *string
+	description         *string
+	is_challenge        *bool
+	teacher_comment     *map[string]interface{}
+	parent_comment      *map[string]interface{}
+	friend_comment      *map[string]interface{}
+	teacher_reviewer_id *string
+	parent_reviewer_id  *string
+	_type               *step.Type
+	object_name         *string
+	created_at          *int64
+	addcreated_at       *int64
+	measurement         *float64
+	addmeasurement      *float64
+	content             *string
+	url                 *string
+	link_title          *string
+	link_description    *string
+	mime_type           *string
+	page_count          *uint32
+	addpage_count       *int32
+	preview_object_name *string
+	sort_order          *float64
+	addsort_order       *float64
+	_path               *string
+	clearedFields       map[string]struct{}
+	target              *uint64
+	clearedtarget       bool
+	parent              *uint64
+	clearedparent       bool
+	children            map[uint64]struct{}
+	removedchildren     map[uint64]struct{}
+	clearedchildren     bool
+	tags                map[uint64]struct{}
+	removedtags         map[uint64]struct{}
+	clearedtags         bool
+	done                bool
+	oldValue            func(context.Context) (*Step, error)
+	predicates          []predicate.Step
+}
+
+var _ ent.Mutation = (*StepMutation)(nil)
+
+// stepOption allows management of the mutation configuration using functional options.
+type stepOption func(*StepMutation)
+
+// newStepMutation creates new mutation for the Step entity.
+func newStepMutation(c config, op Op, opts ...stepOption) *StepMutation {
+	m := &StepMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStep,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStepID sets the ID field of the mutation.
+func withStepID(id uint64) stepOption {
+	return func(m *StepMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Step
+		)
+		m.oldValue = func(ctx context.Context) (*Step, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Step.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStep sets the old Step of the mutation.
+func withStep(node *Step) stepOption {
+	return func(m *StepMutation) {
+		m.oldValue = func(context.Context) (*Step, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StepMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StepMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Step entities.
+func (m *StepMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StepMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StepMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Step.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *StepMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *StepMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *StepMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[step.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *StepMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[step.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *StepMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, step.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *StepMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *StepMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *StepMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[step.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *StepMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[step.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *StepMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, step.FieldDescription)
+}
+
+// SetIsChallenge sets the "is_challenge" field.
+func (m *StepMutation) SetIsChallenge(b bool) {
+	m.is_challenge = &b
+}
+
+// IsChallenge returns the value of the "is_challenge" field in the mutation.
+func (m *StepMutation) IsChallenge() (r bool, exists bool) {
+	v := m.is_challenge
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsChallenge returns the old "is_challenge" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldIsChallenge(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsChallenge is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsChallenge requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsChallenge: %w", err)
+	}
+	return oldValue.IsChallenge, nil
+}
+
+// ResetIsChallenge resets all changes to the "is_challenge" field.
+func (m *StepMutation) ResetIsChallenge() {
+	m.is_challenge = nil
+}
+
+// SetTeacherComment sets the "teacher_comment" field.
+func (m *StepMutation) SetTeacherComment(value map[string]interface{}) {
+	m.teacher_comment = &value
+}
+
+// TeacherComment returns the value of the "teacher_comment" field in the mutation.
+func (m *StepMutation) TeacherComment() (r map[string]interface{}, exists bool) {
+	v := m.teacher_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeacherComment returns the old "teacher_comment" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldTeacherComment(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeacherComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeacherComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeacherComment: %w", err)
+	}
+	return oldValue.TeacherComment, nil
+}
+
+// ClearTeacherComment clears the value of the "teacher_comment" field.
+func (m *StepMutation) ClearTeacherComment() {
+	m.teacher_comment = nil
+	m.clearedFields[step.FieldTeacherComment] = struct{}{}
+}
+
+// TeacherCommentCleared returns if the "teacher_comment" field was cleared in this mutation.
+func (m *StepMutation) TeacherCommentCleared() bool {
+	_, ok := m.clearedFields[step.FieldTeacherComment]
+	return ok
+}
+
+// ResetTeacherComment resets all changes to the "teacher_comment" field.
+func (m *StepMutation) ResetTeacherComment() {
+	m.teacher_comment = nil
+	delete(m.clearedFields, step.FieldTeacherComment)
+}
+
+// SetParentComment sets the "parent_comment" field.
+func (m *StepMutation) SetParentComment(value map[string]interface{}) {
+	m.parent_comment = &value
+}
+
+// ParentComment returns the value of the "parent_comment" field in the mutation.
+func (m *StepMutation) ParentComment() (r map[string]interface{}, exists bool) {
+	v := m.parent_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentComment returns the old "parent_comment" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldParentComment(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentComment: %w", err)
+	}
+	return oldValue.ParentComment, nil
+}
+
+// ClearParentComment clears the value of the "parent_comment" field.
+func (m *StepMutation) ClearParentComment() {
+	m.parent_comment = nil
+	m.clearedFields[step.FieldParentComment] = struct{}{}
+}
+
+// ParentCommentCleared returns if the "parent_comment" field was cleared in this mutation.
+func (m *StepMutation) ParentCommentCleared() bool {
+	_, ok := m.clearedFields[step.FieldParentComment]
+	return ok
+}
+
+// ResetParentComment resets all changes to the "parent_comment" field.
+func (m *StepMutation) ResetParentComment() {
+	m.parent_comment = nil
+	delete(m.clearedFields, step.FieldParentComment)
+}
+
+// SetFriendComment sets the "friend_comment" field.
+func (m *StepMutation) SetFriendComment(value map[string]interface{}) {
+	m.friend_comment = &value
+}
+
+// FriendComment returns the value of the "friend_comment" field in the mutation.
+func (m *StepMutation) FriendComment() (r map[string]interface{}, exists bool) {
+	v := m.friend_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFriendComment returns the old "friend_comment" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldFriendComment(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFriendComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFriendComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFriendComment: %w", err)
+	}
+	return oldValue.FriendComment, nil
+}
+
+// ClearFriendComment clears the value of the "friend_comment" field.
+func (m *StepMutation) ClearFriendComment() {
+	m.friend_comment = nil
+	m.clearedFields[step.FieldFriendComment] = struct{}{}
+}
+
+// FriendCommentCleared returns if the "friend_comment" field was cleared in this mutation.
+func (m *StepMutation) FriendCommentCleared() bool {
+	_, ok := m.clearedFields[step.FieldFriendComment]
+	return ok
+}
+
+// ResetFriendComment resets all changes to the "friend_comment" field.
+func (m *StepMutation) ResetFriendComment() {
+	m.friend_comment = nil
+	delete(m.clearedFields, step.FieldFriendComment)
+}
+
+// SetTeacherReviewerID sets the "teacher_reviewer_id" field.
+func (m *StepMutation) SetTeacherReviewerID(s string) {
+	m.teacher_reviewer_id = &s
+}
+
+// TeacherReviewerID returns the value of the "teacher_reviewer_id" field in the mutation.
+func (m *StepMutation) TeacherReviewerID() (r string, exists bool) {
+	v := m.teacher_reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeacherReviewerID returns the old "teacher_reviewer_id" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldTeacherReviewerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeacherReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeacherReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeacherReviewerID: %w", err)
+	}
+	return oldValue.TeacherReviewerID, nil
+}
+
+// ClearTeacherReviewerID clears the value of the "teacher_reviewer_id" field.
+func (m *StepMutation) ClearTeacherReviewerID() {
+	m.teacher_reviewer_id = nil
+	m.clearedFields[step.FieldTeacherReviewerID] = struct{}{}
+}
+
+// TeacherReviewerIDCleared returns if the "teacher_reviewer_id" field was cleared in this mutation.
+func (m *StepMutation) TeacherReviewerIDCleared() bool {
+	_, ok := m.clearedFields[step.FieldTeacherReviewerID]
+	return ok
+}
+
+// ResetTeacherReviewerID resets all changes to the "teacher_reviewer_id" field.
+func (m *StepMutation) ResetTeacherReviewerID() {
+	m.teacher_reviewer_id = nil
+	delete(m.clearedFields, step.FieldTeacherReviewerID)
+}
+
+// SetParentReviewerID sets the "parent_reviewer_id" field.
+func (m *StepMutation) SetParentReviewerID(s string) {
+	m.parent_reviewer_id = &s
+}
+
+// ParentReviewerID returns the value of the "parent_reviewer_id" field in the mutation.
+func (m *StepMutation) ParentReviewerID() (r string, exists bool) {
+	v := m.parent_reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentReviewerID returns the old "parent_reviewer_id" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldParentReviewerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentReviewerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentReviewerID: %w", err)
+	}
+	return oldValue.ParentReviewerID, nil
+}
+
+// ClearParentReviewerID clears the value of the "parent_reviewer_id" field.
+func (m *StepMutation) ClearParentReviewerID() {
+	m.parent_reviewer_id = nil
+	m.clearedFields[step.FieldParentReviewerID] = struct{}{}
+}
+
+// ParentReviewerIDCleared returns if the "parent_reviewer_id" field was cleared in this mutation.
+func (m *StepMutation) ParentReviewerIDCleared() bool {
+	_, ok := m.clearedFields[step.FieldParentReviewerID]
+	return ok
+}
+
+// ResetParentReviewerID resets all changes to the "parent_reviewer_id" field.
+func (m *StepMutation) ResetParentReviewerID() {
+	m.parent_reviewer_id = nil
+	delete(m.clearedFields, step.FieldParentReviewerID)
+}
+
+// SetType sets the "type" field.
+func (m *StepMutation) SetType(s step.Type) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *StepMutation) GetType() (r step.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldType(ctx context.Context) (v step.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *StepMutation) ResetType() {
+	m._type = nil
+}
+
+// SetObjectName sets the "object_name" field.
+func (m *StepMutation) SetObjectName(s string) {
+	m.object_name = &s
+}
+
+// ObjectName returns the value of the "object_name" field in the mutation.
+func (m *StepMutation) ObjectName() (r string, exists bool) {
+	v := m.object_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectName returns the old "object_name" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectName: %w", err)
+	}
+	return oldValue.ObjectName, nil
+}
+
+// ClearObjectName clears the value of the "object_name" field.
+func (m *StepMutation) ClearObjectName() {
+	m.object_name = nil
+	m.clearedFields[step.FieldObjectName] = struct{}{}
+}
+
+// ObjectNameCleared returns if the "object_name" field was cleared in this mutation.
+func (m *StepMutation) ObjectNameCleared() bool {
+	_, ok := m.clearedFields[step.FieldObjectName]
+	return ok
+}
+
+// ResetObjectName resets all changes to the "object_name" field.
+func (m *StepMutation) ResetObjectName() {
+	m.object_name = nil
+	delete(m.clearedFields, step.FieldObjectName)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StepMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StepMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *StepMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *StepMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StepMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetRefTargetID sets the "ref_target_id" field.
+func (m *StepMutation) SetRefTargetID(u uint64) {
+	m.target = &u
+}
+
+// RefTargetID returns the value of the "ref_target_id" field in the mutation.
+func (m *StepMutation) RefTargetID() (r uint64, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefTargetID returns the old "ref_target_id" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldRefTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefTargetID: %w", err)
+	}
+	return oldValue.RefTargetID, nil
+}
+
+// ClearRefTargetID clears the value of the "ref_target_id" field.
+func (m *StepMutation) ClearRefTargetID() {
+	m.target = nil
+	m.clearedFields[step.FieldRefTargetID] = struct{}{}
+}
+
+// RefTargetIDCleared returns if the "ref_target_id" field was cleared in this mutation.
+func (m *StepMutation) RefTargetIDCleared() bool {
+	_, ok := m.clearedFields[step.FieldRefTargetID]
+	return ok
+}
+
+// ResetRefTargetID resets all changes to the "ref_target_id" field.
+func (m *StepMutation) ResetRefTargetID() {
+	m.target = nil
+	delete(m.clearedFields, step.FieldRefTargetID)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *StepMutation) SetParentID(u uint64) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *StepMutation) ParentID() (r uint64, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldParentID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *StepMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[step.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *StepMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[step.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *StepMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, step.FieldParentID)
+}
+
+// SetMeasurement sets the "measurement" field.
+func (m *StepMutation) SetMeasurement(f float64) {
+	m.measurement = &f
+	m.addmeasurement = nil
+}
+
+// Measurement returns the value of the "measurement" field in the mutation.
+func (m *StepMutation) Measurement() (r float64, exists bool) {
+	v := m.measurement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeasurement returns the old "measurement" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldMeasurement(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeasurement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeasurement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeasurement: %w", err)
+	}
+	return oldValue.Measurement, nil
+}
+
+// AddMeasurement adds f to the "measurement" field.
+func (m *StepMutation) AddMeasurement(f float64) {
+	if m.addmeasurement != nil {
+		*m.addmeasurement += f
+	} else {
+		m.addmeasurement = &f
+	}
+}
+
+// AddedMeasurement returns the value that was added to the "measurement" field in this mutation.
+func (m *StepMutation) AddedMeasurement() (r float64, exists bool) {
+	v := m.addmeasurement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMeasurement clears the value of the "measurement" field.
+func (m *StepMutation) ClearMeasurement() {
+	m.measurement = nil
+	m.addmeasurement = nil
+	m.clearedFields[step.FieldMeasurement] = struct{}{}
+}
+
+// MeasurementCleared returns if the "measurement" field was cleared in this mutation.
+func (m *StepMutation) MeasurementCleared() bool {
+	_, ok := m.clearedFields[step.FieldMeasurement]
+	return ok
+}
+
+// ResetMeasurement resets all changes to the "measurement" field.
+func (m *StepMutation) ResetMeasurement() {
+	m.measurement = nil
+	m.addmeasurement = nil
+	delete(m.clearedFields, step.FieldMeasurement)
+}
+
+// SetContent sets the "content" field.
+func (m *StepMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *StepMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *StepMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[step.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *StepMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[step.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *StepMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, step.FieldContent)
+}
+
+// SetURL sets the "url" field.
+func (m *StepMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *StepMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *StepMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[step.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *StepMutation) URLCleared() bool {
+	_, ok := m.clearedFields[step.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *StepMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, step.FieldURL)
+}
+
+// SetLinkTitle sets the "link_title" field.
+func (m *StepMutation) SetLinkTitle(s string) {
+	m.link_title = &s
+}
+
+// LinkTitle returns the value of the "link_title" field in the mutation.
+func (m *StepMutation) LinkTitle() (r string, exists bool) {
+	v := m.link_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkTitle returns the old "link_title" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldLinkTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkTitle: %w", err)
+	}
+	return oldValue.LinkTitle, nil
+}
+
+// ClearLinkTitle clears the value of the "link_title" field.
+func (m *StepMutation) ClearLinkTitle() {
+	m.link_title = nil
+	m.clearedFields[step.FieldLinkTitle] = struct{}{}
+}
+
+// LinkTitleCleared returns if the "link_title" field was cleared in this mutation.
+func (m *StepMutation) LinkTitleCleared() bool {
+	_, ok := m.clearedFields[step.FieldLinkTitle]
+	return ok
+}
+
+// ResetLinkTitle resets all changes to the "link_title" field.
+func (m *StepMutation) ResetLinkTitle() {
+	m.link_title = nil
+	delete(m.clearedFields, step.FieldLinkTitle)
+}
+
+// SetLinkDescription sets the "link_description" field.
+func (m *StepMutation) SetLinkDescription(s string) {
+	m.link_description = &s
+}
+
+// LinkDescription returns the value of the "link_description" field in the mutation.
+func (m *StepMutation) LinkDescription() (r string, exists bool) {
+	v := m.link_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkDescription returns the old "link_description" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldLinkDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkDescription: %w", err)
+	}
+	return oldValue.LinkDescription, nil
+}
+
+// ClearLinkDescription clears the value of the "link_description" field.
+func (m *StepMutation) ClearLinkDescription() {
+	m.link_description = nil
+	m.clearedFields[step.FieldLinkDescription] = struct{}{}
+}
+
+// LinkDescriptionCleared returns if the "link_description" field was cleared in this mutation.
+func (m *StepMutation) LinkDescriptionCleared() bool {
+	_, ok := m.clearedFields[step.FieldLinkDescription]
+	return ok
+}
+
+// ResetLinkDescription resets all changes to the "link_description" field.
+func (m *StepMutation) ResetLinkDescription() {
+	m.link_description = nil
+	delete(m.clearedFields, step.FieldLinkDescription)
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *StepMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *StepMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *StepMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[step.FieldMimeType] = struct{}{}
+}
+
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *StepMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[step.FieldMimeType]
+	return ok
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *StepMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, step.FieldMimeType)
+}
+
+// SetPageCount sets the "page_count" field.
+func (m *StepMutation) SetPageCount(u uint32) {
+	m.page_count = &u
+	m.addpage_count = nil
+}
+
+// PageCount returns the value of the "page_count" field in the mutation.
+func (m *StepMutation) PageCount() (r uint32, exists bool) {
+	v := m.page_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPageCount returns the old "page_count" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldPageCount(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPageCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPageCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPageCount: %w", err)
+	}
+	return oldValue.PageCount, nil
+}
+
+// AddPageCount adds u to the "page_count" field.
+func (m *StepMutation) AddPageCount(u int32) {
+	if m.addpage_count != nil {
+		*m.addpage_count += u
+	} else {
+		m.addpage_count = &u
+	}
+}
+
+// AddedPageCount returns the value that was added to the "page_count" field in this mutation.
+func (m *StepMutation) AddedPageCount() (r int32, exists bool) {
+	v := m.addpage_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPageCount clears the value of the "page_count" field.
+func (m *StepMutation) ClearPageCount() {
+	m.page_count = nil
+	m.addpage_count = nil
+	m.clearedFields[step.FieldPageCount] = struct{}{}
+}
+
+// PageCountCleared returns if the "page_count" field was cleared in this mutation.
+func (m *StepMutation) PageCountCleared() bool {
+	_, ok := m.clearedFields[step.FieldPageCount]
+	return ok
+}
+
+// ResetPageCount resets all changes to the "page_count" field.
+func (m *StepMutation) ResetPageCount() {
+	m.page_count = nil
+	m.addpage_count = nil
+	delete(m.clearedFields, step.FieldPageCount)
+}
+
+// SetPreviewObjectName sets the "preview_object_name" field.
+func (m *StepMutation) SetPreviewObjectName(s string) {
+	m.preview_object_name = &s
+}
+
+// PreviewObjectName returns the value of the "preview_object_name" field in the mutation.
+func (m *StepMutation) PreviewObjectName() (r string, exists bool) {
+	v := m.preview_object_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewObjectName returns the old "preview_object_name" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldPreviewObjectName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewObjectName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewObjectName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewObjectName: %w", err)
+	}
+	return oldValue.PreviewObjectName, nil
+}
+
+// ClearPreviewObjectName clears the value of the "preview_object_name" field.
+func (m *StepMutation) ClearPreviewObjectName() {
+	m.preview_object_name = nil
+	m.clearedFields[step.FieldPreviewObjectName] = struct{}{}
+}
+
+// PreviewObjectNameCleared returns if the "preview_object_name" field was cleared in this mutation.
+func (m *StepMutation) PreviewObjectNameCleared() bool {
+	_, ok := m.clearedFields[step.FieldPreviewObjectName]
+	return ok
+}
+
+// ResetPreviewObjectName resets all changes to the "preview_object_name" field.
+func (m *StepMutation) ResetPreviewObjectName() {
+	m.preview_object_name = nil
+	delete(m.clearedFields, step.FieldPreviewObjectName)
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *StepMutation) SetSortOrder(f float64) {
+	m.sort_order = &f
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *StepMutation) SortOrder() (r float64, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldSortOrder(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds f to the "sort_order" field.
+func (m *StepMutation) AddSortOrder(f float64) {
+	if m.addsort_order != nil {
+		*m.addsort_order += f
+	} else {
+		m.addsort_order = &f
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *StepMutation) AddedSortOrder() (r float64, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *StepMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetPath sets the "path" field.
+func (m *StepMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *StepMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the Step entity.
+// If the Step object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *StepMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetTargetID sets the "target" edge to the Target entity by id.
+func (m *StepMutation) SetTargetID(id uint64) {
+	m.target = &id
+}
+
+// ClearTarget clears the "target" edge to the Target entity.
+func (m *StepMutation) ClearTarget() {
+	m.clearedtarget = true
+	m.clearedFields[step.FieldRefTargetID] = struct{}{}
+}
+
+// TargetCleared reports if the "target" edge to the Target entity was cleared.
+func (m *StepMutation) TargetCleared() bool {
+	return m.RefTargetIDCleared() || m.clearedtarget
+}
+
+// TargetID returns the "target" edge ID in the mutation.
+func (m *StepMutation) TargetID() (id uint64, exists bool) {
+	if m.target != nil {
+		return *m.target, true
+	}
+	return
+}
+
+// TargetIDs returns the "target" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetID instead. It exists only for internal usage by the builders.
+func (m *StepMutation) TargetIDs() (ids []uint64) {
+	if id := m.target; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTarget resets all changes to the "target" edge.
+func (m *StepMutation) ResetTarget() {
+	m.target = nil
+	m.clearedtarget = false
+}
+
+// ClearParent clears the "parent" edge to the Step entity.
+func (m *StepMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[step.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Step entity was cleared.
+func (m *StepMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *StepMutation) ParentIDs() (ids []uint64) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *StepMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Step entity by ids.
+func (m *StepMutation) AddChildIDs(ids ...uint64) {
+	if m.children == nil {
+		m.children = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Step entity.
+func (m *StepMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Step entity was cleared.
+func (m *StepMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Step entity by IDs.
+func (m *StepMutation) RemoveChildIDs(ids ...uint64) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Step entity.
+func (m *StepMutation) RemovedChildrenIDs() (ids []uint64) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *StepMutation) ChildrenIDs() (ids []uint64) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *StepMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *StepMutation) AddTagIDs(ids ...uint64) {
+	if m.tags == nil {
+		m.tags = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *StepMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *StepMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *StepMutation) RemoveTagIDs(ids ...uint64) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *StepMutation) RemovedTagsIDs() (ids []uint64) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *StepMutation) TagsIDs() (ids []uint64) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *StepMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// Where appends a list predicates to the StepMutation builder.
+func (m *StepMutation) Where(ps ...predicate.Step) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StepMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StepMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Step, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StepMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StepMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Step).
+func (m *StepMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StepMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.title != nil {
+		fields = append(fields, step.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, step.FieldDescription)
+	}
+	if m.is_challenge != nil {
+		fields = append(fields, step.FieldIsChallenge)
+	}
+	if m.teacher_comment != nil {
+		fields = append(fields, step.FieldTeacherComment)
+	}
+	if m.parent_comment != nil {
+		fields = append(fields, step.FieldParentComment)
+	}
+	if m.friend_comment != nil {
+		fields = append(fields, step.FieldFriendComment)
+	}
+	if m.teacher_reviewer_id != nil {
+		fields = append(fields, step.FieldTeacherReviewerID)
+	}
+	if m.parent_reviewer_id != nil {
+		fields = append(fields, step.FieldParentReviewerID)
+	}
+	if m._type != nil {
+		fields = append(fields, step.FieldType)
+	}
+	if m.object_name != nil {
+		fields = append(fields, step.FieldObjectName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, step.FieldCreatedAt)
+	}
+	if m.target != nil {
+		fields = append(fields, step.FieldRefTargetID)
+	}
+	if m.parent != nil {
+		fields = append(fields, step.FieldParentID)
+	}
+	if m.measurement != nil {
+		fields = append(fields, step.FieldMeasurement)
+	}
+	if m.content != nil {
+		fields = append(fields, step.FieldContent)
+	}
+	if m.url != nil {
+		fields = append(fields, step.FieldURL)
+	}
+	if m.link_title != nil {
+		fields = append(fields, step.FieldLinkTitle)
+	}
+	if m.link_description != nil {
+		fields = append(fields, step.FieldLinkDescription)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, step.FieldMimeType)
+	}
+	if m.page_count != nil {
+		fields = append(fields, step.FieldPageCount)
+	}
+	if m.preview_object_name != nil {
+		fields = append(fields, step.FieldPreviewObjectName)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, step.FieldSortOrder)
+	}
+	if m._path != nil {
+		fields = append(fields, step.FieldPath)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StepMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case step.FieldTitle:
+		return m.Title()
+	case step.FieldDescription:
+		return m.Description()
+	case step.FieldIsChallenge:
+		return m.IsChallenge()
+	case step.FieldTeacherComment:
+		return m.TeacherComment()
+	case step.FieldParentComment:
+		return m.ParentComment()
+	case step.FieldFriendComment:
+		return m.FriendComment()
+	case step.FieldTeacherReviewerID:
+		return m.TeacherReviewerID()
+	case step.FieldParentReviewerID:
+		return m.ParentReviewerID()
+	case step.FieldType:
+		return m.GetType()
+	case step.FieldObjectName:
+		return m.ObjectName()
+	case step.FieldCreatedAt:
+		return m.CreatedAt()
+	case step.FieldRefTargetID:
+		return m.RefTargetID()
+	case step.FieldParentID:
+		return m.ParentID()
+	case step.FieldMeasurement:
+		return m.Measurement()
+	case step.FieldContent:
+		return m.Content()
+	case step.FieldURL:
+		return m.URL()
+	case step.FieldLinkTitle:
+		return m.LinkTitle()
+	case step.FieldLinkDescription:
+		return m.LinkDescription()
+	case step.FieldMimeType:
+		return m.MimeType()
+	case step.FieldPageCount:
+		return m.PageCount()
+	case step.FieldPreviewObjectName:
+		return m.PreviewObjectName()
+	case step.FieldSortOrder:
+		return m.SortOrder()
+	case step.FieldPath:
+		return m.Path()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StepMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case step.FieldTitle:
+		return m.OldTitle(ctx)
+	case step.FieldDescription:
+		return m.OldDescription(ctx)
+	case step.FieldIsChallenge:
+		return m.OldIsChallenge(ctx)
+	case step.FieldTeacherComment:
+		return m.OldTeacherComment(ctx)
+	case step.FieldParentComment:
+		return m.OldParentComment(ctx)
+	case step.FieldFriendComment:
+		return m.OldFriendComment(ctx)
+	case step.FieldTeacherReviewerID:
+		return m.OldTeacherReviewerID(ctx)
+	case step.FieldParentReviewerID:
+		return m.OldParentReviewerID(ctx)
+	case step.FieldType:
+		return m.OldType(ctx)
+	case step.FieldObjectName:
+		return m.OldObjectName(ctx)
+	case step.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case step.FieldRefTargetID:
+		return m.OldRefTargetID(ctx)
+	case step.FieldParentID:
+		return m.OldParentID(ctx)
+	case step.FieldMeasurement:
+		return m.OldMeasurement(ctx)
+	case step.FieldContent:
+		return m.OldContent(ctx)
+	case step.FieldURL:
+		return m.OldURL(ctx)
+	case step.FieldLinkTitle:
+		return m.OldLinkTitle(ctx)
+	case step.FieldLinkDescription:
+		return m.OldLinkDescription(ctx)
+	case step.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case step.FieldPageCount:
+		return m.OldPageCount(ctx)
+	case step.FieldPreviewObjectName:
+		return m.OldPreviewObjectName(ctx)
+	case step.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case step.FieldPath:
+		return m.OldPath(ctx)
+	}
+	return nil, fmt.Errorf("unknown Step field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StepMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case step.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case step.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case step.FieldIsChallenge:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsChallenge(v)
+		return nil
+	case step.FieldTeacherComment:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeacherComment(v)
+		return nil
+	case step.FieldParentComment:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentComment(v)
+		return nil
+	case step.FieldFriendComment:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFriendComment(v)
+		return nil
+	case step.FieldTeacherReviewerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeacherReviewerID(v)
+		return nil
+	case step.FieldParentReviewerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentReviewerID(v)
+		return nil
+	case step.FieldType:
+		v, ok := value.(step.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case step.FieldObjectName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectName(v)
+		return nil
+	case step.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case step.FieldRefTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefTargetID(v)
+		return nil
+	case step.FieldParentID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case step.FieldMeasurement:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeasurement(v)
+		return nil
+	case step.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case step.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case step.FieldLinkTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkTitle(v)
+		return nil
+	case step.FieldLinkDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkDescription(v)
+		return nil
+	case step.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case step.FieldPageCount:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPageCount(v)
+		return nil
+	case step.FieldPreviewObjectName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewObjectName(v)
+		return nil
+	case step.FieldSortOrder:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	case step.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Step field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StepMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, step.FieldCreatedAt)
+	}
+	if m.addmeasurement != nil {
+		fields = append(fields, step.FieldMeasurement)
+	}
+	if m.addpage_count != nil {
+		fields = append(fields, step.FieldPageCount)
+	}
+	if m.addsort_order != nil {
+		fields = append(fields, step.FieldSortOrder)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StepMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case step.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case step.FieldMeasurement:
+		return m.AddedMeasurement()
+	case step.FieldPageCount:
+		return m.AddedPageCount()
+	case step.FieldSortOrder:
+		return m.AddedSortOrder()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StepMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case step.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	case step.FieldMeasurement:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMeasurement(v)
+		return nil
+	case step.FieldPageCount:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPageCount(v)
+		return nil
+	case step.FieldSortOrder:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Step numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StepMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(step.FieldTitle) {
+		fields = append(fields, step.FieldTitle)
+	}
+	if m.FieldCleared(step.FieldDescription) {
+		fields = append(fields, step.FieldDescription)
+	}
+	if m.FieldCleared(step.FieldTeacherComment) {
+		fields = append(fields, step.FieldTeacherComment)
+	}
+	if m.FieldCleared(step.FieldParentComment) {
+		fields = append(fields, step.FieldParentComment)
+	}
+	if m.FieldCleared(step.FieldFriendComment) {
+		fields = append(fields, step.FieldFriendComment)
+	}
+	if m.FieldCleared(step.FieldTeacherReviewerID) {
+		fields = append(fields, step.FieldTeacherReviewerID)
+	}
+	if m.FieldCleared(step.FieldParentReviewerID) {
+		fields = append(fields, step.FieldParentReviewerID)
+	}
+	if m.FieldCleared(step.FieldObjectName) {
+		fields = append(fields, step.FieldObjectName)
+	}
+	if m.FieldCleared(step.FieldRefTargetID) {
+		fields = append(fields, step.FieldRefTargetID)
+	}
+	if m.FieldCleared(step.FieldParentID) {
+		fields = append(fields, step.FieldParentID)
+	}
+	if m.FieldCleared(step.FieldMeasurement) {
+		fields = append(fields, step.FieldMeasurement)
+	}
+	if m.FieldCleared(step.FieldContent) {
+		fields = append(fields, step.FieldContent)
+	}
+	if m.FieldCleared(step.FieldURL) {
+		fields = append(fields, step.FieldURL)
+	}
+	if m.FieldCleared(step.FieldLinkTitle) {
+		fields = append(fields, step.FieldLinkTitle)
+	}
+	if m.FieldCleared(step.FieldLinkDescription) {
+		fields = append(fields, step.FieldLinkDescription)
+	}
+	if m.FieldCleared(step.FieldMimeType) {
+		fields = append(fields, step.FieldMimeType)
+	}
+	if m.FieldCleared(step.FieldPageCount) {
+		fields = append(fields, step.FieldPageCount)
+	}
+	if m.FieldCleared(step.FieldPreviewObjectName) {
+		fields = append(fields, step.FieldPreviewObjectName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StepMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StepMutation) ClearField(name string) error {
+	switch name {
+	case step.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case step.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case step.FieldTeacherComment:
+		m.ClearTeacherComment()
+		return nil
+	case step.FieldParentComment:
+		m.ClearParentComment()
+		return nil
+	case step.FieldFriendComment:
+		m.ClearFriendComment()
+		return nil
+	case step.FieldTeacherReviewerID:
+		m.ClearTeacherReviewerID()
+		return nil
+	case step.FieldParentReviewerID:
+		m.ClearParentReviewerID()
+		return nil
+	case step.FieldObjectName:
+		m.ClearObjectName()
+		return nil
+	case step.FieldRefTargetID:
+		m.ClearRefTargetID()
+		return nil
+	case step.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case step.FieldMeasurement:
+		m.ClearMeasurement()
+		return nil
+	case step.FieldContent:
+		m.ClearContent()
+		return nil
+	case step.FieldURL:
+		m.ClearURL()
+		return nil
+	case step.FieldLinkTitle:
+		m.ClearLinkTitle()
+		return nil
+	case step.FieldLinkDescription:
+		m.ClearLinkDescription()
+		return nil
+	case step.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case step.FieldPageCount:
+		m.ClearPageCount()
+		return nil
+	case step.FieldPreviewObjectName:
+		m.ClearPreviewObjectName()
+		return nil
+	}
+	return fmt.Errorf("unknown Step nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StepMutation) ResetField(name string) error {
+	switch name {
+	case step.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case step.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case step.FieldIsChallenge:
+		m.ResetIsChallenge()
+		return nil
+	case step.FieldTeacherComment:
+		m.ResetTeacherComment()
+		return nil
+	case step.FieldParentComment:
+		m.ResetParentComment()
+		return nil
+	case step.FieldFriendComment:
+		m.ResetFriendComment()
+		return nil
+	case step.FieldTeacherReviewerID:
+		m.ResetTeacherReviewerID()
+		return nil
+	case step.FieldParentReviewerID:
+		m.ResetParentReviewerID()
+		return nil
+	case step.FieldType:
+		m.ResetType()
+		return nil
+	case step.FieldObjectName:
+		m.ResetObjectName()
+		return nil
+	case step.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case step.FieldRefTargetID:
+		m.ResetRefTargetID()
+		return nil
+	case step.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case step.FieldMeasurement:
+		m.ResetMeasurement()
+		return nil
+	case step.FieldContent:
+		m.ResetContent()
+		return nil
+	case step.FieldURL:
+		m.ResetURL()
+		return nil
+	case step.FieldLinkTitle:
+		m.ResetLinkTitle()
+		return nil
+	case step.FieldLinkDescription:
+		m.ResetLinkDescription()
+		return nil
+	case step.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case step.FieldPageCount:
+		m.ResetPageCount()
+		return nil
+	case step.FieldPreviewObjectName:
+		m.ResetPreviewObjectName()
+		return nil
+	case step.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	case step.FieldPath:
+		m.ResetPath()
+		return nil
+	}
+	return fmt.Errorf("unknown Step field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StepMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.target != nil {
+		edges = append(edges, step.EdgeTarget)
+	}
+	if m.parent != nil {
+		edges = append(edges, step.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, step.EdgeChildren)
+	}
+	if m.tags != nil {
+		edges = append(edges, step.EdgeTags)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StepMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case step.EdgeTarget:
+		if id := m.target; id != nil {
+			return []ent.Value{*id}
+		}
+	case step.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case step.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case step.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StepMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, step.EdgeChildren)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, step.EdgeTags)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StepMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case step.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case step.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StepMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtarget {
+		edges = append(edges, step.EdgeTarget)
+	}
+	if m.clearedparent {
+		edges = append(edges, step.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, step.EdgeChildren)
+	}
+	if m.clearedtags {
+		edges = append(edges, step.EdgeTags)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StepMutation) EdgeCleared(name string) bool {
+	switch name {
+	case step.EdgeTarget:
+		return m.clearedtarget
+	case step.EdgeParent:
+		return m.clearedparent
+	case step.EdgeChildren:
+		return m.clearedchildren
+	case step.EdgeTags:
+		return m.clearedtags
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StepMutation) ClearEdge(name string) error {
+	switch name {
+	case step.EdgeTarget:
+		m.ClearTarget()
+		return nil
+	case step.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Step unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StepMutation) ResetEdge(name string) error {
+	switch name {
+	case step.EdgeTarget:
+		m.ResetTarget()
+		return nil
+	case step.EdgeParent:
+		m.ResetParent()
+		return nil
+	case step.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case step.EdgeTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Step edge %s", name)
+}
+
+// StepRateMutation represents an operation that mutates the StepRate nodes in the graph.
+type StepRateMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uint64
+	user_id                   *string
+	top_target_id             *uint64
+	addtop_target_id          *int64
+	target_id                 *uint64
+	addtarget_id              *int64
+	step_id                   *uint64
+	addstep_id                *int64
+	weighted_value            *float64
+	addweighted_value         *float64
+	target_reasonableness     *int32
+	addtarget_reasonableness  *int32
+	target_clarity            *int32
+	addtarget_clarity         *int32
+	target_achievement        *int32
+	addtarget_achievement     *int32
+	reflection_improvement    *int32
+	addreflection_improvement *int32
+	innovation                *int32
+	addinnovation             *int32
+	basic_reliability         *int32
+	addbasic_reliability      *int32
+	skill_improvement         *int32
+	addskill_improvement      *int32
+	difficulty                *int32
+	adddifficulty             *int32
+	self_scores               **objects.CommentScores
+	measurement               *float64
+	addmeasurement            *float64
+	create_counted            *bool
+	date                      *time.Time
+	clearedFields             map[string]struct{}
+	done                      bool
+	oldValue                  func(context.Context) (*StepRate, error)
+	predicates                []predicate.StepRate
+}
+
+var _ ent.Mutation = (*StepRateMutation)(nil)
+
+// steprateOption allows management of the mutation configuration using functional options.
+type steprateOption func(*StepRateMutation)
+
+// newStepRateMutation creates new mutation for the StepRate entity.
+func newStepRateMutation(c config, op Op, opts ...steprateOption) *StepRateMutation {
+	m := &StepRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStepRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStepRateID sets the ID field of the mutation.
+func withStepRateID(id uint64) steprateOption {
+	return func(m *StepRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StepRate
+		)
+		m.oldValue = func(ctx context.Context) (*StepRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StepRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStepRate sets the old StepRate of the mutation.
+func withStepRate(node *StepRate) steprateOption {
+	return func(m *StepRateMutation) {
+		m.oldValue = func(context.Context) (*StepRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StepRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StepRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StepRate entities.
+func (m *StepRateMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StepRateMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StepRateMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StepRate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *StepRateMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StepRateMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StepRateMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTopTargetID sets the "top_target_id" field.
+func (m *StepRateMutation) SetTopTargetID(u uint64) {
+	m.top_target_id = &u
+	m.addtop_target_id = nil
+}
+
+// TopTargetID returns the value of the "top_target_id" field in the mutation.
+func (m *StepRateMutation) TopTargetID() (r uint64, exists bool) {
+	v := m.top_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopTargetID returns the old "top_target_id" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldTopTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopTargetID: %w", err)
+	}
+	return oldValue.TopTargetID, nil
+}
+
+// AddTopTargetID adds u to the "top_target_id" field.
+func (m *StepRateMutation) AddTopTargetID(u int64) {
+	if m.addtop_target_id != nil {
+		*m.addtop_target_id += u
+	} else {
+		m.addtop_target_id = &u
+	}
+}
+
+// AddedTopTargetID returns the value that was added to the "top_target_id" field in this mutation.
+func (m *StepRateMutation) AddedTopTargetID() (r int64, exists bool) {
+	v := m.addtop_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTopTargetID resets all changes to the "top_target_id" field.
+func (m *StepRateMutation) ResetTopTargetID() {
+	m.top_target_id = nil
+	m.addtop_target_id = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *StepRateMutation) SetTargetID(u uint64) {
+	m.target_id = &u
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *StepRateMutation) TargetID() (r uint64, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (m *StepRateMutation) AddTargetID(u int64) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += u
+	} else {
+		m.addtarget_id = &u
+	}
+}
+
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *StepRateMutation) AddedTargetID() (r int64, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *StepRateMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+}
+
+// SetStepID sets the "step_id" field.
+func (m *StepRateMutation) SetStepID(u uint64) {
+	m.step_id = &u
+	m.addstep_id = nil
+}
+
+// StepID returns the value of the "step_id" field in the mutation.
+func (m *StepRateMutation) StepID() (r uint64, exists bool) {
+	v := m.step_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepID returns the old "step_id" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldStepID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
+	}
+	return oldValue.StepID, nil
+}
+
+// AddStepID adds u to the "step_id" field.
+func (m *StepRateMutation) AddStepID(u int64) {
+	if m.addstep_id != nil {
+		*m.addstep_id += u
+	} else {
+		m.addstep_id = &u
+	}
+}
+
+// AddedStepID returns the value that was added to the "step_id" field in this mutation.
+func (m *StepRateMutation) AddedStepID() (r int64, exists bool) {
+	v := m.addstep_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepID resets all changes to the "step_id" field.
+func (m *StepRateMutation) ResetStepID() {
+	m.step_id = nil
+	m.addstep_id = nil
+}
+
+// SetWeightedValue sets the "weighted_value" field.
+func (m *StepRateMutation) SetWeightedValue(f float64) {
+	m.weighted_value = &f
+	m.addweighted_value = nil
+}
+
+// WeightedValue returns the value of the "weighted_value" field in the mutation.
+func (m *StepRateMutation) WeightedValue() (r float64, exists bool) {
+	v := m.weighted_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeightedValue returns the old "weighted_value" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldWeightedValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeightedValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeightedValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeightedValue: %w", err)
+	}
+	return oldValue.WeightedValue, nil
+}
+
+// AddWeightedValue adds f to the "weighted_value" field.
+func (m *StepRateMutation) AddWeightedValue(f float64) {
+	if m.addweighted_value != nil {
+		*m.addweighted_value += f
+	} else {
+		m.addweighted_value = &f
+	}
+}
+
+// AddedWeightedValue returns the value that was added to the "weighted_value" field in this mutation.
+func (m *StepRateMutation) AddedWeightedValue() (r float64, exists bool) {
+	v := m.addweighted_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeightedValue resets all changes to the "weighted_value" field.
+func (m *StepRateMutation) ResetWeightedValue() {
+	m.weighted_value = nil
+	m.addweighted_value = nil
+}
+
+// SetTargetReasonableness sets the "target_reasonableness" field.
+func (m *StepRateMutation) SetTargetReasonableness(i int32) {
+	m.target_reasonableness = &i
+	m.addtarget_reasonableness = nil
+}
+
+// TargetReasonableness returns the value of the "target_reasonableness" field in the mutation.
+func (m *StepRateMutation) TargetReasonableness() (r int32, exists bool) {
+	v := m.target_reasonableness
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetReasonableness returns the old "target_reasonableness" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldTargetReasonableness(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetReasonableness is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetReasonableness requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetReasonableness: %w", err)
+	}
+	return oldValue.TargetReasonableness, nil
+}
+
+// AddTargetReasonableness adds i to the "target_reasonableness" field.
+func (m *StepRateMutation) AddTargetReasonableness(i int32) {
+	if m.addtarget_reasonableness != nil {
+		*m.addtarget_reasonableness += i
+	} else {
+		m.addtarget_reasonableness = &i
+	}
+}
+
+// AddedTargetReasonableness returns the value that was added to the "target_reasonableness" field in this mutation.
+func (m *StepRateMutation) AddedTargetReasonableness() (r int32, exists bool) {
+	v := m.addtarget_reasonableness
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetReasonableness resets all changes to the "target_reasonableness" field.
+func (m *StepRateMutation) ResetTargetReasonableness() {
+	m.target_reasonableness = nil
+	m.addtarget_reasonableness = nil
+}
+
+// SetTargetClarity sets the "target_clarity" field.
+func (m *StepRateMutation) SetTargetClarity(i int32) {
+	m.target_clarity = &i
+	m.addtarget_clarity = nil
+}
+
+// TargetClarity returns the value of the "target_clarity" field in the mutation.
+func (m *StepRateMutation) TargetClarity() (r int32, exists bool) {
+	v := m.target_clarity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetClarity returns the old "target_clarity" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldTargetClarity(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetClarity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetClarity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetClarity: %w", err)
+	}
+	return oldValue.TargetClarity, nil
+}
+
+// AddTargetClarity adds i to the "target_clarity" field.
+func (m *StepRateMutation) AddTargetClarity(i int32) {
+	if m.addtarget_clarity != nil {
+		*m.addtarget_clarity += i
+	} else {
+		m.addtarget_clarity = &i
+	}
+}
+
+// AddedTargetClarity returns the value that was added to the "target_clarity" field in this mutation.
+func (m *StepRateMutation) AddedTargetClarity() (r int32, exists bool) {
+	v := m.addtarget_clarity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetClarity resets all changes to the "target_clarity" field.
+func (m *StepRateMutation) ResetTargetClarity() {
+	m.target_clarity = nil
+	m.addtarget_clarity = nil
+}
+
+// SetTargetAchievement sets the "target_achievement" field.
+func (m *StepRateMutation) SetTargetAchievement(i int32) {
+	m.target_achievement = &i
+	m.addtarget_achievement = nil
+}
+
+// TargetAchievement returns the value of the "target_achievement" field in the mutation.
+func (m *StepRateMutation) TargetAchievement() (r int32, exists bool) {
+	v := m.target_achievement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetAchievement returns the old "target_achievement" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldTargetAchievement(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetAchievement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetAchievement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetAchievement: %w", err)
+	}
+	return oldValue.TargetAchievement, nil
+}
+
+// AddTargetAchievement adds i to the "target_achievement" field.
+func (m *StepRateMutation) AddTargetAchievement(i int32) {
+	if m.addtarget_achievement != nil {
+		*m.addtarget_achievement += i
+	} else {
+		m.addtarget_achievement = &i
+	}
+}
+
+// AddedTargetAchievement returns the value that was added to the "target_achievement" field in this mutation.
+func (m *StepRateMutation) AddedTargetAchievement() (r int32, exists bool) {
+	v := m.addtarget_achievement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetAchievement resets all changes to the "target_achievement" field.
+func (m *StepRateMutation) ResetTargetAchievement() {
+	m.target_achievement = nil
+	m.addtarget_achievement = nil
+}
+
+// SetReflectionImprovement sets the "reflection_improvement" field.
+func (m *StepRateMutation) SetReflectionImprovement(i int32) {
+	m.reflection_improvement = &i
+	m.addreflection_improvement = nil
+}
+
+// ReflectionImprovement returns the value of the "reflection_improvement" field in the mutation.
+func (m *StepRateMutation) ReflectionImprovement() (r int32, exists bool) {
+	v := m.reflection_improvement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReflectionImprovement returns the old "reflection_improvement" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldReflectionImprovement(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReflectionImprovement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReflectionImprovement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReflectionImprovement: %w", err)
+	}
+	return oldValue.ReflectionImprovement, nil
+}
+
+// AddReflectionImprovement adds i to the "reflection_improvement" field.
+func (m *StepRateMutation) AddReflectionImprovement(i int32) {
+	if m.addreflection_improvement != nil {
+		*m.addreflection_improvement += i
+	} else {
+		m.addreflection_improvement = &i
+	}
+}
+
+// AddedReflectionImprovement returns the value that was added to the "reflection_improvement" field in this mutation.
+func (m *StepRateMutation) AddedReflectionImprovement() (r int32, exists bool) {
+	v := m.addreflection_improvement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReflectionImprovement resets all changes to the "reflection_improvement" field.
+func (m *StepRateMutation) ResetReflectionImprovement() {
+	m.reflection_improvement = nil
+	m.addreflection_improvement = nil
+}
+
+// SetInnovation sets the "innovation" field.
+func (m *StepRateMutation) SetInnovation(i int32) {
+	m.innovation = &i
+	m.addinnovation = nil
+}
+
+// Innovation returns the value of the "innovation" field in the mutation.
+func (m *StepRateMutation) Innovation() (r int32, exists bool) {
+	v := m.innovation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInnovation returns the old "innovation" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldInnovation(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInnovation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInnovation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInnovation: %w", err)
+	}
+	return oldValue.Innovation, nil
+}
+
+// AddInnovation adds i to the "innovation" field.
+func (m *StepRateMutation) AddInnovation(i int32) {
+	if m.addinnovation != nil {
+		*m.addinnovation += i
+	} else {
+		m.addinnovation = &i
+	}
+}
+
+// AddedInnovation returns the value that was added to the "innovation" field in this mutation.
+func (m *StepRateMutation) AddedInnovation() (r int32, exists bool) {
+	v := m.addinnovation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInnovation resets all changes to the "innovation" field.
+func (m *StepRateMutation) ResetInnovation() {
+	m.innovation = nil
+	m.addinnovation = nil
+}
+
+// SetBasicReliability sets the "basic_reliability" field.
+func (m *StepRateMutation) SetBasicReliability(i int32) {
+	m.basic_reliability = &i
+	m.addbasic_reliability = nil
+}
+
+// BasicReliability returns the value of the "basic_reliability" field in the mutation.
+func (m *StepRateMutation) BasicReliability() (r int32, exists bool) {
+	v := m.basic_reliability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBasicReliability returns the old "basic_reliability" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldBasicReliability(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBasicReliability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBasicReliability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBasicReliability: %w", err)
+	}
+	return oldValue.BasicReliability, nil
+}
+
+// AddBasicReliability adds i to the "basic_reliability" field.
+func (m *StepRateMutation) AddBasicReliability(i int32) {
+	if m.addbasic_reliability != nil {
+		*m.addbasic_reliability += i
+	} else {
+		m.addbasic_reliability = &i
+	}
+}
+
+// AddedBasicReliability returns the value that was added to the "basic_reliability" field in this mutation.
+func (m *StepRateMutation) AddedBasicReliability() (r int32, exists bool) {
+	v := m.addbasic_reliability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBasicReliability resets all changes to the "basic_reliability" field.
+func (m *StepRateMutation) ResetBasicReliability() {
+	m.basic_reliability = nil
+	m.addbasic_reliability = nil
+}
+
+// SetSkillImprovement sets the "skill_improvement" field.
+func (m *StepRateMutation) SetSkillImprovement(i int32) {
+	m.skill_improvement = &i
+	m.addskill_improvement = nil
+}
+
+// SkillImprovement returns the value of the "skill_improvement" field in the mutation.
+func (m *StepRateMutation) SkillImprovement() (r int32, exists bool) {
+	v := m.skill_improvement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkillImprovement returns the old "skill_improvement" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldSkillImprovement(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkillImprovement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkillImprovement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkillImprovement: %w", err)
+	}
+	return oldValue.SkillImprovement, nil
+}
+
+// AddSkillImprovement adds i to the "skill_improvement" field.
+func (m *StepRateMutation) AddSkillImprovement(i int32) {
+	if m.addskill_improvement != nil {
+		*m.addskill_improvement += i
+	} else {
+		m.addskill_improvement = &i
+	}
+}
+
+// AddedSkillImprovement returns the value that was added to the "skill_improvement" field in this mutation.
+func (m *StepRateMutation) AddedSkillImprovement() (r int32, exists bool) {
+	v := m.addskill_improvement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSkillImprovement resets all changes to the "skill_improvement" field.
+func (m *StepRateMutation) ResetSkillImprovement() {
+	m.skill_improvement = nil
+	m.addskill_improvement = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *StepRateMutation) SetDifficulty(i int32) {
+	m.difficulty = &i
+	m.adddifficulty = nil
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *StepRateMutation) Difficulty() (r int32, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldDifficulty(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// AddDifficulty adds i to the "difficulty" field.
+func (m *StepRateMutation) AddDifficulty(i int32) {
+	if m.adddifficulty != nil {
+		*m.adddifficulty += i
+	} else {
+		m.adddifficulty = &i
+	}
+}
+
+// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
+func (m *StepRateMutation) AddedDifficulty() (r int32, exists bool) {
+	v := m.adddifficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *StepRateMutation) ResetDifficulty() {
+	m.difficulty = nil
+	m.adddifficulty = nil
+}
+
+// SetSelfScores sets the "self_scores" field.
+func (m *StepRateMutation) SetSelfScores(os *objects.CommentScores) {
+	m.self_scores = &os
+}
+
+// SelfScores returns the value of the "self_scores" field in the mutation.
+func (m *StepRateMutation) SelfScores() (r *objects.CommentScores, exists bool) {
+	v := m.self_scores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelfScores returns the old "self_scores" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldSelfScores(ctx context.Context) (v *objects.CommentScores, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelfScores is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelfScores requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelfScores: %w", err)
+	}
+	return oldValue.SelfScores, nil
+}
+
+// ClearSelfScores clears the value of the "self_scores" field.
+func (m *StepRateMutation) ClearSelfScores() {
+	m.self_scores = nil
+	m.clearedFields[steprate.FieldSelfScores] = struct{}{}
+}
+
+// SelfScoresCleared returns if the "self_scores" field was cleared in this mutation.
+func (m *StepRateMutation) SelfScoresCleared() bool {
+	_, ok := m.clearedFields[steprate.FieldSelfScores]
+	return ok
+}
+
+// ResetSelfScores resets all changes to the "self_scores" field.
+func (m *StepRateMutation) ResetSelfScores() {
+	m.self_scores = nil
+	delete(m.clearedFields, steprate.FieldSelfScores)
+}
+
+// SetMeasurement sets the "measurement" field.
+func (m *StepRateMutation) SetMeasurement(f float64) {
+	m.measurement = &f
+	m.addmeasurement = nil
+}
+
+// Measurement returns the value of the "measurement" field in the mutation.
+func (m *StepRateMutation) Measurement() (r float64, exists bool) {
+	v := m.measurement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeasurement returns the old "measurement" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldMeasurement(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeasurement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeasurement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeasurement: %w", err)
+	}
+	return oldValue.Measurement, nil
+}
+
+// AddMeasurement adds f to the "measurement" field.
+func (m *StepRateMutation) AddMeasurement(f float64) {
+	if m.addmeasurement != nil {
+		*m.addmeasurement += f
+	} else {
+		m.addmeasurement = &f
+	}
+}
+
+// AddedMeasurement returns the value that was added to the "measurement" field in this mutation.
+func (m *StepRateMutation) AddedMeasurement() (r float64, exists bool) {
+	v := m.addmeasurement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMeasurement resets all changes to the "measurement" field.
+func (m *StepRateMutation) ResetMeasurement() {
+	m.measurement = nil
+	m.addmeasurement = nil
+}
+
+// SetCreateCounted sets the "create_counted" field.
+func (m *StepRateMutation) SetCreateCounted(b bool) {
+	m.create_counted = &b
+}
+
+// CreateCounted returns the value of the "create_counted" field in the mutation.
+func (m *StepRateMutation) CreateCounted() (r bool, exists bool) {
+	v := m.create_counted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateCounted returns the old "create_counted" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldCreateCounted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateCounted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateCounted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateCounted: %w", err)
+	}
+	return oldValue.CreateCounted, nil
+}
+
+// ResetCreateCounted resets all changes to the "create_counted" field.
+func (m *StepRateMutation) ResetCreateCounted() {
+	m.create_counted = nil
+}
+
+// SetDate sets the "date" field.
+func (m *StepRateMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *StepRateMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the StepRate entity.
+// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *StepRateMutation) ResetDate() {
+	m.date = nil
+}
+
+// Where appends a list predicates to the StepRateMutation builder.
+func (m *StepRateMutation) Where(ps ...predicate.StepRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StepRateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StepRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StepRate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StepRateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StepRateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StepRate).
+func (m *StepRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StepRateMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.user_id != nil {
+		fields = append(fields, steprate.FieldUserID)
+	}
+	if m.top_target_id != nil {
+		fields = append(fields, steprate.FieldTopTargetID)
+	}
+	if m.target_id != nil {
+		fields = append(fields, steprate.FieldTargetID)
+	}
+	if m.step_id != nil {
+		fields = append(fields, steprate.FieldStepID)
+	}
+	if m.weighted_value != nil {
+		fields = append(fields, steprate.FieldWeightedValue)
+	}
+	if m.target_reasonableness != nil {
+		fields = append(fields, steprate.FieldTargetReasonableness)
+	}
+	if m.target_clarity != nil {
+		fields = append(fields, steprate.FieldTargetClarity)
+	}
+	if m.target_achievement != nil {
+		fields = append(fields, steprate.FieldTargetAchievement)
+	}
+	if m.reflection_improvement != nil {
+		fields = append(fields, steprate.FieldReflectionImprovement)
+	}
+	if m.innovation != nil {
+		fields = append(fields, steprate.FieldInnovation)
+	}
+	if m.basic_reliability != nil {
+		fields = append(fields, steprate.FieldBasicReliability)
+	}
+	if m.skill_improvement != nil {
+		fields = append(fields, steprate.FieldSkillImprovement)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, steprate.FieldDifficulty)
+	}
+	if m.self_scores != nil {
+		fields = append(fields, steprate.FieldSelfScores)
+	}
+	if m.measurement != nil {
+		fields = append(fields, steprate.FieldMeasurement)
+	}
+	if m.create_counted != nil {
+		fields = append(fields, steprate.FieldCreateCounted)
+	}
+	if m.date != nil {
+		fields = append(fields, steprate.FieldDate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StepRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case steprate.FieldUserID:
+		return m.UserID()
+	case steprate.FieldTopTargetID:
+		return m.TopTargetID()
+	case steprate.FieldTargetID:
+		return m.TargetID()
+	case steprate.FieldStepID:
+		return m.StepID()
+	case steprate.FieldWeightedValue:
+		return m.WeightedValue()
+	case steprate.FieldTargetReasonableness:
+		return m.TargetReasonableness()
+	case steprate.FieldTargetClarity:
+		return m.TargetClarity()
+	case steprate.FieldTargetAchievement:
+		return m.TargetAchievement()
+	case steprate.FieldReflectionImprovement:
+		return m.ReflectionImprovement()
+	case steprate.FieldInnovation:
+		return m.Innovation()
+	case steprate.FieldBasicReliability:
+		return m.BasicReliability()
+	case steprate.FieldSkillImprovement:
+		return m.SkillImprovement()
+	case steprate.FieldDifficulty:
+		return m.Difficulty()
+	case steprate.FieldSelfScores:
+		return m.SelfScores()
+	case steprate.FieldMeasurement:
+		return m.Measurement()
+	case steprate.FieldCreateCounted:
+		return m.CreateCounted()
+	case steprate.FieldDate:
+		return m.Date()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StepRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case steprate.FieldUserID:
+		return m.OldUserID(ctx)
+	case steprate.FieldTopTargetID:
+		return m.OldTopTargetID(ctx)
+	case steprate.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case steprate.FieldStepID:
+		return m.OldStepID(ctx)
+	case steprate.FieldWeightedValue:
+		return m.OldWeightedValue(ctx)
+	case steprate.FieldTargetReasonableness:
+		return m.OldTargetReasonableness(ctx)
+	case steprate.FieldTargetClarity:
+		return m.OldTargetClarity(ctx)
+	case steprate.FieldTargetAchievement:
+		return m.OldTargetAchievement(ctx)
+	case steprate.FieldReflectionImprovement:
+		return m.OldReflectionImprovement(ctx)
+	case steprate.FieldInnovation:
+		return m.OldInnovation(ctx)
+	case steprate.FieldBasicReliability:
+		return m.OldBasicReliability(ctx)
+	case steprate.FieldSkillImprovement:
+		return m.OldSkillImprovement(ctx)
+	case steprate.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case steprate.FieldSelfScores:
+		return m.OldSelfScores(ctx)
+	case steprate.FieldMeasurement:
+		return m.OldMeasurement(ctx)
+	case steprate.FieldCreateCounted:
+		return m.OldCreateCounted(ctx)
+	case steprate.FieldDate:
+		return m.OldDate(ctx)
+	}
+	return nil, fmt.Errorf("unknown StepRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StepRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case steprate.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case steprate.FieldTopTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopTargetID(v)
+		return nil
+	case steprate.FieldTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case steprate.FieldStepID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepID(v)
+		return nil
+	case steprate.FieldWeightedValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeightedValue(v)
+		return nil
+	case steprate.FieldTargetReasonableness:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetReasonableness(v)
+		return nil
+	case steprate.FieldTargetClarity:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetClarity(v)
+		return nil
+	case steprate.FieldTargetAchievement:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAchievement(v)
+		return nil
+	case steprate.FieldReflectionImprovement:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReflectionImprovement(v)
+		return nil
+	case steprate.FieldInnovation:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInnovation(v)
+		return nil
+	case steprate.FieldBasicReliability:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBasicReliability(v)
+		return nil
+	case steprate.FieldSkillImprovement:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkillImprovement(v)
+		return nil
+	case steprate.FieldDifficulty:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case steprate.FieldSelfScores:
+		v, ok := value.(*objects.CommentScores)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelfScores(v)
+		return nil
+	case steprate.FieldMeasurement:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeasurement(v)
+		return nil
+	case steprate.FieldCreateCounted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateCounted(v)
+		return nil
+	case steprate.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StepRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StepRateMutation) AddedFields() []string {
+	var fields []string
+	if m.addtop_target_id != nil {
+		fields = append(fields, steprate.FieldTopTargetID)
+	}
+	if m.addtarget_id != nil {
+		fields = append(fields, steprate.FieldTargetID)
+	}
+	if m.addstep_id != nil {
+		fields = append(fields, steprate.FieldStepID)
+	}
+	if m.addweighted_value != nil {
+		fields = append(fields, steprate.FieldWeightedValue)
+	}
+	if m.addtarget_reasonableness != nil {
+		fields = append(fields, steprate.FieldTargetReasonableness)
+	}
+	if m.addtarget_clarity != nil {
+		fields = append(fields, steprate.FieldTargetClarity)
+	}
+	if m.addtarget_achievement != nil {
+		fields = append(fields, steprate.FieldTargetAchievement)
+	}
+	if m.addreflection_improvement != nil {
+		fields = append(fields, steprate.FieldReflectionImprovement)
+	}
+	if m.addinnovation != nil {
+		fields = append(fields, steprate.FieldInnovation)
+	}
+	if m.addbasic_reliability != nil {
+		fields = append(fields, steprate.FieldBasicReliability)
+	}
+	if m.addskill_improvement != nil {
+		fields = append(fields, steprate.FieldSkillImprovement)
+	}
+	if m.adddifficulty != nil {
+		fields = append(fields, steprate.FieldDifficulty)
+	}
+	if m.addmeasurement != nil {
+		fields = append(fields, steprate.FieldMeasurement)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StepRateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case steprate.FieldTopTargetID:
+		return m.AddedTopTargetID()
+	case steprate.FieldTargetID:
+		return m.AddedTargetID()
+	case steprate.FieldStepID:
+		return m.AddedStepID()
+	case steprate.FieldWeightedValue:
+		return m.AddedWeightedValue()
+	case steprate.FieldTargetReasonableness:
+		return m.AddedTargetReasonableness()
+	case steprate.FieldTargetClarity:
+		return m.AddedTargetClarity()
+	case steprate.FieldTargetAchievement:
+		return m.AddedTargetAchievement()
+	case steprate.FieldReflectionImprovement:
+		return m.AddedReflectionImprovement()
+	case steprate.FieldInnovation:
+		return m.AddedInnovation()
+	case steprate.FieldBasicReliability:
+		return m.AddedBasicReliability()
+	case steprate.FieldSkillImprovement:
+		return m.AddedSkillImprovement()
+	case steprate.FieldDifficulty:
+		return m.AddedDifficulty()
+	case steprate.FieldMeasurement:
+		return m.AddedMeasurement()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StepRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case steprate.FieldTopTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopTargetID(v)
+		return nil
+	case steprate.FieldTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case steprate.FieldStepID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepID(v)
+		return nil
+	case steprate.FieldWeightedValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeightedValue(v)
+		return nil
+	case steprate.FieldTargetReasonableness:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetReasonableness(v)
+		return nil
+	case steprate.FieldTargetClarity:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetClarity(v)
+		return nil
+	case steprate.FieldTargetAchievement:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetAchievement(v)
+		return nil
+	case steprate.FieldReflectionImprovement:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReflectionImprovement(v)
+		return nil
+	case steprate.FieldInnovation:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInnovation(v)
+		return nil
+	case steprate.FieldBasicReliability:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBasicReliability(v)
+		return nil
+	case steprate.FieldSkillImprovement:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkillImprovement(v)
+		return nil
+	case steprate.FieldDifficulty:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDifficulty(v)
+		return nil
+	case steprate.FieldMeasurement:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMeasurement(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StepRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StepRateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(steprate.FieldSelfScores) {
+		fields = append(fields, steprate.FieldSelfScores)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StepRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StepRateMutation) ClearField(name string) error {
+	switch name {
+	case steprate.FieldSelfScores:
+		m.ClearSelfScores()
+		return nil
+	}
+	return fmt.Errorf("unknown StepRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StepRateMutation) ResetField(name string) error {
+	switch name {
+	case steprate.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case steprate.FieldTopTargetID:
+		m.ResetTopTargetID()
+		return nil
+	case steprate.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case steprate.FieldStepID:
+		m.ResetStepID()
+		return nil
+	case steprate.FieldWeightedValue:
+		m.ResetWeightedValue()
+		return nil
+	case steprate.FieldTargetReasonableness:
+		m.ResetTargetReasonableness()
+		return nil
+	case steprate.FieldTargetClarity:
+		m.ResetTargetClarity()
+		return nil
+	case steprate.FieldTargetAchievement:
+		m.ResetTargetAchievement()
+		return nil
+	case steprate.FieldReflectionImprovement:
+		m.ResetReflectionImprovement()
+		return nil
+	case steprate.FieldInnovation:
+		m.ResetInnovation()
+		return nil
+	case steprate.FieldBasicReliability:
+		m.ResetBasicReliability()
+		return nil
+	case steprate.FieldSkillImprovement:
+		m.ResetSkillImprovement()
+		return nil
+	case steprate.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case steprate.FieldSelfScores:
+		m.ResetSelfScores()
+		return nil
+	case steprate.FieldMeasurement:
+		m.ResetMeasurement()
+		return nil
+	case steprate.FieldCreateCounted:
+		m.ResetCreateCounted()
+		return nil
+	case steprate.FieldDate:
+		m.ResetDate()
+		return nil
+	}
+	return fmt.Errorf("unknown StepRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StepRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StepRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StepRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StepRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StepRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StepRateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StepRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StepRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StepRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StepRate edge %s", name)
+}
+
+// StepRateCriterionMutation represents an operation that mutates the StepRateCriterion nodes in the graph.
+type StepRateCriterionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uint64
+	user_id          *string
+	top_target_id    *uint64
+	addtop_target_id *int64
+	target_id        *uint64
+	addtarget_id     *int64
+	step_id          *uint64
+	addstep_id       *int64
+	key              *string
+	value            *float64
+	addvalue         *float64
+	date             *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*StepRateCriterion, error)
+	predicates       []predicate.StepRateCriterion
+}
+
+var _ ent.Mutation = (*StepRateCriterionMutation)(nil)
+
+// stepratecriterionOption allows management of the mutation configuration using functional options.
+type stepratecriterionOption func(*StepRateCriterionMutation)
+
+// newStepRateCriterionMutation creates new mutation for the StepRateCriterion entity.
+func newStepRateCriterionMutation(c config, op Op, opts ...stepratecriterionOption) *StepRateCriterionMutation {
+	m := &StepRateCriterionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStepRateCriterion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStepRateCriterionID sets the ID field of the mutation.
+func withStepRateCriterionID(id uint64) stepratecriterionOption {
+	return func(m *StepRateCriterionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StepRateCriterion
+		)
+		m.oldValue = func(ctx context.Context) (*StepRateCriterion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StepRateCriterion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStepRateCriterion sets the old StepRateCriterion of the mutation.
+func withStepRateCriterion(node *StepRateCriterion) stepratecriterionOption {
+	return func(m *StepRateCriterionMutation) {
+		m.oldValue = func(context.Context) (*StepRateCriterion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StepRateCriterionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StepRateCriterionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StepRateCriterion entities.
+func (m *StepRateCriterionMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StepRateCriterionMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StepRateCriterionMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StepRateCriterion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *StepRateCriterionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StepRateCriterionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StepRateCriterionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTopTargetID sets the "top_target_id" field.
+func (m *StepRateCriterionMutation) SetTopTargetID(u uint64) {
+	m.top_target_id = &u
+	m.addtop_target_id = nil
+}
+
+// TopTargetID returns the value of the "top_target_id" field in the mutation.
+func (m *StepRateCriterionMutation) TopTargetID() (r uint64, exists bool) {
+	v := m.top_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopTargetID returns the old "top_target_id" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldTopTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopTargetID: %w", err)
+	}
+	return oldValue.TopTargetID, nil
+}
+
+// AddTopTargetID adds u to the "top_target_id" field.
+func (m *StepRateCriterionMutation) AddTopTargetID(u int64) {
+	if m.addtop_target_id != nil {
+		*m.addtop_target_id += u
+	} else {
+		m.addtop_target_id = &u
+	}
+}
+
+// AddedTopTargetID returns the value that was added to the "top_target_id" field in this mutation.
+func (m *StepRateCriterionMutation) AddedTopTargetID() (r int64, exists bool) {
+	v := m.addtop_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTopTargetID resets all changes to the "top_target_id" field.
+func (m *StepRateCriterionMutation) ResetTopTargetID() {
+	m.top_target_id = nil
+	m.addtop_target_id = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *StepRateCriterionMutation) SetTargetID(u uint64) {
+	m.target_id = &u
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *StepRateCriterionMutation) TargetID() (r uint64, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldTargetID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (m *StepRateCriterionMutation) AddTargetID(u int64) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += u
+	} else {
+		m.addtarget_id = &u
+	}
+}
+
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *StepRateCriterionMutation) AddedTargetID() (r int64, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *StepRateCriterionMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+}
+
+// SetStepID sets the "step_id" field.
+func (m *StepRateCriterionMutation) SetStepID(u uint64) {
+	m.step_id = &u
+	m.addstep_id = nil
+}
+
+// StepID returns the value of the "step_id" field in the mutation.
+func (m *StepRateCriterionMutation) StepID() (r uint64, exists bool) {
+	v := m.step_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepID returns the old "step_id" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldStepID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
+	}
+	return oldValue.StepID, nil
+}
+
+// AddStepID adds u to the "step_id" field.
+func (m *StepRateCriterionMutation) AddStepID(u int64) {
+	if m.addstep_id != nil {
+		*m.addstep_id += u
+	} else {
+		m.addstep_id = &u
+	}
+}
+
+// AddedStepID returns the value that was added to the "step_id" field in this mutation.
+func (m *StepRateCriterionMutation) AddedStepID() (r int64, exists bool) {
+	v := m.addstep_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepID resets all changes to the "step_id" field.
+func (m *StepRateCriterionMutation) ResetStepID() {
+	m.step_id = nil
+	m.addstep_id = nil
+}
+
+// SetKey sets the "key" field.
+func (m *StepRateCriterionMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *StepRateCriterionMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *StepRateCriterionMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the "value" field.
+func (m *StepRateCriterionMutation) SetValue(f float64) {
+	m.value = &f
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *StepRateCriterionMutation) Value() (r float64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds f to the "value" field.
+func (m *StepRateCriterionMutation) AddValue(f float64) {
+	if m.addvalue != nil {
+		*m.addvalue += f
+	} else {
+		m.addvalue = &f
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *StepRateCriterionMutation) AddedValue() (r float64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *StepRateCriterionMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetDate sets the "date" field.
+func (m *StepRateCriterionMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *StepRateCriterionMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the StepRateCriterion entity.
+// If the StepRateCriterion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StepRateCriterionMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *StepRateCriterionMutation) ResetDate() {
+	m.date = nil
+}
+
+// Where appends a list predicates to the StepRateCriterionMutation builder.
+func (m *StepRateCriterionMutation) Where(ps ...predicate.StepRateCriterion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StepRateCriterionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StepRateCriterionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StepRateCriterion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StepRateCriterionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StepRateCriterionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StepRateCriterion).
+func (m *StepRateCriterionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StepRateCriterionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, stepratecriterion.FieldUserID)
+	}
+	if m.top_target_id != nil {
+		fields = append(fields, stepratecriterion.FieldTopTargetID)
+	}
+	if m.target_id != nil {
+		fields = append(fields, stepratecriterion.FieldTargetID)
+	}
+	if m.step_id != nil {
+		fields = append(fields, stepratecriterion.FieldStepID)
+	}
+	if m.key != nil {
+		fields = append(fields, stepratecriterion.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, stepratecriterion.FieldValue)
+	}
+	if m.date != nil {
+		fields = append(fields, stepratecriterion.FieldDate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StepRateCriterionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case stepratecriterion.FieldUserID:
+		return m.UserID()
+	case stepratecriterion.FieldTopTargetID:
+		return m.TopTargetID()
+	case stepratecriterion.FieldTargetID:
+		return m.TargetID()
+	case stepratecriterion.FieldStepID:
+		return m.StepID()
+	case stepratecriterion.FieldKey:
+		return m.Key()
+	case stepratecriterion.FieldValue:
+		return m.Value()
+	case stepratecriterion.FieldDate:
+		return m.Date()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StepRateCriterionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case stepratecriterion.FieldUserID:
+		return m.OldUserID(ctx)
+	case stepratecriterion.FieldTopTargetID:
+		return m.OldTopTargetID(ctx)
+	case stepratecriterion.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case stepratecriterion.FieldStepID:
+		return m.OldStepID(ctx)
+	case stepratecriterion.FieldKey:
+		return m.OldKey(ctx)
+	case stepratecriterion.FieldValue:
+		return m.OldValue(ctx)
+	case stepratecriterion.FieldDate:
+		return m.OldDate(ctx)
+	}
+	return nil, fmt.Errorf("unknown StepRateCriterion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StepRateCriterionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case stepratecriterion.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case stepratecriterion.FieldTopTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopTargetID(v)
+		return nil
+	case stepratecriterion.FieldTargetID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case stepratecriterion.FieldStepID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepID(v)
+		return nil
+	case stepratecriterion.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case stepratecriterion.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case stepratecriterion.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StepRateCriterion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StepRateCriterionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtop_target_id != nil {
+		fields = append(fields, stepratecriterion.FieldTopTargetID)
+	}
+	if m.addtarget_id != nil {
+		fields = append(fields, stepratecriterion.FieldTargetID)
+	}
+	if m.addstep_id != nil {
+		fields = append(fields, stepratecriterion.FieldStepID)
+	}
+	if m.addvalue != nil {
+		fields = append(fields, stepratecriterion.FieldValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StepRateCriterionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case stepratecriterion.FieldTopTargetID:
+		return m.AddedTopTargetID()
+	case stepratecriterion.FieldTargetID:
+		return m.AddedTargetID()
+	case stepratecriterion.FieldStepID:
+		return m.AddedStepID()
+	case stepratecriterion.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StepRateCriterionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case stepratecriterion.FieldTopTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTopTargetID(v)
+		return nil
+	case stepratecriterion.FieldTargetID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case stepratecriterion.FieldStepID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepID(v)
+		return nil
+	case stepratecriterion.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StepRateCriterion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StepRateCriterionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StepRateCriterionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StepRateCriterionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StepRateCriterion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StepRateCriterionMutation) ResetField(name string) error {
+	switch name {
+	case stepratecriterion.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case stepratecriterion.FieldTopTargetID:
+		m.ResetTopTargetID()
+		return nil
+	case stepratecriterion.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case stepratecriterion.FieldStepID:
+		m.ResetStepID()
+		return nil
+	case stepratecriterion.FieldKey:
+		m.ResetKey()
+		return nil
+	case stepratecriterion.FieldValue:
+		m.ResetValue()
+		return nil
+	case stepratecriterion.FieldDate:
+		m.ResetDate()
+		return nil
+	}
+	return fmt.Errorf("unknown StepRateCriterion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StepRateCriterionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StepRateCriterionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StepRateCriterionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StepRateCriterionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StepRateCriterionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StepRateCriterionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StepRateCriterionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StepRateCriterion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StepRateCriterionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StepRateCriterion edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uint64
+	user_id        *string
+	name           *string
+	color          *string
+	created_at     *int64
+	addcreated_at  *int64
+	clearedFields  map[string]struct{}
+	targets        map[uint64]struct{}
+	removedtargets map[uint64]struct{}
+	clearedtargets bool
+	steps          map[uint64]struct{}
+	removedsteps   map[uint64]struct{}
+	clearedsteps   bool
+	done           bool
+	oldValue       func(context.Context) (*Tag, error)
+	predicates     []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id uint64) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TagMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TagMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TagMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *TagMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[tag.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *TagMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[tag.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, tag.FieldColor)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *TagMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *TagMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// AddTargetIDs adds the "targets" edge to the Target entity by ids.
+func (m *TagMutation) AddTargetIDs(ids ...uint64) {
+	if m.targets == nil {
+		m.targets = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.targets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTargets clears the "targets" edge to the Target entity.
+func (m *TagMutation) ClearTargets() {
+	m.clearedtargets = true
+}
+
+// TargetsCleared reports if the "targets" edge to the Target entity was cleared.
+func (m *TagMutation) TargetsCleared() bool {
+	return m.clearedtargets
+}
+
+// RemoveTargetIDs removes the "targets" edge to the Target entity by IDs.
+func (m *TagMutation) RemoveTargetIDs(ids ...uint64) {
+	if m.removedtargets == nil {
+		m.removedtargets = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.targets, ids[i])
+		m.removedtargets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTargets returns the removed IDs of the "targets" edge to the Target entity.
+func (m *TagMutation) RemovedTargetsIDs() (ids []uint64) {
+	for id := range m.removedtargets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TargetsIDs returns the "targets" edge IDs in the mutation.
+func (m *TagMutation) TargetsIDs() (ids []uint64) {
+	for id := range m.targets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTargets resets all changes to the "targets" edge.
+func (m *TagMutation) ResetTargets() {
+	m.targets = nil
+	m.clearedtargets = false
+	m.removedtargets = nil
+}
+
+// AddStepIDs adds the "steps" edge to the Step entity by ids.
+func (m *TagMutation) AddStepIDs(ids ...uint64) {
+	if m.steps == nil {
+		m.steps = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.steps[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSteps clears the "steps" edge to the Step entity.
+func (m *TagMutation) ClearSteps() {
+	m.clearedsteps = true
+}
+
+// StepsCleared reports if the "steps" edge to the Step entity was cleared.
+func (m *TagMutation) StepsCleared() bool {
+	return m.clearedsteps
+}
+
+// RemoveStepIDs removes the "steps" edge to the Step entity by IDs.
+func (m *TagMutation) RemoveStepIDs(ids ...uint64) {
+	if m.removedsteps == nil {
+		m.removedsteps = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.steps, ids[i])
+		m.removedsteps[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSteps returns the removed IDs of the "steps" edge to the Step entity.
+func (m *TagMutation) RemovedStepsIDs() (ids []uint64) {
+	for id := range m.removedsteps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StepsIDs returns the "steps" edge IDs in the mutation.
+func (m *TagMutation) StepsIDs() (ids []uint64) {
+	for id := range m.steps {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSteps resets all changes to the "steps" edge.
+func (m *TagMutation) ResetSteps() {
+	m.steps = nil
+	m.clearedsteps = false
+	m.removedsteps = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, tag.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldUserID:
+		return m.UserID()
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldUserID:
+		return m.OldUserID(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldCreatedAt:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tag.FieldColor) {
+		fields = append(fields, tag.FieldColor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	switch name {
+	case tag.FieldColor:
+		m.ClearColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldColor:
+		m.ResetColor()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.targets != nil {
+		edges = append(edges, tag.EdgeTargets)
+	}
+	if m.steps != nil {
+		edges = append(edges, tag.EdgeSteps)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeTargets:
+		ids := make([]ent.Value, 0, len(m.targets))
+		for id := range m.targets {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeSteps:
+		ids := make([]ent.Value, 0, len(m.steps))
+		for id := range m.steps {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtargets != nil {
+		edges = append(edges, tag.EdgeTargets)
+	}
+	if m.removedsteps != nil {
+		edges = append(edges, tag.EdgeSteps)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeTargets:
+		ids := make([]ent.Value, 0, len(m.removedtargets))
+		for id := range m.removedtargets {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeSteps:
+		ids := make([]ent.Value, 0, len(m.removedsteps))
+		for id := range m.removedsteps {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtargets {
+		edges = append(edges, tag.EdgeTargets)
+	}
+	if m.clearedsteps {
+		edges = append(edges, tag.EdgeSteps)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeTargets:
+		return m.clearedtargets
+	case tag.EdgeSteps:
+		return m.clearedsteps
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeTargets:
+		m.ResetTargets()
+		return nil
+	case tag.EdgeSteps:
+		m.ResetSteps()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TargetMutation represents an operation that mutates the Target nodes in the graph.
+type TargetMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uint64
+	user_id                 *string
+	title                   *string
+	description             *string
+	_type                   *string
+	created_at              *int64
+	addcreated_at           *int64
+	start_at                *int64
+	addstart_at             *int64
+	challenge_at            *int64
+	addchallenge_at         *int64
+	done_at                 *int64
+	adddone_at              *int64
+	layer                   *uint32
+	addlayer                *int32
+	status                  *target.Status
+	schedule_type           *target.ScheduleType
+	schedule_weekdays       *[]int
+	appendschedule_weekdays []int
+	schedule_times          *uint32
+	addschedule_times       *int32
+	mode                    *target.Mode
+	unit                    *string
+	goal_value              *float64
+	addgoal_value           *float64
+	sort_order              *float64
+	addsort_order           *float64
+	_path                   *string
+	rubric                  **objects.TargetRubric
+	clearedFields           map[string]struct{}
+	parent                  *uint64
+	clearedparent           bool
+	children                map[uint64]struct{}
+	removedchildren         map[uint64]struct{}
+	clearedchildren         bool
+	steps                   map[uint64]struct{}
+	removedsteps            map[uint64]struct{}
+	clearedsteps            bool
+	tags                    map[uint64]struct{}
+	removedtags             map[uint64]struct{}
+	clearedtags             bool
+	done                    bool
+	oldValue                func(context.Context) (*Target, error)
+	predicates              []predicate.Target
+}
+
+var _ ent.Mutation = (*TargetMutation)(nil)
+
+// targetOption allows management of the mutation configuration using functional options.
+type targetOption func(*TargetMutation)
+
+// newTargetMutation creates new mutation for the Target entity.
+func newTargetMutation(c config, op Op, opts ...targetOption) *TargetMutation {
+	m := &TargetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTarget,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTargetID sets the ID field of the mutation.
+func withTargetID(id uint64) targetOption {
+	return func(m *TargetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Target
+		)
+		m.oldValue = func(ctx context.Context) (*Target, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Target.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTarget sets the old Target of the mutation.
+func withTarget(node *Target) targetOption {
+	return func(m *TargetMutation) {
+		m.oldValue = func(context.Context) (*Target, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TargetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TargetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Target entities.
+func (m *TargetMutation) SetID(id uint64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TargetMutation) ID() (id uint64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TargetMutation) IDs(ctx context.Context) ([]uint64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uint64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Target.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TargetMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TargetMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TargetMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TargetMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TargetMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TargetMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TargetMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TargetMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TargetMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetType sets the "type" field.
+func (m *TargetMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TargetMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TargetMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TargetMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TargetMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (m *TargetMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
+	}
+}
+
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *TargetMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TargetMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *TargetMutation) SetStartAt(i int64) {
+	m.start_at = &i
+	m.addstart_at = nil
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *TargetMutation) StartAt() (r int64, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldStartAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// AddStartAt adds i to the "start_at" field.
+func (m *TargetMutation) AddStartAt(i int64) {
+	if m.addstart_at != nil {
+		*m.addstart_at += i
+	} else {
+		m.addstart_at = &i
+	}
+}
+
+// AddedStartAt returns the value that was added to the "start_at" field in this mutation.
+func (m *TargetMutation) AddedStartAt() (r int64, exists bool) {
+	v := m.addstart_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartAt clears the value of the "start_at" field.
+func (m *TargetMutation) ClearStartAt() {
+	m.start_at = nil
+	m.addstart_at = nil
+	m.clearedFields[target.FieldStartAt] = struct{}{}
+}
+
+// StartAtCleared returns if the "start_at" field was cleared in this mutation.
+func (m *TargetMutation) StartAtCleared() bool {
+	_, ok := m.clearedFields[target.FieldStartAt]
+	return ok
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *TargetMutation) ResetStartAt() {
+	m.start_at = nil
+	m.addstart_at = nil
+	delete(m.clearedFields, target.FieldStartAt)
+}
+
+// SetChallengeAt sets the "challenge_at" field.
+func (m *TargetMutation) SetChallengeAt(i int64) {
+	m.challenge_at = &i
+	m.addchallenge_at = nil
+}
+
+// ChallengeAt returns the value of the "challenge_at" field in the mutation.
+func (m *TargetMutation) ChallengeAt() (r int64, exists bool) {
+	v := m.challenge_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChallengeAt returns the old "challenge_at" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldChallengeAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChallengeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChallengeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChallengeAt: %w", err)
+	}
+	return oldValue.ChallengeAt, nil
+}
+
+// AddChallengeAt adds i to the "challenge_at" field.
+func (m *TargetMutation) AddChallengeAt(i int64) {
+	if m.addchallenge_at != nil {
+		*m.addchallenge_at += i
+	} else {
+		m.addchallenge_at = &i
+	}
+}
+
+// AddedChallengeAt returns the value that was added to the "challenge_at" field in this mutation.
+func (m *TargetMutation) AddedChallengeAt() (r int64, exists bool) {
+	v := m.addchallenge_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChallengeAt clears the value of the "challenge_at" field.
+func (m *TargetMutation) ClearChallengeAt() {
+	m.challenge_at = nil
+	m.addchallenge_at = nil
+	m.clearedFields[target.FieldChallengeAt] = struct{}{}
+}
+
+// ChallengeAtCleared returns if the "challenge_at" field was cleared in this mutation.
+func (m *TargetMutation) ChallengeAtCleared() bool {
+	_, ok := m.clearedFields[target.FieldChallengeAt]
+	return ok
+}
+
+// ResetChallengeAt resets all changes to the "challenge_at" field.
+func (m *TargetMutation) ResetChallengeAt() {
+	m.challenge_at = nil
+	m.addchallenge_at = nil
+	delete(m.clearedFields, target.FieldChallengeAt)
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *StepRateMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// SetDoneAt sets the "done_at" field.
+func (m *TargetMutation) SetDoneAt(i int64) {
+	m.done_at = &i
+	m.adddone_at = nil
+}
+
+// DoneAt returns the value of the "done_at" field in the mutation.
+func (m *TargetMutation) DoneAt() (r int64, exists bool) {
+	v := m.done_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldDoneAt returns the old "done_at" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *TargetMutation) OldDoneAt(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldDoneAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.DoneAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *StepRateMutation) ResetUserID() {
-	m.user_id = nil
+// AddDoneAt adds i to the "done_at" field.
+func (m *TargetMutation) AddDoneAt(i int64) {
+	if m.adddone_at != nil {
+		*m.adddone_at += i
+	} else {
+		m.adddone_at = &i
+	}
 }
 
-// SetTopTargetID sets the "top_target_id" field.
-func (m *StepRateMutation) SetTopTargetID(u uint64) {
-	m.top_target_id = &u
-	m.addtop_target_id = nil
+// AddedDoneAt returns the value that was added to the "done_at" field in this mutation.
+func (m *TargetMutation) AddedDoneAt() (r int64, exists bool) {
+	v := m.adddone_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TopTargetID returns the value of the "top_target_id" field in the mutation.
-func (m *StepRateMutation) TopTargetID() (r uint64, exists bool) {
-	v := m.top_target_id
+// ClearDoneAt clears the value of the "done_at" field.
+func (m *TargetMutation) ClearDoneAt() {
+	m.done_at = nil
+	m.adddone_at = nil
+	m.clearedFields[target.FieldDoneAt] = struct{}{}
+}
+
+// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
+func (m *TargetMutation) DoneAtCleared() bool {
+	_, ok := m.clearedFields[target.FieldDoneAt]
+	return ok
+}
+
+// ResetDoneAt resets all changes to the "done_at" field.
+func (m *TargetMutation) ResetDoneAt() {
+	m.done_at = nil
+	m.adddone_at = nil
+	delete(m.clearedFields, target.FieldDoneAt)
+}
+
+// SetLayer sets the "layer" field.
+func (m *TargetMutation) SetLayer(u uint32) {
+	m.layer = &u
+	m.addlayer = nil
+}
+
+// Layer returns the value of the "layer" field in the mutation.
+func (m *TargetMutation) Layer() (r uint32, exists bool) {
+	v := m.layer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTopTargetID returns the old "top_target_id" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldLayer returns the old "layer" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldTopTargetID(ctx context.Context) (v uint64, err error) {
+func (m *TargetMutation) OldLayer(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTopTargetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLayer is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTopTargetID requires an ID field in the mutation")
+		return v, errors.New("OldLayer requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTopTargetID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLayer: %w", err)
 	}
-	return oldValue.TopTargetID, nil
+	return oldValue.Layer, nil
 }
 
-// AddTopTargetID adds u to the "top_target_id" field.
-func (m *StepRateMutation) AddTopTargetID(u int64) {
-	if m.addtop_target_id != nil {
-		*m.addtop_target_id += u
+// AddLayer adds u to the "layer" field.
+func (m *TargetMutation) AddLayer(u int32) {
+	if m.addlayer != nil {
+		*m.addlayer += u
 	} else {
-		m.addtop_target_id = &u
+		m.addlayer = &u
 	}
 }
 
-// AddedTopTargetID returns the value that was added to the "top_target_id" field in this mutation.
-func (m *StepRateMutation) AddedTopTargetID() (r int64, exists bool) {
-	v := m.addtop_target_id
+// AddedLayer returns the value that was added to the "layer" field in this mutation.
+func (m *TargetMutation) AddedLayer() (r int32, exists bool) {
+	v := m.addlayer
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTopTargetID resets all changes to the "top_target_id" field.
-func (m *StepRateMutation) ResetTopTargetID() {
-	m.top_target_id = nil
-	m.addtop_target_id = nil
+// ResetLayer resets all changes to the "layer" field.
+func (m *TargetMutation) ResetLayer() {
+	m.layer = nil
+	m.addlayer = nil
 }
 
-// SetTargetID sets the "target_id" field.
-func (m *StepRateMutation) SetTargetID(u uint64) {
-	m.target_id = &u
-	m.addtarget_id = nil
+// SetStatus sets the "status" field.
+func (m *TargetMutation) SetStatus(t target.Status) {
+	m.status = &t
 }
 
-// TargetID returns the value of the "target_id" field in the mutation.
-func (m *StepRateMutation) TargetID() (r uint64, exists bool) {
-	v := m.target_id
+// Status returns the value of the "status" field in the mutation.
+func (m *TargetMutation) Status() (r target.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetID returns the old "target_id" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldTargetID(ctx context.Context) (v uint64, err error) {
+func (m *TargetMutation) OldStatus(ctx context.Context) (v target.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
-	}
-	return oldValue.TargetID, nil
-}
-
-// AddTargetID adds u to the "target_id" field.
-func (m *StepRateMutation) AddTargetID(u int64) {
-	if m.addtarget_id != nil {
-		*m.addtarget_id += u
-	} else {
-		m.addtarget_id = &u
-	}
-}
-
-// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
-func (m *StepRateMutation) AddedTargetID() (r int64, exists bool) {
-	v := m.addtarget_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return *v, true
+	return oldValue.Status, nil
 }
 
-// ResetTargetID resets all changes to the "target_id" field.
-func (m *StepRateMutation) ResetTargetID() {
-	m.target_id = nil
-	m.addtarget_id = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TargetMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetStepID sets the "step_id" field.
-func (m *StepRateMutation) SetStepID(u uint64) {
-	m.step_id = &u
-	m.addstep_id = nil
+// SetParentID sets the "parent_id" field.
+func (m *TargetMutation) SetParentID(u uint64) {
+	m.parent = &u
 }
 
-// StepID returns the value of the "step_id" field in the mutation.
-func (m *StepRateMutation) StepID() (r uint64, exists bool) {
-	v := m.step_id
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TargetMutation) ParentID() (r uint64, exists bool) {
+	v := m.parent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStepID returns the old "step_id" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldStepID(ctx context.Context) (v uint64, err error) {
+func (m *TargetMutation) OldParentID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStepID requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.StepID, nil
+	return oldValue.ParentID, nil
 }
 
-// AddStepID adds u to the "step_id" field.
-func (m *StepRateMutation) AddStepID(u int64) {
-	if m.addstep_id != nil {
-		*m.addstep_id += u
-	} else {
-		m.addstep_id = &u
-	}
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TargetMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[target.FieldParentID] = struct{}{}
 }
 
-// AddedStepID returns the value that was added to the "step_id" field in this mutation.
-func (m *StepRateMutation) AddedStepID() (r int64, exists bool) {
-	v := m.addstep_id
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TargetMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[target.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TargetMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, target.FieldParentID)
+}
+
+// SetScheduleType sets the "schedule_type" field.
+func (m *TargetMutation) SetScheduleType(tt target.ScheduleType) {
+	m.schedule_type = &tt
+}
+
+// ScheduleType returns the value of the "schedule_type" field in the mutation.
+func (m *TargetMutation) ScheduleType() (r target.ScheduleType, exists bool) {
+	v := m.schedule_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStepID resets all changes to the "step_id" field.
-func (m *StepRateMutation) ResetStepID() {
-	m.step_id = nil
-	m.addstep_id = nil
+// OldScheduleType returns the old "schedule_type" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldScheduleType(ctx context.Context) (v target.ScheduleType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleType: %w", err)
+	}
+	return oldValue.ScheduleType, nil
 }
 
-// SetWeightedValue sets the "weighted_value" field.
-func (m *StepRateMutation) SetWeightedValue(f float64) {
-	m.weighted_value = &f
-	m.addweighted_value = nil
+// ResetScheduleType resets all changes to the "schedule_type" field.
+func (m *TargetMutation) ResetScheduleType() {
+	m.schedule_type = nil
+}
+
+// SetScheduleWeekdays sets the "schedule_weekdays" field.
+func (m *TargetMutation) SetScheduleWeekdays(i []int) {
+	m.schedule_weekdays = &i
+	m.appendschedule_weekdays = nil
 }
 
-// WeightedValue returns the value of the "weighted_value" field in the mutation.
-func (m *StepRateMutation) WeightedValue() (r float64, exists bool) {
-	v := m.weighted_value
+// ScheduleWeekdays returns the value of the "schedule_weekdays" field in the mutation.
+func (m *TargetMutation) ScheduleWeekdays() (r []int, exists bool) {
+	v := m.schedule_weekdays
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeightedValue returns the old "weighted_value" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleWeekdays returns the old "schedule_weekdays" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldWeightedValue(ctx context.Context) (v float64, err error) {
+func (m *TargetMutation) OldScheduleWeekdays(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeightedValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleWeekdays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeightedValue requires an ID field in the mutation")
+		return v, errors.New("OldScheduleWeekdays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeightedValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleWeekdays: %w", err)
 	}
-	return oldValue.WeightedValue, nil
+	return oldValue.ScheduleWeekdays, nil
 }
 
-// AddWeightedValue adds f to the "weighted_value" field.
-func (m *StepRateMutation) AddWeightedValue(f float64) {
-	if m.addweighted_value != nil {
-		*m.addweighted_value += f
-	} else {
-		m.addweighted_value = &f
-	}
+// AppendScheduleWeekdays adds i to the "schedule_weekdays" field.
+func (m *TargetMutation) AppendScheduleWeekdays(i []int) {
+	m.appendschedule_weekdays = append(m.appendschedule_weekdays, i...)
 }
 
-// AddedWeightedValue returns the value that was added to the "weighted_value" field in this mutation.
-func (m *StepRateMutation) AddedWeightedValue() (r float64, exists bool) {
-	v := m.addweighted_value
-	if v == nil {
-		return
+// AppendedScheduleWeekdays returns the list of values that were appended to the "schedule_weekdays" field in this mutation.
+func (m *TargetMutation) AppendedScheduleWeekdays() ([]int, bool) {
+	if len(m.appendschedule_weekdays) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendschedule_weekdays, true
 }
 
-// ResetWeightedValue resets all changes to the "weighted_value" field.
-func (m *StepRateMutation) ResetWeightedValue() {
-	m.weighted_value = nil
-	m.addweighted_value = nil
+// ClearScheduleWeekdays clears the value of the "schedule_weekdays" field.
+func (m *TargetMutation) ClearScheduleWeekdays() {
+	m.schedule_weekdays = nil
+	m.appendschedule_weekdays = nil
+	m.clearedFields[target.FieldScheduleWeekdays] = struct{}{}
 }
 
-// SetTargetReasonableness sets the "target_reasonableness" field.
-func (m *StepRateMutation) SetTargetReasonableness(i int32) {
-	m.target_reasonableness = &i
-	m.addtarget_reasonableness = nil
+// ScheduleWeekdaysCleared returns if the "schedule_weekdays" field was cleared in this mutation.
+func (m *TargetMutation) ScheduleWeekdaysCleared() bool {
+	_, ok := m.clearedFields[target.FieldScheduleWeekdays]
+	return ok
 }
 
-// TargetReasonableness returns the value of the "target_reasonableness" field in the mutation.
-func (m *StepRateMutation) TargetReasonableness() (r int32, exists bool) {
-	v := m.target_reasonableness
+// ResetScheduleWeekdays resets all changes to the "schedule_weekdays" field.
+func (m *TargetMutation) ResetScheduleWeekdays() {
+	m.schedule_weekdays = nil
+	m.appendschedule_weekdays = nil
+	delete(m.clearedFields, target.FieldScheduleWeekdays)
+}
+
+// SetScheduleTimes sets the "schedule_times" field.
+func (m *TargetMutation) SetScheduleTimes(u uint32) {
+	m.schedule_times = &u
+	m.addschedule_times = nil
+}
+
+// ScheduleTimes returns the value of the "schedule_times" field in the mutation.
+func (m *TargetMutation) ScheduleTimes() (r uint32, exists bool) {
+	v := m.schedule_times
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetReasonableness returns the old "target_reasonableness" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleTimes returns the old "schedule_times" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldTargetReasonableness(ctx context.Context) (v int32, err error) {
+func (m *TargetMutation) OldScheduleTimes(ctx context.Context) (v uint32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetReasonableness is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleTimes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetReasonableness requires an ID field in the mutation")
+		return v, errors.New("OldScheduleTimes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetReasonableness: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleTimes: %w", err)
 	}
-	return oldValue.TargetReasonableness, nil
+	return oldValue.ScheduleTimes, nil
 }
 
-// AddTargetReasonableness adds i to the "target_reasonableness" field.
-func (m *StepRateMutation) AddTargetReasonableness(i int32) {
-	if m.addtarget_reasonableness != nil {
-		*m.addtarget_reasonableness += i
+// AddScheduleTimes adds u to the "schedule_times" field.
+func (m *TargetMutation) AddScheduleTimes(u int32) {
+	if m.addschedule_times != nil {
+		*m.addschedule_times += u
 	} else {
-		m.addtarget_reasonableness = &i
+		m.addschedule_times = &u
 	}
 }
 
-// AddedTargetReasonableness returns the value that was added to the "target_reasonableness" field in this mutation.
-func (m *StepRateMutation) AddedTargetReasonableness() (r int32, exists bool) {
-	v := m.addtarget_reasonableness
+// AddedScheduleTimes returns the value that was added to the "schedule_times" field in this mutation.
+func (m *TargetMutation) AddedScheduleTimes() (r int32, exists bool) {
+	v := m.addschedule_times
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTargetReasonableness resets all changes to the "target_reasonableness" field.
-func (m *StepRateMutation) ResetTargetReasonableness() {
-	m.target_reasonableness = nil
-	m.addtarget_reasonableness = nil
+// ClearScheduleTimes clears the value of the "schedule_times" field.
+func (m *TargetMutation) ClearScheduleTimes() {
+	m.schedule_times = nil
+	m.addschedule_times = nil
+	m.clearedFields[target.FieldScheduleTimes] = struct{}{}
 }
 
-// SetTargetClarity sets the "target_clarity" field.
-func (m *StepRateMutation) SetTargetClarity(i int32) {
-	m.target_clarity = &i
-	m.addtarget_clarity = nil
+// ScheduleTimesCleared returns if the "schedule_times" field was cleared in this mutation.
+func (m *TargetMutation) ScheduleTimesCleared() bool {
+	_, ok := m.clearedFields[target.FieldScheduleTimes]
+	return ok
 }
 
-// TargetClarity returns the value of the "target_clarity" field in the mutation.
-func (m *StepRateMutation) TargetClarity() (r int32, exists bool) {
-	v := m.target_clarity
+// ResetScheduleTimes resets all changes to the "schedule_times" field.
+func (m *TargetMutation) ResetScheduleTimes() {
+	m.schedule_times = nil
+	m.addschedule_times = nil
+	delete(m.clearedFields, target.FieldScheduleTimes)
+}
+
+// SetMode sets the "mode" field.
+func (m *TargetMutation) SetMode(t target.Mode) {
+	m.mode = &t
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *TargetMutation) Mode() (r target.Mode, exists bool) {
+	v := m.mode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetClarity returns the old "target_clarity" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldMode returns the old "mode" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldTargetClarity(ctx context.Context) (v int32, err error) {
+func (m *TargetMutation) OldMode(ctx context.Context) (v target.Mode, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetClarity is only allowed on UpdateOne operations")
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetClarity requires an ID field in the mutation")
+		return v, errors.New("OldMode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetClarity: %w", err)
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
 	}
-	return oldValue.TargetClarity, nil
+	return oldValue.Mode, nil
 }
 
-// AddTargetClarity adds i to the "target_clarity" field.
-func (m *StepRateMutation) AddTargetClarity(i int32) {
-	if m.addtarget_clarity != nil {
-		*m.addtarget_clarity += i
-	} else {
-		m.addtarget_clarity = &i
-	}
+// ResetMode resets all changes to the "mode" field.
+func (m *TargetMutation) ResetMode() {
+	m.mode = nil
 }
 
-// AddedTargetClarity returns the value that was added to the "target_clarity" field in this mutation.
-func (m *StepRateMutation) AddedTargetClarity() (r int32, exists bool) {
-	v := m.addtarget_clarity
+// SetUnit sets the "unit" field.
+func (m *TargetMutation) SetUnit(s string) {
+	m.unit = &s
+}
+
+// Unit returns the value of the "unit" field in the mutation.
+func (m *TargetMutation) Unit() (r string, exists bool) {
+	v := m.unit
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTargetClarity resets all changes to the "target_clarity" field.
-func (m *StepRateMutation) ResetTargetClarity() {
-	m.target_clarity = nil
-	m.addtarget_clarity = nil
+// OldUnit returns the old "unit" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TargetMutation) OldUnit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+	}
+	return oldValue.Unit, nil
 }
 
-// SetTargetAchievement sets the "target_achievement" field.
-func (m *StepRateMutation) SetTargetAchievement(i int32) {
-	m.target_achievement = &i
-	m.addtarget_achievement = nil
+// ClearUnit clears the value of the "unit" field.
+func (m *TargetMutation) ClearUnit() {
+	m.unit = nil
+	m.clearedFields[target.FieldUnit] = struct{}{}
 }
 
-// TargetAchievement returns the value of the "target_achievement" field in the mutation.
-func (m *StepRateMutation) TargetAchievement() (r int32, exists bool) {
-	v := m.target_achievement
+// UnitCleared returns if the "unit" field was cleared in this mutation.
+func (m *TargetMutation) UnitCleared() bool {
+	_, ok := m.clearedFields[target.FieldUnit]
+	return ok
+}
+
+// ResetUnit resets all changes to the "unit" field.
+func (m *TargetMutation) ResetUnit() {
+	m.unit = nil
+	delete(m.clearedFields, target.FieldUnit)
+}
+
+// SetGoalValue sets the "goal_value" field.
+func (m *TargetMutation) SetGoalValue(f float64) {
+	m.goal_value = &f
+	m.addgoal_value = nil
+}
+
+// GoalValue returns the value of the "goal_value" field in the mutation.
+func (m *TargetMutation) GoalValue() (r float64, exists bool) {
+	v := m.goal_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetAchievement returns the old "target_achievement" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldGoalValue returns the old "goal_value" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldTargetAchievement(ctx context.Context) (v int32, err error) {
+func (m *TargetMutation) OldGoalValue(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetAchievement is only allowed on UpdateOne operations")
+		return v, errors.New("OldGoalValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetAchievement requires an ID field in the mutation")
+		return v, errors.New("OldGoalValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetAchievement: %w", err)
+		return v, fmt.Errorf("querying old value for OldGoalValue: %w", err)
 	}
-	return oldValue.TargetAchievement, nil
+	return oldValue.GoalValue, nil
 }
 
-// AddTargetAchievement adds i to the "target_achievement" field.
-func (m *StepRateMutation) AddTargetAchievement(i int32) {
-	if m.addtarget_achievement != nil {
-		*m.addtarget_achievement += i
+// AddGoalValue adds f to the "goal_value" field.
+func (m *TargetMutation) AddGoalValue(f float64) {
+	if m.addgoal_value != nil {
+		*m.addgoal_value += f
 	} else {
-		m.addtarget_achievement = &i
+		m.addgoal_value = &f
 	}
 }
 
-// AddedTargetAchievement returns the value that was added to the "target_achievement" field in this mutation.
-func (m *StepRateMutation) AddedTargetAchievement() (r int32, exists bool) {
-	v := m.addtarget_achievement
+// AddedGoalValue returns the value that was added to the "goal_value" field in this mutation.
+func (m *TargetMutation) AddedGoalValue() (r float64, exists bool) {
+	v := m.addgoal_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTargetAchievement resets all changes to the "target_achievement" field.
-func (m *StepRateMutation) ResetTargetAchievement() {
-	m.target_achievement = nil
-	m.addtarget_achievement = nil
+// ClearGoalValue clears the value of the "goal_value" field.
+func (m *TargetMutation) ClearGoalValue() {
+	m.goal_value = nil
+	m.addgoal_value = nil
+	m.clearedFields[target.FieldGoalValue] = struct{}{}
 }
 
-// SetReflectionImprovement sets the "reflection_improvement" field.
-func (m *StepRateMutation) SetReflectionImprovement(i int32) {
-	m.reflection_improvement = &i
-	m.addreflection_improvement = nil
+// GoalValueCleared returns if the "goal_value" field was cleared in this mutation.
+func (m *TargetMutation) GoalValueCleared() bool {
+	_, ok := m.clearedFields[target.FieldGoalValue]
+	return ok
 }
 
-// ReflectionImprovement returns the value of the "reflection_improvement" field in the mutation.
-func (m *StepRateMutation) ReflectionImprovement() (r int32, exists bool) {
-	v := m.reflection_improvement
+// ResetGoalValue resets all changes to the "goal_value" field.
+func (m *TargetMutation) ResetGoalValue() {
+	m.goal_value = nil
+	m.addgoal_value = nil
+	delete(m.clearedFields, target.FieldGoalValue)
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *TargetMutation) SetSortOrder(f float64) {
+	m.sort_order = &f
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *TargetMutation) SortOrder() (r float64, exists bool) {
+	v := m.sort_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReflectionImprovement returns the old "reflection_improvement" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldSortOrder returns the old "sort_order" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldReflectionImprovement(ctx context.Context) (v int32, err error) {
+func (m *TargetMutation) OldSortOrder(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReflectionImprovement is only allowed on UpdateOne operations")
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReflectionImprovement requires an ID field in the mutation")
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReflectionImprovement: %w", err)
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
 	}
-	return oldValue.ReflectionImprovement, nil
+	return oldValue.SortOrder, nil
 }
 
-// AddReflectionImprovement adds i to the "reflection_improvement" field.
-func (m *StepRateMutation) AddReflectionImprovement(i int32) {
-	if m.addreflection_improvement != nil {
-		*m.addreflection_improvement += i
+// AddSortOrder adds f to the "sort_order" field.
+func (m *TargetMutation) AddSortOrder(f float64) {
+	if m.addsort_order != nil {
+		*m.addsort_order += f
 	} else {
-		m.addreflection_improvement = &i
+		m.addsort_order = &f
 	}
 }
 
-// AddedReflectionImprovement returns the value that was added to the "reflection_improvement" field in this mutation.
-func (m *StepRateMutation) AddedReflectionImprovement() (r int32, exists bool) {
-	v := m.addreflection_improvement
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *TargetMutation) AddedSortOrder() (r float64, exists bool) {
+	v := m.addsort_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetReflectionImprovement resets all changes to the "reflection_improvement" field.
-func (m *StepRateMutation) ResetReflectionImprovement() {
-	m.reflection_improvement = nil
-	m.addreflection_improvement = nil
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *TargetMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
 }
 
-// SetInnovation sets the "innovation" field.
-func (m *StepRateMutation) SetInnovation(i int32) {
-	m.innovation = &i
-	m.addinnovation = nil
+// SetPath sets the "path" field.
+func (m *TargetMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// Innovation returns the value of the "innovation" field in the mutation.
-func (m *StepRateMutation) Innovation() (r int32, exists bool) {
-	v := m.innovation
+// Path returns the value of the "path" field in the mutation.
+func (m *TargetMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInnovation returns the old "innovation" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldInnovation(ctx context.Context) (v int32, err error) {
+func (m *TargetMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInnovation is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInnovation requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInnovation: %w", err)
-	}
-	return oldValue.Innovation, nil
-}
-
-// AddInnovation adds i to the "innovation" field.
-func (m *StepRateMutation) AddInnovation(i int32) {
-	if m.addinnovation != nil {
-		*m.addinnovation += i
-	} else {
-		m.addinnovation = &i
-	}
-}
-
-// AddedInnovation returns the value that was added to the "innovation" field in this mutation.
-func (m *StepRateMutation) AddedInnovation() (r int32, exists bool) {
-	v := m.addinnovation
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return *v, true
+	return oldValue.Path, nil
 }
 
-// ResetInnovation resets all changes to the "innovation" field.
-func (m *StepRateMutation) ResetInnovation() {
-	m.innovation = nil
-	m.addinnovation = nil
+// ResetPath resets all changes to the "path" field.
+func (m *TargetMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetBasicReliability sets the "basic_reliability" field.
-func (m *StepRateMutation) SetBasicReliability(i int32) {
-	m.basic_reliability = &i
-	m.addbasic_reliability = nil
+// SetRubric sets the "rubric" field.
+func (m *TargetMutation) SetRubric(or *objects.TargetRubric) {
+	m.rubric = &or
 }
 
-// BasicReliability returns the value of the "basic_reliability" field in the mutation.
-func (m *StepRateMutation) BasicReliability() (r int32, exists bool) {
-	v := m.basic_reliability
+// Rubric returns the value of the "rubric" field in the mutation.
+func (m *TargetMutation) Rubric() (r *objects.TargetRubric, exists bool) {
+	v := m.rubric
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBasicReliability returns the old "basic_reliability" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
+// OldRubric returns the old "rubric" field's value of the Target entity.
+// If the Target object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldBasicReliability(ctx context.Context) (v int32, err error) {
+func (m *TargetMutation) OldRubric(ctx context.Context) (v *objects.TargetRubric, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBasicReliability is only allowed on UpdateOne operations")
+		return v, errors.New("OldRubric is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBasicReliability requires an ID field in the mutation")
+		return v, errors.New("OldRubric requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBasicReliability: %w", err)
+		return v, fmt.Errorf("querying old value for OldRubric: %w", err)
 	}
-	return oldValue.BasicReliability, nil
+	return oldValue.Rubric, nil
 }
 
-// AddBasicReliability adds i to the "basic_reliability" field.
-func (m *StepRateMutation) AddBasicReliability(i int32) {
-	if m.addbasic_reliability != nil {
-		*m.addbasic_reliability += i
-	} else {
-		m.addbasic_reliability = &i
+// ClearRubric clears the value of the "rubric" field.
+func (m *TargetMutation) ClearRubric() {
+	m.rubric = nil
+	m.clearedFields[target.FieldRubric] = struct{}{}
+}
+
+// RubricCleared returns if the "rubric" field was cleared in this mutation.
+func (m *TargetMutation) RubricCleared() bool {
+	_, ok := m.clearedFields[target.FieldRubric]
+	return ok
+}
+
+// ResetRubric resets all changes to the "rubric" field.
+func (m *TargetMutation) ResetRubric() {
+	m.rubric = nil
+	delete(m.clearedFields, target.FieldRubric)
+}
+
+// ClearParent clears the "parent" edge to the Target entity.
+func (m *TargetMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[target.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Target entity was cleared.
+func (m *TargetMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TargetMutation) ParentIDs() (ids []uint64) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TargetMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
 }
 
-// AddedBasicReliability returns the value that was added to the "basic_reliability" field in this mutation.
-func (m *StepRateMutation) AddedBasicReliability() (r int32, exists bool) {
-	v := m.addbasic_reliability
-	if v == nil {
-		return
+// AddChildIDs adds the "children" edge to the Target entity by ids.
+func (m *TargetMutation) AddChildIDs(ids ...uint64) {
+	if m.children == nil {
+		m.children = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetBasicReliability resets all changes to the "basic_reliability" field.
-func (m *StepRateMutation) ResetBasicReliability() {
-	m.basic_reliability = nil
-	m.addbasic_reliability = nil
+// ClearChildren clears the "children" edge to the Target entity.
+func (m *TargetMutation) ClearChildren() {
+	m.clearedchildren = true
 }
 
-// SetSkillImprovement sets the "skill_improvement" field.
-func (m *StepRateMutation) SetSkillImprovement(i int32) {
-	m.skill_improvement = &i
-	m.addskill_improvement = nil
+// ChildrenCleared reports if the "children" edge to the Target entity was cleared.
+func (m *TargetMutation) ChildrenCleared() bool {
+	return m.clearedchildren
 }
 
-// SkillImprovement returns the value of the "skill_improvement" field in the mutation.
-func (m *StepRateMutation) SkillImprovement() (r int32, exists bool) {
-	v := m.skill_improvement
-	if v == nil {
-		return
+// RemoveChildIDs removes the "children" edge to the Target entity by IDs.
+func (m *TargetMutation) RemoveChildIDs(ids ...uint64) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSkillImprovement returns the old "skill_improvement" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldSkillImprovement(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSkillImprovement is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSkillImprovement requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSkillImprovement: %w", err)
+// RemovedChildren returns the removed IDs of the "children" edge to the Target entity.
+func (m *TargetMutation) RemovedChildrenIDs() (ids []uint64) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
 	}
-	return oldValue.SkillImprovement, nil
+	return
 }
 
-// AddSkillImprovement adds i to the "skill_improvement" field.
-func (m *StepRateMutation) AddSkillImprovement(i int32) {
-	if m.addskill_improvement != nil {
-		*m.addskill_improvement += i
-	} else {
-		m.addskill_improvement = &i
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *TargetMutation) ChildrenIDs() (ids []uint64) {
+	for id := range m.children {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedSkillImprovement returns the value that was added to the "skill_improvement" field in this mutation.
-func (m *StepRateMutation) AddedSkillImprovement() (r int32, exists bool) {
-	v := m.addskill_improvement
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetChildren resets all changes to the "children" edge.
+func (m *TargetMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
 }
 
-// ResetSkillImprovement resets all changes to the "skill_improvement" field.
-func (m *StepRateMutation) ResetSkillImprovement() {
-	m.skill_improvement = nil
-	m.addskill_improvement = nil
+// AddStepIDs adds the "steps" edge to the Step entity by ids.
+func (m *TargetMutation) AddStepIDs(ids ...uint64) {
+	if m.steps == nil {
+		m.steps = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.steps[ids[i]] = struct{}{}
+	}
 }
 
-// SetDifficulty sets the "difficulty" field.
-func (m *StepRateMutation) SetDifficulty(i int32) {
-	m.difficulty = &i
-	m.adddifficulty = nil
+// ClearSteps clears the "steps" edge to the Step entity.
+func (m *TargetMutation) ClearSteps() {
+	m.clearedsteps = true
 }
 
-// Difficulty returns the value of the "difficulty" field in the mutation.
-func (m *StepRateMutation) Difficulty() (r int32, exists bool) {
-	v := m.difficulty
-	if v == nil {
-		return
-	}
-	return *v, true
+// StepsCleared reports if the "steps" edge to the Step entity was cleared.
+func (m *TargetMutation) StepsCleared() bool {
+	return m.clearedsteps
 }
 
-// OldDifficulty returns the old "difficulty" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldDifficulty(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+// RemoveStepIDs removes the "steps" edge to the Step entity by IDs.
+func (m *TargetMutation) RemoveStepIDs(ids ...uint64) {
+	if m.removedsteps == nil {
+		m.removedsteps = make(map[uint64]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.steps, ids[i])
+		m.removedsteps[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+}
+
+// RemovedSteps returns the removed IDs of the "steps" edge to the Step entity.
+func (m *TargetMutation) RemovedStepsIDs() (ids []uint64) {
+	for id := range m.removedsteps {
+		ids = append(ids, id)
 	}
-	return oldValue.Difficulty, nil
+	return
 }
 
-// AddDifficulty adds i to the "difficulty" field.
-func (m *StepRateMutation) AddDifficulty(i int32) {
-	if m.adddifficulty != nil {
-		*m.adddifficulty += i
-	} else {
-		m.adddifficulty = &i
+// StepsIDs returns the "steps" edge IDs in the mutation.
+func (m *TargetMutation) StepsIDs() (ids []uint64) {
+	for id := range m.steps {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
-func (m *StepRateMutation) AddedDifficulty() (r int32, exists bool) {
-	v := m.adddifficulty
-	if v == nil {
-		return
+// ResetSteps resets all changes to the "steps" edge.
+func (m *TargetMutation) ResetSteps() {
+	m.steps = nil
+	m.clearedsteps = false
+	m.removedsteps = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *TargetMutation) AddTagIDs(ids ...uint64) {
+	if m.tags == nil {
+		m.tags = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetDifficulty resets all changes to the "difficulty" field.
-func (m *StepRateMutation) ResetDifficulty() {
-	m.difficulty = nil
-	m.adddifficulty = nil
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *TargetMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// SetDate sets the "date" field.
-func (m *StepRateMutation) SetDate(t time.Time) {
-	m.date = &t
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *TargetMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *StepRateMutation) Date() (r time.Time, exists bool) {
-	v := m.date
-	if v == nil {
-		return
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *TargetMutation) RemoveTagIDs(ids ...uint64) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uint64]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the StepRate entity.
-// If the StepRate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StepRateMutation) OldDate(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *TargetMutation) RemovedTagsIDs() (ids []uint64) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *TargetMutation) TagsIDs() (ids []uint64) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
-	return oldValue.Date, nil
+	return
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *StepRateMutation) ResetDate() {
-	m.date = nil
+// ResetTags resets all changes to the "tags" edge.
+func (m *TargetMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// Where appends a list predicates to the StepRateMutation builder.
-func (m *StepRateMutation) Where(ps ...predicate.StepRate) {
+// Where appends a list predicates to the TargetMutation builder.
+func (m *TargetMutation) Where(ps ...predicate.Target) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StepRateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TargetMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StepRateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StepRate, len(ps))
+func (m *TargetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Target, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5262,66 +18689,84 @@ func (m *StepRateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StepRateMutation) Op() Op {
+func (m *TargetMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StepRateMutation) SetOp(op Op) {
+func (m *TargetMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (StepRate).
-func (m *StepRateMutation) Type() string {
+// Type returns the node type of this mutation (Target).
+func (m *TargetMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StepRateMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *TargetMutation) Fields() []string {
+	fields := make([]string, 0, 20)
 	if m.user_id != nil {
-		fields = append(fields, steprate.FieldUserID)
+		fields = append(fields, target.FieldUserID)
 	}
-	if m.top_target_id != nil {
-		fields = append(fields, steprate.FieldTopTargetID)
+	if m.title != nil {
+		fields = append(fields, target.FieldTitle)
 	}
-	if m.target_id != nil {
-		fields = append(fields, steprate.FieldTargetID)
+	if m.description != nil {
+		fields = append(fields, target.FieldDescription)
 	}
-	if m.step_id != nil {
-		fields = append(fields, steprate.FieldStepID)
+	if m._type != nil {
+		fields = append(fields, target.FieldType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, target.FieldCreatedAt)
+	}
+	if m.start_at != nil {
+		fields = append(fields, target.FieldStartAt)
+	}
+	if m.challenge_at != nil {
+		fields = append(fields, target.FieldChallengeAt)
+	}
+	if m.done_at != nil {
+		fields = append(fields, target.FieldDoneAt)
+	}
+	if m.layer != nil {
+		fields = append(fields, target.FieldLayer)
 	}
-	if m.weighted_value != nil {
-		fields = append(fields, steprate.FieldWeightedValue)
+	if m.status != nil {
+		fields = append(fields, target.FieldStatus)
 	}
-	if m.target_reasonableness != nil {
-		fields = append(fields, steprate.FieldTargetReasonableness)
+	if m.parent != nil {
+		fields = append(fields, target.FieldParentID)
 	}
-	if m.target_clarity != nil {
-		fields = append(fields, steprate.FieldTargetClarity)
+	if m.schedule_type != nil {
+		fields = append(fields, target.FieldScheduleType)
 	}
-	if m.target_achievement != nil {
-		fields = append(fields, steprate.FieldTargetAchievement)
+	if m.schedule_weekdays != nil {
+		fields = append(fields, target.FieldScheduleWeekdays)
 	}
-	if m.reflection_improvement != nil {
-		fields = append(fields, steprate.FieldReflectionImprovement)
+	if m.schedule_times != nil {
+		fields = append(fields, target.FieldScheduleTimes)
 	}
-	if m.innovation != nil {
-		fields = append(fields, steprate.FieldInnovation)
+	if m.mode != nil {
+		fields = append(fields, target.FieldMode)
 	}
-	if m.basic_reliability != nil {
-		fields = append(fields, steprate.FieldBasicReliability)
+	if m.unit != nil {
+		fields = append(fields, target.FieldUnit)
 	}
-	if m.skill_improvement != nil {
-		fields = append(fields, steprate.FieldSkillImprovement)
+	if m.goal_value != nil {
+		fields = append(fields, target.FieldGoalValue)
 	}
-	if m.difficulty != nil {
-		fields = append(fields, steprate.FieldDifficulty)
+	if m.sort_order != nil {
+		fields = append(fields, target.FieldSortOrder)
 	}
-	if m.date != nil {
-		fields = append(fields, steprate.FieldDate)
+	if m._path != nil {
+		fields = append(fields, target.FieldPath)
+	}
+	if m.rubric != nil {
+		fields = append(fields, target.FieldRubric)
 	}
 	return fields
 }
@@ -5329,36 +18774,48 @@ func (m *StepRateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StepRateMutation) Field(name string) (ent.Value, bool) {
+func (m *TargetMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case steprate.FieldUserID:
+	case target.FieldUserID:
 		return m.UserID()
-	case steprate.FieldTopTargetID:
-		return m.TopTargetID()
-	case steprate.FieldTargetID:
-		return m.TargetID()
-	case steprate.FieldStepID:
-		return m.StepID()
-	case steprate.FieldWeightedValue:
-		return m.WeightedValue()
-	case steprate.FieldTargetReasonableness:
-		return m.TargetReasonableness()
-	case steprate.FieldTargetClarity:
-		return m.TargetClarity()
-	case steprate.FieldTargetAchievement:
-		return m.TargetAchievement()
-	case steprate.FieldReflectionImprovement:
-		return m.ReflectionImprovement()
-	case steprate.FieldInnovation:
-		return m.Innovation()
-	case steprate.FieldBasicReliability:
-		return m.BasicReliability()
-	case steprate.FieldSkillImprovement:
-		return m.SkillImprovement()
-	case steprate.FieldDifficulty:
-		return m.Difficulty()
-	case steprate.FieldDate:
-		return m.Date()
+	case target.FieldTitle:
+		return m.Title()
+	case target.FieldDescription:
+		return m.Description()
+	case target.FieldType:
+		return m.GetType()
+	case target.FieldCreatedAt:
+		return m.CreatedAt()
+	case target.FieldStartAt:
+		return m.StartAt()
+	case target.FieldChallengeAt:
+		return m.ChallengeAt()
+	case target.FieldDoneAt:
+		return m.DoneAt()
+	case target.FieldLayer:
+		return m.Layer()
+	case target.FieldStatus:
+		return m.Status()
+	case target.FieldParentID:
+		return m.ParentID()
+	case target.FieldScheduleType:
+		return m.ScheduleType()
+	case target.FieldScheduleWeekdays:
+		return m.ScheduleWeekdays()
+	case target.FieldScheduleTimes:
+		return m.ScheduleTimes()
+	case target.FieldMode:
+		return m.Mode()
+	case target.FieldUnit:
+		return m.Unit()
+	case target.FieldGoalValue:
+		return m.GoalValue()
+	case target.FieldSortOrder:
+		return m.SortOrder()
+	case target.FieldPath:
+		return m.Path()
+	case target.FieldRubric:
+		return m.Rubric()
 	}
 	return nil, false
 }
@@ -5366,186 +18823,228 @@ func (m *StepRateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StepRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case steprate.FieldUserID:
+	case target.FieldUserID:
 		return m.OldUserID(ctx)
-	case steprate.FieldTopTargetID:
-		return m.OldTopTargetID(ctx)
-	case steprate.FieldTargetID:
-		return m.OldTargetID(ctx)
-	case steprate.FieldStepID:
-		return m.OldStepID(ctx)
-	case steprate.FieldWeightedValue:
-		return m.OldWeightedValue(ctx)
-	case steprate.FieldTargetReasonableness:
-		return m.OldTargetReasonableness(ctx)
-	case steprate.FieldTargetClarity:
-		return m.OldTargetClarity(ctx)
-	case steprate.FieldTargetAchievement:
-		return m.OldTargetAchievement(ctx)
-	case steprate.FieldReflectionImprovement:
-		return m.OldReflectionImprovement(ctx)
-	case steprate.FieldInnovation:
-		return m.OldInnovation(ctx)
-	case steprate.FieldBasicReliability:
-		return m.OldBasicReliability(ctx)
-	case steprate.FieldSkillImprovement:
-		return m.OldSkillImprovement(ctx)
-	case steprate.FieldDifficulty:
-		return m.OldDifficulty(ctx)
-	case steprate.FieldDate:
-		return m.OldDate(ctx)
+	case target.FieldTitle:
+		return m.OldTitle(ctx)
+	case target.FieldDescription:
+		return m.OldDescription(ctx)
+	case target.FieldType:
+		return m.OldType(ctx)
+	case target.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case target.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case target.FieldChallengeAt:
+		return m.OldChallengeAt(ctx)
+	case target.FieldDoneAt:
+		return m.OldDoneAt(ctx)
+	case target.FieldLayer:
+		return m.OldLayer(ctx)
+	case target.FieldStatus:
+		return m.OldStatus(ctx)
+	case target.FieldParentID:
+		return m.OldParentID(ctx)
+	case target.FieldScheduleType:
+		return m.OldScheduleType(ctx)
+	case target.FieldScheduleWeekdays:
+		return m.OldScheduleWeekdays(ctx)
+	case target.FieldScheduleTimes:
+		return m.OldScheduleTimes(ctx)
+	case target.FieldMode:
+		return m.OldMode(ctx)
+	case target.FieldUnit:
+		return m.OldUnit(ctx)
+	case target.FieldGoalValue:
+		return m.OldGoalValue(ctx)
+	case target.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case target.FieldPath:
+		return m.OldPath(ctx)
+	case target.FieldRubric:
+		return m.OldRubric(ctx)
 	}
-	return nil, fmt.Errorf("unknown StepRate field %s", name)
+	return nil, fmt.Errorf("unknown Target field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StepRateMutation) SetField(name string, value ent.Value) error {
+func (m *TargetMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case steprate.FieldUserID:
+	case target.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case steprate.FieldTopTargetID:
-		v, ok := value.(uint64)
+	case target.FieldTitle:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTopTargetID(v)
+		m.SetTitle(v)
 		return nil
-	case steprate.FieldTargetID:
-		v, ok := value.(uint64)
+	case target.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetID(v)
+		m.SetDescription(v)
 		return nil
-	case steprate.FieldStepID:
-		v, ok := value.(uint64)
+	case target.FieldType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStepID(v)
+		m.SetType(v)
 		return nil
-	case steprate.FieldWeightedValue:
-		v, ok := value.(float64)
+	case target.FieldCreatedAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWeightedValue(v)
+		m.SetCreatedAt(v)
 		return nil
-	case steprate.FieldTargetReasonableness:
-		v, ok := value.(int32)
+	case target.FieldStartAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetReasonableness(v)
+		m.SetStartAt(v)
 		return nil
-	case steprate.FieldTargetClarity:
-		v, ok := value.(int32)
+	case target.FieldChallengeAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetClarity(v)
+		m.SetChallengeAt(v)
 		return nil
-	case steprate.FieldTargetAchievement:
-		v, ok := value.(int32)
+	case target.FieldDoneAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetAchievement(v)
+		m.SetDoneAt(v)
 		return nil
-	case steprate.FieldReflectionImprovement:
-		v, ok := value.(int32)
+	case target.FieldLayer:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReflectionImprovement(v)
+		m.SetLayer(v)
 		return nil
-	case steprate.FieldInnovation:
-		v, ok := value.(int32)
+	case target.FieldStatus:
+		v, ok := value.(target.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInnovation(v)
+		m.SetStatus(v)
 		return nil
-	case steprate.FieldBasicReliability:
-		v, ok := value.(int32)
+	case target.FieldParentID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBasicReliability(v)
+		m.SetParentID(v)
 		return nil
-	case steprate.FieldSkillImprovement:
-		v, ok := value.(int32)
+	case target.FieldScheduleType:
+		v, ok := value.(target.ScheduleType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSkillImprovement(v)
+		m.SetScheduleType(v)
 		return nil
-	case steprate.FieldDifficulty:
-		v, ok := value.(int32)
+	case target.FieldScheduleWeekdays:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDifficulty(v)
+		m.SetScheduleWeekdays(v)
 		return nil
-	case steprate.FieldDate:
-		v, ok := value.(time.Time)
+	case target.FieldScheduleTimes:
+		v, ok := value.(uint32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDate(v)
+		m.SetScheduleTimes(v)
+		return nil
+	case target.FieldMode:
+		v, ok := value.(target.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case target.FieldUnit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnit(v)
+		return nil
+	case target.FieldGoalValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoalValue(v)
+		return nil
+	case target.FieldSortOrder:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSortOrder(v)
+		return nil
+	case target.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case target.FieldRubric:
+		v, ok := value.(*objects.TargetRubric)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRubric(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StepRate field %s", name)
+	return fmt.Errorf("unknown Target field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StepRateMutation) AddedFields() []string {
+func (m *TargetMutation) AddedFields() []string {
 	var fields []string
-	if m.addtop_target_id != nil {
-		fields = append(fields, steprate.FieldTopTargetID)
-	}
-	if m.addtarget_id != nil {
-		fields = append(fields, steprate.FieldTargetID)
-	}
-	if m.addstep_id != nil {
-		fields = append(fields, steprate.FieldStepID)
-	}
-	if m.addweighted_value != nil {
-		fields = append(fields, steprate.FieldWeightedValue)
-	}
-	if m.addtarget_reasonableness != nil {
-		fields = append(fields, steprate.FieldTargetReasonableness)
+	if m.addcreated_at != nil {
+		fields = append(fields, target.FieldCreatedAt)
 	}
-	if m.addtarget_clarity != nil {
-		fields = append(fields, steprate.FieldTargetClarity)
+	if m.addstart_at != nil {
+		fields = append(fields, target.FieldStartAt)
 	}
-	if m.addtarget_achievement != nil {
-		fields = append(fields, steprate.FieldTargetAchievement)
+	if m.addchallenge_at != nil {
+		fields = append(fields, target.FieldChallengeAt)
 	}
-	if m.addreflection_improvement != nil {
-		fields = append(fields, steprate.FieldReflectionImprovement)
+	if m.adddone_at != nil {
+		fields = append(fields, target.FieldDoneAt)
 	}
-	if m.addinnovation != nil {
-		fields = append(fields, steprate.FieldInnovation)
+	if m.addlayer != nil {
+		fields = append(fields, target.FieldLayer)
 	}
-	if m.addbasic_reliability != nil {
-		fields = append(fields, steprate.FieldBasicReliability)
+	if m.addschedule_times != nil {
+		fields = append(fields, target.FieldScheduleTimes)
 	}
-	if m.addskill_improvement != nil {
-		fields = append(fields, steprate.FieldSkillImprovement)
+	if m.addgoal_value != nil {
+		fields = append(fields, target.FieldGoalValue)
 	}
-	if m.adddifficulty != nil {
-		fields = append(fields, steprate.FieldDifficulty)
+	if m.addsort_order != nil {
+		fields = append(fields, target.FieldSortOrder)
 	}
 	return fields
 }
@@ -5553,32 +19052,24 @@ func (m *StepRateMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StepRateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TargetMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case steprate.FieldTopTargetID:
-		return m.AddedTopTargetID()
-	case steprate.FieldTargetID:
-		return m.AddedTargetID()
-	case steprate.FieldStepID:
-		return m.AddedStepID()
-	case steprate.FieldWeightedValue:
-		return m.AddedWeightedValue()
-	case steprate.FieldTargetReasonableness:
-		return m.AddedTargetReasonableness()
-	case steprate.FieldTargetClarity:
-		return m.AddedTargetClarity()
-	case steprate.FieldTargetAchievement:
-		return m.AddedTargetAchievement()
-	case steprate.FieldReflectionImprovement:
-		return m.AddedReflectionImprovement()
-	case steprate.FieldInnovation:
-		return m.AddedInnovation()
-	case steprate.FieldBasicReliability:
-		return m.AddedBasicReliability()
-	case steprate.FieldSkillImprovement:
-		return m.AddedSkillImprovement()
-	case steprate.FieldDifficulty:
-		return m.AddedDifficulty()
+	case target.FieldCreatedAt:
+		return m.AddedCreatedAt()
+	case target.FieldStartAt:
+		return m.AddedStartAt()
+	case target.FieldChallengeAt:
+		return m.AddedChallengeAt()
+	case target.FieldDoneAt:
+		return m.AddedDoneAt()
+	case target.FieldLayer:
+		return m.AddedLayer()
+	case target.FieldScheduleTimes:
+		return m.AddedScheduleTimes()
+	case target.FieldGoalValue:
+		return m.AddedGoalValue()
+	case target.FieldSortOrder:
+		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -5586,259 +19077,401 @@ func (m *StepRateMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StepRateMutation) AddField(name string, value ent.Value) error {
+func (m *TargetMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case steprate.FieldTopTargetID:
+	case target.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTopTargetID(v)
+		m.AddCreatedAt(v)
 		return nil
-	case steprate.FieldTargetID:
+	case target.FieldStartAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTargetID(v)
+		m.AddStartAt(v)
 		return nil
-	case steprate.FieldStepID:
+	case target.FieldChallengeAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStepID(v)
-		return nil
-	case steprate.FieldWeightedValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWeightedValue(v)
-		return nil
-	case steprate.FieldTargetReasonableness:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTargetReasonableness(v)
-		return nil
-	case steprate.FieldTargetClarity:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTargetClarity(v)
-		return nil
-	case steprate.FieldTargetAchievement:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTargetAchievement(v)
+		m.AddChallengeAt(v)
 		return nil
-	case steprate.FieldReflectionImprovement:
-		v, ok := value.(int32)
+	case target.FieldDoneAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddReflectionImprovement(v)
+		m.AddDoneAt(v)
 		return nil
-	case steprate.FieldInnovation:
+	case target.FieldLayer:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInnovation(v)
+		m.AddLayer(v)
 		return nil
-	case steprate.FieldBasicReliability:
+	case target.FieldScheduleTimes:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBasicReliability(v)
+		m.AddScheduleTimes(v)
 		return nil
-	case steprate.FieldSkillImprovement:
-		v, ok := value.(int32)
+	case target.FieldGoalValue:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSkillImprovement(v)
+		m.AddGoalValue(v)
 		return nil
-	case steprate.FieldDifficulty:
-		v, ok := value.(int32)
+	case target.FieldSortOrder:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDifficulty(v)
+		m.AddSortOrder(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StepRate numeric field %s", name)
+	return fmt.Errorf("unknown Target numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StepRateMutation) ClearedFields() []string {
-	return nil
+func (m *TargetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(target.FieldStartAt) {
+		fields = append(fields, target.FieldStartAt)
+	}
+	if m.FieldCleared(target.FieldChallengeAt) {
+		fields = append(fields, target.FieldChallengeAt)
+	}
+	if m.FieldCleared(target.FieldDoneAt) {
+		fields = append(fields, target.FieldDoneAt)
+	}
+	if m.FieldCleared(target.FieldParentID) {
+		fields = append(fields, target.FieldParentID)
+	}
+	if m.FieldCleared(target.FieldScheduleWeekdays) {
+		fields = append(fields, target.FieldScheduleWeekdays)
+	}
+	if m.FieldCleared(target.FieldScheduleTimes) {
+		fields = append(fields, target.FieldScheduleTimes)
+	}
+	if m.FieldCleared(target.FieldUnit) {
+		fields = append(fields, target.FieldUnit)
+	}
+	if m.FieldCleared(target.FieldGoalValue) {
+		fields = append(fields, target.FieldGoalValue)
+	}
+	if m.FieldCleared(target.FieldRubric) {
+		fields = append(fields, target.FieldRubric)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StepRateMutation) FieldCleared(name string) bool {
+func (m *TargetMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StepRateMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown StepRate nullable field %s", name)
+func (m *TargetMutation) ClearField(name string) error {
+	switch name {
+	case target.FieldStartAt:
+		m.ClearStartAt()
+		return nil
+	case target.FieldChallengeAt:
+		m.ClearChallengeAt()
+		return nil
+	case target.FieldDoneAt:
+		m.ClearDoneAt()
+		return nil
+	case target.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case target.FieldScheduleWeekdays:
+		m.ClearScheduleWeekdays()
+		return nil
+	case target.FieldScheduleTimes:
+		m.ClearScheduleTimes()
+		return nil
+	case target.FieldUnit:
+		m.ClearUnit()
+		return nil
+	case target.FieldGoalValue:
+		m.ClearGoalValue()
+		return nil
+	case target.FieldRubric:
+		m.ClearRubric()
+		return nil
+	}
+	return fmt.Errorf("unknown Target nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StepRateMutation) ResetField(name string) error {
+func (m *TargetMutation) ResetField(name string) error {
 	switch name {
-	case steprate.FieldUserID:
+	case target.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case steprate.FieldTopTargetID:
-		m.ResetTopTargetID()
+	case target.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case steprate.FieldTargetID:
-		m.ResetTargetID()
+	case target.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case steprate.FieldStepID:
-		m.ResetStepID()
+	case target.FieldType:
+		m.ResetType()
 		return nil
-	case steprate.FieldWeightedValue:
-		m.ResetWeightedValue()
+	case target.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case steprate.FieldTargetReasonableness:
-		m.ResetTargetReasonableness()
+	case target.FieldStartAt:
+		m.ResetStartAt()
 		return nil
-	case steprate.FieldTargetClarity:
-		m.ResetTargetClarity()
+	case target.FieldChallengeAt:
+		m.ResetChallengeAt()
 		return nil
-	case steprate.FieldTargetAchievement:
-		m.ResetTargetAchievement()
+	case target.FieldDoneAt:
+		m.ResetDoneAt()
 		return nil
-	case steprate.FieldReflectionImprovement:
-		m.ResetReflectionImprovement()
+	case target.FieldLayer:
+		m.ResetLayer()
 		return nil
-	case steprate.FieldInnovation:
-		m.ResetInnovation()
+	case target.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case steprate.FieldBasicReliability:
-		m.ResetBasicReliability()
+	case target.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case steprate.FieldSkillImprovement:
-		m.ResetSkillImprovement()
+	case target.FieldScheduleType:
+		m.ResetScheduleType()
 		return nil
-	case steprate.FieldDifficulty:
-		m.ResetDifficulty()
+	case target.FieldScheduleWeekdays:
+		m.ResetScheduleWeekdays()
 		return nil
-	case steprate.FieldDate:
-		m.ResetDate()
+	case target.FieldScheduleTimes:
+		m.ResetScheduleTimes()
+		return nil
+	case target.FieldMode:
+		m.ResetMode()
+		return nil
+	case target.FieldUnit:
+		m.ResetUnit()
+		return nil
+	case target.FieldGoalValue:
+		m.ResetGoalValue()
+		return nil
+	case target.FieldSortOrder:
+		m.ResetSortOrder()
+		return nil
+	case target.FieldPath:
+		m.ResetPath()
+		return nil
+	case target.FieldRubric:
+		m.ResetRubric()
 		return nil
 	}
-	return fmt.Errorf("unknown StepRate field %s", name)
+	return fmt.Errorf("unknown Target field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StepRateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TargetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.parent != nil {
+		edges = append(edges, target.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, target.EdgeChildren)
+	}
+	if m.steps != nil {
+		edges = append(edges, target.EdgeSteps)
+	}
+	if m.tags != nil {
+		edges = append(edges, target.EdgeTags)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StepRateMutation) AddedIDs(name string) []ent.Value {
+func (m *TargetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case target.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case target.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case target.EdgeSteps:
+		ids := make([]ent.Value, 0, len(m.steps))
+		for id := range m.steps {
+			ids = append(ids, id)
+		}
+		return ids
+	case target.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StepRateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TargetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, target.EdgeChildren)
+	}
+	if m.removedsteps != nil {
+		edges = append(edges, target.EdgeSteps)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, target.EdgeTags)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StepRateMutation) RemovedIDs(name string) []ent.Value {
+func (m *TargetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case target.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case target.EdgeSteps:
+		ids := make([]ent.Value, 0, len(m.removedsteps))
+		for id := range m.removedsteps {
+			ids = append(ids, id)
+		}
+		return ids
+	case target.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StepRateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TargetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedparent {
+		edges = append(edges, target.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, target.EdgeChildren)
+	}
+	if m.clearedsteps {
+		edges = append(edges, target.EdgeSteps)
+	}
+	if m.clearedtags {
+		edges = append(edges, target.EdgeTags)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StepRateMutation) EdgeCleared(name string) bool {
+func (m *TargetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case target.EdgeParent:
+		return m.clearedparent
+	case target.EdgeChildren:
+		return m.clearedchildren
+	case target.EdgeSteps:
+		return m.clearedsteps
+	case target.EdgeTags:
+		return m.clearedtags
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StepRateMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown StepRate unique edge %s", name)
+func (m *TargetMutation) ClearEdge(name string) error {
+	switch name {
+	case target.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Target unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StepRateMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown StepRate edge %s", name)
+func (m *TargetMutation) ResetEdge(name string) error {
+	switch name {
+	case target.EdgeParent:
+		m.ResetParent()
+		return nil
+	case target.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case target.EdgeSteps:
+		m.ResetSteps()
+		return nil
+	case target.EdgeTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Target edge %s", name)
 }
 
-// TargetMutation represents an operation that mutates the Target nodes in the graph.
-type TargetMutation struct {
+// TemplateMutation represents an operation that mutates the Template nodes in the graph.
+type TemplateMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uint64
-	user_id         *string
-	title           *string
-	description     *string
-	_type           *string
-	created_at      *int64
-	addcreated_at   *int64
-	start_at        *int64
-	addstart_at     *int64
-	challenge_at    *int64
-	addchallenge_at *int64
-	done_at         *int64
-	adddone_at      *int64
-	layer           *uint32
-	addlayer        *int32
-	status          *target.Status
-	clearedFields   map[string]struct{}
-	parent          *uint64
-	clearedparent   bool
-	children        map[uint64]struct{}
-	removedchildren map[uint64]struct{}
-	clearedchildren bool
-	steps           map[uint64]struct{}
-	removedsteps    map[uint64]struct{}
-	clearedsteps    bool
-	done            bool
-	oldValue        func(context.Context) (*Target, error)
-	predicates      []predicate.Target
-}
-
-var _ ent.Mutation = (*TargetMutation)(nil)
-
-// targetOption allows management of the mutation configuration using functional options.
-type targetOption func(*TargetMutation)
-
-// newTargetMutation creates new mutation for the Target entity.
-func newTargetMutation(c config, op Op, opts ...targetOption) *TargetMutation {
-	m := &TargetMutation{
+	op                  Op
+	typ                 string
+	id                  *uint64
+	user_id             *string
+	title               *string
+	description         *string
+	visibility          *template.Visibility
+	share_token         *string
+	content             **objects.TemplateTarget
+	source_target_id    *uint64
+	addsource_target_id *int64
+	created_at          *int64
+	addcreated_at       *int64
+	updated_at          *int64
+	addupdated_at       *int64
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Template, error)
+	predicates          []predicate.Template
+}
+
+var _ ent.Mutation = (*TemplateMutation)(nil)
+
+// templateOption allows management of the mutation configuration using functional options.
+type templateOption func(*TemplateMutation)
+
+// newTemplateMutation creates new mutation for the Template entity.
+func newTemplateMutation(c config, op Op, opts ...templateOption) *TemplateMutation {
+	m := &TemplateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTarget,
+		typ:           TypeTemplate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5847,20 +19480,20 @@ func newTargetMutation(c config, op Op, opts ...targetOption) *TargetMutation {
 	return m
 }
 
-// withTargetID sets the ID field of the mutation.
-func withTargetID(id uint64) targetOption {
-	return func(m *TargetMutation) {
+// withTemplateID sets the ID field of the mutation.
+func withTemplateID(id uint64) templateOption {
+	return func(m *TemplateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Target
+			value *Template
 		)
-		m.oldValue = func(ctx context.Context) (*Target, error) {
+		m.oldValue = func(ctx context.Context) (*Template, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Target.Get(ctx, id)
+					value, err = m.Client().Template.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5869,10 +19502,10 @@ func withTargetID(id uint64) targetOption {
 	}
 }
 
-// withTarget sets the old Target of the mutation.
-func withTarget(node *Target) targetOption {
-	return func(m *TargetMutation) {
-		m.oldValue = func(context.Context) (*Target, error) {
+// withTemplate sets the old Template of the mutation.
+func withTemplate(node *Template) templateOption {
+	return func(m *TemplateMutation) {
+		m.oldValue = func(context.Context) (*Template, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5881,7 +19514,7 @@ func withTarget(node *Target) targetOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TargetMutation) Client() *Client {
+func (m TemplateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5889,7 +19522,7 @@ func (m TargetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TargetMutation) Tx() (*Tx, error) {
+func (m TemplateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5899,14 +19532,14 @@ func (m TargetMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Target entities.
-func (m *TargetMutation) SetID(id uint64) {
+// operation is only accepted on creation of Template entities.
+func (m *TemplateMutation) SetID(id uint64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TargetMutation) ID() (id uint64, exists bool) {
+func (m *TemplateMutation) ID() (id uint64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5917,716 +19550,454 @@ func (m *TargetMutation) ID() (id uint64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TargetMutation) IDs(ctx context.Context) ([]uint64, error) {
+func (m *TemplateMutation) IDs(ctx context.Context) ([]uint64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
 			return []uint64{id}, nil
 		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Target.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetUserID sets the "user_id" field.
-func (m *TargetMutation) SetUserID(s string) {
-	m.user_id = &s
-}
-
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *TargetMutation) UserID() (r string, exists bool) {
-	v := m.user_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *TargetMutation) ResetUserID() {
-	m.user_id = nil
-}
-
-// SetTitle sets the "title" field.
-func (m *TargetMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *TargetMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *TargetMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetDescription sets the "description" field.
-func (m *TargetMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *TargetMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *TargetMutation) ResetDescription() {
-	m.description = nil
-}
-
-// SetType sets the "type" field.
-func (m *TargetMutation) SetType(s string) {
-	m._type = &s
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *TargetMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *TargetMutation) ResetType() {
-	m._type = nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Template.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TargetMutation) SetCreatedAt(i int64) {
-	m.created_at = &i
-	m.addcreated_at = nil
+// SetUserID sets the "user_id" field.
+func (m *TemplateMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TargetMutation) CreatedAt() (r int64, exists bool) {
-	v := m.created_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TemplateMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+func (m *TemplateMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// AddCreatedAt adds i to the "created_at" field.
-func (m *TargetMutation) AddCreatedAt(i int64) {
-	if m.addcreated_at != nil {
-		*m.addcreated_at += i
-	} else {
-		m.addcreated_at = &i
-	}
-}
-
-// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
-func (m *TargetMutation) AddedCreatedAt() (r int64, exists bool) {
-	v := m.addcreated_at
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return *v, true
+	return oldValue.UserID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TargetMutation) ResetCreatedAt() {
-	m.created_at = nil
-	m.addcreated_at = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TemplateMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetStartAt sets the "start_at" field.
-func (m *TargetMutation) SetStartAt(i int64) {
-	m.start_at = &i
-	m.addstart_at = nil
+// SetTitle sets the "title" field.
+func (m *TemplateMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// StartAt returns the value of the "start_at" field in the mutation.
-func (m *TargetMutation) StartAt() (r int64, exists bool) {
-	v := m.start_at
+// Title returns the value of the "title" field in the mutation.
+func (m *TemplateMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartAt returns the old "start_at" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldStartAt(ctx context.Context) (v int64, err error) {
+func (m *TemplateMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartAt requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
-	}
-	return oldValue.StartAt, nil
-}
-
-// AddStartAt adds i to the "start_at" field.
-func (m *TargetMutation) AddStartAt(i int64) {
-	if m.addstart_at != nil {
-		*m.addstart_at += i
-	} else {
-		m.addstart_at = &i
-	}
-}
-
-// AddedStartAt returns the value that was added to the "start_at" field in this mutation.
-func (m *TargetMutation) AddedStartAt() (r int64, exists bool) {
-	v := m.addstart_at
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return *v, true
-}
-
-// ClearStartAt clears the value of the "start_at" field.
-func (m *TargetMutation) ClearStartAt() {
-	m.start_at = nil
-	m.addstart_at = nil
-	m.clearedFields[target.FieldStartAt] = struct{}{}
-}
-
-// StartAtCleared returns if the "start_at" field was cleared in this mutation.
-func (m *TargetMutation) StartAtCleared() bool {
-	_, ok := m.clearedFields[target.FieldStartAt]
-	return ok
+	return oldValue.Title, nil
 }
 
-// ResetStartAt resets all changes to the "start_at" field.
-func (m *TargetMutation) ResetStartAt() {
-	m.start_at = nil
-	m.addstart_at = nil
-	delete(m.clearedFields, target.FieldStartAt)
+// ResetTitle resets all changes to the "title" field.
+func (m *TemplateMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetChallengeAt sets the "challenge_at" field.
-func (m *TargetMutation) SetChallengeAt(i int64) {
-	m.challenge_at = &i
-	m.addchallenge_at = nil
+// SetDescription sets the "description" field.
+func (m *TemplateMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ChallengeAt returns the value of the "challenge_at" field in the mutation.
-func (m *TargetMutation) ChallengeAt() (r int64, exists bool) {
-	v := m.challenge_at
+// Description returns the value of the "description" field in the mutation.
+func (m *TemplateMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChallengeAt returns the old "challenge_at" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldChallengeAt(ctx context.Context) (v int64, err error) {
+func (m *TemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChallengeAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChallengeAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChallengeAt: %w", err)
-	}
-	return oldValue.ChallengeAt, nil
-}
-
-// AddChallengeAt adds i to the "challenge_at" field.
-func (m *TargetMutation) AddChallengeAt(i int64) {
-	if m.addchallenge_at != nil {
-		*m.addchallenge_at += i
-	} else {
-		m.addchallenge_at = &i
-	}
-}
-
-// AddedChallengeAt returns the value that was added to the "challenge_at" field in this mutation.
-func (m *TargetMutation) AddedChallengeAt() (r int64, exists bool) {
-	v := m.addchallenge_at
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return *v, true
+	return oldValue.Description, nil
 }
 
-// ClearChallengeAt clears the value of the "challenge_at" field.
-func (m *TargetMutation) ClearChallengeAt() {
-	m.challenge_at = nil
-	m.addchallenge_at = nil
-	m.clearedFields[target.FieldChallengeAt] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *TemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[template.FieldDescription] = struct{}{}
 }
 
-// ChallengeAtCleared returns if the "challenge_at" field was cleared in this mutation.
-func (m *TargetMutation) ChallengeAtCleared() bool {
-	_, ok := m.clearedFields[target.FieldChallengeAt]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[template.FieldDescription]
 	return ok
 }
 
-// ResetChallengeAt resets all changes to the "challenge_at" field.
-func (m *TargetMutation) ResetChallengeAt() {
-	m.challenge_at = nil
-	m.addchallenge_at = nil
-	delete(m.clearedFields, target.FieldChallengeAt)
+// ResetDescription resets all changes to the "description" field.
+func (m *TemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, template.FieldDescription)
 }
 
-// SetDoneAt sets the "done_at" field.
-func (m *TargetMutation) SetDoneAt(i int64) {
-	m.done_at = &i
-	m.adddone_at = nil
+// SetVisibility sets the "visibility" field.
+func (m *TemplateMutation) SetVisibility(t template.Visibility) {
+	m.visibility = &t
 }
 
-// DoneAt returns the value of the "done_at" field in the mutation.
-func (m *TargetMutation) DoneAt() (r int64, exists bool) {
-	v := m.done_at
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *TemplateMutation) Visibility() (r template.Visibility, exists bool) {
+	v := m.visibility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDoneAt returns the old "done_at" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldVisibility returns the old "visibility" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldDoneAt(ctx context.Context) (v int64, err error) {
+func (m *TemplateMutation) OldVisibility(ctx context.Context) (v template.Visibility, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDoneAt requires an ID field in the mutation")
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
-	}
-	return oldValue.DoneAt, nil
-}
-
-// AddDoneAt adds i to the "done_at" field.
-func (m *TargetMutation) AddDoneAt(i int64) {
-	if m.adddone_at != nil {
-		*m.adddone_at += i
-	} else {
-		m.adddone_at = &i
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
 	}
+	return oldValue.Visibility, nil
 }
 
-// AddedDoneAt returns the value that was added to the "done_at" field in this mutation.
-func (m *TargetMutation) AddedDoneAt() (r int64, exists bool) {
-	v := m.adddone_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearDoneAt clears the value of the "done_at" field.
-func (m *TargetMutation) ClearDoneAt() {
-	m.done_at = nil
-	m.adddone_at = nil
-	m.clearedFields[target.FieldDoneAt] = struct{}{}
-}
-
-// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
-func (m *TargetMutation) DoneAtCleared() bool {
-	_, ok := m.clearedFields[target.FieldDoneAt]
-	return ok
-}
-
-// ResetDoneAt resets all changes to the "done_at" field.
-func (m *TargetMutation) ResetDoneAt() {
-	m.done_at = nil
-	m.adddone_at = nil
-	delete(m.clearedFields, target.FieldDoneAt)
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *TemplateMutation) ResetVisibility() {
+	m.visibility = nil
 }
 
-// SetLayer sets the "layer" field.
-func (m *TargetMutation) SetLayer(u uint32) {
-	m.layer = &u
-	m.addlayer = nil
+// SetShareToken sets the "share_token" field.
+func (m *TemplateMutation) SetShareToken(s string) {
+	m.share_token = &s
 }
 
-// Layer returns the value of the "layer" field in the mutation.
-func (m *TargetMutation) Layer() (r uint32, exists bool) {
-	v := m.layer
+// ShareToken returns the value of the "share_token" field in the mutation.
+func (m *TemplateMutation) ShareToken() (r string, exists bool) {
+	v := m.share_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLayer returns the old "layer" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldShareToken returns the old "share_token" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldLayer(ctx context.Context) (v uint32, err error) {
+func (m *TemplateMutation) OldShareToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLayer is only allowed on UpdateOne operations")
+		return v, errors.New("OldShareToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLayer requires an ID field in the mutation")
+		return v, errors.New("OldShareToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLayer: %w", err)
+		return v, fmt.Errorf("querying old value for OldShareToken: %w", err)
 	}
-	return oldValue.Layer, nil
+	return oldValue.ShareToken, nil
 }
 
-// AddLayer adds u to the "layer" field.
-func (m *TargetMutation) AddLayer(u int32) {
-	if m.addlayer != nil {
-		*m.addlayer += u
-	} else {
-		m.addlayer = &u
-	}
+// ClearShareToken clears the value of the "share_token" field.
+func (m *TemplateMutation) ClearShareToken() {
+	m.share_token = nil
+	m.clearedFields[template.FieldShareToken] = struct{}{}
 }
 
-// AddedLayer returns the value that was added to the "layer" field in this mutation.
-func (m *TargetMutation) AddedLayer() (r int32, exists bool) {
-	v := m.addlayer
-	if v == nil {
-		return
-	}
-	return *v, true
+// ShareTokenCleared returns if the "share_token" field was cleared in this mutation.
+func (m *TemplateMutation) ShareTokenCleared() bool {
+	_, ok := m.clearedFields[template.FieldShareToken]
+	return ok
 }
 
-// ResetLayer resets all changes to the "layer" field.
-func (m *TargetMutation) ResetLayer() {
-	m.layer = nil
-	m.addlayer = nil
+// ResetShareToken resets all changes to the "share_token" field.
+func (m *TemplateMutation) ResetShareToken() {
+	m.share_token = nil
+	delete(m.clearedFields, template.FieldShareToken)
 }
-
-// SetStatus sets the "status" field.
-func (m *TargetMutation) SetStatus(t target.Status) {
-	m.status = &t
+
+// SetContent sets the "content" field.
+func (m *TemplateMutation) SetContent(ot *objects.TemplateTarget) {
+	m.content = &ot
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TargetMutation) Status() (r target.Status, exists bool) {
-	v := m.status
+// Content returns the value of the "content" field in the mutation.
+func (m *TemplateMutation) Content() (r *objects.TemplateTarget, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldStatus(ctx context.Context) (v target.Status, err error) {
+func (m *TemplateMutation) OldContent(ctx context.Context) (v *objects.TemplateTarget, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Content, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TargetMutation) ResetStatus() {
-	m.status = nil
+// ResetContent resets all changes to the "content" field.
+func (m *TemplateMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *TargetMutation) SetParentID(u uint64) {
-	m.parent = &u
+// SetSourceTargetID sets the "source_target_id" field.
+func (m *TemplateMutation) SetSourceTargetID(u uint64) {
+	m.source_target_id = &u
+	m.addsource_target_id = nil
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *TargetMutation) ParentID() (r uint64, exists bool) {
-	v := m.parent
+// SourceTargetID returns the value of the "source_target_id" field in the mutation.
+func (m *TemplateMutation) SourceTargetID() (r uint64, exists bool) {
+	v := m.source_target_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Target entity.
-// If the Target object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceTargetID returns the old "source_target_id" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TargetMutation) OldParentID(ctx context.Context) (v uint64, err error) {
+func (m *TemplateMutation) OldSourceTargetID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceTargetID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldSourceTargetID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceTargetID: %w", err)
 	}
-	return oldValue.ParentID, nil
-}
-
-// ClearParentID clears the value of the "parent_id" field.
-func (m *TargetMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[target.FieldParentID] = struct{}{}
+	return oldValue.SourceTargetID, nil
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *TargetMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[target.FieldParentID]
-	return ok
+// AddSourceTargetID adds u to the "source_target_id" field.
+func (m *TemplateMutation) AddSourceTargetID(u int64) {
+	if m.addsource_target_id != nil {
+		*m.addsource_target_id += u
+	} else {
+		m.addsource_target_id = &u
+	}
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *TargetMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, target.FieldParentID)
+// AddedSourceTargetID returns the value that was added to the "source_target_id" field in this mutation.
+func (m *TemplateMutation) AddedSourceTargetID() (r int64, exists bool) {
+	v := m.addsource_target_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearParent clears the "parent" edge to the Target entity.
-func (m *TargetMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[target.FieldParentID] = struct{}{}
+// ClearSourceTargetID clears the value of the "source_target_id" field.
+func (m *TemplateMutation) ClearSourceTargetID() {
+	m.source_target_id = nil
+	m.addsource_target_id = nil
+	m.clearedFields[template.FieldSourceTargetID] = struct{}{}
 }
 
-// ParentCleared reports if the "parent" edge to the Target entity was cleared.
-func (m *TargetMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// SourceTargetIDCleared returns if the "source_target_id" field was cleared in this mutation.
+func (m *TemplateMutation) SourceTargetIDCleared() bool {
+	_, ok := m.clearedFields[template.FieldSourceTargetID]
+	return ok
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *TargetMutation) ParentIDs() (ids []uint64) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetSourceTargetID resets all changes to the "source_target_id" field.
+func (m *TemplateMutation) ResetSourceTargetID() {
+	m.source_target_id = nil
+	m.addsource_target_id = nil
+	delete(m.clearedFields, template.FieldSourceTargetID)
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *TargetMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// SetCreatedAt sets the "created_at" field.
+func (m *TemplateMutation) SetCreatedAt(i int64) {
+	m.created_at = &i
+	m.addcreated_at = nil
 }
 
-// AddChildIDs adds the "children" edge to the Target entity by ids.
-func (m *TargetMutation) AddChildIDs(ids ...uint64) {
-	if m.children == nil {
-		m.children = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TemplateMutation) CreatedAt() (r int64, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearChildren clears the "children" edge to the Target entity.
-func (m *TargetMutation) ClearChildren() {
-	m.clearedchildren = true
-}
-
-// ChildrenCleared reports if the "children" edge to the Target entity was cleared.
-func (m *TargetMutation) ChildrenCleared() bool {
-	return m.clearedchildren
-}
-
-// RemoveChildIDs removes the "children" edge to the Target entity by IDs.
-func (m *TargetMutation) RemoveChildIDs(ids ...uint64) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uint64]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldCreatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-}
-
-// RemovedChildren returns the removed IDs of the "children" edge to the Target entity.
-func (m *TargetMutation) RemovedChildrenIDs() (ids []uint64) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return
+	return oldValue.CreatedAt, nil
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *TargetMutation) ChildrenIDs() (ids []uint64) {
-	for id := range m.children {
-		ids = append(ids, id)
+// AddCreatedAt adds i to the "created_at" field.
+func (m *TemplateMutation) AddCreatedAt(i int64) {
+	if m.addcreated_at != nil {
+		*m.addcreated_at += i
+	} else {
+		m.addcreated_at = &i
 	}
-	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *TargetMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// AddedCreatedAt returns the value that was added to the "created_at" field in this mutation.
+func (m *TemplateMutation) AddedCreatedAt() (r int64, exists bool) {
+	v := m.addcreated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddStepIDs adds the "steps" edge to the Step entity by ids.
-func (m *TargetMutation) AddStepIDs(ids ...uint64) {
-	if m.steps == nil {
-		m.steps = make(map[uint64]struct{})
-	}
-	for i := range ids {
-		m.steps[ids[i]] = struct{}{}
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+	m.addcreated_at = nil
 }
 
-// ClearSteps clears the "steps" edge to the Step entity.
-func (m *TargetMutation) ClearSteps() {
-	m.clearedsteps = true
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TemplateMutation) SetUpdatedAt(i int64) {
+	m.updated_at = &i
+	m.addupdated_at = nil
 }
 
-// StepsCleared reports if the "steps" edge to the Step entity was cleared.
-func (m *TargetMutation) StepsCleared() bool {
-	return m.clearedsteps
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TemplateMutation) UpdatedAt() (r int64, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveStepIDs removes the "steps" edge to the Step entity by IDs.
-func (m *TargetMutation) RemoveStepIDs(ids ...uint64) {
-	if m.removedsteps == nil {
-		m.removedsteps = make(map[uint64]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldUpdatedAt(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.steps, ids[i])
-		m.removedsteps[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// RemovedSteps returns the removed IDs of the "steps" edge to the Step entity.
-func (m *TargetMutation) RemovedStepsIDs() (ids []uint64) {
-	for id := range m.removedsteps {
-		ids = append(ids, id)
+// AddUpdatedAt adds i to the "updated_at" field.
+func (m *TemplateMutation) AddUpdatedAt(i int64) {
+	if m.addupdated_at != nil {
+		*m.addupdated_at += i
+	} else {
+		m.addupdated_at = &i
 	}
-	return
 }
 
-// StepsIDs returns the "steps" edge IDs in the mutation.
-func (m *TargetMutation) StepsIDs() (ids []uint64) {
-	for id := range m.steps {
-		ids = append(ids, id)
+// AddedUpdatedAt returns the value that was added to the "updated_at" field in this mutation.
+func (m *TemplateMutation) AddedUpdatedAt() (r int64, exists bool) {
+	v := m.addupdated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetSteps resets all changes to the "steps" edge.
-func (m *TargetMutation) ResetSteps() {
-	m.steps = nil
-	m.clearedsteps = false
-	m.removedsteps = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	m.addupdated_at = nil
 }
 
-// Where appends a list predicates to the TargetMutation builder.
-func (m *TargetMutation) Where(ps ...predicate.Target) {
+// Where appends a list predicates to the TemplateMutation builder.
+func (m *TemplateMutation) Where(ps ...predicate.Template) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TargetMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TargetMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Target, len(ps))
+func (m *TemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Template, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6634,57 +20005,51 @@ func (m *TargetMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TargetMutation) Op() Op {
+func (m *TemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TargetMutation) SetOp(op Op) {
+func (m *TemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Target).
-func (m *TargetMutation) Type() string {
+// Type returns the node type of this mutation (Template).
+func (m *TemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TargetMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *TemplateMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.user_id != nil {
-		fields = append(fields, target.FieldUserID)
+		fields = append(fields, template.FieldUserID)
 	}
 	if m.title != nil {
-		fields = append(fields, target.FieldTitle)
+		fields = append(fields, template.FieldTitle)
 	}
 	if m.description != nil {
-		fields = append(fields, target.FieldDescription)
-	}
-	if m._type != nil {
-		fields = append(fields, target.FieldType)
-	}
-	if m.created_at != nil {
-		fields = append(fields, target.FieldCreatedAt)
+		fields = append(fields, template.FieldDescription)
 	}
-	if m.start_at != nil {
-		fields = append(fields, target.FieldStartAt)
+	if m.visibility != nil {
+		fields = append(fields, template.FieldVisibility)
 	}
-	if m.challenge_at != nil {
-		fields = append(fields, target.FieldChallengeAt)
+	if m.share_token != nil {
+		fields = append(fields, template.FieldShareToken)
 	}
-	if m.done_at != nil {
-		fields = append(fields, target.FieldDoneAt)
+	if m.content != nil {
+		fields = append(fields, template.FieldContent)
 	}
-	if m.layer != nil {
-		fields = append(fields, target.FieldLayer)
+	if m.source_target_id != nil {
+		fields = append(fields, template.FieldSourceTargetID)
 	}
-	if m.status != nil {
-		fields = append(fields, target.FieldStatus)
+	if m.created_at != nil {
+		fields = append(fields, template.FieldCreatedAt)
 	}
-	if m.parent != nil {
-		fields = append(fields, target.FieldParentID)
+	if m.updated_at != nil {
+		fields = append(fields, template.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6692,30 +20057,26 @@ func (m *TargetMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TargetMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case target.FieldUserID:
-		return m.UserID()
-	case target.FieldTitle:
-		return m.Title()
-	case target.FieldDescription:
-		return m.Description()
-	case target.FieldType:
-		return m.GetType()
-	case target.FieldCreatedAt:
-		return m.CreatedAt()
-	case target.FieldStartAt:
-		return m.StartAt()
-	case target.FieldChallengeAt:
-		return m.ChallengeAt()
-	case target.FieldDoneAt:
-		return m.DoneAt()
-	case target.FieldLayer:
-		return m.Layer()
-	case target.FieldStatus:
-		return m.Status()
-	case target.FieldParentID:
-		return m.ParentID()
+func (m *TemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case template.FieldUserID:
+		return m.UserID()
+	case template.FieldTitle:
+		return m.Title()
+	case template.FieldDescription:
+		return m.Description()
+	case template.FieldVisibility:
+		return m.Visibility()
+	case template.FieldShareToken:
+		return m.ShareToken()
+	case template.FieldContent:
+		return m.Content()
+	case template.FieldSourceTargetID:
+		return m.SourceTargetID()
+	case template.FieldCreatedAt:
+		return m.CreatedAt()
+	case template.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6723,138 +20084,114 @@ func (m *TargetMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TargetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case target.FieldUserID:
+	case template.FieldUserID:
 		return m.OldUserID(ctx)
-	case target.FieldTitle:
+	case template.FieldTitle:
 		return m.OldTitle(ctx)
-	case target.FieldDescription:
+	case template.FieldDescription:
 		return m.OldDescription(ctx)
-	case target.FieldType:
-		return m.OldType(ctx)
-	case target.FieldCreatedAt:
+	case template.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case template.FieldShareToken:
+		return m.OldShareToken(ctx)
+	case template.FieldContent:
+		return m.OldContent(ctx)
+	case template.FieldSourceTargetID:
+		return m.OldSourceTargetID(ctx)
+	case template.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case target.FieldStartAt:
-		return m.OldStartAt(ctx)
-	case target.FieldChallengeAt:
-		return m.OldChallengeAt(ctx)
-	case target.FieldDoneAt:
-		return m.OldDoneAt(ctx)
-	case target.FieldLayer:
-		return m.OldLayer(ctx)
-	case target.FieldStatus:
-		return m.OldStatus(ctx)
-	case target.FieldParentID:
-		return m.OldParentID(ctx)
+	case template.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Target field %s", name)
+	return nil, fmt.Errorf("unknown Template field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TargetMutation) SetField(name string, value ent.Value) error {
+func (m *TemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case target.FieldUserID:
+	case template.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case target.FieldTitle:
+	case template.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTitle(v)
 		return nil
-	case target.FieldDescription:
+	case template.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case target.FieldType:
-		v, ok := value.(string)
+	case template.FieldVisibility:
+		v, ok := value.(template.Visibility)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetVisibility(v)
 		return nil
-	case target.FieldCreatedAt:
-		v, ok := value.(int64)
+	case template.FieldShareToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetShareToken(v)
 		return nil
-	case target.FieldStartAt:
-		v, ok := value.(int64)
+	case template.FieldContent:
+		v, ok := value.(*objects.TemplateTarget)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartAt(v)
+		m.SetContent(v)
 		return nil
-	case target.FieldChallengeAt:
-		v, ok := value.(int64)
+	case template.FieldSourceTargetID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChallengeAt(v)
+		m.SetSourceTargetID(v)
 		return nil
-	case target.FieldDoneAt:
+	case template.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDoneAt(v)
-		return nil
-	case target.FieldLayer:
-		v, ok := value.(uint32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLayer(v)
-		return nil
-	case target.FieldStatus:
-		v, ok := value.(target.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetCreatedAt(v)
 		return nil
-	case target.FieldParentID:
-		v, ok := value.(uint64)
+	case template.FieldUpdatedAt:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Target field %s", name)
+	return fmt.Errorf("unknown Template field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TargetMutation) AddedFields() []string {
+func (m *TemplateMutation) AddedFields() []string {
 	var fields []string
-	if m.addcreated_at != nil {
-		fields = append(fields, target.FieldCreatedAt)
-	}
-	if m.addstart_at != nil {
-		fields = append(fields, target.FieldStartAt)
-	}
-	if m.addchallenge_at != nil {
-		fields = append(fields, target.FieldChallengeAt)
+	if m.addsource_target_id != nil {
+		fields = append(fields, template.FieldSourceTargetID)
 	}
-	if m.adddone_at != nil {
-		fields = append(fields, target.FieldDoneAt)
+	if m.addcreated_at != nil {
+		fields = append(fields, template.FieldCreatedAt)
 	}
-	if m.addlayer != nil {
-		fields = append(fields, target.FieldLayer)
+	if m.addupdated_at != nil {
+		fields = append(fields, template.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6862,18 +20199,14 @@ func (m *TargetMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TargetMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TemplateMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case target.FieldCreatedAt:
+	case template.FieldSourceTargetID:
+		return m.AddedSourceTargetID()
+	case template.FieldCreatedAt:
 		return m.AddedCreatedAt()
-	case target.FieldStartAt:
-		return m.AddedStartAt()
-	case target.FieldChallengeAt:
-		return m.AddedChallengeAt()
-	case target.FieldDoneAt:
-		return m.AddedDoneAt()
-	case target.FieldLayer:
-		return m.AddedLayer()
+	case template.FieldUpdatedAt:
+		return m.AddedUpdatedAt()
 	}
 	return nil, false
 }
@@ -6881,258 +20214,152 @@ func (m *TargetMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TargetMutation) AddField(name string, value ent.Value) error {
+func (m *TemplateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case target.FieldCreatedAt:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreatedAt(v)
-		return nil
-	case target.FieldStartAt:
+	case template.FieldSourceTargetID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStartAt(v)
+		m.AddSourceTargetID(v)
 		return nil
-	case target.FieldChallengeAt:
+	case template.FieldCreatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddChallengeAt(v)
+		m.AddCreatedAt(v)
 		return nil
-	case target.FieldDoneAt:
+	case template.FieldUpdatedAt:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDoneAt(v)
-		return nil
-	case target.FieldLayer:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLayer(v)
+		m.AddUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Target numeric field %s", name)
+	return fmt.Errorf("unknown Template numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TargetMutation) ClearedFields() []string {
+func (m *TemplateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(target.FieldStartAt) {
-		fields = append(fields, target.FieldStartAt)
-	}
-	if m.FieldCleared(target.FieldChallengeAt) {
-		fields = append(fields, target.FieldChallengeAt)
+	if m.FieldCleared(template.FieldDescription) {
+		fields = append(fields, template.FieldDescription)
 	}
-	if m.FieldCleared(target.FieldDoneAt) {
-		fields = append(fields, target.FieldDoneAt)
+	if m.FieldCleared(template.FieldShareToken) {
+		fields = append(fields, template.FieldShareToken)
 	}
-	if m.FieldCleared(target.FieldParentID) {
-		fields = append(fields, target.FieldParentID)
+	if m.FieldCleared(template.FieldSourceTargetID) {
+		fields = append(fields, template.FieldSourceTargetID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TargetMutation) FieldCleared(name string) bool {
+func (m *TemplateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TargetMutation) ClearField(name string) error {
+func (m *TemplateMutation) ClearField(name string) error {
 	switch name {
-	case target.FieldStartAt:
-		m.ClearStartAt()
-		return nil
-	case target.FieldChallengeAt:
-		m.ClearChallengeAt()
+	case template.FieldDescription:
+		m.ClearDescription()
 		return nil
-	case target.FieldDoneAt:
-		m.ClearDoneAt()
+	case template.FieldShareToken:
+		m.ClearShareToken()
 		return nil
-	case target.FieldParentID:
-		m.ClearParentID()
+	case template.FieldSourceTargetID:
+		m.ClearSourceTargetID()
 		return nil
 	}
-	return fmt.Errorf("unknown Target nullable field %s", name)
+	return fmt.Errorf("unknown Template nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TargetMutation) ResetField(name string) error {
+func (m *TemplateMutation) ResetField(name string) error {
 	switch name {
-	case target.FieldUserID:
+	case template.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case target.FieldTitle:
+	case template.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case target.FieldDescription:
+	case template.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case target.FieldType:
-		m.ResetType()
-		return nil
-	case target.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case target.FieldStartAt:
-		m.ResetStartAt()
+	case template.FieldVisibility:
+		m.ResetVisibility()
 		return nil
-	case target.FieldChallengeAt:
-		m.ResetChallengeAt()
+	case template.FieldShareToken:
+		m.ResetShareToken()
 		return nil
-	case target.FieldDoneAt:
-		m.ResetDoneAt()
+	case template.FieldContent:
+		m.ResetContent()
 		return nil
-	case target.FieldLayer:
-		m.ResetLayer()
+	case template.FieldSourceTargetID:
+		m.ResetSourceTargetID()
 		return nil
-	case target.FieldStatus:
-		m.ResetStatus()
+	case template.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case target.FieldParentID:
-		m.ResetParentID()
+	case template.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Target field %s", name)
+	return fmt.Errorf("unknown Template field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TargetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.parent != nil {
-		edges = append(edges, target.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, target.EdgeChildren)
-	}
-	if m.steps != nil {
-		edges = append(edges, target.EdgeSteps)
-	}
+func (m *TemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TargetMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case target.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case target.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	case target.EdgeSteps:
-		ids := make([]ent.Value, 0, len(m.steps))
-		for id := range m.steps {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TemplateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TargetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedchildren != nil {
-		edges = append(edges, target.EdgeChildren)
-	}
-	if m.removedsteps != nil {
-		edges = append(edges, target.EdgeSteps)
-	}
+func (m *TemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TargetMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case target.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	case target.EdgeSteps:
-		ids := make([]ent.Value, 0, len(m.removedsteps))
-		for id := range m.removedsteps {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TemplateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TargetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedparent {
-		edges = append(edges, target.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, target.EdgeChildren)
-	}
-	if m.clearedsteps {
-		edges = append(edges, target.EdgeSteps)
-	}
+func (m *TemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TargetMutation) EdgeCleared(name string) bool {
-	switch name {
-	case target.EdgeParent:
-		return m.clearedparent
-	case target.EdgeChildren:
-		return m.clearedchildren
-	case target.EdgeSteps:
-		return m.clearedsteps
-	}
+func (m *TemplateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TargetMutation) ClearEdge(name string) error {
-	switch name {
-	case target.EdgeParent:
-		m.ClearParent()
-		return nil
-	}
-	return fmt.Errorf("unknown Target unique edge %s", name)
+func (m *TemplateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Template unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TargetMutation) ResetEdge(name string) error {
-	switch name {
-	case target.EdgeParent:
-		m.ResetParent()
-		return nil
-	case target.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	case target.EdgeSteps:
-		m.ResetSteps()
-		return nil
-	}
-	return fmt.Errorf("unknown Target edge %s", name)
+func (m *TemplateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Template edge %s", name)
 }
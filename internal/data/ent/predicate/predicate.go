@@ -9,9 +9,33 @@ import (
 // Award is the predicate function for award builders.
 type Award func(*sql.Selector)
 
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// Export is the predicate function for export builders.
+type Export func(*sql.Selector)
+
+// ImportItem is the predicate function for importitem builders.
+type ImportItem func(*sql.Selector)
+
+// ImportJob is the predicate function for importjob builders.
+type ImportJob func(*sql.Selector)
+
 // Portrait is the predicate function for portrait builders.
 type Portrait func(*sql.Selector)
 
+// Report is the predicate function for report builders.
+type Report func(*sql.Selector)
+
+// ReviewRequest is the predicate function for reviewrequest builders.
+type ReviewRequest func(*sql.Selector)
+
+// Reviewer is the predicate function for reviewer builders.
+type Reviewer func(*sql.Selector)
+
+// SearchDocument is the predicate function for searchdocument builders.
+type SearchDocument func(*sql.Selector)
+
 // Show is the predicate function for show builders.
 type Show func(*sql.Selector)
 
@@ -24,5 +48,14 @@ type Step func(*sql.Selector)
 // StepRate is the predicate function for steprate builders.
 type StepRate func(*sql.Selector)
 
+// StepRateCriterion is the predicate function for stepratecriterion builders.
+type StepRateCriterion func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // Target is the predicate function for target builders.
 type Target func(*sql.Selector)
+
+// Template is the predicate function for template builders.
+type Template func(*sql.Selector)
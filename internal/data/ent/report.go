@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"step/internal/data/ent/report"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Report is the model entity for the Report schema.
+type Report struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// User ID
+	UserID string `json:"user_id,omitempty"`
+	// 顶层目标ID
+	TargetID uint64 `json:"target_id,omitempty"`
+	// 开始日期
+	StartDate string `json:"start_date,omitempty"`
+	// 结束日期
+	EndDate string `json:"end_date,omitempty"`
+	// 评分趋势的统计单位
+	StatUnit report.StatUnit `json:"stat_unit,omitempty"`
+	// 选择的媒体积累ID
+	MediaStepIds []uint64 `json:"media_step_ids,omitempty"`
+	// 状态
+	Status report.Status `json:"status,omitempty"`
+	// HTML对象名
+	HTMLObjectName string `json:"html_object_name,omitempty"`
+	// PDF对象名
+	PdfObjectName string `json:"pdf_object_name,omitempty"`
+	// 失败原因
+	Error string `json:"error,omitempty"`
+	// 创建时间
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// 完成时间
+	FinishedAt   int64 `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Report) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldMediaStepIds:
+			values[i] = new([]byte)
+		case report.FieldID, report.FieldTargetID, report.FieldCreatedAt, report.FieldFinishedAt:
+			values[i] = new(sql.NullInt64)
+		case report.FieldUserID, report.FieldStartDate, report.FieldEndDate, report.FieldStatUnit, report.FieldStatus, report.FieldHTMLObjectName, report.FieldPdfObjectName, report.FieldError:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Report fields.
+func (r *Report) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case report.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = uint64(value.Int64)
+		case report.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				r.UserID = value.String
+			}
+		case report.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				r.TargetID = uint64(value.Int64)
+			}
+		case report.FieldStartDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				r.StartDate = value.String
+			}
+		case report.FieldEndDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				r.EndDate = value.String
+			}
+		case report.FieldStatUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stat_unit", values[i])
+			} else if value.Valid {
+				r.StatUnit = report.StatUnit(value.String)
+			}
+		case report.FieldMediaStepIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field media_step_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.MediaStepIds); err != nil {
+					return fmt.Errorf("unmarshal field media_step_ids: %w", err)
+				}
+			}
+		case report.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = report.Status(value.String)
+			}
+		case report.FieldHTMLObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field html_object_name", values[i])
+			} else if value.Valid {
+				r.HTMLObjectName = value.String
+			}
+		case report.FieldPdfObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_object_name", values[i])
+			} else if value.Valid {
+				r.PdfObjectName = value.String
+			}
+		case report.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				r.Error = value.String
+			}
+		case report.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Int64
+			}
+		case report.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				r.FinishedAt = value.Int64
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Report.
+// This includes values selected through modifiers, order, etc.
+func (r *Report) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Report.
+// Note that you need to call Report.Unwrap() before calling this method if this Report
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Report) Update() *ReportUpdateOne {
+	return NewReportClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Report entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Report) Unwrap() *Report {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Report is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Report) String() string {
+	var builder strings.Builder
+	builder.WriteString("Report(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(r.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(r.StartDate)
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(r.EndDate)
+	builder.WriteString(", ")
+	builder.WriteString("stat_unit=")
+	builder.WriteString(fmt.Sprintf("%v", r.StatUnit))
+	builder.WriteString(", ")
+	builder.WriteString("media_step_ids=")
+	builder.WriteString(fmt.Sprintf("%v", r.MediaStepIds))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("html_object_name=")
+	builder.WriteString(r.HTMLObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("pdf_object_name=")
+	builder.WriteString(r.PdfObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(r.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.FinishedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reports is a parsable slice of Report.
+type Reports []*Report
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package report
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the report type in the database.
+	Label = "report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldStatUnit holds the string denoting the stat_unit field in the database.
+	FieldStatUnit = "stat_unit"
+	// FieldMediaStepIds holds the string denoting the media_step_ids field in the database.
+	FieldMediaStepIds = "media_step_ids"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldHTMLObjectName holds the string denoting the html_object_name field in the database.
+	FieldHTMLObjectName = "html_object_name"
+	// FieldPdfObjectName holds the string denoting the pdf_object_name field in the database.
+	FieldPdfObjectName = "pdf_object_name"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the report in the database.
+	Table = "reports"
+)
+
+// Columns holds all SQL columns for report fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldTargetID,
+	FieldStartDate,
+	FieldEndDate,
+	FieldStatUnit,
+	FieldMediaStepIds,
+	FieldStatus,
+	FieldHTMLObjectName,
+	FieldPdfObjectName,
+	FieldError,
+	FieldCreatedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// StartDateValidator is a validator for the "start_date" field. It is called by the builders before save.
+	StartDateValidator func(string) error
+	// EndDateValidator is a validator for the "end_date" field. It is called by the builders before save.
+	EndDateValidator func(string) error
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() int64
+)
+
+// StatUnit defines the type for the "stat_unit" enum field.
+type StatUnit string
+
+// StatUnitWeek is the default value of the StatUnit enum.
+const DefaultStatUnit = StatUnitWeek
+
+// StatUnit values.
+const (
+	StatUnitDay   StatUnit = "day"
+	StatUnitWeek  StatUnit = "week"
+	StatUnitMonth StatUnit = "month"
+)
+
+func (su StatUnit) String() string {
+	return string(su)
+}
+
+// StatUnitValidator is a validator for the "stat_unit" field enum values. It is called by the builders before save.
+func StatUnitValidator(su StatUnit) error {
+	switch su {
+	case StatUnitDay, StatUnitWeek, StatUnitMonth:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for stat_unit field: %q", su)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusRunning Status = "running"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusDone, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("report: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Report queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByStartDate orders the results by the start_date field.
+func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
+}
+
+// ByEndDate orders the results by the end_date field.
+func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
+}
+
+// ByStatUnit orders the results by the stat_unit field.
+func ByStatUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatUnit, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByHTMLObjectName orders the results by the html_object_name field.
+func ByHTMLObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTMLObjectName, opts...).ToFunc()
+}
+
+// ByPdfObjectName orders the results by the pdf_object_name field.
+func ByPdfObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfObjectName, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
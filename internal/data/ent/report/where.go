@@ -0,0 +1,714 @@
+// Code generated by ent, DO NOT EDIT.
+
+package report
+
+import (
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldUserID, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetID, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldEndDate, v))
+}
+
+// HTMLObjectName applies equality check predicate on the "html_object_name" field. It's identical to HTMLObjectNameEQ.
+func HTMLObjectName(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHTMLObjectName, v))
+}
+
+// PdfObjectName applies equality check predicate on the "pdf_object_name" field. It's identical to PdfObjectNameEQ.
+func PdfObjectName(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldPdfObjectName, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v int64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...uint64) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...uint64) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v uint64) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldTargetID, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldStartDate, v))
+}
+
+// StartDateContains applies the Contains predicate on the "start_date" field.
+func StartDateContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldStartDate, v))
+}
+
+// StartDateHasPrefix applies the HasPrefix predicate on the "start_date" field.
+func StartDateHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldStartDate, v))
+}
+
+// StartDateHasSuffix applies the HasSuffix predicate on the "start_date" field.
+func StartDateHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldStartDate, v))
+}
+
+// StartDateEqualFold applies the EqualFold predicate on the "start_date" field.
+func StartDateEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldStartDate, v))
+}
+
+// StartDateContainsFold applies the ContainsFold predicate on the "start_date" field.
+func StartDateContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldEndDate, v))
+}
+
+// EndDateContains applies the Contains predicate on the "end_date" field.
+func EndDateContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldEndDate, v))
+}
+
+// EndDateHasPrefix applies the HasPrefix predicate on the "end_date" field.
+func EndDateHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldEndDate, v))
+}
+
+// EndDateHasSuffix applies the HasSuffix predicate on the "end_date" field.
+func EndDateHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldEndDate, v))
+}
+
+// EndDateEqualFold applies the EqualFold predicate on the "end_date" field.
+func EndDateEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldEndDate, v))
+}
+
+// EndDateContainsFold applies the ContainsFold predicate on the "end_date" field.
+func EndDateContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldEndDate, v))
+}
+
+// StatUnitEQ applies the EQ predicate on the "stat_unit" field.
+func StatUnitEQ(v StatUnit) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldStatUnit, v))
+}
+
+// StatUnitNEQ applies the NEQ predicate on the "stat_unit" field.
+func StatUnitNEQ(v StatUnit) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldStatUnit, v))
+}
+
+// StatUnitIn applies the In predicate on the "stat_unit" field.
+func StatUnitIn(vs ...StatUnit) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldStatUnit, vs...))
+}
+
+// StatUnitNotIn applies the NotIn predicate on the "stat_unit" field.
+func StatUnitNotIn(vs ...StatUnit) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldStatUnit, vs...))
+}
+
+// MediaStepIdsIsNil applies the IsNil predicate on the "media_step_ids" field.
+func MediaStepIdsIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldMediaStepIds))
+}
+
+// MediaStepIdsNotNil applies the NotNil predicate on the "media_step_ids" field.
+func MediaStepIdsNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldMediaStepIds))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HTMLObjectNameEQ applies the EQ predicate on the "html_object_name" field.
+func HTMLObjectNameEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameNEQ applies the NEQ predicate on the "html_object_name" field.
+func HTMLObjectNameNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameIn applies the In predicate on the "html_object_name" field.
+func HTMLObjectNameIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldHTMLObjectName, vs...))
+}
+
+// HTMLObjectNameNotIn applies the NotIn predicate on the "html_object_name" field.
+func HTMLObjectNameNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldHTMLObjectName, vs...))
+}
+
+// HTMLObjectNameGT applies the GT predicate on the "html_object_name" field.
+func HTMLObjectNameGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameGTE applies the GTE predicate on the "html_object_name" field.
+func HTMLObjectNameGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameLT applies the LT predicate on the "html_object_name" field.
+func HTMLObjectNameLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameLTE applies the LTE predicate on the "html_object_name" field.
+func HTMLObjectNameLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameContains applies the Contains predicate on the "html_object_name" field.
+func HTMLObjectNameContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameHasPrefix applies the HasPrefix predicate on the "html_object_name" field.
+func HTMLObjectNameHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameHasSuffix applies the HasSuffix predicate on the "html_object_name" field.
+func HTMLObjectNameHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameIsNil applies the IsNil predicate on the "html_object_name" field.
+func HTMLObjectNameIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldHTMLObjectName))
+}
+
+// HTMLObjectNameNotNil applies the NotNil predicate on the "html_object_name" field.
+func HTMLObjectNameNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldHTMLObjectName))
+}
+
+// HTMLObjectNameEqualFold applies the EqualFold predicate on the "html_object_name" field.
+func HTMLObjectNameEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldHTMLObjectName, v))
+}
+
+// HTMLObjectNameContainsFold applies the ContainsFold predicate on the "html_object_name" field.
+func HTMLObjectNameContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldHTMLObjectName, v))
+}
+
+// PdfObjectNameEQ applies the EQ predicate on the "pdf_object_name" field.
+func PdfObjectNameEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameNEQ applies the NEQ predicate on the "pdf_object_name" field.
+func PdfObjectNameNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameIn applies the In predicate on the "pdf_object_name" field.
+func PdfObjectNameIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldPdfObjectName, vs...))
+}
+
+// PdfObjectNameNotIn applies the NotIn predicate on the "pdf_object_name" field.
+func PdfObjectNameNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldPdfObjectName, vs...))
+}
+
+// PdfObjectNameGT applies the GT predicate on the "pdf_object_name" field.
+func PdfObjectNameGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameGTE applies the GTE predicate on the "pdf_object_name" field.
+func PdfObjectNameGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameLT applies the LT predicate on the "pdf_object_name" field.
+func PdfObjectNameLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameLTE applies the LTE predicate on the "pdf_object_name" field.
+func PdfObjectNameLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameContains applies the Contains predicate on the "pdf_object_name" field.
+func PdfObjectNameContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameHasPrefix applies the HasPrefix predicate on the "pdf_object_name" field.
+func PdfObjectNameHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameHasSuffix applies the HasSuffix predicate on the "pdf_object_name" field.
+func PdfObjectNameHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameIsNil applies the IsNil predicate on the "pdf_object_name" field.
+func PdfObjectNameIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldPdfObjectName))
+}
+
+// PdfObjectNameNotNil applies the NotNil predicate on the "pdf_object_name" field.
+func PdfObjectNameNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldPdfObjectName))
+}
+
+// PdfObjectNameEqualFold applies the EqualFold predicate on the "pdf_object_name" field.
+func PdfObjectNameEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldPdfObjectName, v))
+}
+
+// PdfObjectNameContainsFold applies the ContainsFold predicate on the "pdf_object_name" field.
+func PdfObjectNameContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldPdfObjectName, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Report {
+	return predicate.Report(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Report {
+	return predicate.Report(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Report {
+	return predicate.Report(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v int64) predicate.Report {
+	return predicate.Report(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v int64) predicate.Report {
+	return predicate.Report(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...int64) predicate.Report {
+	return predicate.Report(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...int64) predicate.Report {
+	return predicate.Report(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v int64) predicate.Report {
+	return predicate.Report(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v int64) predicate.Report {
+	return predicate.Report(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v int64) predicate.Report {
+	return predicate.Report(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v int64) predicate.Report {
+	return predicate.Report(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.Report {
+	return predicate.Report(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.Report {
+	return predicate.Report(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Report) predicate.Report {
+	return predicate.Report(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Report) predicate.Report {
+	return predicate.Report(sql.NotPredicates(p))
+}
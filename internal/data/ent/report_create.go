@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/report"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportCreate is the builder for creating a Report entity.
+type ReportCreate struct {
+	config
+	mutation *ReportMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rc *ReportCreate) SetUserID(s string) *ReportCreate {
+	rc.mutation.SetUserID(s)
+	return rc
+}
+
+// SetTargetID sets the "target_id" field.
+func (rc *ReportCreate) SetTargetID(u uint64) *ReportCreate {
+	rc.mutation.SetTargetID(u)
+	return rc
+}
+
+// SetStartDate sets the "start_date" field.
+func (rc *ReportCreate) SetStartDate(s string) *ReportCreate {
+	rc.mutation.SetStartDate(s)
+	return rc
+}
+
+// SetEndDate sets the "end_date" field.
+func (rc *ReportCreate) SetEndDate(s string) *ReportCreate {
+	rc.mutation.SetEndDate(s)
+	return rc
+}
+
+// SetStatUnit sets the "stat_unit" field.
+func (rc *ReportCreate) SetStatUnit(ru report.StatUnit) *ReportCreate {
+	rc.mutation.SetStatUnit(ru)
+	return rc
+}
+
+// SetNillableStatUnit sets the "stat_unit" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableStatUnit(ru *report.StatUnit) *ReportCreate {
+	if ru != nil {
+		rc.SetStatUnit(*ru)
+	}
+	return rc
+}
+
+// SetMediaStepIds sets the "media_step_ids" field.
+func (rc *ReportCreate) SetMediaStepIds(u []uint64) *ReportCreate {
+	rc.mutation.SetMediaStepIds(u)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReportCreate) SetStatus(r report.Status) *ReportCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableStatus(r *report.Status) *ReportCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetHTMLObjectName sets the "html_object_name" field.
+func (rc *ReportCreate) SetHTMLObjectName(s string) *ReportCreate {
+	rc.mutation.SetHTMLObjectName(s)
+	return rc
+}
+
+// SetNillableHTMLObjectName sets the "html_object_name" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableHTMLObjectName(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetHTMLObjectName(*s)
+	}
+	return rc
+}
+
+// SetPdfObjectName sets the "pdf_object_name" field.
+func (rc *ReportCreate) SetPdfObjectName(s string) *ReportCreate {
+	rc.mutation.SetPdfObjectName(s)
+	return rc
+}
+
+// SetNillablePdfObjectName sets the "pdf_object_name" field if the given value is not nil.
+func (rc *ReportCreate) SetNillablePdfObjectName(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetPdfObjectName(*s)
+	}
+	return rc
+}
+
+// SetError sets the "error" field.
+func (rc *ReportCreate) SetError(s string) *ReportCreate {
+	rc.mutation.SetError(s)
+	return rc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableError(s *string) *ReportCreate {
+	if s != nil {
+		rc.SetError(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReportCreate) SetCreatedAt(i int64) *ReportCreate {
+	rc.mutation.SetCreatedAt(i)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableCreatedAt(i *int64) *ReportCreate {
+	if i != nil {
+		rc.SetCreatedAt(*i)
+	}
+	return rc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (rc *ReportCreate) SetFinishedAt(i int64) *ReportCreate {
+	rc.mutation.SetFinishedAt(i)
+	return rc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (rc *ReportCreate) SetNillableFinishedAt(i *int64) *ReportCreate {
+	if i != nil {
+		rc.SetFinishedAt(*i)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReportCreate) SetID(u uint64) *ReportCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (rc *ReportCreate) Mutation() *ReportMutation {
+	return rc.mutation
+}
+
+// Save creates the Report in the database.
+func (rc *ReportCreate) Save(ctx context.Context) (*Report, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReportCreate) SaveX(ctx context.Context) *Report {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReportCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReportCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReportCreate) defaults() {
+	if _, ok := rc.mutation.StatUnit(); !ok {
+		v := report.DefaultStatUnit
+		rc.mutation.SetStatUnit(v)
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		v := report.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := report.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReportCreate) check() error {
+	if _, ok := rc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Report.user_id"`)}
+	}
+	if _, ok := rc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Report.target_id"`)}
+	}
+	if _, ok := rc.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "Report.start_date"`)}
+	}
+	if v, ok := rc.mutation.StartDate(); ok {
+		if err := report.StartDateValidator(v); err != nil {
+			return &ValidationError{Name: "start_date", err: fmt.Errorf(`ent: validator failed for field "Report.start_date": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "Report.end_date"`)}
+	}
+	if v, ok := rc.mutation.EndDate(); ok {
+		if err := report.EndDateValidator(v); err != nil {
+			return &ValidationError{Name: "end_date", err: fmt.Errorf(`ent: validator failed for field "Report.end_date": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.StatUnit(); !ok {
+		return &ValidationError{Name: "stat_unit", err: errors.New(`ent: missing required field "Report.stat_unit"`)}
+	}
+	if v, ok := rc.mutation.StatUnit(); ok {
+		if err := report.StatUnitValidator(v); err != nil {
+			return &ValidationError{Name: "stat_unit", err: fmt.Errorf(`ent: validator failed for field "Report.stat_unit": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Report.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := rc.mutation.Error(); ok {
+		if err := report.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "Report.error": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Report.created_at"`)}
+	}
+	return nil
+}
+
+func (rc *ReportCreate) sqlSave(ctx context.Context) (*Report, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReportCreate) createSpec() (*Report, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Report{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(report.Table, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUint64))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.UserID(); ok {
+		_spec.SetField(report.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rc.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeUint64, value)
+		_node.TargetID = value
+	}
+	if value, ok := rc.mutation.StartDate(); ok {
+		_spec.SetField(report.FieldStartDate, field.TypeString, value)
+		_node.StartDate = value
+	}
+	if value, ok := rc.mutation.EndDate(); ok {
+		_spec.SetField(report.FieldEndDate, field.TypeString, value)
+		_node.EndDate = value
+	}
+	if value, ok := rc.mutation.StatUnit(); ok {
+		_spec.SetField(report.FieldStatUnit, field.TypeEnum, value)
+		_node.StatUnit = value
+	}
+	if value, ok := rc.mutation.MediaStepIds(); ok {
+		_spec.SetField(report.FieldMediaStepIds, field.TypeJSON, value)
+		_node.MediaStepIds = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.HTMLObjectName(); ok {
+		_spec.SetField(report.FieldHTMLObjectName, field.TypeString, value)
+		_node.HTMLObjectName = value
+	}
+	if value, ok := rc.mutation.PdfObjectName(); ok {
+		_spec.SetField(report.FieldPdfObjectName, field.TypeString, value)
+		_node.PdfObjectName = value
+	}
+	if value, ok := rc.mutation.Error(); ok {
+		_spec.SetField(report.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(report.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.FinishedAt(); ok {
+		_spec.SetField(report.FieldFinishedAt, field.TypeInt64, value)
+		_node.FinishedAt = value
+	}
+	return _node, _spec
+}
+
+// ReportCreateBulk is the builder for creating many Report entities in bulk.
+type ReportCreateBulk struct {
+	config
+	err      error
+	builders []*ReportCreate
+}
+
+// Save creates the Report entities in the database.
+func (rcb *ReportCreateBulk) Save(ctx context.Context) ([]*Report, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Report, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReportCreateBulk) SaveX(ctx context.Context) []*Report {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReportCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
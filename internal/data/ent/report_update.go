@@ -0,0 +1,768 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/report"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ReportUpdate is the builder for updating Report entities.
+type ReportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ru *ReportUpdate) Where(ps ...predicate.Report) *ReportUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetUserID sets the "user_id" field.
+func (ru *ReportUpdate) SetUserID(s string) *ReportUpdate {
+	ru.mutation.SetUserID(s)
+	return ru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableUserID(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetUserID(*s)
+	}
+	return ru
+}
+
+// SetTargetID sets the "target_id" field.
+func (ru *ReportUpdate) SetTargetID(u uint64) *ReportUpdate {
+	ru.mutation.ResetTargetID()
+	ru.mutation.SetTargetID(u)
+	return ru
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableTargetID(u *uint64) *ReportUpdate {
+	if u != nil {
+		ru.SetTargetID(*u)
+	}
+	return ru
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (ru *ReportUpdate) AddTargetID(u int64) *ReportUpdate {
+	ru.mutation.AddTargetID(u)
+	return ru
+}
+
+// SetStartDate sets the "start_date" field.
+func (ru *ReportUpdate) SetStartDate(s string) *ReportUpdate {
+	ru.mutation.SetStartDate(s)
+	return ru
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStartDate(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetStartDate(*s)
+	}
+	return ru
+}
+
+// SetEndDate sets the "end_date" field.
+func (ru *ReportUpdate) SetEndDate(s string) *ReportUpdate {
+	ru.mutation.SetEndDate(s)
+	return ru
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableEndDate(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetEndDate(*s)
+	}
+	return ru
+}
+
+// SetStatUnit sets the "stat_unit" field.
+func (ru *ReportUpdate) SetStatUnit(value report.StatUnit) *ReportUpdate {
+	ru.mutation.SetStatUnit(value)
+	return ru
+}
+
+// SetNillableStatUnit sets the "stat_unit" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStatUnit(value *report.StatUnit) *ReportUpdate {
+	if value != nil {
+		ru.SetStatUnit(*value)
+	}
+	return ru
+}
+
+// SetMediaStepIds sets the "media_step_ids" field.
+func (ru *ReportUpdate) SetMediaStepIds(u []uint64) *ReportUpdate {
+	ru.mutation.SetMediaStepIds(u)
+	return ru
+}
+
+// AppendMediaStepIds appends u to the "media_step_ids" field.
+func (ru *ReportUpdate) AppendMediaStepIds(u []uint64) *ReportUpdate {
+	ru.mutation.AppendMediaStepIds(u)
+	return ru
+}
+
+// ClearMediaStepIds clears the value of the "media_step_ids" field.
+func (ru *ReportUpdate) ClearMediaStepIds() *ReportUpdate {
+	ru.mutation.ClearMediaStepIds()
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReportUpdate) SetStatus(r report.Status) *ReportUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableStatus(r *report.Status) *ReportUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetHTMLObjectName sets the "html_object_name" field.
+func (ru *ReportUpdate) SetHTMLObjectName(s string) *ReportUpdate {
+	ru.mutation.SetHTMLObjectName(s)
+	return ru
+}
+
+// SetNillableHTMLObjectName sets the "html_object_name" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableHTMLObjectName(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetHTMLObjectName(*s)
+	}
+	return ru
+}
+
+// ClearHTMLObjectName clears the value of the "html_object_name" field.
+func (ru *ReportUpdate) ClearHTMLObjectName() *ReportUpdate {
+	ru.mutation.ClearHTMLObjectName()
+	return ru
+}
+
+// SetPdfObjectName sets the "pdf_object_name" field.
+func (ru *ReportUpdate) SetPdfObjectName(s string) *ReportUpdate {
+	ru.mutation.SetPdfObjectName(s)
+	return ru
+}
+
+// SetNillablePdfObjectName sets the "pdf_object_name" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillablePdfObjectName(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetPdfObjectName(*s)
+	}
+	return ru
+}
+
+// ClearPdfObjectName clears the value of the "pdf_object_name" field.
+func (ru *ReportUpdate) ClearPdfObjectName() *ReportUpdate {
+	ru.mutation.ClearPdfObjectName()
+	return ru
+}
+
+// SetError sets the "error" field.
+func (ru *ReportUpdate) SetError(s string) *ReportUpdate {
+	ru.mutation.SetError(s)
+	return ru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableError(s *string) *ReportUpdate {
+	if s != nil {
+		ru.SetError(*s)
+	}
+	return ru
+}
+
+// ClearError clears the value of the "error" field.
+func (ru *ReportUpdate) ClearError() *ReportUpdate {
+	ru.mutation.ClearError()
+	return ru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ru *ReportUpdate) SetFinishedAt(i int64) *ReportUpdate {
+	ru.mutation.ResetFinishedAt()
+	ru.mutation.SetFinishedAt(i)
+	return ru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ru *ReportUpdate) SetNillableFinishedAt(i *int64) *ReportUpdate {
+	if i != nil {
+		ru.SetFinishedAt(*i)
+	}
+	return ru
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (ru *ReportUpdate) AddFinishedAt(i int64) *ReportUpdate {
+	ru.mutation.AddFinishedAt(i)
+	return ru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (ru *ReportUpdate) ClearFinishedAt() *ReportUpdate {
+	ru.mutation.ClearFinishedAt()
+	return ru
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ru *ReportUpdate) Mutation() *ReportMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReportUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReportUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReportUpdate) check() error {
+	if v, ok := ru.mutation.StartDate(); ok {
+		if err := report.StartDateValidator(v); err != nil {
+			return &ValidationError{Name: "start_date", err: fmt.Errorf(`ent: validator failed for field "Report.start_date": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.EndDate(); ok {
+		if err := report.EndDateValidator(v); err != nil {
+			return &ValidationError{Name: "end_date", err: fmt.Errorf(`ent: validator failed for field "Report.end_date": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.StatUnit(); ok {
+		if err := report.StatUnitValidator(v); err != nil {
+			return &ValidationError{Name: "stat_unit", err: fmt.Errorf(`ent: validator failed for field "Report.stat_unit": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Error(); ok {
+		if err := report.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "Report.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReportUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUint64))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.UserID(); ok {
+		_spec.SetField(report.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := ru.mutation.AddedTargetID(); ok {
+		_spec.AddField(report.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := ru.mutation.StartDate(); ok {
+		_spec.SetField(report.FieldStartDate, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.EndDate(); ok {
+		_spec.SetField(report.FieldEndDate, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.StatUnit(); ok {
+		_spec.SetField(report.FieldStatUnit, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.MediaStepIds(); ok {
+		_spec.SetField(report.FieldMediaStepIds, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedMediaStepIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, report.FieldMediaStepIds, value)
+		})
+	}
+	if ru.mutation.MediaStepIdsCleared() {
+		_spec.ClearField(report.FieldMediaStepIds, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.HTMLObjectName(); ok {
+		_spec.SetField(report.FieldHTMLObjectName, field.TypeString, value)
+	}
+	if ru.mutation.HTMLObjectNameCleared() {
+		_spec.ClearField(report.FieldHTMLObjectName, field.TypeString)
+	}
+	if value, ok := ru.mutation.PdfObjectName(); ok {
+		_spec.SetField(report.FieldPdfObjectName, field.TypeString, value)
+	}
+	if ru.mutation.PdfObjectNameCleared() {
+		_spec.ClearField(report.FieldPdfObjectName, field.TypeString)
+	}
+	if value, ok := ru.mutation.Error(); ok {
+		_spec.SetField(report.FieldError, field.TypeString, value)
+	}
+	if ru.mutation.ErrorCleared() {
+		_spec.ClearField(report.FieldError, field.TypeString)
+	}
+	if value, ok := ru.mutation.FinishedAt(); ok {
+		_spec.SetField(report.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(report.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if ru.mutation.FinishedAtCleared() {
+		_spec.ClearField(report.FieldFinishedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReportUpdateOne is the builder for updating a single Report entity.
+type ReportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (ruo *ReportUpdateOne) SetUserID(s string) *ReportUpdateOne {
+	ruo.mutation.SetUserID(s)
+	return ruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableUserID(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetUserID(*s)
+	}
+	return ruo
+}
+
+// SetTargetID sets the "target_id" field.
+func (ruo *ReportUpdateOne) SetTargetID(u uint64) *ReportUpdateOne {
+	ruo.mutation.ResetTargetID()
+	ruo.mutation.SetTargetID(u)
+	return ruo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableTargetID(u *uint64) *ReportUpdateOne {
+	if u != nil {
+		ruo.SetTargetID(*u)
+	}
+	return ruo
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (ruo *ReportUpdateOne) AddTargetID(u int64) *ReportUpdateOne {
+	ruo.mutation.AddTargetID(u)
+	return ruo
+}
+
+// SetStartDate sets the "start_date" field.
+func (ruo *ReportUpdateOne) SetStartDate(s string) *ReportUpdateOne {
+	ruo.mutation.SetStartDate(s)
+	return ruo
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStartDate(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetStartDate(*s)
+	}
+	return ruo
+}
+
+// SetEndDate sets the "end_date" field.
+func (ruo *ReportUpdateOne) SetEndDate(s string) *ReportUpdateOne {
+	ruo.mutation.SetEndDate(s)
+	return ruo
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableEndDate(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetEndDate(*s)
+	}
+	return ruo
+}
+
+// SetStatUnit sets the "stat_unit" field.
+func (ruo *ReportUpdateOne) SetStatUnit(ru report.StatUnit) *ReportUpdateOne {
+	ruo.mutation.SetStatUnit(ru)
+	return ruo
+}
+
+// SetNillableStatUnit sets the "stat_unit" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStatUnit(ru *report.StatUnit) *ReportUpdateOne {
+	if ru != nil {
+		ruo.SetStatUnit(*ru)
+	}
+	return ruo
+}
+
+// SetMediaStepIds sets the "media_step_ids" field.
+func (ruo *ReportUpdateOne) SetMediaStepIds(u []uint64) *ReportUpdateOne {
+	ruo.mutation.SetMediaStepIds(u)
+	return ruo
+}
+
+// AppendMediaStepIds appends u to the "media_step_ids" field.
+func (ruo *ReportUpdateOne) AppendMediaStepIds(u []uint64) *ReportUpdateOne {
+	ruo.mutation.AppendMediaStepIds(u)
+	return ruo
+}
+
+// ClearMediaStepIds clears the value of the "media_step_ids" field.
+func (ruo *ReportUpdateOne) ClearMediaStepIds() *ReportUpdateOne {
+	ruo.mutation.ClearMediaStepIds()
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReportUpdateOne) SetStatus(r report.Status) *ReportUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableStatus(r *report.Status) *ReportUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetHTMLObjectName sets the "html_object_name" field.
+func (ruo *ReportUpdateOne) SetHTMLObjectName(s string) *ReportUpdateOne {
+	ruo.mutation.SetHTMLObjectName(s)
+	return ruo
+}
+
+// SetNillableHTMLObjectName sets the "html_object_name" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableHTMLObjectName(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetHTMLObjectName(*s)
+	}
+	return ruo
+}
+
+// ClearHTMLObjectName clears the value of the "html_object_name" field.
+func (ruo *ReportUpdateOne) ClearHTMLObjectName() *ReportUpdateOne {
+	ruo.mutation.ClearHTMLObjectName()
+	return ruo
+}
+
+// SetPdfObjectName sets the "pdf_object_name" field.
+func (ruo *ReportUpdateOne) SetPdfObjectName(s string) *ReportUpdateOne {
+	ruo.mutation.SetPdfObjectName(s)
+	return ruo
+}
+
+// SetNillablePdfObjectName sets the "pdf_object_name" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillablePdfObjectName(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetPdfObjectName(*s)
+	}
+	return ruo
+}
+
+// ClearPdfObjectName clears the value of the "pdf_object_name" field.
+func (ruo *ReportUpdateOne) ClearPdfObjectName() *ReportUpdateOne {
+	ruo.mutation.ClearPdfObjectName()
+	return ruo
+}
+
+// SetError sets the "error" field.
+func (ruo *ReportUpdateOne) SetError(s string) *ReportUpdateOne {
+	ruo.mutation.SetError(s)
+	return ruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableError(s *string) *ReportUpdateOne {
+	if s != nil {
+		ruo.SetError(*s)
+	}
+	return ruo
+}
+
+// ClearError clears the value of the "error" field.
+func (ruo *ReportUpdateOne) ClearError() *ReportUpdateOne {
+	ruo.mutation.ClearError()
+	return ruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ruo *ReportUpdateOne) SetFinishedAt(i int64) *ReportUpdateOne {
+	ruo.mutation.ResetFinishedAt()
+	ruo.mutation.SetFinishedAt(i)
+	return ruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ruo *ReportUpdateOne) SetNillableFinishedAt(i *int64) *ReportUpdateOne {
+	if i != nil {
+		ruo.SetFinishedAt(*i)
+	}
+	return ruo
+}
+
+// AddFinishedAt adds i to the "finished_at" field.
+func (ruo *ReportUpdateOne) AddFinishedAt(i int64) *ReportUpdateOne {
+	ruo.mutation.AddFinishedAt(i)
+	return ruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (ruo *ReportUpdateOne) ClearFinishedAt() *ReportUpdateOne {
+	ruo.mutation.ClearFinishedAt()
+	return ruo
+}
+
+// Mutation returns the ReportMutation object of the builder.
+func (ruo *ReportUpdateOne) Mutation() *ReportMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReportUpdate builder.
+func (ruo *ReportUpdateOne) Where(ps ...predicate.Report) *ReportUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReportUpdateOne) Select(field string, fields ...string) *ReportUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Report entity.
+func (ruo *ReportUpdateOne) Save(ctx context.Context) (*Report, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReportUpdateOne) SaveX(ctx context.Context) *Report {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReportUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReportUpdateOne) check() error {
+	if v, ok := ruo.mutation.StartDate(); ok {
+		if err := report.StartDateValidator(v); err != nil {
+			return &ValidationError{Name: "start_date", err: fmt.Errorf(`ent: validator failed for field "Report.start_date": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.EndDate(); ok {
+		if err := report.EndDateValidator(v); err != nil {
+			return &ValidationError{Name: "end_date", err: fmt.Errorf(`ent: validator failed for field "Report.end_date": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.StatUnit(); ok {
+		if err := report.StatUnitValidator(v); err != nil {
+			return &ValidationError{Name: "stat_unit", err: fmt.Errorf(`ent: validator failed for field "Report.stat_unit": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := report.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Report.status": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Error(); ok {
+		if err := report.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "Report.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReportUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReportUpdateOne) sqlSave(ctx context.Context) (_node *Report, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(report.Table, report.Columns, sqlgraph.NewFieldSpec(report.FieldID, field.TypeUint64))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Report.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, report.FieldID)
+		for _, f := range fields {
+			if !report.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != report.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.UserID(); ok {
+		_spec.SetField(report.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.TargetID(); ok {
+		_spec.SetField(report.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := ruo.mutation.AddedTargetID(); ok {
+		_spec.AddField(report.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := ruo.mutation.StartDate(); ok {
+		_spec.SetField(report.FieldStartDate, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.EndDate(); ok {
+		_spec.SetField(report.FieldEndDate, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.StatUnit(); ok {
+		_spec.SetField(report.FieldStatUnit, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.MediaStepIds(); ok {
+		_spec.SetField(report.FieldMediaStepIds, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedMediaStepIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, report.FieldMediaStepIds, value)
+		})
+	}
+	if ruo.mutation.MediaStepIdsCleared() {
+		_spec.ClearField(report.FieldMediaStepIds, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(report.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.HTMLObjectName(); ok {
+		_spec.SetField(report.FieldHTMLObjectName, field.TypeString, value)
+	}
+	if ruo.mutation.HTMLObjectNameCleared() {
+		_spec.ClearField(report.FieldHTMLObjectName, field.TypeString)
+	}
+	if value, ok := ruo.mutation.PdfObjectName(); ok {
+		_spec.SetField(report.FieldPdfObjectName, field.TypeString, value)
+	}
+	if ruo.mutation.PdfObjectNameCleared() {
+		_spec.ClearField(report.FieldPdfObjectName, field.TypeString)
+	}
+	if value, ok := ruo.mutation.Error(); ok {
+		_spec.SetField(report.FieldError, field.TypeString, value)
+	}
+	if ruo.mutation.ErrorCleared() {
+		_spec.ClearField(report.FieldError, field.TypeString)
+	}
+	if value, ok := ruo.mutation.FinishedAt(); ok {
+		_spec.SetField(report.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedFinishedAt(); ok {
+		_spec.AddField(report.FieldFinishedAt, field.TypeInt64, value)
+	}
+	if ruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(report.FieldFinishedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Report{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{report.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"step/internal/data/ent/reviewer"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reviewer is the model entity for the Reviewer schema.
+type Reviewer struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// 邀请的学生User ID
+	StudentID string `json:"student_id,omitempty"`
+	// 接受邀请的老师或家长User ID, 未接受时为空
+	ReviewerID string `json:"reviewer_id,omitempty"`
+	// 评论角色
+	Role reviewer.Role `json:"role,omitempty"`
+	// 状态
+	Status reviewer.Status `json:"status,omitempty"`
+	// 邀请码, 接受后清空
+	InviteCode string `json:"invite_code,omitempty"`
+	// 邀请码过期时间
+	InviteExpiresAt int64 `json:"invite_expires_at,omitempty"`
+	// 创建时间
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// 接受邀请时间
+	AcceptedAt   int64 `json:"accepted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reviewer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reviewer.FieldID, reviewer.FieldInviteExpiresAt, reviewer.FieldCreatedAt, reviewer.FieldAcceptedAt:
+			values[i] = new(sql.NullInt64)
+		case reviewer.FieldStudentID, reviewer.FieldReviewerID, reviewer.FieldRole, reviewer.FieldStatus, reviewer.FieldInviteCode:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reviewer fields.
+func (r *Reviewer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reviewer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = uint64(value.Int64)
+		case reviewer.FieldStudentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				r.StudentID = value.String
+			}
+		case reviewer.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				r.ReviewerID = value.String
+			}
+		case reviewer.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				r.Role = reviewer.Role(value.String)
+			}
+		case reviewer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				r.Status = reviewer.Status(value.String)
+			}
+		case reviewer.FieldInviteCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_code", values[i])
+			} else if value.Valid {
+				r.InviteCode = value.String
+			}
+		case reviewer.FieldInviteExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_expires_at", values[i])
+			} else if value.Valid {
+				r.InviteExpiresAt = value.Int64
+			}
+		case reviewer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Int64
+			}
+		case reviewer.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				r.AcceptedAt = value.Int64
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reviewer.
+// This includes values selected through modifiers, order, etc.
+func (r *Reviewer) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Reviewer.
+// Note that you need to call Reviewer.Unwrap() before calling this method if this Reviewer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reviewer) Update() *ReviewerUpdateOne {
+	return NewReviewerClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reviewer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reviewer) Unwrap() *Reviewer {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reviewer is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reviewer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reviewer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(r.StudentID)
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_id=")
+	builder.WriteString(r.ReviewerID)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", r.Role))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", r.Status))
+	builder.WriteString(", ")
+	builder.WriteString("invite_code=")
+	builder.WriteString(r.InviteCode)
+	builder.WriteString(", ")
+	builder.WriteString("invite_expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.InviteExpiresAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("accepted_at=")
+	builder.WriteString(fmt.Sprintf("%v", r.AcceptedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reviewers is a parsable slice of Reviewer.
+type Reviewers []*Reviewer
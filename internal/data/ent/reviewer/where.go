@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewer
+
+import (
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldStudentID, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// InviteCode applies equality check predicate on the "invite_code" field. It's identical to InviteCodeEQ.
+func InviteCode(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldInviteCode, v))
+}
+
+// InviteExpiresAt applies equality check predicate on the "invite_expires_at" field. It's identical to InviteExpiresAtEQ.
+func InviteExpiresAt(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldInviteExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// StudentIDGT applies the GT predicate on the "student_id" field.
+func StudentIDGT(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldStudentID, v))
+}
+
+// StudentIDGTE applies the GTE predicate on the "student_id" field.
+func StudentIDGTE(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldStudentID, v))
+}
+
+// StudentIDLT applies the LT predicate on the "student_id" field.
+func StudentIDLT(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldStudentID, v))
+}
+
+// StudentIDLTE applies the LTE predicate on the "student_id" field.
+func StudentIDLTE(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldStudentID, v))
+}
+
+// StudentIDContains applies the Contains predicate on the "student_id" field.
+func StudentIDContains(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldContains(FieldStudentID, v))
+}
+
+// StudentIDHasPrefix applies the HasPrefix predicate on the "student_id" field.
+func StudentIDHasPrefix(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldHasPrefix(FieldStudentID, v))
+}
+
+// StudentIDHasSuffix applies the HasSuffix predicate on the "student_id" field.
+func StudentIDHasSuffix(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldHasSuffix(FieldStudentID, v))
+}
+
+// StudentIDEqualFold applies the EqualFold predicate on the "student_id" field.
+func StudentIDEqualFold(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEqualFold(FieldStudentID, v))
+}
+
+// StudentIDContainsFold applies the ContainsFold predicate on the "student_id" field.
+func StudentIDContainsFold(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldContainsFold(FieldStudentID, v))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDContains applies the Contains predicate on the "reviewer_id" field.
+func ReviewerIDContains(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldContains(FieldReviewerID, v))
+}
+
+// ReviewerIDHasPrefix applies the HasPrefix predicate on the "reviewer_id" field.
+func ReviewerIDHasPrefix(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldHasPrefix(FieldReviewerID, v))
+}
+
+// ReviewerIDHasSuffix applies the HasSuffix predicate on the "reviewer_id" field.
+func ReviewerIDHasSuffix(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldHasSuffix(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewerIDEqualFold applies the EqualFold predicate on the "reviewer_id" field.
+func ReviewerIDEqualFold(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEqualFold(FieldReviewerID, v))
+}
+
+// ReviewerIDContainsFold applies the ContainsFold predicate on the "reviewer_id" field.
+func ReviewerIDContainsFold(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldContainsFold(FieldReviewerID, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// InviteCodeEQ applies the EQ predicate on the "invite_code" field.
+func InviteCodeEQ(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldInviteCode, v))
+}
+
+// InviteCodeNEQ applies the NEQ predicate on the "invite_code" field.
+func InviteCodeNEQ(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldInviteCode, v))
+}
+
+// InviteCodeIn applies the In predicate on the "invite_code" field.
+func InviteCodeIn(vs ...string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldInviteCode, vs...))
+}
+
+// InviteCodeNotIn applies the NotIn predicate on the "invite_code" field.
+func InviteCodeNotIn(vs ...string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldInviteCode, vs...))
+}
+
+// InviteCodeGT applies the GT predicate on the "invite_code" field.
+func InviteCodeGT(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldInviteCode, v))
+}
+
+// InviteCodeGTE applies the GTE predicate on the "invite_code" field.
+func InviteCodeGTE(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldInviteCode, v))
+}
+
+// InviteCodeLT applies the LT predicate on the "invite_code" field.
+func InviteCodeLT(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldInviteCode, v))
+}
+
+// InviteCodeLTE applies the LTE predicate on the "invite_code" field.
+func InviteCodeLTE(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldInviteCode, v))
+}
+
+// InviteCodeContains applies the Contains predicate on the "invite_code" field.
+func InviteCodeContains(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldContains(FieldInviteCode, v))
+}
+
+// InviteCodeHasPrefix applies the HasPrefix predicate on the "invite_code" field.
+func InviteCodeHasPrefix(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldHasPrefix(FieldInviteCode, v))
+}
+
+// InviteCodeHasSuffix applies the HasSuffix predicate on the "invite_code" field.
+func InviteCodeHasSuffix(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldHasSuffix(FieldInviteCode, v))
+}
+
+// InviteCodeIsNil applies the IsNil predicate on the "invite_code" field.
+func InviteCodeIsNil() predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIsNull(FieldInviteCode))
+}
+
+// InviteCodeNotNil applies the NotNil predicate on the "invite_code" field.
+func InviteCodeNotNil() predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotNull(FieldInviteCode))
+}
+
+// InviteCodeEqualFold applies the EqualFold predicate on the "invite_code" field.
+func InviteCodeEqualFold(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEqualFold(FieldInviteCode, v))
+}
+
+// InviteCodeContainsFold applies the ContainsFold predicate on the "invite_code" field.
+func InviteCodeContainsFold(v string) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldContainsFold(FieldInviteCode, v))
+}
+
+// InviteExpiresAtEQ applies the EQ predicate on the "invite_expires_at" field.
+func InviteExpiresAtEQ(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldInviteExpiresAt, v))
+}
+
+// InviteExpiresAtNEQ applies the NEQ predicate on the "invite_expires_at" field.
+func InviteExpiresAtNEQ(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldInviteExpiresAt, v))
+}
+
+// InviteExpiresAtIn applies the In predicate on the "invite_expires_at" field.
+func InviteExpiresAtIn(vs ...int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldInviteExpiresAt, vs...))
+}
+
+// InviteExpiresAtNotIn applies the NotIn predicate on the "invite_expires_at" field.
+func InviteExpiresAtNotIn(vs ...int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldInviteExpiresAt, vs...))
+}
+
+// InviteExpiresAtGT applies the GT predicate on the "invite_expires_at" field.
+func InviteExpiresAtGT(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldInviteExpiresAt, v))
+}
+
+// InviteExpiresAtGTE applies the GTE predicate on the "invite_expires_at" field.
+func InviteExpiresAtGTE(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldInviteExpiresAt, v))
+}
+
+// InviteExpiresAtLT applies the LT predicate on the "invite_expires_at" field.
+func InviteExpiresAtLT(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldInviteExpiresAt, v))
+}
+
+// InviteExpiresAtLTE applies the LTE predicate on the "invite_expires_at" field.
+func InviteExpiresAtLTE(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldInviteExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v int64) predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.Reviewer {
+	return predicate.Reviewer(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reviewer) predicate.Reviewer {
+	return predicate.Reviewer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reviewer) predicate.Reviewer {
+	return predicate.Reviewer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reviewer) predicate.Reviewer {
+	return predicate.Reviewer(sql.NotPredicates(p))
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/reviewer"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReviewerCreate is the builder for creating a Reviewer entity.
+type ReviewerCreate struct {
+	config
+	mutation *ReviewerMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (rc *ReviewerCreate) SetStudentID(s string) *ReviewerCreate {
+	rc.mutation.SetStudentID(s)
+	return rc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (rc *ReviewerCreate) SetReviewerID(s string) *ReviewerCreate {
+	rc.mutation.SetReviewerID(s)
+	return rc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (rc *ReviewerCreate) SetNillableReviewerID(s *string) *ReviewerCreate {
+	if s != nil {
+		rc.SetReviewerID(*s)
+	}
+	return rc
+}
+
+// SetRole sets the "role" field.
+func (rc *ReviewerCreate) SetRole(r reviewer.Role) *ReviewerCreate {
+	rc.mutation.SetRole(r)
+	return rc
+}
+
+// SetStatus sets the "status" field.
+func (rc *ReviewerCreate) SetStatus(r reviewer.Status) *ReviewerCreate {
+	rc.mutation.SetStatus(r)
+	return rc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rc *ReviewerCreate) SetNillableStatus(r *reviewer.Status) *ReviewerCreate {
+	if r != nil {
+		rc.SetStatus(*r)
+	}
+	return rc
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (rc *ReviewerCreate) SetInviteCode(s string) *ReviewerCreate {
+	rc.mutation.SetInviteCode(s)
+	return rc
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (rc *ReviewerCreate) SetNillableInviteCode(s *string) *ReviewerCreate {
+	if s != nil {
+		rc.SetInviteCode(*s)
+	}
+	return rc
+}
+
+// SetInviteExpiresAt sets the "invite_expires_at" field.
+func (rc *ReviewerCreate) SetInviteExpiresAt(i int64) *ReviewerCreate {
+	rc.mutation.SetInviteExpiresAt(i)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReviewerCreate) SetCreatedAt(i int64) *ReviewerCreate {
+	rc.mutation.SetCreatedAt(i)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReviewerCreate) SetNillableCreatedAt(i *int64) *ReviewerCreate {
+	if i != nil {
+		rc.SetCreatedAt(*i)
+	}
+	return rc
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (rc *ReviewerCreate) SetAcceptedAt(i int64) *ReviewerCreate {
+	rc.mutation.SetAcceptedAt(i)
+	return rc
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (rc *ReviewerCreate) SetNillableAcceptedAt(i *int64) *ReviewerCreate {
+	if i != nil {
+		rc.SetAcceptedAt(*i)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReviewerCreate) SetID(u uint64) *ReviewerCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// Mutation returns the ReviewerMutation object of the builder.
+func (rc *ReviewerCreate) Mutation() *ReviewerMutation {
+	return rc.mutation
+}
+
+// Save creates the Reviewer in the database.
+func (rc *ReviewerCreate) Save(ctx context.Context) (*Reviewer, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReviewerCreate) SaveX(ctx context.Context) *Reviewer {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReviewerCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReviewerCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReviewerCreate) defaults() {
+	if _, ok := rc.mutation.Status(); !ok {
+		v := reviewer.DefaultStatus
+		rc.mutation.SetStatus(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reviewer.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReviewerCreate) check() error {
+	if _, ok := rc.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "Reviewer.student_id"`)}
+	}
+	if _, ok := rc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Reviewer.role"`)}
+	}
+	if v, ok := rc.mutation.Role(); ok {
+		if err := reviewer.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Reviewer.role": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Reviewer.status"`)}
+	}
+	if v, ok := rc.mutation.Status(); ok {
+		if err := reviewer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reviewer.status": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.InviteExpiresAt(); !ok {
+		return &ValidationError{Name: "invite_expires_at", err: errors.New(`ent: missing required field "Reviewer.invite_expires_at"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reviewer.created_at"`)}
+	}
+	return nil
+}
+
+func (rc *ReviewerCreate) sqlSave(ctx context.Context) (*Reviewer, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReviewerCreate) createSpec() (*Reviewer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reviewer{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reviewer.Table, sqlgraph.NewFieldSpec(reviewer.FieldID, field.TypeUint64))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.StudentID(); ok {
+		_spec.SetField(reviewer.FieldStudentID, field.TypeString, value)
+		_node.StudentID = value
+	}
+	if value, ok := rc.mutation.ReviewerID(); ok {
+		_spec.SetField(reviewer.FieldReviewerID, field.TypeString, value)
+		_node.ReviewerID = value
+	}
+	if value, ok := rc.mutation.Role(); ok {
+		_spec.SetField(reviewer.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := rc.mutation.Status(); ok {
+		_spec.SetField(reviewer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rc.mutation.InviteCode(); ok {
+		_spec.SetField(reviewer.FieldInviteCode, field.TypeString, value)
+		_node.InviteCode = value
+	}
+	if value, ok := rc.mutation.InviteExpiresAt(); ok {
+		_spec.SetField(reviewer.FieldInviteExpiresAt, field.TypeInt64, value)
+		_node.InviteExpiresAt = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reviewer.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.AcceptedAt(); ok {
+		_spec.SetField(reviewer.FieldAcceptedAt, field.TypeInt64, value)
+		_node.AcceptedAt = value
+	}
+	return _node, _spec
+}
+
+// ReviewerCreateBulk is the builder for creating many Reviewer entities in bulk.
+type ReviewerCreateBulk struct {
+	config
+	err      error
+	builders []*ReviewerCreate
+}
+
+// Save creates the Reviewer entities in the database.
+func (rcb *ReviewerCreateBulk) Save(ctx context.Context) ([]*Reviewer, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reviewer, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReviewerCreateBulk) SaveX(ctx context.Context) []*Reviewer {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReviewerCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReviewerCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
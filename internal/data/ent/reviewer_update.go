@@ -0,0 +1,559 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/reviewer"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReviewerUpdate is the builder for updating Reviewer entities.
+type ReviewerUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewerUpdate builder.
+func (ru *ReviewerUpdate) Where(ps ...predicate.Reviewer) *ReviewerUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetStudentID sets the "student_id" field.
+func (ru *ReviewerUpdate) SetStudentID(s string) *ReviewerUpdate {
+	ru.mutation.SetStudentID(s)
+	return ru
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableStudentID(s *string) *ReviewerUpdate {
+	if s != nil {
+		ru.SetStudentID(*s)
+	}
+	return ru
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ru *ReviewerUpdate) SetReviewerID(s string) *ReviewerUpdate {
+	ru.mutation.SetReviewerID(s)
+	return ru
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableReviewerID(s *string) *ReviewerUpdate {
+	if s != nil {
+		ru.SetReviewerID(*s)
+	}
+	return ru
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (ru *ReviewerUpdate) ClearReviewerID() *ReviewerUpdate {
+	ru.mutation.ClearReviewerID()
+	return ru
+}
+
+// SetRole sets the "role" field.
+func (ru *ReviewerUpdate) SetRole(r reviewer.Role) *ReviewerUpdate {
+	ru.mutation.SetRole(r)
+	return ru
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableRole(r *reviewer.Role) *ReviewerUpdate {
+	if r != nil {
+		ru.SetRole(*r)
+	}
+	return ru
+}
+
+// SetStatus sets the "status" field.
+func (ru *ReviewerUpdate) SetStatus(r reviewer.Status) *ReviewerUpdate {
+	ru.mutation.SetStatus(r)
+	return ru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableStatus(r *reviewer.Status) *ReviewerUpdate {
+	if r != nil {
+		ru.SetStatus(*r)
+	}
+	return ru
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (ru *ReviewerUpdate) SetInviteCode(s string) *ReviewerUpdate {
+	ru.mutation.SetInviteCode(s)
+	return ru
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableInviteCode(s *string) *ReviewerUpdate {
+	if s != nil {
+		ru.SetInviteCode(*s)
+	}
+	return ru
+}
+
+// ClearInviteCode clears the value of the "invite_code" field.
+func (ru *ReviewerUpdate) ClearInviteCode() *ReviewerUpdate {
+	ru.mutation.ClearInviteCode()
+	return ru
+}
+
+// SetInviteExpiresAt sets the "invite_expires_at" field.
+func (ru *ReviewerUpdate) SetInviteExpiresAt(i int64) *ReviewerUpdate {
+	ru.mutation.ResetInviteExpiresAt()
+	ru.mutation.SetInviteExpiresAt(i)
+	return ru
+}
+
+// SetNillableInviteExpiresAt sets the "invite_expires_at" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableInviteExpiresAt(i *int64) *ReviewerUpdate {
+	if i != nil {
+		ru.SetInviteExpiresAt(*i)
+	}
+	return ru
+}
+
+// AddInviteExpiresAt adds i to the "invite_expires_at" field.
+func (ru *ReviewerUpdate) AddInviteExpiresAt(i int64) *ReviewerUpdate {
+	ru.mutation.AddInviteExpiresAt(i)
+	return ru
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (ru *ReviewerUpdate) SetAcceptedAt(i int64) *ReviewerUpdate {
+	ru.mutation.ResetAcceptedAt()
+	ru.mutation.SetAcceptedAt(i)
+	return ru
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (ru *ReviewerUpdate) SetNillableAcceptedAt(i *int64) *ReviewerUpdate {
+	if i != nil {
+		ru.SetAcceptedAt(*i)
+	}
+	return ru
+}
+
+// AddAcceptedAt adds i to the "accepted_at" field.
+func (ru *ReviewerUpdate) AddAcceptedAt(i int64) *ReviewerUpdate {
+	ru.mutation.AddAcceptedAt(i)
+	return ru
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (ru *ReviewerUpdate) ClearAcceptedAt() *ReviewerUpdate {
+	ru.mutation.ClearAcceptedAt()
+	return ru
+}
+
+// Mutation returns the ReviewerMutation object of the builder.
+func (ru *ReviewerUpdate) Mutation() *ReviewerMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReviewerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReviewerUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReviewerUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReviewerUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReviewerUpdate) check() error {
+	if v, ok := ru.mutation.Role(); ok {
+		if err := reviewer.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Reviewer.role": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Status(); ok {
+		if err := reviewer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reviewer.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReviewerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewerUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReviewerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reviewer.Table, reviewer.Columns, sqlgraph.NewFieldSpec(reviewer.FieldID, field.TypeUint64))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.StudentID(); ok {
+		_spec.SetField(reviewer.FieldStudentID, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.ReviewerID(); ok {
+		_spec.SetField(reviewer.FieldReviewerID, field.TypeString, value)
+	}
+	if ru.mutation.ReviewerIDCleared() {
+		_spec.ClearField(reviewer.FieldReviewerID, field.TypeString)
+	}
+	if value, ok := ru.mutation.Role(); ok {
+		_spec.SetField(reviewer.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.Status(); ok {
+		_spec.SetField(reviewer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.InviteCode(); ok {
+		_spec.SetField(reviewer.FieldInviteCode, field.TypeString, value)
+	}
+	if ru.mutation.InviteCodeCleared() {
+		_spec.ClearField(reviewer.FieldInviteCode, field.TypeString)
+	}
+	if value, ok := ru.mutation.InviteExpiresAt(); ok {
+		_spec.SetField(reviewer.FieldInviteExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedInviteExpiresAt(); ok {
+		_spec.AddField(reviewer.FieldInviteExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AcceptedAt(); ok {
+		_spec.SetField(reviewer.FieldAcceptedAt, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedAcceptedAt(); ok {
+		_spec.AddField(reviewer.FieldAcceptedAt, field.TypeInt64, value)
+	}
+	if ru.mutation.AcceptedAtCleared() {
+		_spec.ClearField(reviewer.FieldAcceptedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReviewerUpdateOne is the builder for updating a single Reviewer entity.
+type ReviewerUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStudentID sets the "student_id" field.
+func (ruo *ReviewerUpdateOne) SetStudentID(s string) *ReviewerUpdateOne {
+	ruo.mutation.SetStudentID(s)
+	return ruo
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableStudentID(s *string) *ReviewerUpdateOne {
+	if s != nil {
+		ruo.SetStudentID(*s)
+	}
+	return ruo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ruo *ReviewerUpdateOne) SetReviewerID(s string) *ReviewerUpdateOne {
+	ruo.mutation.SetReviewerID(s)
+	return ruo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableReviewerID(s *string) *ReviewerUpdateOne {
+	if s != nil {
+		ruo.SetReviewerID(*s)
+	}
+	return ruo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (ruo *ReviewerUpdateOne) ClearReviewerID() *ReviewerUpdateOne {
+	ruo.mutation.ClearReviewerID()
+	return ruo
+}
+
+// SetRole sets the "role" field.
+func (ruo *ReviewerUpdateOne) SetRole(r reviewer.Role) *ReviewerUpdateOne {
+	ruo.mutation.SetRole(r)
+	return ruo
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableRole(r *reviewer.Role) *ReviewerUpdateOne {
+	if r != nil {
+		ruo.SetRole(*r)
+	}
+	return ruo
+}
+
+// SetStatus sets the "status" field.
+func (ruo *ReviewerUpdateOne) SetStatus(r reviewer.Status) *ReviewerUpdateOne {
+	ruo.mutation.SetStatus(r)
+	return ruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableStatus(r *reviewer.Status) *ReviewerUpdateOne {
+	if r != nil {
+		ruo.SetStatus(*r)
+	}
+	return ruo
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (ruo *ReviewerUpdateOne) SetInviteCode(s string) *ReviewerUpdateOne {
+	ruo.mutation.SetInviteCode(s)
+	return ruo
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableInviteCode(s *string) *ReviewerUpdateOne {
+	if s != nil {
+		ruo.SetInviteCode(*s)
+	}
+	return ruo
+}
+
+// ClearInviteCode clears the value of the "invite_code" field.
+func (ruo *ReviewerUpdateOne) ClearInviteCode() *ReviewerUpdateOne {
+	ruo.mutation.ClearInviteCode()
+	return ruo
+}
+
+// SetInviteExpiresAt sets the "invite_expires_at" field.
+func (ruo *ReviewerUpdateOne) SetInviteExpiresAt(i int64) *ReviewerUpdateOne {
+	ruo.mutation.ResetInviteExpiresAt()
+	ruo.mutation.SetInviteExpiresAt(i)
+	return ruo
+}
+
+// SetNillableInviteExpiresAt sets the "invite_expires_at" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableInviteExpiresAt(i *int64) *ReviewerUpdateOne {
+	if i != nil {
+		ruo.SetInviteExpiresAt(*i)
+	}
+	return ruo
+}
+
+// AddInviteExpiresAt adds i to the "invite_expires_at" field.
+func (ruo *ReviewerUpdateOne) AddInviteExpiresAt(i int64) *ReviewerUpdateOne {
+	ruo.mutation.AddInviteExpiresAt(i)
+	return ruo
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (ruo *ReviewerUpdateOne) SetAcceptedAt(i int64) *ReviewerUpdateOne {
+	ruo.mutation.ResetAcceptedAt()
+	ruo.mutation.SetAcceptedAt(i)
+	return ruo
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (ruo *ReviewerUpdateOne) SetNillableAcceptedAt(i *int64) *ReviewerUpdateOne {
+	if i != nil {
+		ruo.SetAcceptedAt(*i)
+	}
+	return ruo
+}
+
+// AddAcceptedAt adds i to the "accepted_at" field.
+func (ruo *ReviewerUpdateOne) AddAcceptedAt(i int64) *ReviewerUpdateOne {
+	ruo.mutation.AddAcceptedAt(i)
+	return ruo
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (ruo *ReviewerUpdateOne) ClearAcceptedAt() *ReviewerUpdateOne {
+	ruo.mutation.ClearAcceptedAt()
+	return ruo
+}
+
+// Mutation returns the ReviewerMutation object of the builder.
+func (ruo *ReviewerUpdateOne) Mutation() *ReviewerMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReviewerUpdate builder.
+func (ruo *ReviewerUpdateOne) Where(ps ...predicate.Reviewer) *ReviewerUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReviewerUpdateOne) Select(field string, fields ...string) *ReviewerUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reviewer entity.
+func (ruo *ReviewerUpdateOne) Save(ctx context.Context) (*Reviewer, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReviewerUpdateOne) SaveX(ctx context.Context) *Reviewer {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReviewerUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReviewerUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReviewerUpdateOne) check() error {
+	if v, ok := ruo.mutation.Role(); ok {
+		if err := reviewer.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Reviewer.role": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Status(); ok {
+		if err := reviewer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Reviewer.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReviewerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewerUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReviewerUpdateOne) sqlSave(ctx context.Context) (_node *Reviewer, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reviewer.Table, reviewer.Columns, sqlgraph.NewFieldSpec(reviewer.FieldID, field.TypeUint64))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reviewer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewer.FieldID)
+		for _, f := range fields {
+			if !reviewer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.StudentID(); ok {
+		_spec.SetField(reviewer.FieldStudentID, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.ReviewerID(); ok {
+		_spec.SetField(reviewer.FieldReviewerID, field.TypeString, value)
+	}
+	if ruo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(reviewer.FieldReviewerID, field.TypeString)
+	}
+	if value, ok := ruo.mutation.Role(); ok {
+		_spec.SetField(reviewer.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.Status(); ok {
+		_spec.SetField(reviewer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.InviteCode(); ok {
+		_spec.SetField(reviewer.FieldInviteCode, field.TypeString, value)
+	}
+	if ruo.mutation.InviteCodeCleared() {
+		_spec.ClearField(reviewer.FieldInviteCode, field.TypeString)
+	}
+	if value, ok := ruo.mutation.InviteExpiresAt(); ok {
+		_spec.SetField(reviewer.FieldInviteExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedInviteExpiresAt(); ok {
+		_spec.AddField(reviewer.FieldInviteExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AcceptedAt(); ok {
+		_spec.SetField(reviewer.FieldAcceptedAt, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedAcceptedAt(); ok {
+		_spec.AddField(reviewer.FieldAcceptedAt, field.TypeInt64, value)
+	}
+	if ruo.mutation.AcceptedAtCleared() {
+		_spec.ClearField(reviewer.FieldAcceptedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Reviewer{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"step/internal/data/ent/reviewrequest"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ReviewRequest is the model entity for the ReviewRequest schema.
+type ReviewRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// 请求评论的学生User ID
+	UserID string `json:"user_id,omitempty"`
+	// 积累ID, 可以是目录
+	StepID uint64 `json:"step_id,omitempty"`
+	// 通过分享链接评论的角色
+	Roles []string `json:"roles,omitempty"`
+	// 关联的老师或家长User ID
+	ReviewerIds []string `json:"reviewer_ids,omitempty"`
+	// 接收分享链接的邮箱
+	Emails []string `json:"emails,omitempty"`
+	// 附言
+	Message string `json:"message,omitempty"`
+	// 分享链接的share_to, 没有角色时为空
+	ShareTo string `json:"share_to,omitempty"`
+	// 状态
+	Status reviewrequest.Status `json:"status,omitempty"`
+	// 未评论时的提醒时间
+	RemindAt int64 `json:"remind_at,omitempty"`
+	// 已提醒时间
+	RemindedAt int64 `json:"reminded_at,omitempty"`
+	// 过期时间
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// 第一条回应的评论ID
+	CommentID uint64 `json:"comment_id,omitempty"`
+	// 评论时间
+	CommentedAt int64 `json:"commented_at,omitempty"`
+	// 创建时间
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReviewRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reviewrequest.FieldRoles, reviewrequest.FieldReviewerIds, reviewrequest.FieldEmails:
+			values[i] = new([]byte)
+		case reviewrequest.FieldID, reviewrequest.FieldStepID, reviewrequest.FieldRemindAt, reviewrequest.FieldRemindedAt, reviewrequest.FieldExpiresAt, reviewrequest.FieldCommentID, reviewrequest.FieldCommentedAt, reviewrequest.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case reviewrequest.FieldUserID, reviewrequest.FieldMessage, reviewrequest.FieldShareTo, reviewrequest.FieldStatus:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReviewRequest fields.
+func (rr *ReviewRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reviewrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rr.ID = uint64(value.Int64)
+		case reviewrequest.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rr.UserID = value.String
+			}
+		case reviewrequest.FieldStepID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step_id", values[i])
+			} else if value.Valid {
+				rr.StepID = uint64(value.Int64)
+			}
+		case reviewrequest.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		case reviewrequest.FieldReviewerIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.ReviewerIds); err != nil {
+					return fmt.Errorf("unmarshal field reviewer_ids: %w", err)
+				}
+			}
+		case reviewrequest.FieldEmails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field emails", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.Emails); err != nil {
+					return fmt.Errorf("unmarshal field emails: %w", err)
+				}
+			}
+		case reviewrequest.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				rr.Message = value.String
+			}
+		case reviewrequest.FieldShareTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_to", values[i])
+			} else if value.Valid {
+				rr.ShareTo = value.String
+			}
+		case reviewrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = reviewrequest.Status(value.String)
+			}
+		case reviewrequest.FieldRemindAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remind_at", values[i])
+			} else if value.Valid {
+				rr.RemindAt = value.Int64
+			}
+		case reviewrequest.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				rr.RemindedAt = value.Int64
+			}
+		case reviewrequest.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rr.ExpiresAt = value.Int64
+			}
+		case reviewrequest.FieldCommentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				rr.CommentID = uint64(value.Int64)
+			}
+		case reviewrequest.FieldCommentedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field commented_at", values[i])
+			} else if value.Valid {
+				rr.CommentedAt = value.Int64
+			}
+		case reviewrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Int64
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReviewRequest.
+// This includes values selected through modifiers, order, etc.
+func (rr *ReviewRequest) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReviewRequest.
+// Note that you need to call ReviewRequest.Unwrap() before calling this method if this ReviewRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *ReviewRequest) Update() *ReviewRequestUpdateOne {
+	return NewReviewRequestClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the ReviewRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *ReviewRequest) Unwrap() *ReviewRequest {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReviewRequest is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *ReviewRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReviewRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(rr.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("step_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.StepID))
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Roles))
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_ids=")
+	builder.WriteString(fmt.Sprintf("%v", rr.ReviewerIds))
+	builder.WriteString(", ")
+	builder.WriteString("emails=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Emails))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(rr.Message)
+	builder.WriteString(", ")
+	builder.WriteString("share_to=")
+	builder.WriteString(rr.ShareTo)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("remind_at=")
+	builder.WriteString(fmt.Sprintf("%v", rr.RemindAt))
+	builder.WriteString(", ")
+	builder.WriteString("reminded_at=")
+	builder.WriteString(fmt.Sprintf("%v", rr.RemindedAt))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", rr.ExpiresAt))
+	builder.WriteString(", ")
+	builder.WriteString("comment_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.CommentID))
+	builder.WriteString(", ")
+	builder.WriteString("commented_at=")
+	builder.WriteString(fmt.Sprintf("%v", rr.CommentedAt))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", rr.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReviewRequests is a parsable slice of ReviewRequest.
+type ReviewRequests []*ReviewRequest
@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reviewrequest
+
+import (
+	"step/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// StepID applies equality check predicate on the "step_id" field. It's identical to StepIDEQ.
+func StepID(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldStepID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldMessage, v))
+}
+
+// ShareTo applies equality check predicate on the "share_to" field. It's identical to ShareToEQ.
+func ShareTo(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldShareTo, v))
+}
+
+// RemindAt applies equality check predicate on the "remind_at" field. It's identical to RemindAtEQ.
+func RemindAt(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldRemindAt, v))
+}
+
+// RemindedAt applies equality check predicate on the "reminded_at" field. It's identical to RemindedAtEQ.
+func RemindedAt(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CommentID applies equality check predicate on the "comment_id" field. It's identical to CommentIDEQ.
+func CommentID(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentedAt applies equality check predicate on the "commented_at" field. It's identical to CommentedAtEQ.
+func CommentedAt(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldCommentedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// StepIDEQ applies the EQ predicate on the "step_id" field.
+func StepIDEQ(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldStepID, v))
+}
+
+// StepIDNEQ applies the NEQ predicate on the "step_id" field.
+func StepIDNEQ(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldStepID, v))
+}
+
+// StepIDIn applies the In predicate on the "step_id" field.
+func StepIDIn(vs ...uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldStepID, vs...))
+}
+
+// StepIDNotIn applies the NotIn predicate on the "step_id" field.
+func StepIDNotIn(vs ...uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldStepID, vs...))
+}
+
+// StepIDGT applies the GT predicate on the "step_id" field.
+func StepIDGT(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldStepID, v))
+}
+
+// StepIDGTE applies the GTE predicate on the "step_id" field.
+func StepIDGTE(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldStepID, v))
+}
+
+// StepIDLT applies the LT predicate on the "step_id" field.
+func StepIDLT(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldStepID, v))
+}
+
+// StepIDLTE applies the LTE predicate on the "step_id" field.
+func StepIDLTE(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldStepID, v))
+}
+
+// RolesIsNil applies the IsNil predicate on the "roles" field.
+func RolesIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldRoles))
+}
+
+// RolesNotNil applies the NotNil predicate on the "roles" field.
+func RolesNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldRoles))
+}
+
+// ReviewerIdsIsNil applies the IsNil predicate on the "reviewer_ids" field.
+func ReviewerIdsIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldReviewerIds))
+}
+
+// ReviewerIdsNotNil applies the NotNil predicate on the "reviewer_ids" field.
+func ReviewerIdsNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldReviewerIds))
+}
+
+// EmailsIsNil applies the IsNil predicate on the "emails" field.
+func EmailsIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldEmails))
+}
+
+// EmailsNotNil applies the NotNil predicate on the "emails" field.
+func EmailsNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldEmails))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ShareToEQ applies the EQ predicate on the "share_to" field.
+func ShareToEQ(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldShareTo, v))
+}
+
+// ShareToNEQ applies the NEQ predicate on the "share_to" field.
+func ShareToNEQ(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldShareTo, v))
+}
+
+// ShareToIn applies the In predicate on the "share_to" field.
+func ShareToIn(vs ...string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldShareTo, vs...))
+}
+
+// ShareToNotIn applies the NotIn predicate on the "share_to" field.
+func ShareToNotIn(vs ...string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldShareTo, vs...))
+}
+
+// ShareToGT applies the GT predicate on the "share_to" field.
+func ShareToGT(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldShareTo, v))
+}
+
+// ShareToGTE applies the GTE predicate on the "share_to" field.
+func ShareToGTE(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldShareTo, v))
+}
+
+// ShareToLT applies the LT predicate on the "share_to" field.
+func ShareToLT(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldShareTo, v))
+}
+
+// ShareToLTE applies the LTE predicate on the "share_to" field.
+func ShareToLTE(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldShareTo, v))
+}
+
+// ShareToContains applies the Contains predicate on the "share_to" field.
+func ShareToContains(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldContains(FieldShareTo, v))
+}
+
+// ShareToHasPrefix applies the HasPrefix predicate on the "share_to" field.
+func ShareToHasPrefix(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldHasPrefix(FieldShareTo, v))
+}
+
+// ShareToHasSuffix applies the HasSuffix predicate on the "share_to" field.
+func ShareToHasSuffix(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldHasSuffix(FieldShareTo, v))
+}
+
+// ShareToIsNil applies the IsNil predicate on the "share_to" field.
+func ShareToIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldShareTo))
+}
+
+// ShareToNotNil applies the NotNil predicate on the "share_to" field.
+func ShareToNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldShareTo))
+}
+
+// ShareToEqualFold applies the EqualFold predicate on the "share_to" field.
+func ShareToEqualFold(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEqualFold(FieldShareTo, v))
+}
+
+// ShareToContainsFold applies the ContainsFold predicate on the "share_to" field.
+func ShareToContainsFold(v string) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldContainsFold(FieldShareTo, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RemindAtEQ applies the EQ predicate on the "remind_at" field.
+func RemindAtEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldRemindAt, v))
+}
+
+// RemindAtNEQ applies the NEQ predicate on the "remind_at" field.
+func RemindAtNEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldRemindAt, v))
+}
+
+// RemindAtIn applies the In predicate on the "remind_at" field.
+func RemindAtIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldRemindAt, vs...))
+}
+
+// RemindAtNotIn applies the NotIn predicate on the "remind_at" field.
+func RemindAtNotIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldRemindAt, vs...))
+}
+
+// RemindAtGT applies the GT predicate on the "remind_at" field.
+func RemindAtGT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldRemindAt, v))
+}
+
+// RemindAtGTE applies the GTE predicate on the "remind_at" field.
+func RemindAtGTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldRemindAt, v))
+}
+
+// RemindAtLT applies the LT predicate on the "remind_at" field.
+func RemindAtLT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldRemindAt, v))
+}
+
+// RemindAtLTE applies the LTE predicate on the "remind_at" field.
+func RemindAtLTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldRemindAt, v))
+}
+
+// RemindedAtEQ applies the EQ predicate on the "reminded_at" field.
+func RemindedAtEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtNEQ applies the NEQ predicate on the "reminded_at" field.
+func RemindedAtNEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtIn applies the In predicate on the "reminded_at" field.
+func RemindedAtIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtNotIn applies the NotIn predicate on the "reminded_at" field.
+func RemindedAtNotIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtGT applies the GT predicate on the "reminded_at" field.
+func RemindedAtGT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldRemindedAt, v))
+}
+
+// RemindedAtGTE applies the GTE predicate on the "reminded_at" field.
+func RemindedAtGTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldRemindedAt, v))
+}
+
+// RemindedAtLT applies the LT predicate on the "reminded_at" field.
+func RemindedAtLT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldRemindedAt, v))
+}
+
+// RemindedAtLTE applies the LTE predicate on the "reminded_at" field.
+func RemindedAtLTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldRemindedAt, v))
+}
+
+// RemindedAtIsNil applies the IsNil predicate on the "reminded_at" field.
+func RemindedAtIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldRemindedAt))
+}
+
+// RemindedAtNotNil applies the NotNil predicate on the "reminded_at" field.
+func RemindedAtNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldRemindedAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CommentIDEQ applies the EQ predicate on the "comment_id" field.
+func CommentIDEQ(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentIDNEQ applies the NEQ predicate on the "comment_id" field.
+func CommentIDNEQ(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldCommentID, v))
+}
+
+// CommentIDIn applies the In predicate on the "comment_id" field.
+func CommentIDIn(vs ...uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldCommentID, vs...))
+}
+
+// CommentIDNotIn applies the NotIn predicate on the "comment_id" field.
+func CommentIDNotIn(vs ...uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldCommentID, vs...))
+}
+
+// CommentIDGT applies the GT predicate on the "comment_id" field.
+func CommentIDGT(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldCommentID, v))
+}
+
+// CommentIDGTE applies the GTE predicate on the "comment_id" field.
+func CommentIDGTE(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldCommentID, v))
+}
+
+// CommentIDLT applies the LT predicate on the "comment_id" field.
+func CommentIDLT(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldCommentID, v))
+}
+
+// CommentIDLTE applies the LTE predicate on the "comment_id" field.
+func CommentIDLTE(v uint64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldCommentID, v))
+}
+
+// CommentIDIsNil applies the IsNil predicate on the "comment_id" field.
+func CommentIDIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldCommentID))
+}
+
+// CommentIDNotNil applies the NotNil predicate on the "comment_id" field.
+func CommentIDNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldCommentID))
+}
+
+// CommentedAtEQ applies the EQ predicate on the "commented_at" field.
+func CommentedAtEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldCommentedAt, v))
+}
+
+// CommentedAtNEQ applies the NEQ predicate on the "commented_at" field.
+func CommentedAtNEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldCommentedAt, v))
+}
+
+// CommentedAtIn applies the In predicate on the "commented_at" field.
+func CommentedAtIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldCommentedAt, vs...))
+}
+
+// CommentedAtNotIn applies the NotIn predicate on the "commented_at" field.
+func CommentedAtNotIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldCommentedAt, vs...))
+}
+
+// CommentedAtGT applies the GT predicate on the "commented_at" field.
+func CommentedAtGT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldCommentedAt, v))
+}
+
+// CommentedAtGTE applies the GTE predicate on the "commented_at" field.
+func CommentedAtGTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldCommentedAt, v))
+}
+
+// CommentedAtLT applies the LT predicate on the "commented_at" field.
+func CommentedAtLT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldCommentedAt, v))
+}
+
+// CommentedAtLTE applies the LTE predicate on the "commented_at" field.
+func CommentedAtLTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldCommentedAt, v))
+}
+
+// CommentedAtIsNil applies the IsNil predicate on the "commented_at" field.
+func CommentedAtIsNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIsNull(FieldCommentedAt))
+}
+
+// CommentedAtNotNil applies the NotNil predicate on the "commented_at" field.
+func CommentedAtNotNil() predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotNull(FieldCommentedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v int64) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReviewRequest) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReviewRequest) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReviewRequest) predicate.ReviewRequest {
+	return predicate.ReviewRequest(sql.NotPredicates(p))
+}
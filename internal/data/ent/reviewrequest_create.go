@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/reviewrequest"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReviewRequestCreate is the builder for creating a ReviewRequest entity.
+type ReviewRequestCreate struct {
+	config
+	mutation *ReviewRequestMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (rrc *ReviewRequestCreate) SetUserID(s string) *ReviewRequestCreate {
+	rrc.mutation.SetUserID(s)
+	return rrc
+}
+
+// SetStepID sets the "step_id" field.
+func (rrc *ReviewRequestCreate) SetStepID(u uint64) *ReviewRequestCreate {
+	rrc.mutation.SetStepID(u)
+	return rrc
+}
+
+// SetRoles sets the "roles" field.
+func (rrc *ReviewRequestCreate) SetRoles(s []string) *ReviewRequestCreate {
+	rrc.mutation.SetRoles(s)
+	return rrc
+}
+
+// SetReviewerIds sets the "reviewer_ids" field.
+func (rrc *ReviewRequestCreate) SetReviewerIds(s []string) *ReviewRequestCreate {
+	rrc.mutation.SetReviewerIds(s)
+	return rrc
+}
+
+// SetEmails sets the "emails" field.
+func (rrc *ReviewRequestCreate) SetEmails(s []string) *ReviewRequestCreate {
+	rrc.mutation.SetEmails(s)
+	return rrc
+}
+
+// SetMessage sets the "message" field.
+func (rrc *ReviewRequestCreate) SetMessage(s string) *ReviewRequestCreate {
+	rrc.mutation.SetMessage(s)
+	return rrc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableMessage(s *string) *ReviewRequestCreate {
+	if s != nil {
+		rrc.SetMessage(*s)
+	}
+	return rrc
+}
+
+// SetShareTo sets the "share_to" field.
+func (rrc *ReviewRequestCreate) SetShareTo(s string) *ReviewRequestCreate {
+	rrc.mutation.SetShareTo(s)
+	return rrc
+}
+
+// SetNillableShareTo sets the "share_to" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableShareTo(s *string) *ReviewRequestCreate {
+	if s != nil {
+		rrc.SetShareTo(*s)
+	}
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *ReviewRequestCreate) SetStatus(r reviewrequest.Status) *ReviewRequestCreate {
+	rrc.mutation.SetStatus(r)
+	return rrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableStatus(r *reviewrequest.Status) *ReviewRequestCreate {
+	if r != nil {
+		rrc.SetStatus(*r)
+	}
+	return rrc
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (rrc *ReviewRequestCreate) SetRemindAt(i int64) *ReviewRequestCreate {
+	rrc.mutation.SetRemindAt(i)
+	return rrc
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (rrc *ReviewRequestCreate) SetRemindedAt(i int64) *ReviewRequestCreate {
+	rrc.mutation.SetRemindedAt(i)
+	return rrc
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableRemindedAt(i *int64) *ReviewRequestCreate {
+	if i != nil {
+		rrc.SetRemindedAt(*i)
+	}
+	return rrc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rrc *ReviewRequestCreate) SetExpiresAt(i int64) *ReviewRequestCreate {
+	rrc.mutation.SetExpiresAt(i)
+	return rrc
+}
+
+// SetCommentID sets the "comment_id" field.
+func (rrc *ReviewRequestCreate) SetCommentID(u uint64) *ReviewRequestCreate {
+	rrc.mutation.SetCommentID(u)
+	return rrc
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableCommentID(u *uint64) *ReviewRequestCreate {
+	if u != nil {
+		rrc.SetCommentID(*u)
+	}
+	return rrc
+}
+
+// SetCommentedAt sets the "commented_at" field.
+func (rrc *ReviewRequestCreate) SetCommentedAt(i int64) *ReviewRequestCreate {
+	rrc.mutation.SetCommentedAt(i)
+	return rrc
+}
+
+// SetNillableCommentedAt sets the "commented_at" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableCommentedAt(i *int64) *ReviewRequestCreate {
+	if i != nil {
+		rrc.SetCommentedAt(*i)
+	}
+	return rrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *ReviewRequestCreate) SetCreatedAt(i int64) *ReviewRequestCreate {
+	rrc.mutation.SetCreatedAt(i)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *ReviewRequestCreate) SetNillableCreatedAt(i *int64) *ReviewRequestCreate {
+	if i != nil {
+		rrc.SetCreatedAt(*i)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *ReviewRequestCreate) SetID(u uint64) *ReviewRequestCreate {
+	rrc.mutation.SetID(u)
+	return rrc
+}
+
+// Mutation returns the ReviewRequestMutation object of the builder.
+func (rrc *ReviewRequestCreate) Mutation() *ReviewRequestMutation {
+	return rrc.mutation
+}
+
+// Save creates the ReviewRequest in the database.
+func (rrc *ReviewRequestCreate) Save(ctx context.Context) (*ReviewRequest, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *ReviewRequestCreate) SaveX(ctx context.Context) *ReviewRequest {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *ReviewRequestCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *ReviewRequestCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *ReviewRequestCreate) defaults() {
+	if _, ok := rrc.mutation.Status(); !ok {
+		v := reviewrequest.DefaultStatus
+		rrc.mutation.SetStatus(v)
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := reviewrequest.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *ReviewRequestCreate) check() error {
+	if _, ok := rrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ReviewRequest.user_id"`)}
+	}
+	if _, ok := rrc.mutation.StepID(); !ok {
+		return &ValidationError{Name: "step_id", err: errors.New(`ent: missing required field "ReviewRequest.step_id"`)}
+	}
+	if v, ok := rrc.mutation.Message(); ok {
+		if err := reviewrequest.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReviewRequest.message": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReviewRequest.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := reviewrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReviewRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.RemindAt(); !ok {
+		return &ValidationError{Name: "remind_at", err: errors.New(`ent: missing required field "ReviewRequest.remind_at"`)}
+	}
+	if _, ok := rrc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ReviewRequest.expires_at"`)}
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReviewRequest.created_at"`)}
+	}
+	return nil
+}
+
+func (rrc *ReviewRequestCreate) sqlSave(ctx context.Context) (*ReviewRequest, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *ReviewRequestCreate) createSpec() (*ReviewRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReviewRequest{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(reviewrequest.Table, sqlgraph.NewFieldSpec(reviewrequest.FieldID, field.TypeUint64))
+	)
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rrc.mutation.UserID(); ok {
+		_spec.SetField(reviewrequest.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rrc.mutation.StepID(); ok {
+		_spec.SetField(reviewrequest.FieldStepID, field.TypeUint64, value)
+		_node.StepID = value
+	}
+	if value, ok := rrc.mutation.Roles(); ok {
+		_spec.SetField(reviewrequest.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := rrc.mutation.ReviewerIds(); ok {
+		_spec.SetField(reviewrequest.FieldReviewerIds, field.TypeJSON, value)
+		_node.ReviewerIds = value
+	}
+	if value, ok := rrc.mutation.Emails(); ok {
+		_spec.SetField(reviewrequest.FieldEmails, field.TypeJSON, value)
+		_node.Emails = value
+	}
+	if value, ok := rrc.mutation.Message(); ok {
+		_spec.SetField(reviewrequest.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := rrc.mutation.ShareTo(); ok {
+		_spec.SetField(reviewrequest.FieldShareTo, field.TypeString, value)
+		_node.ShareTo = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(reviewrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.RemindAt(); ok {
+		_spec.SetField(reviewrequest.FieldRemindAt, field.TypeInt64, value)
+		_node.RemindAt = value
+	}
+	if value, ok := rrc.mutation.RemindedAt(); ok {
+		_spec.SetField(reviewrequest.FieldRemindedAt, field.TypeInt64, value)
+		_node.RemindedAt = value
+	}
+	if value, ok := rrc.mutation.ExpiresAt(); ok {
+		_spec.SetField(reviewrequest.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := rrc.mutation.CommentID(); ok {
+		_spec.SetField(reviewrequest.FieldCommentID, field.TypeUint64, value)
+		_node.CommentID = value
+	}
+	if value, ok := rrc.mutation.CommentedAt(); ok {
+		_spec.SetField(reviewrequest.FieldCommentedAt, field.TypeInt64, value)
+		_node.CommentedAt = value
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(reviewrequest.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ReviewRequestCreateBulk is the builder for creating many ReviewRequest entities in bulk.
+type ReviewRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ReviewRequestCreate
+}
+
+// Save creates the ReviewRequest entities in the database.
+func (rrcb *ReviewRequestCreateBulk) Save(ctx context.Context) ([]*ReviewRequest, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*ReviewRequest, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReviewRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *ReviewRequestCreateBulk) SaveX(ctx context.Context) []*ReviewRequest {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *ReviewRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *ReviewRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
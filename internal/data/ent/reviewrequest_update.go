@@ -0,0 +1,952 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/reviewrequest"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ReviewRequestUpdate is the builder for updating ReviewRequest entities.
+type ReviewRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReviewRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReviewRequestUpdate builder.
+func (rru *ReviewRequestUpdate) Where(ps ...predicate.ReviewRequest) *ReviewRequestUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUserID sets the "user_id" field.
+func (rru *ReviewRequestUpdate) SetUserID(s string) *ReviewRequestUpdate {
+	rru.mutation.SetUserID(s)
+	return rru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableUserID(s *string) *ReviewRequestUpdate {
+	if s != nil {
+		rru.SetUserID(*s)
+	}
+	return rru
+}
+
+// SetStepID sets the "step_id" field.
+func (rru *ReviewRequestUpdate) SetStepID(u uint64) *ReviewRequestUpdate {
+	rru.mutation.ResetStepID()
+	rru.mutation.SetStepID(u)
+	return rru
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableStepID(u *uint64) *ReviewRequestUpdate {
+	if u != nil {
+		rru.SetStepID(*u)
+	}
+	return rru
+}
+
+// AddStepID adds u to the "step_id" field.
+func (rru *ReviewRequestUpdate) AddStepID(u int64) *ReviewRequestUpdate {
+	rru.mutation.AddStepID(u)
+	return rru
+}
+
+// SetRoles sets the "roles" field.
+func (rru *ReviewRequestUpdate) SetRoles(s []string) *ReviewRequestUpdate {
+	rru.mutation.SetRoles(s)
+	return rru
+}
+
+// AppendRoles appends s to the "roles" field.
+func (rru *ReviewRequestUpdate) AppendRoles(s []string) *ReviewRequestUpdate {
+	rru.mutation.AppendRoles(s)
+	return rru
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (rru *ReviewRequestUpdate) ClearRoles() *ReviewRequestUpdate {
+	rru.mutation.ClearRoles()
+	return rru
+}
+
+// SetReviewerIds sets the "reviewer_ids" field.
+func (rru *ReviewRequestUpdate) SetReviewerIds(s []string) *ReviewRequestUpdate {
+	rru.mutation.SetReviewerIds(s)
+	return rru
+}
+
+// AppendReviewerIds appends s to the "reviewer_ids" field.
+func (rru *ReviewRequestUpdate) AppendReviewerIds(s []string) *ReviewRequestUpdate {
+	rru.mutation.AppendReviewerIds(s)
+	return rru
+}
+
+// ClearReviewerIds clears the value of the "reviewer_ids" field.
+func (rru *ReviewRequestUpdate) ClearReviewerIds() *ReviewRequestUpdate {
+	rru.mutation.ClearReviewerIds()
+	return rru
+}
+
+// SetEmails sets the "emails" field.
+func (rru *ReviewRequestUpdate) SetEmails(s []string) *ReviewRequestUpdate {
+	rru.mutation.SetEmails(s)
+	return rru
+}
+
+// AppendEmails appends s to the "emails" field.
+func (rru *ReviewRequestUpdate) AppendEmails(s []string) *ReviewRequestUpdate {
+	rru.mutation.AppendEmails(s)
+	return rru
+}
+
+// ClearEmails clears the value of the "emails" field.
+func (rru *ReviewRequestUpdate) ClearEmails() *ReviewRequestUpdate {
+	rru.mutation.ClearEmails()
+	return rru
+}
+
+// SetMessage sets the "message" field.
+func (rru *ReviewRequestUpdate) SetMessage(s string) *ReviewRequestUpdate {
+	rru.mutation.SetMessage(s)
+	return rru
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableMessage(s *string) *ReviewRequestUpdate {
+	if s != nil {
+		rru.SetMessage(*s)
+	}
+	return rru
+}
+
+// ClearMessage clears the value of the "message" field.
+func (rru *ReviewRequestUpdate) ClearMessage() *ReviewRequestUpdate {
+	rru.mutation.ClearMessage()
+	return rru
+}
+
+// SetShareTo sets the "share_to" field.
+func (rru *ReviewRequestUpdate) SetShareTo(s string) *ReviewRequestUpdate {
+	rru.mutation.SetShareTo(s)
+	return rru
+}
+
+// SetNillableShareTo sets the "share_to" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableShareTo(s *string) *ReviewRequestUpdate {
+	if s != nil {
+		rru.SetShareTo(*s)
+	}
+	return rru
+}
+
+// ClearShareTo clears the value of the "share_to" field.
+func (rru *ReviewRequestUpdate) ClearShareTo() *ReviewRequestUpdate {
+	rru.mutation.ClearShareTo()
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *ReviewRequestUpdate) SetStatus(r reviewrequest.Status) *ReviewRequestUpdate {
+	rru.mutation.SetStatus(r)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableStatus(r *reviewrequest.Status) *ReviewRequestUpdate {
+	if r != nil {
+		rru.SetStatus(*r)
+	}
+	return rru
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (rru *ReviewRequestUpdate) SetRemindAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.ResetRemindAt()
+	rru.mutation.SetRemindAt(i)
+	return rru
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableRemindAt(i *int64) *ReviewRequestUpdate {
+	if i != nil {
+		rru.SetRemindAt(*i)
+	}
+	return rru
+}
+
+// AddRemindAt adds i to the "remind_at" field.
+func (rru *ReviewRequestUpdate) AddRemindAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.AddRemindAt(i)
+	return rru
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (rru *ReviewRequestUpdate) SetRemindedAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.ResetRemindedAt()
+	rru.mutation.SetRemindedAt(i)
+	return rru
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableRemindedAt(i *int64) *ReviewRequestUpdate {
+	if i != nil {
+		rru.SetRemindedAt(*i)
+	}
+	return rru
+}
+
+// AddRemindedAt adds i to the "reminded_at" field.
+func (rru *ReviewRequestUpdate) AddRemindedAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.AddRemindedAt(i)
+	return rru
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (rru *ReviewRequestUpdate) ClearRemindedAt() *ReviewRequestUpdate {
+	rru.mutation.ClearRemindedAt()
+	return rru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rru *ReviewRequestUpdate) SetExpiresAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.ResetExpiresAt()
+	rru.mutation.SetExpiresAt(i)
+	return rru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableExpiresAt(i *int64) *ReviewRequestUpdate {
+	if i != nil {
+		rru.SetExpiresAt(*i)
+	}
+	return rru
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (rru *ReviewRequestUpdate) AddExpiresAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.AddExpiresAt(i)
+	return rru
+}
+
+// SetCommentID sets the "comment_id" field.
+func (rru *ReviewRequestUpdate) SetCommentID(u uint64) *ReviewRequestUpdate {
+	rru.mutation.ResetCommentID()
+	rru.mutation.SetCommentID(u)
+	return rru
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableCommentID(u *uint64) *ReviewRequestUpdate {
+	if u != nil {
+		rru.SetCommentID(*u)
+	}
+	return rru
+}
+
+// AddCommentID adds u to the "comment_id" field.
+func (rru *ReviewRequestUpdate) AddCommentID(u int64) *ReviewRequestUpdate {
+	rru.mutation.AddCommentID(u)
+	return rru
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (rru *ReviewRequestUpdate) ClearCommentID() *ReviewRequestUpdate {
+	rru.mutation.ClearCommentID()
+	return rru
+}
+
+// SetCommentedAt sets the "commented_at" field.
+func (rru *ReviewRequestUpdate) SetCommentedAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.ResetCommentedAt()
+	rru.mutation.SetCommentedAt(i)
+	return rru
+}
+
+// SetNillableCommentedAt sets the "commented_at" field if the given value is not nil.
+func (rru *ReviewRequestUpdate) SetNillableCommentedAt(i *int64) *ReviewRequestUpdate {
+	if i != nil {
+		rru.SetCommentedAt(*i)
+	}
+	return rru
+}
+
+// AddCommentedAt adds i to the "commented_at" field.
+func (rru *ReviewRequestUpdate) AddCommentedAt(i int64) *ReviewRequestUpdate {
+	rru.mutation.AddCommentedAt(i)
+	return rru
+}
+
+// ClearCommentedAt clears the value of the "commented_at" field.
+func (rru *ReviewRequestUpdate) ClearCommentedAt() *ReviewRequestUpdate {
+	rru.mutation.ClearCommentedAt()
+	return rru
+}
+
+// Mutation returns the ReviewRequestMutation object of the builder.
+func (rru *ReviewRequestUpdate) Mutation() *ReviewRequestMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *ReviewRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *ReviewRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *ReviewRequestUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *ReviewRequestUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *ReviewRequestUpdate) check() error {
+	if v, ok := rru.mutation.Message(); ok {
+		if err := reviewrequest.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReviewRequest.message": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Status(); ok {
+		if err := reviewrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReviewRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rru *ReviewRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewRequestUpdate {
+	rru.modifiers = append(rru.modifiers, modifiers...)
+	return rru
+}
+
+func (rru *ReviewRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reviewrequest.Table, reviewrequest.Columns, sqlgraph.NewFieldSpec(reviewrequest.FieldID, field.TypeUint64))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UserID(); ok {
+		_spec.SetField(reviewrequest.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.StepID(); ok {
+		_spec.SetField(reviewrequest.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := rru.mutation.AddedStepID(); ok {
+		_spec.AddField(reviewrequest.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := rru.mutation.Roles(); ok {
+		_spec.SetField(reviewrequest.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reviewrequest.FieldRoles, value)
+		})
+	}
+	if rru.mutation.RolesCleared() {
+		_spec.ClearField(reviewrequest.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := rru.mutation.ReviewerIds(); ok {
+		_spec.SetField(reviewrequest.FieldReviewerIds, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.AppendedReviewerIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reviewrequest.FieldReviewerIds, value)
+		})
+	}
+	if rru.mutation.ReviewerIdsCleared() {
+		_spec.ClearField(reviewrequest.FieldReviewerIds, field.TypeJSON)
+	}
+	if value, ok := rru.mutation.Emails(); ok {
+		_spec.SetField(reviewrequest.FieldEmails, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.AppendedEmails(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reviewrequest.FieldEmails, value)
+		})
+	}
+	if rru.mutation.EmailsCleared() {
+		_spec.ClearField(reviewrequest.FieldEmails, field.TypeJSON)
+	}
+	if value, ok := rru.mutation.Message(); ok {
+		_spec.SetField(reviewrequest.FieldMessage, field.TypeString, value)
+	}
+	if rru.mutation.MessageCleared() {
+		_spec.ClearField(reviewrequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := rru.mutation.ShareTo(); ok {
+		_spec.SetField(reviewrequest.FieldShareTo, field.TypeString, value)
+	}
+	if rru.mutation.ShareToCleared() {
+		_spec.ClearField(reviewrequest.FieldShareTo, field.TypeString)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(reviewrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.RemindAt(); ok {
+		_spec.SetField(reviewrequest.FieldRemindAt, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedRemindAt(); ok {
+		_spec.AddField(reviewrequest.FieldRemindAt, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.RemindedAt(); ok {
+		_spec.SetField(reviewrequest.FieldRemindedAt, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedRemindedAt(); ok {
+		_spec.AddField(reviewrequest.FieldRemindedAt, field.TypeInt64, value)
+	}
+	if rru.mutation.RemindedAtCleared() {
+		_spec.ClearField(reviewrequest.FieldRemindedAt, field.TypeInt64)
+	}
+	if value, ok := rru.mutation.ExpiresAt(); ok {
+		_spec.SetField(reviewrequest.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(reviewrequest.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.CommentID(); ok {
+		_spec.SetField(reviewrequest.FieldCommentID, field.TypeUint64, value)
+	}
+	if value, ok := rru.mutation.AddedCommentID(); ok {
+		_spec.AddField(reviewrequest.FieldCommentID, field.TypeUint64, value)
+	}
+	if rru.mutation.CommentIDCleared() {
+		_spec.ClearField(reviewrequest.FieldCommentID, field.TypeUint64)
+	}
+	if value, ok := rru.mutation.CommentedAt(); ok {
+		_spec.SetField(reviewrequest.FieldCommentedAt, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedCommentedAt(); ok {
+		_spec.AddField(reviewrequest.FieldCommentedAt, field.TypeInt64, value)
+	}
+	if rru.mutation.CommentedAtCleared() {
+		_spec.ClearField(reviewrequest.FieldCommentedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(rru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// ReviewRequestUpdateOne is the builder for updating a single ReviewRequest entity.
+type ReviewRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReviewRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (rruo *ReviewRequestUpdateOne) SetUserID(s string) *ReviewRequestUpdateOne {
+	rruo.mutation.SetUserID(s)
+	return rruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableUserID(s *string) *ReviewRequestUpdateOne {
+	if s != nil {
+		rruo.SetUserID(*s)
+	}
+	return rruo
+}
+
+// SetStepID sets the "step_id" field.
+func (rruo *ReviewRequestUpdateOne) SetStepID(u uint64) *ReviewRequestUpdateOne {
+	rruo.mutation.ResetStepID()
+	rruo.mutation.SetStepID(u)
+	return rruo
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableStepID(u *uint64) *ReviewRequestUpdateOne {
+	if u != nil {
+		rruo.SetStepID(*u)
+	}
+	return rruo
+}
+
+// AddStepID adds u to the "step_id" field.
+func (rruo *ReviewRequestUpdateOne) AddStepID(u int64) *ReviewRequestUpdateOne {
+	rruo.mutation.AddStepID(u)
+	return rruo
+}
+
+// SetRoles sets the "roles" field.
+func (rruo *ReviewRequestUpdateOne) SetRoles(s []string) *ReviewRequestUpdateOne {
+	rruo.mutation.SetRoles(s)
+	return rruo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (rruo *ReviewRequestUpdateOne) AppendRoles(s []string) *ReviewRequestUpdateOne {
+	rruo.mutation.AppendRoles(s)
+	return rruo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (rruo *ReviewRequestUpdateOne) ClearRoles() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearRoles()
+	return rruo
+}
+
+// SetReviewerIds sets the "reviewer_ids" field.
+func (rruo *ReviewRequestUpdateOne) SetReviewerIds(s []string) *ReviewRequestUpdateOne {
+	rruo.mutation.SetReviewerIds(s)
+	return rruo
+}
+
+// AppendReviewerIds appends s to the "reviewer_ids" field.
+func (rruo *ReviewRequestUpdateOne) AppendReviewerIds(s []string) *ReviewRequestUpdateOne {
+	rruo.mutation.AppendReviewerIds(s)
+	return rruo
+}
+
+// ClearReviewerIds clears the value of the "reviewer_ids" field.
+func (rruo *ReviewRequestUpdateOne) ClearReviewerIds() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearReviewerIds()
+	return rruo
+}
+
+// SetEmails sets the "emails" field.
+func (rruo *ReviewRequestUpdateOne) SetEmails(s []string) *ReviewRequestUpdateOne {
+	rruo.mutation.SetEmails(s)
+	return rruo
+}
+
+// AppendEmails appends s to the "emails" field.
+func (rruo *ReviewRequestUpdateOne) AppendEmails(s []string) *ReviewRequestUpdateOne {
+	rruo.mutation.AppendEmails(s)
+	return rruo
+}
+
+// ClearEmails clears the value of the "emails" field.
+func (rruo *ReviewRequestUpdateOne) ClearEmails() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearEmails()
+	return rruo
+}
+
+// SetMessage sets the "message" field.
+func (rruo *ReviewRequestUpdateOne) SetMessage(s string) *ReviewRequestUpdateOne {
+	rruo.mutation.SetMessage(s)
+	return rruo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableMessage(s *string) *ReviewRequestUpdateOne {
+	if s != nil {
+		rruo.SetMessage(*s)
+	}
+	return rruo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (rruo *ReviewRequestUpdateOne) ClearMessage() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearMessage()
+	return rruo
+}
+
+// SetShareTo sets the "share_to" field.
+func (rruo *ReviewRequestUpdateOne) SetShareTo(s string) *ReviewRequestUpdateOne {
+	rruo.mutation.SetShareTo(s)
+	return rruo
+}
+
+// SetNillableShareTo sets the "share_to" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableShareTo(s *string) *ReviewRequestUpdateOne {
+	if s != nil {
+		rruo.SetShareTo(*s)
+	}
+	return rruo
+}
+
+// ClearShareTo clears the value of the "share_to" field.
+func (rruo *ReviewRequestUpdateOne) ClearShareTo() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearShareTo()
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *ReviewRequestUpdateOne) SetStatus(r reviewrequest.Status) *ReviewRequestUpdateOne {
+	rruo.mutation.SetStatus(r)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableStatus(r *reviewrequest.Status) *ReviewRequestUpdateOne {
+	if r != nil {
+		rruo.SetStatus(*r)
+	}
+	return rruo
+}
+
+// SetRemindAt sets the "remind_at" field.
+func (rruo *ReviewRequestUpdateOne) SetRemindAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.ResetRemindAt()
+	rruo.mutation.SetRemindAt(i)
+	return rruo
+}
+
+// SetNillableRemindAt sets the "remind_at" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableRemindAt(i *int64) *ReviewRequestUpdateOne {
+	if i != nil {
+		rruo.SetRemindAt(*i)
+	}
+	return rruo
+}
+
+// AddRemindAt adds i to the "remind_at" field.
+func (rruo *ReviewRequestUpdateOne) AddRemindAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.AddRemindAt(i)
+	return rruo
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (rruo *ReviewRequestUpdateOne) SetRemindedAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.ResetRemindedAt()
+	rruo.mutation.SetRemindedAt(i)
+	return rruo
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableRemindedAt(i *int64) *ReviewRequestUpdateOne {
+	if i != nil {
+		rruo.SetRemindedAt(*i)
+	}
+	return rruo
+}
+
+// AddRemindedAt adds i to the "reminded_at" field.
+func (rruo *ReviewRequestUpdateOne) AddRemindedAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.AddRemindedAt(i)
+	return rruo
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (rruo *ReviewRequestUpdateOne) ClearRemindedAt() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearRemindedAt()
+	return rruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rruo *ReviewRequestUpdateOne) SetExpiresAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.ResetExpiresAt()
+	rruo.mutation.SetExpiresAt(i)
+	return rruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableExpiresAt(i *int64) *ReviewRequestUpdateOne {
+	if i != nil {
+		rruo.SetExpiresAt(*i)
+	}
+	return rruo
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (rruo *ReviewRequestUpdateOne) AddExpiresAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.AddExpiresAt(i)
+	return rruo
+}
+
+// SetCommentID sets the "comment_id" field.
+func (rruo *ReviewRequestUpdateOne) SetCommentID(u uint64) *ReviewRequestUpdateOne {
+	rruo.mutation.ResetCommentID()
+	rruo.mutation.SetCommentID(u)
+	return rruo
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableCommentID(u *uint64) *ReviewRequestUpdateOne {
+	if u != nil {
+		rruo.SetCommentID(*u)
+	}
+	return rruo
+}
+
+// AddCommentID adds u to the "comment_id" field.
+func (rruo *ReviewRequestUpdateOne) AddCommentID(u int64) *ReviewRequestUpdateOne {
+	rruo.mutation.AddCommentID(u)
+	return rruo
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (rruo *ReviewRequestUpdateOne) ClearCommentID() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearCommentID()
+	return rruo
+}
+
+// SetCommentedAt sets the "commented_at" field.
+func (rruo *ReviewRequestUpdateOne) SetCommentedAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.ResetCommentedAt()
+	rruo.mutation.SetCommentedAt(i)
+	return rruo
+}
+
+// SetNillableCommentedAt sets the "commented_at" field if the given value is not nil.
+func (rruo *ReviewRequestUpdateOne) SetNillableCommentedAt(i *int64) *ReviewRequestUpdateOne {
+	if i != nil {
+		rruo.SetCommentedAt(*i)
+	}
+	return rruo
+}
+
+// AddCommentedAt adds i to the "commented_at" field.
+func (rruo *ReviewRequestUpdateOne) AddCommentedAt(i int64) *ReviewRequestUpdateOne {
+	rruo.mutation.AddCommentedAt(i)
+	return rruo
+}
+
+// ClearCommentedAt clears the value of the "commented_at" field.
+func (rruo *ReviewRequestUpdateOne) ClearCommentedAt() *ReviewRequestUpdateOne {
+	rruo.mutation.ClearCommentedAt()
+	return rruo
+}
+
+// Mutation returns the ReviewRequestMutation object of the builder.
+func (rruo *ReviewRequestUpdateOne) Mutation() *ReviewRequestMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the ReviewRequestUpdate builder.
+func (rruo *ReviewRequestUpdateOne) Where(ps ...predicate.ReviewRequest) *ReviewRequestUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *ReviewRequestUpdateOne) Select(field string, fields ...string) *ReviewRequestUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated ReviewRequest entity.
+func (rruo *ReviewRequestUpdateOne) Save(ctx context.Context) (*ReviewRequest, error) {
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *ReviewRequestUpdateOne) SaveX(ctx context.Context) *ReviewRequest {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *ReviewRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *ReviewRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *ReviewRequestUpdateOne) check() error {
+	if v, ok := rruo.mutation.Message(); ok {
+		if err := reviewrequest.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReviewRequest.message": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := reviewrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReviewRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rruo *ReviewRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReviewRequestUpdateOne {
+	rruo.modifiers = append(rruo.modifiers, modifiers...)
+	return rruo
+}
+
+func (rruo *ReviewRequestUpdateOne) sqlSave(ctx context.Context) (_node *ReviewRequest, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reviewrequest.Table, reviewrequest.Columns, sqlgraph.NewFieldSpec(reviewrequest.FieldID, field.TypeUint64))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReviewRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reviewrequest.FieldID)
+		for _, f := range fields {
+			if !reviewrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reviewrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UserID(); ok {
+		_spec.SetField(reviewrequest.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.StepID(); ok {
+		_spec.SetField(reviewrequest.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := rruo.mutation.AddedStepID(); ok {
+		_spec.AddField(reviewrequest.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := rruo.mutation.Roles(); ok {
+		_spec.SetField(reviewrequest.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reviewrequest.FieldRoles, value)
+		})
+	}
+	if rruo.mutation.RolesCleared() {
+		_spec.ClearField(reviewrequest.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := rruo.mutation.ReviewerIds(); ok {
+		_spec.SetField(reviewrequest.FieldReviewerIds, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.AppendedReviewerIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reviewrequest.FieldReviewerIds, value)
+		})
+	}
+	if rruo.mutation.ReviewerIdsCleared() {
+		_spec.ClearField(reviewrequest.FieldReviewerIds, field.TypeJSON)
+	}
+	if value, ok := rruo.mutation.Emails(); ok {
+		_spec.SetField(reviewrequest.FieldEmails, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.AppendedEmails(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reviewrequest.FieldEmails, value)
+		})
+	}
+	if rruo.mutation.EmailsCleared() {
+		_spec.ClearField(reviewrequest.FieldEmails, field.TypeJSON)
+	}
+	if value, ok := rruo.mutation.Message(); ok {
+		_spec.SetField(reviewrequest.FieldMessage, field.TypeString, value)
+	}
+	if rruo.mutation.MessageCleared() {
+		_spec.ClearField(reviewrequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := rruo.mutation.ShareTo(); ok {
+		_spec.SetField(reviewrequest.FieldShareTo, field.TypeString, value)
+	}
+	if rruo.mutation.ShareToCleared() {
+		_spec.ClearField(reviewrequest.FieldShareTo, field.TypeString)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(reviewrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.RemindAt(); ok {
+		_spec.SetField(reviewrequest.FieldRemindAt, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedRemindAt(); ok {
+		_spec.AddField(reviewrequest.FieldRemindAt, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.RemindedAt(); ok {
+		_spec.SetField(reviewrequest.FieldRemindedAt, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedRemindedAt(); ok {
+		_spec.AddField(reviewrequest.FieldRemindedAt, field.TypeInt64, value)
+	}
+	if rruo.mutation.RemindedAtCleared() {
+		_spec.ClearField(reviewrequest.FieldRemindedAt, field.TypeInt64)
+	}
+	if value, ok := rruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(reviewrequest.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(reviewrequest.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.CommentID(); ok {
+		_spec.SetField(reviewrequest.FieldCommentID, field.TypeUint64, value)
+	}
+	if value, ok := rruo.mutation.AddedCommentID(); ok {
+		_spec.AddField(reviewrequest.FieldCommentID, field.TypeUint64, value)
+	}
+	if rruo.mutation.CommentIDCleared() {
+		_spec.ClearField(reviewrequest.FieldCommentID, field.TypeUint64)
+	}
+	if value, ok := rruo.mutation.CommentedAt(); ok {
+		_spec.SetField(reviewrequest.FieldCommentedAt, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedCommentedAt(); ok {
+		_spec.AddField(reviewrequest.FieldCommentedAt, field.TypeInt64, value)
+	}
+	if rruo.mutation.CommentedAtCleared() {
+		_spec.ClearField(reviewrequest.FieldCommentedAt, field.TypeInt64)
+	}
+	_spec.AddModifiers(rruo.modifiers...)
+	_node = &ReviewRequest{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reviewrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
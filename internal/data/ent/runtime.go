@@ -4,11 +4,22 @@ package ent
 
 import (
 	"step/internal/data/ent/award"
+	"step/internal/data/ent/comment"
+	"step/internal/data/ent/export"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/data/ent/report"
+	"step/internal/data/ent/reviewer"
+	"step/internal/data/ent/reviewrequest"
 	"step/internal/data/ent/schema"
+	"step/internal/data/ent/searchdocument"
 	"step/internal/data/ent/show"
 	"step/internal/data/ent/showreserve"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
+	"step/internal/data/ent/template"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -21,6 +32,126 @@ func init() {
 	awardDescSettedAt := awardFields[10].Descriptor()
 	// award.DefaultSettedAt holds the default value on creation for the setted_at field.
 	award.DefaultSettedAt = awardDescSettedAt.Default.(int64)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[8].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() int64)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[9].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() int64)
+	exportFields := schema.Export{}.Fields()
+	_ = exportFields
+	// exportDescSize is the schema descriptor for size field.
+	exportDescSize := exportFields[5].Descriptor()
+	// export.DefaultSize holds the default value on creation for the size field.
+	export.DefaultSize = exportDescSize.Default.(int64)
+	// exportDescTargets is the schema descriptor for targets field.
+	exportDescTargets := exportFields[6].Descriptor()
+	// export.DefaultTargets holds the default value on creation for the targets field.
+	export.DefaultTargets = exportDescTargets.Default.(uint32)
+	// exportDescSteps is the schema descriptor for steps field.
+	exportDescSteps := exportFields[7].Descriptor()
+	// export.DefaultSteps holds the default value on creation for the steps field.
+	export.DefaultSteps = exportDescSteps.Default.(uint32)
+	// exportDescError is the schema descriptor for error field.
+	exportDescError := exportFields[8].Descriptor()
+	// export.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	export.ErrorValidator = exportDescError.Validators[0].(func(string) error)
+	// exportDescCreatedAt is the schema descriptor for created_at field.
+	exportDescCreatedAt := exportFields[9].Descriptor()
+	// export.DefaultCreatedAt holds the default value on creation for the created_at field.
+	export.DefaultCreatedAt = exportDescCreatedAt.Default.(func() int64)
+	importitemFields := schema.ImportItem{}.Fields()
+	_ = importitemFields
+	// importitemDescRef is the schema descriptor for ref field.
+	importitemDescRef := importitemFields[4].Descriptor()
+	// importitem.RefValidator is a validator for the "ref" field. It is called by the builders before save.
+	importitem.RefValidator = importitemDescRef.Validators[0].(func(string) error)
+	// importitemDescParentRef is the schema descriptor for parent_ref field.
+	importitemDescParentRef := importitemFields[5].Descriptor()
+	// importitem.ParentRefValidator is a validator for the "parent_ref" field. It is called by the builders before save.
+	importitem.ParentRefValidator = importitemDescParentRef.Validators[0].(func(string) error)
+	// importitemDescTargetRef is the schema descriptor for target_ref field.
+	importitemDescTargetRef := importitemFields[6].Descriptor()
+	// importitem.TargetRefValidator is a validator for the "target_ref" field. It is called by the builders before save.
+	importitem.TargetRefValidator = importitemDescTargetRef.Validators[0].(func(string) error)
+	// importitemDescError is the schema descriptor for error field.
+	importitemDescError := importitemFields[11].Descriptor()
+	// importitem.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	importitem.ErrorValidator = importitemDescError.Validators[0].(func(string) error)
+	importjobFields := schema.ImportJob{}.Fields()
+	_ = importjobFields
+	// importjobDescTotal is the schema descriptor for total field.
+	importjobDescTotal := importjobFields[6].Descriptor()
+	// importjob.DefaultTotal holds the default value on creation for the total field.
+	importjob.DefaultTotal = importjobDescTotal.Default.(uint32)
+	// importjobDescSucceeded is the schema descriptor for succeeded field.
+	importjobDescSucceeded := importjobFields[7].Descriptor()
+	// importjob.DefaultSucceeded holds the default value on creation for the succeeded field.
+	importjob.DefaultSucceeded = importjobDescSucceeded.Default.(uint32)
+	// importjobDescFailed is the schema descriptor for failed field.
+	importjobDescFailed := importjobFields[8].Descriptor()
+	// importjob.DefaultFailed holds the default value on creation for the failed field.
+	importjob.DefaultFailed = importjobDescFailed.Default.(uint32)
+	// importjobDescError is the schema descriptor for error field.
+	importjobDescError := importjobFields[9].Descriptor()
+	// importjob.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	importjob.ErrorValidator = importjobDescError.Validators[0].(func(string) error)
+	// importjobDescCreatedAt is the schema descriptor for created_at field.
+	importjobDescCreatedAt := importjobFields[10].Descriptor()
+	// importjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	importjob.DefaultCreatedAt = importjobDescCreatedAt.Default.(func() int64)
+	reportFields := schema.Report{}.Fields()
+	_ = reportFields
+	// reportDescStartDate is the schema descriptor for start_date field.
+	reportDescStartDate := reportFields[3].Descriptor()
+	// report.StartDateValidator is a validator for the "start_date" field. It is called by the builders before save.
+	report.StartDateValidator = reportDescStartDate.Validators[0].(func(string) error)
+	// reportDescEndDate is the schema descriptor for end_date field.
+	reportDescEndDate := reportFields[4].Descriptor()
+	// report.EndDateValidator is a validator for the "end_date" field. It is called by the builders before save.
+	report.EndDateValidator = reportDescEndDate.Validators[0].(func(string) error)
+	// reportDescError is the schema descriptor for error field.
+	reportDescError := reportFields[10].Descriptor()
+	// report.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	report.ErrorValidator = reportDescError.Validators[0].(func(string) error)
+	// reportDescCreatedAt is the schema descriptor for created_at field.
+	reportDescCreatedAt := reportFields[11].Descriptor()
+	// report.DefaultCreatedAt holds the default value on creation for the created_at field.
+	report.DefaultCreatedAt = reportDescCreatedAt.Default.(func() int64)
+	reviewrequestFields := schema.ReviewRequest{}.Fields()
+	_ = reviewrequestFields
+	// reviewrequestDescMessage is the schema descriptor for message field.
+	reviewrequestDescMessage := reviewrequestFields[6].Descriptor()
+	// reviewrequest.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	reviewrequest.MessageValidator = reviewrequestDescMessage.Validators[0].(func(string) error)
+	// reviewrequestDescCreatedAt is the schema descriptor for created_at field.
+	reviewrequestDescCreatedAt := reviewrequestFields[14].Descriptor()
+	// reviewrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reviewrequest.DefaultCreatedAt = reviewrequestDescCreatedAt.Default.(func() int64)
+	reviewerFields := schema.Reviewer{}.Fields()
+	_ = reviewerFields
+	// reviewerDescCreatedAt is the schema descriptor for created_at field.
+	reviewerDescCreatedAt := reviewerFields[7].Descriptor()
+	// reviewer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reviewer.DefaultCreatedAt = reviewerDescCreatedAt.Default.(func() int64)
+	searchdocumentFields := schema.SearchDocument{}.Fields()
+	_ = searchdocumentFields
+	// searchdocumentDescStepID is the schema descriptor for step_id field.
+	searchdocumentDescStepID := searchdocumentFields[4].Descriptor()
+	// searchdocument.DefaultStepID holds the default value on creation for the step_id field.
+	searchdocument.DefaultStepID = searchdocumentDescStepID.Default.(uint64)
+	// searchdocumentDescCommentRole is the schema descriptor for comment_role field.
+	searchdocumentDescCommentRole := searchdocumentFields[5].Descriptor()
+	// searchdocument.DefaultCommentRole holds the default value on creation for the comment_role field.
+	searchdocument.DefaultCommentRole = searchdocumentDescCommentRole.Default.(string)
+	// searchdocumentDescTitle is the schema descriptor for title field.
+	searchdocumentDescTitle := searchdocumentFields[6].Descriptor()
+	// searchdocument.DefaultTitle holds the default value on creation for the title field.
+	searchdocument.DefaultTitle = searchdocumentDescTitle.Default.(string)
 	showFields := schema.Show{}.Fields()
 	_ = showFields
 	// showDescCreatedAt is the schema descriptor for created_at field.
@@ -48,9 +179,71 @@ func init() {
 	// step.DefaultIsChallenge holds the default value on creation for the is_challenge field.
 	step.DefaultIsChallenge = stepDescIsChallenge.Default.(bool)
 	// stepDescCreatedAt is the schema descriptor for created_at field.
-	stepDescCreatedAt := stepFields[9].Descriptor()
+	stepDescCreatedAt := stepFields[11].Descriptor()
 	// step.DefaultCreatedAt holds the default value on creation for the created_at field.
 	step.DefaultCreatedAt = stepDescCreatedAt.Default.(int64)
+	// stepDescURL is the schema descriptor for url field.
+	stepDescURL := stepFields[16].Descriptor()
+	// step.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	step.URLValidator = stepDescURL.Validators[0].(func(string) error)
+	// stepDescLinkTitle is the schema descriptor for link_title field.
+	stepDescLinkTitle := stepFields[17].Descriptor()
+	// step.LinkTitleValidator is a validator for the "link_title" field. It is called by the builders before save.
+	step.LinkTitleValidator = stepDescLinkTitle.Validators[0].(func(string) error)
+	// stepDescLinkDescription is the schema descriptor for link_description field.
+	stepDescLinkDescription := stepFields[18].Descriptor()
+	// step.LinkDescriptionValidator is a validator for the "link_description" field. It is called by the builders before save.
+	step.LinkDescriptionValidator = stepDescLinkDescription.Validators[0].(func(string) error)
+	// stepDescMimeType is the schema descriptor for mime_type field.
+	stepDescMimeType := stepFields[19].Descriptor()
+	// step.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	step.MimeTypeValidator = stepDescMimeType.Validators[0].(func(string) error)
+	// stepDescSortOrder is the schema descriptor for sort_order field.
+	stepDescSortOrder := stepFields[22].Descriptor()
+	// step.DefaultSortOrder holds the default value on creation for the sort_order field.
+	step.DefaultSortOrder = stepDescSortOrder.Default.(float64)
+	// stepDescPath is the schema descriptor for path field.
+	stepDescPath := stepFields[23].Descriptor()
+	// step.DefaultPath holds the default value on creation for the path field.
+	step.DefaultPath = stepDescPath.Default.(string)
+	steprateFields := schema.StepRate{}.Fields()
+	_ = steprateFields
+	// steprateDescMeasurement is the schema descriptor for measurement field.
+	steprateDescMeasurement := steprateFields[15].Descriptor()
+	// steprate.DefaultMeasurement holds the default value on creation for the measurement field.
+	steprate.DefaultMeasurement = steprateDescMeasurement.Default.(float64)
+	// steprateDescCreateCounted is the schema descriptor for create_counted field.
+	steprateDescCreateCounted := steprateFields[16].Descriptor()
+	// steprate.DefaultCreateCounted holds the default value on creation for the create_counted field.
+	steprate.DefaultCreateCounted = steprateDescCreateCounted.Default.(bool)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[2].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescColor is the schema descriptor for color field.
+	tagDescColor := tagFields[3].Descriptor()
+	// tag.ColorValidator is a validator for the "color" field. It is called by the builders before save.
+	tag.ColorValidator = tagDescColor.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[4].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() int64)
 	targetFields := schema.Target{}.Fields()
 	_ = targetFields
 	// targetDescTitle is the schema descriptor for title field.
@@ -73,4 +266,48 @@ func init() {
 	targetDescLayer := targetFields[9].Descriptor()
 	// target.DefaultLayer holds the default value on creation for the layer field.
 	target.DefaultLayer = targetDescLayer.Default.(uint32)
+	// targetDescUnit is the schema descriptor for unit field.
+	targetDescUnit := targetFields[16].Descriptor()
+	// target.UnitValidator is a validator for the "unit" field. It is called by the builders before save.
+	target.UnitValidator = targetDescUnit.Validators[0].(func(string) error)
+	// targetDescSortOrder is the schema descriptor for sort_order field.
+	targetDescSortOrder := targetFields[18].Descriptor()
+	// target.DefaultSortOrder holds the default value on creation for the sort_order field.
+	target.DefaultSortOrder = targetDescSortOrder.Default.(float64)
+	// targetDescPath is the schema descriptor for path field.
+	targetDescPath := targetFields[19].Descriptor()
+	// target.DefaultPath holds the default value on creation for the path field.
+	target.DefaultPath = targetDescPath.Default.(string)
+	templateFields := schema.Template{}.Fields()
+	_ = templateFields
+	// templateDescTitle is the schema descriptor for title field.
+	templateDescTitle := templateFields[2].Descriptor()
+	// template.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	template.TitleValidator = func() func(string) error {
+		validators := templateDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// templateDescDescription is the schema descriptor for description field.
+	templateDescDescription := templateFields[3].Descriptor()
+	// template.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	template.DescriptionValidator = templateDescDescription.Validators[0].(func(string) error)
+	// templateDescCreatedAt is the schema descriptor for created_at field.
+	templateDescCreatedAt := templateFields[8].Descriptor()
+	// template.DefaultCreatedAt holds the default value on creation for the created_at field.
+	template.DefaultCreatedAt = templateDescCreatedAt.Default.(func() int64)
+	// templateDescUpdatedAt is the schema descriptor for updated_at field.
+	templateDescUpdatedAt := templateFields[9].Descriptor()
+	// template.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	template.DefaultUpdatedAt = templateDescUpdatedAt.Default.(func() int64)
 }
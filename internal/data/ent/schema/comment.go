@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"step/internal/objects"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.Uint64("step_id").Comment("积累ID"),
+		field.String("author_id").Optional().Comment("作者User ID, 分享链接的朋友评论为空"),
+		field.Enum("role").Values("teacher", "parent", "friend", "student").Comment("作者角色, student为积累所有者的回复"),
+		field.JSON("rating", map[string]any{}).Optional().Comment("评分, 只有顶层评论有"),
+		field.Text("content").Optional().Comment("文字内容"),
+		field.Uint64("parent_id").Optional().Comment("回复的顶层评论ID, 0为顶层评论"),
+		field.JSON("history", []objects.CommentRevision{}).Optional().Comment("编辑前的版本, 按时间先后"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("更新时间"),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("step_id"),
+		index.Fields("parent_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Export holds the schema definition for the Export entity.
+type Export struct {
+	ent.Schema
+}
+
+// Fields of the Export.
+func (Export) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("User ID"),
+		field.Uint64("target_id").Comment("导出的目标ID"),
+		field.Enum("status").Values("pending", "running", "done", "failed").Default("pending").Comment("状态"),
+		field.String("object_name").Optional().Comment("压缩包对象名"),
+		field.Int64("size").Default(0).Comment("压缩包大小"),
+		field.Uint32("targets").Default(0).Comment("导出的目标数"),
+		field.Uint32("steps").Default(0).Comment("导出的积累数"),
+		field.String("error").MaxLen(1000).Optional().Comment("失败原因"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+		field.Int64("finished_at").Optional().Comment("完成时间"),
+	}
+}
+
+// Edges of the Export.
+func (Export) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Export.
+func (Export) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
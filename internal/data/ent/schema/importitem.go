@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"step/internal/objects"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImportItem holds the schema definition for the ImportItem entity.
+type ImportItem struct {
+	ent.Schema
+}
+
+// Fields of the ImportItem.
+func (ImportItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.Uint64("job_id").Comment("导入任务ID"),
+		field.Uint32("seq").Comment("处理顺序"),
+		field.Enum("kind").Values("target", "dir", "step").Comment("类型"),
+		field.String("ref").MaxLen(1024).Comment("清单中的引用"),
+		field.String("parent_ref").MaxLen(1024).Optional().Comment("父目标或父目录的引用"),
+		field.String("target_ref").MaxLen(1024).Optional().Comment("所属目标的引用"),
+		field.Int64("item_time").Comment("原始创建时间"),
+		field.JSON("data", &objects.ImportItemData{}).Comment("导入内容"),
+		field.Enum("status").Values("pending", "created", "done", "failed").Default("pending").Comment("状态"),
+		field.Uint64("entity_id").Optional().Comment("创建的目标或积累ID"),
+		field.String("error").MaxLen(1000).Optional().Comment("失败原因"),
+	}
+}
+
+// Edges of the ImportItem.
+func (ImportItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("job", ImportJob.Type).Ref("items").Field("job_id").Unique().Required(),
+	}
+}
+
+// Indexes of the ImportItem.
+func (ImportItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id", "seq").Unique(),
+	}
+}
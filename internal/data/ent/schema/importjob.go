@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ImportJob holds the schema definition for the ImportJob entity.
+type ImportJob struct {
+	ent.Schema
+}
+
+// Fields of the ImportJob.
+func (ImportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("User ID"),
+		field.Uint64("parent_target_id").Optional().Comment("导入到的父目标ID, 0为顶层目标"),
+		field.Enum("status").Values("pending", "running", "done", "failed").Default("pending").Comment("状态"),
+		field.Enum("format").Values("json", "csv").Comment("清单格式"),
+		field.String("object_name").Comment("压缩包对象名"),
+		field.Uint32("total").Default(0).Comment("导入项总数"),
+		field.Uint32("succeeded").Default(0).Comment("成功数"),
+		field.Uint32("failed").Default(0).Comment("失败数"),
+		field.String("error").MaxLen(1000).Optional().Comment("失败原因"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+		field.Int64("finished_at").Optional().Comment("完成时间"),
+	}
+}
+
+// Edges of the ImportJob.
+func (ImportJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", ImportItem.Type),
+	}
+}
+
+// Indexes of the ImportJob.
+func (ImportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Report holds the schema definition for the Report entity.
+type Report struct {
+	ent.Schema
+}
+
+// Fields of the Report.
+func (Report) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("User ID"),
+		field.Uint64("target_id").Comment("顶层目标ID"),
+		field.String("start_date").MaxLen(10).Comment("开始日期"),
+		field.String("end_date").MaxLen(10).Comment("结束日期"),
+		field.Enum("stat_unit").Values("day", "week", "month").Default("week").Comment("评分趋势的统计单位"),
+		field.JSON("media_step_ids", []uint64{}).Optional().Comment("选择的媒体积累ID"),
+		field.Enum("status").Values("pending", "running", "done", "failed").Default("pending").Comment("状态"),
+		field.String("html_object_name").Optional().Comment("HTML对象名"),
+		field.String("pdf_object_name").Optional().Comment("PDF对象名"),
+		field.String("error").MaxLen(1000).Optional().Comment("失败原因"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+		field.Int64("finished_at").Optional().Comment("完成时间"),
+	}
+}
+
+// Edges of the Report.
+func (Report) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Report.
+func (Report) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reviewer holds the schema definition for the Reviewer entity.
+type Reviewer struct {
+	ent.Schema
+}
+
+// Fields of the Reviewer.
+func (Reviewer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("student_id").Comment("邀请的学生User ID"),
+		field.String("reviewer_id").Optional().Comment("接受邀请的老师或家长User ID, 未接受时为空"),
+		field.Enum("role").Values("teacher", "parent").Comment("评论角色"),
+		field.Enum("status").Values("pending", "active").Default("pending").Comment("状态"),
+		field.String("invite_code").Optional().Unique().Comment("邀请码, 接受后清空"),
+		field.Int64("invite_expires_at").Comment("邀请码过期时间"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+		field.Int64("accepted_at").Optional().Comment("接受邀请时间"),
+	}
+}
+
+// Edges of the Reviewer.
+func (Reviewer) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Reviewer.
+func (Reviewer) Indexes() []ent.Index {
+	return []ent.Index{
+		// 一个评论人对同一个学生只有一个角色
+		index.Fields("student_id", "reviewer_id").Unique(),
+		index.Fields("reviewer_id"),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReviewRequest holds the schema definition for the ReviewRequest entity.
+type ReviewRequest struct {
+	ent.Schema
+}
+
+// Fields of the ReviewRequest.
+func (ReviewRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("请求评论的学生User ID"),
+		field.Uint64("step_id").Comment("积累ID, 可以是目录"),
+		field.JSON("roles", []string{}).Optional().Comment("通过分享链接评论的角色"),
+		field.JSON("reviewer_ids", []string{}).Optional().Comment("关联的老师或家长User ID"),
+		field.JSON("emails", []string{}).Optional().Comment("接收分享链接的邮箱"),
+		field.String("message").MaxLen(500).Optional().Comment("附言"),
+		field.String("share_to").Optional().Comment("分享链接的share_to, 没有角色时为空"),
+		field.Enum("status").Values("pending", "commented", "expired").Default("pending").Comment("状态"),
+		field.Int64("remind_at").Comment("未评论时的提醒时间"),
+		field.Int64("reminded_at").Optional().Comment("已提醒时间"),
+		field.Int64("expires_at").Comment("过期时间"),
+		field.Uint64("comment_id").Optional().Comment("第一条回应的评论ID"),
+		field.Int64("commented_at").Optional().Comment("评论时间"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+	}
+}
+
+// Edges of the ReviewRequest.
+func (ReviewRequest) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ReviewRequest.
+func (ReviewRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		// 评论时查找积累及其所在目录的待回应请求
+		index.Fields("step_id", "status"),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchDocument holds the schema definition for the SearchDocument entity.
+// 数据库全文索引(MySQL FULLTEXT / SQLite FTS5)使用的文档表，全文索引本身在启动时创建
+type SearchDocument struct {
+	ent.Schema
+}
+
+// Fields of the SearchDocument.
+func (SearchDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("User ID"),
+		field.Enum("kind").Values("target", "step", "comment").Comment("文档类型"),
+		field.Uint64("target_id").Comment("Target ID"),
+		field.Uint64("step_id").Default(0).Comment("Step ID, 目标文档为0"),
+		field.String("comment_role").Default("").Comment("评论角色"),
+		field.String("title").Default("").Comment("标题"),
+		field.Text("content").Comment("内容"),
+		field.Int64("created_at").Comment("创建时间"),
+	}
+}
+
+// Edges of the SearchDocument.
+func (SearchDocument) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SearchDocument.
+func (SearchDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("target_id"),
+	}
+}
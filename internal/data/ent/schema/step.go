@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Step holds the schema definition for the Step entity.
@@ -20,14 +21,26 @@ func (Step) Fields() []ent.Field {
 		field.String("title").MaxLen(50).Optional().Comment("名称"),
 		field.String("description").MaxLen(500).Optional().Comment("描述"),
 		field.Bool("is_challenge").Default(false).Comment("是否挑战"),
-		field.JSON("teacher_comment", map[string]any{}).Optional().Comment("老师评论"),
-		field.JSON("parent_comment", map[string]any{}).Optional().Comment("家长评论"),
-		field.JSON("friend_comment", map[string]any{}).Optional().Comment("朋友评论"),
-		field.Enum("type").Values("image", "video", "audio", "dir").Comment("类型"),
+		field.JSON("teacher_comment", map[string]any{}).Optional().Comment("已废弃, 老师最新的评分, 评论保存在comments表"),
+		field.JSON("parent_comment", map[string]any{}).Optional().Comment("已废弃, 家长最新的评分, 评论保存在comments表"),
+		field.JSON("friend_comment", map[string]any{}).Optional().Comment("已废弃, 朋友最新的评分, 评论保存在comments表"),
+		field.String("teacher_reviewer_id").Optional().Comment("已废弃, 评论的老师User ID"),
+		field.String("parent_reviewer_id").Optional().Comment("已废弃, 评论的家长User ID"),
+		field.Enum("type").Values("image", "video", "audio", "dir", "measurement", "note", "link", "document").Comment("类型"),
 		field.String("object_name").Optional().Unique().Comment("对象名"),
 		field.Int64("created_at").Immutable().Default(time.Now().Local().Unix()).Comment("创建时间"),
 		field.Uint64("ref_target_id").Optional().Comment("目标ID"),
 		field.Uint64("parent_id").Optional().Comment("父ID"),
+		field.Float("measurement").Optional().Comment("计量值"),
+		field.Text("content").Optional().Comment("文字内容(markdown)"),
+		field.String("url").MaxLen(2048).Optional().Comment("链接"),
+		field.String("link_title").MaxLen(255).Optional().Comment("链接标题"),
+		field.String("link_description").MaxLen(1000).Optional().Comment("链接描述"),
+		field.String("mime_type").MaxLen(255).Optional().Comment("文件MIME类型"),
+		field.Uint32("page_count").Optional().Comment("文档页数"),
+		field.String("preview_object_name").Optional().Comment("预览图对象名"),
+		field.Float("sort_order").Default(0).Comment("手动排序, 0为未排序"),
+		field.String("path").Default("").Comment("祖先目录ID路径, 如/3/7/, 不在目录中为/"),
 	}
 }
 
@@ -36,5 +49,14 @@ func (Step) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("target", Target.Type).Ref("steps").Field("ref_target_id").Unique(),
 		edge.To("children", Step.Type).From("parent").Field("parent_id").Unique(),
+		edge.From("tags", Tag.Type).Ref("steps"),
+	}
+}
+
+// Indexes of the Step.
+func (Step) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按路径前缀查询子树
+		index.Fields("path"),
 	}
 }
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"step/internal/objects"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 )
@@ -29,6 +31,10 @@ func (StepRate) Fields() []ent.Field {
 		field.Int32("basic_reliability").Comment("基础牢靠"),
 		field.Int32("skill_improvement").Comment("技能提升"),
 		field.Int32("difficulty").Comment("困难度"),
+		field.JSON("self_scores", &objects.CommentScores{}).Optional().Comment("上面各列中自评的部分, 画像只按自评的困难度计入挑战心态"),
+		field.Float("measurement").Default(0).Comment("计量值"),
+		// 评价可能先于积累创建处理，这时先建好空记录，积累创建的统计还没有计入；旧记录都是积累创建时写入的，默认为true
+		field.Bool("create_counted").Default(true).Comment("积累创建的统计是否已计入画像"),
 		field.Time("date").SchemaType(map[string]string{
 			"mysql":    "date",
 			"postgres": "date",
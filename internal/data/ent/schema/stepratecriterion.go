@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StepRateCriterion holds the schema definition for the StepRateCriterion entity.
+type StepRateCriterion struct {
+	ent.Schema
+}
+
+// Fields of the StepRateCriterion.
+func (StepRateCriterion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("User ID"),
+		field.Uint64("top_target_id").Comment("Top Target ID"),
+		field.Uint64("target_id").Comment("Target ID"),
+		field.Uint64("step_id").Comment("Step ID"),
+		field.String("key").Comment("自定义评分标准的评价项"),
+		field.Float("value").Comment("所有评分相对中间值的偏移之和"),
+		field.Time("date").SchemaType(map[string]string{
+			"mysql":    "date",
+			"postgres": "date",
+			"sqlite3":  "date",
+		}).Comment("日期"),
+	}
+}
+
+// Edges of the StepRateCriterion.
+func (StepRateCriterion) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the StepRateCriterion.
+func (StepRateCriterion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("step_id", "key").Unique(),
+		index.Fields("top_target_id", "key"),
+	}
+}
@@ -3,9 +3,12 @@ package schema
 import (
 	"time"
 
+	"step/internal/objects"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Target holds the schema definition for the Target entity.
@@ -28,6 +31,15 @@ func (Target) Fields() []ent.Field {
 		field.Uint32("layer").Default(0).Comment("层级"),
 		field.Enum("status").Values("init", "step", "step_hard", "done").Default("init").Comment("状态"),
 		field.Uint64("parent_id").Optional().Comment("父ID"),
+		field.Enum("schedule_type").Values("none", "daily", "weekdays", "times_per_week").Default("none").Comment("打卡周期类型"),
+		field.Ints("schedule_weekdays").Optional().Comment("每周打卡日, 0-6, 0为周日"),
+		field.Uint32("schedule_times").Optional().Comment("每周打卡次数"),
+		field.Enum("mode").Values("check_in", "quantity").Default("check_in").Comment("目标模式"),
+		field.String("unit").MaxLen(20).Optional().Comment("计量单位"),
+		field.Float("goal_value").Optional().Comment("目标值"),
+		field.Float("sort_order").Default(0).Comment("手动排序, 0为未排序"),
+		field.String("path").Default("").Comment("祖先目标ID路径, 如/1/5/, 顶层为/"),
+		field.JSON("rubric", &objects.TargetRubric{}).Optional().Comment("自定义评分标准, 只用于顶层目标, 为空时使用默认评分标准"),
 	}
 }
 
@@ -36,5 +48,14 @@ func (Target) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("children", Target.Type).From("parent").Field("parent_id").Unique(),
 		edge.To("steps", Step.Type),
+		edge.From("tags", Tag.Type).Ref("targets"),
+	}
+}
+
+// Indexes of the Target.
+func (Target) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按路径前缀查询子树
+		index.Fields("path"),
 	}
 }
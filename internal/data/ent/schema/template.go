@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"step/internal/objects"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Template holds the schema definition for the Template entity.
+type Template struct {
+	ent.Schema
+}
+
+// Fields of the Template.
+func (Template) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id").Unique().Comment("自增ID"),
+		field.String("user_id").Comment("User ID"),
+		field.String("title").NotEmpty().MaxLen(50).Comment("名称"),
+		field.String("description").MaxLen(500).Optional().Comment("描述"),
+		field.Enum("visibility").Values("private", "link").Default("private").Comment("可见性, link为持有链接的用户可见"),
+		field.String("share_token").Optional().Unique().Comment("分享链接令牌"),
+		field.JSON("content", &objects.TemplateTarget{}).Comment("目标树结构"),
+		field.Uint64("source_target_id").Optional().Comment("来源目标ID"),
+		field.Int64("created_at").Immutable().DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("创建时间"),
+		field.Int64("updated_at").DefaultFunc(func() int64 { return time.Now().Local().Unix() }).Comment("更新时间"),
+	}
+}
+
+// Edges of the Template.
+func (Template) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Template.
+func (Template) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"step/internal/data/ent/searchdocument"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SearchDocument is the model entity for the SearchDocument schema.
+type SearchDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// User ID
+	UserID string `json:"user_id,omitempty"`
+	// 文档类型
+	Kind searchdocument.Kind `json:"kind,omitempty"`
+	// Target ID
+	TargetID uint64 `json:"target_id,omitempty"`
+	// Step ID, 目标文档为0
+	StepID uint64 `json:"step_id,omitempty"`
+	// 评论角色
+	CommentRole string `json:"comment_role,omitempty"`
+	// 标题
+	Title string `json:"title,omitempty"`
+	// 内容
+	Content string `json:"content,omitempty"`
+	// 创建时间
+	CreatedAt    int64 `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchDocument) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchdocument.FieldID, searchdocument.FieldTargetID, searchdocument.FieldStepID, searchdocument.FieldCreatedAt:
+			values[i] = new(sql.NullInt64)
+		case searchdocument.FieldUserID, searchdocument.FieldKind, searchdocument.FieldCommentRole, searchdocument.FieldTitle, searchdocument.FieldContent:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchDocument fields.
+func (sd *SearchDocument) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchdocument.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sd.ID = uint64(value.Int64)
+		case searchdocument.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sd.UserID = value.String
+			}
+		case searchdocument.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				sd.Kind = searchdocument.Kind(value.String)
+			}
+		case searchdocument.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				sd.TargetID = uint64(value.Int64)
+			}
+		case searchdocument.FieldStepID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step_id", values[i])
+			} else if value.Valid {
+				sd.StepID = uint64(value.Int64)
+			}
+		case searchdocument.FieldCommentRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_role", values[i])
+			} else if value.Valid {
+				sd.CommentRole = value.String
+			}
+		case searchdocument.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				sd.Title = value.String
+			}
+		case searchdocument.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				sd.Content = value.String
+			}
+		case searchdocument.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sd.CreatedAt = value.Int64
+			}
+		default:
+			sd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchDocument.
+// This includes values selected through modifiers, order, etc.
+func (sd *SearchDocument) Value(name string) (ent.Value, error) {
+	return sd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SearchDocument.
+// Note that you need to call SearchDocument.Unwrap() before calling this method if this SearchDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sd *SearchDocument) Update() *SearchDocumentUpdateOne {
+	return NewSearchDocumentClient(sd.config).UpdateOne(sd)
+}
+
+// Unwrap unwraps the SearchDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sd *SearchDocument) Unwrap() *SearchDocument {
+	_tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchDocument is not a transactional entity")
+	}
+	sd.config.driver = _tx.drv
+	return sd
+}
+
+// String implements the fmt.Stringer.
+func (sd *SearchDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sd.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(sd.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", sd.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", sd.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("step_id=")
+	builder.WriteString(fmt.Sprintf("%v", sd.StepID))
+	builder.WriteString(", ")
+	builder.WriteString("comment_role=")
+	builder.WriteString(sd.CommentRole)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(sd.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(sd.Content)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", sd.CreatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchDocuments is a parsable slice of SearchDocument.
+type SearchDocuments []*SearchDocument
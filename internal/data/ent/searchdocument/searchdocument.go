@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchdocument
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the searchdocument type in the database.
+	Label = "search_document"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldStepID holds the string denoting the step_id field in the database.
+	FieldStepID = "step_id"
+	// FieldCommentRole holds the string denoting the comment_role field in the database.
+	FieldCommentRole = "comment_role"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the searchdocument in the database.
+	Table = "search_documents"
+)
+
+// Columns holds all SQL columns for searchdocument fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldKind,
+	FieldTargetID,
+	FieldStepID,
+	FieldCommentRole,
+	FieldTitle,
+	FieldContent,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStepID holds the default value on creation for the "step_id" field.
+	DefaultStepID uint64
+	// DefaultCommentRole holds the default value on creation for the "comment_role" field.
+	DefaultCommentRole string
+	// DefaultTitle holds the default value on creation for the "title" field.
+	DefaultTitle string
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindTarget  Kind = "target"
+	KindStep    Kind = "step"
+	KindComment Kind = "comment"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindTarget, KindStep, KindComment:
+		return nil
+	default:
+		return fmt.Errorf("searchdocument: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the SearchDocument queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByStepID orders the results by the step_id field.
+func ByStepID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepID, opts...).ToFunc()
+}
+
+// ByCommentRole orders the results by the comment_role field.
+func ByCommentRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommentRole, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
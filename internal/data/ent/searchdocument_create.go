@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/searchdocument"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentCreate is the builder for creating a SearchDocument entity.
+type SearchDocumentCreate struct {
+	config
+	mutation *SearchDocumentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (sdc *SearchDocumentCreate) SetUserID(s string) *SearchDocumentCreate {
+	sdc.mutation.SetUserID(s)
+	return sdc
+}
+
+// SetKind sets the "kind" field.
+func (sdc *SearchDocumentCreate) SetKind(s searchdocument.Kind) *SearchDocumentCreate {
+	sdc.mutation.SetKind(s)
+	return sdc
+}
+
+// SetTargetID sets the "target_id" field.
+func (sdc *SearchDocumentCreate) SetTargetID(u uint64) *SearchDocumentCreate {
+	sdc.mutation.SetTargetID(u)
+	return sdc
+}
+
+// SetStepID sets the "step_id" field.
+func (sdc *SearchDocumentCreate) SetStepID(u uint64) *SearchDocumentCreate {
+	sdc.mutation.SetStepID(u)
+	return sdc
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (sdc *SearchDocumentCreate) SetNillableStepID(u *uint64) *SearchDocumentCreate {
+	if u != nil {
+		sdc.SetStepID(*u)
+	}
+	return sdc
+}
+
+// SetCommentRole sets the "comment_role" field.
+func (sdc *SearchDocumentCreate) SetCommentRole(s string) *SearchDocumentCreate {
+	sdc.mutation.SetCommentRole(s)
+	return sdc
+}
+
+// SetNillableCommentRole sets the "comment_role" field if the given value is not nil.
+func (sdc *SearchDocumentCreate) SetNillableCommentRole(s *string) *SearchDocumentCreate {
+	if s != nil {
+		sdc.SetCommentRole(*s)
+	}
+	return sdc
+}
+
+// SetTitle sets the "title" field.
+func (sdc *SearchDocumentCreate) SetTitle(s string) *SearchDocumentCreate {
+	sdc.mutation.SetTitle(s)
+	return sdc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (sdc *SearchDocumentCreate) SetNillableTitle(s *string) *SearchDocumentCreate {
+	if s != nil {
+		sdc.SetTitle(*s)
+	}
+	return sdc
+}
+
+// SetContent sets the "content" field.
+func (sdc *SearchDocumentCreate) SetContent(s string) *SearchDocumentCreate {
+	sdc.mutation.SetContent(s)
+	return sdc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdc *SearchDocumentCreate) SetCreatedAt(i int64) *SearchDocumentCreate {
+	sdc.mutation.SetCreatedAt(i)
+	return sdc
+}
+
+// SetID sets the "id" field.
+func (sdc *SearchDocumentCreate) SetID(u uint64) *SearchDocumentCreate {
+	sdc.mutation.SetID(u)
+	return sdc
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (sdc *SearchDocumentCreate) Mutation() *SearchDocumentMutation {
+	return sdc.mutation
+}
+
+// Save creates the SearchDocument in the database.
+func (sdc *SearchDocumentCreate) Save(ctx context.Context) (*SearchDocument, error) {
+	sdc.defaults()
+	return withHooks(ctx, sdc.sqlSave, sdc.mutation, sdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sdc *SearchDocumentCreate) SaveX(ctx context.Context) *SearchDocument {
+	v, err := sdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdc *SearchDocumentCreate) Exec(ctx context.Context) error {
+	_, err := sdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdc *SearchDocumentCreate) ExecX(ctx context.Context) {
+	if err := sdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdc *SearchDocumentCreate) defaults() {
+	if _, ok := sdc.mutation.StepID(); !ok {
+		v := searchdocument.DefaultStepID
+		sdc.mutation.SetStepID(v)
+	}
+	if _, ok := sdc.mutation.CommentRole(); !ok {
+		v := searchdocument.DefaultCommentRole
+		sdc.mutation.SetCommentRole(v)
+	}
+	if _, ok := sdc.mutation.Title(); !ok {
+		v := searchdocument.DefaultTitle
+		sdc.mutation.SetTitle(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdc *SearchDocumentCreate) check() error {
+	if _, ok := sdc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SearchDocument.user_id"`)}
+	}
+	if _, ok := sdc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "SearchDocument.kind"`)}
+	}
+	if v, ok := sdc.mutation.Kind(); ok {
+		if err := searchdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.kind": %w`, err)}
+		}
+	}
+	if _, ok := sdc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "SearchDocument.target_id"`)}
+	}
+	if _, ok := sdc.mutation.StepID(); !ok {
+		return &ValidationError{Name: "step_id", err: errors.New(`ent: missing required field "SearchDocument.step_id"`)}
+	}
+	if _, ok := sdc.mutation.CommentRole(); !ok {
+		return &ValidationError{Name: "comment_role", err: errors.New(`ent: missing required field "SearchDocument.comment_role"`)}
+	}
+	if _, ok := sdc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "SearchDocument.title"`)}
+	}
+	if _, ok := sdc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "SearchDocument.content"`)}
+	}
+	if _, ok := sdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SearchDocument.created_at"`)}
+	}
+	return nil
+}
+
+func (sdc *SearchDocumentCreate) sqlSave(ctx context.Context) (*SearchDocument, error) {
+	if err := sdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	sdc.mutation.id = &_node.ID
+	sdc.mutation.done = true
+	return _node, nil
+}
+
+func (sdc *SearchDocumentCreate) createSpec() (*SearchDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchDocument{config: sdc.config}
+		_spec = sqlgraph.NewCreateSpec(searchdocument.Table, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeUint64))
+	)
+	if id, ok := sdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sdc.mutation.UserID(); ok {
+		_spec.SetField(searchdocument.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := sdc.mutation.Kind(); ok {
+		_spec.SetField(searchdocument.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := sdc.mutation.TargetID(); ok {
+		_spec.SetField(searchdocument.FieldTargetID, field.TypeUint64, value)
+		_node.TargetID = value
+	}
+	if value, ok := sdc.mutation.StepID(); ok {
+		_spec.SetField(searchdocument.FieldStepID, field.TypeUint64, value)
+		_node.StepID = value
+	}
+	if value, ok := sdc.mutation.CommentRole(); ok {
+		_spec.SetField(searchdocument.FieldCommentRole, field.TypeString, value)
+		_node.CommentRole = value
+	}
+	if value, ok := sdc.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := sdc.mutation.Content(); ok {
+		_spec.SetField(searchdocument.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := sdc.mutation.CreatedAt(); ok {
+		_spec.SetField(searchdocument.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SearchDocumentCreateBulk is the builder for creating many SearchDocument entities in bulk.
+type SearchDocumentCreateBulk struct {
+	config
+	err      error
+	builders []*SearchDocumentCreate
+}
+
+// Save creates the SearchDocument entities in the database.
+func (sdcb *SearchDocumentCreateBulk) Save(ctx context.Context) ([]*SearchDocument, error) {
+	if sdcb.err != nil {
+		return nil, sdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sdcb.builders))
+	nodes := make([]*SearchDocument, len(sdcb.builders))
+	mutators := make([]Mutator, len(sdcb.builders))
+	for i := range sdcb.builders {
+		func(i int, root context.Context) {
+			builder := sdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdcb *SearchDocumentCreateBulk) SaveX(ctx context.Context) []*SearchDocument {
+	v, err := sdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdcb *SearchDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := sdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdcb *SearchDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := sdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/searchdocument"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentQuery is the builder for querying SearchDocument entities.
+type SearchDocumentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []searchdocument.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SearchDocument
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SearchDocumentQuery builder.
+func (sdq *SearchDocumentQuery) Where(ps ...predicate.SearchDocument) *SearchDocumentQuery {
+	sdq.predicates = append(sdq.predicates, ps...)
+	return sdq
+}
+
+// Limit the number of records to be returned by this query.
+func (sdq *SearchDocumentQuery) Limit(limit int) *SearchDocumentQuery {
+	sdq.ctx.Limit = &limit
+	return sdq
+}
+
+// Offset to start from.
+func (sdq *SearchDocumentQuery) Offset(offset int) *SearchDocumentQuery {
+	sdq.ctx.Offset = &offset
+	return sdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sdq *SearchDocumentQuery) Unique(unique bool) *SearchDocumentQuery {
+	sdq.ctx.Unique = &unique
+	return sdq
+}
+
+// Order specifies how the records should be ordered.
+func (sdq *SearchDocumentQuery) Order(o ...searchdocument.OrderOption) *SearchDocumentQuery {
+	sdq.order = append(sdq.order, o...)
+	return sdq
+}
+
+// First returns the first SearchDocument entity from the query.
+// Returns a *NotFoundError when no SearchDocument was found.
+func (sdq *SearchDocumentQuery) First(ctx context.Context) (*SearchDocument, error) {
+	nodes, err := sdq.Limit(1).All(setContextOp(ctx, sdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{searchdocument.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) FirstX(ctx context.Context) *SearchDocument {
+	node, err := sdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SearchDocument ID from the query.
+// Returns a *NotFoundError when no SearchDocument ID was found.
+func (sdq *SearchDocumentQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = sdq.Limit(1).IDs(setContextOp(ctx, sdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{searchdocument.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := sdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SearchDocument entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SearchDocument entity is found.
+// Returns a *NotFoundError when no SearchDocument entities are found.
+func (sdq *SearchDocumentQuery) Only(ctx context.Context) (*SearchDocument, error) {
+	nodes, err := sdq.Limit(2).All(setContextOp(ctx, sdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{searchdocument.Label}
+	default:
+		return nil, &NotSingularError{searchdocument.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) OnlyX(ctx context.Context) *SearchDocument {
+	node, err := sdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SearchDocument ID in the query.
+// Returns a *NotSingularError when more than one SearchDocument ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sdq *SearchDocumentQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = sdq.Limit(2).IDs(setContextOp(ctx, sdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{searchdocument.Label}
+	default:
+		err = &NotSingularError{searchdocument.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := sdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SearchDocuments.
+func (sdq *SearchDocumentQuery) All(ctx context.Context) ([]*SearchDocument, error) {
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryAll)
+	if err := sdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SearchDocument, *SearchDocumentQuery]()
+	return withInterceptors[[]*SearchDocument](ctx, sdq, qr, sdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) AllX(ctx context.Context) []*SearchDocument {
+	nodes, err := sdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SearchDocument IDs.
+func (sdq *SearchDocumentQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if sdq.ctx.Unique == nil && sdq.path != nil {
+		sdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryIDs)
+	if err = sdq.Select(searchdocument.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := sdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sdq *SearchDocumentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryCount)
+	if err := sdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sdq, querierCount[*SearchDocumentQuery](), sdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) CountX(ctx context.Context) int {
+	count, err := sdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sdq *SearchDocumentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sdq.ctx, ent.OpQueryExist)
+	switch _, err := sdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sdq *SearchDocumentQuery) ExistX(ctx context.Context) bool {
+	exist, err := sdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SearchDocumentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sdq *SearchDocumentQuery) Clone() *SearchDocumentQuery {
+	if sdq == nil {
+		return nil
+	}
+	return &SearchDocumentQuery{
+		config:     sdq.config,
+		ctx:        sdq.ctx.Clone(),
+		order:      append([]searchdocument.OrderOption{}, sdq.order...),
+		inters:     append([]Interceptor{}, sdq.inters...),
+		predicates: append([]predicate.SearchDocument{}, sdq.predicates...),
+		// clone intermediate query.
+		sql:  sdq.sql.Clone(),
+		path: sdq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SearchDocument.Query().
+//		GroupBy(searchdocument.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (sdq *SearchDocumentQuery) GroupBy(field string, fields ...string) *SearchDocumentGroupBy {
+	sdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SearchDocumentGroupBy{build: sdq}
+	grbuild.flds = &sdq.ctx.Fields
+	grbuild.label = searchdocument.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.SearchDocument.Query().
+//		Select(searchdocument.FieldUserID).
+//		Scan(ctx, &v)
+func (sdq *SearchDocumentQuery) Select(fields ...string) *SearchDocumentSelect {
+	sdq.ctx.Fields = append(sdq.ctx.Fields, fields...)
+	sbuild := &SearchDocumentSelect{SearchDocumentQuery: sdq}
+	sbuild.label = searchdocument.Label
+	sbuild.flds, sbuild.scan = &sdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SearchDocumentSelect configured with the given aggregations.
+func (sdq *SearchDocumentQuery) Aggregate(fns ...AggregateFunc) *SearchDocumentSelect {
+	return sdq.Select().Aggregate(fns...)
+}
+
+func (sdq *SearchDocumentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sdq.ctx.Fields {
+		if !searchdocument.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if sdq.path != nil {
+		prev, err := sdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sdq.sql = prev
+	}
+	return nil
+}
+
+func (sdq *SearchDocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SearchDocument, error) {
+	var (
+		nodes = []*SearchDocument{}
+		_spec = sdq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SearchDocument).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SearchDocument{config: sdq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(sdq.modifiers) > 0 {
+		_spec.Modifiers = sdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sdq *SearchDocumentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sdq.querySpec()
+	if len(sdq.modifiers) > 0 {
+		_spec.Modifiers = sdq.modifiers
+	}
+	_spec.Node.Columns = sdq.ctx.Fields
+	if len(sdq.ctx.Fields) > 0 {
+		_spec.Unique = sdq.ctx.Unique != nil && *sdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sdq.driver, _spec)
+}
+
+func (sdq *SearchDocumentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeUint64))
+	_spec.From = sdq.sql
+	if unique := sdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchdocument.FieldID)
+		for i := range fields {
+			if fields[i] != searchdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sdq *SearchDocumentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sdq.driver.Dialect())
+	t1 := builder.Table(searchdocument.Table)
+	columns := sdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = searchdocument.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sdq.sql != nil {
+		selector = sdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sdq.ctx.Unique != nil && *sdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sdq.modifiers {
+		m(selector)
+	}
+	for _, p := range sdq.predicates {
+		p(selector)
+	}
+	for _, p := range sdq.order {
+		p(selector)
+	}
+	if offset := sdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sdq *SearchDocumentQuery) Modify(modifiers ...func(s *sql.Selector)) *SearchDocumentSelect {
+	sdq.modifiers = append(sdq.modifiers, modifiers...)
+	return sdq.Select()
+}
+
+// SearchDocumentGroupBy is the group-by builder for SearchDocument entities.
+type SearchDocumentGroupBy struct {
+	selector
+	build *SearchDocumentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sdgb *SearchDocumentGroupBy) Aggregate(fns ...AggregateFunc) *SearchDocumentGroupBy {
+	sdgb.fns = append(sdgb.fns, fns...)
+	return sdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sdgb *SearchDocumentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchDocumentQuery, *SearchDocumentGroupBy](ctx, sdgb.build, sdgb, sdgb.build.inters, v)
+}
+
+func (sdgb *SearchDocumentGroupBy) sqlScan(ctx context.Context, root *SearchDocumentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sdgb.fns))
+	for _, fn := range sdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sdgb.flds)+len(sdgb.fns))
+		for _, f := range *sdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SearchDocumentSelect is the builder for selecting fields of SearchDocument entities.
+type SearchDocumentSelect struct {
+	*SearchDocumentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sds *SearchDocumentSelect) Aggregate(fns ...AggregateFunc) *SearchDocumentSelect {
+	sds.fns = append(sds.fns, fns...)
+	return sds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sds *SearchDocumentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sds.ctx, ent.OpQuerySelect)
+	if err := sds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchDocumentQuery, *SearchDocumentSelect](ctx, sds.SearchDocumentQuery, sds, sds.inters, v)
+}
+
+func (sds *SearchDocumentSelect) sqlScan(ctx context.Context, root *SearchDocumentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sds.fns))
+	for _, fn := range sds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sds *SearchDocumentSelect) Modify(modifiers ...func(s *sql.Selector)) *SearchDocumentSelect {
+	sds.modifiers = append(sds.modifiers, modifiers...)
+	return sds
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/searchdocument"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentUpdate is the builder for updating SearchDocument entities.
+type SearchDocumentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SearchDocumentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SearchDocumentUpdate builder.
+func (sdu *SearchDocumentUpdate) Where(ps ...predicate.SearchDocument) *SearchDocumentUpdate {
+	sdu.mutation.Where(ps...)
+	return sdu
+}
+
+// SetUserID sets the "user_id" field.
+func (sdu *SearchDocumentUpdate) SetUserID(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetUserID(s)
+	return sdu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableUserID(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetUserID(*s)
+	}
+	return sdu
+}
+
+// SetKind sets the "kind" field.
+func (sdu *SearchDocumentUpdate) SetKind(s searchdocument.Kind) *SearchDocumentUpdate {
+	sdu.mutation.SetKind(s)
+	return sdu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableKind(s *searchdocument.Kind) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetKind(*s)
+	}
+	return sdu
+}
+
+// SetTargetID sets the "target_id" field.
+func (sdu *SearchDocumentUpdate) SetTargetID(u uint64) *SearchDocumentUpdate {
+	sdu.mutation.ResetTargetID()
+	sdu.mutation.SetTargetID(u)
+	return sdu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableTargetID(u *uint64) *SearchDocumentUpdate {
+	if u != nil {
+		sdu.SetTargetID(*u)
+	}
+	return sdu
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (sdu *SearchDocumentUpdate) AddTargetID(u int64) *SearchDocumentUpdate {
+	sdu.mutation.AddTargetID(u)
+	return sdu
+}
+
+// SetStepID sets the "step_id" field.
+func (sdu *SearchDocumentUpdate) SetStepID(u uint64) *SearchDocumentUpdate {
+	sdu.mutation.ResetStepID()
+	sdu.mutation.SetStepID(u)
+	return sdu
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableStepID(u *uint64) *SearchDocumentUpdate {
+	if u != nil {
+		sdu.SetStepID(*u)
+	}
+	return sdu
+}
+
+// AddStepID adds u to the "step_id" field.
+func (sdu *SearchDocumentUpdate) AddStepID(u int64) *SearchDocumentUpdate {
+	sdu.mutation.AddStepID(u)
+	return sdu
+}
+
+// SetCommentRole sets the "comment_role" field.
+func (sdu *SearchDocumentUpdate) SetCommentRole(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetCommentRole(s)
+	return sdu
+}
+
+// SetNillableCommentRole sets the "comment_role" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableCommentRole(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetCommentRole(*s)
+	}
+	return sdu
+}
+
+// SetTitle sets the "title" field.
+func (sdu *SearchDocumentUpdate) SetTitle(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetTitle(s)
+	return sdu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableTitle(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetTitle(*s)
+	}
+	return sdu
+}
+
+// SetContent sets the "content" field.
+func (sdu *SearchDocumentUpdate) SetContent(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetContent(s)
+	return sdu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableContent(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetContent(*s)
+	}
+	return sdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sdu *SearchDocumentUpdate) SetCreatedAt(i int64) *SearchDocumentUpdate {
+	sdu.mutation.ResetCreatedAt()
+	sdu.mutation.SetCreatedAt(i)
+	return sdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableCreatedAt(i *int64) *SearchDocumentUpdate {
+	if i != nil {
+		sdu.SetCreatedAt(*i)
+	}
+	return sdu
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (sdu *SearchDocumentUpdate) AddCreatedAt(i int64) *SearchDocumentUpdate {
+	sdu.mutation.AddCreatedAt(i)
+	return sdu
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (sdu *SearchDocumentUpdate) Mutation() *SearchDocumentMutation {
+	return sdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sdu *SearchDocumentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdu.sqlSave, sdu.mutation, sdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdu *SearchDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := sdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sdu *SearchDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := sdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdu *SearchDocumentUpdate) ExecX(ctx context.Context) {
+	if err := sdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdu *SearchDocumentUpdate) check() error {
+	if v, ok := sdu.mutation.Kind(); ok {
+		if err := searchdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sdu *SearchDocumentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchDocumentUpdate {
+	sdu.modifiers = append(sdu.modifiers, modifiers...)
+	return sdu
+}
+
+func (sdu *SearchDocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeUint64))
+	if ps := sdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sdu.mutation.UserID(); ok {
+		_spec.SetField(searchdocument.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Kind(); ok {
+		_spec.SetField(searchdocument.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := sdu.mutation.TargetID(); ok {
+		_spec.SetField(searchdocument.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := sdu.mutation.AddedTargetID(); ok {
+		_spec.AddField(searchdocument.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := sdu.mutation.StepID(); ok {
+		_spec.SetField(searchdocument.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := sdu.mutation.AddedStepID(); ok {
+		_spec.AddField(searchdocument.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := sdu.mutation.CommentRole(); ok {
+		_spec.SetField(searchdocument.FieldCommentRole, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Content(); ok {
+		_spec.SetField(searchdocument.FieldContent, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.CreatedAt(); ok {
+		_spec.SetField(searchdocument.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := sdu.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(searchdocument.FieldCreatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(sdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sdu.mutation.done = true
+	return n, nil
+}
+
+// SearchDocumentUpdateOne is the builder for updating a single SearchDocument entity.
+type SearchDocumentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SearchDocumentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (sduo *SearchDocumentUpdateOne) SetUserID(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetUserID(s)
+	return sduo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableUserID(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetUserID(*s)
+	}
+	return sduo
+}
+
+// SetKind sets the "kind" field.
+func (sduo *SearchDocumentUpdateOne) SetKind(s searchdocument.Kind) *SearchDocumentUpdateOne {
+	sduo.mutation.SetKind(s)
+	return sduo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableKind(s *searchdocument.Kind) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetKind(*s)
+	}
+	return sduo
+}
+
+// SetTargetID sets the "target_id" field.
+func (sduo *SearchDocumentUpdateOne) SetTargetID(u uint64) *SearchDocumentUpdateOne {
+	sduo.mutation.ResetTargetID()
+	sduo.mutation.SetTargetID(u)
+	return sduo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableTargetID(u *uint64) *SearchDocumentUpdateOne {
+	if u != nil {
+		sduo.SetTargetID(*u)
+	}
+	return sduo
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (sduo *SearchDocumentUpdateOne) AddTargetID(u int64) *SearchDocumentUpdateOne {
+	sduo.mutation.AddTargetID(u)
+	return sduo
+}
+
+// SetStepID sets the "step_id" field.
+func (sduo *SearchDocumentUpdateOne) SetStepID(u uint64) *SearchDocumentUpdateOne {
+	sduo.mutation.ResetStepID()
+	sduo.mutation.SetStepID(u)
+	return sduo
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableStepID(u *uint64) *SearchDocumentUpdateOne {
+	if u != nil {
+		sduo.SetStepID(*u)
+	}
+	return sduo
+}
+
+// AddStepID adds u to the "step_id" field.
+func (sduo *SearchDocumentUpdateOne) AddStepID(u int64) *SearchDocumentUpdateOne {
+	sduo.mutation.AddStepID(u)
+	return sduo
+}
+
+// SetCommentRole sets the "comment_role" field.
+func (sduo *SearchDocumentUpdateOne) SetCommentRole(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetCommentRole(s)
+	return sduo
+}
+
+// SetNillableCommentRole sets the "comment_role" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableCommentRole(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetCommentRole(*s)
+	}
+	return sduo
+}
+
+// SetTitle sets the "title" field.
+func (sduo *SearchDocumentUpdateOne) SetTitle(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetTitle(s)
+	return sduo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableTitle(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetTitle(*s)
+	}
+	return sduo
+}
+
+// SetContent sets the "content" field.
+func (sduo *SearchDocumentUpdateOne) SetContent(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetContent(s)
+	return sduo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableContent(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetContent(*s)
+	}
+	return sduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sduo *SearchDocumentUpdateOne) SetCreatedAt(i int64) *SearchDocumentUpdateOne {
+	sduo.mutation.ResetCreatedAt()
+	sduo.mutation.SetCreatedAt(i)
+	return sduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableCreatedAt(i *int64) *SearchDocumentUpdateOne {
+	if i != nil {
+		sduo.SetCreatedAt(*i)
+	}
+	return sduo
+}
+
+// AddCreatedAt adds i to the "created_at" field.
+func (sduo *SearchDocumentUpdateOne) AddCreatedAt(i int64) *SearchDocumentUpdateOne {
+	sduo.mutation.AddCreatedAt(i)
+	return sduo
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (sduo *SearchDocumentUpdateOne) Mutation() *SearchDocumentMutation {
+	return sduo.mutation
+}
+
+// Where appends a list predicates to the SearchDocumentUpdate builder.
+func (sduo *SearchDocumentUpdateOne) Where(ps ...predicate.SearchDocument) *SearchDocumentUpdateOne {
+	sduo.mutation.Where(ps...)
+	return sduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sduo *SearchDocumentUpdateOne) Select(field string, fields ...string) *SearchDocumentUpdateOne {
+	sduo.fields = append([]string{field}, fields...)
+	return sduo
+}
+
+// Save executes the query and returns the updated SearchDocument entity.
+func (sduo *SearchDocumentUpdateOne) Save(ctx context.Context) (*SearchDocument, error) {
+	return withHooks(ctx, sduo.sqlSave, sduo.mutation, sduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sduo *SearchDocumentUpdateOne) SaveX(ctx context.Context) *SearchDocument {
+	node, err := sduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sduo *SearchDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := sduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sduo *SearchDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := sduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sduo *SearchDocumentUpdateOne) check() error {
+	if v, ok := sduo.mutation.Kind(); ok {
+		if err := searchdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sduo *SearchDocumentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchDocumentUpdateOne {
+	sduo.modifiers = append(sduo.modifiers, modifiers...)
+	return sduo
+}
+
+func (sduo *SearchDocumentUpdateOne) sqlSave(ctx context.Context) (_node *SearchDocument, err error) {
+	if err := sduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeUint64))
+	id, ok := sduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchdocument.FieldID)
+		for _, f := range fields {
+			if !searchdocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sduo.mutation.UserID(); ok {
+		_spec.SetField(searchdocument.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Kind(); ok {
+		_spec.SetField(searchdocument.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := sduo.mutation.TargetID(); ok {
+		_spec.SetField(searchdocument.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := sduo.mutation.AddedTargetID(); ok {
+		_spec.AddField(searchdocument.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := sduo.mutation.StepID(); ok {
+		_spec.SetField(searchdocument.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := sduo.mutation.AddedStepID(); ok {
+		_spec.AddField(searchdocument.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := sduo.mutation.CommentRole(); ok {
+		_spec.SetField(searchdocument.FieldCommentRole, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Content(); ok {
+		_spec.SetField(searchdocument.FieldContent, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.CreatedAt(); ok {
+		_spec.SetField(searchdocument.FieldCreatedAt, field.TypeInt64, value)
+	}
+	if value, ok := sduo.mutation.AddedCreatedAt(); ok {
+		_spec.AddField(searchdocument.FieldCreatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(sduo.modifiers...)
+	_node = &SearchDocument{config: sduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sduo.mutation.done = true
+	return _node, nil
+}
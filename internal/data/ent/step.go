@@ -25,12 +25,16 @@ type Step struct {
 	Description string `json:"description,omitempty"`
 	// 是否挑战
 	IsChallenge bool `json:"is_challenge,omitempty"`
-	// 老师评论
+	// 已废弃, 老师最新的评分, 评论保存在comments表
 	TeacherComment map[string]interface{} `json:"teacher_comment,omitempty"`
-	// 家长评论
+	// 已废弃, 家长最新的评分, 评论保存在comments表
 	ParentComment map[string]interface{} `json:"parent_comment,omitempty"`
-	// 朋友评论
+	// 已废弃, 朋友最新的评分, 评论保存在comments表
 	FriendComment map[string]interface{} `json:"friend_comment,omitempty"`
+	// 已废弃, 评论的老师User ID
+	TeacherReviewerID string `json:"teacher_reviewer_id,omitempty"`
+	// 已废弃, 评论的家长User ID
+	ParentReviewerID string `json:"parent_reviewer_id,omitempty"`
 	// 类型
 	Type step.Type `json:"type,omitempty"`
 	// 对象名
@@ -41,6 +45,26 @@ type Step struct {
 	RefTargetID uint64 `json:"ref_target_id,omitempty"`
 	// 父ID
 	ParentID uint64 `json:"parent_id,omitempty"`
+	// 计量值
+	Measurement float64 `json:"measurement,omitempty"`
+	// 文字内容(markdown)
+	Content string `json:"content,omitempty"`
+	// 链接
+	URL string `json:"url,omitempty"`
+	// 链接标题
+	LinkTitle string `json:"link_title,omitempty"`
+	// 链接描述
+	LinkDescription string `json:"link_description,omitempty"`
+	// 文件MIME类型
+	MimeType string `json:"mime_type,omitempty"`
+	// 文档页数
+	PageCount uint32 `json:"page_count,omitempty"`
+	// 预览图对象名
+	PreviewObjectName string `json:"preview_object_name,omitempty"`
+	// 手动排序, 0为未排序
+	SortOrder float64 `json:"sort_order,omitempty"`
+	// 祖先目录ID路径, 如/3/7/, 不在目录中为/
+	Path string `json:"path,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the StepQuery when eager-loading is set.
 	Edges        StepEdges `json:"edges"`
@@ -55,9 +79,11 @@ type StepEdges struct {
 	Parent *Step `json:"parent,omitempty"`
 	// Children holds the value of the children edge.
 	Children []*Step `json:"children,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // TargetOrErr returns the Target value or an error if the edge
@@ -91,6 +117,15 @@ func (e StepEdges) ChildrenOrErr() ([]*Step, error) {
 	return nil, &NotLoadedError{edge: "children"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e StepEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Step) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -100,9 +135,11 @@ func (*Step) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case step.FieldIsChallenge:
 			values[i] = new(sql.NullBool)
-		case step.FieldID, step.FieldCreatedAt, step.FieldRefTargetID, step.FieldParentID:
+		case step.FieldMeasurement, step.FieldSortOrder:
+			values[i] = new(sql.NullFloat64)
+		case step.FieldID, step.FieldCreatedAt, step.FieldRefTargetID, step.FieldParentID, step.FieldPageCount:
 			values[i] = new(sql.NullInt64)
-		case step.FieldTitle, step.FieldDescription, step.FieldType, step.FieldObjectName:
+		case step.FieldTitle, step.FieldDescription, step.FieldTeacherReviewerID, step.FieldParentReviewerID, step.FieldType, step.FieldObjectName, step.FieldContent, step.FieldURL, step.FieldLinkTitle, step.FieldLinkDescription, step.FieldMimeType, step.FieldPreviewObjectName, step.FieldPath:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -167,6 +204,18 @@ func (s *Step) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field friend_comment: %w", err)
 				}
 			}
+		case step.FieldTeacherReviewerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field teacher_reviewer_id", values[i])
+			} else if value.Valid {
+				s.TeacherReviewerID = value.String
+			}
+		case step.FieldParentReviewerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_reviewer_id", values[i])
+			} else if value.Valid {
+				s.ParentReviewerID = value.String
+			}
 		case step.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type", values[i])
@@ -197,6 +246,66 @@ func (s *Step) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.ParentID = uint64(value.Int64)
 			}
+		case step.FieldMeasurement:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field measurement", values[i])
+			} else if value.Valid {
+				s.Measurement = value.Float64
+			}
+		case step.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				s.Content = value.String
+			}
+		case step.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				s.URL = value.String
+			}
+		case step.FieldLinkTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link_title", values[i])
+			} else if value.Valid {
+				s.LinkTitle = value.String
+			}
+		case step.FieldLinkDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link_description", values[i])
+			} else if value.Valid {
+				s.LinkDescription = value.String
+			}
+		case step.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				s.MimeType = value.String
+			}
+		case step.FieldPageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field page_count", values[i])
+			} else if value.Valid {
+				s.PageCount = uint32(value.Int64)
+			}
+		case step.FieldPreviewObjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_object_name", values[i])
+			} else if value.Valid {
+				s.PreviewObjectName = value.String
+			}
+		case step.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				s.SortOrder = value.Float64
+			}
+		case step.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				s.Path = value.String
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -225,6 +334,11 @@ func (s *Step) QueryChildren() *StepQuery {
 	return NewStepClient(s.config).QueryChildren(s)
 }
 
+// QueryTags queries the "tags" edge of the Step entity.
+func (s *Step) QueryTags() *TagQuery {
+	return NewStepClient(s.config).QueryTags(s)
+}
+
 // Update returns a builder for updating this Step.
 // Note that you need to call Step.Unwrap() before calling this method if this Step
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -266,6 +380,12 @@ func (s *Step) String() string {
 	builder.WriteString("friend_comment=")
 	builder.WriteString(fmt.Sprintf("%v", s.FriendComment))
 	builder.WriteString(", ")
+	builder.WriteString("teacher_reviewer_id=")
+	builder.WriteString(s.TeacherReviewerID)
+	builder.WriteString(", ")
+	builder.WriteString("parent_reviewer_id=")
+	builder.WriteString(s.ParentReviewerID)
+	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", s.Type))
 	builder.WriteString(", ")
@@ -280,6 +400,36 @@ func (s *Step) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("parent_id=")
 	builder.WriteString(fmt.Sprintf("%v", s.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("measurement=")
+	builder.WriteString(fmt.Sprintf("%v", s.Measurement))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(s.Content)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(s.URL)
+	builder.WriteString(", ")
+	builder.WriteString("link_title=")
+	builder.WriteString(s.LinkTitle)
+	builder.WriteString(", ")
+	builder.WriteString("link_description=")
+	builder.WriteString(s.LinkDescription)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(s.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("page_count=")
+	builder.WriteString(fmt.Sprintf("%v", s.PageCount))
+	builder.WriteString(", ")
+	builder.WriteString("preview_object_name=")
+	builder.WriteString(s.PreviewObjectName)
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", s.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(s.Path)
 	builder.WriteByte(')')
 	return builder.String()
 }
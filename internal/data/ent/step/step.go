@@ -26,6 +26,10 @@ const (
 	FieldParentComment = "parent_comment"
 	// FieldFriendComment holds the string denoting the friend_comment field in the database.
 	FieldFriendComment = "friend_comment"
+	// FieldTeacherReviewerID holds the string denoting the teacher_reviewer_id field in the database.
+	FieldTeacherReviewerID = "teacher_reviewer_id"
+	// FieldParentReviewerID holds the string denoting the parent_reviewer_id field in the database.
+	FieldParentReviewerID = "parent_reviewer_id"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldObjectName holds the string denoting the object_name field in the database.
@@ -36,12 +40,34 @@ const (
 	FieldRefTargetID = "ref_target_id"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldMeasurement holds the string denoting the measurement field in the database.
+	FieldMeasurement = "measurement"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldLinkTitle holds the string denoting the link_title field in the database.
+	FieldLinkTitle = "link_title"
+	// FieldLinkDescription holds the string denoting the link_description field in the database.
+	FieldLinkDescription = "link_description"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
+	// FieldPageCount holds the string denoting the page_count field in the database.
+	FieldPageCount = "page_count"
+	// FieldPreviewObjectName holds the string denoting the preview_object_name field in the database.
+	FieldPreviewObjectName = "preview_object_name"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
 	// EdgeTarget holds the string denoting the target edge name in mutations.
 	EdgeTarget = "target"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
 	EdgeChildren = "children"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the step in the database.
 	Table = "steps"
 	// TargetTable is the table that holds the target relation/edge.
@@ -59,6 +85,11 @@ const (
 	ChildrenTable = "steps"
 	// ChildrenColumn is the table column denoting the children relation/edge.
 	ChildrenColumn = "parent_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_steps"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for step fields.
@@ -70,13 +101,31 @@ var Columns = []string{
 	FieldTeacherComment,
 	FieldParentComment,
 	FieldFriendComment,
+	FieldTeacherReviewerID,
+	FieldParentReviewerID,
 	FieldType,
 	FieldObjectName,
 	FieldCreatedAt,
 	FieldRefTargetID,
 	FieldParentID,
+	FieldMeasurement,
+	FieldContent,
+	FieldURL,
+	FieldLinkTitle,
+	FieldLinkDescription,
+	FieldMimeType,
+	FieldPageCount,
+	FieldPreviewObjectName,
+	FieldSortOrder,
+	FieldPath,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "step_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -96,6 +145,18 @@ var (
 	DefaultIsChallenge bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt int64
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// LinkTitleValidator is a validator for the "link_title" field. It is called by the builders before save.
+	LinkTitleValidator func(string) error
+	// LinkDescriptionValidator is a validator for the "link_description" field. It is called by the builders before save.
+	LinkDescriptionValidator func(string) error
+	// MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
+	MimeTypeValidator func(string) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder float64
+	// DefaultPath holds the default value on creation for the "path" field.
+	DefaultPath string
 )
 
 // Type defines the type for the "type" enum field.
@@ -103,10 +164,14 @@ type Type string
 
 // Type values.
 const (
-	TypeImage Type = "image"
-	TypeVideo Type = "video"
-	TypeAudio Type = "audio"
-	TypeDir   Type = "dir"
+	TypeImage       Type = "image"
+	TypeVideo       Type = "video"
+	TypeAudio       Type = "audio"
+	TypeDir         Type = "dir"
+	TypeMeasurement Type = "measurement"
+	TypeNote        Type = "note"
+	TypeLink        Type = "link"
+	TypeDocument    Type = "document"
 )
 
 func (_type Type) String() string {
@@ -116,7 +181,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeImage, TypeVideo, TypeAudio, TypeDir:
+	case TypeImage, TypeVideo, TypeAudio, TypeDir, TypeMeasurement, TypeNote, TypeLink, TypeDocument:
 		return nil
 	default:
 		return fmt.Errorf("step: invalid enum value for type field: %q", _type)
@@ -146,6 +211,16 @@ func ByIsChallenge(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsChallenge, opts...).ToFunc()
 }
 
+// ByTeacherReviewerID orders the results by the teacher_reviewer_id field.
+func ByTeacherReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeacherReviewerID, opts...).ToFunc()
+}
+
+// ByParentReviewerID orders the results by the parent_reviewer_id field.
+func ByParentReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentReviewerID, opts...).ToFunc()
+}
+
 // ByType orders the results by the type field.
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
@@ -171,6 +246,56 @@ func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
+// ByMeasurement orders the results by the measurement field.
+func ByMeasurement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeasurement, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByLinkTitle orders the results by the link_title field.
+func ByLinkTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkTitle, opts...).ToFunc()
+}
+
+// ByLinkDescription orders the results by the link_description field.
+func ByLinkDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLinkDescription, opts...).ToFunc()
+}
+
+// ByMimeType orders the results by the mime_type field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
+// ByPageCount orders the results by the page_count field.
+func ByPageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPageCount, opts...).ToFunc()
+}
+
+// ByPreviewObjectName orders the results by the preview_object_name field.
+func ByPreviewObjectName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewObjectName, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
 // ByTargetField orders the results by target field.
 func ByTargetField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -198,6 +323,20 @@ func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTargetStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -219,3 +358,10 @@ func newChildrenStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
 	)
 }
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
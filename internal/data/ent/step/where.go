@@ -69,6 +69,16 @@ func IsChallenge(v bool) predicate.Step {
 	return predicate.Step(sql.FieldEQ(FieldIsChallenge, v))
 }
 
+// TeacherReviewerID applies equality check predicate on the "teacher_reviewer_id" field. It's identical to TeacherReviewerIDEQ.
+func TeacherReviewerID(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldTeacherReviewerID, v))
+}
+
+// ParentReviewerID applies equality check predicate on the "parent_reviewer_id" field. It's identical to ParentReviewerIDEQ.
+func ParentReviewerID(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldParentReviewerID, v))
+}
+
 // ObjectName applies equality check predicate on the "object_name" field. It's identical to ObjectNameEQ.
 func ObjectName(v string) predicate.Step {
 	return predicate.Step(sql.FieldEQ(FieldObjectName, v))
@@ -89,6 +99,56 @@ func ParentID(v uint64) predicate.Step {
 	return predicate.Step(sql.FieldEQ(FieldParentID, v))
 }
 
+// Measurement applies equality check predicate on the "measurement" field. It's identical to MeasurementEQ.
+func Measurement(v float64) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldMeasurement, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldContent, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldURL, v))
+}
+
+// LinkTitle applies equality check predicate on the "link_title" field. It's identical to LinkTitleEQ.
+func LinkTitle(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldLinkTitle, v))
+}
+
+// LinkDescription applies equality check predicate on the "link_description" field. It's identical to LinkDescriptionEQ.
+func LinkDescription(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldLinkDescription, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldMimeType, v))
+}
+
+// PageCount applies equality check predicate on the "page_count" field. It's identical to PageCountEQ.
+func PageCount(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldPageCount, v))
+}
+
+// PreviewObjectName applies equality check predicate on the "preview_object_name" field. It's identical to PreviewObjectNameEQ.
+func PreviewObjectName(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldPreviewObjectName, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v float64) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldPath, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Step {
 	return predicate.Step(sql.FieldEQ(FieldTitle, v))
@@ -279,6 +339,156 @@ func FriendCommentNotNil() predicate.Step {
 	return predicate.Step(sql.FieldNotNull(FieldFriendComment))
 }
 
+// TeacherReviewerIDEQ applies the EQ predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDNEQ applies the NEQ predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDIn applies the In predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldTeacherReviewerID, vs...))
+}
+
+// TeacherReviewerIDNotIn applies the NotIn predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldTeacherReviewerID, vs...))
+}
+
+// TeacherReviewerIDGT applies the GT predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDGTE applies the GTE predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDLT applies the LT predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDLTE applies the LTE predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDContains applies the Contains predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDHasPrefix applies the HasPrefix predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDHasSuffix applies the HasSuffix predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDIsNil applies the IsNil predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldTeacherReviewerID))
+}
+
+// TeacherReviewerIDNotNil applies the NotNil predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldTeacherReviewerID))
+}
+
+// TeacherReviewerIDEqualFold applies the EqualFold predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldTeacherReviewerID, v))
+}
+
+// TeacherReviewerIDContainsFold applies the ContainsFold predicate on the "teacher_reviewer_id" field.
+func TeacherReviewerIDContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldTeacherReviewerID, v))
+}
+
+// ParentReviewerIDEQ applies the EQ predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDNEQ applies the NEQ predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDIn applies the In predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldParentReviewerID, vs...))
+}
+
+// ParentReviewerIDNotIn applies the NotIn predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldParentReviewerID, vs...))
+}
+
+// ParentReviewerIDGT applies the GT predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDGTE applies the GTE predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDLT applies the LT predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDLTE applies the LTE predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDContains applies the Contains predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDHasPrefix applies the HasPrefix predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDHasSuffix applies the HasSuffix predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDIsNil applies the IsNil predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldParentReviewerID))
+}
+
+// ParentReviewerIDNotNil applies the NotNil predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldParentReviewerID))
+}
+
+// ParentReviewerIDEqualFold applies the EqualFold predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldParentReviewerID, v))
+}
+
+// ParentReviewerIDContainsFold applies the ContainsFold predicate on the "parent_reviewer_id" field.
+func ParentReviewerIDContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldParentReviewerID, v))
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.Step {
 	return predicate.Step(sql.FieldEQ(FieldType, v))
@@ -474,6 +684,661 @@ func ParentIDNotNil() predicate.Step {
 	return predicate.Step(sql.FieldNotNull(FieldParentID))
 }
 
+// MeasurementEQ applies the EQ predicate on the "measurement" field.
+func MeasurementEQ(v float64) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldMeasurement, v))
+}
+
+// MeasurementNEQ applies the NEQ predicate on the "measurement" field.
+func MeasurementNEQ(v float64) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldMeasurement, v))
+}
+
+// MeasurementIn applies the In predicate on the "measurement" field.
+func MeasurementIn(vs ...float64) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldMeasurement, vs...))
+}
+
+// MeasurementNotIn applies the NotIn predicate on the "measurement" field.
+func MeasurementNotIn(vs ...float64) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldMeasurement, vs...))
+}
+
+// MeasurementGT applies the GT predicate on the "measurement" field.
+func MeasurementGT(v float64) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldMeasurement, v))
+}
+
+// MeasurementGTE applies the GTE predicate on the "measurement" field.
+func MeasurementGTE(v float64) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldMeasurement, v))
+}
+
+// MeasurementLT applies the LT predicate on the "measurement" field.
+func MeasurementLT(v float64) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldMeasurement, v))
+}
+
+// MeasurementLTE applies the LTE predicate on the "measurement" field.
+func MeasurementLTE(v float64) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldMeasurement, v))
+}
+
+// MeasurementIsNil applies the IsNil predicate on the "measurement" field.
+func MeasurementIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldMeasurement))
+}
+
+// MeasurementNotNil applies the NotNil predicate on the "measurement" field.
+func MeasurementNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldMeasurement))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldContent, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldURL, v))
+}
+
+// LinkTitleEQ applies the EQ predicate on the "link_title" field.
+func LinkTitleEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldLinkTitle, v))
+}
+
+// LinkTitleNEQ applies the NEQ predicate on the "link_title" field.
+func LinkTitleNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldLinkTitle, v))
+}
+
+// LinkTitleIn applies the In predicate on the "link_title" field.
+func LinkTitleIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldLinkTitle, vs...))
+}
+
+// LinkTitleNotIn applies the NotIn predicate on the "link_title" field.
+func LinkTitleNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldLinkTitle, vs...))
+}
+
+// LinkTitleGT applies the GT predicate on the "link_title" field.
+func LinkTitleGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldLinkTitle, v))
+}
+
+// LinkTitleGTE applies the GTE predicate on the "link_title" field.
+func LinkTitleGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldLinkTitle, v))
+}
+
+// LinkTitleLT applies the LT predicate on the "link_title" field.
+func LinkTitleLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldLinkTitle, v))
+}
+
+// LinkTitleLTE applies the LTE predicate on the "link_title" field.
+func LinkTitleLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldLinkTitle, v))
+}
+
+// LinkTitleContains applies the Contains predicate on the "link_title" field.
+func LinkTitleContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldLinkTitle, v))
+}
+
+// LinkTitleHasPrefix applies the HasPrefix predicate on the "link_title" field.
+func LinkTitleHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldLinkTitle, v))
+}
+
+// LinkTitleHasSuffix applies the HasSuffix predicate on the "link_title" field.
+func LinkTitleHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldLinkTitle, v))
+}
+
+// LinkTitleIsNil applies the IsNil predicate on the "link_title" field.
+func LinkTitleIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldLinkTitle))
+}
+
+// LinkTitleNotNil applies the NotNil predicate on the "link_title" field.
+func LinkTitleNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldLinkTitle))
+}
+
+// LinkTitleEqualFold applies the EqualFold predicate on the "link_title" field.
+func LinkTitleEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldLinkTitle, v))
+}
+
+// LinkTitleContainsFold applies the ContainsFold predicate on the "link_title" field.
+func LinkTitleContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldLinkTitle, v))
+}
+
+// LinkDescriptionEQ applies the EQ predicate on the "link_description" field.
+func LinkDescriptionEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldLinkDescription, v))
+}
+
+// LinkDescriptionNEQ applies the NEQ predicate on the "link_description" field.
+func LinkDescriptionNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldLinkDescription, v))
+}
+
+// LinkDescriptionIn applies the In predicate on the "link_description" field.
+func LinkDescriptionIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldLinkDescription, vs...))
+}
+
+// LinkDescriptionNotIn applies the NotIn predicate on the "link_description" field.
+func LinkDescriptionNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldLinkDescription, vs...))
+}
+
+// LinkDescriptionGT applies the GT predicate on the "link_description" field.
+func LinkDescriptionGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldLinkDescription, v))
+}
+
+// LinkDescriptionGTE applies the GTE predicate on the "link_description" field.
+func LinkDescriptionGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldLinkDescription, v))
+}
+
+// LinkDescriptionLT applies the LT predicate on the "link_description" field.
+func LinkDescriptionLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldLinkDescription, v))
+}
+
+// LinkDescriptionLTE applies the LTE predicate on the "link_description" field.
+func LinkDescriptionLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldLinkDescription, v))
+}
+
+// LinkDescriptionContains applies the Contains predicate on the "link_description" field.
+func LinkDescriptionContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldLinkDescription, v))
+}
+
+// LinkDescriptionHasPrefix applies the HasPrefix predicate on the "link_description" field.
+func LinkDescriptionHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldLinkDescription, v))
+}
+
+// LinkDescriptionHasSuffix applies the HasSuffix predicate on the "link_description" field.
+func LinkDescriptionHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldLinkDescription, v))
+}
+
+// LinkDescriptionIsNil applies the IsNil predicate on the "link_description" field.
+func LinkDescriptionIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldLinkDescription))
+}
+
+// LinkDescriptionNotNil applies the NotNil predicate on the "link_description" field.
+func LinkDescriptionNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldLinkDescription))
+}
+
+// LinkDescriptionEqualFold applies the EqualFold predicate on the "link_description" field.
+func LinkDescriptionEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldLinkDescription, v))
+}
+
+// LinkDescriptionContainsFold applies the ContainsFold predicate on the "link_description" field.
+func LinkDescriptionContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldLinkDescription, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeIsNil applies the IsNil predicate on the "mime_type" field.
+func MimeTypeIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldMimeType))
+}
+
+// MimeTypeNotNil applies the NotNil predicate on the "mime_type" field.
+func MimeTypeNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldMimeType))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// PageCountEQ applies the EQ predicate on the "page_count" field.
+func PageCountEQ(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldPageCount, v))
+}
+
+// PageCountNEQ applies the NEQ predicate on the "page_count" field.
+func PageCountNEQ(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldPageCount, v))
+}
+
+// PageCountIn applies the In predicate on the "page_count" field.
+func PageCountIn(vs ...uint32) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldPageCount, vs...))
+}
+
+// PageCountNotIn applies the NotIn predicate on the "page_count" field.
+func PageCountNotIn(vs ...uint32) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldPageCount, vs...))
+}
+
+// PageCountGT applies the GT predicate on the "page_count" field.
+func PageCountGT(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldPageCount, v))
+}
+
+// PageCountGTE applies the GTE predicate on the "page_count" field.
+func PageCountGTE(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldPageCount, v))
+}
+
+// PageCountLT applies the LT predicate on the "page_count" field.
+func PageCountLT(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldPageCount, v))
+}
+
+// PageCountLTE applies the LTE predicate on the "page_count" field.
+func PageCountLTE(v uint32) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldPageCount, v))
+}
+
+// PageCountIsNil applies the IsNil predicate on the "page_count" field.
+func PageCountIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldPageCount))
+}
+
+// PageCountNotNil applies the NotNil predicate on the "page_count" field.
+func PageCountNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldPageCount))
+}
+
+// PreviewObjectNameEQ applies the EQ predicate on the "preview_object_name" field.
+func PreviewObjectNameEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameNEQ applies the NEQ predicate on the "preview_object_name" field.
+func PreviewObjectNameNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameIn applies the In predicate on the "preview_object_name" field.
+func PreviewObjectNameIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldPreviewObjectName, vs...))
+}
+
+// PreviewObjectNameNotIn applies the NotIn predicate on the "preview_object_name" field.
+func PreviewObjectNameNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldPreviewObjectName, vs...))
+}
+
+// PreviewObjectNameGT applies the GT predicate on the "preview_object_name" field.
+func PreviewObjectNameGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameGTE applies the GTE predicate on the "preview_object_name" field.
+func PreviewObjectNameGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameLT applies the LT predicate on the "preview_object_name" field.
+func PreviewObjectNameLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameLTE applies the LTE predicate on the "preview_object_name" field.
+func PreviewObjectNameLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameContains applies the Contains predicate on the "preview_object_name" field.
+func PreviewObjectNameContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameHasPrefix applies the HasPrefix predicate on the "preview_object_name" field.
+func PreviewObjectNameHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameHasSuffix applies the HasSuffix predicate on the "preview_object_name" field.
+func PreviewObjectNameHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameIsNil applies the IsNil predicate on the "preview_object_name" field.
+func PreviewObjectNameIsNil() predicate.Step {
+	return predicate.Step(sql.FieldIsNull(FieldPreviewObjectName))
+}
+
+// PreviewObjectNameNotNil applies the NotNil predicate on the "preview_object_name" field.
+func PreviewObjectNameNotNil() predicate.Step {
+	return predicate.Step(sql.FieldNotNull(FieldPreviewObjectName))
+}
+
+// PreviewObjectNameEqualFold applies the EqualFold predicate on the "preview_object_name" field.
+func PreviewObjectNameEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldPreviewObjectName, v))
+}
+
+// PreviewObjectNameContainsFold applies the ContainsFold predicate on the "preview_object_name" field.
+func PreviewObjectNameContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldPreviewObjectName, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v float64) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v float64) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...float64) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...float64) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v float64) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v float64) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v float64) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v float64) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Step {
+	return predicate.Step(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Step {
+	return predicate.Step(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Step {
+	return predicate.Step(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Step {
+	return predicate.Step(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Step {
+	return predicate.Step(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Step {
+	return predicate.Step(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Step {
+	return predicate.Step(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Step {
+	return predicate.Step(sql.FieldContainsFold(FieldPath, v))
+}
+
 // HasTarget applies the HasEdge predicate on the "target" edge.
 func HasTarget() predicate.Step {
 	return predicate.Step(func(s *sql.Selector) {
@@ -543,6 +1408,29 @@ func HasChildrenWith(preds ...predicate.Step) predicate.Step {
 	})
 }
 
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Step {
+	return predicate.Step(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Step {
+	return predicate.Step(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Step) predicate.Step {
 	return predicate.Step(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -80,6 +81,34 @@ func (sc *StepCreate) SetFriendComment(m map[string]interface{}) *StepCreate {
 	return sc
 }
 
+// SetTeacherReviewerID sets the "teacher_reviewer_id" field.
+func (sc *StepCreate) SetTeacherReviewerID(s string) *StepCreate {
+	sc.mutation.SetTeacherReviewerID(s)
+	return sc
+}
+
+// SetNillableTeacherReviewerID sets the "teacher_reviewer_id" field if the given value is not nil.
+func (sc *StepCreate) SetNillableTeacherReviewerID(s *string) *StepCreate {
+	if s != nil {
+		sc.SetTeacherReviewerID(*s)
+	}
+	return sc
+}
+
+// SetParentReviewerID sets the "parent_reviewer_id" field.
+func (sc *StepCreate) SetParentReviewerID(s string) *StepCreate {
+	sc.mutation.SetParentReviewerID(s)
+	return sc
+}
+
+// SetNillableParentReviewerID sets the "parent_reviewer_id" field if the given value is not nil.
+func (sc *StepCreate) SetNillableParentReviewerID(s *string) *StepCreate {
+	if s != nil {
+		sc.SetParentReviewerID(*s)
+	}
+	return sc
+}
+
 // SetType sets the "type" field.
 func (sc *StepCreate) SetType(s step.Type) *StepCreate {
 	sc.mutation.SetType(s)
@@ -142,6 +171,146 @@ func (sc *StepCreate) SetNillableParentID(u *uint64) *StepCreate {
 	return sc
 }
 
+// SetMeasurement sets the "measurement" field.
+func (sc *StepCreate) SetMeasurement(f float64) *StepCreate {
+	sc.mutation.SetMeasurement(f)
+	return sc
+}
+
+// SetNillableMeasurement sets the "measurement" field if the given value is not nil.
+func (sc *StepCreate) SetNillableMeasurement(f *float64) *StepCreate {
+	if f != nil {
+		sc.SetMeasurement(*f)
+	}
+	return sc
+}
+
+// SetContent sets the "content" field.
+func (sc *StepCreate) SetContent(s string) *StepCreate {
+	sc.mutation.SetContent(s)
+	return sc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sc *StepCreate) SetNillableContent(s *string) *StepCreate {
+	if s != nil {
+		sc.SetContent(*s)
+	}
+	return sc
+}
+
+// SetURL sets the "url" field.
+func (sc *StepCreate) SetURL(s string) *StepCreate {
+	sc.mutation.SetURL(s)
+	return sc
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (sc *StepCreate) SetNillableURL(s *string) *StepCreate {
+	if s != nil {
+		sc.SetURL(*s)
+	}
+	return sc
+}
+
+// SetLinkTitle sets the "link_title" field.
+func (sc *StepCreate) SetLinkTitle(s string) *StepCreate {
+	sc.mutation.SetLinkTitle(s)
+	return sc
+}
+
+// SetNillableLinkTitle sets the "link_title" field if the given value is not nil.
+func (sc *StepCreate) SetNillableLinkTitle(s *string) *StepCreate {
+	if s != nil {
+		sc.SetLinkTitle(*s)
+	}
+	return sc
+}
+
+// SetLinkDescription sets the "link_description" field.
+func (sc *StepCreate) SetLinkDescription(s string) *StepCreate {
+	sc.mutation.SetLinkDescription(s)
+	return sc
+}
+
+// SetNillableLinkDescription sets the "link_description" field if the given value is not nil.
+func (sc *StepCreate) SetNillableLinkDescription(s *string) *StepCreate {
+	if s != nil {
+		sc.SetLinkDescription(*s)
+	}
+	return sc
+}
+
+// SetMimeType sets the "mime_type" field.
+func (sc *StepCreate) SetMimeType(s string) *StepCreate {
+	sc.mutation.SetMimeType(s)
+	return sc
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (sc *StepCreate) SetNillableMimeType(s *string) *StepCreate {
+	if s != nil {
+		sc.SetMimeType(*s)
+	}
+	return sc
+}
+
+// SetPageCount sets the "page_count" field.
+func (sc *StepCreate) SetPageCount(u uint32) *StepCreate {
+	sc.mutation.SetPageCount(u)
+	return sc
+}
+
+// SetNillablePageCount sets the "page_count" field if the given value is not nil.
+func (sc *StepCreate) SetNillablePageCount(u *uint32) *StepCreate {
+	if u != nil {
+		sc.SetPageCount(*u)
+	}
+	return sc
+}
+
+// SetPreviewObjectName sets the "preview_object_name" field.
+func (sc *StepCreate) SetPreviewObjectName(s string) *StepCreate {
+	sc.mutation.SetPreviewObjectName(s)
+	return sc
+}
+
+// SetNillablePreviewObjectName sets the "preview_object_name" field if the given value is not nil.
+func (sc *StepCreate) SetNillablePreviewObjectName(s *string) *StepCreate {
+	if s != nil {
+		sc.SetPreviewObjectName(*s)
+	}
+	return sc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (sc *StepCreate) SetSortOrder(f float64) *StepCreate {
+	sc.mutation.SetSortOrder(f)
+	return sc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (sc *StepCreate) SetNillableSortOrder(f *float64) *StepCreate {
+	if f != nil {
+		sc.SetSortOrder(*f)
+	}
+	return sc
+}
+
+// SetPath sets the "path" field.
+func (sc *StepCreate) SetPath(s string) *StepCreate {
+	sc.mutation.SetPath(s)
+	return sc
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (sc *StepCreate) SetNillablePath(s *string) *StepCreate {
+	if s != nil {
+		sc.SetPath(*s)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *StepCreate) SetID(u uint64) *StepCreate {
 	sc.mutation.SetID(u)
@@ -187,6 +356,21 @@ func (sc *StepCreate) AddChildren(s ...*Step) *StepCreate {
 	return sc.AddChildIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (sc *StepCreate) AddTagIDs(ids ...uint64) *StepCreate {
+	sc.mutation.AddTagIDs(ids...)
+	return sc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (sc *StepCreate) AddTags(t ...*Tag) *StepCreate {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return sc.AddTagIDs(ids...)
+}
+
 // Mutation returns the StepMutation object of the builder.
 func (sc *StepCreate) Mutation() *StepMutation {
 	return sc.mutation
@@ -230,6 +414,14 @@ func (sc *StepCreate) defaults() {
 		v := step.DefaultCreatedAt
 		sc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := sc.mutation.SortOrder(); !ok {
+		v := step.DefaultSortOrder
+		sc.mutation.SetSortOrder(v)
+	}
+	if _, ok := sc.mutation.Path(); !ok {
+		v := step.DefaultPath
+		sc.mutation.SetPath(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -258,6 +450,32 @@ func (sc *StepCreate) check() error {
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Step.created_at"`)}
 	}
+	if v, ok := sc.mutation.URL(); ok {
+		if err := step.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Step.url": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.LinkTitle(); ok {
+		if err := step.LinkTitleValidator(v); err != nil {
+			return &ValidationError{Name: "link_title", err: fmt.Errorf(`ent: validator failed for field "Step.link_title": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.LinkDescription(); ok {
+		if err := step.LinkDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "link_description", err: fmt.Errorf(`ent: validator failed for field "Step.link_description": %w`, err)}
+		}
+	}
+	if v, ok := sc.mutation.MimeType(); ok {
+		if err := step.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Step.mime_type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Step.sort_order"`)}
+	}
+	if _, ok := sc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Step.path"`)}
+	}
 	return nil
 }
 
@@ -314,6 +532,14 @@ func (sc *StepCreate) createSpec() (*Step, *sqlgraph.CreateSpec) {
 		_spec.SetField(step.FieldFriendComment, field.TypeJSON, value)
 		_node.FriendComment = value
 	}
+	if value, ok := sc.mutation.TeacherReviewerID(); ok {
+		_spec.SetField(step.FieldTeacherReviewerID, field.TypeString, value)
+		_node.TeacherReviewerID = value
+	}
+	if value, ok := sc.mutation.ParentReviewerID(); ok {
+		_spec.SetField(step.FieldParentReviewerID, field.TypeString, value)
+		_node.ParentReviewerID = value
+	}
 	if value, ok := sc.mutation.GetType(); ok {
 		_spec.SetField(step.FieldType, field.TypeEnum, value)
 		_node.Type = value
@@ -326,6 +552,46 @@ func (sc *StepCreate) createSpec() (*Step, *sqlgraph.CreateSpec) {
 		_spec.SetField(step.FieldCreatedAt, field.TypeInt64, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := sc.mutation.Measurement(); ok {
+		_spec.SetField(step.FieldMeasurement, field.TypeFloat64, value)
+		_node.Measurement = value
+	}
+	if value, ok := sc.mutation.Content(); ok {
+		_spec.SetField(step.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := sc.mutation.URL(); ok {
+		_spec.SetField(step.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := sc.mutation.LinkTitle(); ok {
+		_spec.SetField(step.FieldLinkTitle, field.TypeString, value)
+		_node.LinkTitle = value
+	}
+	if value, ok := sc.mutation.LinkDescription(); ok {
+		_spec.SetField(step.FieldLinkDescription, field.TypeString, value)
+		_node.LinkDescription = value
+	}
+	if value, ok := sc.mutation.MimeType(); ok {
+		_spec.SetField(step.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := sc.mutation.PageCount(); ok {
+		_spec.SetField(step.FieldPageCount, field.TypeUint32, value)
+		_node.PageCount = value
+	}
+	if value, ok := sc.mutation.PreviewObjectName(); ok {
+		_spec.SetField(step.FieldPreviewObjectName, field.TypeString, value)
+		_node.PreviewObjectName = value
+	}
+	if value, ok := sc.mutation.SortOrder(); ok {
+		_spec.SetField(step.FieldSortOrder, field.TypeFloat64, value)
+		_node.SortOrder = value
+	}
+	if value, ok := sc.mutation.Path(); ok {
+		_spec.SetField(step.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
 	if nodes := sc.mutation.TargetIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -376,6 +642,22 @@ func (sc *StepCreate) createSpec() (*Step, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 	"math"
 	"step/internal/data/ent/predicate"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
 
 	"entgo.io/ent"
@@ -27,6 +28,7 @@ type StepQuery struct {
 	withTarget   *TargetQuery
 	withParent   *StepQuery
 	withChildren *StepQuery
+	withTags     *TagQuery
 	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -130,6 +132,28 @@ func (sq *StepQuery) QueryChildren() *StepQuery {
 	return query
 }
 
+// QueryTags chains the current query on the "tags" edge.
+func (sq *StepQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(step.Table, step.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, step.TagsTable, step.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Step entity from the query.
 // Returns a *NotFoundError when no Step was found.
 func (sq *StepQuery) First(ctx context.Context) (*Step, error) {
@@ -325,6 +349,7 @@ func (sq *StepQuery) Clone() *StepQuery {
 		withTarget:   sq.withTarget.Clone(),
 		withParent:   sq.withParent.Clone(),
 		withChildren: sq.withChildren.Clone(),
+		withTags:     sq.withTags.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -364,6 +389,17 @@ func (sq *StepQuery) WithChildren(opts ...func(*StepQuery)) *StepQuery {
 	return sq
 }
 
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *StepQuery) WithTags(opts ...func(*TagQuery)) *StepQuery {
+	query := (&TagClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withTags = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -442,10 +478,11 @@ func (sq *StepQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Step, e
 	var (
 		nodes       = []*Step{}
 		_spec       = sq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			sq.withTarget != nil,
 			sq.withParent != nil,
 			sq.withChildren != nil,
+			sq.withTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +525,13 @@ func (sq *StepQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Step, e
 			return nil, err
 		}
 	}
+	if query := sq.withTags; query != nil {
+		if err := sq.loadTags(ctx, query, nodes,
+			func(n *Step) { n.Edges.Tags = []*Tag{} },
+			func(n *Step, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -579,6 +623,67 @@ func (sq *StepQuery) loadChildren(ctx context.Context, query *StepQuery, nodes [
 	}
 	return nil
 }
+func (sq *StepQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Step, init func(*Step), assign func(*Step, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Step)
+	nids := make(map[uint64]map[*Step]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(step.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(step.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(step.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(step.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Step]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (sq *StepQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"step/internal/data/ent/predicate"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
 
 	"entgo.io/ent/dialect/sql"
@@ -119,6 +120,46 @@ func (su *StepUpdate) ClearFriendComment() *StepUpdate {
 	return su
 }
 
+// SetTeacherReviewerID sets the "teacher_reviewer_id" field.
+func (su *StepUpdate) SetTeacherReviewerID(s string) *StepUpdate {
+	su.mutation.SetTeacherReviewerID(s)
+	return su
+}
+
+// SetNillableTeacherReviewerID sets the "teacher_reviewer_id" field if the given value is not nil.
+func (su *StepUpdate) SetNillableTeacherReviewerID(s *string) *StepUpdate {
+	if s != nil {
+		su.SetTeacherReviewerID(*s)
+	}
+	return su
+}
+
+// ClearTeacherReviewerID clears the value of the "teacher_reviewer_id" field.
+func (su *StepUpdate) ClearTeacherReviewerID() *StepUpdate {
+	su.mutation.ClearTeacherReviewerID()
+	return su
+}
+
+// SetParentReviewerID sets the "parent_reviewer_id" field.
+func (su *StepUpdate) SetParentReviewerID(s string) *StepUpdate {
+	su.mutation.SetParentReviewerID(s)
+	return su
+}
+
+// SetNillableParentReviewerID sets the "parent_reviewer_id" field if the given value is not nil.
+func (su *StepUpdate) SetNillableParentReviewerID(s *string) *StepUpdate {
+	if s != nil {
+		su.SetParentReviewerID(*s)
+	}
+	return su
+}
+
+// ClearParentReviewerID clears the value of the "parent_reviewer_id" field.
+func (su *StepUpdate) ClearParentReviewerID() *StepUpdate {
+	su.mutation.ClearParentReviewerID()
+	return su
+}
+
 // SetType sets the "type" field.
 func (su *StepUpdate) SetType(s step.Type) *StepUpdate {
 	su.mutation.SetType(s)
@@ -193,6 +234,215 @@ func (su *StepUpdate) ClearParentID() *StepUpdate {
 	return su
 }
 
+// SetMeasurement sets the "measurement" field.
+func (su *StepUpdate) SetMeasurement(f float64) *StepUpdate {
+	su.mutation.ResetMeasurement()
+	su.mutation.SetMeasurement(f)
+	return su
+}
+
+// SetNillableMeasurement sets the "measurement" field if the given value is not nil.
+func (su *StepUpdate) SetNillableMeasurement(f *float64) *StepUpdate {
+	if f != nil {
+		su.SetMeasurement(*f)
+	}
+	return su
+}
+
+// AddMeasurement adds f to the "measurement" field.
+func (su *StepUpdate) AddMeasurement(f float64) *StepUpdate {
+	su.mutation.AddMeasurement(f)
+	return su
+}
+
+// ClearMeasurement clears the value of the "measurement" field.
+func (su *StepUpdate) ClearMeasurement() *StepUpdate {
+	su.mutation.ClearMeasurement()
+	return su
+}
+
+// SetContent sets the "content" field.
+func (su *StepUpdate) SetContent(s string) *StepUpdate {
+	su.mutation.SetContent(s)
+	return su
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (su *StepUpdate) SetNillableContent(s *string) *StepUpdate {
+	if s != nil {
+		su.SetContent(*s)
+	}
+	return su
+}
+
+// ClearContent clears the value of the "content" field.
+func (su *StepUpdate) ClearContent() *StepUpdate {
+	su.mutation.ClearContent()
+	return su
+}
+
+// SetURL sets the "url" field.
+func (su *StepUpdate) SetURL(s string) *StepUpdate {
+	su.mutation.SetURL(s)
+	return su
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (su *StepUpdate) SetNillableURL(s *string) *StepUpdate {
+	if s != nil {
+		su.SetURL(*s)
+	}
+	return su
+}
+
+// ClearURL clears the value of the "url" field.
+func (su *StepUpdate) ClearURL() *StepUpdate {
+	su.mutation.ClearURL()
+	return su
+}
+
+// SetLinkTitle sets the "link_title" field.
+func (su *StepUpdate) SetLinkTitle(s string) *StepUpdate {
+	su.mutation.SetLinkTitle(s)
+	return su
+}
+
+// SetNillableLinkTitle sets the "link_title" field if the given value is not nil.
+func (su *StepUpdate) SetNillableLinkTitle(s *string) *StepUpdate {
+	if s != nil {
+		su.SetLinkTitle(*s)
+	}
+	return su
+}
+
+// ClearLinkTitle clears the value of the "link_title" field.
+func (su *StepUpdate) ClearLinkTitle() *StepUpdate {
+	su.mutation.ClearLinkTitle()
+	return su
+}
+
+// SetLinkDescription sets the "link_description" field.
+func (su *StepUpdate) SetLinkDescription(s string) *StepUpdate {
+	su.mutation.SetLinkDescription(s)
+	return su
+}
+
+// SetNillableLinkDescription sets the "link_description" field if the given value is not nil.
+func (su *StepUpdate) SetNillableLinkDescription(s *string) *StepUpdate {
+	if s != nil {
+		su.SetLinkDescription(*s)
+	}
+	return su
+}
+
+// ClearLinkDescription clears the value of the "link_description" field.
+func (su *StepUpdate) ClearLinkDescription() *StepUpdate {
+	su.mutation.ClearLinkDescription()
+	return su
+}
+
+// SetMimeType sets the "mime_type" field.
+func (su *StepUpdate) SetMimeType(s string) *StepUpdate {
+	su.mutation.SetMimeType(s)
+	return su
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (su *StepUpdate) SetNillableMimeType(s *string) *StepUpdate {
+	if s != nil {
+		su.SetMimeType(*s)
+	}
+	return su
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (su *StepUpdate) ClearMimeType() *StepUpdate {
+	su.mutation.ClearMimeType()
+	return su
+}
+
+// SetPageCount sets the "page_count" field.
+func (su *StepUpdate) SetPageCount(u uint32) *StepUpdate {
+	su.mutation.ResetPageCount()
+	su.mutation.SetPageCount(u)
+	return su
+}
+
+// SetNillablePageCount sets the "page_count" field if the given value is not nil.
+func (su *StepUpdate) SetNillablePageCount(u *uint32) *StepUpdate {
+	if u != nil {
+		su.SetPageCount(*u)
+	}
+	return su
+}
+
+// AddPageCount adds u to the "page_count" field.
+func (su *StepUpdate) AddPageCount(u int32) *StepUpdate {
+	su.mutation.AddPageCount(u)
+	return su
+}
+
+// ClearPageCount clears the value of the "page_count" field.
+func (su *StepUpdate) ClearPageCount() *StepUpdate {
+	su.mutation.ClearPageCount()
+	return su
+}
+
+// SetPreviewObjectName sets the "preview_object_name" field.
+func (su *StepUpdate) SetPreviewObjectName(s string) *StepUpdate {
+	su.mutation.SetPreviewObjectName(s)
+	return su
+}
+
+// SetNillablePreviewObjectName sets the "preview_object_name" field if the given value is not nil.
+func (su *StepUpdate) SetNillablePreviewObjectName(s *string) *StepUpdate {
+	if s != nil {
+		su.SetPreviewObjectName(*s)
+	}
+	return su
+}
+
+// ClearPreviewObjectName clears the value of the "preview_object_name" field.
+func (su *StepUpdate) ClearPreviewObjectName() *StepUpdate {
+	su.mutation.ClearPreviewObjectName()
+	return su
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (su *StepUpdate) SetSortOrder(f float64) *StepUpdate {
+	su.mutation.ResetSortOrder()
+	su.mutation.SetSortOrder(f)
+	return su
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (su *StepUpdate) SetNillableSortOrder(f *float64) *StepUpdate {
+	if f != nil {
+		su.SetSortOrder(*f)
+	}
+	return su
+}
+
+// AddSortOrder adds f to the "sort_order" field.
+func (su *StepUpdate) AddSortOrder(f float64) *StepUpdate {
+	su.mutation.AddSortOrder(f)
+	return su
+}
+
+// SetPath sets the "path" field.
+func (su *StepUpdate) SetPath(s string) *StepUpdate {
+	su.mutation.SetPath(s)
+	return su
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (su *StepUpdate) SetNillablePath(s *string) *StepUpdate {
+	if s != nil {
+		su.SetPath(*s)
+	}
+	return su
+}
+
 // SetTargetID sets the "target" edge to the Target entity by ID.
 func (su *StepUpdate) SetTargetID(id uint64) *StepUpdate {
 	su.mutation.SetTargetID(id)
@@ -232,6 +482,21 @@ func (su *StepUpdate) AddChildren(s ...*Step) *StepUpdate {
 	return su.AddChildIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (su *StepUpdate) AddTagIDs(ids ...uint64) *StepUpdate {
+	su.mutation.AddTagIDs(ids...)
+	return su
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (su *StepUpdate) AddTags(t ...*Tag) *StepUpdate {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.AddTagIDs(ids...)
+}
+
 // Mutation returns the StepMutation object of the builder.
 func (su *StepUpdate) Mutation() *StepMutation {
 	return su.mutation
@@ -270,6 +535,27 @@ func (su *StepUpdate) RemoveChildren(s ...*Step) *StepUpdate {
 	return su.RemoveChildIDs(ids...)
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (su *StepUpdate) ClearTags() *StepUpdate {
+	su.mutation.ClearTags()
+	return su
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (su *StepUpdate) RemoveTagIDs(ids ...uint64) *StepUpdate {
+	su.mutation.RemoveTagIDs(ids...)
+	return su
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (su *StepUpdate) RemoveTags(t ...*Tag) *StepUpdate {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *StepUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -314,6 +600,26 @@ func (su *StepUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Step.type": %w`, err)}
 		}
 	}
+	if v, ok := su.mutation.URL(); ok {
+		if err := step.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Step.url": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.LinkTitle(); ok {
+		if err := step.LinkTitleValidator(v); err != nil {
+			return &ValidationError{Name: "link_title", err: fmt.Errorf(`ent: validator failed for field "Step.link_title": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.LinkDescription(); ok {
+		if err := step.LinkDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "link_description", err: fmt.Errorf(`ent: validator failed for field "Step.link_description": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.MimeType(); ok {
+		if err := step.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Step.mime_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -368,6 +674,18 @@ func (su *StepUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.FriendCommentCleared() {
 		_spec.ClearField(step.FieldFriendComment, field.TypeJSON)
 	}
+	if value, ok := su.mutation.TeacherReviewerID(); ok {
+		_spec.SetField(step.FieldTeacherReviewerID, field.TypeString, value)
+	}
+	if su.mutation.TeacherReviewerIDCleared() {
+		_spec.ClearField(step.FieldTeacherReviewerID, field.TypeString)
+	}
+	if value, ok := su.mutation.ParentReviewerID(); ok {
+		_spec.SetField(step.FieldParentReviewerID, field.TypeString, value)
+	}
+	if su.mutation.ParentReviewerIDCleared() {
+		_spec.ClearField(step.FieldParentReviewerID, field.TypeString)
+	}
 	if value, ok := su.mutation.GetType(); ok {
 		_spec.SetField(step.FieldType, field.TypeEnum, value)
 	}
@@ -377,6 +695,69 @@ func (su *StepUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.ObjectNameCleared() {
 		_spec.ClearField(step.FieldObjectName, field.TypeString)
 	}
+	if value, ok := su.mutation.Measurement(); ok {
+		_spec.SetField(step.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.AddedMeasurement(); ok {
+		_spec.AddField(step.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if su.mutation.MeasurementCleared() {
+		_spec.ClearField(step.FieldMeasurement, field.TypeFloat64)
+	}
+	if value, ok := su.mutation.Content(); ok {
+		_spec.SetField(step.FieldContent, field.TypeString, value)
+	}
+	if su.mutation.ContentCleared() {
+		_spec.ClearField(step.FieldContent, field.TypeString)
+	}
+	if value, ok := su.mutation.URL(); ok {
+		_spec.SetField(step.FieldURL, field.TypeString, value)
+	}
+	if su.mutation.URLCleared() {
+		_spec.ClearField(step.FieldURL, field.TypeString)
+	}
+	if value, ok := su.mutation.LinkTitle(); ok {
+		_spec.SetField(step.FieldLinkTitle, field.TypeString, value)
+	}
+	if su.mutation.LinkTitleCleared() {
+		_spec.ClearField(step.FieldLinkTitle, field.TypeString)
+	}
+	if value, ok := su.mutation.LinkDescription(); ok {
+		_spec.SetField(step.FieldLinkDescription, field.TypeString, value)
+	}
+	if su.mutation.LinkDescriptionCleared() {
+		_spec.ClearField(step.FieldLinkDescription, field.TypeString)
+	}
+	if value, ok := su.mutation.MimeType(); ok {
+		_spec.SetField(step.FieldMimeType, field.TypeString, value)
+	}
+	if su.mutation.MimeTypeCleared() {
+		_spec.ClearField(step.FieldMimeType, field.TypeString)
+	}
+	if value, ok := su.mutation.PageCount(); ok {
+		_spec.SetField(step.FieldPageCount, field.TypeUint32, value)
+	}
+	if value, ok := su.mutation.AddedPageCount(); ok {
+		_spec.AddField(step.FieldPageCount, field.TypeUint32, value)
+	}
+	if su.mutation.PageCountCleared() {
+		_spec.ClearField(step.FieldPageCount, field.TypeUint32)
+	}
+	if value, ok := su.mutation.PreviewObjectName(); ok {
+		_spec.SetField(step.FieldPreviewObjectName, field.TypeString, value)
+	}
+	if su.mutation.PreviewObjectNameCleared() {
+		_spec.ClearField(step.FieldPreviewObjectName, field.TypeString)
+	}
+	if value, ok := su.mutation.SortOrder(); ok {
+		_spec.SetField(step.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.AddedSortOrder(); ok {
+		_spec.AddField(step.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := su.mutation.Path(); ok {
+		_spec.SetField(step.FieldPath, field.TypeString, value)
+	}
 	if su.mutation.TargetCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -480,6 +861,51 @@ func (su *StepUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedTagsIDs(); len(nodes) > 0 && !su.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(su.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -592,6 +1018,46 @@ func (suo *StepUpdateOne) ClearFriendComment() *StepUpdateOne {
 	return suo
 }
 
+// SetTeacherReviewerID sets the "teacher_reviewer_id" field.
+func (suo *StepUpdateOne) SetTeacherReviewerID(s string) *StepUpdateOne {
+	suo.mutation.SetTeacherReviewerID(s)
+	return suo
+}
+
+// SetNillableTeacherReviewerID sets the "teacher_reviewer_id" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableTeacherReviewerID(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetTeacherReviewerID(*s)
+	}
+	return suo
+}
+
+// ClearTeacherReviewerID clears the value of the "teacher_reviewer_id" field.
+func (suo *StepUpdateOne) ClearTeacherReviewerID() *StepUpdateOne {
+	suo.mutation.ClearTeacherReviewerID()
+	return suo
+}
+
+// SetParentReviewerID sets the "parent_reviewer_id" field.
+func (suo *StepUpdateOne) SetParentReviewerID(s string) *StepUpdateOne {
+	suo.mutation.SetParentReviewerID(s)
+	return suo
+}
+
+// SetNillableParentReviewerID sets the "parent_reviewer_id" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableParentReviewerID(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetParentReviewerID(*s)
+	}
+	return suo
+}
+
+// ClearParentReviewerID clears the value of the "parent_reviewer_id" field.
+func (suo *StepUpdateOne) ClearParentReviewerID() *StepUpdateOne {
+	suo.mutation.ClearParentReviewerID()
+	return suo
+}
+
 // SetType sets the "type" field.
 func (suo *StepUpdateOne) SetType(s step.Type) *StepUpdateOne {
 	suo.mutation.SetType(s)
@@ -666,6 +1132,215 @@ func (suo *StepUpdateOne) ClearParentID() *StepUpdateOne {
 	return suo
 }
 
+// SetMeasurement sets the "measurement" field.
+func (suo *StepUpdateOne) SetMeasurement(f float64) *StepUpdateOne {
+	suo.mutation.ResetMeasurement()
+	suo.mutation.SetMeasurement(f)
+	return suo
+}
+
+// SetNillableMeasurement sets the "measurement" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableMeasurement(f *float64) *StepUpdateOne {
+	if f != nil {
+		suo.SetMeasurement(*f)
+	}
+	return suo
+}
+
+// AddMeasurement adds f to the "measurement" field.
+func (suo *StepUpdateOne) AddMeasurement(f float64) *StepUpdateOne {
+	suo.mutation.AddMeasurement(f)
+	return suo
+}
+
+// ClearMeasurement clears the value of the "measurement" field.
+func (suo *StepUpdateOne) ClearMeasurement() *StepUpdateOne {
+	suo.mutation.ClearMeasurement()
+	return suo
+}
+
+// SetContent sets the "content" field.
+func (suo *StepUpdateOne) SetContent(s string) *StepUpdateOne {
+	suo.mutation.SetContent(s)
+	return suo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableContent(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetContent(*s)
+	}
+	return suo
+}
+
+// ClearContent clears the value of the "content" field.
+func (suo *StepUpdateOne) ClearContent() *StepUpdateOne {
+	suo.mutation.ClearContent()
+	return suo
+}
+
+// SetURL sets the "url" field.
+func (suo *StepUpdateOne) SetURL(s string) *StepUpdateOne {
+	suo.mutation.SetURL(s)
+	return suo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableURL(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetURL(*s)
+	}
+	return suo
+}
+
+// ClearURL clears the value of the "url" field.
+func (suo *StepUpdateOne) ClearURL() *StepUpdateOne {
+	suo.mutation.ClearURL()
+	return suo
+}
+
+// SetLinkTitle sets the "link_title" field.
+func (suo *StepUpdateOne) SetLinkTitle(s string) *StepUpdateOne {
+	suo.mutation.SetLinkTitle(s)
+	return suo
+}
+
+// SetNillableLinkTitle sets the "link_title" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableLinkTitle(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetLinkTitle(*s)
+	}
+	return suo
+}
+
+// ClearLinkTitle clears the value of the "link_title" field.
+func (suo *StepUpdateOne) ClearLinkTitle() *StepUpdateOne {
+	suo.mutation.ClearLinkTitle()
+	return suo
+}
+
+// SetLinkDescription sets the "link_description" field.
+func (suo *StepUpdateOne) SetLinkDescription(s string) *StepUpdateOne {
+	suo.mutation.SetLinkDescription(s)
+	return suo
+}
+
+// SetNillableLinkDescription sets the "link_description" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableLinkDescription(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetLinkDescription(*s)
+	}
+	return suo
+}
+
+// ClearLinkDescription clears the value of the "link_description" field.
+func (suo *StepUpdateOne) ClearLinkDescription() *StepUpdateOne {
+	suo.mutation.ClearLinkDescription()
+	return suo
+}
+
+// SetMimeType sets the "mime_type" field.
+func (suo *StepUpdateOne) SetMimeType(s string) *StepUpdateOne {
+	suo.mutation.SetMimeType(s)
+	return suo
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableMimeType(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetMimeType(*s)
+	}
+	return suo
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (suo *StepUpdateOne) ClearMimeType() *StepUpdateOne {
+	suo.mutation.ClearMimeType()
+	return suo
+}
+
+// SetPageCount sets the "page_count" field.
+func (suo *StepUpdateOne) SetPageCount(u uint32) *StepUpdateOne {
+	suo.mutation.ResetPageCount()
+	suo.mutation.SetPageCount(u)
+	return suo
+}
+
+// SetNillablePageCount sets the "page_count" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillablePageCount(u *uint32) *StepUpdateOne {
+	if u != nil {
+		suo.SetPageCount(*u)
+	}
+	return suo
+}
+
+// AddPageCount adds u to the "page_count" field.
+func (suo *StepUpdateOne) AddPageCount(u int32) *StepUpdateOne {
+	suo.mutation.AddPageCount(u)
+	return suo
+}
+
+// ClearPageCount clears the value of the "page_count" field.
+func (suo *StepUpdateOne) ClearPageCount() *StepUpdateOne {
+	suo.mutation.ClearPageCount()
+	return suo
+}
+
+// SetPreviewObjectName sets the "preview_object_name" field.
+func (suo *StepUpdateOne) SetPreviewObjectName(s string) *StepUpdateOne {
+	suo.mutation.SetPreviewObjectName(s)
+	return suo
+}
+
+// SetNillablePreviewObjectName sets the "preview_object_name" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillablePreviewObjectName(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetPreviewObjectName(*s)
+	}
+	return suo
+}
+
+// ClearPreviewObjectName clears the value of the "preview_object_name" field.
+func (suo *StepUpdateOne) ClearPreviewObjectName() *StepUpdateOne {
+	suo.mutation.ClearPreviewObjectName()
+	return suo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (suo *StepUpdateOne) SetSortOrder(f float64) *StepUpdateOne {
+	suo.mutation.ResetSortOrder()
+	suo.mutation.SetSortOrder(f)
+	return suo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillableSortOrder(f *float64) *StepUpdateOne {
+	if f != nil {
+		suo.SetSortOrder(*f)
+	}
+	return suo
+}
+
+// AddSortOrder adds f to the "sort_order" field.
+func (suo *StepUpdateOne) AddSortOrder(f float64) *StepUpdateOne {
+	suo.mutation.AddSortOrder(f)
+	return suo
+}
+
+// SetPath sets the "path" field.
+func (suo *StepUpdateOne) SetPath(s string) *StepUpdateOne {
+	suo.mutation.SetPath(s)
+	return suo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (suo *StepUpdateOne) SetNillablePath(s *string) *StepUpdateOne {
+	if s != nil {
+		suo.SetPath(*s)
+	}
+	return suo
+}
+
 // SetTargetID sets the "target" edge to the Target entity by ID.
 func (suo *StepUpdateOne) SetTargetID(id uint64) *StepUpdateOne {
 	suo.mutation.SetTargetID(id)
@@ -705,6 +1380,21 @@ func (suo *StepUpdateOne) AddChildren(s ...*Step) *StepUpdateOne {
 	return suo.AddChildIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (suo *StepUpdateOne) AddTagIDs(ids ...uint64) *StepUpdateOne {
+	suo.mutation.AddTagIDs(ids...)
+	return suo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (suo *StepUpdateOne) AddTags(t ...*Tag) *StepUpdateOne {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.AddTagIDs(ids...)
+}
+
 // Mutation returns the StepMutation object of the builder.
 func (suo *StepUpdateOne) Mutation() *StepMutation {
 	return suo.mutation
@@ -743,6 +1433,27 @@ func (suo *StepUpdateOne) RemoveChildren(s ...*Step) *StepUpdateOne {
 	return suo.RemoveChildIDs(ids...)
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (suo *StepUpdateOne) ClearTags() *StepUpdateOne {
+	suo.mutation.ClearTags()
+	return suo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (suo *StepUpdateOne) RemoveTagIDs(ids ...uint64) *StepUpdateOne {
+	suo.mutation.RemoveTagIDs(ids...)
+	return suo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (suo *StepUpdateOne) RemoveTags(t ...*Tag) *StepUpdateOne {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the StepUpdate builder.
 func (suo *StepUpdateOne) Where(ps ...predicate.Step) *StepUpdateOne {
 	suo.mutation.Where(ps...)
@@ -800,6 +1511,26 @@ func (suo *StepUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Step.type": %w`, err)}
 		}
 	}
+	if v, ok := suo.mutation.URL(); ok {
+		if err := step.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Step.url": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.LinkTitle(); ok {
+		if err := step.LinkTitleValidator(v); err != nil {
+			return &ValidationError{Name: "link_title", err: fmt.Errorf(`ent: validator failed for field "Step.link_title": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.LinkDescription(); ok {
+		if err := step.LinkDescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "link_description", err: fmt.Errorf(`ent: validator failed for field "Step.link_description": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.MimeType(); ok {
+		if err := step.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "Step.mime_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -871,6 +1602,18 @@ func (suo *StepUpdateOne) sqlSave(ctx context.Context) (_node *Step, err error)
 	if suo.mutation.FriendCommentCleared() {
 		_spec.ClearField(step.FieldFriendComment, field.TypeJSON)
 	}
+	if value, ok := suo.mutation.TeacherReviewerID(); ok {
+		_spec.SetField(step.FieldTeacherReviewerID, field.TypeString, value)
+	}
+	if suo.mutation.TeacherReviewerIDCleared() {
+		_spec.ClearField(step.FieldTeacherReviewerID, field.TypeString)
+	}
+	if value, ok := suo.mutation.ParentReviewerID(); ok {
+		_spec.SetField(step.FieldParentReviewerID, field.TypeString, value)
+	}
+	if suo.mutation.ParentReviewerIDCleared() {
+		_spec.ClearField(step.FieldParentReviewerID, field.TypeString)
+	}
 	if value, ok := suo.mutation.GetType(); ok {
 		_spec.SetField(step.FieldType, field.TypeEnum, value)
 	}
@@ -880,6 +1623,69 @@ func (suo *StepUpdateOne) sqlSave(ctx context.Context) (_node *Step, err error)
 	if suo.mutation.ObjectNameCleared() {
 		_spec.ClearField(step.FieldObjectName, field.TypeString)
 	}
+	if value, ok := suo.mutation.Measurement(); ok {
+		_spec.SetField(step.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.AddedMeasurement(); ok {
+		_spec.AddField(step.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if suo.mutation.MeasurementCleared() {
+		_spec.ClearField(step.FieldMeasurement, field.TypeFloat64)
+	}
+	if value, ok := suo.mutation.Content(); ok {
+		_spec.SetField(step.FieldContent, field.TypeString, value)
+	}
+	if suo.mutation.ContentCleared() {
+		_spec.ClearField(step.FieldContent, field.TypeString)
+	}
+	if value, ok := suo.mutation.URL(); ok {
+		_spec.SetField(step.FieldURL, field.TypeString, value)
+	}
+	if suo.mutation.URLCleared() {
+		_spec.ClearField(step.FieldURL, field.TypeString)
+	}
+	if value, ok := suo.mutation.LinkTitle(); ok {
+		_spec.SetField(step.FieldLinkTitle, field.TypeString, value)
+	}
+	if suo.mutation.LinkTitleCleared() {
+		_spec.ClearField(step.FieldLinkTitle, field.TypeString)
+	}
+	if value, ok := suo.mutation.LinkDescription(); ok {
+		_spec.SetField(step.FieldLinkDescription, field.TypeString, value)
+	}
+	if suo.mutation.LinkDescriptionCleared() {
+		_spec.ClearField(step.FieldLinkDescription, field.TypeString)
+	}
+	if value, ok := suo.mutation.MimeType(); ok {
+		_spec.SetField(step.FieldMimeType, field.TypeString, value)
+	}
+	if suo.mutation.MimeTypeCleared() {
+		_spec.ClearField(step.FieldMimeType, field.TypeString)
+	}
+	if value, ok := suo.mutation.PageCount(); ok {
+		_spec.SetField(step.FieldPageCount, field.TypeUint32, value)
+	}
+	if value, ok := suo.mutation.AddedPageCount(); ok {
+		_spec.AddField(step.FieldPageCount, field.TypeUint32, value)
+	}
+	if suo.mutation.PageCountCleared() {
+		_spec.ClearField(step.FieldPageCount, field.TypeUint32)
+	}
+	if value, ok := suo.mutation.PreviewObjectName(); ok {
+		_spec.SetField(step.FieldPreviewObjectName, field.TypeString, value)
+	}
+	if suo.mutation.PreviewObjectNameCleared() {
+		_spec.ClearField(step.FieldPreviewObjectName, field.TypeString)
+	}
+	if value, ok := suo.mutation.SortOrder(); ok {
+		_spec.SetField(step.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(step.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := suo.mutation.Path(); ok {
+		_spec.SetField(step.FieldPath, field.TypeString, value)
+	}
 	if suo.mutation.TargetCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -983,6 +1789,51 @@ func (suo *StepUpdateOne) sqlSave(ctx context.Context) (_node *Step, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !suo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   step.TagsTable,
+			Columns: step.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(suo.modifiers...)
 	_node = &Step{config: suo.config}
 	_spec.Assign = _node.assignValues
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"step/internal/data/ent/steprate"
+	"step/internal/objects"
 	"strings"
 	"time"
 
@@ -44,6 +46,12 @@ type StepRate struct {
 	SkillImprovement int32 `json:"skill_improvement,omitempty"`
 	// 困难度
 	Difficulty int32 `json:"difficulty,omitempty"`
+	// 上面各列中自评的部分, 画像只按自评的困难度计入挑战心态
+	SelfScores *objects.CommentScores `json:"self_scores,omitempty"`
+	// 计量值
+	Measurement float64 `json:"measurement,omitempty"`
+	// 积累创建的统计是否已计入画像
+	CreateCounted bool `json:"create_counted,omitempty"`
 	// 日期
 	Date         time.Time `json:"date,omitempty"`
 	selectValues sql.SelectValues
@@ -54,7 +62,11 @@ func (*StepRate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case steprate.FieldWeightedValue:
+		case steprate.FieldSelfScores:
+			values[i] = new([]byte)
+		case steprate.FieldCreateCounted:
+			values[i] = new(sql.NullBool)
+		case steprate.FieldWeightedValue, steprate.FieldMeasurement:
 			values[i] = new(sql.NullFloat64)
 		case steprate.FieldID, steprate.FieldTopTargetID, steprate.FieldTargetID, steprate.FieldStepID, steprate.FieldTargetReasonableness, steprate.FieldTargetClarity, steprate.FieldTargetAchievement, steprate.FieldReflectionImprovement, steprate.FieldInnovation, steprate.FieldBasicReliability, steprate.FieldSkillImprovement, steprate.FieldDifficulty:
 			values[i] = new(sql.NullInt64)
@@ -161,6 +173,26 @@ func (sr *StepRate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sr.Difficulty = int32(value.Int64)
 			}
+		case steprate.FieldSelfScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field self_scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.SelfScores); err != nil {
+					return fmt.Errorf("unmarshal field self_scores: %w", err)
+				}
+			}
+		case steprate.FieldMeasurement:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field measurement", values[i])
+			} else if value.Valid {
+				sr.Measurement = value.Float64
+			}
+		case steprate.FieldCreateCounted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field create_counted", values[i])
+			} else if value.Valid {
+				sr.CreateCounted = value.Bool
+			}
 		case steprate.FieldDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field date", values[i])
@@ -242,6 +274,15 @@ func (sr *StepRate) String() string {
 	builder.WriteString("difficulty=")
 	builder.WriteString(fmt.Sprintf("%v", sr.Difficulty))
 	builder.WriteString(", ")
+	builder.WriteString("self_scores=")
+	builder.WriteString(fmt.Sprintf("%v", sr.SelfScores))
+	builder.WriteString(", ")
+	builder.WriteString("measurement=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Measurement))
+	builder.WriteString(", ")
+	builder.WriteString("create_counted=")
+	builder.WriteString(fmt.Sprintf("%v", sr.CreateCounted))
+	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(sr.Date.Format(time.ANSIC))
 	builder.WriteByte(')')
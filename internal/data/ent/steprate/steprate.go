@@ -37,6 +37,12 @@ const (
 	FieldSkillImprovement = "skill_improvement"
 	// FieldDifficulty holds the string denoting the difficulty field in the database.
 	FieldDifficulty = "difficulty"
+	// FieldSelfScores holds the string denoting the self_scores field in the database.
+	FieldSelfScores = "self_scores"
+	// FieldMeasurement holds the string denoting the measurement field in the database.
+	FieldMeasurement = "measurement"
+	// FieldCreateCounted holds the string denoting the create_counted field in the database.
+	FieldCreateCounted = "create_counted"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
 	// Table holds the table name of the steprate in the database.
@@ -59,6 +65,9 @@ var Columns = []string{
 	FieldBasicReliability,
 	FieldSkillImprovement,
 	FieldDifficulty,
+	FieldSelfScores,
+	FieldMeasurement,
+	FieldCreateCounted,
 	FieldDate,
 }
 
@@ -72,6 +81,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultMeasurement holds the default value on creation for the "measurement" field.
+	DefaultMeasurement float64
+	// DefaultCreateCounted holds the default value on creation for the "create_counted" field.
+	DefaultCreateCounted bool
+)
+
 // OrderOption defines the ordering options for the StepRate queries.
 type OrderOption func(*sql.Selector)
 
@@ -145,6 +161,16 @@ func ByDifficulty(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDifficulty, opts...).ToFunc()
 }
 
+// ByMeasurement orders the results by the measurement field.
+func ByMeasurement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeasurement, opts...).ToFunc()
+}
+
+// ByCreateCounted orders the results by the create_counted field.
+func ByCreateCounted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateCounted, opts...).ToFunc()
+}
+
 // ByDate orders the results by the date field.
 func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
@@ -119,6 +119,16 @@ func Difficulty(v int32) predicate.StepRate {
 	return predicate.StepRate(sql.FieldEQ(FieldDifficulty, v))
 }
 
+// Measurement applies equality check predicate on the "measurement" field. It's identical to MeasurementEQ.
+func Measurement(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldEQ(FieldMeasurement, v))
+}
+
+// CreateCounted applies equality check predicate on the "create_counted" field. It's identical to CreateCountedEQ.
+func CreateCounted(v bool) predicate.StepRate {
+	return predicate.StepRate(sql.FieldEQ(FieldCreateCounted, v))
+}
+
 // Date applies equality check predicate on the "date" field. It's identical to DateEQ.
 func Date(v time.Time) predicate.StepRate {
 	return predicate.StepRate(sql.FieldEQ(FieldDate, v))
@@ -669,6 +679,66 @@ func DifficultyLTE(v int32) predicate.StepRate {
 	return predicate.StepRate(sql.FieldLTE(FieldDifficulty, v))
 }
 
+// SelfScoresIsNil applies the IsNil predicate on the "self_scores" field.
+func SelfScoresIsNil() predicate.StepRate {
+	return predicate.StepRate(sql.FieldIsNull(FieldSelfScores))
+}
+
+// SelfScoresNotNil applies the NotNil predicate on the "self_scores" field.
+func SelfScoresNotNil() predicate.StepRate {
+	return predicate.StepRate(sql.FieldNotNull(FieldSelfScores))
+}
+
+// MeasurementEQ applies the EQ predicate on the "measurement" field.
+func MeasurementEQ(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldEQ(FieldMeasurement, v))
+}
+
+// MeasurementNEQ applies the NEQ predicate on the "measurement" field.
+func MeasurementNEQ(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldNEQ(FieldMeasurement, v))
+}
+
+// MeasurementIn applies the In predicate on the "measurement" field.
+func MeasurementIn(vs ...float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldIn(FieldMeasurement, vs...))
+}
+
+// MeasurementNotIn applies the NotIn predicate on the "measurement" field.
+func MeasurementNotIn(vs ...float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldNotIn(FieldMeasurement, vs...))
+}
+
+// MeasurementGT applies the GT predicate on the "measurement" field.
+func MeasurementGT(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldGT(FieldMeasurement, v))
+}
+
+// MeasurementGTE applies the GTE predicate on the "measurement" field.
+func MeasurementGTE(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldGTE(FieldMeasurement, v))
+}
+
+// MeasurementLT applies the LT predicate on the "measurement" field.
+func MeasurementLT(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldLT(FieldMeasurement, v))
+}
+
+// MeasurementLTE applies the LTE predicate on the "measurement" field.
+func MeasurementLTE(v float64) predicate.StepRate {
+	return predicate.StepRate(sql.FieldLTE(FieldMeasurement, v))
+}
+
+// CreateCountedEQ applies the EQ predicate on the "create_counted" field.
+func CreateCountedEQ(v bool) predicate.StepRate {
+	return predicate.StepRate(sql.FieldEQ(FieldCreateCounted, v))
+}
+
+// CreateCountedNEQ applies the NEQ predicate on the "create_counted" field.
+func CreateCountedNEQ(v bool) predicate.StepRate {
+	return predicate.StepRate(sql.FieldNEQ(FieldCreateCounted, v))
+}
+
 // DateEQ applies the EQ predicate on the "date" field.
 func DateEQ(v time.Time) predicate.StepRate {
 	return predicate.StepRate(sql.FieldEQ(FieldDate, v))
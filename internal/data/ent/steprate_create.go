@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"step/internal/data/ent/steprate"
+	"step/internal/objects"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -98,6 +99,40 @@ func (src *StepRateCreate) SetDifficulty(i int32) *StepRateCreate {
 	return src
 }
 
+// SetSelfScores sets the "self_scores" field.
+func (src *StepRateCreate) SetSelfScores(os *objects.CommentScores) *StepRateCreate {
+	src.mutation.SetSelfScores(os)
+	return src
+}
+
+// SetMeasurement sets the "measurement" field.
+func (src *StepRateCreate) SetMeasurement(f float64) *StepRateCreate {
+	src.mutation.SetMeasurement(f)
+	return src
+}
+
+// SetNillableMeasurement sets the "measurement" field if the given value is not nil.
+func (src *StepRateCreate) SetNillableMeasurement(f *float64) *StepRateCreate {
+	if f != nil {
+		src.SetMeasurement(*f)
+	}
+	return src
+}
+
+// SetCreateCounted sets the "create_counted" field.
+func (src *StepRateCreate) SetCreateCounted(b bool) *StepRateCreate {
+	src.mutation.SetCreateCounted(b)
+	return src
+}
+
+// SetNillableCreateCounted sets the "create_counted" field if the given value is not nil.
+func (src *StepRateCreate) SetNillableCreateCounted(b *bool) *StepRateCreate {
+	if b != nil {
+		src.SetCreateCounted(*b)
+	}
+	return src
+}
+
 // SetDate sets the "date" field.
 func (src *StepRateCreate) SetDate(t time.Time) *StepRateCreate {
 	src.mutation.SetDate(t)
@@ -117,6 +152,7 @@ func (src *StepRateCreate) Mutation() *StepRateMutation {
 
 // Save creates the StepRate in the database.
 func (src *StepRateCreate) Save(ctx context.Context) (*StepRate, error) {
+	src.defaults()
 	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
 }
 
@@ -142,6 +178,18 @@ func (src *StepRateCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (src *StepRateCreate) defaults() {
+	if _, ok := src.mutation.Measurement(); !ok {
+		v := steprate.DefaultMeasurement
+		src.mutation.SetMeasurement(v)
+	}
+	if _, ok := src.mutation.CreateCounted(); !ok {
+		v := steprate.DefaultCreateCounted
+		src.mutation.SetCreateCounted(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (src *StepRateCreate) check() error {
 	if _, ok := src.mutation.UserID(); !ok {
@@ -183,6 +231,12 @@ func (src *StepRateCreate) check() error {
 	if _, ok := src.mutation.Difficulty(); !ok {
 		return &ValidationError{Name: "difficulty", err: errors.New(`ent: missing required field "StepRate.difficulty"`)}
 	}
+	if _, ok := src.mutation.Measurement(); !ok {
+		return &ValidationError{Name: "measurement", err: errors.New(`ent: missing required field "StepRate.measurement"`)}
+	}
+	if _, ok := src.mutation.CreateCounted(); !ok {
+		return &ValidationError{Name: "create_counted", err: errors.New(`ent: missing required field "StepRate.create_counted"`)}
+	}
 	if _, ok := src.mutation.Date(); !ok {
 		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "StepRate.date"`)}
 	}
@@ -270,6 +324,18 @@ func (src *StepRateCreate) createSpec() (*StepRate, *sqlgraph.CreateSpec) {
 		_spec.SetField(steprate.FieldDifficulty, field.TypeInt32, value)
 		_node.Difficulty = value
 	}
+	if value, ok := src.mutation.SelfScores(); ok {
+		_spec.SetField(steprate.FieldSelfScores, field.TypeJSON, value)
+		_node.SelfScores = value
+	}
+	if value, ok := src.mutation.Measurement(); ok {
+		_spec.SetField(steprate.FieldMeasurement, field.TypeFloat64, value)
+		_node.Measurement = value
+	}
+	if value, ok := src.mutation.CreateCounted(); ok {
+		_spec.SetField(steprate.FieldCreateCounted, field.TypeBool, value)
+		_node.CreateCounted = value
+	}
 	if value, ok := src.mutation.Date(); ok {
 		_spec.SetField(steprate.FieldDate, field.TypeTime, value)
 		_node.Date = value
@@ -295,6 +361,7 @@ func (srcb *StepRateCreateBulk) Save(ctx context.Context) ([]*StepRate, error) {
 	for i := range srcb.builders {
 		func(i int, root context.Context) {
 			builder := srcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*StepRateMutation)
 				if !ok {
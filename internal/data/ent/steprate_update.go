@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"step/internal/data/ent/predicate"
 	"step/internal/data/ent/steprate"
+	"step/internal/objects"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -295,6 +296,53 @@ func (sru *StepRateUpdate) AddDifficulty(i int32) *StepRateUpdate {
 	return sru
 }
 
+// SetSelfScores sets the "self_scores" field.
+func (sru *StepRateUpdate) SetSelfScores(os *objects.CommentScores) *StepRateUpdate {
+	sru.mutation.SetSelfScores(os)
+	return sru
+}
+
+// ClearSelfScores clears the value of the "self_scores" field.
+func (sru *StepRateUpdate) ClearSelfScores() *StepRateUpdate {
+	sru.mutation.ClearSelfScores()
+	return sru
+}
+
+// SetMeasurement sets the "measurement" field.
+func (sru *StepRateUpdate) SetMeasurement(f float64) *StepRateUpdate {
+	sru.mutation.ResetMeasurement()
+	sru.mutation.SetMeasurement(f)
+	return sru
+}
+
+// SetNillableMeasurement sets the "measurement" field if the given value is not nil.
+func (sru *StepRateUpdate) SetNillableMeasurement(f *float64) *StepRateUpdate {
+	if f != nil {
+		sru.SetMeasurement(*f)
+	}
+	return sru
+}
+
+// AddMeasurement adds f to the "measurement" field.
+func (sru *StepRateUpdate) AddMeasurement(f float64) *StepRateUpdate {
+	sru.mutation.AddMeasurement(f)
+	return sru
+}
+
+// SetCreateCounted sets the "create_counted" field.
+func (sru *StepRateUpdate) SetCreateCounted(b bool) *StepRateUpdate {
+	sru.mutation.SetCreateCounted(b)
+	return sru
+}
+
+// SetNillableCreateCounted sets the "create_counted" field if the given value is not nil.
+func (sru *StepRateUpdate) SetNillableCreateCounted(b *bool) *StepRateUpdate {
+	if b != nil {
+		sru.SetCreateCounted(*b)
+	}
+	return sru
+}
+
 // SetDate sets the "date" field.
 func (sru *StepRateUpdate) SetDate(t time.Time) *StepRateUpdate {
 	sru.mutation.SetDate(t)
@@ -431,6 +479,21 @@ func (sru *StepRateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := sru.mutation.AddedDifficulty(); ok {
 		_spec.AddField(steprate.FieldDifficulty, field.TypeInt32, value)
 	}
+	if value, ok := sru.mutation.SelfScores(); ok {
+		_spec.SetField(steprate.FieldSelfScores, field.TypeJSON, value)
+	}
+	if sru.mutation.SelfScoresCleared() {
+		_spec.ClearField(steprate.FieldSelfScores, field.TypeJSON)
+	}
+	if value, ok := sru.mutation.Measurement(); ok {
+		_spec.SetField(steprate.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.AddedMeasurement(); ok {
+		_spec.AddField(steprate.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.CreateCounted(); ok {
+		_spec.SetField(steprate.FieldCreateCounted, field.TypeBool, value)
+	}
 	if value, ok := sru.mutation.Date(); ok {
 		_spec.SetField(steprate.FieldDate, field.TypeTime, value)
 	}
@@ -722,6 +785,53 @@ func (sruo *StepRateUpdateOne) AddDifficulty(i int32) *StepRateUpdateOne {
 	return sruo
 }
 
+// SetSelfScores sets the "self_scores" field.
+func (sruo *StepRateUpdateOne) SetSelfScores(os *objects.CommentScores) *StepRateUpdateOne {
+	sruo.mutation.SetSelfScores(os)
+	return sruo
+}
+
+// ClearSelfScores clears the value of the "self_scores" field.
+func (sruo *StepRateUpdateOne) ClearSelfScores() *StepRateUpdateOne {
+	sruo.mutation.ClearSelfScores()
+	return sruo
+}
+
+// SetMeasurement sets the "measurement" field.
+func (sruo *StepRateUpdateOne) SetMeasurement(f float64) *StepRateUpdateOne {
+	sruo.mutation.ResetMeasurement()
+	sruo.mutation.SetMeasurement(f)
+	return sruo
+}
+
+// SetNillableMeasurement sets the "measurement" field if the given value is not nil.
+func (sruo *StepRateUpdateOne) SetNillableMeasurement(f *float64) *StepRateUpdateOne {
+	if f != nil {
+		sruo.SetMeasurement(*f)
+	}
+	return sruo
+}
+
+// AddMeasurement adds f to the "measurement" field.
+func (sruo *StepRateUpdateOne) AddMeasurement(f float64) *StepRateUpdateOne {
+	sruo.mutation.AddMeasurement(f)
+	return sruo
+}
+
+// SetCreateCounted sets the "create_counted" field.
+func (sruo *StepRateUpdateOne) SetCreateCounted(b bool) *StepRateUpdateOne {
+	sruo.mutation.SetCreateCounted(b)
+	return sruo
+}
+
+// SetNillableCreateCounted sets the "create_counted" field if the given value is not nil.
+func (sruo *StepRateUpdateOne) SetNillableCreateCounted(b *bool) *StepRateUpdateOne {
+	if b != nil {
+		sruo.SetCreateCounted(*b)
+	}
+	return sruo
+}
+
 // SetDate sets the "date" field.
 func (sruo *StepRateUpdateOne) SetDate(t time.Time) *StepRateUpdateOne {
 	sruo.mutation.SetDate(t)
@@ -888,6 +998,21 @@ func (sruo *StepRateUpdateOne) sqlSave(ctx context.Context) (_node *StepRate, er
 	if value, ok := sruo.mutation.AddedDifficulty(); ok {
 		_spec.AddField(steprate.FieldDifficulty, field.TypeInt32, value)
 	}
+	if value, ok := sruo.mutation.SelfScores(); ok {
+		_spec.SetField(steprate.FieldSelfScores, field.TypeJSON, value)
+	}
+	if sruo.mutation.SelfScoresCleared() {
+		_spec.ClearField(steprate.FieldSelfScores, field.TypeJSON)
+	}
+	if value, ok := sruo.mutation.Measurement(); ok {
+		_spec.SetField(steprate.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.AddedMeasurement(); ok {
+		_spec.AddField(steprate.FieldMeasurement, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.CreateCounted(); ok {
+		_spec.SetField(steprate.FieldCreateCounted, field.TypeBool, value)
+	}
 	if value, ok := sruo.mutation.Date(); ok {
 		_spec.SetField(steprate.FieldDate, field.TypeTime, value)
 	}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"step/internal/data/ent/stepratecriterion"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// StepRateCriterion is the model entity for the StepRateCriterion schema.
+type StepRateCriterion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// User ID
+	UserID string `json:"user_id,omitempty"`
+	// Top Target ID
+	TopTargetID uint64 `json:"top_target_id,omitempty"`
+	// Target ID
+	TargetID uint64 `json:"target_id,omitempty"`
+	// Step ID
+	StepID uint64 `json:"step_id,omitempty"`
+	// 自定义评分标准的评价项
+	Key string `json:"key,omitempty"`
+	// 所有评分相对中间值的偏移之和
+	Value float64 `json:"value,omitempty"`
+	// 日期
+	Date         time.Time `json:"date,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StepRateCriterion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stepratecriterion.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case stepratecriterion.FieldID, stepratecriterion.FieldTopTargetID, stepratecriterion.FieldTargetID, stepratecriterion.FieldStepID:
+			values[i] = new(sql.NullInt64)
+		case stepratecriterion.FieldUserID, stepratecriterion.FieldKey:
+			values[i] = new(sql.NullString)
+		case stepratecriterion.FieldDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StepRateCriterion fields.
+func (src *StepRateCriterion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stepratecriterion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			src.ID = uint64(value.Int64)
+		case stepratecriterion.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				src.UserID = value.String
+			}
+		case stepratecriterion.FieldTopTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field top_target_id", values[i])
+			} else if value.Valid {
+				src.TopTargetID = uint64(value.Int64)
+			}
+		case stepratecriterion.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				src.TargetID = uint64(value.Int64)
+			}
+		case stepratecriterion.FieldStepID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step_id", values[i])
+			} else if value.Valid {
+				src.StepID = uint64(value.Int64)
+			}
+		case stepratecriterion.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				src.Key = value.String
+			}
+		case stepratecriterion.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				src.Value = value.Float64
+			}
+		case stepratecriterion.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				src.Date = value.Time
+			}
+		default:
+			src.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the StepRateCriterion.
+// This includes values selected through modifiers, order, etc.
+func (src *StepRateCriterion) GetValue(name string) (ent.Value, error) {
+	return src.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StepRateCriterion.
+// Note that you need to call StepRateCriterion.Unwrap() before calling this method if this StepRateCriterion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (src *StepRateCriterion) Update() *StepRateCriterionUpdateOne {
+	return NewStepRateCriterionClient(src.config).UpdateOne(src)
+}
+
+// Unwrap unwraps the StepRateCriterion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (src *StepRateCriterion) Unwrap() *StepRateCriterion {
+	_tx, ok := src.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StepRateCriterion is not a transactional entity")
+	}
+	src.config.driver = _tx.drv
+	return src
+}
+
+// String implements the fmt.Stringer.
+func (src *StepRateCriterion) String() string {
+	var builder strings.Builder
+	builder.WriteString("StepRateCriterion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", src.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(src.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("top_target_id=")
+	builder.WriteString(fmt.Sprintf("%v", src.TopTargetID))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", src.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("step_id=")
+	builder.WriteString(fmt.Sprintf("%v", src.StepID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(src.Key)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", src.Value))
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(src.Date.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StepRateCriterions is a parsable slice of StepRateCriterion.
+type StepRateCriterions []*StepRateCriterion
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stepratecriterion
+
+import (
+	"step/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldUserID, v))
+}
+
+// TopTargetID applies equality check predicate on the "top_target_id" field. It's identical to TopTargetIDEQ.
+func TopTargetID(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldTopTargetID, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldTargetID, v))
+}
+
+// StepID applies equality check predicate on the "step_id" field. It's identical to StepIDEQ.
+func StepID(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldStepID, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldKey, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldValue, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldDate, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TopTargetIDEQ applies the EQ predicate on the "top_target_id" field.
+func TopTargetIDEQ(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldTopTargetID, v))
+}
+
+// TopTargetIDNEQ applies the NEQ predicate on the "top_target_id" field.
+func TopTargetIDNEQ(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldTopTargetID, v))
+}
+
+// TopTargetIDIn applies the In predicate on the "top_target_id" field.
+func TopTargetIDIn(vs ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldTopTargetID, vs...))
+}
+
+// TopTargetIDNotIn applies the NotIn predicate on the "top_target_id" field.
+func TopTargetIDNotIn(vs ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldTopTargetID, vs...))
+}
+
+// TopTargetIDGT applies the GT predicate on the "top_target_id" field.
+func TopTargetIDGT(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldTopTargetID, v))
+}
+
+// TopTargetIDGTE applies the GTE predicate on the "top_target_id" field.
+func TopTargetIDGTE(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldTopTargetID, v))
+}
+
+// TopTargetIDLT applies the LT predicate on the "top_target_id" field.
+func TopTargetIDLT(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldTopTargetID, v))
+}
+
+// TopTargetIDLTE applies the LTE predicate on the "top_target_id" field.
+func TopTargetIDLTE(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldTopTargetID, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldTargetID, v))
+}
+
+// StepIDEQ applies the EQ predicate on the "step_id" field.
+func StepIDEQ(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldStepID, v))
+}
+
+// StepIDNEQ applies the NEQ predicate on the "step_id" field.
+func StepIDNEQ(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldStepID, v))
+}
+
+// StepIDIn applies the In predicate on the "step_id" field.
+func StepIDIn(vs ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldStepID, vs...))
+}
+
+// StepIDNotIn applies the NotIn predicate on the "step_id" field.
+func StepIDNotIn(vs ...uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldStepID, vs...))
+}
+
+// StepIDGT applies the GT predicate on the "step_id" field.
+func StepIDGT(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldStepID, v))
+}
+
+// StepIDGTE applies the GTE predicate on the "step_id" field.
+func StepIDGTE(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldStepID, v))
+}
+
+// StepIDLT applies the LT predicate on the "step_id" field.
+func StepIDLT(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldStepID, v))
+}
+
+// StepIDLTE applies the LTE predicate on the "step_id" field.
+func StepIDLTE(v uint64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldStepID, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldContainsFold(FieldKey, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v float64) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldValue, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v time.Time) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.FieldLTE(FieldDate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StepRateCriterion) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StepRateCriterion) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StepRateCriterion) predicate.StepRateCriterion {
+	return predicate.StepRateCriterion(sql.NotPredicates(p))
+}
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/stepratecriterion"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StepRateCriterionCreate is the builder for creating a StepRateCriterion entity.
+type StepRateCriterionCreate struct {
+	config
+	mutation *StepRateCriterionMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (srcc *StepRateCriterionCreate) SetUserID(s string) *StepRateCriterionCreate {
+	srcc.mutation.SetUserID(s)
+	return srcc
+}
+
+// SetTopTargetID sets the "top_target_id" field.
+func (srcc *StepRateCriterionCreate) SetTopTargetID(u uint64) *StepRateCriterionCreate {
+	srcc.mutation.SetTopTargetID(u)
+	return srcc
+}
+
+// SetTargetID sets the "target_id" field.
+func (srcc *StepRateCriterionCreate) SetTargetID(u uint64) *StepRateCriterionCreate {
+	srcc.mutation.SetTargetID(u)
+	return srcc
+}
+
+// SetStepID sets the "step_id" field.
+func (srcc *StepRateCriterionCreate) SetStepID(u uint64) *StepRateCriterionCreate {
+	srcc.mutation.SetStepID(u)
+	return srcc
+}
+
+// SetKey sets the "key" field.
+func (srcc *StepRateCriterionCreate) SetKey(s string) *StepRateCriterionCreate {
+	srcc.mutation.SetKey(s)
+	return srcc
+}
+
+// SetValue sets the "value" field.
+func (srcc *StepRateCriterionCreate) SetValue(f float64) *StepRateCriterionCreate {
+	srcc.mutation.SetValue(f)
+	return srcc
+}
+
+// SetDate sets the "date" field.
+func (srcc *StepRateCriterionCreate) SetDate(t time.Time) *StepRateCriterionCreate {
+	srcc.mutation.SetDate(t)
+	return srcc
+}
+
+// SetID sets the "id" field.
+func (srcc *StepRateCriterionCreate) SetID(u uint64) *StepRateCriterionCreate {
+	srcc.mutation.SetID(u)
+	return srcc
+}
+
+// Mutation returns the StepRateCriterionMutation object of the builder.
+func (srcc *StepRateCriterionCreate) Mutation() *StepRateCriterionMutation {
+	return srcc.mutation
+}
+
+// Save creates the StepRateCriterion in the database.
+func (srcc *StepRateCriterionCreate) Save(ctx context.Context) (*StepRateCriterion, error) {
+	return withHooks(ctx, srcc.sqlSave, srcc.mutation, srcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (srcc *StepRateCriterionCreate) SaveX(ctx context.Context) *StepRateCriterion {
+	v, err := srcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcc *StepRateCriterionCreate) Exec(ctx context.Context) error {
+	_, err := srcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcc *StepRateCriterionCreate) ExecX(ctx context.Context) {
+	if err := srcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srcc *StepRateCriterionCreate) check() error {
+	if _, ok := srcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "StepRateCriterion.user_id"`)}
+	}
+	if _, ok := srcc.mutation.TopTargetID(); !ok {
+		return &ValidationError{Name: "top_target_id", err: errors.New(`ent: missing required field "StepRateCriterion.top_target_id"`)}
+	}
+	if _, ok := srcc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "StepRateCriterion.target_id"`)}
+	}
+	if _, ok := srcc.mutation.StepID(); !ok {
+		return &ValidationError{Name: "step_id", err: errors.New(`ent: missing required field "StepRateCriterion.step_id"`)}
+	}
+	if _, ok := srcc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "StepRateCriterion.key"`)}
+	}
+	if _, ok := srcc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "StepRateCriterion.value"`)}
+	}
+	if _, ok := srcc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "StepRateCriterion.date"`)}
+	}
+	return nil
+}
+
+func (srcc *StepRateCriterionCreate) sqlSave(ctx context.Context) (*StepRateCriterion, error) {
+	if err := srcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := srcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, srcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	srcc.mutation.id = &_node.ID
+	srcc.mutation.done = true
+	return _node, nil
+}
+
+func (srcc *StepRateCriterionCreate) createSpec() (*StepRateCriterion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StepRateCriterion{config: srcc.config}
+		_spec = sqlgraph.NewCreateSpec(stepratecriterion.Table, sqlgraph.NewFieldSpec(stepratecriterion.FieldID, field.TypeUint64))
+	)
+	if id, ok := srcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := srcc.mutation.UserID(); ok {
+		_spec.SetField(stepratecriterion.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := srcc.mutation.TopTargetID(); ok {
+		_spec.SetField(stepratecriterion.FieldTopTargetID, field.TypeUint64, value)
+		_node.TopTargetID = value
+	}
+	if value, ok := srcc.mutation.TargetID(); ok {
+		_spec.SetField(stepratecriterion.FieldTargetID, field.TypeUint64, value)
+		_node.TargetID = value
+	}
+	if value, ok := srcc.mutation.StepID(); ok {
+		_spec.SetField(stepratecriterion.FieldStepID, field.TypeUint64, value)
+		_node.StepID = value
+	}
+	if value, ok := srcc.mutation.Key(); ok {
+		_spec.SetField(stepratecriterion.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := srcc.mutation.Value(); ok {
+		_spec.SetField(stepratecriterion.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := srcc.mutation.Date(); ok {
+		_spec.SetField(stepratecriterion.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	return _node, _spec
+}
+
+// StepRateCriterionCreateBulk is the builder for creating many StepRateCriterion entities in bulk.
+type StepRateCriterionCreateBulk struct {
+	config
+	err      error
+	builders []*StepRateCriterionCreate
+}
+
+// Save creates the StepRateCriterion entities in the database.
+func (srccb *StepRateCriterionCreateBulk) Save(ctx context.Context) ([]*StepRateCriterion, error) {
+	if srccb.err != nil {
+		return nil, srccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srccb.builders))
+	nodes := make([]*StepRateCriterion, len(srccb.builders))
+	mutators := make([]Mutator, len(srccb.builders))
+	for i := range srccb.builders {
+		func(i int, root context.Context) {
+			builder := srccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StepRateCriterionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srccb *StepRateCriterionCreateBulk) SaveX(ctx context.Context) []*StepRateCriterion {
+	v, err := srccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srccb *StepRateCriterionCreateBulk) Exec(ctx context.Context) error {
+	_, err := srccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srccb *StepRateCriterionCreateBulk) ExecX(ctx context.Context) {
+	if err := srccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/stepratecriterion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StepRateCriterionDelete is the builder for deleting a StepRateCriterion entity.
+type StepRateCriterionDelete struct {
+	config
+	hooks    []Hook
+	mutation *StepRateCriterionMutation
+}
+
+// Where appends a list predicates to the StepRateCriterionDelete builder.
+func (srcd *StepRateCriterionDelete) Where(ps ...predicate.StepRateCriterion) *StepRateCriterionDelete {
+	srcd.mutation.Where(ps...)
+	return srcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srcd *StepRateCriterionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srcd.sqlExec, srcd.mutation, srcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcd *StepRateCriterionDelete) ExecX(ctx context.Context) int {
+	n, err := srcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srcd *StepRateCriterionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(stepratecriterion.Table, sqlgraph.NewFieldSpec(stepratecriterion.FieldID, field.TypeUint64))
+	if ps := srcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srcd.mutation.done = true
+	return affected, err
+}
+
+// StepRateCriterionDeleteOne is the builder for deleting a single StepRateCriterion entity.
+type StepRateCriterionDeleteOne struct {
+	srcd *StepRateCriterionDelete
+}
+
+// Where appends a list predicates to the StepRateCriterionDelete builder.
+func (srcdo *StepRateCriterionDeleteOne) Where(ps ...predicate.StepRateCriterion) *StepRateCriterionDeleteOne {
+	srcdo.srcd.mutation.Where(ps...)
+	return srcdo
+}
+
+// Exec executes the deletion query.
+func (srcdo *StepRateCriterionDeleteOne) Exec(ctx context.Context) error {
+	n, err := srcdo.srcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{stepratecriterion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcdo *StepRateCriterionDeleteOne) ExecX(ctx context.Context) {
+	if err := srcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/stepratecriterion"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StepRateCriterionQuery is the builder for querying StepRateCriterion entities.
+type StepRateCriterionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []stepratecriterion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StepRateCriterion
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StepRateCriterionQuery builder.
+func (srcq *StepRateCriterionQuery) Where(ps ...predicate.StepRateCriterion) *StepRateCriterionQuery {
+	srcq.predicates = append(srcq.predicates, ps...)
+	return srcq
+}
+
+// Limit the number of records to be returned by this query.
+func (srcq *StepRateCriterionQuery) Limit(limit int) *StepRateCriterionQuery {
+	srcq.ctx.Limit = &limit
+	return srcq
+}
+
+// Offset to start from.
+func (srcq *StepRateCriterionQuery) Offset(offset int) *StepRateCriterionQuery {
+	srcq.ctx.Offset = &offset
+	return srcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srcq *StepRateCriterionQuery) Unique(unique bool) *StepRateCriterionQuery {
+	srcq.ctx.Unique = &unique
+	return srcq
+}
+
+// Order specifies how the records should be ordered.
+func (srcq *StepRateCriterionQuery) Order(o ...stepratecriterion.OrderOption) *StepRateCriterionQuery {
+	srcq.order = append(srcq.order, o...)
+	return srcq
+}
+
+// First returns the first StepRateCriterion entity from the query.
+// Returns a *NotFoundError when no StepRateCriterion was found.
+func (srcq *StepRateCriterionQuery) First(ctx context.Context) (*StepRateCriterion, error) {
+	nodes, err := srcq.Limit(1).All(setContextOp(ctx, srcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{stepratecriterion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) FirstX(ctx context.Context) *StepRateCriterion {
+	node, err := srcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StepRateCriterion ID from the query.
+// Returns a *NotFoundError when no StepRateCriterion ID was found.
+func (srcq *StepRateCriterionQuery) FirstID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = srcq.Limit(1).IDs(setContextOp(ctx, srcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{stepratecriterion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) FirstIDX(ctx context.Context) uint64 {
+	id, err := srcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StepRateCriterion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StepRateCriterion entity is found.
+// Returns a *NotFoundError when no StepRateCriterion entities are found.
+func (srcq *StepRateCriterionQuery) Only(ctx context.Context) (*StepRateCriterion, error) {
+	nodes, err := srcq.Limit(2).All(setContextOp(ctx, srcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{stepratecriterion.Label}
+	default:
+		return nil, &NotSingularError{stepratecriterion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) OnlyX(ctx context.Context) *StepRateCriterion {
+	node, err := srcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StepRateCriterion ID in the query.
+// Returns a *NotSingularError when more than one StepRateCriterion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srcq *StepRateCriterionQuery) OnlyID(ctx context.Context) (id uint64, err error) {
+	var ids []uint64
+	if ids, err = srcq.Limit(2).IDs(setContextOp(ctx, srcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{stepratecriterion.Label}
+	default:
+		err = &NotSingularError{stepratecriterion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) OnlyIDX(ctx context.Context) uint64 {
+	id, err := srcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StepRateCriterions.
+func (srcq *StepRateCriterionQuery) All(ctx context.Context) ([]*StepRateCriterion, error) {
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryAll)
+	if err := srcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StepRateCriterion, *StepRateCriterionQuery]()
+	return withInterceptors[[]*StepRateCriterion](ctx, srcq, qr, srcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) AllX(ctx context.Context) []*StepRateCriterion {
+	nodes, err := srcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StepRateCriterion IDs.
+func (srcq *StepRateCriterionQuery) IDs(ctx context.Context) (ids []uint64, err error) {
+	if srcq.ctx.Unique == nil && srcq.path != nil {
+		srcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryIDs)
+	if err = srcq.Select(stepratecriterion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) IDsX(ctx context.Context) []uint64 {
+	ids, err := srcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srcq *StepRateCriterionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryCount)
+	if err := srcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srcq, querierCount[*StepRateCriterionQuery](), srcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) CountX(ctx context.Context) int {
+	count, err := srcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srcq *StepRateCriterionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srcq.ctx, ent.OpQueryExist)
+	switch _, err := srcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srcq *StepRateCriterionQuery) ExistX(ctx context.Context) bool {
+	exist, err := srcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StepRateCriterionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srcq *StepRateCriterionQuery) Clone() *StepRateCriterionQuery {
+	if srcq == nil {
+		return nil
+	}
+	return &StepRateCriterionQuery{
+		config:     srcq.config,
+		ctx:        srcq.ctx.Clone(),
+		order:      append([]stepratecriterion.OrderOption{}, srcq.order...),
+		inters:     append([]Interceptor{}, srcq.inters...),
+		predicates: append([]predicate.StepRateCriterion{}, srcq.predicates...),
+		// clone intermediate query.
+		sql:  srcq.sql.Clone(),
+		path: srcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StepRateCriterion.Query().
+//		GroupBy(stepratecriterion.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srcq *StepRateCriterionQuery) GroupBy(field string, fields ...string) *StepRateCriterionGroupBy {
+	srcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StepRateCriterionGroupBy{build: srcq}
+	grbuild.flds = &srcq.ctx.Fields
+	grbuild.label = stepratecriterion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.StepRateCriterion.Query().
+//		Select(stepratecriterion.FieldUserID).
+//		Scan(ctx, &v)
+func (srcq *StepRateCriterionQuery) Select(fields ...string) *StepRateCriterionSelect {
+	srcq.ctx.Fields = append(srcq.ctx.Fields, fields...)
+	sbuild := &StepRateCriterionSelect{StepRateCriterionQuery: srcq}
+	sbuild.label = stepratecriterion.Label
+	sbuild.flds, sbuild.scan = &srcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StepRateCriterionSelect configured with the given aggregations.
+func (srcq *StepRateCriterionQuery) Aggregate(fns ...AggregateFunc) *StepRateCriterionSelect {
+	return srcq.Select().Aggregate(fns...)
+}
+
+func (srcq *StepRateCriterionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srcq.ctx.Fields {
+		if !stepratecriterion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srcq.path != nil {
+		prev, err := srcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srcq.sql = prev
+	}
+	return nil
+}
+
+func (srcq *StepRateCriterionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StepRateCriterion, error) {
+	var (
+		nodes = []*StepRateCriterion{}
+		_spec = srcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StepRateCriterion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StepRateCriterion{config: srcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(srcq.modifiers) > 0 {
+		_spec.Modifiers = srcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (srcq *StepRateCriterionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srcq.querySpec()
+	if len(srcq.modifiers) > 0 {
+		_spec.Modifiers = srcq.modifiers
+	}
+	_spec.Node.Columns = srcq.ctx.Fields
+	if len(srcq.ctx.Fields) > 0 {
+		_spec.Unique = srcq.ctx.Unique != nil && *srcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srcq.driver, _spec)
+}
+
+func (srcq *StepRateCriterionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(stepratecriterion.Table, stepratecriterion.Columns, sqlgraph.NewFieldSpec(stepratecriterion.FieldID, field.TypeUint64))
+	_spec.From = srcq.sql
+	if unique := srcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stepratecriterion.FieldID)
+		for i := range fields {
+			if fields[i] != stepratecriterion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := srcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srcq *StepRateCriterionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srcq.driver.Dialect())
+	t1 := builder.Table(stepratecriterion.Table)
+	columns := srcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = stepratecriterion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srcq.sql != nil {
+		selector = srcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srcq.ctx.Unique != nil && *srcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range srcq.modifiers {
+		m(selector)
+	}
+	for _, p := range srcq.predicates {
+		p(selector)
+	}
+	for _, p := range srcq.order {
+		p(selector)
+	}
+	if offset := srcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (srcq *StepRateCriterionQuery) Modify(modifiers ...func(s *sql.Selector)) *StepRateCriterionSelect {
+	srcq.modifiers = append(srcq.modifiers, modifiers...)
+	return srcq.Select()
+}
+
+// StepRateCriterionGroupBy is the group-by builder for StepRateCriterion entities.
+type StepRateCriterionGroupBy struct {
+	selector
+	build *StepRateCriterionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srcgb *StepRateCriterionGroupBy) Aggregate(fns ...AggregateFunc) *StepRateCriterionGroupBy {
+	srcgb.fns = append(srcgb.fns, fns...)
+	return srcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srcgb *StepRateCriterionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StepRateCriterionQuery, *StepRateCriterionGroupBy](ctx, srcgb.build, srcgb, srcgb.build.inters, v)
+}
+
+func (srcgb *StepRateCriterionGroupBy) sqlScan(ctx context.Context, root *StepRateCriterionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srcgb.fns))
+	for _, fn := range srcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srcgb.flds)+len(srcgb.fns))
+		for _, f := range *srcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StepRateCriterionSelect is the builder for selecting fields of StepRateCriterion entities.
+type StepRateCriterionSelect struct {
+	*StepRateCriterionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srcs *StepRateCriterionSelect) Aggregate(fns ...AggregateFunc) *StepRateCriterionSelect {
+	srcs.fns = append(srcs.fns, fns...)
+	return srcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srcs *StepRateCriterionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srcs.ctx, ent.OpQuerySelect)
+	if err := srcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StepRateCriterionQuery, *StepRateCriterionSelect](ctx, srcs.StepRateCriterionQuery, srcs, srcs.inters, v)
+}
+
+func (srcs *StepRateCriterionSelect) sqlScan(ctx context.Context, root *StepRateCriterionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srcs.fns))
+	for _, fn := range srcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (srcs *StepRateCriterionSelect) Modify(modifiers ...func(s *sql.Selector)) *StepRateCriterionSelect {
+	srcs.modifiers = append(srcs.modifiers, modifiers...)
+	return srcs
+}
@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/stepratecriterion"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StepRateCriterionUpdate is the builder for updating StepRateCriterion entities.
+type StepRateCriterionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StepRateCriterionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the StepRateCriterionUpdate builder.
+func (srcu *StepRateCriterionUpdate) Where(ps ...predicate.StepRateCriterion) *StepRateCriterionUpdate {
+	srcu.mutation.Where(ps...)
+	return srcu
+}
+
+// SetUserID sets the "user_id" field.
+func (srcu *StepRateCriterionUpdate) SetUserID(s string) *StepRateCriterionUpdate {
+	srcu.mutation.SetUserID(s)
+	return srcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableUserID(s *string) *StepRateCriterionUpdate {
+	if s != nil {
+		srcu.SetUserID(*s)
+	}
+	return srcu
+}
+
+// SetTopTargetID sets the "top_target_id" field.
+func (srcu *StepRateCriterionUpdate) SetTopTargetID(u uint64) *StepRateCriterionUpdate {
+	srcu.mutation.ResetTopTargetID()
+	srcu.mutation.SetTopTargetID(u)
+	return srcu
+}
+
+// SetNillableTopTargetID sets the "top_target_id" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableTopTargetID(u *uint64) *StepRateCriterionUpdate {
+	if u != nil {
+		srcu.SetTopTargetID(*u)
+	}
+	return srcu
+}
+
+// AddTopTargetID adds u to the "top_target_id" field.
+func (srcu *StepRateCriterionUpdate) AddTopTargetID(u int64) *StepRateCriterionUpdate {
+	srcu.mutation.AddTopTargetID(u)
+	return srcu
+}
+
+// SetTargetID sets the "target_id" field.
+func (srcu *StepRateCriterionUpdate) SetTargetID(u uint64) *StepRateCriterionUpdate {
+	srcu.mutation.ResetTargetID()
+	srcu.mutation.SetTargetID(u)
+	return srcu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableTargetID(u *uint64) *StepRateCriterionUpdate {
+	if u != nil {
+		srcu.SetTargetID(*u)
+	}
+	return srcu
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (srcu *StepRateCriterionUpdate) AddTargetID(u int64) *StepRateCriterionUpdate {
+	srcu.mutation.AddTargetID(u)
+	return srcu
+}
+
+// SetStepID sets the "step_id" field.
+func (srcu *StepRateCriterionUpdate) SetStepID(u uint64) *StepRateCriterionUpdate {
+	srcu.mutation.ResetStepID()
+	srcu.mutation.SetStepID(u)
+	return srcu
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableStepID(u *uint64) *StepRateCriterionUpdate {
+	if u != nil {
+		srcu.SetStepID(*u)
+	}
+	return srcu
+}
+
+// AddStepID adds u to the "step_id" field.
+func (srcu *StepRateCriterionUpdate) AddStepID(u int64) *StepRateCriterionUpdate {
+	srcu.mutation.AddStepID(u)
+	return srcu
+}
+
+// SetKey sets the "key" field.
+func (srcu *StepRateCriterionUpdate) SetKey(s string) *StepRateCriterionUpdate {
+	srcu.mutation.SetKey(s)
+	return srcu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableKey(s *string) *StepRateCriterionUpdate {
+	if s != nil {
+		srcu.SetKey(*s)
+	}
+	return srcu
+}
+
+// SetValue sets the "value" field.
+func (srcu *StepRateCriterionUpdate) SetValue(f float64) *StepRateCriterionUpdate {
+	srcu.mutation.ResetValue()
+	srcu.mutation.SetValue(f)
+	return srcu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableValue(f *float64) *StepRateCriterionUpdate {
+	if f != nil {
+		srcu.SetValue(*f)
+	}
+	return srcu
+}
+
+// AddValue adds f to the "value" field.
+func (srcu *StepRateCriterionUpdate) AddValue(f float64) *StepRateCriterionUpdate {
+	srcu.mutation.AddValue(f)
+	return srcu
+}
+
+// SetDate sets the "date" field.
+func (srcu *StepRateCriterionUpdate) SetDate(t time.Time) *StepRateCriterionUpdate {
+	srcu.mutation.SetDate(t)
+	return srcu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (srcu *StepRateCriterionUpdate) SetNillableDate(t *time.Time) *StepRateCriterionUpdate {
+	if t != nil {
+		srcu.SetDate(*t)
+	}
+	return srcu
+}
+
+// Mutation returns the StepRateCriterionMutation object of the builder.
+func (srcu *StepRateCriterionUpdate) Mutation() *StepRateCriterionMutation {
+	return srcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (srcu *StepRateCriterionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, srcu.sqlSave, srcu.mutation, srcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcu *StepRateCriterionUpdate) SaveX(ctx context.Context) int {
+	affected, err := srcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (srcu *StepRateCriterionUpdate) Exec(ctx context.Context) error {
+	_, err := srcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcu *StepRateCriterionUpdate) ExecX(ctx context.Context) {
+	if err := srcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (srcu *StepRateCriterionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StepRateCriterionUpdate {
+	srcu.modifiers = append(srcu.modifiers, modifiers...)
+	return srcu
+}
+
+func (srcu *StepRateCriterionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stepratecriterion.Table, stepratecriterion.Columns, sqlgraph.NewFieldSpec(stepratecriterion.FieldID, field.TypeUint64))
+	if ps := srcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := srcu.mutation.UserID(); ok {
+		_spec.SetField(stepratecriterion.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := srcu.mutation.TopTargetID(); ok {
+		_spec.SetField(stepratecriterion.FieldTopTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcu.mutation.AddedTopTargetID(); ok {
+		_spec.AddField(stepratecriterion.FieldTopTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcu.mutation.TargetID(); ok {
+		_spec.SetField(stepratecriterion.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcu.mutation.AddedTargetID(); ok {
+		_spec.AddField(stepratecriterion.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcu.mutation.StepID(); ok {
+		_spec.SetField(stepratecriterion.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := srcu.mutation.AddedStepID(); ok {
+		_spec.AddField(stepratecriterion.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := srcu.mutation.Key(); ok {
+		_spec.SetField(stepratecriterion.FieldKey, field.TypeString, value)
+	}
+	if value, ok := srcu.mutation.Value(); ok {
+		_spec.SetField(stepratecriterion.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := srcu.mutation.AddedValue(); ok {
+		_spec.AddField(stepratecriterion.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := srcu.mutation.Date(); ok {
+		_spec.SetField(stepratecriterion.FieldDate, field.TypeTime, value)
+	}
+	_spec.AddModifiers(srcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, srcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stepratecriterion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	srcu.mutation.done = true
+	return n, nil
+}
+
+// StepRateCriterionUpdateOne is the builder for updating a single StepRateCriterion entity.
+type StepRateCriterionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StepRateCriterionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (srcuo *StepRateCriterionUpdateOne) SetUserID(s string) *StepRateCriterionUpdateOne {
+	srcuo.mutation.SetUserID(s)
+	return srcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableUserID(s *string) *StepRateCriterionUpdateOne {
+	if s != nil {
+		srcuo.SetUserID(*s)
+	}
+	return srcuo
+}
+
+// SetTopTargetID sets the "top_target_id" field.
+func (srcuo *StepRateCriterionUpdateOne) SetTopTargetID(u uint64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.ResetTopTargetID()
+	srcuo.mutation.SetTopTargetID(u)
+	return srcuo
+}
+
+// SetNillableTopTargetID sets the "top_target_id" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableTopTargetID(u *uint64) *StepRateCriterionUpdateOne {
+	if u != nil {
+		srcuo.SetTopTargetID(*u)
+	}
+	return srcuo
+}
+
+// AddTopTargetID adds u to the "top_target_id" field.
+func (srcuo *StepRateCriterionUpdateOne) AddTopTargetID(u int64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.AddTopTargetID(u)
+	return srcuo
+}
+
+// SetTargetID sets the "target_id" field.
+func (srcuo *StepRateCriterionUpdateOne) SetTargetID(u uint64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.ResetTargetID()
+	srcuo.mutation.SetTargetID(u)
+	return srcuo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableTargetID(u *uint64) *StepRateCriterionUpdateOne {
+	if u != nil {
+		srcuo.SetTargetID(*u)
+	}
+	return srcuo
+}
+
+// AddTargetID adds u to the "target_id" field.
+func (srcuo *StepRateCriterionUpdateOne) AddTargetID(u int64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.AddTargetID(u)
+	return srcuo
+}
+
+// SetStepID sets the "step_id" field.
+func (srcuo *StepRateCriterionUpdateOne) SetStepID(u uint64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.ResetStepID()
+	srcuo.mutation.SetStepID(u)
+	return srcuo
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableStepID(u *uint64) *StepRateCriterionUpdateOne {
+	if u != nil {
+		srcuo.SetStepID(*u)
+	}
+	return srcuo
+}
+
+// AddStepID adds u to the "step_id" field.
+func (srcuo *StepRateCriterionUpdateOne) AddStepID(u int64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.AddStepID(u)
+	return srcuo
+}
+
+// SetKey sets the "key" field.
+func (srcuo *StepRateCriterionUpdateOne) SetKey(s string) *StepRateCriterionUpdateOne {
+	srcuo.mutation.SetKey(s)
+	return srcuo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableKey(s *string) *StepRateCriterionUpdateOne {
+	if s != nil {
+		srcuo.SetKey(*s)
+	}
+	return srcuo
+}
+
+// SetValue sets the "value" field.
+func (srcuo *StepRateCriterionUpdateOne) SetValue(f float64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.ResetValue()
+	srcuo.mutation.SetValue(f)
+	return srcuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableValue(f *float64) *StepRateCriterionUpdateOne {
+	if f != nil {
+		srcuo.SetValue(*f)
+	}
+	return srcuo
+}
+
+// AddValue adds f to the "value" field.
+func (srcuo *StepRateCriterionUpdateOne) AddValue(f float64) *StepRateCriterionUpdateOne {
+	srcuo.mutation.AddValue(f)
+	return srcuo
+}
+
+// SetDate sets the "date" field.
+func (srcuo *StepRateCriterionUpdateOne) SetDate(t time.Time) *StepRateCriterionUpdateOne {
+	srcuo.mutation.SetDate(t)
+	return srcuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (srcuo *StepRateCriterionUpdateOne) SetNillableDate(t *time.Time) *StepRateCriterionUpdateOne {
+	if t != nil {
+		srcuo.SetDate(*t)
+	}
+	return srcuo
+}
+
+// Mutation returns the StepRateCriterionMutation object of the builder.
+func (srcuo *StepRateCriterionUpdateOne) Mutation() *StepRateCriterionMutation {
+	return srcuo.mutation
+}
+
+// Where appends a list predicates to the StepRateCriterionUpdate builder.
+func (srcuo *StepRateCriterionUpdateOne) Where(ps ...predicate.StepRateCriterion) *StepRateCriterionUpdateOne {
+	srcuo.mutation.Where(ps...)
+	return srcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (srcuo *StepRateCriterionUpdateOne) Select(field string, fields ...string) *StepRateCriterionUpdateOne {
+	srcuo.fields = append([]string{field}, fields...)
+	return srcuo
+}
+
+// Save executes the query and returns the updated StepRateCriterion entity.
+func (srcuo *StepRateCriterionUpdateOne) Save(ctx context.Context) (*StepRateCriterion, error) {
+	return withHooks(ctx, srcuo.sqlSave, srcuo.mutation, srcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcuo *StepRateCriterionUpdateOne) SaveX(ctx context.Context) *StepRateCriterion {
+	node, err := srcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (srcuo *StepRateCriterionUpdateOne) Exec(ctx context.Context) error {
+	_, err := srcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcuo *StepRateCriterionUpdateOne) ExecX(ctx context.Context) {
+	if err := srcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (srcuo *StepRateCriterionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StepRateCriterionUpdateOne {
+	srcuo.modifiers = append(srcuo.modifiers, modifiers...)
+	return srcuo
+}
+
+func (srcuo *StepRateCriterionUpdateOne) sqlSave(ctx context.Context) (_node *StepRateCriterion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(stepratecriterion.Table, stepratecriterion.Columns, sqlgraph.NewFieldSpec(stepratecriterion.FieldID, field.TypeUint64))
+	id, ok := srcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StepRateCriterion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := srcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stepratecriterion.FieldID)
+		for _, f := range fields {
+			if !stepratecriterion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stepratecriterion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := srcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := srcuo.mutation.UserID(); ok {
+		_spec.SetField(stepratecriterion.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := srcuo.mutation.TopTargetID(); ok {
+		_spec.SetField(stepratecriterion.FieldTopTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcuo.mutation.AddedTopTargetID(); ok {
+		_spec.AddField(stepratecriterion.FieldTopTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcuo.mutation.TargetID(); ok {
+		_spec.SetField(stepratecriterion.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcuo.mutation.AddedTargetID(); ok {
+		_spec.AddField(stepratecriterion.FieldTargetID, field.TypeUint64, value)
+	}
+	if value, ok := srcuo.mutation.StepID(); ok {
+		_spec.SetField(stepratecriterion.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := srcuo.mutation.AddedStepID(); ok {
+		_spec.AddField(stepratecriterion.FieldStepID, field.TypeUint64, value)
+	}
+	if value, ok := srcuo.mutation.Key(); ok {
+		_spec.SetField(stepratecriterion.FieldKey, field.TypeString, value)
+	}
+	if value, ok := srcuo.mutation.Value(); ok {
+		_spec.SetField(stepratecriterion.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := srcuo.mutation.AddedValue(); ok {
+		_spec.AddField(stepratecriterion.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := srcuo.mutation.Date(); ok {
+		_spec.SetField(stepratecriterion.FieldDate, field.TypeTime, value)
+	}
+	_spec.AddModifiers(srcuo.modifiers...)
+	_node = &StepRateCriterion{config: srcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, srcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stepratecriterion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	srcuo.mutation.done = true
+	return _node, nil
+}
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"step/internal/data/ent/target"
+	"step/internal/objects"
 	"strings"
 
 	"entgo.io/ent"
@@ -39,6 +41,24 @@ type Target struct {
 	Status target.Status `json:"status,omitempty"`
 	// 父ID
 	ParentID uint64 `json:"parent_id,omitempty"`
+	// 打卡周期类型
+	ScheduleType target.ScheduleType `json:"schedule_type,omitempty"`
+	// 每周打卡日, 0-6, 0为周日
+	ScheduleWeekdays []int `json:"schedule_weekdays,omitempty"`
+	// 每周打卡次数
+	ScheduleTimes uint32 `json:"schedule_times,omitempty"`
+	// 目标模式
+	Mode target.Mode `json:"mode,omitempty"`
+	// 计量单位
+	Unit string `json:"unit,omitempty"`
+	// 目标值
+	GoalValue float64 `json:"goal_value,omitempty"`
+	// 手动排序, 0为未排序
+	SortOrder float64 `json:"sort_order,omitempty"`
+	// 祖先目标ID路径, 如/1/5/, 顶层为/
+	Path string `json:"path,omitempty"`
+	// 自定义评分标准, 只用于顶层目标, 为空时使用默认评分标准
+	Rubric *objects.TargetRubric `json:"rubric,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TargetQuery when eager-loading is set.
 	Edges        TargetEdges `json:"edges"`
@@ -53,9 +73,11 @@ type TargetEdges struct {
 	Children []*Target `json:"children,omitempty"`
 	// Steps holds the value of the steps edge.
 	Steps []*Step `json:"steps,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -87,14 +109,27 @@ func (e TargetEdges) StepsOrErr() ([]*Step, error) {
 	return nil, &NotLoadedError{edge: "steps"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TargetEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Target) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case target.FieldID, target.FieldCreatedAt, target.FieldStartAt, target.FieldChallengeAt, target.FieldDoneAt, target.FieldLayer, target.FieldParentID:
+		case target.FieldScheduleWeekdays, target.FieldRubric:
+			values[i] = new([]byte)
+		case target.FieldGoalValue, target.FieldSortOrder:
+			values[i] = new(sql.NullFloat64)
+		case target.FieldID, target.FieldCreatedAt, target.FieldStartAt, target.FieldChallengeAt, target.FieldDoneAt, target.FieldLayer, target.FieldParentID, target.FieldScheduleTimes:
 			values[i] = new(sql.NullInt64)
-		case target.FieldUserID, target.FieldTitle, target.FieldDescription, target.FieldType, target.FieldStatus:
+		case target.FieldUserID, target.FieldTitle, target.FieldDescription, target.FieldType, target.FieldStatus, target.FieldScheduleType, target.FieldMode, target.FieldUnit, target.FieldPath:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -183,6 +218,64 @@ func (t *Target) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.ParentID = uint64(value.Int64)
 			}
+		case target.FieldScheduleType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_type", values[i])
+			} else if value.Valid {
+				t.ScheduleType = target.ScheduleType(value.String)
+			}
+		case target.FieldScheduleWeekdays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_weekdays", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.ScheduleWeekdays); err != nil {
+					return fmt.Errorf("unmarshal field schedule_weekdays: %w", err)
+				}
+			}
+		case target.FieldScheduleTimes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_times", values[i])
+			} else if value.Valid {
+				t.ScheduleTimes = uint32(value.Int64)
+			}
+		case target.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				t.Mode = target.Mode(value.String)
+			}
+		case target.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				t.Unit = value.String
+			}
+		case target.FieldGoalValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field goal_value", values[i])
+			} else if value.Valid {
+				t.GoalValue = value.Float64
+			}
+		case target.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				t.SortOrder = value.Float64
+			}
+		case target.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				t.Path = value.String
+			}
+		case target.FieldRubric:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rubric", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Rubric); err != nil {
+					return fmt.Errorf("unmarshal field rubric: %w", err)
+				}
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -211,6 +304,11 @@ func (t *Target) QuerySteps() *StepQuery {
 	return NewTargetClient(t.config).QuerySteps(t)
 }
 
+// QueryTags queries the "tags" edge of the Target entity.
+func (t *Target) QueryTags() *TagQuery {
+	return NewTargetClient(t.config).QueryTags(t)
+}
+
 // Update returns a builder for updating this Target.
 // Note that you need to call Target.Unwrap() before calling this method if this Target
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -266,6 +364,33 @@ func (t *Target) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("parent_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_type=")
+	builder.WriteString(fmt.Sprintf("%v", t.ScheduleType))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_weekdays=")
+	builder.WriteString(fmt.Sprintf("%v", t.ScheduleWeekdays))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_times=")
+	builder.WriteString(fmt.Sprintf("%v", t.ScheduleTimes))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", t.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(t.Unit)
+	builder.WriteString(", ")
+	builder.WriteString("goal_value=")
+	builder.WriteString(fmt.Sprintf("%v", t.GoalValue))
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", t.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(t.Path)
+	builder.WriteString(", ")
+	builder.WriteString("rubric=")
+	builder.WriteString(fmt.Sprintf("%v", t.Rubric))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -36,12 +36,32 @@ const (
 	FieldStatus = "status"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldScheduleType holds the string denoting the schedule_type field in the database.
+	FieldScheduleType = "schedule_type"
+	// FieldScheduleWeekdays holds the string denoting the schedule_weekdays field in the database.
+	FieldScheduleWeekdays = "schedule_weekdays"
+	// FieldScheduleTimes holds the string denoting the schedule_times field in the database.
+	FieldScheduleTimes = "schedule_times"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldGoalValue holds the string denoting the goal_value field in the database.
+	FieldGoalValue = "goal_value"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldRubric holds the string denoting the rubric field in the database.
+	FieldRubric = "rubric"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
 	EdgeChildren = "children"
 	// EdgeSteps holds the string denoting the steps edge name in mutations.
 	EdgeSteps = "steps"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
 	// Table holds the table name of the target in the database.
 	Table = "targets"
 	// ParentTable is the table that holds the parent relation/edge.
@@ -59,6 +79,11 @@ const (
 	StepsInverseTable = "steps"
 	// StepsColumn is the table column denoting the steps relation/edge.
 	StepsColumn = "ref_target_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_targets"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
 )
 
 // Columns holds all SQL columns for target fields.
@@ -75,8 +100,23 @@ var Columns = []string{
 	FieldLayer,
 	FieldStatus,
 	FieldParentID,
+	FieldScheduleType,
+	FieldScheduleWeekdays,
+	FieldScheduleTimes,
+	FieldMode,
+	FieldUnit,
+	FieldGoalValue,
+	FieldSortOrder,
+	FieldPath,
+	FieldRubric,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "target_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -98,6 +138,12 @@ var (
 	DefaultCreatedAt int64
 	// DefaultLayer holds the default value on creation for the "layer" field.
 	DefaultLayer uint32
+	// UnitValidator is a validator for the "unit" field. It is called by the builders before save.
+	UnitValidator func(string) error
+	// DefaultSortOrder holds the default value on creation for the "sort_order" field.
+	DefaultSortOrder float64
+	// DefaultPath holds the default value on creation for the "path" field.
+	DefaultPath string
 )
 
 // Status defines the type for the "status" enum field.
@@ -128,6 +174,60 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// ScheduleType defines the type for the "schedule_type" enum field.
+type ScheduleType string
+
+// ScheduleTypeNone is the default value of the ScheduleType enum.
+const DefaultScheduleType = ScheduleTypeNone
+
+// ScheduleType values.
+const (
+	ScheduleTypeNone         ScheduleType = "none"
+	ScheduleTypeDaily        ScheduleType = "daily"
+	ScheduleTypeWeekdays     ScheduleType = "weekdays"
+	ScheduleTypeTimesPerWeek ScheduleType = "times_per_week"
+)
+
+func (st ScheduleType) String() string {
+	return string(st)
+}
+
+// ScheduleTypeValidator is a validator for the "schedule_type" field enum values. It is called by the builders before save.
+func ScheduleTypeValidator(st ScheduleType) error {
+	switch st {
+	case ScheduleTypeNone, ScheduleTypeDaily, ScheduleTypeWeekdays, ScheduleTypeTimesPerWeek:
+		return nil
+	default:
+		return fmt.Errorf("target: invalid enum value for schedule_type field: %q", st)
+	}
+}
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// ModeCheckIn is the default value of the Mode enum.
+const DefaultMode = ModeCheckIn
+
+// Mode values.
+const (
+	ModeCheckIn  Mode = "check_in"
+	ModeQuantity Mode = "quantity"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeCheckIn, ModeQuantity:
+		return nil
+	default:
+		return fmt.Errorf("target: invalid enum value for mode field: %q", m)
+	}
+}
+
 // OrderOption defines the ordering options for the Target queries.
 type OrderOption func(*sql.Selector)
 
@@ -191,6 +291,41 @@ func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
+// ByScheduleType orders the results by the schedule_type field.
+func ByScheduleType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduleType, opts...).ToFunc()
+}
+
+// ByScheduleTimes orders the results by the schedule_times field.
+func ByScheduleTimes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduleTimes, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByUnit orders the results by the unit field.
+func ByUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnit, opts...).ToFunc()
+}
+
+// ByGoalValue orders the results by the goal_value field.
+func ByGoalValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGoalValue, opts...).ToFunc()
+}
+
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -225,6 +360,20 @@ func BySteps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newStepsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -246,3 +395,10 @@ func newStepsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, StepsTable, StepsColumn),
 	)
 }
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
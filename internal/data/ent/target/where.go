@@ -104,6 +104,31 @@ func ParentID(v uint64) predicate.Target {
 	return predicate.Target(sql.FieldEQ(FieldParentID, v))
 }
 
+// ScheduleTimes applies equality check predicate on the "schedule_times" field. It's identical to ScheduleTimesEQ.
+func ScheduleTimes(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldScheduleTimes, v))
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v string) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldUnit, v))
+}
+
+// GoalValue applies equality check predicate on the "goal_value" field. It's identical to GoalValueEQ.
+func GoalValue(v float64) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldGoalValue, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v float64) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldPath, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v string) predicate.Target {
 	return predicate.Target(sql.FieldEQ(FieldUserID, v))
@@ -644,6 +669,346 @@ func ParentIDNotNil() predicate.Target {
 	return predicate.Target(sql.FieldNotNull(FieldParentID))
 }
 
+// ScheduleTypeEQ applies the EQ predicate on the "schedule_type" field.
+func ScheduleTypeEQ(v ScheduleType) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldScheduleType, v))
+}
+
+// ScheduleTypeNEQ applies the NEQ predicate on the "schedule_type" field.
+func ScheduleTypeNEQ(v ScheduleType) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldScheduleType, v))
+}
+
+// ScheduleTypeIn applies the In predicate on the "schedule_type" field.
+func ScheduleTypeIn(vs ...ScheduleType) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldScheduleType, vs...))
+}
+
+// ScheduleTypeNotIn applies the NotIn predicate on the "schedule_type" field.
+func ScheduleTypeNotIn(vs ...ScheduleType) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldScheduleType, vs...))
+}
+
+// ScheduleWeekdaysIsNil applies the IsNil predicate on the "schedule_weekdays" field.
+func ScheduleWeekdaysIsNil() predicate.Target {
+	return predicate.Target(sql.FieldIsNull(FieldScheduleWeekdays))
+}
+
+// ScheduleWeekdaysNotNil applies the NotNil predicate on the "schedule_weekdays" field.
+func ScheduleWeekdaysNotNil() predicate.Target {
+	return predicate.Target(sql.FieldNotNull(FieldScheduleWeekdays))
+}
+
+// ScheduleTimesEQ applies the EQ predicate on the "schedule_times" field.
+func ScheduleTimesEQ(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldScheduleTimes, v))
+}
+
+// ScheduleTimesNEQ applies the NEQ predicate on the "schedule_times" field.
+func ScheduleTimesNEQ(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldScheduleTimes, v))
+}
+
+// ScheduleTimesIn applies the In predicate on the "schedule_times" field.
+func ScheduleTimesIn(vs ...uint32) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldScheduleTimes, vs...))
+}
+
+// ScheduleTimesNotIn applies the NotIn predicate on the "schedule_times" field.
+func ScheduleTimesNotIn(vs ...uint32) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldScheduleTimes, vs...))
+}
+
+// ScheduleTimesGT applies the GT predicate on the "schedule_times" field.
+func ScheduleTimesGT(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldGT(FieldScheduleTimes, v))
+}
+
+// ScheduleTimesGTE applies the GTE predicate on the "schedule_times" field.
+func ScheduleTimesGTE(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldGTE(FieldScheduleTimes, v))
+}
+
+// ScheduleTimesLT applies the LT predicate on the "schedule_times" field.
+func ScheduleTimesLT(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldLT(FieldScheduleTimes, v))
+}
+
+// ScheduleTimesLTE applies the LTE predicate on the "schedule_times" field.
+func ScheduleTimesLTE(v uint32) predicate.Target {
+	return predicate.Target(sql.FieldLTE(FieldScheduleTimes, v))
+}
+
+// ScheduleTimesIsNil applies the IsNil predicate on the "schedule_times" field.
+func ScheduleTimesIsNil() predicate.Target {
+	return predicate.Target(sql.FieldIsNull(FieldScheduleTimes))
+}
+
+// ScheduleTimesNotNil applies the NotNil predicate on the "schedule_times" field.
+func ScheduleTimesNotNil() predicate.Target {
+	return predicate.Target(sql.FieldNotNull(FieldScheduleTimes))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v string) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v string) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...string) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...string) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v string) predicate.Target {
+	return predicate.Target(sql.FieldGT(FieldUnit, v))
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v string) predicate.Target {
+	return predicate.Target(sql.FieldGTE(FieldUnit, v))
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v string) predicate.Target {
+	return predicate.Target(sql.FieldLT(FieldUnit, v))
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v string) predicate.Target {
+	return predicate.Target(sql.FieldLTE(FieldUnit, v))
+}
+
+// UnitContains applies the Contains predicate on the "unit" field.
+func UnitContains(v string) predicate.Target {
+	return predicate.Target(sql.FieldContains(FieldUnit, v))
+}
+
+// UnitHasPrefix applies the HasPrefix predicate on the "unit" field.
+func UnitHasPrefix(v string) predicate.Target {
+	return predicate.Target(sql.FieldHasPrefix(FieldUnit, v))
+}
+
+// UnitHasSuffix applies the HasSuffix predicate on the "unit" field.
+func UnitHasSuffix(v string) predicate.Target {
+	return predicate.Target(sql.FieldHasSuffix(FieldUnit, v))
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.Target {
+	return predicate.Target(sql.FieldIsNull(FieldUnit))
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.Target {
+	return predicate.Target(sql.FieldNotNull(FieldUnit))
+}
+
+// UnitEqualFold applies the EqualFold predicate on the "unit" field.
+func UnitEqualFold(v string) predicate.Target {
+	return predicate.Target(sql.FieldEqualFold(FieldUnit, v))
+}
+
+// UnitContainsFold applies the ContainsFold predicate on the "unit" field.
+func UnitContainsFold(v string) predicate.Target {
+	return predicate.Target(sql.FieldContainsFold(FieldUnit, v))
+}
+
+// GoalValueEQ applies the EQ predicate on the "goal_value" field.
+func GoalValueEQ(v float64) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldGoalValue, v))
+}
+
+// GoalValueNEQ applies the NEQ predicate on the "goal_value" field.
+func GoalValueNEQ(v float64) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldGoalValue, v))
+}
+
+// GoalValueIn applies the In predicate on the "goal_value" field.
+func GoalValueIn(vs ...float64) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldGoalValue, vs...))
+}
+
+// GoalValueNotIn applies the NotIn predicate on the "goal_value" field.
+func GoalValueNotIn(vs ...float64) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldGoalValue, vs...))
+}
+
+// GoalValueGT applies the GT predicate on the "goal_value" field.
+func GoalValueGT(v float64) predicate.Target {
+	return predicate.Target(sql.FieldGT(FieldGoalValue, v))
+}
+
+// GoalValueGTE applies the GTE predicate on the "goal_value" field.
+func GoalValueGTE(v float64) predicate.Target {
+	return predicate.Target(sql.FieldGTE(FieldGoalValue, v))
+}
+
+// GoalValueLT applies the LT predicate on the "goal_value" field.
+func GoalValueLT(v float64) predicate.Target {
+	return predicate.Target(sql.FieldLT(FieldGoalValue, v))
+}
+
+// GoalValueLTE applies the LTE predicate on the "goal_value" field.
+func GoalValueLTE(v float64) predicate.Target {
+	return predicate.Target(sql.FieldLTE(FieldGoalValue, v))
+}
+
+// GoalValueIsNil applies the IsNil predicate on the "goal_value" field.
+func GoalValueIsNil() predicate.Target {
+	return predicate.Target(sql.FieldIsNull(FieldGoalValue))
+}
+
+// GoalValueNotNil applies the NotNil predicate on the "goal_value" field.
+func GoalValueNotNil() predicate.Target {
+	return predicate.Target(sql.FieldNotNull(FieldGoalValue))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v float64) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v float64) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...float64) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...float64) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v float64) predicate.Target {
+	return predicate.Target(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v float64) predicate.Target {
+	return predicate.Target(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v float64) predicate.Target {
+	return predicate.Target(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v float64) predicate.Target {
+	return predicate.Target(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Target {
+	return predicate.Target(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Target {
+	return predicate.Target(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Target {
+	return predicate.Target(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Target {
+	return predicate.Target(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Target {
+	return predicate.Target(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Target {
+	return predicate.Target(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Target {
+	return predicate.Target(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Target {
+	return predicate.Target(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Target {
+	return predicate.Target(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Target {
+	return predicate.Target(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Target {
+	return predicate.Target(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Target {
+	return predicate.Target(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Target {
+	return predicate.Target(sql.FieldContainsFold(FieldPath, v))
+}
+
+// RubricIsNil applies the IsNil predicate on the "rubric" field.
+func RubricIsNil() predicate.Target {
+	return predicate.Target(sql.FieldIsNull(FieldRubric))
+}
+
+// RubricNotNil applies the NotNil predicate on the "rubric" field.
+func RubricNotNil() predicate.Target {
+	return predicate.Target(sql.FieldNotNull(FieldRubric))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Target {
 	return predicate.Target(func(s *sql.Selector) {
@@ -713,6 +1078,29 @@ func HasStepsWith(preds ...predicate.Step) predicate.Target {
 	})
 }
 
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Target {
+	return predicate.Target(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Target {
+	return predicate.Target(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Target) predicate.Target {
 	return predicate.Target(sql.AndPredicates(predicates...))
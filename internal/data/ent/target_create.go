@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
+	"step/internal/objects"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -150,6 +152,116 @@ func (tc *TargetCreate) SetNillableParentID(u *uint64) *TargetCreate {
 	return tc
 }
 
+// SetScheduleType sets the "schedule_type" field.
+func (tc *TargetCreate) SetScheduleType(tt target.ScheduleType) *TargetCreate {
+	tc.mutation.SetScheduleType(tt)
+	return tc
+}
+
+// SetNillableScheduleType sets the "schedule_type" field if the given value is not nil.
+func (tc *TargetCreate) SetNillableScheduleType(tt *target.ScheduleType) *TargetCreate {
+	if tt != nil {
+		tc.SetScheduleType(*tt)
+	}
+	return tc
+}
+
+// SetScheduleWeekdays sets the "schedule_weekdays" field.
+func (tc *TargetCreate) SetScheduleWeekdays(i []int) *TargetCreate {
+	tc.mutation.SetScheduleWeekdays(i)
+	return tc
+}
+
+// SetScheduleTimes sets the "schedule_times" field.
+func (tc *TargetCreate) SetScheduleTimes(u uint32) *TargetCreate {
+	tc.mutation.SetScheduleTimes(u)
+	return tc
+}
+
+// SetNillableScheduleTimes sets the "schedule_times" field if the given value is not nil.
+func (tc *TargetCreate) SetNillableScheduleTimes(u *uint32) *TargetCreate {
+	if u != nil {
+		tc.SetScheduleTimes(*u)
+	}
+	return tc
+}
+
+// SetMode sets the "mode" field.
+func (tc *TargetCreate) SetMode(t target.Mode) *TargetCreate {
+	tc.mutation.SetMode(t)
+	return tc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (tc *TargetCreate) SetNillableMode(t *target.Mode) *TargetCreate {
+	if t != nil {
+		tc.SetMode(*t)
+	}
+	return tc
+}
+
+// SetUnit sets the "unit" field.
+func (tc *TargetCreate) SetUnit(s string) *TargetCreate {
+	tc.mutation.SetUnit(s)
+	return tc
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (tc *TargetCreate) SetNillableUnit(s *string) *TargetCreate {
+	if s != nil {
+		tc.SetUnit(*s)
+	}
+	return tc
+}
+
+// SetGoalValue sets the "goal_value" field.
+func (tc *TargetCreate) SetGoalValue(f float64) *TargetCreate {
+	tc.mutation.SetGoalValue(f)
+	return tc
+}
+
+// SetNillableGoalValue sets the "goal_value" field if the given value is not nil.
+func (tc *TargetCreate) SetNillableGoalValue(f *float64) *TargetCreate {
+	if f != nil {
+		tc.SetGoalValue(*f)
+	}
+	return tc
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (tc *TargetCreate) SetSortOrder(f float64) *TargetCreate {
+	tc.mutation.SetSortOrder(f)
+	return tc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (tc *TargetCreate) SetNillableSortOrder(f *float64) *TargetCreate {
+	if f != nil {
+		tc.SetSortOrder(*f)
+	}
+	return tc
+}
+
+// SetPath sets the "path" field.
+func (tc *TargetCreate) SetPath(s string) *TargetCreate {
+	tc.mutation.SetPath(s)
+	return tc
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (tc *TargetCreate) SetNillablePath(s *string) *TargetCreate {
+	if s != nil {
+		tc.SetPath(*s)
+	}
+	return tc
+}
+
+// SetRubric sets the "rubric" field.
+func (tc *TargetCreate) SetRubric(or *objects.TargetRubric) *TargetCreate {
+	tc.mutation.SetRubric(or)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TargetCreate) SetID(u uint64) *TargetCreate {
 	tc.mutation.SetID(u)
@@ -191,6 +303,21 @@ func (tc *TargetCreate) AddSteps(s ...*Step) *TargetCreate {
 	return tc.AddStepIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tc *TargetCreate) AddTagIDs(ids ...uint64) *TargetCreate {
+	tc.mutation.AddTagIDs(ids...)
+	return tc
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tc *TargetCreate) AddTags(t ...*Tag) *TargetCreate {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddTagIDs(ids...)
+}
+
 // Mutation returns the TargetMutation object of the builder.
 func (tc *TargetCreate) Mutation() *TargetMutation {
 	return tc.mutation
@@ -242,6 +369,22 @@ func (tc *TargetCreate) defaults() {
 		v := target.DefaultStatus
 		tc.mutation.SetStatus(v)
 	}
+	if _, ok := tc.mutation.ScheduleType(); !ok {
+		v := target.DefaultScheduleType
+		tc.mutation.SetScheduleType(v)
+	}
+	if _, ok := tc.mutation.Mode(); !ok {
+		v := target.DefaultMode
+		tc.mutation.SetMode(v)
+	}
+	if _, ok := tc.mutation.SortOrder(); !ok {
+		v := target.DefaultSortOrder
+		tc.mutation.SetSortOrder(v)
+	}
+	if _, ok := tc.mutation.Path(); !ok {
+		v := target.DefaultPath
+		tc.mutation.SetPath(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -282,6 +425,38 @@ func (tc *TargetCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Target.status": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.ScheduleType(); !ok {
+		return &ValidationError{Name: "schedule_type", err: errors.New(`ent: missing required field "Target.schedule_type"`)}
+	}
+	if v, ok := tc.mutation.ScheduleType(); ok {
+		if err := target.ScheduleTypeValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_type", err: fmt.Errorf(`ent: validator failed for field "Target.schedule_type": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "Target.mode"`)}
+	}
+	if v, ok := tc.mutation.Mode(); ok {
+		if err := target.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Target.mode": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.Unit(); ok {
+		if err := target.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "Target.unit": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Target.sort_order"`)}
+	}
+	if _, ok := tc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Target.path"`)}
+	}
+	if v, ok := tc.mutation.Rubric(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rubric", err: fmt.Errorf(`ent: validator failed for field "Target.rubric": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -354,6 +529,42 @@ func (tc *TargetCreate) createSpec() (*Target, *sqlgraph.CreateSpec) {
 		_spec.SetField(target.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := tc.mutation.ScheduleType(); ok {
+		_spec.SetField(target.FieldScheduleType, field.TypeEnum, value)
+		_node.ScheduleType = value
+	}
+	if value, ok := tc.mutation.ScheduleWeekdays(); ok {
+		_spec.SetField(target.FieldScheduleWeekdays, field.TypeJSON, value)
+		_node.ScheduleWeekdays = value
+	}
+	if value, ok := tc.mutation.ScheduleTimes(); ok {
+		_spec.SetField(target.FieldScheduleTimes, field.TypeUint32, value)
+		_node.ScheduleTimes = value
+	}
+	if value, ok := tc.mutation.Mode(); ok {
+		_spec.SetField(target.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := tc.mutation.Unit(); ok {
+		_spec.SetField(target.FieldUnit, field.TypeString, value)
+		_node.Unit = value
+	}
+	if value, ok := tc.mutation.GoalValue(); ok {
+		_spec.SetField(target.FieldGoalValue, field.TypeFloat64, value)
+		_node.GoalValue = value
+	}
+	if value, ok := tc.mutation.SortOrder(); ok {
+		_spec.SetField(target.FieldSortOrder, field.TypeFloat64, value)
+		_node.SortOrder = value
+	}
+	if value, ok := tc.mutation.Path(); ok {
+		_spec.SetField(target.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := tc.mutation.Rubric(); ok {
+		_spec.SetField(target.FieldRubric, field.TypeJSON, value)
+		_node.Rubric = value
+	}
 	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -403,6 +614,22 @@ func (tc *TargetCreate) createSpec() (*Target, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 	"math"
 	"step/internal/data/ent/predicate"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
 
 	"entgo.io/ent"
@@ -27,6 +28,7 @@ type TargetQuery struct {
 	withParent   *TargetQuery
 	withChildren *TargetQuery
 	withSteps    *StepQuery
+	withTags     *TagQuery
 	modifiers    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -130,6 +132,28 @@ func (tq *TargetQuery) QuerySteps() *StepQuery {
 	return query
 }
 
+// QueryTags chains the current query on the "tags" edge.
+func (tq *TargetQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(target.Table, target.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, target.TagsTable, target.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Target entity from the query.
 // Returns a *NotFoundError when no Target was found.
 func (tq *TargetQuery) First(ctx context.Context) (*Target, error) {
@@ -325,6 +349,7 @@ func (tq *TargetQuery) Clone() *TargetQuery {
 		withParent:   tq.withParent.Clone(),
 		withChildren: tq.withChildren.Clone(),
 		withSteps:    tq.withSteps.Clone(),
+		withTags:     tq.withTags.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -364,6 +389,17 @@ func (tq *TargetQuery) WithSteps(opts ...func(*StepQuery)) *TargetQuery {
 	return tq
 }
 
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TargetQuery) WithTags(opts ...func(*TagQuery)) *TargetQuery {
+	query := (&TagClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTags = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -442,10 +478,11 @@ func (tq *TargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Targe
 	var (
 		nodes       = []*Target{}
 		_spec       = tq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			tq.withParent != nil,
 			tq.withChildren != nil,
 			tq.withSteps != nil,
+			tq.withTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -489,6 +526,13 @@ func (tq *TargetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Targe
 			return nil, err
 		}
 	}
+	if query := tq.withTags; query != nil {
+		if err := tq.loadTags(ctx, query, nodes,
+			func(n *Target) { n.Edges.Tags = []*Tag{} },
+			func(n *Target, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -581,6 +625,67 @@ func (tq *TargetQuery) loadSteps(ctx context.Context, query *StepQuery, nodes []
 	}
 	return nil
 }
+func (tq *TargetQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Target, init func(*Target), assign func(*Target, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uint64]*Target)
+	nids := make(map[uint64]map[*Target]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(target.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(target.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(target.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(target.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := uint64(values[0].(*sql.NullInt64).Int64)
+				inValue := uint64(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Target]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (tq *TargetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
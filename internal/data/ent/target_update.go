@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"step/internal/data/ent/predicate"
 	"step/internal/data/ent/step"
+	"step/internal/data/ent/tag"
 	"step/internal/data/ent/target"
+	"step/internal/objects"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -221,6 +224,173 @@ func (tu *TargetUpdate) ClearParentID() *TargetUpdate {
 	return tu
 }
 
+// SetScheduleType sets the "schedule_type" field.
+func (tu *TargetUpdate) SetScheduleType(tt target.ScheduleType) *TargetUpdate {
+	tu.mutation.SetScheduleType(tt)
+	return tu
+}
+
+// SetNillableScheduleType sets the "schedule_type" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillableScheduleType(tt *target.ScheduleType) *TargetUpdate {
+	if tt != nil {
+		tu.SetScheduleType(*tt)
+	}
+	return tu
+}
+
+// SetScheduleWeekdays sets the "schedule_weekdays" field.
+func (tu *TargetUpdate) SetScheduleWeekdays(i []int) *TargetUpdate {
+	tu.mutation.SetScheduleWeekdays(i)
+	return tu
+}
+
+// AppendScheduleWeekdays appends i to the "schedule_weekdays" field.
+func (tu *TargetUpdate) AppendScheduleWeekdays(i []int) *TargetUpdate {
+	tu.mutation.AppendScheduleWeekdays(i)
+	return tu
+}
+
+// ClearScheduleWeekdays clears the value of the "schedule_weekdays" field.
+func (tu *TargetUpdate) ClearScheduleWeekdays() *TargetUpdate {
+	tu.mutation.ClearScheduleWeekdays()
+	return tu
+}
+
+// SetScheduleTimes sets the "schedule_times" field.
+func (tu *TargetUpdate) SetScheduleTimes(u uint32) *TargetUpdate {
+	tu.mutation.ResetScheduleTimes()
+	tu.mutation.SetScheduleTimes(u)
+	return tu
+}
+
+// SetNillableScheduleTimes sets the "schedule_times" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillableScheduleTimes(u *uint32) *TargetUpdate {
+	if u != nil {
+		tu.SetScheduleTimes(*u)
+	}
+	return tu
+}
+
+// AddScheduleTimes adds u to the "schedule_times" field.
+func (tu *TargetUpdate) AddScheduleTimes(u int32) *TargetUpdate {
+	tu.mutation.AddScheduleTimes(u)
+	return tu
+}
+
+// ClearScheduleTimes clears the value of the "schedule_times" field.
+func (tu *TargetUpdate) ClearScheduleTimes() *TargetUpdate {
+	tu.mutation.ClearScheduleTimes()
+	return tu
+}
+
+// SetMode sets the "mode" field.
+func (tu *TargetUpdate) SetMode(t target.Mode) *TargetUpdate {
+	tu.mutation.SetMode(t)
+	return tu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillableMode(t *target.Mode) *TargetUpdate {
+	if t != nil {
+		tu.SetMode(*t)
+	}
+	return tu
+}
+
+// SetUnit sets the "unit" field.
+func (tu *TargetUpdate) SetUnit(s string) *TargetUpdate {
+	tu.mutation.SetUnit(s)
+	return tu
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillableUnit(s *string) *TargetUpdate {
+	if s != nil {
+		tu.SetUnit(*s)
+	}
+	return tu
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (tu *TargetUpdate) ClearUnit() *TargetUpdate {
+	tu.mutation.ClearUnit()
+	return tu
+}
+
+// SetGoalValue sets the "goal_value" field.
+func (tu *TargetUpdate) SetGoalValue(f float64) *TargetUpdate {
+	tu.mutation.ResetGoalValue()
+	tu.mutation.SetGoalValue(f)
+	return tu
+}
+
+// SetNillableGoalValue sets the "goal_value" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillableGoalValue(f *float64) *TargetUpdate {
+	if f != nil {
+		tu.SetGoalValue(*f)
+	}
+	return tu
+}
+
+// AddGoalValue adds f to the "goal_value" field.
+func (tu *TargetUpdate) AddGoalValue(f float64) *TargetUpdate {
+	tu.mutation.AddGoalValue(f)
+	return tu
+}
+
+// ClearGoalValue clears the value of the "goal_value" field.
+func (tu *TargetUpdate) ClearGoalValue() *TargetUpdate {
+	tu.mutation.ClearGoalValue()
+	return tu
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (tu *TargetUpdate) SetSortOrder(f float64) *TargetUpdate {
+	tu.mutation.ResetSortOrder()
+	tu.mutation.SetSortOrder(f)
+	return tu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillableSortOrder(f *float64) *TargetUpdate {
+	if f != nil {
+		tu.SetSortOrder(*f)
+	}
+	return tu
+}
+
+// AddSortOrder adds f to the "sort_order" field.
+func (tu *TargetUpdate) AddSortOrder(f float64) *TargetUpdate {
+	tu.mutation.AddSortOrder(f)
+	return tu
+}
+
+// SetPath sets the "path" field.
+func (tu *TargetUpdate) SetPath(s string) *TargetUpdate {
+	tu.mutation.SetPath(s)
+	return tu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (tu *TargetUpdate) SetNillablePath(s *string) *TargetUpdate {
+	if s != nil {
+		tu.SetPath(*s)
+	}
+	return tu
+}
+
+// SetRubric sets the "rubric" field.
+func (tu *TargetUpdate) SetRubric(or *objects.TargetRubric) *TargetUpdate {
+	tu.mutation.SetRubric(or)
+	return tu
+}
+
+// ClearRubric clears the value of the "rubric" field.
+func (tu *TargetUpdate) ClearRubric() *TargetUpdate {
+	tu.mutation.ClearRubric()
+	return tu
+}
+
 // SetParent sets the "parent" edge to the Target entity.
 func (tu *TargetUpdate) SetParent(t *Target) *TargetUpdate {
 	return tu.SetParentID(t.ID)
@@ -256,6 +426,21 @@ func (tu *TargetUpdate) AddSteps(s ...*Step) *TargetUpdate {
 	return tu.AddStepIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tu *TargetUpdate) AddTagIDs(ids ...uint64) *TargetUpdate {
+	tu.mutation.AddTagIDs(ids...)
+	return tu
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tu *TargetUpdate) AddTags(t ...*Tag) *TargetUpdate {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTagIDs(ids...)
+}
+
 // Mutation returns the TargetMutation object of the builder.
 func (tu *TargetUpdate) Mutation() *TargetMutation {
 	return tu.mutation
@@ -309,6 +494,27 @@ func (tu *TargetUpdate) RemoveSteps(s ...*Step) *TargetUpdate {
 	return tu.RemoveStepIDs(ids...)
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tu *TargetUpdate) ClearTags() *TargetUpdate {
+	tu.mutation.ClearTags()
+	return tu
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tu *TargetUpdate) RemoveTagIDs(ids ...uint64) *TargetUpdate {
+	tu.mutation.RemoveTagIDs(ids...)
+	return tu
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tu *TargetUpdate) RemoveTags(t ...*Tag) *TargetUpdate {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TargetUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
@@ -353,6 +559,26 @@ func (tu *TargetUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Target.status": %w`, err)}
 		}
 	}
+	if v, ok := tu.mutation.ScheduleType(); ok {
+		if err := target.ScheduleTypeValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_type", err: fmt.Errorf(`ent: validator failed for field "Target.schedule_type": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Mode(); ok {
+		if err := target.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Target.mode": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Unit(); ok {
+		if err := target.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "Target.unit": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Rubric(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rubric", err: fmt.Errorf(`ent: validator failed for field "Target.rubric": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -422,6 +648,62 @@ func (tu *TargetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.Status(); ok {
 		_spec.SetField(target.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := tu.mutation.ScheduleType(); ok {
+		_spec.SetField(target.FieldScheduleType, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.ScheduleWeekdays(); ok {
+		_spec.SetField(target.FieldScheduleWeekdays, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedScheduleWeekdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, target.FieldScheduleWeekdays, value)
+		})
+	}
+	if tu.mutation.ScheduleWeekdaysCleared() {
+		_spec.ClearField(target.FieldScheduleWeekdays, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.ScheduleTimes(); ok {
+		_spec.SetField(target.FieldScheduleTimes, field.TypeUint32, value)
+	}
+	if value, ok := tu.mutation.AddedScheduleTimes(); ok {
+		_spec.AddField(target.FieldScheduleTimes, field.TypeUint32, value)
+	}
+	if tu.mutation.ScheduleTimesCleared() {
+		_spec.ClearField(target.FieldScheduleTimes, field.TypeUint32)
+	}
+	if value, ok := tu.mutation.Mode(); ok {
+		_spec.SetField(target.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.Unit(); ok {
+		_spec.SetField(target.FieldUnit, field.TypeString, value)
+	}
+	if tu.mutation.UnitCleared() {
+		_spec.ClearField(target.FieldUnit, field.TypeString)
+	}
+	if value, ok := tu.mutation.GoalValue(); ok {
+		_spec.SetField(target.FieldGoalValue, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedGoalValue(); ok {
+		_spec.AddField(target.FieldGoalValue, field.TypeFloat64, value)
+	}
+	if tu.mutation.GoalValueCleared() {
+		_spec.ClearField(target.FieldGoalValue, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.SortOrder(); ok {
+		_spec.SetField(target.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedSortOrder(); ok {
+		_spec.AddField(target.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.Path(); ok {
+		_spec.SetField(target.FieldPath, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Rubric(); ok {
+		_spec.SetField(target.FieldRubric, field.TypeJSON, value)
+	}
+	if tu.mutation.RubricCleared() {
+		_spec.ClearField(target.FieldRubric, field.TypeJSON)
+	}
 	if tu.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -541,6 +823,51 @@ func (tu *TargetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tu.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -755,6 +1082,173 @@ func (tuo *TargetUpdateOne) ClearParentID() *TargetUpdateOne {
 	return tuo
 }
 
+// SetScheduleType sets the "schedule_type" field.
+func (tuo *TargetUpdateOne) SetScheduleType(tt target.ScheduleType) *TargetUpdateOne {
+	tuo.mutation.SetScheduleType(tt)
+	return tuo
+}
+
+// SetNillableScheduleType sets the "schedule_type" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillableScheduleType(tt *target.ScheduleType) *TargetUpdateOne {
+	if tt != nil {
+		tuo.SetScheduleType(*tt)
+	}
+	return tuo
+}
+
+// SetScheduleWeekdays sets the "schedule_weekdays" field.
+func (tuo *TargetUpdateOne) SetScheduleWeekdays(i []int) *TargetUpdateOne {
+	tuo.mutation.SetScheduleWeekdays(i)
+	return tuo
+}
+
+// AppendScheduleWeekdays appends i to the "schedule_weekdays" field.
+func (tuo *TargetUpdateOne) AppendScheduleWeekdays(i []int) *TargetUpdateOne {
+	tuo.mutation.AppendScheduleWeekdays(i)
+	return tuo
+}
+
+// ClearScheduleWeekdays clears the value of the "schedule_weekdays" field.
+func (tuo *TargetUpdateOne) ClearScheduleWeekdays() *TargetUpdateOne {
+	tuo.mutation.ClearScheduleWeekdays()
+	return tuo
+}
+
+// SetScheduleTimes sets the "schedule_times" field.
+func (tuo *TargetUpdateOne) SetScheduleTimes(u uint32) *TargetUpdateOne {
+	tuo.mutation.ResetScheduleTimes()
+	tuo.mutation.SetScheduleTimes(u)
+	return tuo
+}
+
+// SetNillableScheduleTimes sets the "schedule_times" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillableScheduleTimes(u *uint32) *TargetUpdateOne {
+	if u != nil {
+		tuo.SetScheduleTimes(*u)
+	}
+	return tuo
+}
+
+// AddScheduleTimes adds u to the "schedule_times" field.
+func (tuo *TargetUpdateOne) AddScheduleTimes(u int32) *TargetUpdateOne {
+	tuo.mutation.AddScheduleTimes(u)
+	return tuo
+}
+
+// ClearScheduleTimes clears the value of the "schedule_times" field.
+func (tuo *TargetUpdateOne) ClearScheduleTimes() *TargetUpdateOne {
+	tuo.mutation.ClearScheduleTimes()
+	return tuo
+}
+
+// SetMode sets the "mode" field.
+func (tuo *TargetUpdateOne) SetMode(t target.Mode) *TargetUpdateOne {
+	tuo.mutation.SetMode(t)
+	return tuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillableMode(t *target.Mode) *TargetUpdateOne {
+	if t != nil {
+		tuo.SetMode(*t)
+	}
+	return tuo
+}
+
+// SetUnit sets the "unit" field.
+func (tuo *TargetUpdateOne) SetUnit(s string) *TargetUpdateOne {
+	tuo.mutation.SetUnit(s)
+	return tuo
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillableUnit(s *string) *TargetUpdateOne {
+	if s != nil {
+		tuo.SetUnit(*s)
+	}
+	return tuo
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (tuo *TargetUpdateOne) ClearUnit() *TargetUpdateOne {
+	tuo.mutation.ClearUnit()
+	return tuo
+}
+
+// SetGoalValue sets the "goal_value" field.
+func (tuo *TargetUpdateOne) SetGoalValue(f float64) *TargetUpdateOne {
+	tuo.mutation.ResetGoalValue()
+	tuo.mutation.SetGoalValue(f)
+	return tuo
+}
+
+// SetNillableGoalValue sets the "goal_value" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillableGoalValue(f *float64) *TargetUpdateOne {
+	if f != nil {
+		tuo.SetGoalValue(*f)
+	}
+	return tuo
+}
+
+// AddGoalValue adds f to the "goal_value" field.
+func (tuo *TargetUpdateOne) AddGoalValue(f float64) *TargetUpdateOne {
+	tuo.mutation.AddGoalValue(f)
+	return tuo
+}
+
+// ClearGoalValue clears the value of the "goal_value" field.
+func (tuo *TargetUpdateOne) ClearGoalValue() *TargetUpdateOne {
+	tuo.mutation.ClearGoalValue()
+	return tuo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (tuo *TargetUpdateOne) SetSortOrder(f float64) *TargetUpdateOne {
+	tuo.mutation.ResetSortOrder()
+	tuo.mutation.SetSortOrder(f)
+	return tuo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillableSortOrder(f *float64) *TargetUpdateOne {
+	if f != nil {
+		tuo.SetSortOrder(*f)
+	}
+	return tuo
+}
+
+// AddSortOrder adds f to the "sort_order" field.
+func (tuo *TargetUpdateOne) AddSortOrder(f float64) *TargetUpdateOne {
+	tuo.mutation.AddSortOrder(f)
+	return tuo
+}
+
+// SetPath sets the "path" field.
+func (tuo *TargetUpdateOne) SetPath(s string) *TargetUpdateOne {
+	tuo.mutation.SetPath(s)
+	return tuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (tuo *TargetUpdateOne) SetNillablePath(s *string) *TargetUpdateOne {
+	if s != nil {
+		tuo.SetPath(*s)
+	}
+	return tuo
+}
+
+// SetRubric sets the "rubric" field.
+func (tuo *TargetUpdateOne) SetRubric(or *objects.TargetRubric) *TargetUpdateOne {
+	tuo.mutation.SetRubric(or)
+	return tuo
+}
+
+// ClearRubric clears the value of the "rubric" field.
+func (tuo *TargetUpdateOne) ClearRubric() *TargetUpdateOne {
+	tuo.mutation.ClearRubric()
+	return tuo
+}
+
 // SetParent sets the "parent" edge to the Target entity.
 func (tuo *TargetUpdateOne) SetParent(t *Target) *TargetUpdateOne {
 	return tuo.SetParentID(t.ID)
@@ -790,6 +1284,21 @@ func (tuo *TargetUpdateOne) AddSteps(s ...*Step) *TargetUpdateOne {
 	return tuo.AddStepIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (tuo *TargetUpdateOne) AddTagIDs(ids ...uint64) *TargetUpdateOne {
+	tuo.mutation.AddTagIDs(ids...)
+	return tuo
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (tuo *TargetUpdateOne) AddTags(t ...*Tag) *TargetUpdateOne {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTagIDs(ids...)
+}
+
 // Mutation returns the TargetMutation object of the builder.
 func (tuo *TargetUpdateOne) Mutation() *TargetMutation {
 	return tuo.mutation
@@ -843,6 +1352,27 @@ func (tuo *TargetUpdateOne) RemoveSteps(s ...*Step) *TargetUpdateOne {
 	return tuo.RemoveStepIDs(ids...)
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (tuo *TargetUpdateOne) ClearTags() *TargetUpdateOne {
+	tuo.mutation.ClearTags()
+	return tuo
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (tuo *TargetUpdateOne) RemoveTagIDs(ids ...uint64) *TargetUpdateOne {
+	tuo.mutation.RemoveTagIDs(ids...)
+	return tuo
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (tuo *TargetUpdateOne) RemoveTags(t ...*Tag) *TargetUpdateOne {
+	ids := make([]uint64, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the TargetUpdate builder.
 func (tuo *TargetUpdateOne) Where(ps ...predicate.Target) *TargetUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -900,6 +1430,26 @@ func (tuo *TargetUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Target.status": %w`, err)}
 		}
 	}
+	if v, ok := tuo.mutation.ScheduleType(); ok {
+		if err := target.ScheduleTypeValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_type", err: fmt.Errorf(`ent: validator failed for field "Target.schedule_type": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Mode(); ok {
+		if err := target.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Target.mode": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Unit(); ok {
+		if err := target.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "Target.unit": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Rubric(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rubric", err: fmt.Errorf(`ent: validator failed for field "Target.rubric": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -986,6 +1536,62 @@ func (tuo *TargetUpdateOne) sqlSave(ctx context.Context) (_node *Target, err err
 	if value, ok := tuo.mutation.Status(); ok {
 		_spec.SetField(target.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := tuo.mutation.ScheduleType(); ok {
+		_spec.SetField(target.FieldScheduleType, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.ScheduleWeekdays(); ok {
+		_spec.SetField(target.FieldScheduleWeekdays, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedScheduleWeekdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, target.FieldScheduleWeekdays, value)
+		})
+	}
+	if tuo.mutation.ScheduleWeekdaysCleared() {
+		_spec.ClearField(target.FieldScheduleWeekdays, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.ScheduleTimes(); ok {
+		_spec.SetField(target.FieldScheduleTimes, field.TypeUint32, value)
+	}
+	if value, ok := tuo.mutation.AddedScheduleTimes(); ok {
+		_spec.AddField(target.FieldScheduleTimes, field.TypeUint32, value)
+	}
+	if tuo.mutation.ScheduleTimesCleared() {
+		_spec.ClearField(target.FieldScheduleTimes, field.TypeUint32)
+	}
+	if value, ok := tuo.mutation.Mode(); ok {
+		_spec.SetField(target.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.Unit(); ok {
+		_spec.SetField(target.FieldUnit, field.TypeString, value)
+	}
+	if tuo.mutation.UnitCleared() {
+		_spec.ClearField(target.FieldUnit, field.TypeString)
+	}
+	if value, ok := tuo.mutation.GoalValue(); ok {
+		_spec.SetField(target.FieldGoalValue, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedGoalValue(); ok {
+		_spec.AddField(target.FieldGoalValue, field.TypeFloat64, value)
+	}
+	if tuo.mutation.GoalValueCleared() {
+		_spec.ClearField(target.FieldGoalValue, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.SortOrder(); ok {
+		_spec.SetField(target.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedSortOrder(); ok {
+		_spec.AddField(target.FieldSortOrder, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.Path(); ok {
+		_spec.SetField(target.FieldPath, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Rubric(); ok {
+		_spec.SetField(target.FieldRubric, field.TypeJSON, value)
+	}
+	if tuo.mutation.RubricCleared() {
+		_spec.ClearField(target.FieldRubric, field.TypeJSON)
+	}
 	if tuo.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1105,6 +1711,51 @@ func (tuo *TargetUpdateOne) sqlSave(ctx context.Context) (_node *Target, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !tuo.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   target.TagsTable,
+			Columns: target.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUint64),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Target{config: tuo.config}
 	_spec.Assign = _node.assignValues
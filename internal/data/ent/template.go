@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"step/internal/data/ent/template"
+	"step/internal/objects"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Template is the model entity for the Template schema.
+type Template struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 自增ID
+	ID uint64 `json:"id,omitempty"`
+	// User ID
+	UserID string `json:"user_id,omitempty"`
+	// 名称
+	Title string `json:"title,omitempty"`
+	// 描述
+	Description string `json:"description,omitempty"`
+	// 可见性, link为持有链接的用户可见
+	Visibility template.Visibility `json:"visibility,omitempty"`
+	// 分享链接令牌
+	ShareToken string `json:"share_token,omitempty"`
+	// 目标树结构
+	Content *objects.TemplateTarget `json:"content,omitempty"`
+	// 来源目标ID
+	SourceTargetID uint64 `json:"source_target_id,omitempty"`
+	// 创建时间
+	CreatedAt int64 `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    int64 `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Template) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case template.FieldContent:
+			values[i] = new([]byte)
+		case template.FieldID, template.FieldSourceTargetID, template.FieldCreatedAt, template.FieldUpdatedAt:
+			values[i] = new(sql.NullInt64)
+		case template.FieldUserID, template.FieldTitle, template.FieldDescription, template.FieldVisibility, template.FieldShareToken:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Template fields.
+func (t *Template) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case template.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = uint64(value.Int64)
+		case template.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				t.UserID = value.String
+			}
+		case template.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				t.Title = value.String
+			}
+		case template.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				t.Description = value.String
+			}
+		case template.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				t.Visibility = template.Visibility(value.String)
+			}
+		case template.FieldShareToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_token", values[i])
+			} else if value.Valid {
+				t.ShareToken = value.String
+			}
+		case template.FieldContent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Content); err != nil {
+					return fmt.Errorf("unmarshal field content: %w", err)
+				}
+			}
+		case template.FieldSourceTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_target_id", values[i])
+			} else if value.Valid {
+				t.SourceTargetID = uint64(value.Int64)
+			}
+		case template.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Int64
+			}
+		case template.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				t.UpdatedAt = value.Int64
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Template.
+// This includes values selected through modifiers, order, etc.
+func (t *Template) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Template.
+// Note that you need to call Template.Unwrap() before calling this method if this Template
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Template) Update() *TemplateUpdateOne {
+	return NewTemplateClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Template entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Template) Unwrap() *Template {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Template is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Template) String() string {
+	var builder strings.Builder
+	builder.WriteString("Template(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(t.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(t.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(t.Description)
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", t.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("share_token=")
+	builder.WriteString(t.ShareToken)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(fmt.Sprintf("%v", t.Content))
+	builder.WriteString(", ")
+	builder.WriteString("source_target_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.SourceTargetID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", t.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", t.UpdatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Templates is a parsable slice of Template.
+type Templates []*Template
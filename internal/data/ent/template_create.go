@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/template"
+	"step/internal/objects"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TemplateCreate is the builder for creating a Template entity.
+type TemplateCreate struct {
+	config
+	mutation *TemplateMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (tc *TemplateCreate) SetUserID(s string) *TemplateCreate {
+	tc.mutation.SetUserID(s)
+	return tc
+}
+
+// SetTitle sets the "title" field.
+func (tc *TemplateCreate) SetTitle(s string) *TemplateCreate {
+	tc.mutation.SetTitle(s)
+	return tc
+}
+
+// SetDescription sets the "description" field.
+func (tc *TemplateCreate) SetDescription(s string) *TemplateCreate {
+	tc.mutation.SetDescription(s)
+	return tc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableDescription(s *string) *TemplateCreate {
+	if s != nil {
+		tc.SetDescription(*s)
+	}
+	return tc
+}
+
+// SetVisibility sets the "visibility" field.
+func (tc *TemplateCreate) SetVisibility(t template.Visibility) *TemplateCreate {
+	tc.mutation.SetVisibility(t)
+	return tc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableVisibility(t *template.Visibility) *TemplateCreate {
+	if t != nil {
+		tc.SetVisibility(*t)
+	}
+	return tc
+}
+
+// SetShareToken sets the "share_token" field.
+func (tc *TemplateCreate) SetShareToken(s string) *TemplateCreate {
+	tc.mutation.SetShareToken(s)
+	return tc
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableShareToken(s *string) *TemplateCreate {
+	if s != nil {
+		tc.SetShareToken(*s)
+	}
+	return tc
+}
+
+// SetContent sets the "content" field.
+func (tc *TemplateCreate) SetContent(ot *objects.TemplateTarget) *TemplateCreate {
+	tc.mutation.SetContent(ot)
+	return tc
+}
+
+// SetSourceTargetID sets the "source_target_id" field.
+func (tc *TemplateCreate) SetSourceTargetID(u uint64) *TemplateCreate {
+	tc.mutation.SetSourceTargetID(u)
+	return tc
+}
+
+// SetNillableSourceTargetID sets the "source_target_id" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableSourceTargetID(u *uint64) *TemplateCreate {
+	if u != nil {
+		tc.SetSourceTargetID(*u)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TemplateCreate) SetCreatedAt(i int64) *TemplateCreate {
+	tc.mutation.SetCreatedAt(i)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableCreatedAt(i *int64) *TemplateCreate {
+	if i != nil {
+		tc.SetCreatedAt(*i)
+	}
+	return tc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tc *TemplateCreate) SetUpdatedAt(i int64) *TemplateCreate {
+	tc.mutation.SetUpdatedAt(i)
+	return tc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableUpdatedAt(i *int64) *TemplateCreate {
+	if i != nil {
+		tc.SetUpdatedAt(*i)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TemplateCreate) SetID(u uint64) *TemplateCreate {
+	tc.mutation.SetID(u)
+	return tc
+}
+
+// Mutation returns the TemplateMutation object of the builder.
+func (tc *TemplateCreate) Mutation() *TemplateMutation {
+	return tc.mutation
+}
+
+// Save creates the Template in the database.
+func (tc *TemplateCreate) Save(ctx context.Context) (*Template, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TemplateCreate) SaveX(ctx context.Context) *Template {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TemplateCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TemplateCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TemplateCreate) defaults() {
+	if _, ok := tc.mutation.Visibility(); !ok {
+		v := template.DefaultVisibility
+		tc.mutation.SetVisibility(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := template.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		v := template.DefaultUpdatedAt()
+		tc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TemplateCreate) check() error {
+	if _, ok := tc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Template.user_id"`)}
+	}
+	if _, ok := tc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Template.title"`)}
+	}
+	if v, ok := tc.mutation.Title(); ok {
+		if err := template.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Template.title": %w`, err)}
+		}
+	}
+	if v, ok := tc.mutation.Description(); ok {
+		if err := template.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Template.description": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Template.visibility"`)}
+	}
+	if v, ok := tc.mutation.Visibility(); ok {
+		if err := template.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Template.visibility": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Template.content"`)}
+	}
+	if v, ok := tc.mutation.Content(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Template.content": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Template.created_at"`)}
+	}
+	if _, ok := tc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Template.updated_at"`)}
+	}
+	return nil
+}
+
+func (tc *TemplateCreate) sqlSave(ctx context.Context) (*Template, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint64(id)
+	}
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TemplateCreate) createSpec() (*Template, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Template{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(template.Table, sqlgraph.NewFieldSpec(template.FieldID, field.TypeUint64))
+	)
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tc.mutation.UserID(); ok {
+		_spec.SetField(template.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := tc.mutation.Title(); ok {
+		_spec.SetField(template.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := tc.mutation.Description(); ok {
+		_spec.SetField(template.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := tc.mutation.Visibility(); ok {
+		_spec.SetField(template.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := tc.mutation.ShareToken(); ok {
+		_spec.SetField(template.FieldShareToken, field.TypeString, value)
+		_node.ShareToken = value
+	}
+	if value, ok := tc.mutation.Content(); ok {
+		_spec.SetField(template.FieldContent, field.TypeJSON, value)
+		_node.Content = value
+	}
+	if value, ok := tc.mutation.SourceTargetID(); ok {
+		_spec.SetField(template.FieldSourceTargetID, field.TypeUint64, value)
+		_node.SourceTargetID = value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.SetField(template.FieldCreatedAt, field.TypeInt64, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tc.mutation.UpdatedAt(); ok {
+		_spec.SetField(template.FieldUpdatedAt, field.TypeInt64, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TemplateCreateBulk is the builder for creating many Template entities in bulk.
+type TemplateCreateBulk struct {
+	config
+	err      error
+	builders []*TemplateCreate
+}
+
+// Save creates the Template entities in the database.
+func (tcb *TemplateCreateBulk) Save(ctx context.Context) ([]*Template, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Template, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TemplateCreateBulk) SaveX(ctx context.Context) []*Template {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"step/internal/data/ent/predicate"
+	"step/internal/data/ent/template"
+	"step/internal/objects"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TemplateUpdate is the builder for updating Template entities.
+type TemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TemplateUpdate builder.
+func (tu *TemplateUpdate) Where(ps ...predicate.Template) *TemplateUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetUserID sets the "user_id" field.
+func (tu *TemplateUpdate) SetUserID(s string) *TemplateUpdate {
+	tu.mutation.SetUserID(s)
+	return tu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableUserID(s *string) *TemplateUpdate {
+	if s != nil {
+		tu.SetUserID(*s)
+	}
+	return tu
+}
+
+// SetTitle sets the "title" field.
+func (tu *TemplateUpdate) SetTitle(s string) *TemplateUpdate {
+	tu.mutation.SetTitle(s)
+	return tu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableTitle(s *string) *TemplateUpdate {
+	if s != nil {
+		tu.SetTitle(*s)
+	}
+	return tu
+}
+
+// SetDescription sets the "description" field.
+func (tu *TemplateUpdate) SetDescription(s string) *TemplateUpdate {
+	tu.mutation.SetDescription(s)
+	return tu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableDescription(s *string) *TemplateUpdate {
+	if s != nil {
+		tu.SetDescription(*s)
+	}
+	return tu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tu *TemplateUpdate) ClearDescription() *TemplateUpdate {
+	tu.mutation.ClearDescription()
+	return tu
+}
+
+// SetVisibility sets the "visibility" field.
+func (tu *TemplateUpdate) SetVisibility(t template.Visibility) *TemplateUpdate {
+	tu.mutation.SetVisibility(t)
+	return tu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableVisibility(t *template.Visibility) *TemplateUpdate {
+	if t != nil {
+		tu.SetVisibility(*t)
+	}
+	return tu
+}
+
+// SetShareToken sets the "share_token" field.
+func (tu *TemplateUpdate) SetShareToken(s string) *TemplateUpdate {
+	tu.mutation.SetShareToken(s)
+	return tu
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableShareToken(s *string) *TemplateUpdate {
+	if s != nil {
+		tu.SetShareToken(*s)
+	}
+	return tu
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (tu *TemplateUpdate) ClearShareToken() *TemplateUpdate {
+	tu.mutation.ClearShareToken()
+	return tu
+}
+
+// SetContent sets the "content" field.
+func (tu *TemplateUpdate) SetContent(ot *objects.TemplateTarget) *TemplateUpdate {
+	tu.mutation.SetContent(ot)
+	return tu
+}
+
+// SetSourceTargetID sets the "source_target_id" field.
+func (tu *TemplateUpdate) SetSourceTargetID(u uint64) *TemplateUpdate {
+	tu.mutation.ResetSourceTargetID()
+	tu.mutation.SetSourceTargetID(u)
+	return tu
+}
+
+// SetNillableSourceTargetID sets the "source_target_id" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableSourceTargetID(u *uint64) *TemplateUpdate {
+	if u != nil {
+		tu.SetSourceTargetID(*u)
+	}
+	return tu
+}
+
+// AddSourceTargetID adds u to the "source_target_id" field.
+func (tu *TemplateUpdate) AddSourceTargetID(u int64) *TemplateUpdate {
+	tu.mutation.AddSourceTargetID(u)
+	return tu
+}
+
+// ClearSourceTargetID clears the value of the "source_target_id" field.
+func (tu *TemplateUpdate) ClearSourceTargetID() *TemplateUpdate {
+	tu.mutation.ClearSourceTargetID()
+	return tu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tu *TemplateUpdate) SetUpdatedAt(i int64) *TemplateUpdate {
+	tu.mutation.ResetUpdatedAt()
+	tu.mutation.SetUpdatedAt(i)
+	return tu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableUpdatedAt(i *int64) *TemplateUpdate {
+	if i != nil {
+		tu.SetUpdatedAt(*i)
+	}
+	return tu
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (tu *TemplateUpdate) AddUpdatedAt(i int64) *TemplateUpdate {
+	tu.mutation.AddUpdatedAt(i)
+	return tu
+}
+
+// Mutation returns the TemplateMutation object of the builder.
+func (tu *TemplateUpdate) Mutation() *TemplateMutation {
+	return tu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TemplateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TemplateUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TemplateUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TemplateUpdate) check() error {
+	if v, ok := tu.mutation.Title(); ok {
+		if err := template.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Template.title": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Description(); ok {
+		if err := template.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Template.description": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Visibility(); ok {
+		if err := template.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Template.visibility": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Content(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Template.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TemplateUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
+func (tu *TemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(template.Table, template.Columns, sqlgraph.NewFieldSpec(template.FieldID, field.TypeUint64))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.UserID(); ok {
+		_spec.SetField(template.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Title(); ok {
+		_spec.SetField(template.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Description(); ok {
+		_spec.SetField(template.FieldDescription, field.TypeString, value)
+	}
+	if tu.mutation.DescriptionCleared() {
+		_spec.ClearField(template.FieldDescription, field.TypeString)
+	}
+	if value, ok := tu.mutation.Visibility(); ok {
+		_spec.SetField(template.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.ShareToken(); ok {
+		_spec.SetField(template.FieldShareToken, field.TypeString, value)
+	}
+	if tu.mutation.ShareTokenCleared() {
+		_spec.ClearField(template.FieldShareToken, field.TypeString)
+	}
+	if value, ok := tu.mutation.Content(); ok {
+		_spec.SetField(template.FieldContent, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.SourceTargetID(); ok {
+		_spec.SetField(template.FieldSourceTargetID, field.TypeUint64, value)
+	}
+	if value, ok := tu.mutation.AddedSourceTargetID(); ok {
+		_spec.AddField(template.FieldSourceTargetID, field.TypeUint64, value)
+	}
+	if tu.mutation.SourceTargetIDCleared() {
+		_spec.ClearField(template.FieldSourceTargetID, field.TypeUint64)
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.SetField(template.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(template.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(tu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{template.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TemplateUpdateOne is the builder for updating a single Template entity.
+type TemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (tuo *TemplateUpdateOne) SetUserID(s string) *TemplateUpdateOne {
+	tuo.mutation.SetUserID(s)
+	return tuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableUserID(s *string) *TemplateUpdateOne {
+	if s != nil {
+		tuo.SetUserID(*s)
+	}
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TemplateUpdateOne) SetTitle(s string) *TemplateUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableTitle(s *string) *TemplateUpdateOne {
+	if s != nil {
+		tuo.SetTitle(*s)
+	}
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TemplateUpdateOne) SetDescription(s string) *TemplateUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableDescription(s *string) *TemplateUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tuo *TemplateUpdateOne) ClearDescription() *TemplateUpdateOne {
+	tuo.mutation.ClearDescription()
+	return tuo
+}
+
+// SetVisibility sets the "visibility" field.
+func (tuo *TemplateUpdateOne) SetVisibility(t template.Visibility) *TemplateUpdateOne {
+	tuo.mutation.SetVisibility(t)
+	return tuo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableVisibility(t *template.Visibility) *TemplateUpdateOne {
+	if t != nil {
+		tuo.SetVisibility(*t)
+	}
+	return tuo
+}
+
+// SetShareToken sets the "share_token" field.
+func (tuo *TemplateUpdateOne) SetShareToken(s string) *TemplateUpdateOne {
+	tuo.mutation.SetShareToken(s)
+	return tuo
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableShareToken(s *string) *TemplateUpdateOne {
+	if s != nil {
+		tuo.SetShareToken(*s)
+	}
+	return tuo
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (tuo *TemplateUpdateOne) ClearShareToken() *TemplateUpdateOne {
+	tuo.mutation.ClearShareToken()
+	return tuo
+}
+
+// SetContent sets the "content" field.
+func (tuo *TemplateUpdateOne) SetContent(ot *objects.TemplateTarget) *TemplateUpdateOne {
+	tuo.mutation.SetContent(ot)
+	return tuo
+}
+
+// SetSourceTargetID sets the "source_target_id" field.
+func (tuo *TemplateUpdateOne) SetSourceTargetID(u uint64) *TemplateUpdateOne {
+	tuo.mutation.ResetSourceTargetID()
+	tuo.mutation.SetSourceTargetID(u)
+	return tuo
+}
+
+// SetNillableSourceTargetID sets the "source_target_id" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableSourceTargetID(u *uint64) *TemplateUpdateOne {
+	if u != nil {
+		tuo.SetSourceTargetID(*u)
+	}
+	return tuo
+}
+
+// AddSourceTargetID adds u to the "source_target_id" field.
+func (tuo *TemplateUpdateOne) AddSourceTargetID(u int64) *TemplateUpdateOne {
+	tuo.mutation.AddSourceTargetID(u)
+	return tuo
+}
+
+// ClearSourceTargetID clears the value of the "source_target_id" field.
+func (tuo *TemplateUpdateOne) ClearSourceTargetID() *TemplateUpdateOne {
+	tuo.mutation.ClearSourceTargetID()
+	return tuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TemplateUpdateOne) SetUpdatedAt(i int64) *TemplateUpdateOne {
+	tuo.mutation.ResetUpdatedAt()
+	tuo.mutation.SetUpdatedAt(i)
+	return tuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableUpdatedAt(i *int64) *TemplateUpdateOne {
+	if i != nil {
+		tuo.SetUpdatedAt(*i)
+	}
+	return tuo
+}
+
+// AddUpdatedAt adds i to the "updated_at" field.
+func (tuo *TemplateUpdateOne) AddUpdatedAt(i int64) *TemplateUpdateOne {
+	tuo.mutation.AddUpdatedAt(i)
+	return tuo
+}
+
+// Mutation returns the TemplateMutation object of the builder.
+func (tuo *TemplateUpdateOne) Mutation() *TemplateMutation {
+	return tuo.mutation
+}
+
+// Where appends a list predicates to the TemplateUpdate builder.
+func (tuo *TemplateUpdateOne) Where(ps ...predicate.Template) *TemplateUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TemplateUpdateOne) Select(field string, fields ...string) *TemplateUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Template entity.
+func (tuo *TemplateUpdateOne) Save(ctx context.Context) (*Template, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TemplateUpdateOne) SaveX(ctx context.Context) *Template {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TemplateUpdateOne) check() error {
+	if v, ok := tuo.mutation.Title(); ok {
+		if err := template.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Template.title": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Description(); ok {
+		if err := template.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Template.description": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Visibility(); ok {
+		if err := template.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Template.visibility": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Content(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "Template.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TemplateUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
+func (tuo *TemplateUpdateOne) sqlSave(ctx context.Context) (_node *Template, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(template.Table, template.Columns, sqlgraph.NewFieldSpec(template.FieldID, field.TypeUint64))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Template.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, template.FieldID)
+		for _, f := range fields {
+			if !template.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != template.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.UserID(); ok {
+		_spec.SetField(template.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Title(); ok {
+		_spec.SetField(template.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Description(); ok {
+		_spec.SetField(template.FieldDescription, field.TypeString, value)
+	}
+	if tuo.mutation.DescriptionCleared() {
+		_spec.ClearField(template.FieldDescription, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Visibility(); ok {
+		_spec.SetField(template.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.ShareToken(); ok {
+		_spec.SetField(template.FieldShareToken, field.TypeString, value)
+	}
+	if tuo.mutation.ShareTokenCleared() {
+		_spec.ClearField(template.FieldShareToken, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Content(); ok {
+		_spec.SetField(template.FieldContent, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.SourceTargetID(); ok {
+		_spec.SetField(template.FieldSourceTargetID, field.TypeUint64, value)
+	}
+	if value, ok := tuo.mutation.AddedSourceTargetID(); ok {
+		_spec.AddField(template.FieldSourceTargetID, field.TypeUint64, value)
+	}
+	if tuo.mutation.SourceTargetIDCleared() {
+		_spec.ClearField(template.FieldSourceTargetID, field.TypeUint64)
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(template.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedUpdatedAt(); ok {
+		_spec.AddField(template.FieldUpdatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(tuo.modifiers...)
+	_node = &Template{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{template.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
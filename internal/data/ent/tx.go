@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,8 +16,24 @@ type Tx struct {
 	config
 	// Award is the client for interacting with the Award builders.
 	Award *AwardClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// Export is the client for interacting with the Export builders.
+	Export *ExportClient
+	// ImportItem is the client for interacting with the ImportItem builders.
+	ImportItem *ImportItemClient
+	// ImportJob is the client for interacting with the ImportJob builders.
+	ImportJob *ImportJobClient
 	// Portrait is the client for interacting with the Portrait builders.
 	Portrait *PortraitClient
+	// Report is the client for interacting with the Report builders.
+	Report *ReportClient
+	// ReviewRequest is the client for interacting with the ReviewRequest builders.
+	ReviewRequest *ReviewRequestClient
+	// Reviewer is the client for interacting with the Reviewer builders.
+	Reviewer *ReviewerClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// Show is the client for interacting with the Show builders.
 	Show *ShowClient
 	// ShowReserve is the client for interacting with the ShowReserve builders.
@@ -24,8 +42,14 @@ type Tx struct {
 	Step *StepClient
 	// StepRate is the client for interacting with the StepRate builders.
 	StepRate *StepRateClient
+	// StepRateCriterion is the client for interacting with the StepRateCriterion builders.
+	StepRateCriterion *StepRateCriterionClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// Target is the client for interacting with the Target builders.
 	Target *TargetClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
 
 	// lazily loaded.
 	client     *Client
@@ -158,12 +182,23 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Award = NewAwardClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.Export = NewExportClient(tx.config)
+	tx.ImportItem = NewImportItemClient(tx.config)
+	tx.ImportJob = NewImportJobClient(tx.config)
 	tx.Portrait = NewPortraitClient(tx.config)
+	tx.Report = NewReportClient(tx.config)
+	tx.ReviewRequest = NewReviewRequestClient(tx.config)
+	tx.Reviewer = NewReviewerClient(tx.config)
+	tx.SearchDocument = NewSearchDocumentClient(tx.config)
 	tx.Show = NewShowClient(tx.config)
 	tx.ShowReserve = NewShowReserveClient(tx.config)
 	tx.Step = NewStepClient(tx.config)
 	tx.StepRate = NewStepRateClient(tx.config)
+	tx.StepRateCriterion = NewStepRateCriterionClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.Target = NewTargetClient(tx.config)
+	tx.Template = NewTemplateClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -226,3 +261,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,359 @@
+package data
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"path"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"time"
+)
+
+// exportBuilder 遍历目标树生成manifest，并把媒体文件写入压缩包
+type exportBuilder struct {
+	r       *exportRepo
+	targets int
+	steps   int
+	// 压缩包中的路径 -> 对象名
+	files map[string]string
+}
+
+func (b *exportBuilder) manifest(ctx context.Context, target *ent.Target) (*objects.ExportManifest, error) {
+	root, err := b.exportTarget(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objects.ExportManifest{
+		Version:    objects.ExportManifestVersion,
+		ExportedAt: time.Now().Local().Unix(),
+		Target:     root,
+	}, nil
+}
+
+func (b *exportBuilder) exportTarget(ctx context.Context, target *ent.Target) (*objects.ExportTarget, error) {
+	b.targets++
+	node := &objects.ExportTarget{
+		ID:          target.ID,
+		Title:       target.Title,
+		Description: target.Description,
+		Type:        target.Type,
+		Status:      target.Status.String(),
+		Mode:        target.Mode.String(),
+		Unit:        target.Unit,
+		GoalValue:   target.GoalValue,
+		CreatedAt:   target.CreatedAt,
+		StartAt:     target.StartAt,
+		ChallengeAt: target.ChallengeAt,
+		DoneAt:      target.DoneAt,
+	}
+	if target.Rubric.IsCustom() {
+		node.Rubric = target.Rubric
+	}
+
+	steps, err := b.exportSteps(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	node.Steps = steps
+
+	children, err := b.r.data.ent_client.Target.Query().
+		Where(entTarget.ParentID(target.ID)).
+		Order(targetOrder(objects.SortManual)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		childNode, err := b.exportTarget(ctx, child)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+
+	return node, nil
+}
+
+func toExportComment(comment *ent.Comment) *objects.ExportComment {
+	return &objects.ExportComment{
+		Role:      comment.Role.String(),
+		Rating:    comment.Rating,
+		Content:   comment.Content,
+		CreatedAt: comment.CreatedAt,
+	}
+}
+
+// exportSteps 目标下的积累按时间先后排列，目录下的积累放在children中
+func (b *exportBuilder) exportSteps(ctx context.Context, target *ent.Target) ([]*objects.ExportStep, error) {
+	steps, err := b.r.data.ent_client.Step.Query().
+		Where(entStep.RefTargetID(target.ID)).
+		Order(ent.Asc(entStep.FieldCreatedAt), ent.Asc(entStep.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stepIDs := make([]uint64, len(steps))
+	for i, s := range steps {
+		stepIDs[i] = s.ID
+	}
+
+	rates, err := b.r.data.ent_client.StepRate.Query().
+		Where(steprate.StepIDIn(stepIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stepRates := make(map[uint64]*ent.StepRate, len(rates))
+	for _, rate := range rates {
+		stepRates[rate.StepID] = rate
+	}
+
+	criteria, err := b.r.data.ent_client.StepRateCriterion.Query().
+		Where(stepratecriterion.StepIDIn(stepIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stepCriteria := make(map[uint64]map[string]float64)
+	for _, criterion := range criteria {
+		if stepCriteria[criterion.StepID] == nil {
+			stepCriteria[criterion.StepID] = make(map[string]float64)
+		}
+		stepCriteria[criterion.StepID][criterion.Key] = criterion.Value
+	}
+
+	threads, err := stepCommentThreads(ctx, b.r.data.ent_client, stepIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[uint64][]*objects.ExportStep)
+	for _, s := range steps {
+		b.steps++
+		node := &objects.ExportStep{
+			ID:              s.ID,
+			Type:            s.Type.String(),
+			Title:           s.Title,
+			Description:     s.Description,
+			IsChallenge:     s.IsChallenge,
+			CreatedAt:       s.CreatedAt,
+			Measurement:     s.Measurement,
+			Content:         s.Content,
+			URL:             s.URL,
+			LinkTitle:       s.LinkTitle,
+			LinkDescription: s.LinkDescription,
+			MimeType:        s.MimeType,
+			PageCount:       s.PageCount,
+		}
+
+		if s.ObjectName != "" {
+			node.File = fmt.Sprintf("media/target_%d/%d_%s", target.ID, s.ID, path.Base(s.ObjectName))
+			b.files[node.File] = s.ObjectName
+		}
+
+		for _, thread := range threads[s.ID] {
+			exportComment := toExportComment(thread.comment)
+			for _, reply := range thread.replies {
+				exportComment.Replies = append(exportComment.Replies, toExportComment(reply))
+			}
+			node.Comments = append(node.Comments, exportComment)
+		}
+
+		if rate, ok := stepRates[s.ID]; ok {
+			node.Rate = &objects.ExportStepRate{
+				WeightedValue:         rate.WeightedValue,
+				TargetReasonableness:  rate.TargetReasonableness,
+				TargetClarity:         rate.TargetClarity,
+				TargetAchievement:     rate.TargetAchievement,
+				ReflectionImprovement: rate.ReflectionImprovement,
+				Innovation:            rate.Innovation,
+				BasicReliability:      rate.BasicReliability,
+				SkillImprovement:      rate.SkillImprovement,
+				Difficulty:            rate.Difficulty,
+				Date:                  rate.Date.Format(time.DateOnly),
+				Criteria:              stepCriteria[s.ID],
+			}
+		}
+
+		children[s.ParentID] = append(children[s.ParentID], node)
+	}
+
+	for _, nodes := range children {
+		for _, node := range nodes {
+			if node.Type == entStep.TypeDir.String() {
+				node.Children = children[node.ID]
+			}
+		}
+	}
+
+	return children[0], nil
+}
+
+// writeArchive 媒体文件本身已经压缩，直接存储；读取失败的文件跳过并从manifest中去掉路径
+func (b *exportBuilder) writeArchive(ctx context.Context, w io.Writer, manifest *objects.ExportManifest) error {
+	zw := zip.NewWriter(w)
+
+	missing := make(map[string]bool)
+	for name, objectName := range b.files {
+		err := b.writeObject(ctx, zw, name, objectName)
+		if err != nil {
+			b.r.log.Warnf("export object %s error: %v", objectName, err)
+			missing[name] = true
+		}
+	}
+	if len(missing) > 0 {
+		clearMissingFiles(manifest.Target, missing)
+	}
+
+	manifestFile, err := zw.Create("manifest.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(manifestFile)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(manifest)
+	if err != nil {
+		return err
+	}
+
+	indexFile, err := zw.Create("index.html")
+	if err != nil {
+		return err
+	}
+	err = exportIndexTemplate.Execute(indexFile, manifest)
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func (b *exportBuilder) writeObject(ctx context.Context, zw *zip.Writer, name string, objectName string) error {
+	object, err := b.r.minioRepo.GetObject(ctx, objectName, "")
+	if err != nil {
+		return err
+	}
+	defer object.Body.Close()
+
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Store,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, object.Body)
+	return err
+}
+
+func clearMissingFiles(target *objects.ExportTarget, missing map[string]bool) {
+	var clearSteps func(steps []*objects.ExportStep)
+	clearSteps = func(steps []*objects.ExportStep) {
+		for _, s := range steps {
+			if missing[s.File] {
+				s.File = ""
+			}
+			clearSteps(s.Children)
+		}
+	}
+	clearSteps(target.Steps)
+	for _, child := range target.Children {
+		clearMissingFiles(child, missing)
+	}
+}
+
+var commentRoleNames = map[string]string{
+	objects.CommentRoleTeacher: "老师评论",
+	objects.CommentRoleParent:  "家长评论",
+	objects.CommentRoleFriend:  "朋友评论",
+	objects.CommentRoleStudent: "本人",
+}
+
+var exportIndexTemplate = template.Must(template.New("index").Funcs(template.FuncMap{
+	"datetime": func(unix int64) string {
+		if unix == 0 {
+			return ""
+		}
+		return time.Unix(unix, 0).Local().Format("2006-01-02 15:04")
+	},
+	"commentText": objects.CommentText,
+	"roleName": func(role string) string {
+		if name, ok := commentRoleNames[role]; ok {
+			return name
+		}
+		return role
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Target.Title}}</title>
+<style>
+body { font-family: -apple-system, "PingFang SC", "Microsoft YaHei", sans-serif; max-width: 960px; margin: 0 auto; padding: 16px; color: #333; }
+section.target { border-left: 3px solid #4a90d9; padding-left: 12px; margin: 16px 0; }
+div.step { border: 1px solid #e5e5e5; border-radius: 6px; padding: 8px 12px; margin: 8px 0; }
+div.dir { background: #fafafa; }
+.meta { color: #888; font-size: 13px; }
+.challenge { color: #d9534f; }
+img, video { max-width: 100%; }
+pre { white-space: pre-wrap; }
+table.rate td { padding: 0 8px; }
+div.comment div.comment { margin-left: 16px; }
+</style>
+</head>
+<body>
+<h1>{{.Target.Title}}</h1>
+<p class="meta">导出时间 {{datetime .ExportedAt}}</p>
+{{template "target" .Target}}
+</body>
+</html>
+{{define "target"}}
+<section class="target">
+<h2>{{.Title}}</h2>
+<p class="meta">{{.Status}} · 创建于 {{datetime .CreatedAt}}{{if .DoneAt}} · 完成于 {{datetime .DoneAt}}{{end}}{{if .GoalValue}} · 目标 {{.GoalValue}} {{.Unit}}{{end}}</p>
+{{if .Description}}<p>{{.Description}}</p>{{end}}
+{{range .Steps}}{{template "step" .}}{{end}}
+{{range .Children}}{{template "target" .}}{{end}}
+</section>
+{{end}}
+{{define "step"}}
+<div class="step{{if eq .Type "dir"}} dir{{end}}">
+<p class="meta">{{.Type}} · {{datetime .CreatedAt}}{{if .IsChallenge}} · <span class="challenge">挑战</span>{{end}}</p>
+{{if .Title}}<h3>{{.Title}}</h3>{{end}}
+{{if .Description}}<p>{{.Description}}</p>{{end}}
+{{if .File}}
+{{if eq .Type "image"}}<img src="{{.File}}" alt="{{.Title}}">
+{{else if eq .Type "video"}}<video src="{{.File}}" controls></video>
+{{else if eq .Type "audio"}}<audio src="{{.File}}" controls></audio>
+{{else}}<a href="{{.File}}">{{.File}}</a>{{if .PageCount}} ({{.PageCount}}页){{end}}{{end}}
+{{end}}
+{{if eq .Type "measurement"}}<p>{{.Measurement}}</p>{{end}}
+{{if .Content}}<pre>{{.Content}}</pre>{{end}}
+{{if .URL}}<p><a href="{{.URL}}">{{if .LinkTitle}}{{.LinkTitle}}{{else}}{{.URL}}{{end}}</a></p>{{if .LinkDescription}}<p class="meta">{{.LinkDescription}}</p>{{end}}{{end}}
+{{range .Comments}}{{template "comment" .}}{{end}}
+{{with .Rate}}<table class="rate"><tr><td>加权值 {{.WeightedValue}}</td><td>目标合理性 {{.TargetReasonableness}}</td><td>目标明确性 {{.TargetClarity}}</td><td>目标达成度 {{.TargetAchievement}}</td></tr><tr><td>反思与改进 {{.ReflectionImprovement}}</td><td>创新性 {{.Innovation}}</td><td>基础牢靠 {{.BasicReliability}}</td><td>技能提升 {{.SkillImprovement}}</td><td>困难度 {{.Difficulty}}</td></tr></table>{{end}}
+{{range .Children}}{{template "step" .}}{{end}}
+</div>
+{{end}}
+{{define "comment"}}
+<div class="comment"><p><strong>{{roleName .Role}}</strong> <span class="meta">{{datetime .CreatedAt}}</span> {{commentText .Rating}}</p>
+{{if .Content}}<pre>{{.Content}}</pre>{{end}}
+{{range .Replies}}{{template "comment" .}}{{end}}
+</div>
+{{end}}`))
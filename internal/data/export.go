@@ -0,0 +1,294 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	entExport "step/internal/data/ent/export"
+	"step/internal/objects"
+	"step/internal/utils"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+type exportRepo struct {
+	data      *Data
+	log       *log.Helper
+	minioRepo biz.MinioRepo
+}
+
+// NewExportRepo .
+func NewExportRepo(data *Data, logger log.Logger, minioRepo biz.MinioRepo) biz.ExportRepo {
+	return &exportRepo{
+		data:      data,
+		log:       log.NewHelper(logger, log.WithMessageKey("exportRepo")),
+		minioRepo: minioRepo,
+	}
+}
+
+// exportObjectName 压缩包放在用户目录下，不和目标的文件混在一起
+func exportObjectName(uid string, exportID uint64) string {
+	return fmt.Sprintf("%s/exports/export_%d.zip", uid, exportID)
+}
+
+func toApiExport(export *ent.Export) *stepApi.Export {
+	return &stepApi.Export{
+		Id:         export.ID,
+		TargetId:   export.TargetID,
+		Status:     export.Status.String(),
+		Size:       export.Size,
+		Targets:    export.Targets,
+		Steps:      export.Steps,
+		Error:      export.Error,
+		CreatedAt:  export.CreatedAt,
+		FinishedAt: export.FinishedAt,
+	}
+}
+
+// getOwnedExport 获取当前用户的导出
+func (r *exportRepo) getOwnedExport(ctx context.Context, id uint64) (*ent.Export, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	export, err := r.data.ent_client.Export.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	return export, nil
+}
+
+func (r *exportRepo) CreateExport(ctx context.Context, req *stepApi.CreateExportRequest) (*stepApi.CreateExportReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	export, err := r.data.ent_client.Export.Query().
+		Where(
+			entExport.UserID(uid),
+			entExport.TargetID(target.ID),
+			entExport.StatusIn(entExport.StatusPending, entExport.StatusRunning),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if export == nil {
+		export, err = r.data.ent_client.Export.Create().
+			SetUserID(uid).
+			SetTargetID(target.ID).
+			SetStatus(entExport.StatusPending).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &stepApi.CreateExportReply{
+		Export: toApiExport(export),
+	}, nil
+}
+
+func (r *exportRepo) GetExport(ctx context.Context, req *stepApi.GetExportRequest) (*stepApi.GetExportReply, error) {
+	export, err := r.getOwnedExport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	exportApi := toApiExport(export)
+	if export.Status == entExport.StatusDone && export.ObjectName != "" {
+		exportApi.DownloadUrl, err = r.minioRepo.GetDownloadPreSignedUrl(ctx, export.ObjectName, r.minioRepo.GetRemoteEndpoint(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &stepApi.GetExportReply{
+		Export: exportApi,
+	}, nil
+}
+
+func (r *exportRepo) GetExports(ctx context.Context, req *stepApi.GetExportsRequest) (*stepApi.GetExportsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	exports, err := r.data.ent_client.Export.Query().
+		Where(entExport.UserID(uid)).
+		Order(ent.Desc(entExport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exportsApi := make([]*stepApi.Export, len(exports))
+	for i, export := range exports {
+		exportsApi[i] = toApiExport(export)
+	}
+
+	return &stepApi.GetExportsReply{
+		Exports: exportsApi,
+	}, nil
+}
+
+// DeleteExport 正在生成的导出不能删除
+func (r *exportRepo) DeleteExport(ctx context.Context, req *stepApi.DeleteExportRequest) (*stepApi.DeleteExportReply, error) {
+	export, err := r.getOwnedExport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.Status == entExport.StatusRunning {
+		return nil, stepApi.ErrorTaskRunning("export is running")
+	}
+
+	err = r.data.ent_client.Export.DeleteOne(export).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.ObjectName != "" {
+		if err := r.minioRepo.RemoveFile(ctx, export.ObjectName); err != nil {
+			r.log.Errorf("remove export object %s error: %v", export.ObjectName, err)
+		}
+	}
+
+	return &stepApi.DeleteExportReply{
+		Id: req.Id,
+	}, nil
+}
+
+// HandleTargetExport 生成压缩包并上传，失败时记录原因，重试时重新生成
+func (r *exportRepo) HandleTargetExport(ctx context.Context, task *asynq.Task) error {
+	var payload objects.TargetExportPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	export, err := r.data.ent_client.Export.Get(ctx, payload.ExportID)
+	if err != nil {
+		// 任务执行前导出已被删除
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if export.Status == entExport.StatusDone {
+		return nil
+	}
+
+	export, err = export.Update().
+		SetStatus(entExport.StatusRunning).
+		ClearError().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.buildExport(ctx, export)
+	if err != nil {
+		message := err.Error()
+		if len(message) > 1000 {
+			message = message[:1000]
+		}
+		_, uerr := export.Update().
+			SetStatus(entExport.StatusFailed).
+			SetError(message).
+			SetFinishedAt(time.Now().Local().Unix()).
+			Save(ctx)
+		if uerr != nil {
+			r.log.Errorf("update export %d error: %v", export.ID, uerr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// buildExport 压缩包先写到临时文件，避免大文件占用内存
+func (r *exportRepo) buildExport(ctx context.Context, export *ent.Export) error {
+	target, err := r.data.ent_client.Target.Get(ctx, export.TargetID)
+	if err != nil {
+		return err
+	}
+
+	if target.UserID != export.UserID {
+		return stepApi.ErrorNotOwner("not owner")
+	}
+
+	builder := &exportBuilder{
+		r:     r,
+		files: make(map[string]string),
+	}
+	manifest, err := builder.manifest(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp("", "step-export-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+
+	err = builder.writeArchive(ctx, file, manifest)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Seek(0, 0)
+	if err != nil {
+		return err
+	}
+
+	objectName := exportObjectName(export.UserID, export.ID)
+	err = r.minioRepo.UploadObject(ctx, objectName, file, "application/zip")
+	if err != nil {
+		return err
+	}
+
+	_, err = export.Update().
+		SetStatus(entExport.StatusDone).
+		SetObjectName(objectName).
+		SetSize(info.Size()).
+		SetTargets(uint32(builder.targets)).
+		SetSteps(uint32(builder.steps)).
+		SetFinishedAt(time.Now().Local().Unix()).
+		Save(ctx)
+	return err
+}
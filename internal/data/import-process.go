@@ -0,0 +1,761 @@
+package data
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	stepApi "step/api/step/v1"
+	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// 导入项批量写入的大小
+const importItemBatchSize = 500
+
+// importProcessor 处理一个导入任务:
+// ● 首次处理时解析清单，把导入项写入数据库，之后的重试和恢复都基于导入项的状态
+// ● 第一阶段按顺序创建目标、目录和积累，每一项单独提交，失败只影响该项及其子项
+// ● 第二阶段按原始时间先后更新目标状态并发出统计事件
+type importProcessor struct {
+	r       *importRepo
+	job     *ent.ImportJob
+	archive *zip.ReadCloser
+	tmpFile string
+}
+
+func (p *importProcessor) close() {
+	if p.archive != nil {
+		p.archive.Close()
+	}
+	if p.tmpFile != "" {
+		os.Remove(p.tmpFile)
+	}
+}
+
+// openArchive 压缩包下载到临时文件，只在需要时下载一次
+func (p *importProcessor) openArchive(ctx context.Context) (*zip.ReadCloser, error) {
+	if p.archive != nil {
+		return p.archive, nil
+	}
+
+	object, err := p.r.minioRepo.GetObject(ctx, p.job.ObjectName, "")
+	if err != nil {
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	file, err := os.CreateTemp("", "step-import-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	p.tmpFile = file.Name()
+
+	_, err = io.Copy(file, object.Body)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.archive, err = zip.OpenReader(p.tmpFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid archive: %v", asynq.SkipRetry, err)
+	}
+	return p.archive, nil
+}
+
+func (p *importProcessor) process(ctx context.Context) error {
+	exist, err := p.r.data.ent_client.ImportItem.Query().
+		Where(importitem.JobID(p.job.ID)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		err = p.plan(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = p.createItems(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = p.emitEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	return p.finish(ctx)
+}
+
+// plan 解析清单并写入导入项，清单错误不重试
+func (p *importProcessor) plan(ctx context.Context) error {
+	archive, err := p.openArchive(ctx)
+	if err != nil {
+		return err
+	}
+
+	manifestName := objects.ImportManifestJSON
+	parse := objects.ParseImportJSON
+	if p.job.Format == importjob.FormatCsv {
+		manifestName = objects.ImportManifestCSV
+		parse = objects.ParseImportCSV
+	}
+
+	manifest, err := archive.Open(manifestName)
+	if err != nil {
+		return fmt.Errorf("%w: %s not found", asynq.SkipRetry, manifestName)
+	}
+	defer manifest.Close()
+
+	items, err := parse(manifest)
+	if err != nil {
+		return fmt.Errorf("%w: %v", asynq.SkipRetry, err)
+	}
+
+	tx, err := p.r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = func() error {
+		for start := 0; start < len(items); start += importItemBatchSize {
+			end := min(start+importItemBatchSize, len(items))
+			builders := make([]*ent.ImportItemCreate, 0, end-start)
+			for i, item := range items[start:end] {
+				builders = append(builders, tx.ImportItem.Create().
+					SetJobID(p.job.ID).
+					SetSeq(uint32(start+i+1)).
+					SetKind(importitem.Kind(item.Kind)).
+					SetRef(item.Ref).
+					SetParentRef(item.ParentRef).
+					SetTargetRef(item.TargetRef).
+					SetItemTime(item.CreatedAt).
+					SetData(item.Data))
+			}
+			err := tx.ImportItem.CreateBulk(builders...).Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.ImportJob.UpdateOneID(p.job.ID).
+			SetTotal(uint32(len(items))).
+			Exec(ctx)
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			p.r.log.Errorf("rollback error: %v", rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// createItems 父级先于子级处理，通过ref找到已创建的父目标或父目录
+func (p *importProcessor) createItems(ctx context.Context) error {
+	items, err := p.r.data.ent_client.ImportItem.Query().
+		Where(importitem.JobID(p.job.ID)).
+		Order(ent.Asc(importitem.FieldSeq)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	refs := make(map[string]*ent.ImportItem, len(items))
+	needArchive := false
+	for _, item := range items {
+		refs[item.Ref] = item
+		if item.Status == importitem.StatusPending && item.Data != nil && item.Data.File != "" {
+			needArchive = true
+		}
+	}
+
+	// 压缩包读取失败时整个任务重试，而不是让所有媒体积累失败
+	if needArchive {
+		_, err = p.openArchive(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		if item.Status != importitem.StatusPending {
+			continue
+		}
+
+		entityID, err := p.createItem(ctx, item, refs)
+		if err != nil {
+			// 数据库不可用等错误由任务重试，已创建的导入项不会重复创建
+			if ctx.Err() != nil {
+				return err
+			}
+			item.Status = importitem.StatusFailed
+			uerr := p.r.data.ent_client.ImportItem.UpdateOne(item).
+				SetStatus(importitem.StatusFailed).
+				SetError(importErrorMessage(err)).
+				Exec(ctx)
+			if uerr != nil {
+				return uerr
+			}
+			continue
+		}
+
+		item.Status = importitem.StatusCreated
+		item.EntityID = entityID
+	}
+
+	return nil
+}
+
+// resolve 找到已创建的父级，父级失败时子级也失败
+func resolveImportRef(refs map[string]*ent.ImportItem, ref string) (uint64, error) {
+	if ref == "" {
+		return 0, nil
+	}
+	parent, ok := refs[ref]
+	if !ok {
+		return 0, fmt.Errorf("parent %s not found", ref)
+	}
+	if parent.Status != importitem.StatusCreated && parent.Status != importitem.StatusDone {
+		return 0, fmt.Errorf("parent %s failed", ref)
+	}
+	return parent.EntityID, nil
+}
+
+func (p *importProcessor) createItem(ctx context.Context, item *ent.ImportItem, refs map[string]*ent.ImportItem) (uint64, error) {
+	if item.Data == nil {
+		return 0, errors.New("item data is empty")
+	}
+
+	if item.Kind == importitem.KindTarget {
+		parentID, err := resolveImportRef(refs, item.ParentRef)
+		if err != nil {
+			return 0, err
+		}
+		if item.ParentRef == "" {
+			parentID = p.job.ParentTargetID
+		}
+		return p.createTarget(ctx, item, parentID)
+	}
+
+	targetID, err := resolveImportRef(refs, item.TargetRef)
+	if err != nil {
+		return 0, err
+	}
+	if targetID == 0 {
+		return 0, errors.New("step has no target")
+	}
+	parentID, err := resolveImportRef(refs, item.ParentRef)
+	if err != nil {
+		return 0, err
+	}
+	return p.createStep(ctx, item, targetID, parentID)
+}
+
+// importItemTime 没有原始时间时用当前时间
+func importItemTime(item *ent.ImportItem) int64 {
+	if item.ItemTime > 0 {
+		return item.ItemTime
+	}
+	return time.Now().Local().Unix()
+}
+
+// createTarget 保留原始的创建时间，json清单中的状态和进度时间一起恢复
+func (p *importProcessor) createTarget(ctx context.Context, item *ent.ImportItem, parentID uint64) (uint64, error) {
+	data := item.Data
+
+	var layer uint32
+	targetPath := objects.RootPath
+	if parentID != 0 {
+		parentTarget, err := p.r.data.ent_client.Target.Get(ctx, parentID)
+		if err != nil {
+			return 0, err
+		}
+		if parentTarget.UserID != p.job.UserID {
+			return 0, stepApi.ErrorNotOwner("not owner")
+		}
+		layer = parentTarget.Layer + 1
+		targetPath = objects.ChildPath(parentTarget.Path, parentTarget.ID)
+	}
+
+	defer p.r.data.cache.InvalidateUserCache(ctx, p.job.UserID, objects.CacheKindTargetTree)
+
+	return p.saveItem(ctx, item, func(tx *ent.Tx) (uint64, error) {
+		createTarget := tx.Target.Create().
+			SetUserID(p.job.UserID).
+			SetTitle(data.Title).
+			SetDescription(data.Description).
+			SetCreatedAt(importItemTime(item)).
+			SetLayer(layer).
+			SetPath(targetPath).
+			SetUnit(data.Unit).
+			SetGoalValue(data.GoalValue)
+		if parentID != 0 {
+			createTarget.SetParentID(parentID)
+		}
+		if data.Type != "" {
+			createTarget.SetType(data.Type)
+		}
+		if data.Mode != "" {
+			createTarget.SetMode(entTarget.Mode(data.Mode))
+		}
+		if data.Status != "" {
+			createTarget.SetStatus(entTarget.Status(data.Status))
+		}
+		if data.StartAt > 0 {
+			createTarget.SetStartAt(data.StartAt)
+		}
+		if data.ChallengeAt > 0 {
+			createTarget.SetChallengeAt(data.ChallengeAt)
+		}
+		if data.DoneAt > 0 {
+			createTarget.SetDoneAt(data.DoneAt)
+		}
+
+		target, err := createTarget.Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return target.ID, nil
+	})
+}
+
+// createStep 媒体积累从压缩包读取文件上传，对象名包含导入ID和顺序号，重试时覆盖同一个对象
+func (p *importProcessor) createStep(ctx context.Context, item *ent.ImportItem, targetID uint64, parentID uint64) (uint64, error) {
+	data := item.Data
+
+	stepType := data.Type
+	if item.Kind == importitem.KindDir {
+		stepType = entStep.TypeDir.String()
+	}
+	if stepType == "" && data.File != "" {
+		stepType = objects.ImportFileStepType(objects.ImportFileMimeType(data.File))
+	}
+	if stepType == "" {
+		return 0, fmt.Errorf("unknown type of file: %s", data.File)
+	}
+	err := entStep.TypeValidator(entStep.Type(stepType))
+	if err != nil {
+		return 0, err
+	}
+
+	stepPath := objects.RootPath
+	if parentID != 0 {
+		parentStep, err := p.r.data.ent_client.Step.Get(ctx, parentID)
+		if err != nil {
+			return 0, err
+		}
+		stepPath = objects.ChildPath(parentStep.Path, parentStep.ID)
+	}
+
+	var objectName, mimeType string
+	if slices.Contains(mediaStepTypes, entStep.Type(stepType)) {
+		if data.File == "" {
+			return 0, fmt.Errorf("%s step needs a file", stepType)
+		}
+		mimeType = objects.ImportFileMimeType(data.File)
+		objectName = stepObjectName(p.job.UserID, targetID, parentID, fmt.Sprintf("import_%d_%d_%s", p.job.ID, item.Seq, path.Base(data.File)))
+		err = p.uploadFile(ctx, data.File, objectName, mimeType)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if len(data.Comments) > 0 {
+		target, err := p.r.data.ent_client.Target.Get(ctx, targetID)
+		if err != nil {
+			return 0, err
+		}
+		topTarget, err := topTargetOf(ctx, p.r.data.ent_client, target)
+		if err != nil {
+			return 0, err
+		}
+		err = checkImportComments(data.Comments, topTarget.Rubric)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	entityID, err := p.saveItem(ctx, item, func(tx *ent.Tx) (uint64, error) {
+		createStep := tx.Step.Create().
+			SetRefTargetID(targetID).
+			SetPath(stepPath).
+			SetType(entStep.Type(stepType)).
+			SetTitle(data.Title).
+			SetDescription(data.Description).
+			SetIsChallenge(data.IsChallenge).
+			SetCreatedAt(importItemTime(item)).
+			SetMeasurement(data.Measurement).
+			SetContent(data.Content).
+			SetURL(data.URL).
+			SetLinkTitle(data.LinkTitle).
+			SetLinkDescription(data.LinkDescription)
+		if parentID != 0 {
+			createStep.SetParentID(parentID)
+		}
+		if objectName != "" {
+			createStep.SetObjectName(objectName).SetMimeType(mimeType)
+		}
+
+		step, err := createStep.Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		err = importComments(ctx, tx, step, data.Comments)
+		if err != nil {
+			return 0, err
+		}
+		return step.ID, nil
+	})
+	if err != nil && objectName != "" {
+		if rerr := p.r.minioRepo.RemoveFile(ctx, objectName); rerr != nil {
+			p.r.log.Errorf("remove import object %s error: %v", objectName, rerr)
+		}
+	}
+	return entityID, err
+}
+
+// importableCommentRole 导入的评论没有作者，老师和家长的评论只能由关联的评论人提交
+func importableCommentRole(role string) bool {
+	return role == objects.CommentRoleFriend || role == objects.CommentRoleStudent
+}
+
+// checkImportComments 和提交评论一样按顶层目标的评分标准校验评分，朋友和自评每个积累只有一条评分
+func checkImportComments(comments objects.ExportComments, targetRubric *objects.TargetRubric) error {
+	rated := make(map[string]bool)
+	for _, comment := range comments {
+		if !importableCommentRole(comment.Role) {
+			return fmt.Errorf("%s comments can't be imported", comment.Role)
+		}
+		if comment.Rating != nil {
+			if rated[comment.Role] {
+				return fmt.Errorf("step has more than one %s rating", comment.Role)
+			}
+			rated[comment.Role] = true
+			_, err := objects.ParseTargetRating(comment.Rating, targetRubric)
+			if err != nil {
+				return fmt.Errorf("invalid %s rating: %v", comment.Role, err)
+			}
+		}
+		for _, reply := range comment.Replies {
+			if !importableCommentRole(reply.Role) {
+				return fmt.Errorf("%s replies can't be imported", reply.Role)
+			}
+		}
+	}
+	return nil
+}
+
+// importComments 导入的评论和回复没有作者，没有时间的使用积累的时间
+func importComments(ctx context.Context, tx *ent.Tx, step *ent.Step, comments objects.ExportComments) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	updateStep := tx.Step.UpdateOneID(step.ID)
+	for _, comment := range comments {
+		createdAt := comment.CreatedAt
+		if createdAt == 0 {
+			createdAt = step.CreatedAt
+		}
+		create := tx.Comment.Create().
+			SetStepID(step.ID).
+			SetRole(entComment.Role(comment.Role)).
+			SetContent(comment.Content).
+			SetCreatedAt(createdAt).
+			SetUpdatedAt(createdAt)
+		if comment.Rating != nil {
+			create.SetRating(comment.Rating)
+			setStepRating(updateStep, comment.Role, comment.Rating, "")
+		}
+		top, err := create.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, reply := range comment.Replies {
+			replyAt := max(reply.CreatedAt, createdAt)
+			err = tx.Comment.Create().
+				SetStepID(step.ID).
+				SetParentID(top.ID).
+				SetRole(entComment.Role(reply.Role)).
+				SetContent(reply.Content).
+				SetCreatedAt(replyAt).
+				SetUpdatedAt(replyAt).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return updateStep.Exec(ctx)
+}
+
+func (p *importProcessor) uploadFile(ctx context.Context, file string, objectName string, mimeType string) error {
+	archive, err := p.openArchive(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := archive.Open(file)
+	if err != nil {
+		return fmt.Errorf("file %s not found in archive", file)
+	}
+	defer f.Close()
+
+	return p.r.minioRepo.UploadObject(ctx, objectName, f, mimeType)
+}
+
+// saveItem 创建实体和标记导入项在同一个事务中，重试时不会重复创建
+func (p *importProcessor) saveItem(ctx context.Context, item *ent.ImportItem, create func(tx *ent.Tx) (uint64, error)) (uint64, error) {
+	tx, err := p.r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var entityID uint64
+	err = func() error {
+		entityID, err = create(tx)
+		if err != nil {
+			return err
+		}
+
+		return tx.ImportItem.UpdateOneID(item.ID).
+			SetStatus(importitem.StatusCreated).
+			SetEntityID(entityID).
+			ClearError().
+			Exec(ctx)
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			p.r.log.Errorf("rollback error: %v", rerr)
+		}
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return entityID, nil
+}
+
+// emitEvents 按原始时间先后处理已创建的导入项，使统计按时间顺序累计。
+// 统计在这个任务中依次执行，不入队，避免并发的统计任务打乱顺序；画像反馈在最后发出一次
+func (p *importProcessor) emitEvents(ctx context.Context) error {
+	items, err := p.r.data.ent_client.ImportItem.Query().
+		Where(importitem.JobID(p.job.ID), importitem.StatusEQ(importitem.StatusCreated)).
+		Order(ent.Asc(importitem.FieldItemTime), ent.Asc(importitem.FieldSeq)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	var changes []*objects.PortraitchangeType
+	for _, item := range items {
+		itemChanges, err := p.emitItemEvents(ctx, item)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, itemChanges...)
+
+		err = p.r.data.ent_client.ImportItem.UpdateOneID(item.ID).
+			SetStatus(importitem.StatusDone).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.r.data.cache.InvalidateUserCache(ctx, p.job.UserID, objects.CacheKindPortrait)
+	changes = mergePortraitChanges(changes)
+	if len(changes) == 0 {
+		return nil
+	}
+	return p.r.asynqEnqueueRepo.EnqueueFeedbackPortraitChange(ctx, p.job.UserID, changes)
+}
+
+// emitItemEvents 和新增目标、积累、评论时的统计任务相同，直接调用；积累已计入StepRate时统计会跳过，重做时不会重复累加
+func (p *importProcessor) emitItemEvents(ctx context.Context, item *ent.ImportItem) ([]*objects.PortraitchangeType, error) {
+	stats := p.r.statisticsRepo
+	switch item.Kind {
+	case importitem.KindTarget:
+		task, err := newPayloadTask(objects.TypeTargetCreate, objects.TargetCreatePayload{TargetID: item.EntityID})
+		if err != nil {
+			return nil, err
+		}
+		_, changes, err := stats.HandleTargetCreate(ctx, task)
+		return changes, err
+
+	case importitem.KindStep:
+		step, err := p.r.data.ent_client.Step.Get(ctx, item.EntityID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.advanceTargetStatus(ctx, step.RefTargetID, step.IsChallenge, step.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		task, err := newPayloadTask(objects.TypeStepCreate, objects.StepCreatePayload{StepID: step.ID})
+		if err != nil {
+			return nil, err
+		}
+		_, changes, err := stats.HandleStepCreate(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+
+		rated, err := p.r.data.ent_client.Comment.Query().
+			Where(entComment.StepID(step.ID), entComment.ParentIDIsNil(), entComment.RatingNotNil()).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if rated {
+			task, err = newPayloadTask(objects.TypeStepComment, objects.StepCommentPayload{StepID: step.ID})
+			if err != nil {
+				return nil, err
+			}
+			_, commentChanges, err := stats.HandleStepComment(ctx, task)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, commentChanges...)
+		}
+
+		// 文档解析和统计顺序无关
+		if step.Type == entStep.TypeDocument {
+			err = p.r.asynqEnqueueRepo.EnqueueStepDocument(ctx, step.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return changes, nil
+	}
+
+	return nil, nil
+}
+
+// newPayloadTask 直接调用统计处理时构造和入队相同的任务
+func newPayloadTask(typename string, payload any) (*asynq.Task, error) {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(typename, jsonPayload), nil
+}
+
+// mergePortraitChanges 按类型合并范围，保持首次出现的顺序
+func mergePortraitChanges(changes []*objects.PortraitchangeType) []*objects.PortraitchangeType {
+	var merged []*objects.PortraitchangeType
+	byType := make(map[string]*objects.PortraitchangeType)
+	for _, change := range changes {
+		m, ok := byType[change.Type]
+		if !ok {
+			m = &objects.PortraitchangeType{Type: change.Type}
+			byType[change.Type] = m
+			merged = append(merged, m)
+		}
+		for _, scope := range change.Scope {
+			if !slices.Contains(m.Scope, scope) {
+				m.Scope = append(m.Scope, scope)
+			}
+		}
+	}
+	return merged
+}
+
+// advanceTargetStatus 和新增积累一样推进目标及其父目标的状态，时间用积累的原始时间
+func (p *importProcessor) advanceTargetStatus(ctx context.Context, targetID uint64, isChallenge bool, at int64) error {
+	for targetID != 0 {
+		target, err := p.r.data.ent_client.Target.Get(ctx, targetID)
+		if err != nil {
+			return err
+		}
+
+		updateTarget := target.Update()
+		switch {
+		case isChallenge && (target.Status == entTarget.StatusInit || target.Status == entTarget.StatusStep):
+			updateTarget.SetStatus(entTarget.StatusStepHard).SetChallengeAt(at)
+			if target.StartAt == 0 {
+				updateTarget.SetStartAt(at)
+			}
+		case !isChallenge && target.Status == entTarget.StatusInit:
+			updateTarget.SetStatus(entTarget.StatusStep).SetStartAt(at)
+		default:
+			return nil
+		}
+
+		err = updateTarget.Exec(ctx)
+		if err != nil {
+			return err
+		}
+		p.r.data.cache.InvalidateUserCache(ctx, p.job.UserID, objects.CacheKindTargetTree)
+
+		targetID = target.ParentID
+	}
+
+	return nil
+}
+
+// finish 重建导入目标的搜索索引并汇总结果
+func (p *importProcessor) finish(ctx context.Context) error {
+	items, err := p.r.data.ent_client.ImportItem.Query().
+		Where(importitem.JobID(p.job.ID)).
+		Select(importitem.FieldKind, importitem.FieldStatus, importitem.FieldEntityID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var succeeded, failed uint32
+	for _, item := range items {
+		switch item.Status {
+		case importitem.StatusDone:
+			succeeded++
+			if item.Kind == importitem.KindTarget {
+				err = p.r.asynqEnqueueRepo.EnqueueSearchIndex(ctx, item.EntityID, 0)
+				if err != nil {
+					p.r.log.Errorf("EnqueueSearchIndex error: %v", err)
+				}
+			}
+		case importitem.StatusFailed:
+			failed++
+		}
+	}
+
+	return p.r.data.ent_client.ImportJob.UpdateOneID(p.job.ID).
+		SetStatus(importjob.StatusDone).
+		SetSucceeded(succeeded).
+		SetFailed(failed).
+		SetFinishedAt(time.Now().Local().Unix()).
+		Exec(ctx)
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	stepApi "step/api/step/v1"
+	"step/internal/biz"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/data/ent/portrait"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cast"
+)
+
+// testEnqueueRepo 导入只会入队画像反馈和搜索索引，其他方法未实现
+type testEnqueueRepo struct {
+	biz.AsynqEnqueueRepo
+	feedbacks int
+}
+
+func (r *testEnqueueRepo) EnqueueFeedbackPortraitChange(ctx context.Context, userID string, portraitChangeTypes []*objects.PortraitchangeType) error {
+	r.feedbacks++
+	return nil
+}
+
+func (r *testEnqueueRepo) EnqueueSearchIndex(ctx context.Context, targetID uint64, stepID uint64) error {
+	return nil
+}
+
+func TestImportResume(t *testing.T) {
+	stepRepo, ctx := newBenchStepRepo(t)
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelError))
+	enqueue := &testEnqueueRepo{}
+	r := &importRepo{
+		data:             stepRepo.data,
+		log:              log.NewHelper(logger),
+		asynqEnqueueRepo: enqueue,
+		statisticsRepo:   NewStatisticsRepo(stepRepo.data, logger, stepRepo),
+	}
+	client := r.data.ent_client
+
+	job, err := client.ImportJob.Create().
+		SetUserID("bench").
+		SetFormat(importjob.FormatJSON).
+		SetObjectName("bench/imports/import.zip").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 已有导入项时不再读取压缩包；第4项的类型错误，第一次处理时失败
+	day := int64(24 * 3600)
+	items := []struct {
+		kind importitem.Kind
+		ref  string
+		time int64
+		data *objects.ImportItemData
+	}{
+		{importitem.KindTarget, "t", 1_700_000_000, &objects.ImportItemData{Title: "imported"}},
+		{importitem.KindStep, "s1", 1_700_000_000 + day, &objects.ImportItemData{Type: "note", Content: "first"}},
+		{importitem.KindStep, "s2", 1_700_000_000 + 2*day, &objects.ImportItemData{Type: "note", Content: "hard", IsChallenge: true}},
+		{importitem.KindStep, "s3", 1_700_000_000 + 3*day, &objects.ImportItemData{Type: "bogus", Content: "later"}},
+	}
+	for i, item := range items {
+		create := client.ImportItem.Create().
+			SetJobID(job.ID).
+			SetSeq(uint32(i + 1)).
+			SetKind(item.kind).
+			SetRef(item.ref).
+			SetItemTime(item.time).
+			SetData(item.data)
+		if item.kind == importitem.KindStep {
+			create.SetTargetRef("t")
+		}
+		err = create.Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handle := func(t *testing.T) {
+		t.Helper()
+		task, err := newPayloadTask(objects.TypeImportProcess, objects.ImportProcessPayload{ImportID: job.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = r.HandleImport(ctx, task)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// check 每个积累只计入一次：非挑战的增加耐心值，挑战的增加毅力值，每个积累一条StepRate
+	check := func(t *testing.T, patience int, perseverance int, succeeded uint32, failed uint32) {
+		t.Helper()
+		basic, err := client.Portrait.Query().
+			Where(portrait.UserID("bench"), portrait.DimensionEQ(portrait.DimensionBasic)).
+			Only(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := cast.ToInt(basic.Value[objects.PortraitBasicPatience]); got != patience {
+			t.Errorf("patience = %d, want %d", got, patience)
+		}
+		if got := cast.ToInt(basic.Value[objects.PortraitBasicPerseverance]); got != perseverance {
+			t.Errorf("perseverance = %d, want %d", got, perseverance)
+		}
+		rates, err := client.StepRate.Query().Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rates != patience+perseverance {
+			t.Errorf("step rates = %d, want %d", rates, patience+perseverance)
+		}
+		job, err := client.ImportJob.Get(ctx, job.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status != importjob.StatusDone || job.Succeeded != succeeded || job.Failed != failed {
+			t.Errorf("job %s succeeded %d failed %d, want done %d %d", job.Status, job.Succeeded, job.Failed, succeeded, failed)
+		}
+	}
+
+	t.Run("first run", func(t *testing.T) {
+		handle(t)
+		check(t, 1, 1, 3, 1)
+	})
+
+	t.Run("resume failed items", func(t *testing.T) {
+		err := client.ImportItem.Update().
+			Where(importitem.JobID(job.ID), importitem.Ref("s3")).
+			SetData(&objects.ImportItemData{Type: "note", Content: "later"}).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = r.ResumeImport(ctx, &stepApi.ResumeImportRequest{Id: job.ID, RetryFailed: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		handle(t)
+		check(t, 2, 1, 4, 0)
+	})
+
+	t.Run("redo after statistics before marking done", func(t *testing.T) {
+		// 统计已经计入、还没有标记完成时任务中断，重做时跳过已计入的积累
+		err := client.ImportItem.Update().
+			Where(importitem.JobID(job.ID), importitem.KindEQ(importitem.KindStep)).
+			SetStatus(importitem.StatusCreated).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = client.ImportJob.UpdateOneID(job.ID).SetStatus(importjob.StatusPending).Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		handle(t)
+		check(t, 2, 1, 4, 0)
+	})
+
+	t.Run("done job is skipped", func(t *testing.T) {
+		handle(t)
+		count, err := client.ImportItem.Query().
+			Where(importitem.JobID(job.ID), importitem.StatusNEQ(importitem.StatusDone)).
+			Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Errorf("%d items not done", count)
+		}
+	})
+}
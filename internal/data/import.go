@@ -0,0 +1,319 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	"step/internal/data/ent/importitem"
+	"step/internal/data/ent/importjob"
+	"step/internal/objects"
+	"step/internal/utils"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/hibiken/asynq"
+)
+
+type importRepo struct {
+	data             *Data
+	log              *log.Helper
+	minioRepo        biz.MinioRepo
+	asynqEnqueueRepo biz.AsynqEnqueueRepo
+	statisticsRepo   biz.StatisticsRepo
+}
+
+// NewImportRepo .
+func NewImportRepo(data *Data, logger log.Logger, minioRepo biz.MinioRepo, asynqEnqueueRepo biz.AsynqEnqueueRepo, statisticsRepo biz.StatisticsRepo) biz.ImportRepo {
+	return &importRepo{
+		data:             data,
+		log:              log.NewHelper(logger, log.WithMessageKey("importRepo")),
+		minioRepo:        minioRepo,
+		asynqEnqueueRepo: asynqEnqueueRepo,
+		statisticsRepo:   statisticsRepo,
+	}
+}
+
+// importObjectName 压缩包在创建导入任务之前上传，用时间区分
+func importObjectName(uid string) string {
+	return fmt.Sprintf("%s/imports/import_%d.zip", uid, time.Now().UnixNano())
+}
+
+func toApiImportJob(job *ent.ImportJob) *stepApi.ImportJob {
+	return &stepApi.ImportJob{
+		Id:             job.ID,
+		ParentTargetId: job.ParentTargetID,
+		Status:         job.Status.String(),
+		Format:         job.Format.String(),
+		Total:          job.Total,
+		Succeeded:      job.Succeeded,
+		Failed:         job.Failed,
+		Error:          job.Error,
+		CreatedAt:      job.CreatedAt,
+		FinishedAt:     job.FinishedAt,
+	}
+}
+
+func toApiImportItem(item *ent.ImportItem) *stepApi.ImportItem {
+	itemApi := &stepApi.ImportItem{
+		Seq:      item.Seq,
+		Kind:     item.Kind.String(),
+		ItemTime: item.ItemTime,
+		Status:   item.Status.String(),
+		EntityId: item.EntityID,
+		Error:    item.Error,
+	}
+	if item.Data != nil {
+		itemApi.Title = item.Data.Title
+		if item.Kind != importitem.KindTarget {
+			itemApi.Type = item.Data.Type
+		}
+	}
+	return itemApi
+}
+
+// importErrorMessage 错误信息按字段长度截断
+func importErrorMessage(err error) string {
+	message := err.Error()
+	if len(message) > 1000 {
+		message = message[:1000]
+	}
+	return message
+}
+
+// getOwnedImport 获取当前用户的导入
+func (r *importRepo) getOwnedImport(ctx context.Context, id uint64) (*ent.ImportJob, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	job, err := r.data.ent_client.ImportJob.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	return job, nil
+}
+
+func (r *importRepo) CreateImport(ctx context.Context, parentTargetID uint64, format string, archive io.Reader) (*stepApi.ImportJob, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if parentTargetID != 0 {
+		parentTarget, err := r.data.ent_client.Target.Get(ctx, parentTargetID)
+		if err != nil {
+			return nil, err
+		}
+
+		if parentTarget.UserID != uid {
+			return nil, stepApi.ErrorNotOwner("not owner")
+		}
+	}
+
+	objectName := importObjectName(uid)
+	err := r.minioRepo.UploadObject(ctx, objectName, archive, "application/zip")
+	if err != nil {
+		return nil, err
+	}
+
+	createJob := r.data.ent_client.ImportJob.Create().
+		SetUserID(uid).
+		SetStatus(importjob.StatusPending).
+		SetFormat(importjob.Format(format)).
+		SetObjectName(objectName)
+	if parentTargetID != 0 {
+		createJob.SetParentTargetID(parentTargetID)
+	}
+
+	job, err := createJob.Save(ctx)
+	if err != nil {
+		if rerr := r.minioRepo.RemoveFile(ctx, objectName); rerr != nil {
+			r.log.Errorf("remove import object %s error: %v", objectName, rerr)
+		}
+		return nil, err
+	}
+
+	return toApiImportJob(job), nil
+}
+
+func (r *importRepo) GetImport(ctx context.Context, req *stepApi.GetImportRequest) (*stepApi.GetImportReply, error) {
+	job, err := r.getOwnedImport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetImportReply{
+		Import: toApiImportJob(job),
+	}, nil
+}
+
+func (r *importRepo) GetImports(ctx context.Context, req *stepApi.GetImportsRequest) (*stepApi.GetImportsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	jobs, err := r.data.ent_client.ImportJob.Query().
+		Where(importjob.UserID(uid)).
+		Order(ent.Desc(importjob.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobsApi := make([]*stepApi.ImportJob, len(jobs))
+	for i, job := range jobs {
+		jobsApi[i] = toApiImportJob(job)
+	}
+
+	return &stepApi.GetImportsReply{
+		Imports: jobsApi,
+	}, nil
+}
+
+// GetImportItems 按处理顺序分页，可按状态筛选(如只看失败的导入项)
+func (r *importRepo) GetImportItems(ctx context.Context, req *stepApi.GetImportItemsRequest) (*stepApi.GetImportItemsReply, error) {
+	job, err := r.getOwnedImport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.data.ent_client.ImportItem.Query().
+		Where(importitem.JobID(job.ID))
+	if req.Status != "" {
+		status := importitem.Status(req.Status)
+		if err := importitem.StatusValidator(status); err != nil {
+			return nil, err
+		}
+		query = query.Where(importitem.StatusEQ(status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	items, err := query.
+		Order(ent.Asc(importitem.FieldSeq)).
+		Offset(int((page - 1) * pageSize)).
+		Limit(int(pageSize)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsApi := make([]*stepApi.ImportItem, len(items))
+	for i, item := range items {
+		itemsApi[i] = toApiImportItem(item)
+	}
+
+	return &stepApi.GetImportItemsReply{
+		Total: uint32(total),
+		Items: itemsApi,
+	}, nil
+}
+
+// ResumeImport 继续处理未完成的导入项，retry_failed时失败的导入项也重新处理
+func (r *importRepo) ResumeImport(ctx context.Context, req *stepApi.ResumeImportRequest) (*stepApi.ResumeImportReply, error) {
+	job, err := r.getOwnedImport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status == importjob.StatusRunning {
+		return nil, stepApi.ErrorTaskRunning("import is running")
+	}
+
+	if req.RetryFailed {
+		_, err = r.data.ent_client.ImportItem.Update().
+			Where(importitem.JobID(job.ID), importitem.StatusEQ(importitem.StatusFailed)).
+			SetStatus(importitem.StatusPending).
+			ClearError().
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	job, err = job.Update().
+		SetStatus(importjob.StatusPending).
+		ClearError().
+		ClearFinishedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.ResumeImportReply{
+		Import: toApiImportJob(job),
+	}, nil
+}
+
+// HandleImport 处理导入，失败时记录原因，重试或恢复时从未完成的导入项继续
+func (r *importRepo) HandleImport(ctx context.Context, task *asynq.Task) error {
+	var payload objects.ImportProcessPayload
+	err := json.Unmarshal(task.Payload(), &payload)
+	if err != nil {
+		return err
+	}
+
+	job, err := r.data.ent_client.ImportJob.Get(ctx, payload.ImportID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if job.Status == importjob.StatusDone {
+		return nil
+	}
+
+	job, err = job.Update().
+		SetStatus(importjob.StatusRunning).
+		ClearError().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	processor := &importProcessor{
+		r:   r,
+		job: job,
+	}
+	defer processor.close()
+
+	err = processor.process(ctx)
+	if err != nil {
+		_, uerr := job.Update().
+			SetStatus(importjob.StatusFailed).
+			SetError(importErrorMessage(err)).
+			SetFinishedAt(time.Now().Local().Unix()).
+			Save(ctx)
+		if uerr != nil {
+			r.log.Errorf("update import %d error: %v", job.ID, uerr)
+		}
+		return err
+	}
+
+	return nil
+}
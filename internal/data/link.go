@@ -0,0 +1,199 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	stepApi "step/api/step/v1"
+	"step/internal/biz"
+	"step/internal/objects"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/net/html"
+)
+
+const (
+	linkFetchTimeout  = 5 * time.Second
+	linkFetchMaxBytes = 1 << 20
+)
+
+type linkRepo struct {
+	log    *log.Helper
+	client *http.Client
+}
+
+func NewLinkRepo(logger log.Logger) biz.LinkRepo {
+	// 只允许访问公网地址，避免通过链接探测内网
+	dialer := &net.Dialer{
+		Timeout: linkFetchTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || !isGlobalAddr(ip) {
+				return stepApi.ErrorInvalidArgument("address %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	return &linkRepo{
+		log: log.NewHelper(logger, log.WithMessageKey("linkRepo")),
+		client: &http.Client{
+			Timeout: linkFetchTimeout,
+			Transport: &http.Transport{
+				// 经过代理时连接的是代理地址，绕过地址检查
+				Proxy:       nil,
+				DialContext: dialer.DialContext,
+			},
+		},
+	}
+}
+
+// linkBlockedPrefixes 不属于公网的保留地址段
+var linkBlockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // CGNAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// isGlobalAddr IPv4映射的IPv6地址按IPv4检查
+func isGlobalAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsMulticast() {
+		return false
+	}
+	for _, prefix := range linkBlockedPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateLinkUrl(rawUrl string) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return stepApi.ErrorInvalidArgument("only http and https url is allowed")
+	}
+	if u.Host == "" {
+		return stepApi.ErrorInvalidArgument("url host is empty")
+	}
+	return nil
+}
+
+// FetchLinkMeta 抓取页面的title和description，优先使用og标签
+func (r *linkRepo) FetchLinkMeta(ctx context.Context, rawUrl string) (*objects.LinkMeta, error) {
+	if err := validateLinkUrl(rawUrl); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "step-go link preview")
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch link failed: %s", resp.Status)
+	}
+	if !strings.Contains(resp.Header.Get("Content-Type"), "text/html") {
+		return &objects.LinkMeta{}, nil
+	}
+
+	return parseLinkMeta(io.LimitReader(resp.Body, linkFetchMaxBytes)), nil
+}
+
+func parseLinkMeta(r io.Reader) *objects.LinkMeta {
+	var title, ogTitle, description, ogDescription string
+	inTitle := false
+
+	tokenizer := html.NewTokenizer(r)
+	for {
+		tt := tokenizer.Next()
+		switch tt {
+		case html.ErrorToken:
+			meta := &objects.LinkMeta{Title: title, Description: description}
+			if ogTitle != "" {
+				meta.Title = ogTitle
+			}
+			if ogDescription != "" {
+				meta.Description = ogDescription
+			}
+			meta.Title = truncateRunes(strings.TrimSpace(meta.Title), 255)
+			meta.Description = truncateRunes(strings.TrimSpace(meta.Description), 1000)
+			return meta
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.Data {
+			case "title":
+				inTitle = title == ""
+			case "meta":
+				var name, content string
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "name", "property":
+						name = strings.ToLower(attr.Val)
+					case "content":
+						content = attr.Val
+					}
+				}
+				switch name {
+				case "og:title":
+					ogTitle = content
+				case "og:description":
+					ogDescription = content
+				case "description":
+					description = content
+				}
+			}
+		case html.TextToken:
+			if inTitle {
+				title += string(tokenizer.Text())
+			}
+		case html.EndTagToken:
+			if tokenizer.Token().Data == "title" {
+				inTitle = false
+			}
+		}
+	}
+}
+
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
@@ -3,8 +3,11 @@ package data
 import (
 	"context"
 	"fmt"
+	"io"
 	"mime/multipart"
+	"net/url"
 	"step/internal/biz"
+	"step/internal/objects"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -51,7 +54,12 @@ func (m *minioRepo) GetUploadPreSignedUrl(ctx context.Context, upload_key string
 	return presigned_request.URL, nil
 }
 
+// GetDownloadPreSignedUrl 地址在缓存有效期内复用
 func (m *minioRepo) GetDownloadPreSignedUrl(ctx context.Context, download_key string, endpoint string) (string, error) {
+	if url, ok := m.data.cache.GetPresignedUrl(ctx, download_key, endpoint); ok {
+		return url, nil
+	}
+
 	options := []func(*s3.Options){
 		func(o *s3.Options) {
 			o.UsePathStyle = true
@@ -76,6 +84,7 @@ func (m *minioRepo) GetDownloadPreSignedUrl(ctx context.Context, download_key st
 		return "", fmt.Errorf("无法获取预签名url. 因为: %v\n", err)
 	}
 
+	m.data.cache.SetPresignedUrl(ctx, download_key, endpoint, presigned_request.URL)
 	return presigned_request.URL, nil
 }
 
@@ -111,5 +120,74 @@ func (m *minioRepo) RemoveFile(ctx context.Context, remove_key string) error {
 		return fmt.Errorf("无法删除文件%s. 因为: %v\n", remove_key, err)
 	}
 
+	m.data.cache.InvalidatePresignedUrls(ctx, remove_key)
+	return nil
+}
+
+func (m *minioRepo) UploadObject(ctx context.Context, upload_key string, body io.Reader, content_type string) error {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(m.data.minio_bucket_name),
+		Key:    aws.String(upload_key),
+		Body:   body,
+	}
+	if content_type != "" {
+		input.ContentType = aws.String(content_type)
+	}
+
+	_, err := m.data.s3_client.PutObject(ctx,
+		input,
+		func(po *s3.Options) {
+			po.UsePathStyle = true
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("无法上传文件%s. 因为: %v\n", upload_key, err)
+	}
+
+	return nil
+}
+
+func (m *minioRepo) GetObject(ctx context.Context, download_key string, byte_range string) (*objects.ObjectContent, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(m.data.minio_bucket_name),
+		Key:    aws.String(download_key),
+	}
+	if byte_range != "" {
+		input.Range = aws.String(byte_range)
+	}
+
+	output, err := m.data.s3_client.GetObject(ctx,
+		input,
+		func(po *s3.Options) {
+			po.UsePathStyle = true
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("无法获取文件%s. 因为: %v\n", download_key, err)
+	}
+
+	return &objects.ObjectContent{
+		Body:          output.Body,
+		ContentType:   aws.ToString(output.ContentType),
+		ContentLength: aws.ToInt64(output.ContentLength),
+		ContentRange:  aws.ToString(output.ContentRange),
+	}, nil
+}
+
+func (m *minioRepo) CopyFile(ctx context.Context, src_key string, dst_key string) error {
+	_, err := m.data.s3_client.CopyObject(ctx,
+		&s3.CopyObjectInput{
+			Bucket:     aws.String(m.data.minio_bucket_name),
+			CopySource: aws.String((&url.URL{Path: m.data.minio_bucket_name + "/" + src_key}).EscapedPath()),
+			Key:        aws.String(dst_key),
+		},
+		func(po *s3.Options) {
+			po.UsePathStyle = true
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("无法复制文件%s到%s. 因为: %v\n", src_key, dst_key, err)
+	}
+
 	return nil
 }
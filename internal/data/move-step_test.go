@@ -0,0 +1,129 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	stepApi "step/api/step/v1"
+	entComment "step/internal/data/ent/comment"
+	"step/internal/objects"
+)
+
+func TestMoveStep(t *testing.T) {
+	r, ctx := newBenchStepRepo(t)
+	from := benchCreateTarget(t, r, ctx, 0, "from")
+	sibling := benchCreateTarget(t, r, ctx, 0, "sibling")
+	// custom和similar的评价项和量程相同，只有权重不同
+	custom := benchCreateTarget(t, r, ctx, 0, "custom")
+	similar := benchCreateTarget(t, r, ctx, 0, "similar")
+	for id, weight := range map[uint64]float64{custom: 1, similar: 2} {
+		err := r.data.ent_client.Target.UpdateOneID(id).
+			SetRubric(&objects.TargetRubric{ScaleMin: 0, ScaleMax: 100, Criteria: []*objects.TargetRubricCriterion{{Key: "speed", Weight: weight}}}).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// from下: dir/sub/note和other两个目录
+	dir := benchCreateDir(t, r, ctx, from, 0, "dir")
+	sub := benchCreateDir(t, r, ctx, from, dir, "sub")
+	other := benchCreateDir(t, r, ctx, from, 0, "other")
+	note, err := r.CreateNoteStep(ctx, &stepApi.CreateNoteStepRequest{Id: from, ParentId: sub, Content: "note"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 按移动顺序执行，后面的用例依赖前面的结果
+	cases := []struct {
+		name   string
+		req    *stepApi.MoveStepRequest
+		rate   bool
+		err    string
+		paths  map[uint64]string
+		target uint64
+	}{
+		{
+			name: "dir with children into another dir",
+			req:  &stepApi.MoveStepRequest{Id: dir, ParentId: other},
+			paths: map[uint64]string{
+				dir:     fmt.Sprintf("/%d/", other),
+				sub:     fmt.Sprintf("/%d/%d/", other, dir),
+				note.Id: fmt.Sprintf("/%d/%d/%d/", other, dir, sub),
+			},
+			target: from,
+		},
+		{
+			name: "into its own child",
+			req:  &stepApi.MoveStepRequest{Id: dir, ParentId: sub},
+			err:  "can't move a dir into itself",
+		},
+		{
+			name: "parent of another target",
+			req:  &stepApi.MoveStepRequest{Id: note.Id, TargetId: sibling, ParentId: other},
+			err:  "parent is not a dir of the target",
+		},
+		{
+			name:   "unrated to a target with another rubric",
+			req:    &stepApi.MoveStepRequest{Id: sub, TargetId: custom},
+			paths:  map[uint64]string{sub: "/", note.Id: fmt.Sprintf("/%d/", sub)},
+			target: custom,
+		},
+		{
+			name:   "rated back to the default rubric",
+			req:    &stepApi.MoveStepRequest{Id: sub, TargetId: from, ParentId: other},
+			rate:   true,
+			err:    "same rubric criteria and scale",
+			target: custom,
+		},
+		{
+			name:   "rated to a target with the same criteria",
+			req:    &stepApi.MoveStepRequest{Id: sub, TargetId: similar},
+			paths:  map[uint64]string{sub: "/", note.Id: fmt.Sprintf("/%d/", sub)},
+			target: similar,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if c.rate {
+				err := r.data.ent_client.Comment.Create().
+					SetStepID(note.Id).
+					SetRole(entComment.RoleTeacher).
+					SetRating(map[string]any{"version": objects.TargetRubricVersion, "data": map[string]any{"speed": 80.0}}).
+					Exec(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := r.MoveStep(ctx, c.req)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("error = %v, want %s", err, c.err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			for id, path := range c.paths {
+				step, err := r.data.ent_client.Step.Get(ctx, id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if step.Path != path {
+					t.Errorf("step %d path = %s, want %s", id, step.Path, path)
+				}
+			}
+			if c.target != 0 {
+				step, err := r.data.ent_client.Step.Get(ctx, note.Id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if step.RefTargetID != c.target {
+					t.Errorf("note target = %d, want %d", step.RefTargetID, c.target)
+				}
+			}
+		})
+	}
+}
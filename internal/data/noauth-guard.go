@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"step/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	noauthGuardKeyPrefix = "step:noauth:"
+	// 进程内的令牌桶和nonce的清理间隔
+	noauthGuardSweepInterval = time.Minute
+	// 进程内令牌桶的上限，超过后新的Key共用一个桶
+	noauthGuardMaxBuckets  = 100000
+	noauthGuardOverflowKey = "overflow"
+)
+
+// tokenBucketScript 令牌桶存在hash中(tokens, 毫秒时间戳)，满桶后过期
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or burst
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return allowed
+`)
+
+type memoryBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// 每秒恢复的令牌数和容量，用于清理时判断是否已经满了
+	rate  float64
+	burst float64
+}
+
+// noauthGuardRepo 多个实例通过Redis共享限流和nonce，Redis不可用时降级为进程内的状态
+type noauthGuardRepo struct {
+	redisBreaker
+
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	// nonce到过期时间
+	nonces  map[string]time.Time
+	sweptAt time.Time
+}
+
+func newNoauthGuardRepo(client *redis.Client, logger log.Logger) *noauthGuardRepo {
+	r := &noauthGuardRepo{
+		buckets: make(map[string]*memoryBucket),
+		nonces:  make(map[string]time.Time),
+	}
+	r.client = client
+	r.log = log.NewHelper(logger, log.WithMessageKey("noauthGuardRepo"))
+	return r
+}
+
+// NewNoauthGuardRepo .
+func NewNoauthGuardRepo(data *Data) biz.NoauthGuardRepo {
+	return data.guard
+}
+
+func (r *noauthGuardRepo) Allow(ctx context.Context, key string, perMinute uint32, burst uint32) bool {
+	if perMinute == 0 || burst == 0 {
+		return true
+	}
+	rate := float64(perMinute) / 60
+	now := time.Now()
+
+	if r.available() {
+		allowed, err := tokenBucketScript.Run(ctx, r.client,
+			[]string{noauthGuardKeyPrefix + "bucket:" + key},
+			rate, burst, now.UnixMilli(),
+		).Int()
+		if err == nil {
+			return allowed == 1
+		}
+		r.fail(err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep(now)
+
+	bucket, ok := r.buckets[key]
+	if !ok && len(r.buckets) >= noauthGuardMaxBuckets {
+		key = noauthGuardOverflowKey
+		bucket, ok = r.buckets[key]
+	}
+	if !ok {
+		bucket = &memoryBucket{tokens: float64(burst), updatedAt: now}
+		r.buckets[key] = bucket
+	}
+	bucket.tokens = math.Min(float64(burst), bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*rate)
+	bucket.updatedAt = now
+	bucket.rate = rate
+	bucket.burst = float64(burst)
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+func (r *noauthGuardRepo) UseNonce(ctx context.Context, nonce string, ttl time.Duration) bool {
+	now := time.Now()
+
+	if r.available() {
+		ok, err := r.client.SetNX(ctx, noauthGuardKeyPrefix+"nonce:"+nonce, 1, ttl).Result()
+		if err == nil {
+			return ok
+		}
+		r.fail(err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep(now)
+
+	if expiresAt, ok := r.nonces[nonce]; ok && now.Before(expiresAt) {
+		return false
+	}
+	r.nonces[nonce] = now.Add(ttl)
+	return true
+}
+
+// sweep 删除已经满了的令牌桶和过期的nonce，调用方持有锁
+func (r *noauthGuardRepo) sweep(now time.Time) {
+	if now.Sub(r.sweptAt) < noauthGuardSweepInterval {
+		return
+	}
+	r.sweptAt = now
+
+	// 删除后重建的桶是满的，只删除按恢复速度已经满了的桶
+	for key, bucket := range r.buckets {
+		if bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*bucket.rate >= bucket.burst {
+			delete(r.buckets, key)
+		}
+	}
+	for nonce, expiresAt := range r.nonces {
+		if !now.Before(expiresAt) {
+			delete(r.nonces, nonce)
+		}
+	}
+}
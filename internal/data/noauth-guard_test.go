@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// newUnavailableGuard Redis连接不上，限流和nonce降级为进程内的状态
+func newUnavailableGuard(t *testing.T) *noauthGuardRepo {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})
+	t.Cleanup(func() { client.Close() })
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelError))
+	return newNoauthGuardRepo(client, logger)
+}
+
+func TestNoauthGuardFallback(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("allow", func(t *testing.T) {
+		r := newUnavailableGuard(t)
+		for i := 0; i < 3; i++ {
+			if !r.Allow(ctx, "ip:1.2.3.4", 60, 3) {
+				t.Fatalf("request %d is limited within the burst", i+1)
+			}
+		}
+		if r.available() {
+			t.Error("redis is still used after a failure")
+		}
+		if r.Allow(ctx, "ip:1.2.3.4", 60, 3) {
+			t.Error("request over the burst is allowed")
+		}
+		if !r.Allow(ctx, "ip:5.6.7.8", 60, 3) {
+			t.Error("another key shares the bucket")
+		}
+		if !r.Allow(ctx, "ip:1.2.3.4", 0, 0) {
+			t.Error("rule without limits is applied")
+		}
+	})
+
+	t.Run("use nonce", func(t *testing.T) {
+		r := newUnavailableGuard(t)
+		if !r.UseNonce(ctx, "nonce", time.Minute) {
+			t.Fatal("first use is rejected")
+		}
+		if r.UseNonce(ctx, "nonce", time.Minute) {
+			t.Error("nonce is used twice")
+		}
+	})
+
+	t.Run("sweep", func(t *testing.T) {
+		r := newUnavailableGuard(t)
+		// 容量大于每分钟的恢复量，空闲一分钟后还没有满
+		for i := 0; i < 20; i++ {
+			r.Allow(ctx, "drained", 6, 20)
+		}
+		r.Allow(ctx, "refilled", 6, 20)
+
+		r.mu.Lock()
+		now := time.Now()
+		for _, bucket := range r.buckets {
+			bucket.updatedAt = now.Add(-2 * time.Minute)
+		}
+		r.sweptAt = time.Time{}
+		r.sweep(now)
+		_, drained := r.buckets["drained"]
+		_, refilled := r.buckets["refilled"]
+		r.mu.Unlock()
+
+		if !drained {
+			t.Error("drained bucket is swept and would be recreated full")
+		}
+		if refilled {
+			t.Error("refilled bucket is kept")
+		}
+		// 两分钟恢复了12个令牌
+		allowed := 0
+		for i := 0; i < 20; i++ {
+			r.mu.Lock()
+			r.sweptAt = time.Now()
+			r.mu.Unlock()
+			if r.Allow(ctx, "drained", 6, 20) {
+				allowed++
+			}
+		}
+		if allowed != 12 {
+			t.Errorf("allowed %d after two idle minutes, want 12", allowed)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package data
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// Redis出错后暂停使用的时间，避免每个请求都等待超时
+	redisRetryInterval = 10 * time.Second
+	// 连接Redis的超时，读写超时使用配置
+	redisDialTimeout = 500 * time.Millisecond
+)
+
+// redisBreaker 没有配置Redis或者最近出错时不使用Redis，由调用方降级
+type redisBreaker struct {
+	client *redis.Client
+	log    *log.Helper
+	// Redis恢复可用的时间(UnixNano)
+	downUntil atomic.Int64
+}
+
+func (b *redisBreaker) available() bool {
+	return b.client != nil && time.Now().UnixNano() >= b.downUntil.Load()
+}
+
+// fail 记录错误并暂停使用Redis，未命中不是错误
+func (b *redisBreaker) fail(err error) {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return
+	}
+	b.downUntil.Store(time.Now().Add(redisRetryInterval).UnixNano())
+	b.log.Warnf("redis is unavailable for %s: %v", redisRetryInterval, err)
+}
@@ -0,0 +1,273 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"slices"
+	stepApi "step/api/step/v1"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// 报告中最多展示的评论数
+const reportMaxComments = 100
+
+// 生成缩略图时读取的原图大小上限
+const reportMaxImageBytes = 20 << 20
+
+// CollectReport 日期范围内的积累用于统计打卡数、评论，没有选择媒体时取最新的图片
+func (r *reportRepo) CollectReport(ctx context.Context, job *objects.ReportJob) (*objects.ReportContent, error) {
+	target, err := r.data.ent_client.Target.Get(ctx, job.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != job.UserID {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	// 报告目标下的积累都属于同一个顶层目标，按它的评分标准解析评分
+	topTarget, err := topTargetOf(ctx, r.data.ent_client, target)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := objects.ReportDateRange(job.StartDate, job.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[uint64]*objects.ReportTarget)
+	root, err := r.reportTarget(ctx, target, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	targetIDs := make([]uint64, 0, len(targets))
+	for id := range targets {
+		targetIDs = append(targetIDs, id)
+	}
+
+	steps, err := r.data.ent_client.Step.Query().
+		Where(
+			entStep.RefTargetIDIn(targetIDs...),
+			entStep.TypeNEQ(entStep.TypeDir),
+			entStep.CreatedAtGTE(start),
+			entStep.CreatedAtLTE(end),
+		).
+		Order(ent.Asc(entStep.FieldCreatedAt), ent.Asc(entStep.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &objects.ReportContent{
+		Title:  target.Title,
+		Target: root,
+	}
+
+	stepIDs := make([]uint64, len(steps))
+	for i, s := range steps {
+		stepIDs[i] = s.ID
+	}
+	threads, err := stepCommentThreads(ctx, r.data.ent_client, stepIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range steps {
+		t := targets[s.RefTargetID]
+		t.Checkins++
+		if s.IsChallenge {
+			t.Challenges++
+		}
+
+		// 报告只包含老师和家长的评论，回复一起显示
+		for _, thread := range threads[s.ID] {
+			role := thread.comment.Role.String()
+			if role != objects.CommentRoleTeacher && role != objects.CommentRoleParent {
+				continue
+			}
+			if len(content.Comments) >= reportMaxComments {
+				break
+			}
+			reportComment := &objects.ReportComment{
+				StepID:      s.ID,
+				TargetTitle: t.Title,
+				StepTitle:   s.Title,
+				Role:        role,
+				Text:        thread.text(),
+				CreatedAt:   thread.comment.CreatedAt,
+			}
+			if thread.comment.Rating != nil {
+				if rating, err := objects.ParseTargetRating(thread.comment.Rating, topTarget.Rubric); err == nil {
+					reportComment.WeightedValue = rating.WeightedValue()
+				}
+			}
+			content.Comments = append(content.Comments, reportComment)
+		}
+	}
+	sumReportCheckins(root)
+
+	mediaSteps, err := r.reportMediaSteps(ctx, job, targetIDs, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range mediaSteps {
+		media, err := r.reportMedia(ctx, s)
+		if err != nil {
+			r.log.Warnf("report thumbnail of step %d error: %v", s.ID, err)
+			continue
+		}
+		media.TargetTitle = targets[s.RefTargetID].Title
+		content.Media = append(content.Media, media)
+	}
+
+	return content, nil
+}
+
+// reportTarget 按路径前缀一次查询出子孙目标，组装成手动排序的目标树
+func (r *reportRepo) reportTarget(ctx context.Context, target *ent.Target, targets map[uint64]*objects.ReportTarget) (*objects.ReportTarget, error) {
+	descendants, err := r.data.ent_client.Target.Query().
+		Where(entTarget.PathHasPrefix(objects.ChildPath(target.Path, target.ID))).
+		Order(targetOrder(objects.SortManual)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range append([]*ent.Target{target}, descendants...) {
+		targets[t.ID] = &objects.ReportTarget{
+			ID:      t.ID,
+			Title:   t.Title,
+			Status:  t.Status.String(),
+			Layer:   t.Layer,
+			StartAt: t.StartAt,
+			DoneAt:  t.DoneAt,
+		}
+	}
+
+	for _, t := range descendants {
+		if parent, ok := targets[t.ParentID]; ok {
+			parent.Children = append(parent.Children, targets[t.ID])
+		}
+	}
+
+	return targets[target.ID], nil
+}
+
+func sumReportCheckins(target *objects.ReportTarget) int {
+	target.TotalCheckins = target.Checkins
+	for _, child := range target.Children {
+		target.TotalCheckins += sumReportCheckins(child)
+	}
+	return target.TotalCheckins
+}
+
+// reportMediaSteps 选择的媒体需要属于报告的目标树，按时间先后排列
+func (r *reportRepo) reportMediaSteps(ctx context.Context, job *objects.ReportJob, targetIDs []uint64, steps []*ent.Step) ([]*ent.Step, error) {
+	if len(job.MediaStepIDs) > 0 {
+		return r.data.ent_client.Step.Query().
+			Where(
+				entStep.IDIn(job.MediaStepIDs...),
+				entStep.RefTargetIDIn(targetIDs...),
+			).
+			Order(ent.Asc(entStep.FieldCreatedAt), ent.Asc(entStep.FieldID)).
+			All(ctx)
+	}
+
+	var images []*ent.Step
+	for i := len(steps) - 1; i >= 0 && len(images) < objects.ReportMediaLimit; i-- {
+		if steps[i].Type == entStep.TypeImage && steps[i].ObjectName != "" {
+			images = append(images, steps[i])
+		}
+	}
+	slices.Reverse(images)
+	return images, nil
+}
+
+// reportMedia 有预览图时用预览图(视频、文档)，图片用原图生成缩略图
+func (r *reportRepo) reportMedia(ctx context.Context, s *ent.Step) (*objects.ReportMedia, error) {
+	objectName := s.PreviewObjectName
+	if objectName == "" && s.Type == entStep.TypeImage {
+		objectName = s.ObjectName
+	}
+	if objectName == "" {
+		return nil, errors.New("no image to show")
+	}
+
+	object, err := r.minioRepo.GetObject(ctx, objectName, "")
+	if err != nil {
+		return nil, err
+	}
+	defer object.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(object.Body, reportMaxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > reportMaxImageBytes {
+		return nil, errors.New("image is too large")
+	}
+
+	thumbnail, width, height, err := reportThumbnail(data, objects.ReportThumbnailSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objects.ReportMedia{
+		StepID:    s.ID,
+		Title:     s.Title,
+		Type:      s.Type.String(),
+		CreatedAt: s.CreatedAt,
+		Thumbnail: thumbnail,
+		Width:     width,
+		Height:    height,
+	}, nil
+}
+
+// reportThumbnail 按比例缩小到最大边长，统一编码为JPEG(PDF和HTML都支持)
+func reportThumbnail(data []byte, size int) ([]byte, int, int, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, 0, 0, errors.New("empty image")
+	}
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(1, height*size/width)
+		} else {
+			width, height = max(1, width*size/height), size
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	// 透明背景填充为白色
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	return buf.Bytes(), width, height, nil
+}
@@ -0,0 +1,517 @@
+package data
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"os"
+	"step/internal/objects"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/hibiken/asynq"
+)
+
+// reportLabels 报告中的文字
+type reportLabels struct {
+	Range       string
+	GeneratedAt string
+	Targets     string
+	Checkins    string
+	Challenges  string
+	Total       string
+	StepRates   string
+	Unit        string
+	Rates       []string
+	Portrait    string
+	Media       string
+	Comments    string
+	NoData      string
+	Status      map[string]string
+	Dimensions  map[string]string
+	Values      map[string]string
+	Roles       map[string]string
+}
+
+var reportLabelsZh = &reportLabels{
+	Range:       "时间范围",
+	GeneratedAt: "生成时间",
+	Targets:     "目标进度",
+	Checkins:    "打卡",
+	Challenges:  "挑战",
+	Total:       "含子目标",
+	StepRates:   "评分趋势",
+	Unit:        "时间",
+	Rates:       []string{"综合", "目标合理性", "目标明确性", "目标达成度", "反思与改进", "创新性", "基础牢靠", "技能提升", "困难度"},
+	Portrait:    "画像",
+	Media:       "作品",
+	Comments:    "老师和家长评论",
+	NoData:      "暂无数据",
+	Status: map[string]string{
+		"init":      "未开始",
+		"step":      "进行中",
+		"step_hard": "挑战中",
+		"done":      "已完成",
+	},
+	Dimensions: map[string]string{
+		"basic":                "基础",
+		"self_discipline":      "自律",
+		"target_and_execution": "目标与执行",
+		"learning_and_growth":  "学习与成长",
+	},
+	Values: map[string]string{
+		objects.PortraitBasicBravery:                              "勇敢",
+		objects.PortraitBasicDecisiveness:                         "果断",
+		objects.PortraitBasicPatience:                             "耐心",
+		objects.PortraitBasicPerseverance:                         "坚持",
+		objects.PortraitSelfDisciplineCheckinFrequency:            "打卡频率",
+		objects.PortraitSelfDisciplineConsistency:                 "持续性",
+		objects.PortraitSelfDisciplineGoalAchievement:             "目标达成",
+		objects.PortraitSelfDisciplineChallengeAttitude:           "挑战态度",
+		objects.PortraitTargetAndExecutionGoalReasonableness:      "目标合理性",
+		objects.PortraitTargetAndExecutionGoalClarity:             "目标明确性",
+		objects.PortraitTargetAndExecutionAdjustmentAbility:       "调整能力",
+		objects.PortraitLearningAndGrowthReflectionAndImprovement: "反思与改进",
+		objects.PortraitLearningAndGrowthInnovativeMethod:         "创新方法",
+		objects.PortraitLearningAndGrowthBasicSolid:               "基础扎实",
+		objects.PortraitLearningAndGrowthSkillImprovement:         "技能提升",
+	},
+	Roles: map[string]string{
+		objects.CommentRoleTeacher: "老师",
+		objects.CommentRoleParent:  "家长",
+	},
+}
+
+func reportLabel(labels map[string]string, key string) string {
+	if value, ok := labels[key]; ok {
+		return value
+	}
+	return key
+}
+
+func reportDatetime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).Local().Format("2006-01-02 15:04")
+}
+
+func reportDate(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).Local().Format(time.DateOnly)
+}
+
+func reportRateValues(rate *objects.ReportStepRate) []float64 {
+	return []float64{
+		rate.WeightedValue,
+		rate.TargetReasonableness,
+		rate.TargetClarity,
+		rate.TargetAchievement,
+		rate.ReflectionImprovement,
+		rate.Innovation,
+		rate.BasicReliability,
+		rate.SkillImprovement,
+		rate.Difficulty,
+	}
+}
+
+// reportTrendPoints 综合评分折线的坐标，纵轴从0到最大值
+func reportTrendPoints(rates []*objects.ReportStepRate, width float64, height float64) [][2]float64 {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	maxValue := 0.0
+	for _, rate := range rates {
+		maxValue = max(maxValue, rate.WeightedValue)
+	}
+	if maxValue == 0 {
+		maxValue = 1
+	}
+
+	points := make([][2]float64, len(rates))
+	for i, rate := range rates {
+		x := width / 2
+		if len(rates) > 1 {
+			x = width * float64(i) / float64(len(rates)-1)
+		}
+		points[i] = [2]float64{x, height - height*rate.WeightedValue/maxValue}
+	}
+	return points
+}
+
+// reportTrendSVG 只包含数字，可以直接输出到HTML
+func reportTrendSVG(rates []*objects.ReportStepRate) template.HTML {
+	const width, height, padding = 640.0, 160.0, 10.0
+	points := reportTrendPoints(rates, width-2*padding, height-2*padding)
+	if len(points) == 0 {
+		return ""
+	}
+
+	var polyline, circles strings.Builder
+	for _, p := range points {
+		fmt.Fprintf(&polyline, "%.1f,%.1f ", p[0]+padding, p[1]+padding)
+		fmt.Fprintf(&circles, `<circle cx="%.1f" cy="%.1f" r="3"/>`, p[0]+padding, p[1]+padding)
+	}
+	return template.HTML(fmt.Sprintf(
+		`<svg class="trend" viewBox="0 0 %.0f %.0f" width="100%%" height="%.0f"><polyline fill="none" stroke="#4a90d9" stroke-width="2" points="%s"/><g fill="#4a90d9">%s</g></svg>`,
+		width, height, height, strings.TrimSpace(polyline.String()), circles.String(),
+	))
+}
+
+var reportHTMLTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"datetime": reportDatetime,
+	"date":     reportDate,
+	"labels":   func() *reportLabels { return reportLabelsZh },
+	"label":    reportLabel,
+	"rates":    reportRateValues,
+	"trend":    reportTrendSVG,
+	"thumbnail": func(media *objects.ReportMedia) template.URL {
+		return template.URL("data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(media.Thumbnail))
+	},
+	"indent": func(layer uint32) int {
+		return int(layer) * 20
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "PingFang SC", "Microsoft YaHei", sans-serif; max-width: 960px; margin: 0 auto; padding: 16px; color: #333; }
+h2 { border-bottom: 2px solid #4a90d9; padding-bottom: 4px; margin-top: 28px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #e5e5e5; padding: 4px 8px; text-align: left; font-size: 14px; }
+th { background: #f5f7fa; }
+.meta { color: #888; font-size: 13px; }
+.media { display: flex; flex-wrap: wrap; gap: 12px; }
+.media figure { margin: 0; width: 220px; }
+.media img { max-width: 100%; border-radius: 4px; }
+.media figcaption { font-size: 13px; color: #666; }
+.comment { border-left: 3px solid #e5e5e5; padding: 4px 12px; margin: 8px 0; }
+pre { white-space: pre-wrap; margin: 4px 0; font-family: inherit; }
+@media print { h2 { page-break-after: avoid; } figure, .comment { page-break-inside: avoid; } }
+</style>
+</head>
+<body>
+{{$l := labels}}
+<h1>{{.Title}}</h1>
+<p class="meta">{{$l.Range}} {{.StartDate}} ~ {{.EndDate}} · {{$l.GeneratedAt}} {{datetime .GeneratedAt}}</p>
+
+<h2>{{$l.Targets}}</h2>
+<table>
+<tr><th></th><th>{{$l.Checkins}}</th><th>{{$l.Challenges}}</th><th>{{$l.Total}}</th></tr>
+{{template "target" .Target}}
+</table>
+
+<h2>{{$l.StepRates}}</h2>
+{{if .StepRates}}
+{{trend .StepRates}}
+<table>
+<tr><th>{{$l.Unit}}</th>{{range $l.Rates}}<th>{{.}}</th>{{end}}</tr>
+{{range .StepRates}}<tr><td>{{.Unit}}</td>{{range rates .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}
+</table>
+{{else}}<p class="meta">{{$l.NoData}}</p>{{end}}
+
+<h2>{{$l.Portrait}}</h2>
+{{if .Portrait}}
+<table>
+{{range .Portrait}}<tr><th>{{label $l.Dimensions .Dimension}}</th><td>{{range .Values}}{{label $l.Values .Name}} {{.Value}}　{{end}}</td></tr>
+{{end}}
+</table>
+{{else}}<p class="meta">{{$l.NoData}}</p>{{end}}
+
+<h2>{{$l.Media}}</h2>
+{{if .Media}}
+<div class="media">
+{{range .Media}}<figure><img src="{{thumbnail .}}" width="{{.Width}}" height="{{.Height}}" alt="{{.Title}}"><figcaption>{{.TargetTitle}}{{if .Title}} · {{.Title}}{{end}}<br>{{date .CreatedAt}}</figcaption></figure>
+{{end}}
+</div>
+{{else}}<p class="meta">{{$l.NoData}}</p>{{end}}
+
+<h2>{{$l.Comments}}</h2>
+{{range .Comments}}<div class="comment">
+<p class="meta">{{label $l.Roles .Role}} · {{.TargetTitle}}{{if .StepTitle}} · {{.StepTitle}}{{end}} · {{date .CreatedAt}}{{if .WeightedValue}} · {{index $l.Rates 0}} {{.WeightedValue}}{{end}}</p>
+{{if .Text}}<pre>{{.Text}}</pre>{{end}}
+</div>
+{{else}}<p class="meta">{{$l.NoData}}</p>{{end}}
+</body>
+</html>
+{{define "target"}}
+<tr><td style="padding-left: {{indent .Layer}}px">{{.Title}} <span class="meta">{{label (labels).Status .Status}}{{if .DoneAt}} · {{date .DoneAt}}{{end}}</span></td><td>{{.Checkins}}</td><td>{{.Challenges}}</td><td>{{.TotalCheckins}}</td></tr>
+{{range .Children}}{{template "target" .}}{{end}}
+{{end}}`))
+
+// reportPDF 按顺序写入报告内容，超出页面时自动分页
+type reportPDF struct {
+	pdf    *fpdf.Fpdf
+	font   string
+	labels *reportLabels
+}
+
+// renderReportPDF 报告内容包含中文，必须配置带中文字形的TTF字体，
+// 缺少字体属于配置错误，重试也无法成功
+func renderReportPDF(content *objects.ReportContent, fontPath string) ([]byte, error) {
+	if fontPath == "" {
+		return nil, fmt.Errorf("%w: PDF reports need a TTF font with CJK glyphs, set data.report.font_path", asynq.SkipRetry)
+	}
+	font, err := os.ReadFile(fontPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: read report font: %v", asynq.SkipRetry, err)
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes("report", "", font)
+	if err := pdf.Error(); err != nil {
+		return nil, fmt.Errorf("%w: load report font %s: %v", asynq.SkipRetry, fontPath, err)
+	}
+	r := &reportPDF{
+		pdf:    pdf,
+		font:   "report",
+		labels: reportLabelsZh,
+	}
+
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		r.setFont(8)
+		pdf.SetTextColor(136, 136, 136)
+		pdf.CellFormat(0, 6, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	r.header(content)
+	r.targets(content.Target)
+	r.stepRates(content.StepRates)
+	r.portrait(content.Portrait)
+	r.media(content.Media)
+	r.comments(content.Comments)
+
+	var buf bytes.Buffer
+	err = pdf.Output(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *reportPDF) setFont(size float64) {
+	r.pdf.SetFont(r.font, "", size)
+}
+
+func (r *reportPDF) section(title string) {
+	r.pdf.Ln(4)
+	r.setFont(14)
+	r.pdf.SetTextColor(51, 51, 51)
+	r.pdf.CellFormat(0, 9, title, "B", 1, "L", false, 0, "")
+	r.pdf.Ln(2)
+	r.setFont(10)
+}
+
+func (r *reportPDF) noData() {
+	r.pdf.SetTextColor(136, 136, 136)
+	r.pdf.CellFormat(0, 6, r.labels.NoData, "", 1, "L", false, 0, "")
+	r.pdf.SetTextColor(51, 51, 51)
+}
+
+// ensureSpace 剩余空间不足时换页，避免图片和表格行被截断
+func (r *reportPDF) ensureSpace(height float64) {
+	_, pageHeight := r.pdf.GetPageSize()
+	_, _, _, bottom := r.pdf.GetMargins()
+	if r.pdf.GetY()+height > pageHeight-bottom {
+		r.pdf.AddPage()
+	}
+}
+
+func (r *reportPDF) header(content *objects.ReportContent) {
+	r.setFont(20)
+	r.pdf.SetTextColor(51, 51, 51)
+	r.pdf.MultiCell(0, 10, content.Title, "", "L", false)
+	r.setFont(10)
+	r.pdf.SetTextColor(136, 136, 136)
+	r.pdf.CellFormat(0, 6, fmt.Sprintf("%s %s ~ %s    %s %s",
+		r.labels.Range, content.StartDate, content.EndDate,
+		r.labels.GeneratedAt, reportDatetime(content.GeneratedAt),
+	), "", 1, "L", false, 0, "")
+	r.pdf.SetTextColor(51, 51, 51)
+}
+
+func (r *reportPDF) targets(root *objects.ReportTarget) {
+	r.section(r.labels.Targets)
+
+	const countWidth = 25.0
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, _ := r.pdf.GetPageSize()
+	titleWidth := pageWidth - left - right - 3*countWidth
+
+	r.pdf.SetFillColor(245, 247, 250)
+	r.pdf.CellFormat(titleWidth, 7, "", "1", 0, "L", true, 0, "")
+	for _, title := range []string{r.labels.Checkins, r.labels.Challenges, r.labels.Total} {
+		r.pdf.CellFormat(countWidth, 7, title, "1", 0, "C", true, 0, "")
+	}
+	r.pdf.Ln(-1)
+
+	var row func(t *objects.ReportTarget)
+	row = func(t *objects.ReportTarget) {
+		r.ensureSpace(7)
+		title := strings.Repeat("    ", int(t.Layer)) + t.Title + "  (" + reportLabel(r.labels.Status, t.Status) + ")"
+		r.pdf.CellFormat(titleWidth, 7, title, "1", 0, "L", false, 0, "")
+		for _, count := range []int{t.Checkins, t.Challenges, t.TotalCheckins} {
+			r.pdf.CellFormat(countWidth, 7, fmt.Sprint(count), "1", 0, "C", false, 0, "")
+		}
+		r.pdf.Ln(-1)
+		for _, child := range t.Children {
+			row(child)
+		}
+	}
+	row(root)
+}
+
+func (r *reportPDF) stepRates(rates []*objects.ReportStepRate) {
+	r.section(r.labels.StepRates)
+	if len(rates) == 0 {
+		r.noData()
+		return
+	}
+
+	// 综合评分折线图
+	const chartHeight = 40.0
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, _ := r.pdf.GetPageSize()
+	chartWidth := pageWidth - left - right
+	r.ensureSpace(chartHeight + 6)
+	top := r.pdf.GetY() + 2
+	r.pdf.SetDrawColor(229, 229, 229)
+	r.pdf.Rect(left, top, chartWidth, chartHeight, "D")
+	r.pdf.SetDrawColor(74, 144, 217)
+	r.pdf.SetLineWidth(0.5)
+	points := reportTrendPoints(rates, chartWidth-8, chartHeight-8)
+	for i, p := range points {
+		x, y := left+4+p[0], top+4+p[1]
+		r.pdf.Circle(x, y, 0.8, "D")
+		if i > 0 {
+			r.pdf.Line(left+4+points[i-1][0], top+4+points[i-1][1], x, y)
+		}
+	}
+	r.pdf.SetLineWidth(0.2)
+	r.pdf.SetDrawColor(0, 0, 0)
+	r.pdf.SetY(top + chartHeight + 4)
+
+	cellWidth := chartWidth / float64(len(r.labels.Rates)+1)
+	header := func() {
+		r.setFont(8)
+		r.pdf.SetFillColor(245, 247, 250)
+		r.pdf.CellFormat(cellWidth, 7, r.labels.Unit, "1", 0, "C", true, 0, "")
+		for _, title := range r.labels.Rates {
+			r.pdf.CellFormat(cellWidth, 7, title, "1", 0, "C", true, 0, "")
+		}
+		r.pdf.Ln(-1)
+	}
+	header()
+	for _, rate := range rates {
+		r.ensureSpace(7)
+		r.pdf.CellFormat(cellWidth, 7, rate.Unit, "1", 0, "C", false, 0, "")
+		for _, value := range reportRateValues(rate) {
+			r.pdf.CellFormat(cellWidth, 7, fmt.Sprint(value), "1", 0, "C", false, 0, "")
+		}
+		r.pdf.Ln(-1)
+	}
+	r.setFont(10)
+}
+
+func (r *reportPDF) portrait(dimensions []*objects.ReportPortraitDimension) {
+	r.section(r.labels.Portrait)
+	if len(dimensions) == 0 {
+		r.noData()
+		return
+	}
+
+	for _, dimension := range dimensions {
+		values := make([]string, 0, len(dimension.Values))
+		for _, value := range dimension.Values {
+			values = append(values, fmt.Sprintf("%s %v", reportLabel(r.labels.Values, value.Name), value.Value))
+		}
+		r.ensureSpace(7)
+		r.pdf.MultiCell(0, 6, reportLabel(r.labels.Dimensions, dimension.Dimension)+": "+strings.Join(values, ", "), "", "L", false)
+	}
+}
+
+// media 每行3张缩略图，图片下方为目标和日期
+func (r *reportPDF) media(media []*objects.ReportMedia) {
+	r.section(r.labels.Media)
+	if len(media) == 0 {
+		r.noData()
+		return
+	}
+
+	const columns, gap, captionHeight = 3, 5.0, 10.0
+	left, _, right, _ := r.pdf.GetMargins()
+	pageWidth, _ := r.pdf.GetPageSize()
+	cellWidth := (pageWidth - left - right - gap*(columns-1)) / columns
+
+	for start := 0; start < len(media); start += columns {
+		row := media[start:min(start+columns, len(media))]
+
+		rowHeight := 0.0
+		for _, m := range row {
+			rowHeight = max(rowHeight, cellWidth*float64(m.Height)/float64(m.Width))
+		}
+		r.ensureSpace(rowHeight + captionHeight + gap)
+		top := r.pdf.GetY()
+
+		for i, m := range row {
+			x := left + float64(i)*(cellWidth+gap)
+			name := fmt.Sprintf("media_%d", m.StepID)
+			r.pdf.RegisterImageOptionsReader(name, fpdf.ImageOptions{ImageType: "JPG"}, bytes.NewReader(m.Thumbnail))
+			r.pdf.ImageOptions(name, x, top, cellWidth, cellWidth*float64(m.Height)/float64(m.Width), false, fpdf.ImageOptions{ImageType: "JPG"}, 0, "")
+
+			caption := m.TargetTitle
+			if m.Title != "" {
+				caption += " · " + m.Title
+			}
+			r.setFont(8)
+			r.pdf.SetXY(x, top+rowHeight+1)
+			r.pdf.CellFormat(cellWidth, 4, caption, "", 2, "L", false, 0, "")
+			r.pdf.CellFormat(cellWidth, 4, reportDate(m.CreatedAt), "", 0, "L", false, 0, "")
+		}
+		r.setFont(10)
+		r.pdf.SetXY(left, top+rowHeight+captionHeight+gap)
+	}
+}
+
+func (r *reportPDF) comments(comments []*objects.ReportComment) {
+	r.section(r.labels.Comments)
+	if len(comments) == 0 {
+		r.noData()
+		return
+	}
+
+	for _, c := range comments {
+		meta := reportLabel(r.labels.Roles, c.Role) + " · " + c.TargetTitle
+		if c.StepTitle != "" {
+			meta += " · " + c.StepTitle
+		}
+		meta += " · " + reportDate(c.CreatedAt)
+		if c.WeightedValue != 0 {
+			meta += fmt.Sprintf(" · %s %v", r.labels.Rates[0], c.WeightedValue)
+		}
+
+		r.ensureSpace(14)
+		r.setFont(8)
+		r.pdf.SetTextColor(136, 136, 136)
+		r.pdf.MultiCell(0, 5, meta, "", "L", false)
+		r.setFont(10)
+		r.pdf.SetTextColor(51, 51, 51)
+		if c.Text != "" {
+			r.pdf.MultiCell(0, 5, c.Text, "", "L", false)
+		}
+		r.pdf.Ln(2)
+	}
+}
@@ -0,0 +1,282 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"step/internal/biz"
+	"step/internal/conf"
+	"step/internal/data/ent"
+	entReport "step/internal/data/ent/report"
+	"step/internal/objects"
+	"step/internal/utils"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type reportRepo struct {
+	data      *Data
+	log       *log.Helper
+	minioRepo biz.MinioRepo
+	// PDF使用的中文字体
+	fontPath string
+}
+
+// NewReportRepo .
+func NewReportRepo(c *conf.Data, data *Data, logger log.Logger, minioRepo biz.MinioRepo) biz.ReportRepo {
+	r := &reportRepo{
+		data:      data,
+		log:       log.NewHelper(logger, log.WithMessageKey("reportRepo")),
+		minioRepo: minioRepo,
+	}
+	if c.Report != nil {
+		r.fontPath = c.Report.FontPath
+	}
+	if r.fontPath == "" {
+		r.log.Warn("data.report.font_path is empty, report generation will fail")
+	}
+	return r
+}
+
+// reportObjectName 报告放在用户目录下，ext为html或pdf
+func reportObjectName(uid string, reportID uint64, ext string) string {
+	return fmt.Sprintf("%s/reports/report_%d.%s", uid, reportID, ext)
+}
+
+func toApiReport(report *ent.Report) *stepApi.Report {
+	return &stepApi.Report{
+		Id:         report.ID,
+		TargetId:   report.TargetID,
+		StartDate:  report.StartDate,
+		EndDate:    report.EndDate,
+		StatUnit:   report.StatUnit.String(),
+		Status:     report.Status.String(),
+		Error:      report.Error,
+		CreatedAt:  report.CreatedAt,
+		FinishedAt: report.FinishedAt,
+	}
+}
+
+// getOwnedReport 获取当前用户的报告
+func (r *reportRepo) getOwnedReport(ctx context.Context, id uint64) (*ent.Report, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	report, err := r.data.ent_client.Report.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	return report, nil
+}
+
+// CreateReport 只能为顶层目标生成报告，评分趋势按顶层目标统计
+func (r *reportRepo) CreateReport(ctx context.Context, req *stepApi.CreateReportRequest) (*stepApi.CreateReportReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	if target.ParentID != 0 {
+		return nil, stepApi.ErrorInvalidArgument("target is not a top target")
+	}
+
+	createReport := r.data.ent_client.Report.Create().
+		SetUserID(uid).
+		SetTargetID(target.ID).
+		SetStartDate(req.StartDate).
+		SetEndDate(req.EndDate).
+		SetStatUnit(entReport.StatUnit(req.StatUnit)).
+		SetStatus(entReport.StatusPending)
+	if len(req.MediaStepIds) > 0 {
+		createReport.SetMediaStepIds(req.MediaStepIds)
+	}
+
+	report, err := createReport.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.CreateReportReply{
+		Report: toApiReport(report),
+	}, nil
+}
+
+func (r *reportRepo) GetReport(ctx context.Context, req *stepApi.GetReportRequest) (*stepApi.GetReportReply, error) {
+	report, err := r.getOwnedReport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	reportApi := toApiReport(report)
+	if report.Status == entReport.StatusDone {
+		endpoint := r.minioRepo.GetRemoteEndpoint(ctx)
+		if report.HTMLObjectName != "" {
+			reportApi.HtmlUrl, err = r.minioRepo.GetDownloadPreSignedUrl(ctx, report.HTMLObjectName, endpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if report.PdfObjectName != "" {
+			reportApi.PdfUrl, err = r.minioRepo.GetDownloadPreSignedUrl(ctx, report.PdfObjectName, endpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &stepApi.GetReportReply{
+		Report: reportApi,
+	}, nil
+}
+
+func (r *reportRepo) GetReports(ctx context.Context, req *stepApi.GetReportsRequest) (*stepApi.GetReportsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	reports, err := r.data.ent_client.Report.Query().
+		Where(entReport.UserID(uid)).
+		Order(ent.Desc(entReport.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reportsApi := make([]*stepApi.Report, len(reports))
+	for i, report := range reports {
+		reportsApi[i] = toApiReport(report)
+	}
+
+	return &stepApi.GetReportsReply{
+		Reports: reportsApi,
+	}, nil
+}
+
+// DeleteReport 正在生成的报告不能删除
+func (r *reportRepo) DeleteReport(ctx context.Context, req *stepApi.DeleteReportRequest) (*stepApi.DeleteReportReply, error) {
+	report, err := r.getOwnedReport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if report.Status == entReport.StatusRunning {
+		return nil, stepApi.ErrorTaskRunning("report is running")
+	}
+
+	err = r.data.ent_client.Report.DeleteOne(report).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, objectName := range []string{report.HTMLObjectName, report.PdfObjectName} {
+		if objectName == "" {
+			continue
+		}
+		if err := r.minioRepo.RemoveFile(ctx, objectName); err != nil {
+			r.log.Errorf("remove report object %s error: %v", objectName, err)
+		}
+	}
+
+	return &stepApi.DeleteReportReply{
+		Id: req.Id,
+	}, nil
+}
+
+func (r *reportRepo) StartReport(ctx context.Context, reportID uint64) (*objects.ReportJob, error) {
+	report, err := r.data.ent_client.Report.Get(ctx, reportID)
+	if err != nil {
+		// 任务执行前报告已被删除
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if report.Status == entReport.StatusDone {
+		return nil, nil
+	}
+
+	report, err = report.Update().
+		SetStatus(entReport.StatusRunning).
+		ClearError().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objects.ReportJob{
+		ID:           report.ID,
+		UserID:       report.UserID,
+		TargetID:     report.TargetID,
+		StartDate:    report.StartDate,
+		EndDate:      report.EndDate,
+		StatUnit:     report.StatUnit.String(),
+		MediaStepIDs: report.MediaStepIds,
+	}, nil
+}
+
+func (r *reportRepo) FailReport(ctx context.Context, reportID uint64, reason error) error {
+	message := reason.Error()
+	if len(message) > 1000 {
+		message = message[:1000]
+	}
+
+	return r.data.ent_client.Report.UpdateOneID(reportID).
+		SetStatus(entReport.StatusFailed).
+		SetError(message).
+		SetFinishedAt(time.Now().Local().Unix()).
+		Exec(ctx)
+}
+
+// FinishReport HTML中的缩略图内嵌为data URI，文件可以离线打开和打印
+func (r *reportRepo) FinishReport(ctx context.Context, job *objects.ReportJob, content *objects.ReportContent) error {
+	var html bytes.Buffer
+	err := reportHTMLTemplate.Execute(&html, content)
+	if err != nil {
+		return err
+	}
+
+	pdf, err := renderReportPDF(content, r.fontPath)
+	if err != nil {
+		return err
+	}
+
+	htmlObjectName := reportObjectName(job.UserID, job.ID, "html")
+	err = r.minioRepo.UploadObject(ctx, htmlObjectName, &html, "text/html; charset=utf-8")
+	if err != nil {
+		return err
+	}
+
+	pdfObjectName := reportObjectName(job.UserID, job.ID, "pdf")
+	err = r.minioRepo.UploadObject(ctx, pdfObjectName, bytes.NewReader(pdf), objects.MimeTypePdf)
+	if err != nil {
+		return err
+	}
+
+	return r.data.ent_client.Report.UpdateOneID(job.ID).
+		SetStatus(entReport.StatusDone).
+		SetHTMLObjectName(htmlObjectName).
+		SetPdfObjectName(pdfObjectName).
+		SetFinishedAt(time.Now().Local().Unix()).
+		Exec(ctx)
+}
@@ -0,0 +1,289 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"step/internal/biz"
+	"step/internal/conf"
+	"step/internal/objects"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewReviewNotifier 根据配置选择评论请求的通知方式，默认只记录日志
+func NewReviewNotifier(c *conf.Data, logger log.Logger) (biz.ReviewNotifier, error) {
+	review := c.GetReview()
+	notifier := objects.ReviewNotifierLog
+	if review.GetNotifier() != "" {
+		notifier = review.GetNotifier()
+	}
+
+	switch notifier {
+	case objects.ReviewNotifierLog:
+		return newLogReviewNotifier(logger), nil
+	case objects.ReviewNotifierSmtp:
+		if review.GetSmtp().GetHost() == "" || review.GetSmtp().GetFrom() == "" {
+			return nil, fmt.Errorf("smtp host and from are required for review notifier %s", notifier)
+		}
+		return newSmtpReviewNotifier(review.Smtp, review.ShareUrl, logger), nil
+	case objects.ReviewNotifierWebhook:
+		if review.GetWebhook().GetUrl() == "" {
+			return nil, fmt.Errorf("webhook url is required for review notifier %s", notifier)
+		}
+		return newWebhookReviewNotifier(review.Webhook, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported review notifier: %s", notifier)
+	}
+}
+
+// logReviewNotifier 只记录日志，发送过的通知保存在内存中
+type logReviewNotifier struct {
+	log *log.Helper
+
+	mu   sync.Mutex
+	sent []*objects.ReviewNotification
+}
+
+func newLogReviewNotifier(logger log.Logger) *logReviewNotifier {
+	return &logReviewNotifier{
+		log: log.NewHelper(logger, log.WithMessageKey("logReviewNotifier")),
+	}
+}
+
+func (n *logReviewNotifier) Notify(ctx context.Context, notification *objects.ReviewNotification) error {
+	n.log.Infof("review %s: request %d of step %d, roles %v, reviewers %v, emails %v",
+		notification.Kind, notification.RequestID, notification.StepID,
+		notification.Roles, notification.ReviewerIDs, notification.Emails)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+// Sent 按发送顺序返回所有通知
+func (n *logReviewNotifier) Sent() []*objects.ReviewNotification {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]*objects.ReviewNotification(nil), n.sent...)
+}
+
+// smtpReviewNotifier 把分享链接发送到邮箱，关联的评论人没有邮箱，在收件箱中查看
+type smtpReviewNotifier struct {
+	log      *log.Helper
+	conf     *conf.Data_Review_Smtp
+	shareUrl string
+}
+
+func newSmtpReviewNotifier(c *conf.Data_Review_Smtp, shareUrl string, logger log.Logger) *smtpReviewNotifier {
+	return &smtpReviewNotifier{
+		log:      log.NewHelper(logger, log.WithMessageKey("smtpReviewNotifier")),
+		conf:     c,
+		shareUrl: shareUrl,
+	}
+}
+
+// shareLink 分享页面加上积累ID和share_to，没有配置分享页面时为空
+func (n *smtpReviewNotifier) shareLink(notification *objects.ReviewNotification) string {
+	if n.shareUrl == "" || notification.ShareTo == "" {
+		return ""
+	}
+	query := url.Values{}
+	query.Set("id", strconv.FormatUint(notification.StepID, 10))
+	query.Set("share_to", notification.ShareTo)
+	separator := "?"
+	if strings.Contains(n.shareUrl, "?") {
+		separator = "&"
+	}
+	return n.shareUrl + separator + query.Encode()
+}
+
+func (n *smtpReviewNotifier) message(notification *objects.ReviewNotification) []byte {
+	subject := "请评论我的积累"
+	if notification.Kind == objects.ReviewNotificationReminder {
+		subject = "提醒：积累还在等待你的评论"
+	}
+	if notification.StepTitle != "" {
+		subject += "：" + notification.StepTitle
+	}
+
+	var body strings.Builder
+	if notification.Message != "" {
+		body.WriteString(notification.Message + "\r\n\r\n")
+	}
+	if link := n.shareLink(notification); link != "" {
+		body.WriteString("评论链接：" + link + "\r\n")
+	}
+	body.WriteString("有效期至：" + time.Unix(notification.ExpiresAt, 0).Local().Format("2006-01-02 15:04") + "\r\n")
+
+	var msg bytes.Buffer
+	msg.WriteString("From: " + n.conf.From + "\r\n")
+	msg.WriteString("To: " + strings.Join(notification.Emails, ", ") + "\r\n")
+	msg.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(body.String())
+	return msg.Bytes()
+}
+
+func (n *smtpReviewNotifier) Notify(ctx context.Context, notification *objects.ReviewNotification) error {
+	if len(notification.Emails) == 0 {
+		return nil
+	}
+
+	port := n.conf.Port
+	// 默认使用提交端口，服务器支持时自动STARTTLS
+	if port == 0 {
+		port = 587
+	}
+	addr := net.JoinHostPort(n.conf.Host, strconv.FormatUint(uint64(port), 10))
+
+	// 信封发件人只用地址，From头可以带名称
+	from := n.conf.From
+	if address, err := mail.ParseAddress(from); err == nil {
+		from = address.Address
+	}
+	return n.sendMail(ctx, addr, from, notification.Emails, n.message(notification))
+}
+
+// sendMail 和smtp.SendMail相同，连接和整个发送过程有超时，任务取消时中断
+func (n *smtpReviewNotifier) sendMail(ctx context.Context, addr string, from string, to []string, msg []byte) (err error) {
+	timeout := n.conf.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = objects.ReviewSmtpTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	defer func() {
+		if err != nil && ctx.Err() != nil {
+			err = fmt.Errorf("send mail via %s: %w", addr, ctx.Err())
+		}
+	}()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	// 任务取消时关闭连接，正在进行的读写立即返回
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, n.conf.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: n.conf.Host})
+		if err != nil {
+			return err
+		}
+	}
+	if n.conf.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp server %s doesn't support AUTH", n.conf.Host)
+		}
+		err = c.Auth(smtp.PlainAuth("", n.conf.Username, n.conf.Password, n.conf.Host))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = c.Mail(from)
+	if err != nil {
+		return err
+	}
+	for _, rcpt := range to {
+		err = c.Rcpt(rcpt)
+		if err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// webhookReviewNotifier 把通知以JSON发送到配置的地址，由接收方按User ID或邮箱转发
+type webhookReviewNotifier struct {
+	log    *log.Helper
+	conf   *conf.Data_Review_Webhook
+	client *http.Client
+}
+
+func newWebhookReviewNotifier(c *conf.Data_Review_Webhook, logger log.Logger) *webhookReviewNotifier {
+	timeout := c.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = objects.ReviewWebhookTimeout
+	}
+	return &webhookReviewNotifier{
+		log:    log.NewHelper(logger, log.WithMessageKey("webhookReviewNotifier")),
+		conf:   c,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (n *webhookReviewNotifier) Notify(ctx context.Context, notification *objects.ReviewNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.conf.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.conf.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(n.conf.Secret))
+		mac.Write(body)
+		req.Header.Set(objects.ReviewSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("review webhook responded %s", resp.Status)
+	}
+	return nil
+}
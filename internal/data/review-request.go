@@ -0,0 +1,352 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"step/internal/data/ent"
+	entReviewer "step/internal/data/ent/reviewer"
+	entReviewRequest "step/internal/data/ent/reviewrequest"
+	entStep "step/internal/data/ent/step"
+	"step/internal/objects"
+	"step/internal/utils"
+	"strings"
+	"time"
+
+	stepApi "step/api/step/v1"
+)
+
+// reviewDays 提醒和过期的天数，配置为0时使用默认值
+func (r *reviewerRepo) reviewDays(remindAfterDays uint32) (uint32, uint32) {
+	if remindAfterDays == 0 {
+		remindAfterDays = r.data.review.GetRemindAfterDays()
+	}
+	if remindAfterDays == 0 {
+		remindAfterDays = objects.ReviewRemindAfterDays
+	}
+	expireAfterDays := r.data.review.GetExpireAfterDays()
+	if expireAfterDays == 0 {
+		expireAfterDays = objects.ReviewExpireAfterDays
+	}
+	return remindAfterDays, expireAfterDays
+}
+
+func toApiReviewRequest(request *ent.ReviewRequest) *stepApi.ReviewRequest {
+	return &stepApi.ReviewRequest{
+		Id:          request.ID,
+		StepId:      request.StepID,
+		Roles:       request.Roles,
+		ReviewerIds: request.ReviewerIds,
+		Emails:      request.Emails,
+		Message:     request.Message,
+		ShareTo:     request.ShareTo,
+		Status:      request.Status.String(),
+		RemindAt:    request.RemindAt,
+		RemindedAt:  request.RemindedAt,
+		ExpiresAt:   request.ExpiresAt,
+		CommentId:   request.CommentID,
+		CommentedAt: request.CommentedAt,
+		CreatedAt:   request.CreatedAt,
+	}
+}
+
+func reviewNotification(kind string, request *ent.ReviewRequest, step *ent.Step) *objects.ReviewNotification {
+	return &objects.ReviewNotification{
+		Kind:        kind,
+		RequestID:   request.ID,
+		StudentID:   request.UserID,
+		StepID:      request.StepID,
+		StepTitle:   step.Title,
+		Roles:       request.Roles,
+		ReviewerIDs: request.ReviewerIds,
+		Emails:      request.Emails,
+		ShareTo:     request.ShareTo,
+		Message:     request.Message,
+		ExpiresAt:   request.ExpiresAt,
+	}
+}
+
+// checkReviewRequestLimits 每个学生24小时内的请求数和邮件收件人数有上限，避免被用来群发邮件
+func (r *reviewerRepo) checkReviewRequestLimits(ctx context.Context, uid string, emails int, now time.Time) error {
+	maxRequests := r.data.review.GetMaxRequestsPerDay()
+	if maxRequests == 0 {
+		maxRequests = objects.ReviewMaxRequestsPerDay
+	}
+	maxEmails := r.data.review.GetMaxEmailsPerDay()
+	if maxEmails == 0 {
+		maxEmails = objects.ReviewMaxEmailsPerDay
+	}
+
+	requests, err := r.data.ent_client.ReviewRequest.Query().
+		Where(
+			entReviewRequest.UserID(uid),
+			entReviewRequest.CreatedAtGT(now.Add(-24*time.Hour).Unix()),
+		).
+		Select(entReviewRequest.FieldEmails).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(requests) >= int(maxRequests) {
+		return stepApi.ErrorRateLimited("at most %d review requests in 24 hours", maxRequests)
+	}
+
+	sent := 0
+	for _, request := range requests {
+		sent += len(request.Emails)
+	}
+	if emails > 0 && sent+emails > int(maxEmails) {
+		return stepApi.ErrorRateLimited("at most %d email recipients in 24 hours", maxEmails)
+	}
+	return nil
+}
+
+// RequestReview 分享链接的角色使用同一个share_to，邮箱只接收朋友角色的分享链接
+func (r *reviewerRepo) RequestReview(ctx context.Context, req *stepApi.RequestReviewRequest) (*stepApi.RequestReviewReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if len(req.Roles) == 0 && len(req.Reviewers) == 0 {
+		return nil, stepApi.ErrorInvalidArgument("roles or reviewers is required")
+	}
+	// 邮箱收到的分享链接只能以朋友身份评论，老师和家长需要关联后以评论人身份评论
+	if len(req.Emails) > 0 && (len(req.Roles) != 1 || req.Roles[0] != objects.CommentRoleFriend) {
+		return nil, stepApi.ErrorInvalidArgument("emails only work with the friend role, link teachers and parents as reviewers")
+	}
+
+	remindAfterDays, expireAfterDays := r.reviewDays(req.RemindAfterDays)
+	if remindAfterDays >= expireAfterDays {
+		return nil, stepApi.ErrorInvalidArgument("remind_after_days must be less than %d", expireAfterDays)
+	}
+
+	step, err := r.data.ent_client.Step.Query().Where(entStep.ID(req.Id)).WithTarget().First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if step.Edges.Target == nil {
+		return nil, stepApi.ErrorTargetNotFound("can't find target of this step")
+	}
+	if step.Edges.Target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	var reviewerIds []string
+	if len(req.Reviewers) > 0 {
+		reviewers, err := r.data.ent_client.Reviewer.Query().
+			Where(
+				entReviewer.IDIn(req.Reviewers...),
+				entReviewer.StudentID(uid),
+				entReviewer.StatusEQ(entReviewer.StatusActive),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(reviewers) != len(req.Reviewers) {
+			return nil, stepApi.ErrorInvalidArgument("reviewers must be your active reviewers")
+		}
+		for _, reviewer := range reviewers {
+			reviewerIds = append(reviewerIds, reviewer.ReviewerID)
+		}
+	}
+
+	now := time.Now()
+	err = r.checkReviewRequestLimits(ctx, uid, len(req.Emails), now)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := now.AddDate(0, 0, int(expireAfterDays)).Unix()
+
+	// 分享链接和请求同时过期，超过分享链接的最长有效期时链接先过期
+	var shareTo string
+	if len(req.Roles) > 0 {
+		shareTo, err = r.encryptRepo.Encrypt(ctx, step.ID, strings.Join(req.Roles, ","), expiresAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	create := r.data.ent_client.ReviewRequest.Create().
+		SetUserID(uid).
+		SetStepID(step.ID).
+		SetMessage(req.Message).
+		SetShareTo(shareTo).
+		SetRemindAt(now.AddDate(0, 0, int(remindAfterDays)).Unix()).
+		SetExpiresAt(expiresAt)
+	if len(req.Roles) > 0 {
+		create.SetRoles(req.Roles)
+	}
+	if len(reviewerIds) > 0 {
+		create.SetReviewerIds(reviewerIds)
+	}
+	if len(req.Emails) > 0 {
+		create.SetEmails(req.Emails)
+	}
+	request, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.RequestReviewReply{
+		Request: toApiReviewRequest(request),
+	}, nil
+}
+
+// ReviewRequestNotification 创建请求后的第一次通知，已评论、已过期或者积累已删除时返回nil
+func (r *reviewerRepo) ReviewRequestNotification(ctx context.Context, id uint64) (*objects.ReviewNotification, error) {
+	request, err := r.data.ent_client.ReviewRequest.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if request.Status != entReviewRequest.StatusPending || time.Now().Unix() >= request.ExpiresAt {
+		return nil, nil
+	}
+
+	step, err := r.data.ent_client.Step.Get(ctx, request.StepID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return reviewNotification(objects.ReviewNotificationRequest, request, step), nil
+}
+
+// GetReviewRequests 已过期但提醒任务还没有处理的请求同时标记为过期
+func (r *reviewerRepo) GetReviewRequests(ctx context.Context, req *stepApi.GetReviewRequestsRequest) (*stepApi.GetReviewRequestsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	_, err := r.data.ent_client.ReviewRequest.Update().
+		Where(
+			entReviewRequest.UserID(uid),
+			entReviewRequest.StatusEQ(entReviewRequest.StatusPending),
+			entReviewRequest.ExpiresAtLTE(time.Now().Unix()),
+		).
+		SetStatus(entReviewRequest.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.data.ent_client.ReviewRequest.Query().
+		Where(entReviewRequest.UserID(uid))
+	if req.StepId != 0 {
+		query = query.Where(entReviewRequest.StepID(req.StepId))
+	}
+	if req.Status != "" {
+		query = query.Where(entReviewRequest.StatusEQ(entReviewRequest.Status(req.Status)))
+	}
+
+	requests, err := query.
+		Order(ent.Desc(entReviewRequest.FieldCreatedAt), ent.Desc(entReviewRequest.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestsApi := make([]*stepApi.ReviewRequest, len(requests))
+	for i, request := range requests {
+		requestsApi[i] = toApiReviewRequest(request)
+	}
+
+	return &stepApi.GetReviewRequestsReply{
+		Requests: requestsApi,
+	}, nil
+}
+
+// expireReviewRequest 只有待回应的请求会过期
+func (r *reviewerRepo) expireReviewRequest(ctx context.Context, id uint64) error {
+	_, err := r.data.ent_client.ReviewRequest.Update().
+		Where(entReviewRequest.ID(id), entReviewRequest.StatusEQ(entReviewRequest.StatusPending)).
+		SetStatus(entReviewRequest.StatusExpired).
+		Save(ctx)
+	return err
+}
+
+// ReviewRequestReminder 需要提醒时返回提醒通知；已评论、已提醒或者还没到提醒时间时返回nil，到期时标记为过期
+func (r *reviewerRepo) ReviewRequestReminder(ctx context.Context, id uint64) (*objects.ReviewNotification, error) {
+	request, err := r.data.ent_client.ReviewRequest.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if request.Status != entReviewRequest.StatusPending {
+		return nil, nil
+	}
+
+	now := time.Now().Unix()
+	if now >= request.ExpiresAt {
+		return nil, r.expireReviewRequest(ctx, id)
+	}
+	if request.RemindedAt != 0 || now < request.RemindAt {
+		return nil, nil
+	}
+
+	step, err := r.data.ent_client.Step.Get(ctx, request.StepID)
+	if err != nil {
+		// 积累已删除，不再需要评论
+		if ent.IsNotFound(err) {
+			return nil, r.expireReviewRequest(ctx, id)
+		}
+		return nil, err
+	}
+
+	return reviewNotification(objects.ReviewNotificationReminder, request, step), nil
+}
+
+func (r *reviewerRepo) SetReviewRequestReminded(ctx context.Context, id uint64) error {
+	return r.data.ent_client.ReviewRequest.UpdateOneID(id).
+		SetRemindedAt(time.Now().Unix()).
+		Exec(ctx)
+}
+
+// answerReviewRequests 积累及其所在目录上等待这个角色或者评论人的请求标记为已评论，自评不算
+func answerReviewRequests(ctx context.Context, tx *ent.Tx, stepId uint64, role string, authorId string, commentId uint64) error {
+	if role == objects.CommentRoleStudent {
+		return nil
+	}
+
+	step, err := tx.Step.Get(ctx, stepId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	requests, err := tx.ReviewRequest.Query().
+		Where(
+			entReviewRequest.StepIDIn(append(objects.PathIDs(step.Path), stepId)...),
+			entReviewRequest.StatusEQ(entReviewRequest.StatusPending),
+			entReviewRequest.ExpiresAtGT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uint64, 0, len(requests))
+	for _, request := range requests {
+		if slices.Contains(request.Roles, role) || (authorId != "" && slices.Contains(request.ReviewerIds, authorId)) {
+			ids = append(ids, request.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return tx.ReviewRequest.Update().
+		Where(entReviewRequest.IDIn(ids...), entReviewRequest.StatusEQ(entReviewRequest.StatusPending)).
+		SetStatus(entReviewRequest.StatusCommented).
+		SetCommentID(commentId).
+		SetCommentedAt(now).
+		Exec(ctx)
+}
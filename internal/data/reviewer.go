@@ -0,0 +1,351 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
+	"step/internal/data/ent/predicate"
+	entReviewer "step/internal/data/ent/reviewer"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/internal/utils"
+	"strings"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type reviewerRepo struct {
+	data        *Data
+	log         *log.Helper
+	minioRepo   biz.MinioRepo
+	encryptRepo biz.EncryptRepo
+}
+
+// NewReviewerRepo .
+func NewReviewerRepo(data *Data, logger log.Logger, minioRepo biz.MinioRepo, encryptRepo biz.EncryptRepo) biz.ReviewerRepo {
+	return &reviewerRepo{
+		data:        data,
+		log:         log.NewHelper(logger, log.WithMessageKey("reviewerRepo")),
+		minioRepo:   minioRepo,
+		encryptRepo: encryptRepo,
+	}
+}
+
+// newInviteCode 方便口头或者手动输入的邀请码，每个字符从字母表中均匀选取
+func newInviteCode() (string, error) {
+	alphabet := objects.ReviewerInviteAlphabet
+	size := big.NewInt(int64(len(alphabet)))
+	b := make([]byte, objects.ReviewerInviteCodeLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", err
+		}
+		b[i] = alphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// toApiReviewer 邀请码只返回给发出邀请的学生
+func toApiReviewer(reviewer *ent.Reviewer, uid string) *stepApi.Reviewer {
+	reviewerApi := &stepApi.Reviewer{
+		Id:              reviewer.ID,
+		StudentId:       reviewer.StudentID,
+		ReviewerId:      reviewer.ReviewerID,
+		Role:            reviewer.Role.String(),
+		Status:          reviewer.Status.String(),
+		InviteExpiresAt: reviewer.InviteExpiresAt,
+		CreatedAt:       reviewer.CreatedAt,
+		AcceptedAt:      reviewer.AcceptedAt,
+	}
+	if reviewer.StudentID == uid && reviewer.Status == entReviewer.StatusPending {
+		reviewerApi.InviteCode = reviewer.InviteCode
+	}
+	return reviewerApi
+}
+
+func (r *reviewerRepo) CreateReviewerInvite(ctx context.Context, req *stepApi.CreateReviewerInviteRequest) (*stepApi.CreateReviewerInviteReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if !objects.ValidReviewerRole(req.Role) {
+		return nil, stepApi.ErrorInvalidArgument("role must be teacher or parent")
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	reviewer, err := r.data.ent_client.Reviewer.Create().
+		SetStudentID(uid).
+		SetRole(entReviewer.Role(req.Role)).
+		SetInviteCode(code).
+		SetInviteExpiresAt(time.Now().Add(objects.ReviewerInviteTTL).Unix()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.CreateReviewerInviteReply{
+		Reviewer: toApiReviewer(reviewer, uid),
+	}, nil
+}
+
+func (r *reviewerRepo) AcceptReviewerInvite(ctx context.Context, req *stepApi.AcceptReviewerInviteRequest) (*stepApi.AcceptReviewerInviteReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(req.InviteCode))
+	invite, err := r.data.ent_client.Reviewer.Query().
+		Where(entReviewer.InviteCode(code), entReviewer.StatusEQ(entReviewer.StatusPending)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, stepApi.ErrorInvalidInvite("invalid invite code")
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if now.Unix() > invite.InviteExpiresAt {
+		return nil, stepApi.ErrorInvalidInvite("invite code is expired")
+	}
+	if invite.StudentID == uid {
+		return nil, stepApi.ErrorInvalidInvite("can't accept your own invite")
+	}
+
+	linked, err := r.data.ent_client.Reviewer.Query().
+		Where(entReviewer.StudentID(invite.StudentID), entReviewer.ReviewerID(uid)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if linked {
+		return nil, stepApi.ErrorConflict("already a reviewer of this student")
+	}
+
+	// 同时接受同一个邀请码时只有一个成功
+	reviewer, err := r.data.ent_client.Reviewer.UpdateOneID(invite.ID).
+		Where(entReviewer.StatusEQ(entReviewer.StatusPending)).
+		SetReviewerID(uid).
+		SetStatus(entReviewer.StatusActive).
+		SetAcceptedAt(now.Unix()).
+		ClearInviteCode().
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, stepApi.ErrorInvalidInvite("invalid invite code")
+		}
+		return nil, err
+	}
+
+	return &stepApi.AcceptReviewerInviteReply{
+		Reviewer: toApiReviewer(reviewer, uid),
+	}, nil
+}
+
+func (r *reviewerRepo) GetReviewers(ctx context.Context, req *stepApi.GetReviewersRequest) (*stepApi.GetReviewersReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	query := r.data.ent_client.Reviewer.Query()
+	if req.As == objects.ReviewerAsReviewer {
+		query = query.Where(entReviewer.ReviewerID(uid), entReviewer.StatusEQ(entReviewer.StatusActive))
+	} else {
+		query = query.Where(entReviewer.StudentID(uid))
+	}
+
+	reviewers, err := query.
+		Order(ent.Desc(entReviewer.FieldCreatedAt), ent.Desc(entReviewer.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewersApi := make([]*stepApi.Reviewer, len(reviewers))
+	for i, reviewer := range reviewers {
+		reviewersApi[i] = toApiReviewer(reviewer, uid)
+	}
+
+	return &stepApi.GetReviewersReply{
+		Reviewers: reviewersApi,
+	}, nil
+}
+
+func (r *reviewerRepo) DeleteReviewer(ctx context.Context, req *stepApi.DeleteReviewerRequest) (*stepApi.DeleteReviewerReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	reviewer, err := r.data.ent_client.Reviewer.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if reviewer.StudentID != uid && reviewer.ReviewerID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	err = r.data.ent_client.Reviewer.DeleteOneID(req.Id).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.DeleteReviewerReply{
+		Id: req.Id,
+	}, nil
+}
+
+// activeReviewers 当前用户作为老师或家长关联的学生，studentId不为空时只返回该学生
+func (r *reviewerRepo) activeReviewers(ctx context.Context, uid string, studentId string) ([]*ent.Reviewer, error) {
+	query := r.data.ent_client.Reviewer.Query().
+		Where(entReviewer.ReviewerID(uid), entReviewer.StatusEQ(entReviewer.StatusActive))
+	if studentId != "" {
+		query = query.Where(entReviewer.StudentID(studentId))
+	}
+	return query.All(ctx)
+}
+
+// unratedBy 评论人还没有评分的积累，同一个学生可以有多个老师
+func unratedBy(uid string) predicate.Step {
+	return func(s *sql.Selector) {
+		t := sql.Table(entComment.Table)
+		s.Where(sql.NotIn(
+			s.C(entStep.FieldID),
+			sql.Select(t.C(entComment.FieldStepID)).From(t).Where(sql.And(
+				sql.EQ(t.C(entComment.FieldAuthorID), uid),
+				sql.IsNull(t.C(entComment.FieldParentID)),
+			)),
+		))
+	}
+}
+
+// GetReviewerInbox 按创建时间倒序，只包含关联之后新增的积累，不包含目录
+func (r *reviewerRepo) GetReviewerInbox(ctx context.Context, req *stepApi.GetReviewerInboxRequest) (*stepApi.GetReviewerInboxReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	reviewers, err := r.activeReviewers(ctx, uid, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviewers) == 0 {
+		return &stepApi.GetReviewerInboxReply{}, nil
+	}
+
+	roles := make(map[string]entReviewer.Role, len(reviewers))
+	students := make([]predicate.Step, len(reviewers))
+	for i, reviewer := range reviewers {
+		roles[reviewer.StudentID] = reviewer.Role
+		predicates := []predicate.Step{
+			entStep.HasTargetWith(entTarget.UserID(reviewer.StudentID)),
+			entStep.CreatedAtGTE(reviewer.AcceptedAt),
+		}
+		students[i] = entStep.And(predicates...)
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	key := stepSortKey(objects.SortCreatedAt)
+	query := r.data.ent_client.Step.Query().
+		Where(entStep.Or(students...), entStep.TypeNEQ(entStep.TypeDir))
+	if !req.IncludeCommented {
+		query = query.Where(unratedBy(uid))
+	}
+	query = query.
+		WithTarget().
+		Order(key.order).
+		Limit(int(pageSize))
+	if req.Cursor != "" {
+		c, err := objects.DecodeCursor(req.Cursor, objects.SortCreatedAt)
+		if err != nil {
+			return nil, stepApi.ErrorInvalidArgument("%v", err)
+		}
+		query = query.Where(key.after(c))
+	}
+
+	steps, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stepsApi, err := toApiSteps(ctx, r.minioRepo, r.data.minio_endpoint_remote, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*stepApi.ReviewerInboxItem, len(steps))
+	for i, step := range steps {
+		target := step.Edges.Target
+		items[i] = &stepApi.ReviewerInboxItem{
+			StudentId:   target.UserID,
+			Role:        roles[target.UserID].String(),
+			TargetId:    target.ID,
+			TargetTitle: target.Title,
+			Step:        stepsApi[i],
+		}
+	}
+
+	var nextCursor string
+	if len(steps) == int(pageSize) {
+		last := steps[len(steps)-1]
+		nextCursor = objects.EncodeCursor(&objects.Cursor{
+			Sort: objects.SortCreatedAt,
+			Key:  last.CreatedAt,
+			ID:   last.ID,
+		})
+	}
+
+	return &stepApi.GetReviewerInboxReply{
+		Items:      items,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// SetReviewerComment 评论角色由关联决定，评论记录评论人的User ID
+func (r *reviewerRepo) SetReviewerComment(ctx context.Context, req *stepApi.SetReviewerCommentRequest) (*stepApi.SetReviewerCommentReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	role, err := stepCommentRole(ctx, r.data.ent_client, req.Id, uid)
+	if err != nil {
+		return nil, err
+	}
+	if role == objects.CommentRoleStudent {
+		return nil, stepApi.ErrorNotReviewer("can't rate your own step")
+	}
+
+	commentId, err := createRatedComment(ctx, r.data.ent_client, r.log, req.Id, role, req.Comment, req.Content, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.SetReviewerCommentReply{
+		Id:        req.Id,
+		Role:      role,
+		CommentId: commentId,
+	}, nil
+}
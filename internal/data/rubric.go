@@ -0,0 +1,256 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/internal/utils"
+
+	stepApi "step/api/step/v1"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type rubricRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewRubricRepo .
+func NewRubricRepo(data *Data, logger log.Logger) biz.RubricRepo {
+	return &rubricRepo{
+		data: data,
+		log:  log.NewHelper(logger, log.WithMessageKey("rubricRepo")),
+	}
+}
+
+func toApiRubric(rubric *objects.Rubric) *stepApi.CommentRubric {
+	dimensions := make([]*stepApi.CommentRubricDimension, len(rubric.Dimensions))
+	for i, dimension := range rubric.Dimensions {
+		portraits := objects.RatingColumnPortraits[dimension.Column]
+		portraitsApi := make([]*stepApi.CommentRubricPortrait, len(portraits))
+		for j, p := range portraits {
+			portraitsApi[j] = &stepApi.CommentRubricPortrait{
+				Dimension:     p.Dimension,
+				Key:           p.Key,
+				ChallengeOnly: p.ChallengeOnly,
+			}
+		}
+		dimensions[i] = &stepApi.CommentRubricDimension{
+			Key:         dimension.Key,
+			Title:       dimension.Title,
+			Description: dimension.Description,
+			Min:         dimension.Min,
+			Max:         dimension.Max,
+			Integer:     dimension.Integer,
+			Required:    dimension.Required,
+			Column:      dimension.Column,
+			Portraits:   portraitsApi,
+			Weight:      dimension.Weight,
+		}
+	}
+
+	return &stepApi.CommentRubric{
+		Version:    rubric.Version,
+		Title:      rubric.Title,
+		Neutral:    rubric.Neutral,
+		Dimensions: dimensions,
+	}
+}
+
+// effectiveRubric 顶层目标没有自定义时使用当前版本的默认评分标准
+func effectiveRubric(topTarget *ent.Target) *objects.Rubric {
+	if topTarget.Rubric.IsCustom() {
+		return topTarget.Rubric.Rubric()
+	}
+	rubric, _ := objects.GetRubric(objects.CurrentRubricVersion)
+	return rubric
+}
+
+// topTargetOf 目标所在的顶层目标，评分标准属于顶层目标
+func topTargetOf(ctx context.Context, client *ent.Client, target *ent.Target) (*ent.Target, error) {
+	if target.Layer == 0 || target.ParentID == 0 {
+		return target, nil
+	}
+
+	ancestorIDs := objects.PathIDs(target.Path)
+	if len(ancestorIDs) == 0 {
+		return nil, fmt.Errorf("target %d with layer %d has no path", target.ID, target.Layer)
+	}
+
+	return client.Target.Get(ctx, ancestorIDs[0])
+}
+
+// stepTargetRubric 积累所在顶层目标的自定义评分标准，没有自定义时为nil
+func stepTargetRubric(ctx context.Context, client *ent.Client, stepId uint64) (*objects.TargetRubric, error) {
+	step, err := client.Step.Query().Where(entStep.ID(stepId)).WithTarget().First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if step.Edges.Target == nil {
+		return nil, stepApi.ErrorTargetNotFound("can't find target of this step")
+	}
+
+	topTarget, err := topTargetOf(ctx, client, step.Edges.Target)
+	if err != nil {
+		return nil, err
+	}
+	return topTarget.Rubric, nil
+}
+
+// targetRated 目标及其子目标下的积累是否已有评分
+func targetRated(ctx context.Context, client *ent.Client, target *ent.Target) (bool, error) {
+	targetIDs, err := client.Target.Query().
+		Where(entTarget.Or(
+			entTarget.ID(target.ID),
+			entTarget.PathHasPrefix(objects.ChildPath(target.Path, target.ID)),
+		)).
+		IDs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	ids := make([]any, len(targetIDs))
+	for i, id := range targetIDs {
+		ids[i] = id
+	}
+	return client.Comment.Query().
+		Where(
+			entComment.ParentIDIsNil(),
+			entComment.RatingNotNil(),
+			func(s *sql.Selector) {
+				t := sql.Table(entStep.Table)
+				s.Where(sql.In(
+					s.C(entComment.FieldStepID),
+					sql.Select(t.C(entStep.FieldID)).From(t).Where(sql.In(t.C(entStep.FieldRefTargetID), ids...)),
+				))
+			},
+		).
+		Exist(ctx)
+}
+
+func (r *rubricRepo) GetCommentRubric(ctx context.Context, version string) (*stepApi.CommentRubric, error) {
+	rubric, ok := objects.GetRubric(version)
+	if !ok {
+		return nil, stepApi.ErrorInvalidArgument("unknown rubric version %s", version)
+	}
+	return toApiRubric(rubric), nil
+}
+
+func (r *rubricRepo) GetStepRubric(ctx context.Context, stepId uint64) (*stepApi.CommentRubric, error) {
+	targetRubric, err := stepTargetRubric(ctx, r.data.ent_client, stepId)
+	if err != nil {
+		return nil, err
+	}
+	if targetRubric.IsCustom() {
+		return toApiRubric(targetRubric.Rubric()), nil
+	}
+	return r.GetCommentRubric(ctx, objects.CurrentRubricVersion)
+}
+
+func (r *rubricRepo) GetTargetRubric(ctx context.Context, req *stepApi.GetTargetRubricRequest) (*stepApi.GetTargetRubricReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 老师和家长评分前也需要评分标准
+	_, err = studentViewerRole(ctx, r.data.ent_client, target.UserID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	topTarget, err := topTargetOf(ctx, r.data.ent_client, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetTargetRubricReply{
+		Id:     topTarget.ID,
+		Custom: topTarget.Rubric.IsCustom(),
+		Rubric: toApiRubric(effectiveRubric(topTarget)),
+	}, nil
+}
+
+// SetTargetRubric 修改后新的评分按新的评价项校验，不再符合的已有评分重新统计时会被跳过
+func (r *rubricRepo) SetTargetRubric(ctx context.Context, req *stepApi.SetTargetRubricRequest) (*stepApi.SetTargetRubricReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+	if target.Layer != 0 || target.ParentID != 0 {
+		return nil, stepApi.ErrorInvalidArgument("only top targets can define a rubric")
+	}
+
+	var targetRubric *objects.TargetRubric
+	if len(req.Criteria) > 0 {
+		targetRubric = &objects.TargetRubric{
+			Title:    req.Title,
+			ScaleMin: req.ScaleMin,
+			ScaleMax: req.ScaleMax,
+			Criteria: make([]*objects.TargetRubricCriterion, len(req.Criteria)),
+		}
+		for i, criterion := range req.Criteria {
+			targetRubric.Criteria[i] = &objects.TargetRubricCriterion{
+				Key:         criterion.Key,
+				Title:       criterion.Title,
+				Description: criterion.Description,
+				Weight:      criterion.Weight,
+			}
+		}
+		if err := targetRubric.Validate(); err != nil {
+			return nil, stepApi.ErrorInvalidArgument("invalid rubric: %v", err)
+		}
+	}
+
+	// 已有评分按原来的评价项保存，修改评价项或量程后无法再解析，统计时会被跳过
+	if !target.Rubric.SameCriteria(targetRubric) {
+		rated, err := targetRated(ctx, r.data.ent_client, target)
+		if err != nil {
+			return nil, err
+		}
+		if rated {
+			return nil, stepApi.ErrorConflict("criteria and scale can't be changed after steps are rated, only titles, descriptions and weights")
+		}
+	}
+
+	update := r.data.ent_client.Target.UpdateOneID(req.Id)
+	if targetRubric == nil {
+		update.ClearRubric()
+	} else {
+		update.SetRubric(targetRubric)
+	}
+
+	target, err = update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 评分趋势中的评价项随评分标准变化
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
+
+	return &stepApi.SetTargetRubricReply{
+		Id:     target.ID,
+		Custom: target.Rubric.IsCustom(),
+		Rubric: toApiRubric(effectiveRubric(target)),
+	}, nil
+}
@@ -0,0 +1,187 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"step/internal/objects"
+	"strconv"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 查询目标已有文档时每次取的数量
+const bleveScanSize = 1000
+
+// bleveSearchIndex 内嵌的Bleve索引，不依赖数据库的全文索引能力
+type bleveSearchIndex struct {
+	index bleve.Index
+	log   *log.Helper
+}
+
+// bleveDocument 索引中的文档，target_id使用字符串以便按目标精确查找
+type bleveDocument struct {
+	UserID      string `json:"user_id"`
+	Kind        string `json:"kind"`
+	TargetID    string `json:"target_id"`
+	StepID      string `json:"step_id"`
+	CommentRole string `json:"comment_role"`
+	Title       string `json:"title"`
+	Content     string `json:"content"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+func newBleveSearchIndex(path string, logger log.Logger) (*bleveSearchIndex, func(), error) {
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.New(path, bleveSearchMapping())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	helper := log.NewHelper(logger, log.WithMessageKey("bleveSearchIndex"))
+	cleanup := func() {
+		if err := index.Close(); err != nil {
+			helper.Errorf("close bleve index: %v", err)
+		}
+	}
+	return &bleveSearchIndex{
+		index: index,
+		log:   helper,
+	}, cleanup, nil
+}
+
+func bleveSearchMapping() mapping.IndexMapping {
+	keywordField := bleve.NewTextFieldMapping()
+	keywordField.Analyzer = keyword.Name
+
+	// 中文按二元分词
+	textField := bleve.NewTextFieldMapping()
+	textField.Analyzer = cjk.AnalyzerName
+
+	docMapping := bleve.NewDocumentMapping()
+	docMapping.AddFieldMappingsAt("user_id", keywordField)
+	docMapping.AddFieldMappingsAt("kind", keywordField)
+	docMapping.AddFieldMappingsAt("target_id", keywordField)
+	docMapping.AddFieldMappingsAt("step_id", keywordField)
+	docMapping.AddFieldMappingsAt("comment_role", keywordField)
+	docMapping.AddFieldMappingsAt("title", textField)
+	docMapping.AddFieldMappingsAt("content", textField)
+	docMapping.AddFieldMappingsAt("created_at", bleve.NewNumericFieldMapping())
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = docMapping
+	return indexMapping
+}
+
+func (i *bleveSearchIndex) ReplaceTarget(ctx context.Context, targetID uint64, docs []*objects.SearchDocument) error {
+	targetQuery := bleve.NewTermQuery(strconv.FormatUint(targetID, 10))
+	targetQuery.SetField("target_id")
+
+	batch := i.index.NewBatch()
+	for from := 0; ; from += bleveScanSize {
+		req := bleve.NewSearchRequestOptions(targetQuery, bleveScanSize, from, false)
+		result, err := i.index.SearchInContext(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, hit := range result.Hits {
+			batch.Delete(hit.ID)
+		}
+		if len(result.Hits) < bleveScanSize {
+			break
+		}
+	}
+
+	for _, doc := range docs {
+		err := batch.Index(doc.Key(), &bleveDocument{
+			UserID:      doc.UserID,
+			Kind:        doc.Kind,
+			TargetID:    strconv.FormatUint(doc.TargetID, 10),
+			StepID:      strconv.FormatUint(doc.StepID, 10),
+			CommentRole: doc.CommentRole,
+			Title:       doc.Title,
+			Content:     doc.Content,
+			CreatedAt:   doc.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return i.index.Batch(batch)
+}
+
+func (i *bleveSearchIndex) Search(ctx context.Context, q *objects.SearchQuery) ([]*objects.SearchHit, int, error) {
+	userQuery := bleve.NewTermQuery(q.UserID)
+	userQuery.SetField("user_id")
+
+	titleQuery := bleve.NewMatchQuery(q.Query)
+	titleQuery.SetField("title")
+	contentQuery := bleve.NewMatchQuery(q.Query)
+	contentQuery.SetField("content")
+
+	queries := []query.Query{userQuery, bleve.NewDisjunctionQuery(titleQuery, contentQuery)}
+	if len(q.Kinds) > 0 {
+		kindQueries := make([]query.Query, len(q.Kinds))
+		for j, kind := range q.Kinds {
+			kindQuery := bleve.NewTermQuery(kind)
+			kindQuery.SetField("kind")
+			kindQueries[j] = kindQuery
+		}
+		queries = append(queries, bleve.NewDisjunctionQuery(kindQueries...))
+	}
+	if q.Tagged != nil {
+		var taggedQueries []query.Query
+		for _, targetID := range q.Tagged.TargetIDs {
+			targetQuery := bleve.NewTermQuery(strconv.FormatUint(targetID, 10))
+			targetQuery.SetField("target_id")
+			taggedQueries = append(taggedQueries, targetQuery)
+		}
+		for _, stepID := range q.Tagged.StepIDs {
+			stepQuery := bleve.NewTermQuery(strconv.FormatUint(stepID, 10))
+			stepQuery.SetField("step_id")
+			taggedQueries = append(taggedQueries, stepQuery)
+		}
+		queries = append(queries, bleve.NewDisjunctionQuery(taggedQueries...))
+	}
+
+	req := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(queries...), q.Limit, q.Offset, false)
+	req.Fields = []string{"*"}
+	result, err := i.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hits := make([]*objects.SearchHit, len(result.Hits))
+	for j, hit := range result.Hits {
+		field := func(name string) string {
+			value, _ := hit.Fields[name].(string)
+			return value
+		}
+		targetID, _ := strconv.ParseUint(field("target_id"), 10, 64)
+		stepID, _ := strconv.ParseUint(field("step_id"), 10, 64)
+		createdAt, _ := hit.Fields["created_at"].(float64)
+
+		hits[j] = &objects.SearchHit{
+			Document: &objects.SearchDocument{
+				UserID:      field("user_id"),
+				Kind:        field("kind"),
+				TargetID:    targetID,
+				StepID:      stepID,
+				CommentRole: field("comment_role"),
+				Title:       field("title"),
+				Content:     field("content"),
+				CreatedAt:   int64(createdAt),
+			},
+			Score: hit.Score,
+		}
+	}
+
+	return hits, int(result.Total), nil
+}
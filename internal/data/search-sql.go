@@ -0,0 +1,307 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"step/internal/data/ent"
+	"step/internal/data/ent/searchdocument"
+	"step/internal/objects"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// MySQL全文索引，使用ngram分词以支持中文
+	searchFulltextIndex = "search_documents_fulltext"
+	// SQLite FTS5虚拟表，rowid与search_documents.id相同
+	searchFtsTable = "search_documents_fts"
+	// trigram分词最短可以匹配3个字符，更短的搜索词使用LIKE
+	searchFtsMinRunes = 3
+	// 每次批量写入的文档数
+	searchBulkSize = 100
+)
+
+// sqlSearchIndex 基于数据库全文索引的搜索，文档保存在search_documents表中
+type sqlSearchIndex struct {
+	data    *Data
+	log     *log.Helper
+	dialect string
+}
+
+func newSqlSearchIndex(ctx context.Context, data *Data, driver string, logger log.Logger) (*sqlSearchIndex, error) {
+	index := &sqlSearchIndex{
+		data:    data,
+		log:     log.NewHelper(logger, log.WithMessageKey("sqlSearchIndex")),
+		dialect: driver,
+	}
+
+	err := index.init(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// init 全文索引不能由ent迁移创建，启动时检查并创建
+func (i *sqlSearchIndex) init(ctx context.Context) error {
+	client := i.data.ent_client
+	switch i.dialect {
+	case dialect.MySQL:
+		rows, err := client.QueryContext(ctx,
+			"SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+			searchdocument.Table, searchFulltextIndex,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var count int
+		if rows.Next() {
+			err = rows.Scan(&count)
+			if err != nil {
+				return err
+			}
+		}
+		if count > 0 {
+			return nil
+		}
+
+		_, err = client.ExecContext(ctx, fmt.Sprintf(
+			"ALTER TABLE `%s` ADD FULLTEXT INDEX `%s` (`%s`, `%s`) WITH PARSER ngram",
+			searchdocument.Table, searchFulltextIndex, searchdocument.FieldTitle, searchdocument.FieldContent,
+		))
+		return err
+	case dialect.SQLite:
+		_, err := client.ExecContext(ctx, fmt.Sprintf(
+			"CREATE VIRTUAL TABLE IF NOT EXISTS %s USING fts5(%s, %s, tokenize = 'trigram')",
+			searchFtsTable, searchdocument.FieldTitle, searchdocument.FieldContent,
+		))
+		return err
+	default:
+		return fmt.Errorf("search engine %s does not support %s", objects.SearchEngineSql, i.dialect)
+	}
+}
+
+func (i *sqlSearchIndex) ReplaceTarget(ctx context.Context, targetID uint64, docs []*objects.SearchDocument) error {
+	tx, err := i.data.ent_client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = i.replaceTarget(ctx, tx, targetID, docs)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			i.log.Errorf("rollback error: %v", rerr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (i *sqlSearchIndex) replaceTarget(ctx context.Context, tx *ent.Tx, targetID uint64, docs []*objects.SearchDocument) error {
+	oldIDs, err := tx.SearchDocument.Query().
+		Where(searchdocument.TargetID(targetID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(oldIDs) > 0 {
+		_, err = tx.SearchDocument.Delete().
+			Where(searchdocument.IDIn(oldIDs...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if i.dialect == dialect.SQLite {
+			args := make([]any, len(oldIDs))
+			for j, id := range oldIDs {
+				args[j] = id
+			}
+			_, err = tx.ExecContext(ctx, fmt.Sprintf(
+				"DELETE FROM %s WHERE rowid IN (%s)",
+				searchFtsTable, strings.TrimSuffix(strings.Repeat("?, ", len(oldIDs)), ", "),
+			), args...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for start := 0; start < len(docs); start += searchBulkSize {
+		chunk := docs[start:min(start+searchBulkSize, len(docs))]
+		builders := make([]*ent.SearchDocumentCreate, len(chunk))
+		for j, doc := range chunk {
+			builders[j] = tx.SearchDocument.Create().
+				SetUserID(doc.UserID).
+				SetKind(searchdocument.Kind(doc.Kind)).
+				SetTargetID(doc.TargetID).
+				SetStepID(doc.StepID).
+				SetCommentRole(doc.CommentRole).
+				SetTitle(doc.Title).
+				SetContent(doc.Content).
+				SetCreatedAt(doc.CreatedAt)
+		}
+
+		created, err := tx.SearchDocument.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if i.dialect == dialect.SQLite {
+			for _, doc := range created {
+				_, err = tx.ExecContext(ctx, fmt.Sprintf(
+					"INSERT INTO %s (rowid, %s, %s) VALUES (?, ?, ?)",
+					searchFtsTable, searchdocument.FieldTitle, searchdocument.FieldContent,
+				), doc.ID, doc.Title, doc.Content)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (i *sqlSearchIndex) Search(ctx context.Context, q *objects.SearchQuery) ([]*objects.SearchHit, int, error) {
+	query := i.data.ent_client.SearchDocument.Query().
+		Where(searchdocument.UserID(q.UserID))
+
+	if len(q.Kinds) > 0 {
+		kinds := make([]searchdocument.Kind, len(q.Kinds))
+		for j, kind := range q.Kinds {
+			kinds[j] = searchdocument.Kind(kind)
+		}
+		query = query.Where(searchdocument.KindIn(kinds...))
+	}
+
+	if q.Tagged != nil {
+		query = query.Where(searchdocument.Or(
+			searchdocument.TargetIDIn(q.Tagged.TargetIDs...),
+			searchdocument.StepIDIn(q.Tagged.StepIDs...),
+		))
+	}
+
+	var score func(s *sql.Selector) sql.Querier
+	switch i.dialect {
+	case dialect.MySQL:
+		match := func(s *sql.Selector) *sql.Predicate {
+			return sql.ExprP(fmt.Sprintf(
+				"MATCH(%s, %s) AGAINST (? IN NATURAL LANGUAGE MODE)",
+				s.C(searchdocument.FieldTitle), s.C(searchdocument.FieldContent),
+			), q.Query)
+		}
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(match(s))
+		})
+		score = func(s *sql.Selector) sql.Querier {
+			return match(s)
+		}
+	case dialect.SQLite:
+		var ftsTerms []string
+		for _, term := range objects.SearchTerms(q.Query) {
+			if utf8.RuneCountInString(term) >= searchFtsMinRunes {
+				// 作为短语匹配，避免搜索词中的FTS5语法
+				ftsTerms = append(ftsTerms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+				continue
+			}
+			pattern := "%" + escapeLike(term) + "%"
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sql.ExprP(fmt.Sprintf(
+					"(%s LIKE ? ESCAPE '\\' OR %s LIKE ? ESCAPE '\\')",
+					s.C(searchdocument.FieldTitle), s.C(searchdocument.FieldContent),
+				), pattern, pattern))
+			})
+		}
+
+		if len(ftsTerms) > 0 {
+			match := strings.Join(ftsTerms, " ")
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sql.ExprP(fmt.Sprintf(
+					"%s IN (SELECT rowid FROM %s WHERE %s MATCH ?)",
+					s.C(searchdocument.FieldID), searchFtsTable, searchFtsTable,
+				), match))
+			})
+			// bm25越小越相关
+			score = func(s *sql.Selector) sql.Querier {
+				return sql.ExprP(fmt.Sprintf(
+					"(SELECT -bm25(%s) FROM %s WHERE %s MATCH ? AND rowid = %s)",
+					searchFtsTable, searchFtsTable, searchFtsTable, s.C(searchdocument.FieldID),
+				), match)
+			}
+		}
+	default:
+		return nil, 0, fmt.Errorf("search engine %s does not support %s", objects.SearchEngineSql, i.dialect)
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	docs, err := query.
+		Modify(func(s *sql.Selector) {
+			if score != nil {
+				s.AppendSelectExprAs(score(s), "score")
+				s.OrderBy(sql.Desc("score"))
+			}
+			s.OrderBy(sql.Desc(s.C(searchdocument.FieldID)))
+		}).
+		Offset(q.Offset).
+		Limit(q.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hits := make([]*objects.SearchHit, len(docs))
+	for j, doc := range docs {
+		hits[j] = &objects.SearchHit{
+			Document: &objects.SearchDocument{
+				UserID:      doc.UserID,
+				Kind:        doc.Kind.String(),
+				TargetID:    doc.TargetID,
+				StepID:      doc.StepID,
+				CommentRole: doc.CommentRole,
+				Title:       doc.Title,
+				Content:     doc.Content,
+				CreatedAt:   doc.CreatedAt,
+			},
+		}
+		if score != nil {
+			value, err := doc.Value("score")
+			if err == nil {
+				hits[j].Score = searchScore(value)
+			}
+		}
+	}
+
+	return hits, total, nil
+}
+
+// searchScore 不同驱动返回的计算列类型不同
+func searchScore(value any) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	case []byte:
+		score, _ := strconv.ParseFloat(string(v), 64)
+		return score
+	}
+	return 0
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
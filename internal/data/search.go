@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"step/internal/biz"
+	"step/internal/conf"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	"step/internal/objects"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type searchRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSearchRepo .
+func NewSearchRepo(data *Data, logger log.Logger) biz.SearchRepo {
+	return &searchRepo{
+		data: data,
+		log:  log.NewHelper(logger, log.WithMessageKey("searchRepo")),
+	}
+}
+
+// NewSearchIndex 根据配置选择搜索引擎，默认使用数据库的全文索引
+func NewSearchIndex(c *conf.Data, data *Data, logger log.Logger) (biz.SearchIndex, func(), error) {
+	engine := objects.SearchEngineSql
+	if c.Search != nil && c.Search.Engine != "" {
+		engine = c.Search.Engine
+	}
+
+	switch engine {
+	case objects.SearchEngineSql:
+		index, err := newSqlSearchIndex(context.Background(), data, c.Database.Driver, logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		return index, func() {}, nil
+	case objects.SearchEngineBleve:
+		if c.Search.BlevePath == "" {
+			return nil, nil, fmt.Errorf("bleve_path is required for search engine %s", engine)
+		}
+		return newBleveSearchIndex(c.Search.BlevePath, logger)
+	default:
+		return nil, nil, fmt.Errorf("unsupported search engine: %s", engine)
+	}
+}
+
+func (r *searchRepo) TargetDocuments(ctx context.Context, targetID uint64, stepID uint64) (uint64, []*objects.SearchDocument, error) {
+	if targetID == 0 {
+		step, err := r.data.ent_client.Step.Get(ctx, stepID)
+		if err != nil {
+			// 积累已删除时无法找到目标，删除时会按目标更新
+			if ent.IsNotFound(err) {
+				return 0, nil, nil
+			}
+			return 0, nil, err
+		}
+		targetID = step.RefTargetID
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, targetID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return targetID, nil, nil
+		}
+		return 0, nil, err
+	}
+
+	docs := []*objects.SearchDocument{
+		{
+			UserID:    target.UserID,
+			Kind:      objects.SearchKindTarget,
+			TargetID:  target.ID,
+			Title:     target.Title,
+			Content:   target.Description,
+			CreatedAt: target.CreatedAt,
+		},
+	}
+
+	steps, err := r.data.ent_client.Step.Query().
+		Where(entStep.RefTargetID(target.ID)).
+		All(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	stepIDs := make([]uint64, len(steps))
+	for i, step := range steps {
+		stepIDs[i] = step.ID
+	}
+	threads, err := stepCommentThreads(ctx, r.data.ent_client, stepIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, step := range steps {
+		content := joinSearchText(step.Description, step.Content, step.LinkTitle, step.LinkDescription, step.URL)
+		if step.Title != "" || content != "" {
+			docs = append(docs, &objects.SearchDocument{
+				UserID:    target.UserID,
+				Kind:      objects.SearchKindStep,
+				TargetID:  target.ID,
+				StepID:    step.ID,
+				Title:     step.Title,
+				Content:   content,
+				CreatedAt: step.CreatedAt,
+			})
+		}
+
+		for _, thread := range threads[step.ID] {
+			text := thread.text()
+			if text == "" {
+				continue
+			}
+			docs = append(docs, &objects.SearchDocument{
+				UserID:      target.UserID,
+				Kind:        objects.SearchKindComment,
+				TargetID:    target.ID,
+				StepID:      step.ID,
+				CommentID:   thread.comment.ID,
+				CommentRole: thread.comment.Role.String(),
+				Title:       step.Title,
+				Content:     text,
+				CreatedAt:   thread.comment.CreatedAt,
+			})
+		}
+	}
+
+	return target.ID, docs, nil
+}
+
+func joinSearchText(texts ...string) string {
+	var parts []string
+	for _, text := range texts {
+		if text = strings.TrimSpace(text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
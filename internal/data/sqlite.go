@@ -0,0 +1,14 @@
+package data
+
+import (
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	"modernc.org/sqlite"
+)
+
+// 使用纯Go的SQLite驱动(内置FTS5)，注册为ent使用的驱动名sqlite3，
+// 连接串如: file:step.db?_pragma=foreign_keys(1)
+func init() {
+	sql.Register(dialect.SQLite, &sqlite.Driver{})
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"step/internal/biz"
 	"step/internal/data/ent"
+	"step/internal/data/ent/comment"
 	"step/internal/data/ent/portrait"
 	"step/internal/data/ent/step"
 	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
 	"step/internal/data/ent/target"
 	"step/internal/objects"
 	"time"
@@ -226,82 +228,318 @@ func (r statisticsRepo) HandleStepCreate(ctx context.Context, task *asynq.Task)
 		return "", nil, err
 	}
 
-	portraitBasic, err := r.data.ent_client.Portrait.Query().
-		Where(portrait.UserID(t.UserID), portrait.DimensionEQ(portrait.DimensionBasic)).
-		Only(ctx)
+	tx, err := r.data.ent_client.Tx(ctx)
 	if err != nil {
 		return "", nil, err
 	}
+	counted, err := r.applyStepCreate(ctx, tx.Client(), s, t, topTarget)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("HandleStepCreate rollback: %v", rerr)
+		}
+		return "", nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return "", nil, err
+	}
+	if !counted {
+		return t.UserID, nil, nil
+	}
+
+	return t.UserID,
+		[]*objects.PortraitchangeType{
+			{Type: "portrait", Scope: []string{portrait.DimensionBasic.String(), portrait.DimensionSelfDiscipline.String()}},
+		}, nil
+}
+
+// applyStepCreate 在同一个事务中累加画像并写入StepRate，StepRate已标记计入时跳过，任务重试和导入重做时不会重复累加
+func (r statisticsRepo) applyStepCreate(ctx context.Context, client *ent.Client, s *ent.Step, t, topTarget *ent.Target) (counted bool, err error) {
+	// 锁住积累，和评价统计串行，评价先建的StepRate在这里能读到
+	_, err = client.Step.Query().
+		Where(step.ID(s.ID)).
+		Modify(lockForUpdate).
+		Only(ctx)
+	if err != nil {
+		return false, err
+	}
+	stepRate, err := client.StepRate.Query().
+		Where(steprate.StepID(s.ID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, err
+	}
+	if stepRate != nil && stepRate.CreateCounted {
+		r.log.Infof("HandleStepCreate step %d already counted", s.ID)
+		return false, nil
+	}
+
+	basic := make(map[string]int)
 
 	// 查询是否是第一次创建
-	stepFirst, err := r.data.ent_client.Step.Query().
+	stepFirst, err := client.Step.Query().
 		Where(step.RefTargetIDEQ(t.ID), step.TypeNEQ(step.TypeDir)).
-		Order(ent.Asc(step.FieldCreatedAt)).
+		Order(ent.Asc(step.FieldCreatedAt), ent.Asc(step.FieldID)).
 		First(ctx)
 	if err != nil {
-		return "", nil, err
+		return false, err
 	}
-	if stepFirst.ID == payload.StepID {
+	if stepFirst.ID == s.ID {
 		// 第一次创建，根据目标设定时间和第一次打卡时间算出需要增加的果断值（0-10）。
-		targetCreateTime := s.Edges.Target.CreatedAt
+		targetCreateTime := t.CreatedAt
 		stepFirstTime := stepFirst.CreatedAt
 		duration := stepFirstTime - targetCreateTime
 		// 一天内，增加10分， 两天内，增加5分， 三天内，增加2分， 超过3天，增加0分
 		durationDays := decimal.NewFromInt(duration).Div(decimal.NewFromInt(24 * 3600))
 		if durationDays.LessThanOrEqual(decimal.NewFromInt(1)) {
-			portraitBasic.Value[objects.PortraitBasicDecisiveness] = cast.ToInt(portraitBasic.Value[objects.PortraitBasicDecisiveness]) + 10
+			basic[objects.PortraitBasicDecisiveness] = 10
 		} else if durationDays.LessThanOrEqual(decimal.NewFromInt(2)) {
-			portraitBasic.Value[objects.PortraitBasicDecisiveness] = cast.ToInt(portraitBasic.Value[objects.PortraitBasicDecisiveness]) + 5
+			basic[objects.PortraitBasicDecisiveness] = 5
 		} else if durationDays.LessThanOrEqual(decimal.NewFromInt(3)) {
-			portraitBasic.Value[objects.PortraitBasicDecisiveness] = cast.ToInt(portraitBasic.Value[objects.PortraitBasicDecisiveness]) + 2
+			basic[objects.PortraitBasicDecisiveness] = 2
 		}
 	}
 
 	if s.IsChallenge {
 		// 挑战性的，增加毅力值1。
-		portraitBasic.Value[objects.PortraitBasicPerseverance] = cast.ToInt(portraitBasic.Value[objects.PortraitBasicPerseverance]) + 1
+		basic[objects.PortraitBasicPerseverance] = 1
 	} else {
 		// 非挑战性的，增加耐心值1。
-		portraitBasic.Value[objects.PortraitBasicPatience] = cast.ToInt(portraitBasic.Value[objects.PortraitBasicPatience]) + 1
+		basic[objects.PortraitBasicPatience] = 1
 	}
 
-	_, err = r.data.ent_client.Portrait.UpdateOneID(portraitBasic.ID).
-		SetValue(portraitBasic.Value).
-		Save(ctx)
+	err = addPortraitValues(ctx, client, t.UserID, portrait.DimensionBasic, basic)
 	if err != nil {
-		return "", nil, err
+		return false, err
 	}
 
+	// 需要同步在step_rates表中新建打卡记录，weighted_value设置为默认值0；评价先处理时记录已经存在，只标记为已计入
+	if stepRate != nil {
+		err = client.StepRate.UpdateOneID(stepRate.ID).
+			SetCreateCounted(true).
+			Exec(ctx)
+	} else {
+		err = createStepRate(client, s, t.ID, topTarget).
+			Exec(ctx)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// 算出打卡频率和持续性指标
+	// TODO: 打卡频率
+	// 持续性按目标的打卡周期计算，连续几个周期就增加几，最大10；同一天的多次打卡只计算一次
+	// 只看按(created_at, id)排在这个积累之前的打卡，导入历史积累或任务乱序时结果和按顺序创建一致
+	var createdAts []int64
+	err = client.Step.Query().
+		Where(
+			step.RefTargetIDEQ(t.ID),
+			step.TypeNEQ(step.TypeDir),
+			step.Or(
+				step.CreatedAtLT(s.CreatedAt),
+				step.And(step.CreatedAtEQ(s.CreatedAt), step.IDLT(s.ID)),
+			),
+		).
+		Select(step.FieldCreatedAt).
+		Scan(ctx, &createdAts)
+	if err != nil {
+		return false, err
+	}
 	// unix时间戳转时间
 	stepTime := time.Unix(s.CreatedAt, 0).Local()
-	// 需要同步在step_rates表中新建打卡记录，weighted_value设置为默认值1
-	_, err = r.data.ent_client.StepRate.Create().
-		SetUserID(t.UserID).
-		SetTopTargetID(topTarget.ID).
-		SetTargetID(s.Edges.Target.ID).
-		SetStepID(s.ID).
-		SetWeightedValue(0).
-		SetTargetReasonableness(0).
-		SetTargetClarity(0).
-		SetTargetAchievement(0).
-		SetReflectionImprovement(0).
-		SetInnovation(0).
-		SetBasicReliability(0).
-		SetSkillImprovement(0).
-		SetDifficulty(0).
-		SetDate(stepTime).
-		Save(ctx)
+	checkins := make([]time.Time, 0, len(createdAts)+1)
+	sameDay := false
+	for _, createdAt := range createdAts {
+		checkin := time.Unix(createdAt, 0).Local()
+		sameDay = sameDay || objects.Day(checkin).Equal(objects.Day(stepTime))
+		checkins = append(checkins, checkin)
+	}
+	checkins = append(checkins, stepTime)
+	if !sameDay {
+		streak := targetSchedule(t).Streak(checkins, stepTime)
+		err = addPortraitValues(ctx, client, t.UserID, portrait.DimensionSelfDiscipline, map[string]int{
+			objects.PortraitSelfDisciplineConsistency: min(streak, 10),
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// addSelfDisciplineConsistency 单独的事务中修改连续性值
+func (r statisticsRepo) addSelfDisciplineConsistency(ctx context.Context, userID string, delta int) error {
+	tx, err := r.data.ent_client.Tx(ctx)
 	if err != nil {
-		return "", nil, err
+		return err
 	}
+	err = addPortraitValues(ctx, tx.Client(), userID, portrait.DimensionSelfDiscipline, map[string]int{
+		objects.PortraitSelfDisciplineConsistency: delta,
+	})
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("addSelfDisciplineConsistency rollback: %v", rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
 
-	// 算出打卡频率和持续性指标
-	// TODO:
+// addPortraitValues 锁住画像记录后累加各指标，和其他统计任务同时修改画像时不会覆盖
+func addPortraitValues(ctx context.Context, client *ent.Client, userID string, dimension portrait.Dimension, change map[string]int) error {
+	for key, value := range change {
+		if value == 0 {
+			delete(change, key)
+		}
+	}
+	if len(change) == 0 {
+		return nil
+	}
 
-	return t.UserID,
-	[]*objects.PortraitchangeType{
-		{Type: "portrait", Scope: []string{portrait.DimensionBasic.String()}},
-	}, nil
+	p, err := client.Portrait.Query().
+		Where(portrait.UserID(userID), portrait.DimensionEQ(dimension)).
+		Modify(lockForUpdate).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if p.Value == nil {
+		p.Value = make(map[string]any)
+	}
+	for key, value := range change {
+		p.Value[key] = cast.ToInt(p.Value[key]) + value
+	}
+	return client.Portrait.UpdateOneID(p.ID).
+		SetValue(p.Value).
+		Exec(ctx)
+}
+
+// 进行中的目标，都需要按打卡周期检查
+func (r statisticsRepo) getActiveTargets(ctx context.Context) ([]*ent.Target, error) {
+	return r.data.ent_client.Target.Query().
+		Where(target.StatusIn(target.StatusStep, target.StatusStepHard)).
+		All(ctx)
+}
+
+// ● 每天检查前一天的打卡情况，按目标的打卡周期，漏掉几次就减少几个连续性值；休息日不计
+// ● 没有设定打卡周期的目标，超过3天没有打卡，则减少连续性值1
+func (r statisticsRepo) HandleTargetMissedCheckin(ctx context.Context, task *asynq.Task) (uids []string, err error) {
+	var payload objects.TargetMissedCheckinPayload
+	if len(task.Payload()) > 0 {
+		err = json.Unmarshal(task.Payload(), &payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	day := objects.Day(time.Now().Local()).AddDate(0, 0, -1)
+	if payload.Date != "" {
+		day, err = time.ParseInLocation("2006-01-02", payload.Date, time.Local)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targets, err := r.getActiveTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	missed := make(map[string]int)
+	for _, t := range targets {
+		// 目标开始之前的日期不计
+		if t.StartAt == 0 || objects.Day(time.Unix(t.StartAt, 0).Local()).After(day) {
+			continue
+		}
+
+		checkins, err := r.stepRepo.GetTargetCheckins(ctx, t.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		schedule := targetSchedule(t)
+		if schedule.IsRecurring() {
+			missed[t.UserID] += schedule.MissedOn(checkins, day)
+			continue
+		}
+
+		// 没有打卡周期的，只在第4天没打卡时扣一次
+		var last time.Time
+		for _, checkin := range checkins {
+			if checkin.After(last) && !objects.Day(checkin).After(day) {
+				last = checkin
+			}
+		}
+		if !last.IsZero() && objects.Day(last).AddDate(0, 0, 4).Equal(day) {
+			missed[t.UserID]++
+		}
+	}
+
+	for userID, count := range missed {
+		if count == 0 {
+			continue
+		}
+
+		err = r.CheckStatistics(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.addSelfDisciplineConsistency(ctx, userID, -count)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, userID)
+	}
+
+	return uids, nil
+}
+
+// 按打卡周期找出当天还需要打卡的目标，日期为空时为今天
+func (r statisticsRepo) HandleTargetCheckinReminder(ctx context.Context, task *asynq.Task) (reminders []*objects.CheckinReminder, err error) {
+	var payload objects.TargetCheckinReminderPayload
+	if len(task.Payload()) > 0 {
+		err = json.Unmarshal(task.Payload(), &payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	today := time.Now().Local()
+	if payload.Date != "" {
+		today, err = time.ParseInLocation("2006-01-02", payload.Date, time.Local)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targets, err := r.getActiveTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range targets {
+		schedule := targetSchedule(t)
+		if !schedule.IsRecurring() {
+			continue
+		}
+
+		checkins, err := r.stepRepo.GetTargetCheckins(ctx, t.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if schedule.NeedsReminder(checkins, today) {
+			reminders = append(reminders, &objects.CheckinReminder{
+				UserID:   t.UserID,
+				TargetID: t.ID,
+				Title:    t.Title,
+			})
+		}
+	}
+
+	return reminders, nil
 }
 
 // 负面评价：评价在0-10之间，但是允许存在负面评价，所以需要统一-5，即5分为中间值，低于5分为负面评价。
@@ -331,131 +569,207 @@ func (r statisticsRepo) HandleStepComment(ctx context.Context, task *asynq.Task)
 		return "", nil, err
 	}
 
-	s, err := r.data.ent_client.Step.Query().
-		Where(step.ID(payload.StepID)).
-		Only(ctx)
+	tx, err := r.data.ent_client.Tx(ctx)
 	if err != nil {
 		return "", nil, err
 	}
-
-	// 获取评论
-	var comment *objects.Comment
-	var commentMap map[string]any
-	if payload.CommentType == "teacher" {
-		commentMap = s.TeacherComment
-	} else if payload.CommentType == "parent" {
-		commentMap = s.ParentComment
-	} else if payload.CommentType == "friend" {
-		commentMap = s.FriendComment
-	}
-	comment, err = objects.NewComment(commentMap)
+	err = r.applyStepComment(ctx, tx.Client(), payload.StepID, t, topTarget)
 	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return "", nil, rerr
+		}
 		return "", nil, err
 	}
-
-	if comment == nil {
-		return "", nil, fmt.Errorf("comment is nil")
+	err = tx.Commit()
+	if err != nil {
+		return "", nil, err
 	}
 
-	weightedValue := comment.Data.WeightedValue - 5
-	targetReasonableness := comment.Data.Target.TargetReasonableness - 5
-	targetClarity := comment.Data.Target.TargetClarity - 5
-	targetAchievement := comment.Data.Target.TargetAchievement - 5
-	reflectionImprovement := comment.Data.Quality.ReflectionImprovement - 5
-	innovation := comment.Data.Quality.Innovation - 5
-	basicReliability := comment.Data.Quality.BasicReliability - 5
-	skillImprovement := comment.Data.Quality.SkillImprovement - 5
-	difficulty := comment.Data.Quality.Difficulty - 5
+	return t.UserID, 
+	[]*objects.PortraitchangeType{
+		{Type: "portrait", Scope: []string{
+			portrait.DimensionSelfDiscipline.String(),
+			portrait.DimensionTargetAndExecution.String(),
+			portrait.DimensionLearningAndGrowth.String(),
+		}},
+		{Type: "target", Scope: []string{cast.ToString(topTarget.ID)}},
+	}, nil
+}
 
-	// 更新step_rates表
-	_, err = r.data.ent_client.StepRate.Update().
-		Where(steprate.StepID(payload.StepID)).
-		AddWeightedValue(weightedValue).
-		AddTargetReasonableness(targetReasonableness).
-		AddTargetClarity(targetClarity).
-		AddTargetAchievement(targetAchievement).
-		AddReflectionImprovement(reflectionImprovement).
-		AddInnovation(innovation).
-		AddBasicReliability(basicReliability).
-		AddSkillImprovement(skillImprovement).
-		AddDifficulty(difficulty).
-		Save(ctx)
+// applyStepComment 在同一个事务中重新计算StepRate并把变化量累加到画像
+func (r statisticsRepo) applyStepComment(ctx context.Context, client *ent.Client, stepID uint64, t, topTarget *ent.Target) error {
+	userID, rubric := t.UserID, topTarget.Rubric
+	// 锁住积累，同一积累的评分变化串行计算，读到的评分和StepRate一致
+	s, err := client.Step.Query().
+		Where(step.ID(stepID)).
+		Modify(lockForUpdate).
+		Only(ctx)
 	if err != nil {
-		return "", nil, err
+		return err
+	}
+	// 目录没有StepRate，评分不计入画像
+	if s.Type == step.TypeDir {
+		return nil
 	}
 
-	// 更新portrait表，自律性
-	if s.IsChallenge {
-		// 挑战心态（迎难而上，不惧困难）
-		portraitSelfDiscipline, err := r.data.ent_client.Portrait.Query().
-			Where(portrait.UserID(t.UserID), portrait.DimensionEQ(portrait.DimensionSelfDiscipline)).
-			Only(ctx)
+	// 根据积累当前所有的评分重新计算StepRate，新增和修改评分都只把变化量累加到画像
+	ratings, err := client.Comment.Query().
+		Where(comment.StepID(stepID), comment.ParentIDIsNil(), comment.RatingNotNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	// 自评计入StepRate，画像只计入其中的困难度，单独保存
+	var scores, selfScores objects.CommentScores
+	criteria := make(map[string]float64)
+	for _, rating := range ratings {
+		// 评分在提交时已经校验，导入的旧数据或修改评分标准前的评分不符合时跳过
+		parsed, err := objects.ParseTargetRating(rating.Rating, rubric)
 		if err != nil {
-			return "", nil, err
+			r.log.Warnf("HandleStepComment skip comment %d: %v", rating.ID, err)
+			continue
 		}
-		portraitSelfDiscipline.Value[objects.PortraitSelfDisciplineChallengeAttitude] =
-			cast.ToInt(portraitSelfDiscipline.Value[objects.PortraitSelfDisciplineChallengeAttitude]) + int(difficulty)
-		_, err = r.data.ent_client.Portrait.UpdateOneID(portraitSelfDiscipline.ID).
-			SetValue(portraitSelfDiscipline.Value).
-			Save(ctx)
-		if err != nil {
-			return "", nil, err
+		// 自评按自己的权重计入
+		weight := objects.RatingWeight(rating.Role.String())
+		scaled := parsed.Scores().Scale(weight)
+		scores = scores.Add(scaled)
+		if rating.Role == comment.RoleStudent {
+			selfScores = selfScores.Add(scaled)
+		}
+		for key, value := range parsed.CriteriaScores() {
+			criteria[key] += value * weight
 		}
 	}
 
-	// 更新portrait表，目标设定与执行能力
-	portraitTargetAndExecution, err := r.data.ent_client.Portrait.Query().
-		Where(portrait.UserID(t.UserID), portrait.DimensionEQ(portrait.DimensionTargetAndExecution)).
+	// 评价可能先于积累创建处理，先在同一个事务中建好StepRate，之后按已保存的评分计算变化量，积累创建时不再新建
+	stepRate, err := client.StepRate.Query().
+		Where(steprate.StepID(stepID)).
 		Only(ctx)
-	if err != nil {
-		return "", nil, err
+	if ent.IsNotFound(err) {
+		stepRate, err = createStepRate(client, s, t.ID, topTarget).
+			SetCreateCounted(false).
+			Save(ctx)
 	}
-	portraitTargetAndExecution.Value[objects.PortraitTargetAndExecutionGoalReasonableness] =
-		cast.ToInt(portraitTargetAndExecution.Value[objects.PortraitTargetAndExecutionGoalReasonableness]) + int(targetReasonableness)
-	portraitTargetAndExecution.Value[objects.PortraitTargetAndExecutionGoalClarity] =
-		cast.ToInt(portraitTargetAndExecution.Value[objects.PortraitTargetAndExecutionGoalClarity]) + int(targetClarity)
-	portraitTargetAndExecution.Value[objects.PortraitTargetAndExecutionGoalAchievement] =
-		cast.ToInt(portraitTargetAndExecution.Value[objects.PortraitTargetAndExecutionGoalAchievement]) + int(targetAchievement)
-	_, err = r.data.ent_client.Portrait.UpdateOneID(portraitTargetAndExecution.ID).
-		SetValue(portraitTargetAndExecution.Value).
+	if err != nil {
+		return err
+	}
+	previous := objects.CommentScores{
+		WeightedValue:         stepRate.WeightedValue,
+		TargetReasonableness:  stepRate.TargetReasonableness,
+		TargetClarity:         stepRate.TargetClarity,
+		TargetAchievement:     stepRate.TargetAchievement,
+		ReflectionImprovement: stepRate.ReflectionImprovement,
+		Innovation:            stepRate.Innovation,
+		BasicReliability:      stepRate.BasicReliability,
+		SkillImprovement:      stepRate.SkillImprovement,
+		Difficulty:            stepRate.Difficulty,
+	}
+	var previousSelf objects.CommentScores
+	if stepRate.SelfScores != nil {
+		previousSelf = *stepRate.SelfScores
+	}
+	selfDelta := selfScores.Sub(previousSelf)
+	reviewerDelta := scores.Sub(selfScores).Sub(previous.Sub(previousSelf))
+
+	// 更新step_rates表
+	_, err = client.StepRate.UpdateOneID(stepRate.ID).
+		SetWeightedValue(scores.WeightedValue).
+		SetTargetReasonableness(scores.TargetReasonableness).
+		SetTargetClarity(scores.TargetClarity).
+		SetTargetAchievement(scores.TargetAchievement).
+		SetReflectionImprovement(scores.ReflectionImprovement).
+		SetInnovation(scores.Innovation).
+		SetBasicReliability(scores.BasicReliability).
+		SetSkillImprovement(scores.SkillImprovement).
+		SetDifficulty(scores.Difficulty).
+		SetSelfScores(&selfScores).
 		Save(ctx)
 	if err != nil {
-		return "", nil, err
+		return err
 	}
 
-	// 更新portrait表，学习与成长能力
-	portraitLearningAndGrowth, err := r.data.ent_client.Portrait.Query().
-		Where(portrait.UserID(t.UserID), portrait.DimensionEQ(portrait.DimensionLearningAndGrowth)).
-		Only(ctx)
+	err = setStepRateCriteria(ctx, client, stepRate, criteria)
 	if err != nil {
-		return "", nil, err
+		return err
 	}
-	portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthReflectionAndImprovement] =
-		cast.ToInt(portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthReflectionAndImprovement]) + int(reflectionImprovement)
-	portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthInnovativeMethod] =
-		cast.ToInt(portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthInnovativeMethod]) + int(innovation)
-	portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthBasicSolid] =
-		cast.ToInt(portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthBasicSolid]) + int(basicReliability)
-	portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthSkillImprovement] =
-		cast.ToInt(portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthSkillImprovement]) + int(skillImprovement)
-	if s.IsChallenge {
-		portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthChallengeAttitude] =
-			cast.ToInt(portraitLearningAndGrowth.Value[objects.PortraitLearningAndGrowthChallengeAttitude]) + int(difficulty)
+
+	// 按评分标准中StepRate列和画像指标的对应关系累加评论人评分的变化量，
+	// 自评只有困难度计入挑战心态
+	changes := make(map[string]map[string]int)
+	addChange := func(column string, value int32) {
+		if value == 0 {
+			return
+		}
+		for _, p := range objects.RatingColumnPortraits[column] {
+			if p.ChallengeOnly && !s.IsChallenge {
+				continue
+			}
+			if changes[p.Dimension] == nil {
+				changes[p.Dimension] = make(map[string]int)
+			}
+			changes[p.Dimension][p.Key] += int(value)
+		}
 	}
-	_, err = r.data.ent_client.Portrait.UpdateOneID(portraitLearningAndGrowth.ID).
-		SetValue(portraitLearningAndGrowth.Value).
-		Save(ctx)
+	for column := range objects.RatingColumnPortraits {
+		addChange(column, reviewerDelta.Column(column))
+	}
+	addChange(objects.RatingColumnDifficulty, selfDelta.Difficulty)
+	for _, dimension := range []portrait.Dimension{
+		portrait.DimensionSelfDiscipline,
+		portrait.DimensionTargetAndExecution,
+		portrait.DimensionLearningAndGrowth,
+	} {
+		err = addPortraitValues(ctx, client, userID, dimension, changes[dimension.String()])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createStepRate 积累的打卡记录，评分都为0，由评价统计更新
+func createStepRate(client *ent.Client, s *ent.Step, targetID uint64, topTarget *ent.Target) *ent.StepRateCreate {
+	return client.StepRate.Create().
+		SetUserID(topTarget.UserID).
+		SetTopTargetID(topTarget.ID).
+		SetTargetID(targetID).
+		SetStepID(s.ID).
+		SetWeightedValue(0).
+		SetTargetReasonableness(0).
+		SetTargetClarity(0).
+		SetTargetAchievement(0).
+		SetReflectionImprovement(0).
+		SetInnovation(0).
+		SetBasicReliability(0).
+		SetSkillImprovement(0).
+		SetDifficulty(0).
+		SetMeasurement(s.Measurement).
+		SetDate(time.Unix(s.CreatedAt, 0).Local())
+}
+
+// setStepRateCriteria 自定义评价项保存在step_rate_criterions表，和StepRate一样保存所有评分的偏移之和
+func setStepRateCriteria(ctx context.Context, client *ent.Client, stepRate *ent.StepRate, criteria map[string]float64) error {
+	_, err := client.StepRateCriterion.Delete().
+		Where(stepratecriterion.StepID(stepRate.StepID)).
+		Exec(ctx)
 	if err != nil {
-		return "", nil, err
+		return err
+	}
+	if len(criteria) == 0 {
+		return nil
 	}
 
-	return t.UserID, 
-	[]*objects.PortraitchangeType{
-		{Type: "portrait", Scope: []string{
-			portrait.DimensionSelfDiscipline.String(),
-			portrait.DimensionTargetAndExecution.String(),
-			portrait.DimensionLearningAndGrowth.String(),
-		}},
-		{Type: "target", Scope: []string{cast.ToString(topTarget.ID)}},
-	}, nil
+	creates := make([]*ent.StepRateCriterionCreate, 0, len(criteria))
+	for key, value := range criteria {
+		creates = append(creates, client.StepRateCriterion.Create().
+			SetUserID(stepRate.UserID).
+			SetTopTargetID(stepRate.TopTargetID).
+			SetTargetID(stepRate.TargetID).
+			SetStepID(stepRate.StepID).
+			SetKey(key).
+			SetValue(value).
+			SetDate(stepRate.Date))
+	}
+	return client.StepRateCriterion.CreateBulk(creates...).Exec(ctx)
 }
@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/internal/utils"
+	"time"
+
+	stepApi "step/api/step/v1"
+)
+
+// activitySteps 当前用户所有目标在[start, end)内的积累(不含目录)
+func (r *stepRepo) activitySteps(uid string, start time.Time, end time.Time) *ent.StepQuery {
+	return r.data.ent_client.Step.Query().
+		Where(
+			entStep.HasTargetWith(entTarget.UserID(uid)),
+			entStep.TypeNEQ(entStep.TypeDir),
+			entStep.CreatedAtGTE(start.Unix()),
+			entStep.CreatedAtLT(end.Unix()),
+		)
+}
+
+// countActivity 按挑战和普通积累计数
+func countActivity(day *stepApi.ActivityDay, isChallenge bool) {
+	day.Total++
+	if isChallenge {
+		day.Challenge++
+	} else {
+		day.Normal++
+	}
+}
+
+func (r *stepRepo) GetActivityTimeline(ctx context.Context, req *stepApi.GetActivityTimelineRequest) (*stepApi.GetActivityTimelineReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	loc, err := objects.ActivityLocation(req.TimeZone)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+
+	start, end, err := objects.ActivityDateRange(req.StartDate, req.EndDate, loc)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+
+	steps, err := r.activitySteps(uid, start, end.AddDate(0, 0, 1)).
+		WithTags().
+		Order(ent.Desc(entStep.FieldCreatedAt), ent.Desc(entStep.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &stepApi.GetActivityTimelineReply{
+		StartDate: start.Format(time.DateOnly),
+		EndDate:   end.Format(time.DateOnly),
+		TimeZone:  loc.String(),
+		Days:      make([]*stepApi.ActivityDay, 0),
+	}
+
+	stepsApi, err := toApiSteps(ctx, r.minioRepo, r.data.minio_endpoint_remote, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	var day *stepApi.ActivityDay
+	for i, step := range steps {
+		date := objects.ActivityDate(step.CreatedAt, loc)
+		// 积累按时间倒序，日期变化时开始新的一天
+		if day == nil || day.Date != date {
+			day = &stepApi.ActivityDay{Date: date}
+			reply.Days = append(reply.Days, day)
+		}
+
+		day.Steps = append(day.Steps, stepsApi[i])
+		countActivity(day, step.IsChallenge)
+	}
+
+	for _, day := range reply.Days {
+		reply.Total += day.Total
+		reply.Challenge += day.Challenge
+		reply.Normal += day.Normal
+	}
+
+	return reply, nil
+}
+
+// GetActivityHeatmap 只查询时间和是否挑战，一年的积累在内存中按天计数
+func (r *stepRepo) GetActivityHeatmap(ctx context.Context, req *stepApi.GetActivityHeatmapRequest) (*stepApi.GetActivityHeatmapReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	loc, err := objects.ActivityLocation(req.TimeZone)
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+
+	year := int(req.Year)
+	if year == 0 {
+		year = time.Now().In(loc).Year()
+	}
+	if year < 1970 || year > 9999 {
+		return nil, stepApi.ErrorInvalidArgument("invalid year")
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(1, 0, 0)
+
+	var checkins []struct {
+		CreatedAt   int64 `json:"created_at"`
+		IsChallenge bool  `json:"is_challenge"`
+	}
+	err = r.activitySteps(uid, start, end).
+		Select(entStep.FieldCreatedAt, entStep.FieldIsChallenge).
+		Scan(ctx, &checkins)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &stepApi.GetActivityHeatmapReply{
+		Year:     int32(year),
+		TimeZone: loc.String(),
+		Days:     make([]*stepApi.ActivityDay, 0, 366),
+	}
+
+	days := make(map[string]*stepApi.ActivityDay)
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		day := &stepApi.ActivityDay{Date: date.Format(time.DateOnly)}
+		days[day.Date] = day
+		reply.Days = append(reply.Days, day)
+	}
+
+	for _, checkin := range checkins {
+		day, ok := days[objects.ActivityDate(checkin.CreatedAt, loc)]
+		if !ok {
+			continue
+		}
+		countActivity(day, checkin.IsChallenge)
+	}
+
+	for _, day := range reply.Days {
+		if day.Total == 0 {
+			continue
+		}
+		reply.Total += day.Total
+		reply.ActiveDays++
+		reply.MaxDayTotal = max(reply.MaxDayTotal, day.Total)
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/internal/utils"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/shopspring/decimal"
+)
+
+// SetSelfAssessment 自评是积累所有者的顶层评分评论，和老师、家长、朋友使用同一个评分标准
+func (r *stepRepo) SetSelfAssessment(ctx context.Context, req *stepApi.SetSelfAssessmentRequest) (*stepApi.SetSelfAssessmentReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	step, err := r.data.ent_client.Step.Query().Where(entStep.ID(req.Id)).WithTarget().First(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if step.Edges.Target == nil {
+		return nil, stepApi.ErrorTargetNotFound("can't find target of this step")
+	}
+	if step.Edges.Target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+	if step.Type == entStep.TypeDir {
+		return nil, stepApi.ErrorInvalidArgument("dir can't be assessed")
+	}
+
+	commentId, err := createRatedComment(ctx, r.data.ent_client, r.log, req.Id, objects.CommentRoleStudent, req.Comment, req.Content, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.SetSelfAssessmentReply{
+		Id:        req.Id,
+		CommentId: commentId,
+	}, nil
+}
+
+// assessmentStep 一个积累的自评和他评
+type assessmentStep struct {
+	self      *objects.Rating
+	reviewers []*assessmentRating
+}
+
+type assessmentRating struct {
+	role   string
+	rating *objects.Rating
+}
+
+// comparisonScope 对比的积累和它们所在的顶层目标，学生本人和关联的老师、家长可以查看
+func (r *stepRepo) comparisonScope(ctx context.Context, req *stepApi.GetSelfAssessmentComparisonRequest, uid string) ([]uint64, *ent.Target, error) {
+	client := r.data.ent_client
+	if req.StepId != 0 {
+		step, err := client.Step.Query().Where(entStep.ID(req.StepId)).WithTarget().First(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if step.Edges.Target == nil {
+			return nil, nil, stepApi.ErrorTargetNotFound("can't find target of this step")
+		}
+		_, err = studentViewerRole(ctx, client, step.Edges.Target.UserID, uid)
+		if err != nil {
+			return nil, nil, err
+		}
+		topTarget, err := topTargetOf(ctx, client, step.Edges.Target)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []uint64{step.ID}, topTarget, nil
+	}
+
+	target, err := client.Target.Get(ctx, req.TargetId)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = studentViewerRole(ctx, client, target.UserID, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	topTarget, err := topTargetOf(ctx, client, target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetIDs, err := client.Target.Query().
+		Where(entTarget.PathHasPrefix(objects.ChildPath(target.Path, target.ID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	stepIDs, err := client.Step.Query().
+		Where(entStep.RefTargetIDIn(append(targetIDs, target.ID)...), entStep.TypeNEQ(entStep.TypeDir)).
+		IDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stepIDs, topTarget, nil
+}
+
+func round2(value float64) float64 {
+	return decimal.NewFromFloat(value).Round(2).InexactFloat64()
+}
+
+// GetSelfAssessmentComparison 按顶层目标当前的评分标准逐项对比，只统计同时有自评和他评的积累
+func (r *stepRepo) GetSelfAssessmentComparison(ctx context.Context, req *stepApi.GetSelfAssessmentComparisonRequest) (*stepApi.GetSelfAssessmentComparisonReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if req.StepId == 0 && req.TargetId == 0 {
+		return nil, stepApi.ErrorInvalidArgument("step_id or target_id is required")
+	}
+
+	stepIDs, topTarget, err := r.comparisonScope(ctx, req, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	rubric := effectiveRubric(topTarget)
+	reply := &stepApi.GetSelfAssessmentComparisonReply{
+		StepId:        req.StepId,
+		TargetId:      req.TargetId,
+		RubricVersion: rubric.Version,
+	}
+	if len(stepIDs) == 0 {
+		return reply, nil
+	}
+
+	comments, err := r.data.ent_client.Comment.Query().
+		Where(entComment.StepIDIn(stepIDs...), entComment.ParentIDIsNil(), entComment.RatingNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make(map[uint64]*assessmentStep)
+	for _, comment := range comments {
+		// 修改评分标准前的评分不参与对比
+		rating, err := objects.ParseTargetRating(comment.Rating, topTarget.Rubric)
+		if err != nil {
+			continue
+		}
+		s, ok := steps[comment.StepID]
+		if !ok {
+			s = &assessmentStep{}
+			steps[comment.StepID] = s
+		}
+		if comment.Role == entComment.RoleStudent {
+			s.self = rating
+		} else {
+			s.reviewers = append(s.reviewers, &assessmentRating{role: comment.Role.String(), rating: rating})
+		}
+	}
+
+	compared := make([]*assessmentStep, 0, len(steps))
+	for _, s := range steps {
+		if s.self != nil && len(s.reviewers) > 0 {
+			compared = append(compared, s)
+		}
+	}
+	reply.StepCount = uint32(len(compared))
+
+	roles := []string{objects.CommentRoleTeacher, objects.CommentRoleParent, objects.CommentRoleFriend}
+	for _, dimension := range rubric.Dimensions {
+		var selfSum, reviewersSum float64
+		var count int
+		roleSums := make(map[string]float64)
+		roleCounts := make(map[string]uint32)
+		for _, s := range compared {
+			selfValue, ok := s.self.Value(dimension)
+			if !ok {
+				continue
+			}
+			var stepSum float64
+			var stepCount int
+			for _, reviewer := range s.reviewers {
+				value, ok := reviewer.rating.Value(dimension)
+				if !ok {
+					continue
+				}
+				stepSum += value
+				stepCount++
+				roleSums[reviewer.role] += value
+				roleCounts[reviewer.role]++
+			}
+			if stepCount == 0 {
+				continue
+			}
+			selfSum += selfValue
+			reviewersSum += stepSum / float64(stepCount)
+			count++
+		}
+
+		dimensionApi := &stepApi.SelfAssessmentDimension{
+			Key:   dimension.Key,
+			Title: dimension.Title,
+		}
+		if count > 0 {
+			dimensionApi.Self = round2(selfSum / float64(count))
+			dimensionApi.Reviewers = round2(reviewersSum / float64(count))
+			dimensionApi.Difference = round2((selfSum - reviewersSum) / float64(count))
+		}
+		for _, role := range roles {
+			if roleCounts[role] == 0 {
+				continue
+			}
+			dimensionApi.Roles = append(dimensionApi.Roles, &stepApi.SelfAssessmentRoleScore{
+				Role:  role,
+				Value: round2(roleSums[role] / float64(roleCounts[role])),
+				Count: roleCounts[role],
+			})
+		}
+		reply.Dimensions = append(reply.Dimensions, dimensionApi)
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,305 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/internal/utils"
+	"strings"
+	"time"
+
+	stepApi "step/api/step/v1"
+)
+
+// 需要复制对象存储文件的积累类型
+var mediaStepTypes = []entStep.Type{
+	entStep.TypeImage,
+	entStep.TypeVideo,
+	entStep.TypeAudio,
+	entStep.TypeDocument,
+}
+
+// targetCloner 在事务中复制目标树，记录新建的目标和需要复制文件的积累
+type targetCloner struct {
+	r            *stepRepo
+	tx           *ent.Tx
+	uid          string
+	now          int64
+	includeSteps bool
+	includeMedia bool
+	targetIDs    []uint64
+	steps        int
+	// 新积累ID -> 原积累，用于复制文件
+	mediaSteps map[uint64]*ent.Step
+}
+
+// cloneSteps 按父目录逐层复制，保证父目录先于子积累创建
+func (c *targetCloner) cloneSteps(ctx context.Context, from *ent.Target, toID uint64) error {
+	query := c.tx.Step.Query().Where(entStep.RefTargetID(from.ID))
+	if !c.includeSteps {
+		query = query.Where(entStep.TypeEQ(entStep.TypeDir))
+	} else if !c.includeMedia {
+		query = query.Where(entStep.TypeNotIn(mediaStepTypes...))
+	}
+
+	steps, err := query.Order(ent.Asc(entStep.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	children := make(map[uint64][]*ent.Step)
+	for _, step := range steps {
+		children[step.ParentID] = append(children[step.ParentID], step)
+	}
+
+	var clone func(oldParentID uint64, newParentID uint64, path string) error
+	clone = func(oldParentID uint64, newParentID uint64, path string) error {
+		for _, s := range children[oldParentID] {
+			createStep := c.tx.Step.Create().
+				SetRefTargetID(toID).
+				SetPath(path).
+				SetType(s.Type).
+				SetTitle(s.Title).
+				SetDescription(s.Description).
+				SetIsChallenge(s.IsChallenge).
+				SetCreatedAt(s.CreatedAt).
+				SetMeasurement(s.Measurement).
+				SetContent(s.Content).
+				SetURL(s.URL).
+				SetLinkTitle(s.LinkTitle).
+				SetLinkDescription(s.LinkDescription).
+				SetMimeType(s.MimeType).
+				SetPageCount(s.PageCount).
+				SetSortOrder(s.SortOrder)
+			if newParentID != 0 {
+				createStep.SetParentID(newParentID)
+			}
+			// 只保留目录结构时用当前时间
+			if !c.includeSteps {
+				createStep.SetCreatedAt(c.now)
+			}
+
+			step, err := createStep.Save(ctx)
+			if err != nil {
+				return err
+			}
+			c.steps++
+
+			if s.ObjectName != "" {
+				c.mediaSteps[step.ID] = s
+			}
+
+			if s.Type == entStep.TypeDir {
+				err = clone(s.ID, step.ID, objects.ChildPath(path, step.ID))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	return clone(0, 0, objects.RootPath)
+}
+
+// cloneTarget 新目标的状态都是未开始，不复制进度时间、评论和评分
+func (c *targetCloner) cloneTarget(ctx context.Context, from *ent.Target, title string, parentID uint64, path string, layer uint32, sortOrder float64, depth int) (*ent.Target, error) {
+	if depth > objects.TemplateMaxDepth {
+		return nil, stepApi.ErrorInvalidArgument("target tree is too deep")
+	}
+	if len(c.targetIDs) >= objects.TemplateMaxTargets {
+		return nil, stepApi.ErrorInvalidArgument("target tree has too many targets")
+	}
+
+	createTarget := c.tx.Target.Create().
+		SetUserID(c.uid).
+		SetTitle(title).
+		SetDescription(from.Description).
+		SetType(from.Type).
+		SetCreatedAt(c.now).
+		SetStatus(entTarget.StatusInit).
+		SetLayer(layer).
+		SetPath(path).
+		SetScheduleType(from.ScheduleType).
+		SetScheduleWeekdays(from.ScheduleWeekdays).
+		SetScheduleTimes(from.ScheduleTimes).
+		SetMode(from.Mode).
+		SetUnit(from.Unit).
+		SetGoalValue(from.GoalValue).
+		SetSortOrder(sortOrder)
+	if parentID != 0 {
+		createTarget.SetParentID(parentID)
+	}
+	// 自定义评分标准只属于顶层目标
+	if layer == 0 && from.Rubric.IsCustom() {
+		createTarget.SetRubric(from.Rubric)
+	}
+
+	target, err := createTarget.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.targetIDs = append(c.targetIDs, target.ID)
+
+	err = c.cloneSteps(ctx, from, target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := c.tx.Target.Query().
+		Where(entTarget.ParentID(from.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		_, err := c.cloneTarget(ctx, child, child.Title, target.ID, objects.ChildPath(path, target.ID), layer+1, child.SortOrder, depth+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+// CloneTarget 深拷贝目标树:
+// ● 默认只复制子目标和目录结构，include_steps时复制所有积累
+// ● include_media时在对象存储中服务端复制文件到新目标的前缀下
+// ● 数据库和文件任一步失败都会回滚，已复制的文件会被删除
+// 返回新创建的所有目标ID
+func (r *stepRepo) CloneTarget(ctx context.Context, req *stepApi.CloneTargetRequest) (*stepApi.CloneTargetReply, []uint64, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	if req.IncludeMedia && !req.IncludeSteps {
+		return nil, nil, stepApi.ErrorInvalidArgument("include_media needs include_steps")
+	}
+
+	source, err := r.data.ent_client.Target.Get(ctx, req.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if source.UserID != uid {
+		return nil, nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	var layer uint32
+	path := objects.RootPath
+	if req.ParentId != 0 {
+		parentTarget, err := r.data.ent_client.Target.Get(ctx, req.ParentId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if parentTarget.UserID != uid {
+			return nil, nil, stepApi.ErrorNotOwner("not owner")
+		}
+
+		// 不能复制到自己的子目标下
+		if parentTarget.ID == source.ID || slices.Contains(objects.PathIDs(parentTarget.Path), source.ID) {
+			return nil, nil, stepApi.ErrorInvalidArgument("can't clone a target into itself")
+		}
+
+		layer = parentTarget.Layer + 1
+		path = objects.ChildPath(parentTarget.Path, parentTarget.ID)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = source.Title
+	}
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cloner := &targetCloner{
+		r:            r,
+		tx:           tx,
+		uid:          uid,
+		now:          time.Now().Local().Unix(),
+		includeSteps: req.IncludeSteps,
+		includeMedia: req.IncludeMedia,
+		mediaSteps:   make(map[uint64]*ent.Step),
+	}
+
+	copied := make([]string, 0)
+	removeCopied := func() {
+		for _, objectName := range copied {
+			if err := r.minioRepo.RemoveFile(ctx, objectName); err != nil {
+				r.log.Errorf("remove copied object %s error: %v", objectName, err)
+			}
+		}
+	}
+
+	var target *ent.Target
+	err = func() error {
+		// 和新创建的目标一样，不设置手动排序
+		target, err = cloner.cloneTarget(ctx, source, title, req.ParentId, path, layer, 0, 1)
+		if err != nil {
+			return err
+		}
+
+		// 对象名包含新的目标ID和目录ID，所以在创建积累之后复制
+		for stepID, s := range cloner.mediaSteps {
+			step, err := tx.Step.Get(ctx, stepID)
+			if err != nil {
+				return err
+			}
+
+			objectName := stepObjectName(uid, step.RefTargetID, step.ParentID, s.ObjectName)
+			err = r.minioRepo.CopyFile(ctx, s.ObjectName, objectName)
+			if err != nil {
+				return err
+			}
+			copied = append(copied, objectName)
+
+			updateStep := tx.Step.UpdateOneID(stepID).SetObjectName(objectName)
+			if s.PreviewObjectName != "" {
+				previewObjectName := objectName + strings.TrimPrefix(s.PreviewObjectName, s.ObjectName)
+				err = r.minioRepo.CopyFile(ctx, s.PreviewObjectName, previewObjectName)
+				if err != nil {
+					return err
+				}
+				copied = append(copied, previewObjectName)
+				updateStep.SetPreviewObjectName(previewObjectName)
+			}
+
+			err = updateStep.Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("rollback error: %v", rerr)
+		}
+		removeCopied()
+		return nil, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		removeCopied()
+		return nil, nil, err
+	}
+
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
+
+	return &stepApi.CloneTargetReply{
+		Id:      target.ID,
+		Targets: uint32(len(cloner.targetIDs)),
+		Steps:   uint32(cloner.steps),
+	}, cloner.targetIDs, nil
+}
@@ -0,0 +1,350 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
+	"step/internal/data/ent/predicate"
+	entStep "step/internal/data/ent/step"
+	entTag "step/internal/data/ent/tag"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+
+	stepApi "step/api/step/v1"
+
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// 默认每页数量
+const defaultPageSize = 10
+
+// listSort 空为手动排序，游标中记录的是这个值
+func listSort(sort string) string {
+	if sort == "" {
+		return objects.SortManual
+	}
+	return sort
+}
+
+// sortKey 列表按 (排序键, id DESC) 排序，游标分页时按同样的顺序比较
+type sortKey struct {
+	expr func(s *sql.Selector) string
+	desc bool
+}
+
+func columnSortKey(column string, desc bool) sortKey {
+	return sortKey{
+		expr: func(s *sql.Selector) string { return s.C(column) },
+		desc: desc,
+	}
+}
+
+func (k sortKey) order(s *sql.Selector) {
+	if k.desc {
+		s.OrderBy(sql.Desc(k.expr(s)))
+	} else {
+		s.OrderBy(sql.Asc(k.expr(s)))
+	}
+	s.OrderBy(sql.Desc(s.C("id")))
+}
+
+// after 排在游标之后的数据
+func (k sortKey) after(cursor *objects.Cursor) func(s *sql.Selector) {
+	return func(s *sql.Selector) {
+		expr := k.expr(s)
+		op := ">"
+		if k.desc {
+			op = "<"
+		}
+		s.Where(sql.ExprP(
+			fmt.Sprintf("((%s %s ?) OR (%s = ? AND %s < ?))", expr, op, expr, s.C("id")),
+			cursor.Key, cursor.Key, cursor.ID,
+		))
+	}
+}
+
+// 按状态排序时进行中的在前面：挑战中、积累中、未开始、已完成
+var targetStatusRank = map[entTarget.Status]int{
+	entTarget.StatusStepHard: 0,
+	entTarget.StatusStep:     1,
+	entTarget.StatusInit:     2,
+	entTarget.StatusDone:     3,
+}
+
+// targetSortKey 手动排序时未排序过的(0)在最前面，按创建先后倒序
+func targetSortKey(sort string) sortKey {
+	switch listSort(sort) {
+	case objects.SortCreatedAt:
+		return columnSortKey(entTarget.FieldCreatedAt, true)
+	case objects.SortTitle:
+		return columnSortKey(entTarget.FieldTitle, false)
+	case objects.SortStatus:
+		return sortKey{
+			expr: func(s *sql.Selector) string {
+				return fmt.Sprintf("CASE %s WHEN '%s' THEN %d WHEN '%s' THEN %d WHEN '%s' THEN %d ELSE %d END",
+					s.C(entTarget.FieldStatus),
+					entTarget.StatusStepHard, targetStatusRank[entTarget.StatusStepHard],
+					entTarget.StatusStep, targetStatusRank[entTarget.StatusStep],
+					entTarget.StatusInit, targetStatusRank[entTarget.StatusInit],
+					targetStatusRank[entTarget.StatusDone],
+				)
+			},
+		}
+	default:
+		return columnSortKey(entTarget.FieldSortOrder, false)
+	}
+}
+
+func targetSortValue(sort string, target *ent.Target) any {
+	switch listSort(sort) {
+	case objects.SortCreatedAt:
+		return target.CreatedAt
+	case objects.SortTitle:
+		return target.Title
+	case objects.SortStatus:
+		return int64(targetStatusRank[target.Status])
+	default:
+		return target.SortOrder
+	}
+}
+
+func targetOrder(sort string) entTarget.OrderOption {
+	return targetSortKey(sort).order
+}
+
+// stepSortKey 按状态排序时挑战性的在前面
+func stepSortKey(sort string) sortKey {
+	switch listSort(sort) {
+	case objects.SortCreatedAt:
+		return columnSortKey(entStep.FieldCreatedAt, true)
+	case objects.SortTitle:
+		// 积累的名称可以为空
+		return sortKey{
+			expr: func(s *sql.Selector) string {
+				return fmt.Sprintf("COALESCE(%s, '')", s.C(entStep.FieldTitle))
+			},
+		}
+	case objects.SortStatus:
+		return columnSortKey(entStep.FieldIsChallenge, true)
+	default:
+		return columnSortKey(entStep.FieldSortOrder, false)
+	}
+}
+
+func stepSortValue(sort string, step *ent.Step) any {
+	switch listSort(sort) {
+	case objects.SortCreatedAt:
+		return step.CreatedAt
+	case objects.SortTitle:
+		return step.Title
+	case objects.SortStatus:
+		// 和布尔列比较时用0/1
+		if step.IsChallenge {
+			return int64(1)
+		}
+		return int64(0)
+	default:
+		return step.SortOrder
+	}
+}
+
+func stepOrder(sort string) entStep.OrderOption {
+	return stepSortKey(sort).order
+}
+
+// targetFilters 目标列表的筛选条件
+func targetFilters(req *stepApi.GetTargetsRequest) ([]predicate.Target, error) {
+	var predicates []predicate.Target
+
+	if len(req.Status) > 0 {
+		statuses := make([]entTarget.Status, len(req.Status))
+		for i, status := range req.Status {
+			statuses[i] = entTarget.Status(status)
+			if err := entTarget.StatusValidator(statuses[i]); err != nil {
+				return nil, err
+			}
+		}
+		predicates = append(predicates, entTarget.StatusIn(statuses...))
+	}
+
+	if req.Type != "" {
+		predicates = append(predicates, entTarget.Type(req.Type))
+	}
+
+	if req.CreatedFrom > 0 {
+		predicates = append(predicates, entTarget.CreatedAtGTE(req.CreatedFrom))
+	}
+
+	if req.CreatedTo > 0 {
+		predicates = append(predicates, entTarget.CreatedAtLTE(req.CreatedTo))
+	}
+
+	if len(req.TagIds) > 0 {
+		predicates = append(predicates, entTarget.HasTagsWith(entTag.IDIn(req.TagIds...)))
+	}
+
+	return predicates, nil
+}
+
+// stepFilter 积累列表的筛选条件，GetTarget和GetTargetDirStepChildren共用
+type stepFilter struct {
+	StepTypes   []string
+	IsChallenge *wrapperspb.BoolValue
+	CreatedFrom int64
+	CreatedTo   int64
+	CommentRole string
+	TagIDs      []uint64
+}
+
+func (f stepFilter) predicates() ([]predicate.Step, error) {
+	var predicates []predicate.Step
+
+	if len(f.StepTypes) > 0 {
+		types := make([]entStep.Type, len(f.StepTypes))
+		for i, stepType := range f.StepTypes {
+			types[i] = entStep.Type(stepType)
+			if err := entStep.TypeValidator(types[i]); err != nil {
+				return nil, err
+			}
+		}
+		predicates = append(predicates, entStep.TypeIn(types...))
+	}
+
+	if f.IsChallenge != nil {
+		predicates = append(predicates, entStep.IsChallenge(f.IsChallenge.Value))
+	}
+
+	if f.CreatedFrom > 0 {
+		predicates = append(predicates, entStep.CreatedAtGTE(f.CreatedFrom))
+	}
+
+	if f.CreatedTo > 0 {
+		predicates = append(predicates, entStep.CreatedAtLTE(f.CreatedTo))
+	}
+
+	if len(f.TagIDs) > 0 {
+		predicates = append(predicates, entStep.HasTagsWith(entTag.IDIn(f.TagIDs...)))
+	}
+
+	switch f.CommentRole {
+	case "":
+	case objects.CommentRoleTeacher, objects.CommentRoleParent, objects.CommentRoleFriend:
+		predicates = append(predicates, commentedAs(f.CommentRole))
+	default:
+		return nil, stepApi.ErrorInvalidArgument("invalid comment role: %s", f.CommentRole)
+	}
+
+	return predicates, nil
+}
+
+// commentedAs 积累有这个角色的顶层评论
+func commentedAs(role string) predicate.Step {
+	return func(s *sql.Selector) {
+		t := sql.Table(entComment.Table)
+		s.Where(sql.In(
+			s.C(entStep.FieldID),
+			sql.Select(t.C(entComment.FieldStepID)).From(t).Where(sql.And(
+				sql.EQ(t.C(entComment.FieldRole), role),
+				sql.IsNull(t.C(entComment.FieldParentID)),
+			)),
+		))
+	}
+}
+
+// pageSteps 按筛选条件和排序分页，有游标时从游标之后开始，否则按页码。
+// 返回的total是筛选后的总数，取满一页时返回下一页的游标
+func pageSteps(ctx context.Context, query *ent.StepQuery, filter stepFilter, sort string, page uint32, pageSize uint32, cursor string) ([]*ent.Step, int, string, error) {
+	predicates, err := filter.predicates()
+	if err != nil {
+		return nil, 0, "", err
+	}
+	query = query.Where(predicates...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	key := stepSortKey(sort)
+	query = query.WithTags().Order(key.order).Limit(int(pageSize))
+	if cursor != "" {
+		c, err := objects.DecodeCursor(cursor, listSort(sort))
+		if err != nil {
+			return nil, 0, "", stepApi.ErrorInvalidArgument("%v", err)
+		}
+		query = query.Where(key.after(c))
+	} else {
+		if page == 0 {
+			page = 1
+		}
+		query = query.Offset(int((page - 1) * pageSize))
+	}
+
+	steps, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	var nextCursor string
+	if len(steps) == int(pageSize) {
+		last := steps[len(steps)-1]
+		nextCursor = objects.EncodeCursor(&objects.Cursor{
+			Sort: listSort(sort),
+			Key:  stepSortValue(sort, last),
+			ID:   last.ID,
+		})
+	}
+
+	return steps, total, nextCursor, nil
+}
+
+// pageTargets 同pageSteps，pageSize和cursor都为空时返回全部
+func pageTargets(ctx context.Context, query *ent.TargetQuery, req *stepApi.GetTargetsRequest) ([]*ent.Target, string, error) {
+	predicates, err := targetFilters(req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := targetSortKey(req.Sort)
+	query = query.Where(predicates...).WithTags().Order(key.order)
+
+	paged := req.PageSize > 0 || req.Cursor != ""
+	pageSize := req.PageSize
+	if paged {
+		if pageSize == 0 {
+			pageSize = defaultPageSize
+		}
+		query = query.Limit(int(pageSize))
+	}
+
+	if req.Cursor != "" {
+		c, err := objects.DecodeCursor(req.Cursor, listSort(req.Sort))
+		if err != nil {
+			return nil, "", stepApi.ErrorInvalidArgument("%v", err)
+		}
+		query = query.Where(key.after(c))
+	}
+
+	targets, err := query.All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if paged && len(targets) == int(pageSize) {
+		last := targets[len(targets)-1]
+		nextCursor = objects.EncodeCursor(&objects.Cursor{
+			Sort: listSort(req.Sort),
+			Key:  targetSortValue(req.Sort, last),
+			ID:   last.ID,
+		})
+	}
+
+	return targets, nextCursor, nil
+}
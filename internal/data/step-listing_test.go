@@ -0,0 +1,146 @@
+package data
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	stepApi "step/api/step/v1"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+)
+
+func TestPageStepsCursor(t *testing.T) {
+	r, ctx := newBenchStepRepo(t)
+	targetID := benchCreateTarget(t, r, ctx, 0, "listing")
+
+	// 排序键有重复，同一排序键按id倒序
+	steps := []struct {
+		title     string
+		createdAt int64
+		challenge bool
+		sortOrder float64
+	}{
+		{"b", 100, false, 2048},
+		{"a", 200, true, 1024},
+		{"", 200, false, 0},
+		{"b", 300, true, 0},
+		{"c", 100, false, 3072},
+		{"a", 400, false, 1024},
+		{"", 500, true, 4096},
+	}
+	for _, s := range steps {
+		err := r.data.ent_client.Step.Create().
+			SetType(entStep.TypeNote).
+			SetRefTargetID(targetID).
+			SetTitle(s.title).
+			SetCreatedAt(s.createdAt).
+			SetIsChallenge(s.challenge).
+			SetSortOrder(s.sortOrder).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, sort := range []string{"", objects.SortCreatedAt, objects.SortTitle, objects.SortStatus} {
+		t.Run(listSort(sort), func(t *testing.T) {
+			query := r.data.ent_client.Step.Query().Where(entStep.RefTargetID(targetID))
+			all, total, _, err := pageSteps(ctx, query.Clone(), stepFilter{}, sort, 1, 100, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != len(steps) || len(all) != len(steps) {
+				t.Fatalf("total = %d, got %d steps, want %d", total, len(all), len(steps))
+			}
+
+			// 每页2条按游标取完，和一次取出的顺序一致
+			var paged []uint64
+			cursor := ""
+			for i := 0; i <= len(steps); i++ {
+				page, _, next, err := pageSteps(ctx, query.Clone(), stepFilter{}, sort, 0, 2, cursor)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, step := range page {
+					paged = append(paged, step.ID)
+				}
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+			want := make([]uint64, len(all))
+			for i, step := range all {
+				want[i] = step.ID
+			}
+			if !slices.Equal(paged, want) {
+				t.Errorf("paged = %v, want %v", paged, want)
+			}
+		})
+	}
+
+	t.Run("cursor of another sort", func(t *testing.T) {
+		query := r.data.ent_client.Step.Query().Where(entStep.RefTargetID(targetID))
+		_, _, next, err := pageSteps(ctx, query.Clone(), stepFilter{}, objects.SortTitle, 0, 2, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, _, err = pageSteps(ctx, query.Clone(), stepFilter{}, objects.SortCreatedAt, 0, 2, next)
+		if err == nil || !strings.Contains(err.Error(), "cursor does not match sort") {
+			t.Fatalf("error = %v, want cursor does not match sort", err)
+		}
+	})
+}
+
+func TestPageTargetsCursor(t *testing.T) {
+	r, ctx := newBenchStepRepo(t)
+	statuses := []entTarget.Status{
+		entTarget.StatusDone, entTarget.StatusStep, entTarget.StatusInit,
+		entTarget.StatusStepHard, entTarget.StatusStep, entTarget.StatusDone,
+	}
+	for i, status := range statuses {
+		id := benchCreateTarget(t, r, ctx, 0, string(rune('a'+i%3)))
+		err := r.data.ent_client.Target.UpdateOneID(id).SetStatus(status).Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, sort := range []string{"", objects.SortCreatedAt, objects.SortTitle, objects.SortStatus} {
+		t.Run(listSort(sort), func(t *testing.T) {
+			query := r.data.ent_client.Target.Query()
+			all, next, err := pageTargets(ctx, query.Clone(), &stepApi.GetTargetsRequest{Sort: sort})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(all) != len(statuses) || next != "" {
+				t.Fatalf("got %d targets and cursor %q, want %d and none", len(all), next, len(statuses))
+			}
+
+			var paged []uint64
+			req := &stepApi.GetTargetsRequest{Sort: sort, PageSize: 4}
+			for i := 0; i <= len(statuses); i++ {
+				page, next, err := pageTargets(ctx, query.Clone(), req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, target := range page {
+					paged = append(paged, target.ID)
+				}
+				if next == "" {
+					break
+				}
+				req.Cursor = next
+			}
+			want := make([]uint64, len(all))
+			for i, target := range all {
+				want[i] = target.ID
+			}
+			if !slices.Equal(paged, want) {
+				t.Errorf("paged = %v, want %v", paged, want)
+			}
+		})
+	}
+}
@@ -3,13 +3,13 @@ package data
 import (
 	"context"
 	"encoding/json"
-	"errors"
-	"strings"
+	"slices"
 
 	stepApi "step/api/step/v1"
 	"step/internal/biz"
 	"step/internal/data/ent"
 	entStep "step/internal/data/ent/step"
+	"step/internal/objects"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -41,18 +41,16 @@ func (r *stepNoauthRepo) findTopStepToGetTarget(ctx context.Context, stepId uint
 	}
 
 	if step.Edges.Parent == nil {
-		return nil, errors.New("can't fine target of this step")
+		return nil, stepApi.ErrorTargetNotFound("can't fine target of this step")
 	}
 
 	return r.findTopStepToGetTarget(ctx, step.Edges.Parent.ID)
 }
 
-func (r *stepNoauthRepo) getStepForApi(ctx context.Context, step *ent.Step, shareTo string) (*stepApi.Step, error) {
+func (r *stepNoauthRepo) getStepForApi(ctx context.Context, step *ent.Step, roles []string) (*stepApi.Step, error) {
 	shareToRoles := make(map[string]bool)
-	if shareTo != "" {
-		for _, role := range strings.Split(shareTo, ",") {
-			shareToRoles[strings.TrimSpace(role)] = true
-		}
+	for _, role := range roles {
+		shareToRoles[role] = true
 	}
 
 	var teacherJsonComment, parentJsonComment, friendJsonComment []byte
@@ -76,33 +74,36 @@ func (r *stepNoauthRepo) getStepForApi(ctx context.Context, step *ent.Step, shar
 		}
 	}
 
-	var presignedUrl string
-	if step.Type != entStep.TypeDir {
-		presignedUrl, err = r.minioRepo.GetDownloadPreSignedUrl(ctx, step.ObjectName, r.data.minio_endpoint_remote)
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	stepForApi := &stepApi.Step{
-		Id:             step.ID,
-		Type:           step.Type.String(),
-		Title:          step.Title,
-		Description:    step.Description,
-		IsChallenge:    step.IsChallenge,
-		ObjectName:     step.ObjectName,
-		PresignedUrl:   presignedUrl,
-		TeacherComment: string(teacherJsonComment),
-		ParentComment:  string(parentJsonComment),
-		FriendComment:  string(friendJsonComment),
-		CreatedAt:      step.CreatedAt,
+		Id:              step.ID,
+		Type:            step.Type.String(),
+		Title:           step.Title,
+		Description:     step.Description,
+		IsChallenge:     step.IsChallenge,
+		ObjectName:      step.ObjectName,
+		TeacherComment:  string(teacherJsonComment),
+		ParentComment:   string(parentJsonComment),
+		FriendComment:   string(friendJsonComment),
+		CreatedAt:       step.CreatedAt,
+		Measurement:     step.Measurement,
+		Content:         step.Content,
+		Url:             step.URL,
+		LinkTitle:       step.LinkTitle,
+		LinkDescription: step.LinkDescription,
+		MimeType:        step.MimeType,
+		PageCount:       step.PageCount,
+	}
+
+	err = presignStep(ctx, r.minioRepo, r.data.minio_endpoint_remote, step, stepForApi)
+	if err != nil {
+		return nil, err
 	}
 
 	return stepForApi, nil
 }
 
 func (r *stepNoauthRepo) GetNoauthStep(ctx context.Context, req *stepApi.GetNoauthStepRequest) (*stepApi.GetNoauthStepReply, error) {
-	decryptedShareTo, err := r.encryptRepo.Decrypt(ctx, req.Id, req.ShareTo)
+	roles, err := r.encryptRepo.VerifyShareToken(ctx, req.Id, req.ShareTo)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +118,7 @@ func (r *stepNoauthRepo) GetNoauthStep(ctx context.Context, req *stepApi.GetNoau
 		return nil, err
 	}
 
-	stepForApi, err := r.getStepForApi(ctx, step, decryptedShareTo)
+	stepForApi, err := r.getStepForApi(ctx, step, roles)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +131,7 @@ func (r *stepNoauthRepo) GetNoauthStep(ctx context.Context, req *stepApi.GetNoau
 		}
 
 		for _, child := range children {
-			childApi, err := r.getStepForApi(ctx, child, decryptedShareTo)
+			childApi, err := r.getStepForApi(ctx, child, roles)
 			if err != nil {
 				return nil, err
 			}
@@ -146,53 +147,37 @@ func (r *stepNoauthRepo) GetNoauthStep(ctx context.Context, req *stepApi.GetNoau
 	}, nil
 }
 
-func (r *stepNoauthRepo) SetCommentForStep(ctx context.Context, req *stepApi.SetCommentForStepRequest) (*stepApi.SetCommentForStepReply, error) {
-	if req.Type != "teacher" && req.Type != "parent" && req.Type != "friend" {
-		return nil, errors.New("invalid comment type")
-	}
-
-	commentMap := make(map[string]any)
-	err := json.Unmarshal([]byte(req.Comment), &commentMap)
+// GetSharedStep 校验分享链接后返回分享的积累；childId不为0时返回分享目录下的积累，包括子目录中的
+func (r *stepNoauthRepo) GetSharedStep(ctx context.Context, id uint64, childId uint64, shareTo string) (*ent.Step, error) {
+	_, err := r.encryptRepo.VerifyShareToken(ctx, id, shareTo)
 	if err != nil {
 		return nil, err
 	}
 
-	step, err := r.data.ent_client.Step.Query().Where(entStep.ID(req.Id)).First(ctx)
+	if childId == 0 {
+		return r.data.ent_client.Step.Get(ctx, id)
+	}
+
+	child, err := r.data.ent_client.Step.Get(ctx, childId)
 	if err != nil {
 		return nil, err
 	}
 
-	/* // step只能在24小时内被评论，且只能被评论一次
-	now := time.Now().Local()
-	stepCreatedAt := time.Unix(step.CreatedAt, 0)
-	if now.After(stepCreatedAt.Add(24 * time.Hour)) {
-		return nil, errors.New("step can't be commented after 24 hours")
-	} */
-
-	if req.Type == "teacher" && step.TeacherComment != nil {
-		return nil, errors.New("step already has teacher comment")
-	} else if req.Type == "parent" && step.ParentComment != nil {
-		return nil, errors.New("step already has parent comment")
-	} else if req.Type == "friend" && step.FriendComment != nil {
-		return nil, errors.New("step already has friend comment")
+	if !slices.Contains(objects.PathIDs(child.Path), id) {
+		return nil, stepApi.ErrorInvalidShareToken("step is not shared")
 	}
 
-	updateStep := step.Update().Where(entStep.ID(req.Id))
-
-	if req.Type == "teacher" {
-		updateStep.SetTeacherComment(commentMap)
-	} else if req.Type == "parent" {
-		updateStep.SetParentComment(commentMap)
-	} else if req.Type == "friend" {
-		updateStep.SetFriendComment(commentMap)
-	}
+	return child, nil
+}
 
-	_, err = updateStep.Save(ctx)
+func (r *stepNoauthRepo) SetCommentForStep(ctx context.Context, req *stepApi.SetCommentForStepRequest) (*stepApi.SetCommentForStepReply, error) {
+	commentId, err := createRatedComment(ctx, r.data.ent_client, r.log, req.Id, req.Type, req.Comment, req.Content, "")
 	if err != nil {
 		return nil, err
 	}
 
 	return &stepApi.SetCommentForStepReply{
-		Id: req.Id,
+		Id:        req.Id,
+		CommentId: commentId,
 	}, nil
 }
@@ -3,38 +3,211 @@ package data
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"path"
 	"step/internal/biz"
 	"step/internal/data/ent"
+	entComment "step/internal/data/ent/comment"
 	entStep "step/internal/data/ent/step"
+	"step/internal/data/ent/steprate"
+	"step/internal/data/ent/stepratecriterion"
 	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
 	"step/internal/utils"
+	"strings"
 	"time"
 
 	stepApi "step/api/step/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/shopspring/decimal"
+	"golang.org/x/sync/errgroup"
 )
 
 type stepRepo struct {
 	data      *Data
 	log       *log.Helper
 	minioRepo biz.MinioRepo
+	linkRepo  biz.LinkRepo
 }
 
-func NewStepRepo(data *Data, logger log.Logger, minioRepo biz.MinioRepo) biz.StepRepo {
+func NewStepRepo(data *Data, logger log.Logger, minioRepo biz.MinioRepo, linkRepo biz.LinkRepo) biz.StepRepo {
 	return &stepRepo{
 		data:      data,
 		log:       log.NewHelper(logger, log.WithMessageKey("stepRepo")),
 		minioRepo: minioRepo,
+		linkRepo:  linkRepo,
 	}
 }
 
+func toApiTarget(target *ent.Target) *stepApi.Target {
+	return &stepApi.Target{
+		Id:           target.ID,
+		Title:        target.Title,
+		Description:  target.Description,
+		Type:         target.Type,
+		CreatedAt:    target.CreatedAt,
+		StartAt:      target.StartAt,
+		ChallengeAt:  target.ChallengeAt,
+		DoneAt:       target.DoneAt,
+		Layer:        target.Layer,
+		Status:       target.Status.String(),
+		TargetParent: target.ParentID,
+		Schedule:     toApiSchedule(targetSchedule(target)),
+		Mode:         target.Mode.String(),
+		Unit:         target.Unit,
+		GoalValue:    target.GoalValue,
+		SortOrder:    target.SortOrder,
+		Tags:         toApiTags(target.Edges.Tags),
+	}
+}
+
+// 计量目标需要设定大于0的目标值
+func validateTargetMode(mode entTarget.Mode, goalValue float64) error {
+	if err := entTarget.ModeValidator(mode); err != nil {
+		return err
+	}
+	if mode == entTarget.ModeQuantity && goalValue <= 0 {
+		return stepApi.ErrorInvalidArgument("goal_value must be greater than 0 for quantity target")
+	}
+	return nil
+}
+
+func toApiStep(step *ent.Step) (*stepApi.Step, error) {
+	jsonTeacherComment, err := json.Marshal(step.TeacherComment)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonParentComment, err := json.Marshal(step.ParentComment)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonFriendComment, err := json.Marshal(step.FriendComment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.Step{
+		Id:                step.ID,
+		Title:             step.Title,
+		Description:       step.Description,
+		IsChallenge:       step.IsChallenge,
+		Type:              step.Type.String(),
+		ObjectName:        step.ObjectName,
+		CreatedAt:         step.CreatedAt,
+		RefTargetId:       step.RefTargetID,
+		TeacherComment:    string(jsonTeacherComment),
+		ParentComment:     string(jsonParentComment),
+		FriendComment:     string(jsonFriendComment),
+		TeacherReviewerId: step.TeacherReviewerID,
+		ParentReviewerId:  step.ParentReviewerID,
+		Measurement:       step.Measurement,
+		Content:           step.Content,
+		Url:               step.URL,
+		LinkTitle:         step.LinkTitle,
+		LinkDescription:   step.LinkDescription,
+		MimeType:          step.MimeType,
+		PageCount:         step.PageCount,
+		SortOrder:         step.SortOrder,
+		Tags:              toApiTags(step.Edges.Tags),
+	}, nil
+}
+
+// presignStep 积累文件和文档预览图的下载地址
+func presignStep(ctx context.Context, minioRepo biz.MinioRepo, endpoint string, step *ent.Step, stepApi *stepApi.Step) error {
+	if step.ObjectName != "" {
+		presignedUrl, err := minioRepo.GetDownloadPreSignedUrl(ctx, step.ObjectName, endpoint)
+		if err != nil {
+			return err
+		}
+		stepApi.PresignedUrl = presignedUrl
+	}
+
+	if step.PreviewObjectName != "" {
+		previewUrl, err := minioRepo.GetDownloadPreSignedUrl(ctx, step.PreviewObjectName, endpoint)
+		if err != nil {
+			return err
+		}
+		stepApi.PreviewUrl = previewUrl
+	}
+
+	return nil
+}
+
+// 并发生成预签名地址的协程数
+const presignConcurrency = 8
+
+// toApiSteps 转换积累列表，下载地址并发生成
+func toApiSteps(ctx context.Context, minioRepo biz.MinioRepo, endpoint string, steps []*ent.Step) ([]*stepApi.Step, error) {
+	stepsApi := make([]*stepApi.Step, len(steps))
+	for i, step := range steps {
+		var err error
+		stepsApi[i], err = toApiStep(step)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(presignConcurrency)
+	for i, step := range steps {
+		g.Go(func() error {
+			return presignStep(ctx, minioRepo, endpoint, step, stepsApi[i])
+		})
+	}
+	err := g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	return stepsApi, nil
+}
+
+func targetSchedule(target *ent.Target) *objects.Schedule {
+	return &objects.Schedule{
+		Type:     target.ScheduleType.String(),
+		Weekdays: target.ScheduleWeekdays,
+		Times:    target.ScheduleTimes,
+	}
+}
+
+func toApiSchedule(schedule *objects.Schedule) *stepApi.TargetSchedule {
+	weekdays := make([]int32, len(schedule.Weekdays))
+	for i, weekday := range schedule.Weekdays {
+		weekdays[i] = int32(weekday)
+	}
+	return &stepApi.TargetSchedule{
+		Type:     schedule.Type,
+		Weekdays: weekdays,
+		Times:    schedule.Times,
+	}
+}
+
+func fromApiSchedule(schedule *stepApi.TargetSchedule) (*objects.Schedule, error) {
+	weekdays := make([]int, len(schedule.Weekdays))
+	for i, weekday := range schedule.Weekdays {
+		weekdays[i] = int(weekday)
+	}
+	s := &objects.Schedule{
+		Type:     schedule.Type,
+		Weekdays: weekdays,
+		Times:    schedule.Times,
+	}
+	if s.Type == "" {
+		s.Type = objects.ScheduleTypeNone
+	}
+	if err := s.Validate(); err != nil {
+		return nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+	return s, nil
+}
+
 func (r *stepRepo) CreateTarget(ctx context.Context, req *stepApi.CreateTargetRequest) (*stepApi.CreateTargetReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	now := time.Now().Local().Unix()
@@ -50,6 +223,28 @@ func (r *stepRepo) CreateTarget(ctx context.Context, req *stepApi.CreateTargetRe
 		createTarget.SetType(req.Type)
 	}
 
+	if req.Mode != "" {
+		err := validateTargetMode(entTarget.Mode(req.Mode), req.GoalValue)
+		if err != nil {
+			return nil, err
+		}
+		createTarget.
+			SetMode(entTarget.Mode(req.Mode)).
+			SetUnit(req.Unit).
+			SetGoalValue(req.GoalValue)
+	}
+
+	if req.Schedule != nil {
+		schedule, err := fromApiSchedule(req.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		createTarget.
+			SetScheduleType(entTarget.ScheduleType(schedule.Type)).
+			SetScheduleWeekdays(schedule.Weekdays).
+			SetScheduleTimes(schedule.Times)
+	}
+
 	if req.ParentId != 0 {
 		parentTarget, err := r.data.ent_client.Target.Get(ctx, req.ParentId)
 		if err != nil {
@@ -57,13 +252,15 @@ func (r *stepRepo) CreateTarget(ctx context.Context, req *stepApi.CreateTargetRe
 		}
 
 		if parentTarget.UserID != uid {
-			return nil, errors.New("not owner")
+			return nil, stepApi.ErrorNotOwner("not owner")
 		}
 
 		createTarget.SetLayer(parentTarget.Layer + 1)
 		createTarget.SetParentID(req.ParentId)
+		createTarget.SetPath(objects.ChildPath(parentTarget.Path, parentTarget.ID))
 	} else {
 		createTarget.SetLayer(0)
+		createTarget.SetPath(objects.RootPath)
 	}
 
 	target, err := createTarget.Save(ctx)
@@ -71,6 +268,8 @@ func (r *stepRepo) CreateTarget(ctx context.Context, req *stepApi.CreateTargetRe
 		return nil, err
 	}
 
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
+
 	return &stepApi.CreateTargetReply{
 		Id:    target.ID,
 		Layer: target.Layer,
@@ -80,7 +279,7 @@ func (r *stepRepo) CreateTarget(ctx context.Context, req *stepApi.CreateTargetRe
 func (r *stepRepo) UpdateTarget(ctx context.Context, req *stepApi.UpdateTargetRequest) (*stepApi.UpdateTargetReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
@@ -89,17 +288,49 @@ func (r *stepRepo) UpdateTarget(ctx context.Context, req *stepApi.UpdateTargetRe
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, stepApi.ErrorNotOwner("not owner")
 	}
 
-	_, err = target.Update().
+	updateTarget := target.Update().
 		SetTitle(req.Title).
-		SetDescription(req.Description).
-		Save(ctx)
+		SetDescription(req.Description)
+
+	if req.Schedule != nil {
+		schedule, err := fromApiSchedule(req.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		updateTarget.
+			SetScheduleType(entTarget.ScheduleType(schedule.Type)).
+			SetScheduleWeekdays(schedule.Weekdays).
+			SetScheduleTimes(schedule.Times)
+	}
+
+	mode := target.Mode
+	if req.Mode != "" {
+		mode = entTarget.Mode(req.Mode)
+		updateTarget.SetMode(mode)
+	}
+	goalValue := target.GoalValue
+	if req.GoalValue != nil {
+		goalValue = req.GoalValue.GetValue()
+		updateTarget.SetGoalValue(goalValue)
+	}
+	if req.Unit != nil {
+		updateTarget.SetUnit(req.Unit.GetValue())
+	}
+	err = validateTargetMode(mode, goalValue)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = updateTarget.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
+
 	return &stepApi.UpdateTargetReply{
 		Id: target.ID,
 	}, nil
@@ -108,35 +339,24 @@ func (r *stepRepo) UpdateTarget(ctx context.Context, req *stepApi.UpdateTargetRe
 func (r *stepRepo) GetTargets(ctx context.Context, req *stepApi.GetTargetsRequest) (*stepApi.GetTargetsReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	if req.ParentId == 0 {
-		targets, err := r.data.ent_client.Target.Query().
+		query := r.data.ent_client.Target.Query().
 			Where(entTarget.UserID(uid)).
-			Where(entTarget.Or(entTarget.ParentIDIsNil(), entTarget.ParentID(0))).
-			Order(ent.Desc(entTarget.FieldID)).
-			All(ctx)
+			Where(entTarget.Or(entTarget.ParentIDIsNil(), entTarget.ParentID(0)))
+		targets, nextCursor, err := pageTargets(ctx, query, req)
 		if err != nil {
 			return nil, err
 		}
 		targetsApi := make([]*stepApi.Target, len(targets))
 		for i, target := range targets {
-			targetsApi[i] = &stepApi.Target{
-				Id:          target.ID,
-				Title:       target.Title,
-				Description: target.Description,
-				Type:        target.Type,
-				CreatedAt:   target.CreatedAt,
-				StartAt:     target.StartAt,
-				ChallengeAt: target.ChallengeAt,
-				DoneAt:      target.DoneAt,
-				Layer:       target.Layer,
-				Status:      target.Status.String(),
-			}
+			targetsApi[i] = toApiTarget(target)
 		}
 		return &stepApi.GetTargetsReply{
-			Targets: targetsApi,
+			Targets:    targetsApi,
+			NextCursor: nextCursor,
 		}, nil
 	} else {
 		target, err := r.data.ent_client.Target.Get(ctx, req.ParentId)
@@ -145,33 +365,22 @@ func (r *stepRepo) GetTargets(ctx context.Context, req *stepApi.GetTargetsReques
 		}
 
 		if target.UserID != uid {
-			return nil, errors.New("not owner")
+			return nil, stepApi.ErrorNotOwner("not owner")
 		}
 
-		targets, err := target.QueryChildren().Order(ent.Desc(entTarget.FieldID)).All(ctx)
+		targets, nextCursor, err := pageTargets(ctx, target.QueryChildren(), req)
 		if err != nil {
 			return nil, err
 		}
 
 		targetsApi := make([]*stepApi.Target, len(targets))
 		for i, target := range targets {
-			targetsApi[i] = &stepApi.Target{
-				Id:           target.ID,
-				Title:        target.Title,
-				Description:  target.Description,
-				Type:         target.Type,
-				CreatedAt:    target.CreatedAt,
-				StartAt:      target.StartAt,
-				ChallengeAt:  target.ChallengeAt,
-				DoneAt:       target.DoneAt,
-				Layer:        target.Layer,
-				Status:       target.Status.String(),
-				TargetParent: target.ParentID,
-			}
+			targetsApi[i] = toApiTarget(target)
 		}
 
 		return &stepApi.GetTargetsReply{
-			Targets: targetsApi,
+			Targets:    targetsApi,
+			NextCursor: nextCursor,
 		}, nil
 	}
 }
@@ -179,7 +388,7 @@ func (r *stepRepo) GetTargets(ctx context.Context, req *stepApi.GetTargetsReques
 func (r *stepRepo) DeleteTarget(ctx context.Context, req *stepApi.DeleteTargetRequest) (*stepApi.DeleteTargetReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
@@ -188,7 +397,7 @@ func (r *stepRepo) DeleteTarget(ctx context.Context, req *stepApi.DeleteTargetRe
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, stepApi.ErrorNotOwner("not owner")
 	}
 
 	err = r.data.ent_client.Target.DeleteOneID(target.ID).Exec(ctx)
@@ -197,6 +406,7 @@ func (r *stepRepo) DeleteTarget(ctx context.Context, req *stepApi.DeleteTargetRe
 	}
 
 	// 当前只删除本目标，子目标及其积累暂不删除
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
 
 	return &stepApi.DeleteTargetReply{
 		Id: target.ID,
@@ -206,7 +416,7 @@ func (r *stepRepo) DeleteTarget(ctx context.Context, req *stepApi.DeleteTargetRe
 func (r *stepRepo) DoneTarget(ctx context.Context, req *stepApi.DoneTargetRequest) (*stepApi.DoneTargetReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
@@ -215,7 +425,7 @@ func (r *stepRepo) DoneTarget(ctx context.Context, req *stepApi.DoneTargetReques
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, stepApi.ErrorNotOwner("not owner")
 	}
 
 	err = r.SetTargetStatusRecursively(ctx, target.ID, entTarget.StatusDone)
@@ -228,72 +438,51 @@ func (r *stepRepo) DoneTarget(ctx context.Context, req *stepApi.DoneTargetReques
 	}, nil
 }
 
+// findRootTarget 顶层目标是路径中的第一个祖先
 func (r *stepRepo) findRootTarget(ctx context.Context, target *ent.Target) (*ent.Target, error) {
-	if target.Layer == 0 {
-		return target, nil
-	}
-
-	if target.ParentID == 0 {
-		return nil, fmt.Errorf("target %d with layer %d has no parent", target.ID, target.Layer)
-	}
-
-	parentTarget, err := r.data.ent_client.Target.Get(ctx, target.ParentID)
-	if err != nil {
-		return nil, err
-	}
-
-	if parentTarget.Layer == 0 {
-		return parentTarget, nil
-	}
-
-	rootTarget, err := r.findRootTarget(ctx, parentTarget)
-	if err != nil {
-		return nil, err
-	}
-	return rootTarget, nil
+	return topTargetOf(ctx, r.data.ent_client, target)
 }
 
-func (r *stepRepo) getChildrenTargets(ctx context.Context, target *stepApi.Target) error {
-	childrenTargets, err := r.data.ent_client.Target.Query().
-		Where(entTarget.ParentID(target.Id)).
+// getTargetTree 一次查询出所有子孙目标，再按parent_id组装成树，同一父目标下的顺序与sort一致
+func (r *stepRepo) getTargetTree(ctx context.Context, root *ent.Target, sort string) (*stepApi.Target, error) {
+	descendants, err := r.data.ent_client.Target.Query().
+		Where(entTarget.PathHasPrefix(objects.ChildPath(root.Path, root.ID))).
+		WithTags().
+		Order(targetOrder(sort)).
 		All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil
-		}
-		return err
+		return nil, err
 	}
 
-	childrenTargetsApi := make([]*stepApi.Target, len(childrenTargets))
-	for i, target := range childrenTargets {
-		childrenTargetsApi[i] = &stepApi.Target{
-			Id:           target.ID,
-			Title:        target.Title,
-			Description:  target.Description,
-			Type:         target.Type,
-			CreatedAt:    target.CreatedAt,
-			StartAt:      target.StartAt,
-			ChallengeAt:  target.ChallengeAt,
-			DoneAt:       target.DoneAt,
-			Layer:        target.Layer,
-			Status:       target.Status.String(),
-			TargetParent: target.ParentID,
-		}
+	rootApi := toApiTarget(root)
+	targetsApi := map[uint64]*stepApi.Target{root.ID: rootApi}
+	for _, target := range descendants {
+		targetsApi[target.ID] = toApiTarget(target)
+	}
 
-		err = r.getChildrenTargets(ctx, childrenTargetsApi[i])
-		if err != nil {
-			return err
+	for _, target := range descendants {
+		parent, ok := targetsApi[target.ParentID]
+		if !ok {
+			r.log.Warnf("target %d in path %s has no parent %d", target.ID, target.Path, target.ParentID)
+			continue
 		}
+		parent.Children = append(parent.Children, targetsApi[target.ID])
 	}
-	target.Children = childrenTargetsApi
 
-	return nil
+	return rootApi, nil
 }
 
 func (r *stepRepo) GetTargetTree(ctx context.Context, req *stepApi.GetTargetTreeRequest) (*stepApi.GetTargetTreeReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	// 同一用户的目标不会变更所有者，缓存按用户存储不需要再校验
+	cacheField := fmt.Sprintf("%d:%s", req.Id, listSort(req.Sort))
+	cached := &stepApi.GetTargetTreeReply{}
+	if r.data.cache.GetUserCache(ctx, uid, objects.CacheKindTargetTree, cacheField, cached) {
+		return cached, nil
 	}
 
 	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
@@ -302,7 +491,7 @@ func (r *stepRepo) GetTargetTree(ctx context.Context, req *stepApi.GetTargetTree
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, stepApi.ErrorNotOwner("not owner")
 	}
 
 	rootTarget, err := r.findRootTarget(ctx, target)
@@ -310,33 +499,23 @@ func (r *stepRepo) GetTargetTree(ctx context.Context, req *stepApi.GetTargetTree
 		return nil, err
 	}
 
-	rootTargetApi := &stepApi.Target{
-		Id:          rootTarget.ID,
-		Title:       rootTarget.Title,
-		Description: rootTarget.Description,
-		Type:        rootTarget.Type,
-		CreatedAt:   rootTarget.CreatedAt,
-		StartAt:     rootTarget.StartAt,
-		ChallengeAt: rootTarget.ChallengeAt,
-		DoneAt:      rootTarget.DoneAt,
-		Layer:       rootTarget.Layer,
-		Status:      rootTarget.Status.String(),
-	}
-
-	err = r.getChildrenTargets(ctx, rootTargetApi)
+	rootTargetApi, err := r.getTargetTree(ctx, rootTarget, req.Sort)
 	if err != nil {
 		return nil, err
 	}
 
-	return &stepApi.GetTargetTreeReply{
+	reply := &stepApi.GetTargetTreeReply{
 		RootTarget: rootTargetApi,
-	}, nil
+	}
+	r.data.cache.SetUserCache(ctx, uid, objects.CacheKindTargetTree, cacheField, reply)
+
+	return reply, nil
 }
 
 func (r *stepRepo) GetTarget(ctx context.Context, req *stepApi.GetTargetRequest) (*stepApi.GetTargetReply, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
 	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
@@ -345,114 +524,52 @@ func (r *stepRepo) GetTarget(ctx context.Context, req *stepApi.GetTargetRequest)
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	target.Edges.Tags, err = target.QueryTags().All(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	reply := &stepApi.GetTargetReply{
-		Target: &stepApi.Target{
-			Id:           target.ID,
-			Title:        target.Title,
-			Description:  target.Description,
-			Type:         target.Type,
-			CreatedAt:    target.CreatedAt,
-			StartAt:      target.StartAt,
-			ChallengeAt:  target.ChallengeAt,
-			DoneAt:       target.DoneAt,
-			Layer:        target.Layer,
-			Status:       target.Status.String(),
-			TargetParent: target.ParentID,
-		},
+		Target: toApiTarget(target),
 	}
 
 	if req.WithSteps {
-		page := req.Page
-		if page == 0 {
-			page = 1
-		}
-
-		pageSize := req.PageSize
-		if pageSize == 0 {
-			pageSize = 10
+		filter := stepFilter{
+			StepTypes:   req.StepTypes,
+			IsChallenge: req.IsChallenge,
+			CreatedFrom: req.CreatedFrom,
+			CreatedTo:   req.CreatedTo,
+			CommentRole: req.CommentRole,
+			TagIDs:      req.TagIds,
 		}
-
-		query := target.QuerySteps()
-
-		total, err := query.Count(ctx)
+		steps, total, nextCursor, err := pageSteps(ctx, target.QuerySteps(), filter, req.Sort, req.Page, req.PageSize, req.Cursor)
 		if err != nil {
 			return nil, err
 		}
 		reply.Total = uint64(total)
+		reply.NextCursor = nextCursor
 
-		steps, err := query.Order(ent.Desc(entStep.FieldID)).Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).All(ctx)
+		reply.Steps, err = toApiSteps(ctx, r.minioRepo, r.data.minio_endpoint_remote, steps)
 		if err != nil {
 			return nil, err
 		}
-
-		stepsApi := make([]*stepApi.Step, len(steps))
-		for i, step := range steps {
-			jsonTeacherComment, err := json.Marshal(step.TeacherComment)
-			if err != nil {
-				return nil, err
-			}
-
-			jsonParentComment, err := json.Marshal(step.ParentComment)
-			if err != nil {
-				return nil, err
-			}
-
-			jsonFriendComment, err := json.Marshal(step.FriendComment)
-			if err != nil {
-				return nil, err
-			}
-
-			stepsApi[i] = &stepApi.Step{
-				Id:             step.ID,
-				Title:          step.Title,
-				Description:    step.Description,
-				IsChallenge:    step.IsChallenge,
-				Type:           step.Type.String(),
-				ObjectName:     step.ObjectName,
-				CreatedAt:      step.CreatedAt,
-				RefTargetId:    step.RefTargetID,
-				TeacherComment: string(jsonTeacherComment),
-				ParentComment:  string(jsonParentComment),
-				FriendComment:  string(jsonFriendComment),
-			}
-
-			if step.Type != entStep.TypeDir {
-				presignedUrl, err := r.minioRepo.GetDownloadPreSignedUrl(ctx, step.ObjectName, r.data.minio_endpoint_remote)
-				if err != nil {
-					return nil, err
-				}
-				stepsApi[i].PresignedUrl = presignedUrl
-			}
-		}
-		reply.Steps = stepsApi
 	}
 
 	return reply, nil
 }
 
 func (r *stepRepo) AddTargetDirStep(ctx context.Context, req *stepApi.AddTargetDirStepRequest) (*stepApi.AddTargetDirStepReply, error) {
-	uid := utils.GetUid(ctx)
-	if uid == "" {
-		return nil, errors.New("uid is empty")
-	}
-
-	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
+	_, createStep, err := r.newTargetStepCreate(ctx, req.Id, req.ParentId, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	if target.UserID != uid {
-		return nil, errors.New("not owner")
-	}
-
-	step, err := r.data.ent_client.Step.Create().
+	step, err := createStep.
 		SetTitle(req.Title).
 		SetType(entStep.TypeDir).
-		SetCreatedAt(time.Now().Local().Unix()).
-		SetRefTargetID(req.Id).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -463,119 +580,143 @@ func (r *stepRepo) AddTargetDirStep(ctx context.Context, req *stepApi.AddTargetD
 	}, nil
 }
 
-func (r *stepRepo) GetTargetDirStepChildren(ctx context.Context, req *stepApi.GetTargetDirStepChildrenRequest) (*stepApi.GetTargetDirStepChildrenReply, error) {
+// newTargetStepCreate 不需要上传文件的积累(目录、计量、文字、链接)，校验目标和父目录后返回创建器
+func (r *stepRepo) newTargetStepCreate(ctx context.Context, targetID uint64, parentID uint64, stepTime int64) (*ent.Target, *ent.StepCreate, error) {
 	uid := utils.GetUid(ctx)
 	if uid == "" {
-		return nil, errors.New("uid is empty")
+		return nil, nil, stepApi.ErrorUnauthenticated("uid is empty")
 	}
 
-	target, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	target, err := r.data.ent_client.Target.Get(ctx, targetID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if target.UserID != uid {
-		return nil, errors.New("not owner")
+		return nil, nil, stepApi.ErrorNotOwner("not owner")
 	}
 
-	page := req.Page
-	if page == 0 {
-		page = 1
+	if stepTime == 0 {
+		stepTime = time.Now().Local().Unix()
 	}
 
-	pageSize := req.PageSize
-	if pageSize == 0 {
-		pageSize = 10
+	createStep := r.data.ent_client.Step.Create().
+		SetCreatedAt(stepTime).
+		SetRefTargetID(target.ID).
+		SetPath(objects.RootPath)
+
+	if parentID != 0 {
+		parentStep, err := r.data.ent_client.Step.Get(ctx, parentID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if parentStep.Type != entStep.TypeDir || parentStep.RefTargetID != target.ID {
+			return nil, nil, stepApi.ErrorInvalidArgument("parent is not a dir of the target")
+		}
+		createStep.SetParentID(parentID)
+		createStep.SetPath(objects.ChildPath(parentStep.Path, parentStep.ID))
 	}
 
-	query := r.data.ent_client.Step.Query().
-		Where(entStep.ParentIDEQ(req.Id))
+	return target, createStep, nil
+}
 
-	total, err := query.Count(ctx)
+// getOwnedStep 获取当前用户的积累，并校验类型
+func (r *stepRepo) getOwnedStep(ctx context.Context, stepID uint64, stepType entStep.Type) (*ent.Step, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.GetTargetByStepIDRecursively(ctx, stepID, 0)
 	if err != nil {
 		return nil, err
 	}
 
-	steps, err := query.Order(ent.Desc(entStep.FieldID)).Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).All(ctx)
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	step, err := r.data.ent_client.Step.Get(ctx, stepID)
 	if err != nil {
 		return nil, err
 	}
 
-	stepsApi := make([]*stepApi.Step, len(steps))
-	for i, step := range steps {
-		jsonTeacherComment, err := json.Marshal(step.TeacherComment)
-		if err != nil {
-			return nil, err
-		}
+	if step.Type != stepType {
+		return nil, stepApi.ErrorInvalidArgument("step is not %s type", stepType)
+	}
 
-		jsonParentComment, err := json.Marshal(step.ParentComment)
-		if err != nil {
-			return nil, err
-		}
+	return step, nil
+}
 
-		jsonFriendComment, err := json.Marshal(step.FriendComment)
-		if err != nil {
-			return nil, err
-		}
+func (r *stepRepo) AddTargetMeasurementStep(ctx context.Context, req *stepApi.AddTargetMeasurementStepRequest) (*stepApi.AddTargetMeasurementStepReply, error) {
+	target, createStep, err := r.newTargetStepCreate(ctx, req.Id, req.ParentId, req.StepTime)
+	if err != nil {
+		return nil, err
+	}
 
-		stepsApi[i] = &stepApi.Step{
-			Id:             step.ID,
-			Title:          step.Title,
-			Description:    step.Description,
-			IsChallenge:    step.IsChallenge,
-			Type:           step.Type.String(),
-			ObjectName:     step.ObjectName,
-			CreatedAt:      step.CreatedAt,
-			RefTargetId:    step.RefTargetID,
-			TeacherComment: string(jsonTeacherComment),
-			ParentComment:  string(jsonParentComment),
-			FriendComment:  string(jsonFriendComment),
-		}
+	if target.Mode != entTarget.ModeQuantity {
+		return nil, stepApi.ErrorInvalidArgument("measurement is only for quantity target")
+	}
 
-		presignedUrl, err := r.minioRepo.GetDownloadPreSignedUrl(ctx, step.ObjectName, r.data.minio_endpoint_remote)
-		if err != nil {
-			return nil, err
-		}
-		stepsApi[i].PresignedUrl = presignedUrl
+	step, err := createStep.
+		SetTitle(req.Title).
+		SetDescription(req.Description).
+		SetIsChallenge(req.IsChallenge).
+		SetType(entStep.TypeMeasurement).
+		SetMeasurement(req.Value).
+		Save(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return &stepApi.GetTargetDirStepChildrenReply{
-		Total: uint64(total),
-		Steps: stepsApi,
+	return &stepApi.AddTargetMeasurementStepReply{
+		Id: step.ID,
 	}, nil
 }
 
-func (r *stepRepo) UpdateTargetStep(ctx context.Context, req *stepApi.UpdateTargetStepRequest) (*stepApi.UpdateTargetStepReply, error) {
-	uid := utils.GetUid(ctx)
-	if uid == "" {
-		return nil, errors.New("uid is empty")
+func (r *stepRepo) CreateNoteStep(ctx context.Context, req *stepApi.CreateNoteStepRequest) (*stepApi.CreateNoteStepReply, error) {
+	if req.Content == "" {
+		return nil, stepApi.ErrorInvalidArgument("content is empty")
 	}
 
-	target, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	_, createStep, err := r.newTargetStepCreate(ctx, req.Id, req.ParentId, req.StepTime)
 	if err != nil {
 		return nil, err
 	}
 
-	if target.UserID != uid {
-		return nil, errors.New("not owner")
+	step, err := createStep.
+		SetTitle(req.Title).
+		SetIsChallenge(req.IsChallenge).
+		SetType(entStep.TypeNote).
+		SetContent(req.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	updateStep := r.data.ent_client.Step.UpdateOneID(req.Id)
+	return &stepApi.CreateNoteStepReply{
+		Id: step.ID,
+	}, nil
+}
 
-	if req.Title != "" {
-		updateStep.SetTitle(req.Title)
+func (r *stepRepo) UpdateNoteStep(ctx context.Context, req *stepApi.UpdateNoteStepRequest) (*stepApi.UpdateNoteStepReply, error) {
+	step, err := r.getOwnedStep(ctx, req.Id, entStep.TypeNote)
+	if err != nil {
+		return nil, err
 	}
 
-	if req.Description != "" {
-		updateStep.SetDescription(req.Description)
-	}
+	updateStep := step.Update()
 
-	if req.IsChallenge != nil {
-		updateStep.SetIsChallenge(req.IsChallenge.GetValue())
+	if req.Title != "" {
+		updateStep.SetTitle(req.Title)
 	}
 
-	if req.Type != "" {
-		updateStep.SetType(entStep.Type(req.Type))
+	if req.Content != nil {
+		if req.Content.GetValue() == "" {
+			return nil, stepApi.ErrorInvalidArgument("content is empty")
+		}
+		updateStep.SetContent(req.Content.GetValue())
 	}
 
 	_, err = updateStep.Save(ctx)
@@ -583,52 +724,721 @@ func (r *stepRepo) UpdateTargetStep(ctx context.Context, req *stepApi.UpdateTarg
 		return nil, err
 	}
 
-	return &stepApi.UpdateTargetStepReply{
+	return &stepApi.UpdateNoteStepReply{
 		Id: req.Id,
 	}, nil
 }
 
-func (r *stepRepo) DeleteTargetStep(ctx context.Context, req *stepApi.DeleteTargetStepRequest) (*stepApi.DeleteTargetStepReply, error) {
-	uid := utils.GetUid(ctx)
-	if uid == "" {
-		return nil, errors.New("uid is empty")
-	}
-
-	target, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+// fetchLinkMeta 抓取失败不影响积累的保存
+func (r *stepRepo) fetchLinkMeta(ctx context.Context, url string) *objects.LinkMeta {
+	meta, err := r.linkRepo.FetchLinkMeta(ctx, url)
 	if err != nil {
-		return nil, err
+		r.log.Warnf("fetch link meta of %s error: %v", url, err)
+		return &objects.LinkMeta{}
 	}
+	return meta
+}
 
-	if target.UserID != uid {
-		return nil, errors.New("not owner")
+func (r *stepRepo) CreateLinkStep(ctx context.Context, req *stepApi.CreateLinkStepRequest) (*stepApi.CreateLinkStepReply, error) {
+	if err := validateLinkUrl(req.Url); err != nil {
+		return nil, err
 	}
 
-	step, err := r.data.ent_client.Step.Query().
-		Where(entStep.ID(req.Id)).
-		First(ctx)
+	_, createStep, err := r.newTargetStepCreate(ctx, req.Id, req.ParentId, req.StepTime)
 	if err != nil {
 		return nil, err
 	}
 
-	if step.ObjectName != "" {
-		err = r.minioRepo.RemoveFile(ctx, step.ObjectName)
-		if err != nil {
-			return nil, err
-		}
-	}
+	meta := r.fetchLinkMeta(ctx, req.Url)
 
-	err = r.data.ent_client.Step.DeleteOneID(step.ID).Exec(ctx)
+	step, err := createStep.
+		SetTitle(req.Title).
+		SetDescription(req.Description).
+		SetIsChallenge(req.IsChallenge).
+		SetType(entStep.TypeLink).
+		SetURL(req.Url).
+		SetLinkTitle(meta.Title).
+		SetLinkDescription(meta.Description).
+		Save(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &stepApi.DeleteTargetStepReply{
-		Id: step.ID,
+	return &stepApi.CreateLinkStepReply{
+		Id:              step.ID,
+		LinkTitle:       step.LinkTitle,
+		LinkDescription: step.LinkDescription,
 	}, nil
 }
 
-func (r *stepRepo) SetTargetStatusRecursively(ctx context.Context, targetID uint64, status entTarget.Status) error {
-	switch status {
+func (r *stepRepo) UpdateLinkStep(ctx context.Context, req *stepApi.UpdateLinkStepRequest) (*stepApi.UpdateLinkStepReply, error) {
+	step, err := r.getOwnedStep(ctx, req.Id, entStep.TypeLink)
+	if err != nil {
+		return nil, err
+	}
+
+	updateStep := step.Update()
+
+	if req.Title != "" {
+		updateStep.SetTitle(req.Title)
+	}
+
+	if req.Description != "" {
+		updateStep.SetDescription(req.Description)
+	}
+
+	linkTitle, linkDescription := step.LinkTitle, step.LinkDescription
+	if req.Url != "" && req.Url != step.URL {
+		if err := validateLinkUrl(req.Url); err != nil {
+			return nil, err
+		}
+
+		meta := r.fetchLinkMeta(ctx, req.Url)
+		linkTitle, linkDescription = meta.Title, meta.Description
+		updateStep.
+			SetURL(req.Url).
+			SetLinkTitle(linkTitle).
+			SetLinkDescription(linkDescription)
+	}
+
+	_, err = updateStep.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.UpdateLinkStepReply{
+		Id:              req.Id,
+		LinkTitle:       linkTitle,
+		LinkDescription: linkDescription,
+	}, nil
+}
+
+func (r *stepRepo) GetTargetDirStepChildren(ctx context.Context, req *stepApi.GetTargetDirStepChildrenRequest) (*stepApi.GetTargetDirStepChildrenReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	query := r.data.ent_client.Step.Query().
+		Where(entStep.ParentIDEQ(req.Id))
+
+	filter := stepFilter{
+		StepTypes:   req.StepTypes,
+		IsChallenge: req.IsChallenge,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		CommentRole: req.CommentRole,
+		TagIDs:      req.TagIds,
+	}
+	steps, total, nextCursor, err := pageSteps(ctx, query, filter, req.Sort, req.Page, req.PageSize, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	stepsApi, err := toApiSteps(ctx, r.minioRepo, r.data.minio_endpoint_remote, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetTargetDirStepChildrenReply{
+		Total:      uint64(total),
+		Steps:      stepsApi,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (r *stepRepo) UpdateTargetStep(ctx context.Context, req *stepApi.UpdateTargetStepRequest) (*stepApi.UpdateTargetStepReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	updateStep := r.data.ent_client.Step.UpdateOneID(req.Id)
+
+	if req.Title != "" {
+		updateStep.SetTitle(req.Title)
+	}
+
+	if req.Description != "" {
+		updateStep.SetDescription(req.Description)
+	}
+
+	if req.IsChallenge != nil {
+		updateStep.SetIsChallenge(req.IsChallenge.GetValue())
+	}
+
+	if req.Type != "" {
+		updateStep.SetType(entStep.Type(req.Type))
+	}
+
+	if req.Measurement != nil {
+		if target.Mode != entTarget.ModeQuantity {
+			return nil, stepApi.ErrorInvalidArgument("measurement is only for quantity target")
+		}
+		updateStep.SetMeasurement(req.Measurement.GetValue())
+	}
+
+	_, err = updateStep.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已经统计过的积累，同步更新计量值
+	if req.Measurement != nil {
+		_, err = r.data.ent_client.StepRate.Update().
+			Where(steprate.StepID(req.Id)).
+			SetMeasurement(req.Measurement.GetValue()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &stepApi.UpdateTargetStepReply{
+		Id: req.Id,
+	}, nil
+}
+
+func (r *stepRepo) DeleteTargetStep(ctx context.Context, req *stepApi.DeleteTargetStepRequest) (*stepApi.DeleteTargetStepReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	step, err := r.data.ent_client.Step.Query().
+		Where(entStep.ID(req.Id)).
+		First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目录连同其下所有积累一起删除，评论和统计记录在同一个事务中删除
+	steps, err := r.getSubSteps(ctx, step)
+	if err != nil {
+		return nil, err
+	}
+	stepIDs := make([]uint64, len(steps))
+	for i, s := range steps {
+		stepIDs[i] = s.ID
+	}
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = func() error {
+		_, err := tx.Comment.Delete().Where(entComment.StepIDIn(stepIDs...)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.StepRateCriterion.Delete().Where(stepratecriterion.StepIDIn(stepIDs...)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.StepRate.Delete().Where(steprate.StepIDIn(stepIDs...)).Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Step.Delete().Where(entStep.IDIn(stepIDs...)).Exec(ctx)
+		return err
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("rollback error: %v", rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	// 评分统计中的StepRate已删除
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
+
+	// 数据库删除成功之后再删除文件，失败时只留下无用的文件
+	for _, s := range steps {
+		for _, objectName := range []string{s.PreviewObjectName, s.ObjectName} {
+			if objectName == "" {
+				continue
+			}
+			if err := r.minioRepo.RemoveFile(ctx, objectName); err != nil {
+				r.log.Errorf("remove object %s error: %v", objectName, err)
+			}
+		}
+	}
+
+	return &stepApi.DeleteTargetStepReply{
+		Id: step.ID,
+	}, nil
+}
+
+func (r *stepRepo) RenameDirStep(ctx context.Context, req *stepApi.RenameDirStepRequest) (*stepApi.RenameDirStepReply, error) {
+	if req.Title == "" {
+		return nil, stepApi.ErrorInvalidArgument("title is empty")
+	}
+
+	step, err := r.getOwnedStep(ctx, req.Id, entStep.TypeDir)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = step.Update().SetTitle(req.Title).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.RenameDirStepReply{
+		Id: req.Id,
+	}, nil
+}
+
+// getSubSteps 积累及其下所有积累，目录下的积累按路径前缀一次查询
+func (r *stepRepo) getSubSteps(ctx context.Context, step *ent.Step) ([]*ent.Step, error) {
+	steps := []*ent.Step{step}
+	if step.Type != entStep.TypeDir {
+		return steps, nil
+	}
+
+	descendants, err := r.data.ent_client.Step.Query().
+		Where(entStep.PathHasPrefix(objects.ChildPath(step.Path, step.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(steps, descendants...), nil
+}
+
+// stepObjectName 与上传时的对象名规则保持一致: uid/target_x/name 或 uid/target_x/dir_y/name
+func stepObjectName(uid string, targetID uint64, parentID uint64, objectName string) string {
+	if parentID == 0 {
+		return fmt.Sprintf("%s/target_%d/%s", uid, targetID, path.Base(objectName))
+	}
+	return fmt.Sprintf("%s/target_%d/dir_%d/%s", uid, targetID, parentID, path.Base(objectName))
+}
+
+// MoveStep 移动积累到其他目录或目标，目录会连同其下所有积累一起移动:
+// ● 更新ref_target_id和parent_id
+// ● 更新step_rates的target_id和top_target_id
+// ● 对象存储中的文件移动到新的target_x/dir_y前缀下
+func (r *stepRepo) MoveStep(ctx context.Context, req *stepApi.MoveStepRequest) (*stepApi.MoveStepReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	fromTarget, err := r.GetTargetByStepIDRecursively(ctx, req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromTarget.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	step, err := r.data.ent_client.Step.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	targetID := req.TargetId
+	if targetID == 0 && req.ParentId != 0 {
+		parentTarget, err := r.GetTargetByStepIDRecursively(ctx, req.ParentId, 0)
+		if err != nil {
+			return nil, err
+		}
+		targetID = parentTarget.ID
+	}
+	if targetID == 0 {
+		return nil, stepApi.ErrorInvalidArgument("target_id or parent_id is required")
+	}
+
+	toTarget, err := r.data.ent_client.Target.Get(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if toTarget.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	steps, err := r.getSubSteps(ctx, step)
+	if err != nil {
+		return nil, err
+	}
+
+	newPath := objects.RootPath
+	if req.ParentId != 0 {
+		parentStep, err := r.data.ent_client.Step.Get(ctx, req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		newPath = objects.ChildPath(parentStep.Path, parentStep.ID)
+
+		if parentStep.Type != entStep.TypeDir || parentStep.RefTargetID != toTarget.ID {
+			return nil, stepApi.ErrorInvalidArgument("parent is not a dir of the target")
+		}
+
+		// 不能移动到自己或自己的子目录下
+		for _, s := range steps {
+			if s.ID == parentStep.ID {
+				return nil, stepApi.ErrorInvalidArgument("can't move a dir into itself")
+			}
+		}
+	}
+
+	if step.RefTargetID == toTarget.ID && step.ParentID == req.ParentId {
+		return &stepApi.MoveStepReply{
+			Id:       step.ID,
+			TargetId: toTarget.ID,
+			ParentId: req.ParentId,
+		}, nil
+	}
+
+	topTarget, err := r.GetTopTargetByTargetID(ctx, toTarget.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 评分按顶层目标的评分标准解析，和SetTargetRubric一样，评价项或量程不同时已评分的积累不能移动
+	fromTopTarget, err := r.GetTopTargetByTargetID(ctx, fromTarget.ID)
+	if err != nil {
+		return nil, err
+	}
+	if fromTopTarget.ID != topTarget.ID && !fromTopTarget.Rubric.SameCriteria(topTarget.Rubric) {
+		stepIDs := make([]uint64, len(steps))
+		for i, s := range steps {
+			stepIDs[i] = s.ID
+		}
+		rated, err := r.data.ent_client.Comment.Query().
+			Where(entComment.StepIDIn(stepIDs...), entComment.ParentIDIsNil(), entComment.RatingNotNil()).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if rated {
+			return nil, stepApi.ErrorConflict("rated steps can only move to a target with the same rubric criteria and scale")
+		}
+	}
+
+	// 先复制文件，数据库更新成功之后再删除旧文件
+	renamed := make(map[string]string)
+	newObjectNames := make(map[uint64]string)
+	newPreviewObjectNames := make(map[uint64]string)
+	for _, s := range steps {
+		if s.ObjectName == "" {
+			continue
+		}
+
+		parentID := s.ParentID
+		if s.ID == step.ID {
+			parentID = req.ParentId
+		}
+		newObjectName := stepObjectName(uid, toTarget.ID, parentID, s.ObjectName)
+		if newObjectName == s.ObjectName {
+			continue
+		}
+		newObjectNames[s.ID] = newObjectName
+		renamed[s.ObjectName] = newObjectName
+
+		if s.PreviewObjectName != "" {
+			newPreviewObjectName := newObjectName + strings.TrimPrefix(s.PreviewObjectName, s.ObjectName)
+			newPreviewObjectNames[s.ID] = newPreviewObjectName
+			renamed[s.PreviewObjectName] = newPreviewObjectName
+		}
+	}
+
+	copied := make([]string, 0, len(renamed))
+	removeCopied := func() {
+		for _, objectName := range copied {
+			if err := r.minioRepo.RemoveFile(ctx, objectName); err != nil {
+				r.log.Errorf("remove copied object %s error: %v", objectName, err)
+			}
+		}
+	}
+	for oldObjectName, newObjectName := range renamed {
+		err = r.minioRepo.CopyFile(ctx, oldObjectName, newObjectName)
+		if err != nil {
+			removeCopied()
+			return nil, err
+		}
+		copied = append(copied, newObjectName)
+	}
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		removeCopied()
+		return nil, err
+	}
+
+	err = func() error {
+		updateStep := tx.Step.UpdateOneID(step.ID).SetPath(newPath)
+		if req.ParentId != 0 {
+			updateStep.SetParentID(req.ParentId)
+		} else {
+			updateStep.ClearParentID()
+		}
+		err := updateStep.Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		stepIDs := make([]uint64, len(steps))
+		for i, s := range steps {
+			stepIDs[i] = s.ID
+		}
+
+		err = tx.Step.Update().
+			Where(entStep.IDIn(stepIDs...)).
+			SetRefTargetID(toTarget.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		// 子孙积累的路径替换为新的前缀，路径相同的一起更新
+		oldPrefix := objects.ChildPath(step.Path, step.ID)
+		newPrefix := objects.ChildPath(newPath, step.ID)
+		descendantIDs := make(map[string][]uint64)
+		for _, s := range steps[1:] {
+			path := newPrefix + strings.TrimPrefix(s.Path, oldPrefix)
+			descendantIDs[path] = append(descendantIDs[path], s.ID)
+		}
+		for path, ids := range descendantIDs {
+			err = tx.Step.Update().
+				Where(entStep.IDIn(ids...)).
+				SetPath(path).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		for stepID, newObjectName := range newObjectNames {
+			updateStep := tx.Step.UpdateOneID(stepID).SetObjectName(newObjectName)
+			if newPreviewObjectName, ok := newPreviewObjectNames[stepID]; ok {
+				updateStep.SetPreviewObjectName(newPreviewObjectName)
+			}
+			err = updateStep.Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.StepRate.Update().
+			Where(steprate.StepIDIn(stepIDs...)).
+			SetTargetID(toTarget.ID).
+			SetTopTargetID(topTarget.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		return tx.StepRateCriterion.Update().
+			Where(stepratecriterion.StepIDIn(stepIDs...)).
+			SetTargetID(toTarget.ID).
+			SetTopTargetID(topTarget.ID).
+			Exec(ctx)
+	}()
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("rollback error: %v", rerr)
+		}
+		removeCopied()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		removeCopied()
+		return nil, err
+	}
+	// step rate换了目标，按目标统计的画像需要重新计算
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindPortrait)
+
+	for oldObjectName := range renamed {
+		if err := r.minioRepo.RemoveFile(ctx, oldObjectName); err != nil {
+			r.log.Errorf("remove moved object %s error: %v", oldObjectName, err)
+		}
+	}
+
+	return &stepApi.MoveStepReply{
+		Id:       step.ID,
+		TargetId: toTarget.ID,
+		ParentId: req.ParentId,
+		Moved:    uint32(len(steps)),
+	}, nil
+}
+
+func (r *stepRepo) ReorderTargets(ctx context.Context, req *stepApi.ReorderTargetsRequest) (*stepApi.ReorderTargetsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	query := r.data.ent_client.Target.Query().Where(entTarget.UserID(uid))
+	if req.ParentId == 0 {
+		query = query.Where(entTarget.Or(entTarget.ParentIDIsNil(), entTarget.ParentID(0)))
+	} else {
+		query = query.Where(entTarget.ParentID(req.ParentId))
+	}
+
+	siblings, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make(map[uint64]float64, len(siblings))
+	for _, sibling := range siblings {
+		ranks[sibling.ID] = sibling.SortOrder
+	}
+
+	changes, err := rerankIDs(req.Ids, ranks)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for id, rank := range changes {
+		err = tx.Target.UpdateOneID(id).SetSortOrder(rank).Exec(ctx)
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				r.log.Errorf("rollback error: %v", rerr)
+			}
+			return nil, err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
+
+	return &stepApi.ReorderTargetsReply{
+		Updated: uint32(len(changes)),
+	}, nil
+}
+
+func (r *stepRepo) ReorderSteps(ctx context.Context, req *stepApi.ReorderStepsRequest) (*stepApi.ReorderStepsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	query := r.data.ent_client.Step.Query().Where(entStep.RefTargetID(target.ID))
+	if req.ParentId == 0 {
+		query = query.Where(entStep.Or(entStep.ParentIDIsNil(), entStep.ParentID(0)))
+	} else {
+		query = query.Where(entStep.ParentID(req.ParentId))
+	}
+
+	siblings, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make(map[uint64]float64, len(siblings))
+	for _, sibling := range siblings {
+		ranks[sibling.ID] = sibling.SortOrder
+	}
+
+	changes, err := rerankIDs(req.Ids, ranks)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for id, rank := range changes {
+		err = tx.Step.UpdateOneID(id).SetSortOrder(rank).Exec(ctx)
+		if err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				r.log.Errorf("rollback error: %v", rerr)
+			}
+			return nil, err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// 和ReorderTargets一样，排序变化后清除缓存
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
+
+	return &stepApi.ReorderStepsReply{
+		Updated: uint32(len(changes)),
+	}, nil
+}
+
+// rerankIDs ids需要包含全部的同级项，返回需要修改的id -> 新rank
+func rerankIDs(ids []uint64, ranks map[uint64]float64) (map[uint64]float64, error) {
+	if len(ids) != len(ranks) {
+		return nil, stepApi.ErrorInvalidArgument("ids must contain all siblings")
+	}
+
+	current := make([]float64, len(ids))
+	seen := make(map[uint64]bool, len(ids))
+	for i, id := range ids {
+		rank, ok := ranks[id]
+		if !ok || seen[id] {
+			return nil, stepApi.ErrorInvalidArgument("invalid sibling id: %d", id)
+		}
+		seen[id] = true
+		current[i] = rank
+	}
+
+	changes := make(map[uint64]float64)
+	for i, rank := range objects.Rerank(current) {
+		changes[ids[i]] = rank
+	}
+	return changes, nil
+}
+
+func (r *stepRepo) SetTargetStatusRecursively(ctx context.Context, targetID uint64, status entTarget.Status) error {
+	switch status {
 	case entTarget.StatusStep:
 		target, err := r.data.ent_client.Target.Query().
 			Where(entTarget.ID(targetID)).
@@ -646,6 +1456,7 @@ func (r *stepRepo) SetTargetStatusRecursively(ctx context.Context, targetID uint
 			if err != nil {
 				return err
 			}
+			r.data.cache.InvalidateUserCache(ctx, target.UserID, objects.CacheKindTargetTree)
 
 			// 递归设置父目标状态
 			if target.Edges.Parent != nil {
@@ -675,6 +1486,7 @@ func (r *stepRepo) SetTargetStatusRecursively(ctx context.Context, targetID uint
 			if err != nil {
 				return err
 			}
+			r.data.cache.InvalidateUserCache(ctx, target.UserID, objects.CacheKindTargetTree)
 
 			// 递归设置父目标状态
 			if target.Edges.Parent != nil {
@@ -704,6 +1516,7 @@ func (r *stepRepo) SetTargetStatusRecursively(ctx context.Context, targetID uint
 			if err != nil {
 				return err
 			}
+			r.data.cache.InvalidateUserCache(ctx, target.UserID, objects.CacheKindTargetTree)
 
 			if target.Edges.Parent != nil {
 				existNonDoneChild, err := r.data.ent_client.Target.Query().
@@ -724,10 +1537,11 @@ func (r *stepRepo) SetTargetStatusRecursively(ctx context.Context, targetID uint
 
 		return nil
 	default:
-		return fmt.Errorf("invalid status: %s", status)
+		return stepApi.ErrorInvalidArgument("invalid status: %s", status)
 	}
 }
 
+// GetTargetByStepIDRecursively 积累都会设置ref_target_id，没有时取路径中最近的有目标的祖先目录
 func (r *stepRepo) GetTargetByStepIDRecursively(ctx context.Context, stepID uint64, rootStepID uint64) (*ent.Target, error) {
 	if rootStepID == 0 {
 		rootStepID = stepID
@@ -736,7 +1550,6 @@ func (r *stepRepo) GetTargetByStepIDRecursively(ctx context.Context, stepID uint
 	step, err := r.data.ent_client.Step.Query().
 		Where(entStep.ID(stepID)).
 		WithTarget().
-		WithParent().
 		First(ctx)
 	if err != nil {
 		return nil, err
@@ -746,25 +1559,132 @@ func (r *stepRepo) GetTargetByStepIDRecursively(ctx context.Context, stepID uint
 		return step.Edges.Target, nil
 	}
 
-	// there is no associated target and no parent step
-	if step.Edges.Parent == nil {
-		return nil, fmt.Errorf("step %d has no associated target", rootStepID)
-	} else {
-		return r.GetTargetByStepIDRecursively(ctx, step.Edges.Parent.ID, rootStepID)
+	ancestorIDs := objects.PathIDs(step.Path)
+	if len(ancestorIDs) == 0 {
+		return nil, stepApi.ErrorTargetNotFound("step %d has no associated target", rootStepID)
+	}
+
+	ancestors, err := r.data.ent_client.Step.Query().
+		Where(entStep.IDIn(ancestorIDs...), entStep.HasTarget()).
+		WithTarget().
+		All(ctx)
+	if err != nil {
+		return nil, err
 	}
+
+	ancestorTargets := make(map[uint64]*ent.Target, len(ancestors))
+	for _, ancestor := range ancestors {
+		ancestorTargets[ancestor.ID] = ancestor.Edges.Target
+	}
+	for i := len(ancestorIDs) - 1; i >= 0; i-- {
+		if target, ok := ancestorTargets[ancestorIDs[i]]; ok {
+			return target, nil
+		}
+	}
+
+	return nil, stepApi.ErrorTargetNotFound("step %d has no associated target", rootStepID)
 }
 
 func (r *stepRepo) GetTopTargetByTargetID(ctx context.Context, targetID uint64) (*ent.Target, error) {
-	target, err := r.data.ent_client.Target.Query().
-		Where(entTarget.ID(targetID)).
-		First(ctx)
+	target, err := r.data.ent_client.Target.Get(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findRootTarget(ctx, target)
+}
+
+// GetTargetCheckins 目标下所有积累的打卡时间(不含目录)
+func (r *stepRepo) GetTargetCheckins(ctx context.Context, targetID uint64) ([]time.Time, error) {
+	var createdAts []int64
+	err := r.data.ent_client.Step.Query().
+		Where(entStep.RefTargetIDEQ(targetID), entStep.TypeNEQ(entStep.TypeDir)).
+		Select(entStep.FieldCreatedAt).
+		Scan(ctx, &createdAts)
+	if err != nil {
+		return nil, err
+	}
+
+	checkins := make([]time.Time, len(createdAts))
+	for i, createdAt := range createdAts {
+		checkins[i] = time.Unix(createdAt, 0).Local()
+	}
+	return checkins, nil
+}
+
+func (r *stepRepo) GetTargetAdherence(ctx context.Context, req *stepApi.GetTargetAdherenceRequest) (*stepApi.GetTargetAdherenceReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	statUnit := req.StatUnit
+	if statUnit == "" {
+		statUnit = objects.StatUnitDay
+	}
+	if statUnit != objects.StatUnitDay && statUnit != objects.StatUnitWeek && statUnit != objects.StatUnitMonth {
+		return nil, stepApi.ErrorInvalidArgument("invalid stat_unit: %s", statUnit)
+	}
+
+	startDate := time.Unix(target.CreatedAt, 0).Local()
+	if req.StartDate != "" {
+		startDate, err = time.ParseInLocation("2006-01-02", req.StartDate, time.Local)
+		if err != nil {
+			return nil, err
+		}
+	}
+	endDate := time.Now().Local()
+	if target.Status == entTarget.StatusDone && target.DoneAt != 0 {
+		endDate = time.Unix(target.DoneAt, 0).Local()
+	}
+	if req.EndDate != "" {
+		endDate, err = time.ParseInLocation("2006-01-02", req.EndDate, time.Local)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checkins, err := r.GetTargetCheckins(ctx, target.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if target.Layer == 0 || target.ParentID == 0 {
-		return target, nil
+	schedule := targetSchedule(target)
+	reply := &stepApi.GetTargetAdherenceReply{
+		Id:       target.ID,
+		Schedule: toApiSchedule(schedule),
+		StatUnit: statUnit,
+		Streak:   uint32(schedule.Streak(checkins, time.Now().Local())),
+		Periods:  make([]*stepApi.AdherencePeriod, 0),
 	}
+	for _, period := range schedule.Adherence(checkins, startDate, endDate, statUnit) {
+		reply.Expected += uint32(period.Expected)
+		reply.Actual += uint32(period.Actual)
+		reply.Periods = append(reply.Periods, &stepApi.AdherencePeriod{
+			Unit:        period.Unit,
+			Expected:    uint32(period.Expected),
+			Actual:      uint32(period.Actual),
+			Rate:        adherenceRate(period.Actual, period.Expected),
+			MissedDates: period.MissedDates,
+		})
+	}
+	reply.Rate = adherenceRate(int(reply.Actual), int(reply.Expected))
 
-	return r.GetTopTargetByTargetID(ctx, target.ParentID)
+	return reply, nil
+}
+
+func adherenceRate(actual int, expected int) float64 {
+	if expected == 0 {
+		return 1
+	}
+	return decimal.NewFromInt(int64(actual)).Div(decimal.NewFromInt(int64(expected))).Round(2).InexactFloat64()
 }
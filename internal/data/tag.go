@@ -0,0 +1,293 @@
+package data
+
+import (
+	"context"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTag "step/internal/data/ent/tag"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/internal/utils"
+	"strings"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type tagRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTagRepo .
+func NewTagRepo(data *Data, logger log.Logger) biz.TagRepo {
+	return &tagRepo{
+		data: data,
+		log:  log.NewHelper(logger, log.WithMessageKey("tagRepo")),
+	}
+}
+
+func toApiTag(tag *ent.Tag) *stepApi.Tag {
+	return &stepApi.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+	}
+}
+
+// toApiTags 未加载标签时返回nil
+func toApiTags(tags []*ent.Tag) []*stepApi.Tag {
+	if tags == nil {
+		return nil
+	}
+	tagsApi := make([]*stepApi.Tag, len(tags))
+	for i, tag := range tags {
+		tagsApi[i] = toApiTag(tag)
+	}
+	return tagsApi
+}
+
+// getOwnedTag 获取当前用户的标签
+func (r *tagRepo) getOwnedTag(ctx context.Context, id uint64) (*ent.Tag, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	tag, err := r.data.ent_client.Tag.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	return tag, nil
+}
+
+func (r *tagRepo) CreateTag(ctx context.Context, req *stepApi.CreateTagRequest) (*stepApi.CreateTagReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	tag, err := r.data.ent_client.Tag.Create().
+		SetUserID(uid).
+		SetName(strings.TrimSpace(req.Name)).
+		SetColor(req.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.CreateTagReply{
+		Tag: toApiTag(tag),
+	}, nil
+}
+
+func (r *tagRepo) UpdateTag(ctx context.Context, req *stepApi.UpdateTagRequest) (*stepApi.UpdateTagReply, error) {
+	tag, err := r.getOwnedTag(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err = tag.Update().
+		SetName(strings.TrimSpace(req.Name)).
+		SetColor(req.Color).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.data.cache.InvalidateUserCache(ctx, tag.UserID, objects.CacheKindTargetTree)
+
+	return &stepApi.UpdateTagReply{
+		Tag: toApiTag(tag),
+	}, nil
+}
+
+func (r *tagRepo) DeleteTag(ctx context.Context, req *stepApi.DeleteTagRequest) (*stepApi.DeleteTagReply, error) {
+	tag, err := r.getOwnedTag(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 关联表中的记录由外键级联删除
+	err = r.data.ent_client.Tag.DeleteOneID(tag.ID).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目标树中有标签，画像的评分可以按标签统计
+	r.data.cache.InvalidateUserCache(ctx, tag.UserID, objects.CacheKindTargetTree, objects.CacheKindPortrait)
+
+	return &stepApi.DeleteTagReply{
+		Id: tag.ID,
+	}, nil
+}
+
+func (r *tagRepo) GetTags(ctx context.Context, req *stepApi.GetTagsRequest) (*stepApi.GetTagsReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	tags, err := r.data.ent_client.Tag.Query().
+		Where(entTag.UserID(uid)).
+		Order(ent.Asc(entTag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetTagsReply{
+		Tags: toApiTags(tags),
+	}, nil
+}
+
+// checkTagItems 目标和积累都需要属于当前用户
+func (r *tagRepo) checkTagItems(ctx context.Context, uid string, targetIDs []uint64, stepIDs []uint64) error {
+	if len(targetIDs) == 0 && len(stepIDs) == 0 {
+		return stepApi.ErrorInvalidArgument("target_ids or step_ids is required")
+	}
+
+	if len(targetIDs) > 0 {
+		count, err := r.data.ent_client.Target.Query().
+			Where(entTarget.IDIn(targetIDs...), entTarget.UserID(uid)).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count != len(uniqueIDs(targetIDs)) {
+			return stepApi.ErrorNotOwner("not owner")
+		}
+	}
+
+	if len(stepIDs) > 0 {
+		count, err := r.data.ent_client.Step.Query().
+			Where(entStep.IDIn(stepIDs...), entStep.HasTargetWith(entTarget.UserID(uid))).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count != len(uniqueIDs(stepIDs)) {
+			return stepApi.ErrorNotOwner("not owner")
+		}
+	}
+
+	return nil
+}
+
+func (r *tagRepo) AssignTag(ctx context.Context, req *stepApi.AssignTagRequest) (*stepApi.AssignTagReply, error) {
+	tag, err := r.getOwnedTag(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.checkTagItems(ctx, tag.UserID, req.TargetIds, req.StepIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已有的关联不重复添加
+	assignedTargetIDs, err := tag.QueryTargets().Where(entTarget.IDIn(req.TargetIds...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assignedStepIDs, err := tag.QuerySteps().Where(entStep.IDIn(req.StepIds...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tag.Update().
+		AddTargetIDs(excludeIDs(uniqueIDs(req.TargetIds), assignedTargetIDs)...).
+		AddStepIDs(excludeIDs(uniqueIDs(req.StepIds), assignedStepIDs)...).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目标树中有标签，画像的评分可以按标签统计
+	r.data.cache.InvalidateUserCache(ctx, tag.UserID, objects.CacheKindTargetTree, objects.CacheKindPortrait)
+
+	return &stepApi.AssignTagReply{
+		Id: tag.ID,
+	}, nil
+}
+
+func (r *tagRepo) UnassignTag(ctx context.Context, req *stepApi.UnassignTagRequest) (*stepApi.UnassignTagReply, error) {
+	tag, err := r.getOwnedTag(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.checkTagItems(ctx, tag.UserID, req.TargetIds, req.StepIds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tag.Update().
+		RemoveTargetIDs(req.TargetIds...).
+		RemoveStepIDs(req.StepIds...).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 目标树中有标签，画像的评分可以按标签统计
+	r.data.cache.InvalidateUserCache(ctx, tag.UserID, objects.CacheKindTargetTree, objects.CacheKindPortrait)
+
+	return &stepApi.UnassignTagReply{
+		Id: tag.ID,
+	}, nil
+}
+
+func (r *tagRepo) TaggedIDs(ctx context.Context, uid string, tagIDs []uint64) ([]uint64, []uint64, error) {
+	tagFilter := entTag.And(entTag.IDIn(tagIDs...), entTag.UserID(uid))
+
+	targetIDs, err := r.data.ent_client.Target.Query().
+		Where(entTarget.HasTagsWith(tagFilter)).
+		IDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stepIDs, err := r.data.ent_client.Step.Query().
+		Where(entStep.HasTagsWith(tagFilter)).
+		IDs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return targetIDs, stepIDs, nil
+}
+
+func uniqueIDs(ids []uint64) []uint64 {
+	seen := make(map[uint64]bool, len(ids))
+	unique := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func excludeIDs(ids []uint64, excluded []uint64) []uint64 {
+	skip := make(map[uint64]bool, len(excluded))
+	for _, id := range excluded {
+		skip[id] = true
+	}
+	var result []uint64
+	for _, id := range ids {
+		if !skip[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
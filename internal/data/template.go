@@ -0,0 +1,570 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"step/internal/biz"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	entTemplate "step/internal/data/ent/template"
+	"step/internal/objects"
+	"step/internal/utils"
+	"strings"
+	"time"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type templateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTemplateRepo .
+func NewTemplateRepo(data *Data, logger log.Logger) biz.TemplateRepo {
+	return &templateRepo{
+		data: data,
+		log:  log.NewHelper(logger, log.WithMessageKey("templateRepo")),
+	}
+}
+
+// newShareToken 分享链接中的随机令牌
+func newShareToken() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func toApiTemplateDirs(dirs []*objects.TemplateDir) []*stepApi.TemplateDir {
+	dirsApi := make([]*stepApi.TemplateDir, len(dirs))
+	for i, dir := range dirs {
+		dirsApi[i] = &stepApi.TemplateDir{
+			Title:       dir.Title,
+			Description: dir.Description,
+			Dirs:        toApiTemplateDirs(dir.Dirs),
+		}
+	}
+	return dirsApi
+}
+
+func toApiTemplateTarget(node *objects.TemplateTarget) *stepApi.TemplateTarget {
+	children := make([]*stepApi.TemplateTarget, len(node.Children))
+	for i, child := range node.Children {
+		children[i] = toApiTemplateTarget(child)
+	}
+	return &stepApi.TemplateTarget{
+		Title:       node.Title,
+		Description: node.Description,
+		Type:        node.Type,
+		Mode:        node.Mode,
+		Unit:        node.Unit,
+		GoalValue:   node.GoalValue,
+		Schedule: toApiSchedule(&objects.Schedule{
+			Type:     node.ScheduleType,
+			Weekdays: node.ScheduleWeekdays,
+			Times:    node.ScheduleTimes,
+		}),
+		Dirs:     toApiTemplateDirs(node.Dirs),
+		Children: children,
+	}
+}
+
+// toApiTemplate 分享令牌只返回给所有者，withRoot时返回完整的目标树
+func toApiTemplate(template *ent.Template, uid string, withRoot bool) *stepApi.Template {
+	targets, dirs := template.Content.Count()
+	templateApi := &stepApi.Template{
+		Id:          template.ID,
+		Title:       template.Title,
+		Description: template.Description,
+		Visibility:  template.Visibility.String(),
+		Targets:     uint32(targets),
+		Dirs:        uint32(dirs),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+		IsOwner:     template.UserID == uid,
+	}
+	if templateApi.IsOwner {
+		templateApi.ShareToken = template.ShareToken
+	}
+	if withRoot {
+		templateApi.Root = toApiTemplateTarget(template.Content)
+	}
+	return templateApi
+}
+
+// getOwnedTemplate 获取当前用户的模板
+func (r *templateRepo) getOwnedTemplate(ctx context.Context, id uint64) (*ent.Template, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	template, err := r.data.ent_client.Template.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	return template, nil
+}
+
+// getSharedTemplate 通过分享令牌获取模板，改为私有后链接失效
+func (r *templateRepo) getSharedTemplate(ctx context.Context, shareToken string) (*ent.Template, error) {
+	if shareToken == "" {
+		return nil, stepApi.ErrorInvalidArgument("share_token is empty")
+	}
+
+	template, err := r.data.ent_client.Template.Query().
+		Where(
+			entTemplate.ShareToken(shareToken),
+			entTemplate.VisibilityEQ(entTemplate.VisibilityLink),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, stepApi.ErrorResourceNotFound("template not found")
+		}
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// snapshotDirs 目标下的目录结构，按手动排序
+func (r *templateRepo) snapshotDirs(ctx context.Context, targetID uint64) ([]*objects.TemplateDir, error) {
+	steps, err := r.data.ent_client.Step.Query().
+		Where(entStep.RefTargetID(targetID), entStep.TypeEQ(entStep.TypeDir)).
+		Order(stepOrder(objects.SortManual)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[uint64][]*ent.Step)
+	for _, step := range steps {
+		children[step.ParentID] = append(children[step.ParentID], step)
+	}
+
+	var build func(parentID uint64) []*objects.TemplateDir
+	build = func(parentID uint64) []*objects.TemplateDir {
+		var dirs []*objects.TemplateDir
+		for _, step := range children[parentID] {
+			dirs = append(dirs, &objects.TemplateDir{
+				Title:       step.Title,
+				Description: step.Description,
+				Dirs:        build(step.ID),
+			})
+		}
+		return dirs
+	}
+
+	return build(0), nil
+}
+
+// snapshotTarget 目标及其子目标的结构
+func (r *templateRepo) snapshotTarget(ctx context.Context, target *ent.Target, depth int) (*objects.TemplateTarget, error) {
+	if depth > objects.TemplateMaxDepth {
+		return nil, stepApi.ErrorInvalidArgument("target tree is too deep")
+	}
+
+	dirs, err := r.snapshotDirs(ctx, target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &objects.TemplateTarget{
+		Title:            target.Title,
+		Description:      target.Description,
+		Type:             target.Type,
+		Mode:             target.Mode.String(),
+		Unit:             target.Unit,
+		GoalValue:        target.GoalValue,
+		ScheduleType:     target.ScheduleType.String(),
+		ScheduleWeekdays: target.ScheduleWeekdays,
+		ScheduleTimes:    target.ScheduleTimes,
+		Dirs:             dirs,
+	}
+
+	children, err := r.data.ent_client.Target.Query().
+		Where(entTarget.ParentID(target.ID)).
+		Order(targetOrder(objects.SortManual)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		childNode, err := r.snapshotTarget(ctx, child, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+
+	return node, nil
+}
+
+func (r *templateRepo) CreateTemplate(ctx context.Context, req *stepApi.CreateTemplateRequest) (*stepApi.CreateTemplateReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = objects.TemplateVisibilityPrivate
+	}
+	if !objects.ValidTemplateVisibility(visibility) {
+		return nil, stepApi.ErrorInvalidArgument("invalid visibility")
+	}
+
+	target, err := r.data.ent_client.Target.Get(ctx, req.TargetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.UserID != uid {
+		return nil, stepApi.ErrorNotOwner("not owner")
+	}
+
+	content, err := r.snapshotTarget(ctx, target, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = content.Validate()
+	if err != nil {
+		return nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = target.Title
+	}
+
+	createTemplate := r.data.ent_client.Template.Create().
+		SetUserID(uid).
+		SetTitle(title).
+		SetDescription(req.Description).
+		SetVisibility(entTemplate.Visibility(visibility)).
+		SetContent(content).
+		SetSourceTargetID(target.ID)
+
+	if visibility == objects.TemplateVisibilityLink {
+		shareToken, err := newShareToken()
+		if err != nil {
+			return nil, err
+		}
+		createTemplate.SetShareToken(shareToken)
+	}
+
+	template, err := createTemplate.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.CreateTemplateReply{
+		Template: toApiTemplate(template, uid, false),
+	}, nil
+}
+
+// UpdateTemplate 改为链接可见时生成分享令牌，改为私有时清除，原来的链接失效
+func (r *templateRepo) UpdateTemplate(ctx context.Context, req *stepApi.UpdateTemplateRequest) (*stepApi.UpdateTemplateReply, error) {
+	template, err := r.getOwnedTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTemplate := template.Update().
+		SetDescription(req.Description).
+		SetUpdatedAt(time.Now().Local().Unix())
+
+	if title := strings.TrimSpace(req.Title); title != "" {
+		updateTemplate.SetTitle(title)
+	}
+
+	visibility := template.Visibility.String()
+	if req.Visibility != "" {
+		if !objects.ValidTemplateVisibility(req.Visibility) {
+			return nil, stepApi.ErrorInvalidArgument("invalid visibility")
+		}
+		visibility = req.Visibility
+		updateTemplate.SetVisibility(entTemplate.Visibility(visibility))
+	}
+
+	if visibility == objects.TemplateVisibilityPrivate {
+		updateTemplate.ClearShareToken()
+	} else if template.ShareToken == "" || req.ResetShareToken {
+		shareToken, err := newShareToken()
+		if err != nil {
+			return nil, err
+		}
+		updateTemplate.SetShareToken(shareToken)
+	}
+
+	template, err = updateTemplate.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.UpdateTemplateReply{
+		Template: toApiTemplate(template, template.UserID, false),
+	}, nil
+}
+
+func (r *templateRepo) DeleteTemplate(ctx context.Context, req *stepApi.DeleteTemplateRequest) (*stepApi.DeleteTemplateReply, error) {
+	template, err := r.getOwnedTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.data.ent_client.Template.DeleteOne(template).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.DeleteTemplateReply{
+		Id: req.Id,
+	}, nil
+}
+
+func (r *templateRepo) GetTemplates(ctx context.Context, req *stepApi.GetTemplatesRequest) (*stepApi.GetTemplatesReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	templates, err := r.data.ent_client.Template.Query().
+		Where(entTemplate.UserID(uid)).
+		Order(ent.Desc(entTemplate.FieldUpdatedAt), ent.Desc(entTemplate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templatesApi := make([]*stepApi.Template, len(templates))
+	for i, template := range templates {
+		templatesApi[i] = toApiTemplate(template, uid, false)
+	}
+
+	return &stepApi.GetTemplatesReply{
+		Templates: templatesApi,
+	}, nil
+}
+
+func (r *templateRepo) GetTemplate(ctx context.Context, req *stepApi.GetTemplateRequest) (*stepApi.GetTemplateReply, error) {
+	template, err := r.getOwnedTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetTemplateReply{
+		Template: toApiTemplate(template, template.UserID, true),
+	}, nil
+}
+
+func (r *templateRepo) GetSharedTemplate(ctx context.Context, req *stepApi.GetSharedTemplateRequest) (*stepApi.GetSharedTemplateReply, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	template, err := r.getSharedTemplate(ctx, req.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stepApi.GetSharedTemplateReply{
+		Template: toApiTemplate(template, uid, true),
+	}, nil
+}
+
+// templateTreeCreator 在事务中按模板创建目标树
+type templateTreeCreator struct {
+	tx        *ent.Tx
+	uid       string
+	now       int64
+	targetIDs []uint64
+	dirs      int
+}
+
+func (c *templateTreeCreator) createDirs(ctx context.Context, targetID uint64, parentID uint64, path string, dirs []*objects.TemplateDir) error {
+	for i, dir := range dirs {
+		createStep := c.tx.Step.Create().
+			SetRefTargetID(targetID).
+			SetPath(path).
+			SetType(entStep.TypeDir).
+			SetTitle(dir.Title).
+			SetDescription(dir.Description).
+			SetCreatedAt(c.now).
+			SetSortOrder(objects.RankGap * float64(i+1))
+		if parentID != 0 {
+			createStep.SetParentID(parentID)
+		}
+
+		step, err := createStep.Save(ctx)
+		if err != nil {
+			return err
+		}
+		c.dirs++
+
+		err = c.createDirs(ctx, targetID, step.ID, objects.ChildPath(path, step.ID), dir.Dirs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createTarget 子目标保持模板中的顺序
+func (c *templateTreeCreator) createTarget(ctx context.Context, node *objects.TemplateTarget, parentID uint64, path string, layer uint32, sortOrder float64) (*ent.Target, error) {
+	createTarget := c.tx.Target.Create().
+		SetUserID(c.uid).
+		SetTitle(node.Title).
+		SetDescription(node.Description).
+		SetCreatedAt(c.now).
+		SetStatus(entTarget.StatusInit).
+		SetLayer(layer).
+		SetPath(path).
+		SetSortOrder(sortOrder)
+
+	if node.Type != "" {
+		createTarget.SetType(node.Type)
+	}
+
+	if node.Mode != "" {
+		err := validateTargetMode(entTarget.Mode(node.Mode), node.GoalValue)
+		if err != nil {
+			return nil, err
+		}
+		createTarget.
+			SetMode(entTarget.Mode(node.Mode)).
+			SetUnit(node.Unit).
+			SetGoalValue(node.GoalValue)
+	}
+
+	if node.ScheduleType != "" {
+		schedule := &objects.Schedule{
+			Type:     node.ScheduleType,
+			Weekdays: node.ScheduleWeekdays,
+			Times:    node.ScheduleTimes,
+		}
+		if err := schedule.Validate(); err != nil {
+			return nil, err
+		}
+		createTarget.
+			SetScheduleType(entTarget.ScheduleType(schedule.Type)).
+			SetScheduleWeekdays(schedule.Weekdays).
+			SetScheduleTimes(schedule.Times)
+	}
+
+	if parentID != 0 {
+		createTarget.SetParentID(parentID)
+	}
+
+	target, err := createTarget.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.targetIDs = append(c.targetIDs, target.ID)
+
+	err = c.createDirs(ctx, target.ID, 0, objects.RootPath, node.Dirs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, child := range node.Children {
+		_, err := c.createTarget(ctx, child, target.ID, objects.ChildPath(path, target.ID), layer+1, objects.RankGap*float64(i+1))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+// InstantiateTemplate 自己的模板用id，分享的模板用share_token，新目标树的状态都是未开始
+func (r *templateRepo) InstantiateTemplate(ctx context.Context, req *stepApi.InstantiateTemplateRequest) (*stepApi.InstantiateTemplateReply, []uint64, error) {
+	uid := utils.GetUid(ctx)
+	if uid == "" {
+		return nil, nil, stepApi.ErrorUnauthenticated("uid is empty")
+	}
+
+	var template *ent.Template
+	var err error
+	if req.ShareToken != "" {
+		template, err = r.getSharedTemplate(ctx, req.ShareToken)
+	} else {
+		template, err = r.getOwnedTemplate(ctx, req.Id)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := *template.Content
+	if title := strings.TrimSpace(req.Title); title != "" {
+		root.Title = title
+	}
+
+	err = root.Validate()
+	if err != nil {
+		return nil, nil, stepApi.ErrorInvalidArgument("%v", err)
+	}
+
+	var layer uint32
+	path := objects.RootPath
+	if req.ParentId != 0 {
+		parentTarget, err := r.data.ent_client.Target.Get(ctx, req.ParentId)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if parentTarget.UserID != uid {
+			return nil, nil, stepApi.ErrorNotOwner("not owner")
+		}
+
+		layer = parentTarget.Layer + 1
+		path = objects.ChildPath(parentTarget.Path, parentTarget.ID)
+	}
+
+	tx, err := r.data.ent_client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	creator := &templateTreeCreator{
+		tx:  tx,
+		uid: uid,
+		now: time.Now().Local().Unix(),
+	}
+	// 和新创建的目标一样，不设置手动排序
+	target, err := creator.createTarget(ctx, &root, req.ParentId, path, layer, 0)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			r.log.Errorf("rollback error: %v", rerr)
+		}
+		return nil, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.data.cache.InvalidateUserCache(ctx, uid, objects.CacheKindTargetTree)
+
+	return &stepApi.InstantiateTemplateReply{
+		Id:      target.ID,
+		Targets: uint32(len(creator.targetIDs)),
+		Dirs:    uint32(creator.dirs),
+	}, creator.targetIDs, nil
+}
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+)
+
+// 补全路径时每次更新的最大ID数
+const treePathBatchSize = 500
+
+// treePathNode 补全路径时只需要id、parent_id和path
+type treePathNode struct {
+	ID       uint64  `json:"id"`
+	ParentID *uint64 `json:"parent_id"`
+	Path     string  `json:"path"`
+}
+
+// treePaths 计算路径为空的节点的路径，返回路径 => 节点ID
+func treePaths(nodes []*treePathNode) (map[string][]uint64, error) {
+	byID := make(map[uint64]*treePathNode, len(nodes))
+	// 解析子节点时会同时填上祖先的路径，先记下需要补全的节点
+	var pending []*treePathNode
+	for _, node := range nodes {
+		byID[node.ID] = node
+		if node.Path == "" {
+			pending = append(pending, node)
+		}
+	}
+
+	var resolve func(node *treePathNode, depth int) (string, error)
+	resolve = func(node *treePathNode, depth int) (string, error) {
+		if node.Path != "" {
+			return node.Path, nil
+		}
+		if depth > len(nodes) {
+			return "", fmt.Errorf("node %d has a parent cycle", node.ID)
+		}
+
+		path := objects.RootPath
+		if node.ParentID != nil && *node.ParentID != 0 {
+			parent, ok := byID[*node.ParentID]
+			if !ok {
+				return "", fmt.Errorf("node %d has no parent %d", node.ID, *node.ParentID)
+			}
+			parentPath, err := resolve(parent, depth+1)
+			if err != nil {
+				return "", err
+			}
+			path = objects.ChildPath(parentPath, parent.ID)
+		}
+		node.Path = path
+		return path, nil
+	}
+
+	paths := make(map[string][]uint64)
+	for _, node := range pending {
+		path, err := resolve(node, 0)
+		if err != nil {
+			return nil, err
+		}
+		paths[path] = append(paths[path], node.ID)
+	}
+	return paths, nil
+}
+
+// backfillTreePaths 为添加路径之前创建的目标和积累补全路径，没有路径为空的数据时不做处理
+func backfillTreePaths(ctx context.Context, client *ent.Client) (int, error) {
+	updated := 0
+
+	exist, err := client.Target.Query().Where(entTarget.Path("")).Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if exist {
+		var nodes []*treePathNode
+		err = client.Target.Query().
+			Select(entTarget.FieldID, entTarget.FieldParentID, entTarget.FieldPath).
+			Scan(ctx, &nodes)
+		if err != nil {
+			return 0, err
+		}
+
+		paths, err := treePaths(nodes)
+		if err != nil {
+			return 0, err
+		}
+		for path, ids := range paths {
+			for chunk := range slices.Chunk(ids, treePathBatchSize) {
+				n, err := client.Target.Update().
+					Where(entTarget.IDIn(chunk...)).
+					SetPath(path).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				updated += n
+			}
+		}
+	}
+
+	exist, err = client.Step.Query().Where(entStep.Path("")).Exist(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if exist {
+		var nodes []*treePathNode
+		err = client.Step.Query().
+			Select(entStep.FieldID, entStep.FieldParentID, entStep.FieldPath).
+			Scan(ctx, &nodes)
+		if err != nil {
+			return 0, err
+		}
+
+		paths, err := treePaths(nodes)
+		if err != nil {
+			return 0, err
+		}
+		for path, ids := range paths {
+			for chunk := range slices.Chunk(ids, treePathBatchSize) {
+				n, err := client.Step.Update().
+					Where(entStep.IDIn(chunk...)).
+					SetPath(path).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				updated += n
+			}
+		}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,82 @@
+package data
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTreePaths(t *testing.T) {
+	parent := func(id uint64) *uint64 { return &id }
+	cases := []struct {
+		name  string
+		nodes []*treePathNode
+		paths map[string][]uint64
+		err   string
+	}{
+		{
+			name:  "empty",
+			paths: map[string][]uint64{},
+		},
+		{
+			name: "roots",
+			nodes: []*treePathNode{
+				{ID: 1},
+				{ID: 2, ParentID: parent(0)},
+			},
+			paths: map[string][]uint64{"/": {1, 2}},
+		},
+		{
+			name: "chain listed child first",
+			nodes: []*treePathNode{
+				{ID: 3, ParentID: parent(2)},
+				{ID: 2, ParentID: parent(1)},
+				{ID: 1},
+			},
+			paths: map[string][]uint64{"/1/2/": {3}, "/1/": {2}, "/": {1}},
+		},
+		{
+			name: "existing paths are kept",
+			nodes: []*treePathNode{
+				{ID: 1, Path: "/"},
+				{ID: 2, ParentID: parent(1), Path: "/1/"},
+				{ID: 3, ParentID: parent(2)},
+				{ID: 4, ParentID: parent(2)},
+			},
+			paths: map[string][]uint64{"/1/2/": {3, 4}},
+		},
+		{
+			name: "missing parent",
+			nodes: []*treePathNode{
+				{ID: 2, ParentID: parent(1)},
+			},
+			err: "node 2 has no parent 1",
+		},
+		{
+			name: "parent cycle",
+			nodes: []*treePathNode{
+				{ID: 1, ParentID: parent(2)},
+				{ID: 2, ParentID: parent(1)},
+			},
+			err: "parent cycle",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			paths, err := treePaths(c.nodes)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("error = %v, want %s", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.EqualFunc(paths, c.paths, slices.Equal[[]uint64]) {
+				t.Errorf("paths = %v, want %v", paths, c.paths)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	stepApi "step/api/step/v1"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	"step/internal/objects"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 基准测试使用内存SQLite，树的规模
+const (
+	benchDeepDepth  = 30
+	benchWideWidth  = 50
+	benchWideLeaves = 10
+	benchMediaSteps = 100
+)
+
+var benchDBSeq atomic.Uint64
+
+type benchHeader map[string]string
+
+func (h benchHeader) Get(key string) string        { return h[key] }
+func (h benchHeader) Set(key string, value string) { h[key] = value }
+func (h benchHeader) Add(key string, value string) { h[key] = value }
+func (h benchHeader) Keys() []string               { return nil }
+func (h benchHeader) Values(key string) []string   { return []string{h[key]} }
+
+type benchTransport struct {
+	header benchHeader
+}
+
+func (t *benchTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *benchTransport) Endpoint() string                { return "" }
+func (t *benchTransport) Operation() string               { return "" }
+func (t *benchTransport) RequestHeader() transport.Header { return t.header }
+func (t *benchTransport) ReplyHeader() transport.Header   { return benchHeader{} }
+
+// newBenchStepRepo 每个测试使用独立的数据库，预签名在本地计算不需要连接MinIO
+func newBenchStepRepo(b testing.TB) (*stepRepo, context.Context) {
+	b.Helper()
+
+	dsn := fmt.Sprintf("file:bench_%d?mode=memory&cache=shared&_pragma=foreign_keys(1)", benchDBSeq.Add(1))
+	client, err := ent.Open("sqlite3", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { client.Close() })
+
+	ctx := transport.NewServerContext(context.Background(), &benchTransport{
+		header: benchHeader{"X-User-ID": "bench"},
+	})
+	err = client.Schema.Create(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	s3Client := s3.NewFromConfig(aws.Config{
+		Credentials:  credentials.NewStaticCredentialsProvider("bench", "bench", ""),
+		BaseEndpoint: aws.String("http://127.0.0.1:9000"),
+		Region:       "auto",
+	})
+	data := &Data{
+		ent_client:        client,
+		s3_client:         s3Client,
+		presign_client:    s3.NewPresignClient(s3Client),
+		minio_bucket_name: "bench",
+	}
+	logger := log.NewFilter(log.DefaultLogger, log.FilterLevel(log.LevelError))
+	repo := NewStepRepo(data, logger, NewMinioRepo(data, logger), nil).(*stepRepo)
+	return repo, ctx
+}
+
+func benchCreateTarget(b testing.TB, r *stepRepo, ctx context.Context, parentID uint64, title string) uint64 {
+	b.Helper()
+	reply, err := r.CreateTarget(ctx, &stepApi.CreateTargetRequest{Title: title, ParentId: parentID})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return reply.Id
+}
+
+func benchCreateDir(b testing.TB, r *stepRepo, ctx context.Context, targetID uint64, parentID uint64, title string) uint64 {
+	b.Helper()
+	reply, err := r.AddTargetDirStep(ctx, &stepApi.AddTargetDirStepRequest{Id: targetID, ParentId: parentID, Title: title})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return reply.Id
+}
+
+// benchDeepTargets 一条长度为depth的目标链，返回根和最深的目标
+func benchDeepTargets(b *testing.B, r *stepRepo, ctx context.Context) (uint64, uint64) {
+	root := benchCreateTarget(b, r, ctx, 0, "deep")
+	leaf := root
+	for i := 1; i < benchDeepDepth; i++ {
+		leaf = benchCreateTarget(b, r, ctx, leaf, fmt.Sprintf("deep %d", i))
+	}
+	return root, leaf
+}
+
+// benchWideTargets 根下width个子目标，每个子目标下leaves个目标，返回根和最后一个目标
+func benchWideTargets(b *testing.B, r *stepRepo, ctx context.Context) (uint64, uint64) {
+	root := benchCreateTarget(b, r, ctx, 0, "wide")
+	var leaf uint64
+	for i := 0; i < benchWideWidth; i++ {
+		child := benchCreateTarget(b, r, ctx, root, fmt.Sprintf("wide %d", i))
+		for j := 0; j < benchWideLeaves; j++ {
+			leaf = benchCreateTarget(b, r, ctx, child, fmt.Sprintf("wide %d-%d", i, j))
+		}
+	}
+	return root, leaf
+}
+
+func countTreeTargets(target *stepApi.Target) int {
+	count := 1
+	for _, child := range target.Children {
+		count += countTreeTargets(child)
+	}
+	return count
+}
+
+func BenchmarkGetTargetTree(b *testing.B) {
+	cases := []struct {
+		name  string
+		build func(*testing.B, *stepRepo, context.Context) (uint64, uint64)
+		count int
+	}{
+		{"deep", benchDeepTargets, benchDeepDepth},
+		{"wide", benchWideTargets, 1 + benchWideWidth*(1+benchWideLeaves)},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			r, ctx := newBenchStepRepo(b)
+			_, leaf := c.build(b, r, ctx)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				reply, err := r.GetTargetTree(ctx, &stepApi.GetTargetTreeRequest{Id: leaf})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if count := countTreeTargets(reply.RootTarget); count != c.count {
+					b.Fatalf("tree has %d targets, want %d", count, c.count)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetTopTargetByTargetID(b *testing.B) {
+	r, ctx := newBenchStepRepo(b)
+	root, leaf := benchDeepTargets(b, r, ctx)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		top, err := r.GetTopTargetByTargetID(ctx, leaf)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if top.ID != root {
+			b.Fatalf("top target is %d, want %d", top.ID, root)
+		}
+	}
+}
+
+// benchDeepDirs 目标下一条长度为depth的目录链，返回最外层和最深的目录
+func benchDeepDirs(b *testing.B, r *stepRepo, ctx context.Context, targetID uint64) (uint64, uint64) {
+	first := benchCreateDir(b, r, ctx, targetID, 0, "dir")
+	leaf := first
+	for i := 1; i < benchDeepDepth; i++ {
+		leaf = benchCreateDir(b, r, ctx, targetID, leaf, fmt.Sprintf("dir %d", i))
+	}
+	return first, leaf
+}
+
+func BenchmarkGetTargetByStepIDRecursively(b *testing.B) {
+	r, ctx := newBenchStepRepo(b)
+	targetID := benchCreateTarget(b, r, ctx, 0, "dirs")
+	first, leaf := benchDeepDirs(b, r, ctx, targetID)
+
+	// 旧数据中目录下的积累可能没有ref_target_id，需要从祖先目录查找
+	err := r.data.ent_client.Step.Update().
+		Where(entStep.IDNEQ(first)).
+		ClearRefTargetID().
+		Exec(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		target, err := r.GetTargetByStepIDRecursively(ctx, leaf, 0)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if target.ID != targetID {
+			b.Fatalf("target is %d, want %d", target.ID, targetID)
+		}
+	}
+}
+
+func BenchmarkGetSubSteps(b *testing.B) {
+	cases := []struct {
+		name  string
+		build func(*testing.B, *stepRepo, context.Context, uint64) (uint64, int)
+	}{
+		{"deep", func(b *testing.B, r *stepRepo, ctx context.Context, targetID uint64) (uint64, int) {
+			first, _ := benchDeepDirs(b, r, ctx, targetID)
+			return first, benchDeepDepth
+		}},
+		{"wide", func(b *testing.B, r *stepRepo, ctx context.Context, targetID uint64) (uint64, int) {
+			first := benchCreateDir(b, r, ctx, targetID, 0, "dir")
+			for i := 0; i < benchWideWidth; i++ {
+				child := benchCreateDir(b, r, ctx, targetID, first, fmt.Sprintf("dir %d", i))
+				for j := 0; j < benchWideLeaves; j++ {
+					benchCreateDir(b, r, ctx, targetID, child, fmt.Sprintf("dir %d-%d", i, j))
+				}
+			}
+			return first, 1 + benchWideWidth*(1+benchWideLeaves)
+		}},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			r, ctx := newBenchStepRepo(b)
+			targetID := benchCreateTarget(b, r, ctx, 0, "dirs")
+			first, count := c.build(b, r, ctx, targetID)
+			step, err := r.data.ent_client.Step.Get(ctx, first)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				steps, err := r.getSubSteps(ctx, step)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(steps) != count {
+					b.Fatalf("sub steps are %d, want %d", len(steps), count)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkGetTarget 每个媒体积累都要生成文件的预签名地址
+func BenchmarkGetTarget(b *testing.B) {
+	r, ctx := newBenchStepRepo(b)
+	targetID := benchCreateTarget(b, r, ctx, 0, "media")
+
+	now := time.Now().Unix()
+	creates := make([]*ent.StepCreate, benchMediaSteps)
+	for i := range creates {
+		creates[i] = r.data.ent_client.Step.Create().
+			SetRefTargetID(targetID).
+			SetPath(objects.RootPath).
+			SetType(entStep.TypeImage).
+			SetObjectName(fmt.Sprintf("bench/target_%d/%d.png", targetID, i)).
+			SetCreatedAt(now)
+	}
+	err := r.data.ent_client.Step.CreateBulk(creates...).Exec(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		reply, err := r.GetTarget(ctx, &stepApi.GetTargetRequest{Id: targetID, WithSteps: true, PageSize: benchMediaSteps})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(reply.Steps) != benchMediaSteps || reply.Steps[0].PresignedUrl == "" {
+			b.Fatalf("got %d steps, want %d presigned", len(reply.Steps), benchMediaSteps)
+		}
+	}
+}
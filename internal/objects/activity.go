@@ -0,0 +1,60 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// 时间线默认展示的天数
+	ActivityTimelineDays = 7
+	// 时间线一次最多查询的天数
+	ActivityTimelineMaxDays = 31
+)
+
+// ActivityLocation 用户的时区，为空时使用服务器时区
+func ActivityLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time_zone: %s", timeZone)
+	}
+	return loc, nil
+}
+
+// ActivityDateRange 时间线的日期范围，结束日期默认为今天，开始日期默认为结束日期前6天
+func ActivityDateRange(startDate string, endDate string, loc *time.Location) (time.Time, time.Time, error) {
+	now := time.Now().In(loc)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	var err error
+	if endDate != "" {
+		end, err = time.ParseInLocation(time.DateOnly, endDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end_date: %s", endDate)
+		}
+	}
+
+	start := end.AddDate(0, 0, 1-ActivityTimelineDays)
+	if startDate != "" {
+		start, err = time.ParseInLocation(time.DateOnly, startDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start_date: %s", startDate)
+		}
+	}
+
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, errors.New("end_date is before start_date")
+	}
+	if !start.AddDate(0, 0, ActivityTimelineMaxDays).After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range is longer than %d days", ActivityTimelineMaxDays)
+	}
+	return start, end, nil
+}
+
+// ActivityDate 积累时间在用户时区的日期
+func ActivityDate(createdAt int64, loc *time.Location) string {
+	return time.Unix(createdAt, 0).In(loc).Format(time.DateOnly)
+}
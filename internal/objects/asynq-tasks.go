@@ -1,10 +1,21 @@
 package objects
 
+import "time"
+
 const (
 	TypeTargetCreate           = "target:create"
 	TypeStepCreate             = "step:create"
 	TypeStepComment            = "step:comment"
 	TypeFeedbackPortraitChange = "feedback:portraitchange"
+	TypeTargetMissedCheckin    = "target:missedcheckin"
+	TypeTargetCheckinReminder  = "target:checkinreminder"
+	TypeStepDocument           = "step:document"
+	TypeSearchIndex            = "search:index"
+	TypeTargetExport           = "target:export"
+	TypeImportProcess          = "import:process"
+	TypeReportGenerate         = "report:generate"
+	TypeReviewRequestRemind    = "review:remind"
+	TypeReviewRequestNotify    = "review:notify"
 )
 
 type TargetCreatePayload struct {
@@ -15,11 +26,107 @@ type StepCreatePayload struct {
 	StepID uint64
 }
 
+type StepDocumentPayload struct {
+	StepID uint64
+}
+
+// 重建目标(及其积累、评论)的搜索索引，TargetID为0时按StepID查找所属目标
+type SearchIndexPayload struct {
+	TargetID uint64
+	StepID   uint64
+}
+
+// 同一目标短时间内的多次修改只重建一次索引
+const SearchIndexDebounce = 3 * time.Second
+
+type TargetExportPayload struct {
+	ExportID uint64
+}
+
+// 导出需要下载所有媒体文件，给足处理时间
+const (
+	TargetExportTimeout  = 30 * time.Minute
+	TargetExportMaxRetry = 3
+)
+
+type ImportProcessPayload struct {
+	ImportID uint64
+}
+
+// 导入需要上传所有媒体文件；已完成的导入项会跳过，重试时从中断处继续。
+// 同一个导入同时只有一个任务，任务结束后才能再次入队
+const (
+	ImportProcessTimeout  = 60 * time.Minute
+	ImportProcessMaxRetry = 3
+	ImportProcessUnique   = 2 * time.Hour
+)
+
+type ReportGeneratePayload struct {
+	ReportID uint64
+}
+
+// 报告需要下载媒体生成缩略图
+const (
+	ReportGenerateTimeout  = 10 * time.Minute
+	ReportGenerateMaxRetry = 3
+)
+
+// 提醒时间和过期时间各处理一次，已评论时跳过
+type ReviewRequestRemindPayload struct {
+	ReviewRequestID uint64
+}
+
+// 创建请求后发送第一次通知，不在请求中同步发送
+type ReviewRequestNotifyPayload struct {
+	ReviewRequestID uint64
+}
+
 type StepCommentPayload struct {
 	StepID      uint64
 	CommentType string
 }
 
+// 为空时检查前一天
+type TargetMissedCheckinPayload struct {
+	Date string // 2006-01-02
+}
+
+// 为空时提醒当天
+type TargetCheckinReminderPayload struct {
+	Date string // 2006-01-02
+}
+
+// 定时任务在每个实例都会触发，按日期生成任务ID去重；
+// 完成后保留一段时间，晚到的重复触发仍会冲突
+const CheckinTaskRetention = 48 * time.Hour
+
+type CheckinReminder struct {
+	UserID   string `json:"user_id"`
+	TargetID uint64 `json:"target_id"`
+	Title    string `json:"title"`
+}
+
+const CheckinReminderNotificationKind = "checkin_reminder"
+
+// 打卡提醒的通知方式，学生没有邮箱，不支持smtp
+const (
+	CheckinNotifierLog     = "log"
+	CheckinNotifierWebhook = "webhook"
+)
+
+// 配置为0时使用
+const CheckinWebhookTimeout = 10 * time.Second
+
+// webhook请求体的HMAC-SHA256签名
+const CheckinSignatureHeader = "X-Step-Signature"
+
+// CheckinReminderNotification 一天的打卡提醒一次发送，由接收方按User ID转发
+type CheckinReminderNotification struct {
+	Kind      string             `json:"kind"`
+	Date      string             `json:"date"`
+	Reminders []*CheckinReminder `json:"reminders"`
+}
+
 type PortraitchangeType struct {
 	Type  string   // portrait, target
 	Scope []string // top dimension for portrait type/top_target_id for target type
@@ -0,0 +1,16 @@
+package objects
+
+import "time"
+
+// 用户缓存的类型，同一用户同一类型的缓存一起失效
+const (
+	CacheKindTargetTree = "target_tree"
+	CacheKindPortrait   = "portrait"
+)
+
+const (
+	// 目标树和画像缓存的有效期，失效通知丢失时最多延迟这么久
+	UserCacheTTL = 10 * time.Minute
+	// 预签名地址有效期30分钟，缓存10分钟保证返回的地址至少还有20分钟有效
+	PresignedUrlCacheTTL = 10 * time.Minute
+)
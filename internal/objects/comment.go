@@ -1,56 +1,105 @@
 package objects
 
-import "encoding/json"
-
-// comment v1.0
-/* {
-	"version": "v1.0",
-	"data":{
-	  "weighted_value": 5,
-	  "target": {
-		"target_reasonableness": 8,
-		"target_clarity": 8,
-		"target_achievement": 8
-	  },
-	  "quality":{
-		"reflection_improvement": 5,
-		"innovation": 5,
-		"basic_reliability": 5,
-		"skill_improvement": 5,
-		"difficulty": 5
-	  }
+import "math"
+
+// 评论角色
+const (
+	CommentRoleTeacher = "teacher"
+	CommentRoleParent  = "parent"
+	CommentRoleFriend  = "friend"
+	// 积累所有者只能回复，不能评分
+	CommentRoleStudent = "student"
+)
+
+// 评论文字内容的最大长度
+const CommentContentMaxBytes = 2000
+
+// 积累所有者自评计入StepRate和画像的权重，老师、家长和朋友的评分权重为1
+const SelfAssessmentWeight = 0.5
+
+func ValidCommentRole(role string) bool {
+	switch role {
+	case CommentRoleTeacher, CommentRoleParent, CommentRoleFriend:
+		return true
 	}
-   } */
-
-type Comment struct {
-	Version string `json:"version"`
-	Data    struct {
-		WeightedValue float64 `json:"weighted_value"`
-		Target        struct {
-			TargetReasonableness int32 `json:"target_reasonableness"`
-			TargetClarity        int32 `json:"target_clarity"`
-			TargetAchievement    int32 `json:"target_achievement"`
-		} `json:"target"`
-		Quality struct {
-			ReflectionImprovement int32 `json:"reflection_improvement"`
-			Innovation            int32 `json:"innovation"`
-			BasicReliability      int32 `json:"basic_reliability"`
-			SkillImprovement      int32 `json:"skill_improvement"`
-			Difficulty            int32 `json:"difficulty"`
-		} `json:"quality"`
-	} `json:"data"`
-}
-
-func NewComment(commentMap map[string]any) (*Comment, error) {
-	jsonComment, err := json.Marshal(commentMap)
-	if err != nil {
-		return nil, err
+	return false
+}
+
+// ValidRatingRole 可以评分的角色，积累所有者只能自评
+func ValidRatingRole(role string) bool {
+	return ValidCommentRole(role) || role == CommentRoleStudent
+}
+
+// RatingWeight 评分计入StepRate和画像的权重
+func RatingWeight(role string) float64 {
+	if role == CommentRoleStudent {
+		return SelfAssessmentWeight
 	}
+	return 1
+}
 
-	comment := &Comment{}
-	err = json.Unmarshal(jsonComment, comment)
-	if err != nil {
-		return nil, err
+// CommentRevision 评论编辑前的版本
+type CommentRevision struct {
+	Rating    map[string]any `json:"rating,omitempty"`
+	Content   string         `json:"content,omitempty"`
+	UpdatedAt int64          `json:"updated_at"`
+}
+
+// CommentScores 评分相对评分标准中间值的偏移，StepRate和画像按偏移累加
+type CommentScores struct {
+	WeightedValue         float64
+	TargetReasonableness  int32
+	TargetClarity         int32
+	TargetAchievement     int32
+	ReflectionImprovement int32
+	Innovation            int32
+	BasicReliability      int32
+	SkillImprovement      int32
+	Difficulty            int32
+}
+
+func (s CommentScores) Add(o CommentScores) CommentScores {
+	return CommentScores{
+		WeightedValue:         s.WeightedValue + o.WeightedValue,
+		TargetReasonableness:  s.TargetReasonableness + o.TargetReasonableness,
+		TargetClarity:         s.TargetClarity + o.TargetClarity,
+		TargetAchievement:     s.TargetAchievement + o.TargetAchievement,
+		ReflectionImprovement: s.ReflectionImprovement + o.ReflectionImprovement,
+		Innovation:            s.Innovation + o.Innovation,
+		BasicReliability:      s.BasicReliability + o.BasicReliability,
+		SkillImprovement:      s.SkillImprovement + o.SkillImprovement,
+		Difficulty:            s.Difficulty + o.Difficulty,
+	}
+}
+
+func (s CommentScores) Sub(o CommentScores) CommentScores {
+	return s.Add(CommentScores{
+		WeightedValue:         -o.WeightedValue,
+		TargetReasonableness:  -o.TargetReasonableness,
+		TargetClarity:         -o.TargetClarity,
+		TargetAchievement:     -o.TargetAchievement,
+		ReflectionImprovement: -o.ReflectionImprovement,
+		Innovation:            -o.Innovation,
+		BasicReliability:      -o.BasicReliability,
+		SkillImprovement:      -o.SkillImprovement,
+		Difficulty:            -o.Difficulty,
+	})
+}
+
+// Scale 按权重缩放，整数列四舍五入
+func (s CommentScores) Scale(weight float64) CommentScores {
+	scale := func(v int32) int32 {
+		return int32(math.Round(float64(v) * weight))
+	}
+	return CommentScores{
+		WeightedValue:         s.WeightedValue * weight,
+		TargetReasonableness:  scale(s.TargetReasonableness),
+		TargetClarity:         scale(s.TargetClarity),
+		TargetAchievement:     scale(s.TargetAchievement),
+		ReflectionImprovement: scale(s.ReflectionImprovement),
+		Innovation:            scale(s.Innovation),
+		BasicReliability:      scale(s.BasicReliability),
+		SkillImprovement:      scale(s.SkillImprovement),
+		Difficulty:            scale(s.Difficulty),
 	}
-	return comment, nil
 }
@@ -0,0 +1,84 @@
+package objects
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// Cursor 游标分页的位置，对客户端不透明。
+// 记录上一页最后一项的排序键和ID，下一页从它之后开始，
+// 所以翻页过程中有新数据插入也不会重复或遗漏。
+// Key的类型由排序方式决定，见cursorKey
+type Cursor struct {
+	Sort string `json:"s"`
+	Key  any    `json:"k"`
+	ID   uint64 `json:"i"`
+}
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// cursorKey 按排序方式转换排序键：手动排序为float64，创建时间和状态为int64，名称为string。
+// 解码后的数字是json.Number，类型不符时返回错误，避免拿错误类型的值和列比较
+func cursorKey(sort string, key any) (any, error) {
+	switch sort {
+	case SortManual:
+		switch v := key.(type) {
+		case float64:
+			return v, nil
+		case json.Number:
+			return v.Float64()
+		}
+	case SortCreatedAt, SortStatus:
+		switch v := key.(type) {
+		case int64:
+			return v, nil
+		case json.Number:
+			return v.Int64()
+		}
+	case SortTitle:
+		if v, ok := key.(string); ok {
+			return v, nil
+		}
+	}
+	return nil, errors.New("cursor key does not match sort")
+}
+
+func EncodeCursor(cursor *Cursor) string {
+	if _, err := cursorKey(cursor.Sort, cursor.Key); err != nil {
+		return ""
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor 解析游标，并校验和当前的排序方式及排序键的类型一致
+func DecodeCursor(value string, sort string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var cursor Cursor
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	err = decoder.Decode(&cursor)
+	if err != nil || cursor.ID == 0 {
+		return nil, errInvalidCursor
+	}
+
+	if cursor.Sort != sort {
+		return nil, errors.New("cursor does not match sort")
+	}
+
+	cursor.Key, err = cursorKey(sort, cursor.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,290 @@
+package objects
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	MimeTypePdf  = "application/pdf"
+	MimeTypeDoc  = "application/msword"
+	MimeTypeDocx = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	MimeTypeXls  = "application/vnd.ms-excel"
+	MimeTypeXlsx = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	MimeTypePpt  = "application/vnd.ms-powerpoint"
+	MimeTypePptx = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	MimeTypeOdt  = "application/vnd.oasis.opendocument.text"
+	MimeTypeOds  = "application/vnd.oasis.opendocument.spreadsheet"
+	MimeTypeOdp  = "application/vnd.oasis.opendocument.presentation"
+	MimeTypeTxt  = "text/plain"
+)
+
+// 允许上传的文档类型及对应的扩展名
+var DocumentMimeTypes = map[string]string{
+	MimeTypePdf:  ".pdf",
+	MimeTypeDoc:  ".doc",
+	MimeTypeDocx: ".docx",
+	MimeTypeXls:  ".xls",
+	MimeTypeXlsx: ".xlsx",
+	MimeTypePpt:  ".ppt",
+	MimeTypePptx: ".pptx",
+	MimeTypeOdt:  ".odt",
+	MimeTypeOds:  ".ods",
+	MimeTypeOdp:  ".odp",
+	MimeTypeTxt:  ".txt",
+}
+
+// 文档解析时最多读取的大小
+const DocumentInspectMaxBytes = 50 << 20
+
+// 统计PDF页数时所有对象流解压后的总大小上限，防止压缩炸弹
+const PdfInflateMaxBytes = 64 << 20
+
+// DocumentInfo 文档解析结果
+type DocumentInfo struct {
+	PageCount          uint32
+	Preview            []byte
+	PreviewContentType string
+}
+
+// ObjectContent 从对象存储读取的内容，读取完需要Close
+type ObjectContent struct {
+	Body          io.ReadCloser
+	ContentType   string
+	ContentLength int64
+	// 分段读取时的范围，如: bytes 0-99/1000
+	ContentRange string
+}
+
+// 浏览器对未知文件或office文件常用的通用类型
+var genericMimeTypes = map[string]bool{
+	"application/octet-stream":     true,
+	"application/zip":              true,
+	"application/x-zip-compressed": true,
+}
+
+// DetectMimeType 优先使用上传时的Content-Type，没有或者为通用类型时根据扩展名判断
+func DetectMimeType(fileName string, contentType string) string {
+	mimeType, _, err := mime.ParseMediaType(contentType)
+	if err == nil && mimeType != "" && !genericMimeTypes[mimeType] {
+		return mimeType
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for documentMimeType, documentExt := range DocumentMimeTypes {
+		if ext == documentExt {
+			return documentMimeType
+		}
+	}
+
+	mimeType, _, err = mime.ParseMediaType(mime.TypeByExtension(ext))
+	if err != nil {
+		return ""
+	}
+	return mimeType
+}
+
+func IsDocumentMimeType(mimeType string) bool {
+	_, ok := DocumentMimeTypes[mimeType]
+	return ok
+}
+
+// IsInlineMimeType 分享下载时浏览器可以直接打开的类型，其余都作为附件下载；svg可以带脚本，不直接打开
+func IsInlineMimeType(contentType string) bool {
+	mimeType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch {
+	case mimeType == "image/svg+xml":
+		return false
+	case mimeType == MimeTypePdf,
+		strings.HasPrefix(mimeType, "image/"),
+		strings.HasPrefix(mimeType, "video/"),
+		strings.HasPrefix(mimeType, "audio/"):
+		return true
+	}
+	return false
+}
+
+// ContentDisposition 按RFC 6266生成，filename为只含ASCII的兼容值，filename*为UTF-8编码的原文件名
+func ContentDisposition(disposition string, fileName string) string {
+	var fallback, encoded strings.Builder
+	for _, r := range fileName {
+		if r >= 0x20 && r < 0x7f && r != '"' && r != '\\' {
+			fallback.WriteRune(r)
+		} else {
+			fallback.WriteByte('_')
+		}
+	}
+	for _, b := range []byte(fileName) {
+		// RFC 5987的attr-char原样保留，其余按百分号编码
+		if 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9' || strings.IndexByte("!#$&+-.^_`|~", b) >= 0 {
+			encoded.WriteByte(b)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return disposition + `; filename="` + fallback.String() + `"; filename*=UTF-8''` + encoded.String()
+}
+
+// DocumentPageCount 文档页数，无法识别时返回0
+func DocumentPageCount(mimeType string, data []byte) uint32 {
+	switch mimeType {
+	case MimeTypePdf:
+		return pdfPageCount(data)
+	case MimeTypeDocx, MimeTypePptx, MimeTypeXlsx:
+		return ooxmlPageCount(data)
+	case MimeTypeOdt, MimeTypeOds, MimeTypeOdp:
+		return odfPageCount(data)
+	}
+	return 0
+}
+
+// DocumentThumbnail office文档内嵌的缩略图
+func DocumentThumbnail(mimeType string, data []byte) ([]byte, string) {
+	var names []string
+	switch mimeType {
+	case MimeTypeDocx, MimeTypePptx, MimeTypeXlsx:
+		names = []string{"docProps/thumbnail.jpeg", "docProps/thumbnail.jpg", "docProps/thumbnail.png"}
+	case MimeTypeOdt, MimeTypeOds, MimeTypeOdp:
+		names = []string{"Thumbnails/thumbnail.png"}
+	default:
+		return nil, ""
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, ""
+	}
+	for _, name := range names {
+		content, err := readZipFile(zr, name)
+		if err != nil || len(content) == 0 {
+			continue
+		}
+		if strings.HasSuffix(name, ".png") {
+			return content, "image/png"
+		}
+		return content, "image/jpeg"
+	}
+	return nil, ""
+}
+
+var (
+	pdfPageRe   = regexp.MustCompile(`/Type\s*/Page[^s]`)
+	pdfCountRe  = regexp.MustCompile(`/Type\s*/Pages[^>]*?/Count\s+(\d+)|/Count\s+(\d+)[^>]*?/Type\s*/Pages`)
+	pdfStreamRe = regexp.MustCompile(`(?s)stream\r?\n(.*?)endstream`)
+)
+
+// pdfPageCount 统计页面对象的数量，同时解压对象流(PDF 1.5+)
+// 流的原始内容不统计，未压缩的部分会和解压后的重复计数；
+// 解压后的流逐个统计后丢弃，所有流解压的总大小不超过PdfInflateMaxBytes
+func pdfPageCount(data []byte) uint32 {
+	var pages, count int
+	scan := func(content []byte) {
+		pages += len(pdfPageRe.FindAll(content, -1))
+		for _, match := range pdfCountRe.FindAllSubmatch(content, -1) {
+			n, _ := strconv.Atoi(string(match[1]) + string(match[2]))
+			count = max(count, n)
+		}
+	}
+
+	var decoded bytes.Buffer
+	budget := int64(PdfInflateMaxBytes)
+	offset := 0
+	for _, match := range pdfStreamRe.FindAllSubmatchIndex(data, -1) {
+		scan(data[offset:match[2]])
+		offset = match[3]
+		if budget <= 0 {
+			continue
+		}
+		zr, err := zlib.NewReader(bytes.NewReader(data[match[2]:match[3]]))
+		if err != nil {
+			continue
+		}
+		decoded.Reset()
+		n, _ := decoded.ReadFrom(io.LimitReader(zr, budget))
+		zr.Close()
+		budget -= n
+		if n > 0 {
+			scan(decoded.Bytes())
+		}
+	}
+	scan(data[offset:])
+
+	// 根Pages的Count最可靠
+	if count > 0 {
+		return uint32(count)
+	}
+	return uint32(pages)
+}
+
+func ooxmlPageCount(data []byte) uint32 {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0
+	}
+
+	content, err := readZipFile(zr, "docProps/app.xml")
+	if err == nil {
+		var props struct {
+			Pages  uint32 `xml:"Pages"`
+			Slides uint32 `xml:"Slides"`
+		}
+		if xml.Unmarshal(content, &props) == nil {
+			if props.Pages > 0 {
+				return props.Pages
+			}
+			if props.Slides > 0 {
+				return props.Slides
+			}
+		}
+	}
+
+	// excel按sheet数量计算
+	var sheets uint32
+	for _, f := range zr.File {
+		if strings.HasPrefix(f.Name, "xl/worksheets/sheet") && strings.HasSuffix(f.Name, ".xml") {
+			sheets++
+		}
+	}
+	return sheets
+}
+
+var odfPageCountRe = regexp.MustCompile(`meta:(?:page|table)-count="(\d+)"`)
+
+func odfPageCount(data []byte) uint32 {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0
+	}
+
+	content, err := readZipFile(zr, "meta.xml")
+	if err != nil {
+		return 0
+	}
+	match := odfPageCountRe.FindSubmatch(content)
+	if match == nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(string(match[1]))
+	return uint32(n)
+}
+
+func readZipFile(zr *zip.Reader, name string) ([]byte, error) {
+	f, err := zr.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(io.LimitReader(f, DocumentInspectMaxBytes))
+}
@@ -0,0 +1,138 @@
+package objects
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"strings"
+	"testing"
+)
+
+// testPdfStream 压缩后放在stream和endstream之间，和对象流一样
+func testPdfStream(t *testing.T, content string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return "1 0 obj\n<< /Type /ObjStm /Filter /FlateDecode >>\nstream\n" + buf.String() + "endstream\nendobj\n"
+}
+
+// testZip 按文件名和内容生成压缩包
+func testZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestPdfPageCount(t *testing.T) {
+	cases := []struct {
+		name  string
+		data  string
+		pages uint32
+	}{
+		{"not a pdf", "hello", 0},
+		{"root count", "%PDF-1.4\n2 0 obj\n<< /Type /Pages /Kids [3 0 R 4 0 R 5 0 R] /Count 3 >>\nendobj\n", 3},
+		{"count before type", "%PDF-1.4\n2 0 obj\n<< /Count 4 /Kids [] /Type /Pages >>\nendobj\n", 4},
+		{"largest count is the root", "%PDF-1.4\n<< /Type /Pages /Count 2 >>\n<< /Type /Pages /Count 5 >>\n", 5},
+		{"page objects without count", "%PDF-1.4\n<< /Type /Page /Parent 2 0 R >>\n<< /Type/Page /Parent 2 0 R >>\n", 2},
+		{"pages in object stream", "%PDF-1.5\n" + testPdfStream(t, "<< /Type /Pages /Kids [] /Count 7 >>"), 7},
+		{"page objects in object stream", "%PDF-1.5\n" + testPdfStream(t, "<< /Type /Page >> << /Type /Page >>"), 2},
+		{"broken stream ignored", "%PDF-1.5\n<< /Type /Page >>\nstream\nnot zlib\nendstream\n", 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := DocumentPageCount(MimeTypePdf, []byte(c.data)); got != c.pages {
+				t.Errorf("pages = %d, want %d", got, c.pages)
+			}
+		})
+	}
+}
+
+func TestPdfPageCountInflateLimit(t *testing.T) {
+	// 解压后超过上限的部分不统计
+	padding := strings.Repeat(" ", PdfInflateMaxBytes)
+	data := "%PDF-1.5\n" + testPdfStream(t, padding+"<< /Type /Pages /Count 9 >>")
+	if got := pdfPageCount([]byte(data)); got != 0 {
+		t.Errorf("pages = %d, want 0", got)
+	}
+}
+
+func TestOfficePageCount(t *testing.T) {
+	cases := []struct {
+		name     string
+		mimeType string
+		files    map[string]string
+		pages    uint32
+	}{
+		{"docx pages", MimeTypeDocx, map[string]string{"docProps/app.xml": "<Properties><Pages>5</Pages></Properties>"}, 5},
+		{"pptx slides", MimeTypePptx, map[string]string{"docProps/app.xml": "<Properties><Slides>12</Slides></Properties>"}, 12},
+		{"xlsx sheets", MimeTypeXlsx, map[string]string{
+			"docProps/app.xml":         "<Properties></Properties>",
+			"xl/worksheets/sheet1.xml": "<worksheet/>",
+			"xl/worksheets/sheet2.xml": "<worksheet/>",
+		}, 2},
+		{"docx without properties", MimeTypeDocx, map[string]string{"word/document.xml": "<document/>"}, 0},
+		{"odt pages", MimeTypeOdt, map[string]string{"meta.xml": `<meta:document-statistic meta:page-count="3" meta:word-count="100"/>`}, 3},
+		{"ods tables", MimeTypeOds, map[string]string{"meta.xml": `<meta:document-statistic meta:table-count="4"/>`}, 4},
+		{"odt without meta", MimeTypeOdt, map[string]string{"content.xml": "<content/>"}, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := DocumentPageCount(c.mimeType, testZip(t, c.files)); got != c.pages {
+				t.Errorf("pages = %d, want %d", got, c.pages)
+			}
+		})
+	}
+
+	if got := DocumentPageCount(MimeTypeDocx, []byte("not a zip")); got != 0 {
+		t.Errorf("invalid zip pages = %d, want 0", got)
+	}
+	if got := DocumentPageCount(MimeTypeTxt, []byte("text")); got != 0 {
+		t.Errorf("txt pages = %d, want 0", got)
+	}
+}
+
+func TestContentDisposition(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType string
+		fileName    string
+		header      string
+	}{
+		{"pdf inline", "application/pdf", "report.pdf", `inline; filename="report.pdf"; filename*=UTF-8''report.pdf`},
+		{"image with params", "image/png; charset=binary", "a b.png", `inline; filename="a b.png"; filename*=UTF-8''a%20b.png`},
+		{"svg as attachment", "image/svg+xml", "x.svg", `attachment; filename="x.svg"; filename*=UTF-8''x.svg`},
+		{"html as attachment", "text/html", "x.html", `attachment; filename="x.html"; filename*=UTF-8''x.html`},
+		{"non ascii", "application/msword", "作业.doc", `attachment; filename="__.doc"; filename*=UTF-8''%E4%BD%9C%E4%B8%9A.doc`},
+		{"quotes escaped", "", `a"b\c.txt`, `attachment; filename="a_b_c.txt"; filename*=UTF-8''a%22b%5Cc.txt`},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			disposition := "attachment"
+			if IsInlineMimeType(c.contentType) {
+				disposition = "inline"
+			}
+			if got := ContentDisposition(disposition, c.fileName); got != c.header {
+				t.Errorf("Content-Disposition = %s, want %s", got, c.header)
+			}
+		})
+	}
+}
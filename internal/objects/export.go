@@ -0,0 +1,118 @@
+package objects
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// 导出任务状态
+const (
+	ExportStatusPending = "pending"
+	ExportStatusRunning = "running"
+	ExportStatusDone    = "done"
+	ExportStatusFailed  = "failed"
+)
+
+// manifest.json的格式版本
+const ExportManifestVersion = "v1.0"
+
+// ExportManifest 导出压缩包中的manifest.json，媒体文件的路径相对于压缩包根目录
+type ExportManifest struct {
+	Version    string        `json:"version"`
+	ExportedAt int64         `json:"exported_at"`
+	Target     *ExportTarget `json:"target"`
+}
+
+type ExportTarget struct {
+	ID          uint64          `json:"id"`
+	Title       string          `json:"title"`
+	Description string          `json:"description,omitempty"`
+	Type        string          `json:"type"`
+	Status      string          `json:"status"`
+	Mode        string          `json:"mode"`
+	Unit        string          `json:"unit,omitempty"`
+	GoalValue   float64         `json:"goal_value,omitempty"`
+	CreatedAt   int64           `json:"created_at"`
+	StartAt     int64           `json:"start_at,omitempty"`
+	ChallengeAt int64           `json:"challenge_at,omitempty"`
+	DoneAt      int64           `json:"done_at,omitempty"`
+	Steps       []*ExportStep   `json:"steps,omitempty"`
+	Children    []*ExportTarget `json:"children,omitempty"`
+	// 顶层目标的自定义评分标准
+	Rubric *TargetRubric `json:"rubric,omitempty"`
+}
+
+type ExportStep struct {
+	ID              uint64  `json:"id"`
+	Type            string  `json:"type"`
+	Title           string  `json:"title,omitempty"`
+	Description     string  `json:"description,omitempty"`
+	IsChallenge     bool    `json:"is_challenge,omitempty"`
+	CreatedAt       int64   `json:"created_at"`
+	Measurement     float64 `json:"measurement,omitempty"`
+	Content         string  `json:"content,omitempty"`
+	URL             string  `json:"url,omitempty"`
+	LinkTitle       string  `json:"link_title,omitempty"`
+	LinkDescription string  `json:"link_description,omitempty"`
+	MimeType        string  `json:"mime_type,omitempty"`
+	PageCount       uint32  `json:"page_count,omitempty"`
+	// 压缩包中的文件路径
+	File string `json:"file,omitempty"`
+	// 顶层评论按时间先后，回复在各自的评论下
+	Comments ExportComments  `json:"comments,omitempty"`
+	Rate     *ExportStepRate `json:"rate,omitempty"`
+	// 目录下的积累
+	Children []*ExportStep `json:"children,omitempty"`
+}
+
+// ExportComment 顶层评论带评分，回复没有评分
+type ExportComment struct {
+	Role      string           `json:"role"`
+	Rating    map[string]any   `json:"rating,omitempty"`
+	Content   string           `json:"content,omitempty"`
+	CreatedAt int64            `json:"created_at,omitempty"`
+	Replies   []*ExportComment `json:"replies,omitempty"`
+}
+
+type ExportComments []*ExportComment
+
+// UnmarshalJSON 兼容旧版本导出的 评论角色 -> 评分
+func (c *ExportComments) UnmarshalJSON(data []byte) error {
+	var comments []*ExportComment
+	if err := json.Unmarshal(data, &comments); err == nil {
+		*c = comments
+		return nil
+	}
+
+	var legacy map[string]map[string]any
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	roles := make([]string, 0, len(legacy))
+	for role := range legacy {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	comments = make([]*ExportComment, 0, len(roles))
+	for _, role := range roles {
+		comments = append(comments, &ExportComment{Role: role, Rating: legacy[role]})
+	}
+	*c = comments
+	return nil
+}
+
+// ExportStepRate 积累的评分(step_rates)，各维度为所有评论相对于5分的累计值
+type ExportStepRate struct {
+	WeightedValue         float64 `json:"weighted_value"`
+	TargetReasonableness  int32   `json:"target_reasonableness"`
+	TargetClarity         int32   `json:"target_clarity"`
+	TargetAchievement     int32   `json:"target_achievement"`
+	ReflectionImprovement int32   `json:"reflection_improvement"`
+	Innovation            int32   `json:"innovation"`
+	BasicReliability      int32   `json:"basic_reliability"`
+	SkillImprovement      int32   `json:"skill_improvement"`
+	Difficulty            int32   `json:"difficulty"`
+	Date                  string  `json:"date"`
+	// 自定义评价项的累计值
+	Criteria map[string]float64 `json:"criteria,omitempty"`
+}
@@ -0,0 +1,416 @@
+package objects
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 导入任务状态
+const (
+	ImportStatusPending = "pending"
+	ImportStatusRunning = "running"
+	ImportStatusDone    = "done"
+	ImportStatusFailed  = "failed"
+)
+
+// 导入项状态: 创建完成后为created，发出统计事件后为done
+const (
+	ImportItemStatusPending = "pending"
+	ImportItemStatusCreated = "created"
+	ImportItemStatusDone    = "done"
+	ImportItemStatusFailed  = "failed"
+)
+
+const (
+	ImportItemKindTarget = "target"
+	ImportItemKindDir    = "dir"
+	ImportItemKindStep   = "step"
+)
+
+// 压缩包中的清单文件，json格式与导出的manifest.json相同
+const (
+	ImportManifestJSON = "manifest.json"
+	ImportManifestCSV  = "manifest.csv"
+)
+
+const (
+	// 上传的压缩包或文件总大小
+	ImportMaxBytes = 2 << 30
+	// 一次导入最多的目标、目录和积累数
+	ImportMaxItems = 10000
+)
+
+// ImportItemData 导入项的内容，目标和积累共用
+type ImportItemData struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// 目标类型或积累类型
+	Type string `json:"type,omitempty"`
+
+	// 目标
+	Status      string  `json:"status,omitempty"`
+	Mode        string  `json:"mode,omitempty"`
+	Unit        string  `json:"unit,omitempty"`
+	GoalValue   float64 `json:"goal_value,omitempty"`
+	StartAt     int64   `json:"start_at,omitempty"`
+	ChallengeAt int64   `json:"challenge_at,omitempty"`
+	DoneAt      int64   `json:"done_at,omitempty"`
+
+	// 积累
+	IsChallenge     bool           `json:"is_challenge,omitempty"`
+	Measurement     float64        `json:"measurement,omitempty"`
+	Content         string         `json:"content,omitempty"`
+	URL             string         `json:"url,omitempty"`
+	LinkTitle       string         `json:"link_title,omitempty"`
+	LinkDescription string         `json:"link_description,omitempty"`
+	File            string         `json:"file,omitempty"`
+	Comments        ExportComments `json:"comments,omitempty"`
+}
+
+// ImportPlanItem 清单解析后的导入项。Ref在一次导入中唯一，
+// 目标的ParentRef是父目标，目录和积累的ParentRef是父目录(为空时在目标下)
+type ImportPlanItem struct {
+	Kind      string
+	Ref       string
+	ParentRef string
+	TargetRef string
+	CreatedAt int64
+	Data      *ImportItemData
+}
+
+// ImportFileStepType 根据文件的MIME类型判断积累类型
+func ImportFileStepType(mimeType string) string {
+	switch {
+	case IsDocumentMimeType(mimeType):
+		return "document"
+	case strings.HasPrefix(mimeType, "image/"):
+		return "image"
+	case strings.HasPrefix(mimeType, "video/"):
+		return "video"
+	case strings.HasPrefix(mimeType, "audio/"):
+		return "audio"
+	}
+	return ""
+}
+
+// ImportFileMimeType 压缩包中的文件只能根据扩展名判断类型
+func ImportFileMimeType(fileName string) string {
+	mimeType := mime.TypeByExtension(strings.ToLower(path.Ext(fileName)))
+	return DetectMimeType(fileName, mimeType)
+}
+
+// ImportFilePath 清单中的文件路径统一为压缩包内的相对路径
+func ImportFilePath(file string) (string, error) {
+	if file == "" {
+		return "", nil
+	}
+	cleaned := path.Clean("/" + strings.ReplaceAll(file, "\\", "/"))
+	cleaned = strings.TrimPrefix(cleaned, "/")
+	if cleaned == "" || cleaned == "." {
+		return "", fmt.Errorf("invalid file path: %s", file)
+	}
+	return cleaned, nil
+}
+
+// sortImportPlan 目标和目录按清单中的先后(父级在前)，积累按时间先后排在最后
+func sortImportPlan(items []*ImportPlanItem) []*ImportPlanItem {
+	var structure, steps []*ImportPlanItem
+	for _, item := range items {
+		if item.Kind == ImportItemKindStep {
+			steps = append(steps, item)
+		} else {
+			structure = append(structure, item)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].CreatedAt < steps[j].CreatedAt
+	})
+	return append(structure, steps...)
+}
+
+func checkImportPlan(items []*ImportPlanItem) ([]*ImportPlanItem, error) {
+	if len(items) == 0 {
+		return nil, errors.New("manifest is empty")
+	}
+	if len(items) > ImportMaxItems {
+		return nil, fmt.Errorf("manifest has too many items, max %d", ImportMaxItems)
+	}
+	return sortImportPlan(items), nil
+}
+
+// ParseImportJSON 解析与导出相同格式的manifest.json
+func ParseImportJSON(r io.Reader) ([]*ImportPlanItem, error) {
+	var manifest ExportManifest
+	err := json.NewDecoder(r).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest.json: %v", err)
+	}
+	if manifest.Target == nil {
+		return nil, errors.New("manifest.json has no target")
+	}
+
+	var items []*ImportPlanItem
+	var addSteps func(targetRef string, parentRef string, steps []*ExportStep) error
+	addSteps = func(targetRef string, parentRef string, steps []*ExportStep) error {
+		for _, s := range steps {
+			ref := fmt.Sprintf("s%d", len(items))
+			kind := ImportItemKindStep
+			if s.Type == "dir" {
+				kind = ImportItemKindDir
+			}
+			file, err := ImportFilePath(s.File)
+			if err != nil {
+				return err
+			}
+			items = append(items, &ImportPlanItem{
+				Kind:      kind,
+				Ref:       ref,
+				ParentRef: parentRef,
+				TargetRef: targetRef,
+				CreatedAt: s.CreatedAt,
+				Data: &ImportItemData{
+					Title:           s.Title,
+					Description:     s.Description,
+					Type:            s.Type,
+					IsChallenge:     s.IsChallenge,
+					Measurement:     s.Measurement,
+					Content:         s.Content,
+					URL:             s.URL,
+					LinkTitle:       s.LinkTitle,
+					LinkDescription: s.LinkDescription,
+					File:            file,
+					Comments:        s.Comments,
+				},
+			})
+			if err := addSteps(targetRef, ref, s.Children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var addTarget func(parentRef string, t *ExportTarget) error
+	addTarget = func(parentRef string, t *ExportTarget) error {
+		ref := fmt.Sprintf("t%d", len(items))
+		items = append(items, &ImportPlanItem{
+			Kind:      ImportItemKindTarget,
+			Ref:       ref,
+			ParentRef: parentRef,
+			CreatedAt: t.CreatedAt,
+			Data: &ImportItemData{
+				Title:       t.Title,
+				Description: t.Description,
+				Type:        t.Type,
+				Status:      t.Status,
+				Mode:        t.Mode,
+				Unit:        t.Unit,
+				GoalValue:   t.GoalValue,
+				StartAt:     t.StartAt,
+				ChallengeAt: t.ChallengeAt,
+				DoneAt:      t.DoneAt,
+			},
+		})
+		if err := addSteps(ref, "", t.Steps); err != nil {
+			return err
+		}
+		for _, child := range t.Children {
+			if err := addTarget(ref, child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err = addTarget("", manifest.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkImportPlan(items)
+}
+
+// manifest.csv的列，第一行为表头，列的顺序不限
+const (
+	importColumnTarget      = "target"
+	importColumnDir         = "dir"
+	importColumnType        = "type"
+	importColumnTitle       = "title"
+	importColumnDescription = "description"
+	importColumnCreatedAt   = "created_at"
+	importColumnFile        = "file"
+	importColumnContent     = "content"
+	importColumnURL         = "url"
+	importColumnMeasurement = "measurement"
+	importColumnIsChallenge = "is_challenge"
+)
+
+// parseImportTime 支持unix时间戳、RFC3339和本地时间
+func parseImportTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return unix, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Unix(), nil
+	}
+	for _, layout := range []string{time.DateTime, "2006-01-02 15:04", time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t.Unix(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid time: %s", value)
+}
+
+func splitImportPath(value string) []string {
+	var parts []string
+	for _, part := range strings.Split(value, "/") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
+
+// ParseImportCSV 每行一个积累，target和dir列是用/分隔的路径，相同路径的目标和目录只创建一次。
+// type为空时根据file的类型判断，没有type和file的行只创建目标和目录
+func ParseImportCSV(r io.Reader) ([]*ImportPlanItem, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest.csv: %v", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns[importColumnTarget]; !ok {
+		return nil, errors.New("manifest.csv has no target column")
+	}
+
+	var items []*ImportPlanItem
+	refs := make(map[string]*ImportPlanItem)
+	// ensure 按路径查找或创建目标和目录，返回ref。
+	// 目标和目录的时间取所有行中最早的，保证早于其下的积累
+	ensure := func(key string, item *ImportPlanItem) string {
+		if existing, ok := refs[key]; ok {
+			if item.CreatedAt != 0 && (existing.CreatedAt == 0 || item.CreatedAt < existing.CreatedAt) {
+				existing.CreatedAt = item.CreatedAt
+			}
+			return key
+		}
+		item.Ref = key
+		refs[key] = item
+		items = append(items, item)
+		return key
+	}
+
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("manifest.csv line %d: %v", line, err)
+		}
+
+		get := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		createdAt, err := parseImportTime(get(importColumnCreatedAt))
+		if err != nil {
+			return nil, fmt.Errorf("manifest.csv line %d: %v", line, err)
+		}
+
+		targetPath := splitImportPath(get(importColumnTarget))
+		if len(targetPath) == 0 {
+			return nil, fmt.Errorf("manifest.csv line %d: target is empty", line)
+		}
+
+		targetRef := ""
+		for i, title := range targetPath {
+			targetRef = ensure("t:"+strings.Join(targetPath[:i+1], "/"), &ImportPlanItem{
+				Kind:      ImportItemKindTarget,
+				ParentRef: targetRef,
+				CreatedAt: createdAt,
+				Data:      &ImportItemData{Title: title},
+			})
+		}
+
+		parentRef := ""
+		dirPath := splitImportPath(get(importColumnDir))
+		for i, title := range dirPath {
+			parentRef = ensure("d:"+targetRef+":"+strings.Join(dirPath[:i+1], "/"), &ImportPlanItem{
+				Kind:      ImportItemKindDir,
+				ParentRef: parentRef,
+				TargetRef: targetRef,
+				CreatedAt: createdAt,
+				Data:      &ImportItemData{Title: title, Type: "dir"},
+			})
+		}
+
+		file, err := ImportFilePath(get(importColumnFile))
+		if err != nil {
+			return nil, fmt.Errorf("manifest.csv line %d: %v", line, err)
+		}
+		stepType := get(importColumnType)
+		if stepType == "" && file == "" {
+			continue
+		}
+
+		var measurement float64
+		if value := get(importColumnMeasurement); value != "" {
+			measurement, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("manifest.csv line %d: invalid measurement: %s", line, value)
+			}
+		}
+
+		var isChallenge bool
+		if value := get(importColumnIsChallenge); value != "" {
+			isChallenge, err = strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("manifest.csv line %d: invalid is_challenge: %s", line, value)
+			}
+		}
+
+		items = append(items, &ImportPlanItem{
+			Kind:      ImportItemKindStep,
+			Ref:       fmt.Sprintf("s:%d", line),
+			ParentRef: parentRef,
+			TargetRef: targetRef,
+			CreatedAt: createdAt,
+			Data: &ImportItemData{
+				Title:       get(importColumnTitle),
+				Description: get(importColumnDescription),
+				Type:        stepType,
+				IsChallenge: isChallenge,
+				Measurement: measurement,
+				Content:     get(importColumnContent),
+				URL:         get(importColumnURL),
+				File:        file,
+			},
+		})
+	}
+
+	return checkImportPlan(items)
+}
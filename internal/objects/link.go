@@ -0,0 +1,7 @@
+package objects
+
+// LinkMeta 链接页面的元信息
+type LinkMeta struct {
+	Title       string
+	Description string
+}
@@ -0,0 +1,55 @@
+package objects
+
+import (
+	"strings"
+	"time"
+)
+
+// 免登录接口的默认限流和评论挑战，配置为0时使用
+const (
+	NoauthIpPerMinute   = 60
+	NoauthIpBurst       = 20
+	NoauthStepPerMinute = 30
+	NoauthStepBurst     = 10
+
+	CommentChallengeDifficulty = 16
+	CommentChallengeTTL        = 5 * time.Minute
+	// 难度上限，超过后客户端难以在有效期内求解
+	CommentChallengeMaxDifficulty = 28
+)
+
+// 分享链接share_to的默认有效期和最长有效期
+const (
+	ShareTokenTTL    = 30 * 24 * time.Hour
+	ShareTokenMaxTTL = 90 * 24 * time.Hour
+)
+
+// ParseShareRoles 分享链接share_to中逗号分隔的评论角色，如teacher,parent
+func ParseShareRoles(value string) ([]string, bool) {
+	roles := make([]string, 0, 3)
+	for _, role := range strings.Split(value, ",") {
+		role = strings.TrimSpace(role)
+		if !ValidCommentRole(role) {
+			return nil, false
+		}
+		roles = append(roles, role)
+	}
+	return roles, true
+}
+
+// ChallengeSolved sha256摘要的前difficulty位是否都为0
+func ChallengeSolved(sum []byte, difficulty uint32) bool {
+	for _, b := range sum {
+		if difficulty == 0 {
+			return true
+		}
+		if difficulty < 8 {
+			return b>>(8-difficulty) == 0
+		}
+		if b != 0 {
+			return false
+		}
+		difficulty -= 8
+	}
+	return difficulty == 0
+}
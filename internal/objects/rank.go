@@ -0,0 +1,127 @@
+package objects
+
+// 手动排序时相邻两个位置的初始间隔
+const RankGap = 1024.0
+
+// 间隔小于该值时重新编号
+const rankMinGap = 1e-6
+
+// 排序方式
+const (
+	// 手动排序，未排序过的按创建先后倒序
+	SortManual    = "manual"
+	SortCreatedAt = "created_at"
+	SortTitle     = "title"
+	SortStatus    = "status"
+)
+
+func ValidSort(sort string) bool {
+	switch sort {
+	case "", SortManual, SortCreatedAt, SortTitle, SortStatus:
+		return true
+	}
+	return false
+}
+
+// Rerank 根据新顺序下各项的当前rank，计算需要修改的rank(下标 -> 新rank)。
+// 保留最长严格递增子序列的rank不变，其余的取相邻保留项之间的分数值，
+// 所以一次拖动通常只需要修改一行；间隔不够时整体按RankGap重新编号。
+// rank为0表示还没有手动排序过(新创建的)，排在最前面，排序后的rank都大于0。
+func Rerank(ranks []float64) map[int]float64 {
+	n := len(ranks)
+	changes := make(map[int]float64)
+	if n == 0 {
+		return changes
+	}
+
+	keep := longestIncreasing(ranks)
+
+	ok := true
+	for i := 0; i < n; {
+		if keep[i] {
+			i++
+			continue
+		}
+		// [i, j)为一段需要重新计算的区间
+		j := i
+		for j < n && !keep[j] {
+			j++
+		}
+		count := j - i
+
+		var low, high float64
+		switch {
+		case i == 0 && j == n:
+			low, high = 0, RankGap*float64(count+1)
+		case i == 0:
+			low, high = 0, ranks[j]
+		case j == n:
+			low = ranks[i-1]
+			high = low + RankGap*float64(count+1)
+		default:
+			low, high = ranks[i-1], ranks[j]
+		}
+
+		step := (high - low) / float64(count+1)
+		if step < rankMinGap {
+			ok = false
+			break
+		}
+		for k := 0; k < count; k++ {
+			changes[i+k] = low + step*float64(k+1)
+		}
+		i = j
+	}
+
+	if !ok {
+		changes = make(map[int]float64)
+		for i := 0; i < n; i++ {
+			rank := RankGap * float64(i+1)
+			if ranks[i] != rank {
+				changes[i] = rank
+			}
+		}
+	}
+	return changes
+}
+
+// longestIncreasing 大于0的值中的最长严格递增子序列，返回每个下标是否在其中
+func longestIncreasing(values []float64) []bool {
+	n := len(values)
+	// tails[k] 长度为k+1的递增子序列的最后一个下标
+	tails := make([]int, 0, n)
+	prev := make([]int, n)
+	for i, v := range values {
+		if v <= 0 {
+			continue
+		}
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if values[tails[mid]] < v {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		} else {
+			prev[i] = -1
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	keep := make([]bool, n)
+	if len(tails) == 0 {
+		return keep
+	}
+	for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+		keep[i] = true
+	}
+	return keep
+}
@@ -0,0 +1,55 @@
+package objects
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestLongestIncreasing(t *testing.T) {
+	cases := []struct {
+		name   string
+		values []float64
+		keep   []bool
+	}{
+		{"empty", nil, []bool{}},
+		{"all unranked", []float64{0, 0}, []bool{false, false}},
+		{"increasing", []float64{1, 2, 3}, []bool{true, true, true}},
+		{"decreasing keeps last", []float64{3, 2, 1}, []bool{false, false, true}},
+		{"one moved forward", []float64{1, 3, 2, 4}, []bool{true, false, true, true}},
+		{"one moved to front", []float64{4, 1, 2, 3}, []bool{false, true, true, true}},
+		{"unranked skipped", []float64{0, 5, 0, 6}, []bool{false, true, false, true}},
+		{"equal values are not increasing", []float64{2, 2, 2}, []bool{false, false, true}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := longestIncreasing(c.values); !slices.Equal(got, c.keep) {
+				t.Errorf("longestIncreasing(%v) = %v, want %v", c.values, got, c.keep)
+			}
+		})
+	}
+}
+
+func TestRerank(t *testing.T) {
+	cases := []struct {
+		name    string
+		ranks   []float64
+		changes map[int]float64
+	}{
+		{"empty", nil, map[int]float64{}},
+		{"already ordered", []float64{1024, 2048, 3072}, map[int]float64{}},
+		{"moved between neighbours", []float64{1024, 3072, 2048}, map[int]float64{1: 1536}},
+		{"new item at front", []float64{0, 1024, 2048}, map[int]float64{0: 512}},
+		{"moved to end", []float64{2048, 3072, 1024}, map[int]float64{2: 4096}},
+		{"all unranked", []float64{0, 0}, map[int]float64{0: 1024, 1: 2048}},
+		{"gap too small renumbers", []float64{1, 1.0000001, 1.00000005}, map[int]float64{0: 1024, 1: 2048, 2: 3072}},
+		{"renumber keeps matching ranks", []float64{1024, 1024.0000001, 1024.00000005}, map[int]float64{1: 2048, 2: 3072}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Rerank(c.ranks); !maps.Equal(got, c.changes) {
+				t.Errorf("Rerank(%v) = %v, want %v", c.ranks, got, c.changes)
+			}
+		})
+	}
+}
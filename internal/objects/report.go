@@ -0,0 +1,134 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 报告状态
+const (
+	ReportStatusPending = "pending"
+	ReportStatusRunning = "running"
+	ReportStatusDone    = "done"
+	ReportStatusFailed  = "failed"
+)
+
+const (
+	// 没有选择媒体时展示范围内最新的图片数
+	ReportMediaLimit = 12
+	// 选择的媒体积累最多数量
+	ReportMaxMedia = 50
+	// 缩略图的最大边长
+	ReportThumbnailSize = 320
+	// 日期范围最多一年多，一个学期或学年
+	ReportMaxDays = 400
+)
+
+// ReportJob 报告的生成参数
+type ReportJob struct {
+	ID           uint64
+	UserID       string
+	TargetID     uint64
+	StartDate    string
+	EndDate      string
+	StatUnit     string
+	MediaStepIDs []uint64
+}
+
+// ReportDateRange 校验报告日期，返回范围的开始和结束时间(本地时间，包含结束日期当天)
+func ReportDateRange(startDate string, endDate string) (int64, int64, error) {
+	if startDate == "" || endDate == "" {
+		return 0, 0, errors.New("start_date and end_date are required")
+	}
+	start, err := time.ParseInLocation(time.DateOnly, startDate, time.Local)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start_date: %s", startDate)
+	}
+	end, err := time.ParseInLocation(time.DateOnly, endDate, time.Local)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end_date: %s", endDate)
+	}
+	if end.Before(start) {
+		return 0, 0, errors.New("end_date is before start_date")
+	}
+	if end.Sub(start) > ReportMaxDays*24*time.Hour {
+		return 0, 0, fmt.Errorf("date range is longer than %d days", ReportMaxDays)
+	}
+	return start.Unix(), end.AddDate(0, 0, 1).Unix() - 1, nil
+}
+
+// ReportContent 报告的内容，HTML和PDF使用相同的内容渲染
+type ReportContent struct {
+	Title       string
+	StartDate   string
+	EndDate     string
+	StatUnit    string
+	GeneratedAt int64
+	Target      *ReportTarget
+	StepRates   []*ReportStepRate
+	Portrait    []*ReportPortraitDimension
+	Media       []*ReportMedia
+	Comments    []*ReportComment
+}
+
+// ReportTarget 目标树，打卡数为日期范围内的积累数(不含目录)
+type ReportTarget struct {
+	ID         uint64
+	Title      string
+	Status     string
+	Layer      uint32
+	StartAt    int64
+	DoneAt     int64
+	Checkins   int
+	Challenges int
+	// 包含所有子目标
+	TotalCheckins int
+	Children      []*ReportTarget
+}
+
+// ReportStepRate 一个统计单位的评分平均值
+type ReportStepRate struct {
+	Unit                  string
+	WeightedValue         float64
+	TargetReasonableness  float64
+	TargetClarity         float64
+	TargetAchievement     float64
+	ReflectionImprovement float64
+	Innovation            float64
+	BasicReliability      float64
+	SkillImprovement      float64
+	Difficulty            float64
+}
+
+type ReportPortraitValue struct {
+	Name  string
+	Value float64
+}
+
+type ReportPortraitDimension struct {
+	Dimension string
+	Values    []*ReportPortraitValue
+}
+
+// ReportMedia 缩略图为JPEG
+type ReportMedia struct {
+	StepID      uint64
+	TargetTitle string
+	Title       string
+	Type        string
+	CreatedAt   int64
+	Thumbnail   []byte
+	Width       int
+	Height      int
+}
+
+type ReportComment struct {
+	StepID        uint64
+	TargetTitle   string
+	StepTitle     string
+	Role          string
+	Text          string
+	WeightedValue float64
+	CreatedAt     int64
+}
@@ -0,0 +1,55 @@
+package objects
+
+import "time"
+
+// 评论请求的状态
+const (
+	ReviewRequestStatusPending   = "pending"
+	ReviewRequestStatusCommented = "commented"
+	ReviewRequestStatusExpired   = "expired"
+)
+
+// 评论请求的通知方式，log同时保存在内存中供测试检查
+const (
+	ReviewNotifierLog     = "log"
+	ReviewNotifierSmtp    = "smtp"
+	ReviewNotifierWebhook = "webhook"
+)
+
+// 配置为0时使用
+const (
+	ReviewRemindAfterDays = 3
+	ReviewExpireAfterDays = 14
+	ReviewWebhookTimeout  = 10 * time.Second
+	ReviewSmtpTimeout     = 30 * time.Second
+	// 每个学生24小时内的请求数和邮件收件人数
+	ReviewMaxRequestsPerDay = 20
+	ReviewMaxEmailsPerDay   = 20
+)
+
+// 发送通知的任务超时，超过时重试
+const ReviewNotifyTaskTimeout = time.Minute
+
+// webhook请求体的HMAC-SHA256签名
+const ReviewSignatureHeader = "X-Step-Signature"
+
+// 通知的类型
+const (
+	ReviewNotificationRequest  = "request"
+	ReviewNotificationReminder = "reminder"
+)
+
+// ReviewNotification 关联的评论人按User ID通知，分享链接发送到邮箱
+type ReviewNotification struct {
+	Kind        string   `json:"kind"`
+	RequestID   uint64   `json:"request_id"`
+	StudentID   string   `json:"student_id"`
+	StepID      uint64   `json:"step_id"`
+	StepTitle   string   `json:"step_title"`
+	Roles       []string `json:"roles"`
+	ReviewerIDs []string `json:"reviewer_ids"`
+	Emails      []string `json:"emails"`
+	ShareTo     string   `json:"share_to"`
+	Message     string   `json:"message"`
+	ExpiresAt   int64    `json:"expires_at"`
+}
@@ -0,0 +1,27 @@
+package objects
+
+import "time"
+
+// 评论人关联的状态，接受邀请后为active
+const (
+	ReviewerStatusPending = "pending"
+	ReviewerStatusActive  = "active"
+)
+
+// GetReviewers的视角
+const (
+	ReviewerAsStudent  = "student"
+	ReviewerAsReviewer = "reviewer"
+)
+
+const (
+	// 邀请码字符集，去掉了容易混淆的0、O、1、I
+	ReviewerInviteAlphabet   = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	ReviewerInviteCodeLength = 8
+	ReviewerInviteTTL        = 7 * 24 * time.Hour
+)
+
+// ValidReviewerRole 只有老师和家长需要关联账号，朋友仍然通过分享链接评论
+func ValidReviewerRole(role string) bool {
+	return role == CommentRoleTeacher || role == CommentRoleParent
+}
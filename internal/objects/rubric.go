@@ -0,0 +1,490 @@
+package objects
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// 当前评论表单使用的评分标准版本
+const CurrentRubricVersion = "v1.0"
+
+// 顶层目标自定义评分标准的版本，评分按目标当前的评价项校验
+const TargetRubricVersion = "target"
+
+// 自定义评分标准的限制
+const (
+	TargetRubricMaxCriteria = 12
+	TargetRubricMaxScale    = 100
+)
+
+// StepRate中的评分列，不同版本的评分标准都映射到这些列上
+const (
+	RatingColumnWeightedValue         = "weighted_value"
+	RatingColumnTargetReasonableness  = "target_reasonableness"
+	RatingColumnTargetClarity         = "target_clarity"
+	RatingColumnTargetAchievement     = "target_achievement"
+	RatingColumnReflectionImprovement = "reflection_improvement"
+	RatingColumnInnovation            = "innovation"
+	RatingColumnBasicReliability      = "basic_reliability"
+	RatingColumnSkillImprovement      = "skill_improvement"
+	RatingColumnDifficulty            = "difficulty"
+)
+
+// 画像维度，和portrait表的dimension一致
+const (
+	PortraitDimensionSelfDiscipline     = "self_discipline"
+	PortraitDimensionTargetAndExecution = "target_and_execution"
+	PortraitDimensionLearningAndGrowth  = "learning_and_growth"
+)
+
+// RatingPortrait 评分列累加到的画像指标
+type RatingPortrait struct {
+	Dimension string
+	Key       string
+	// 只有挑战积累才累加
+	ChallengeOnly bool
+}
+
+// RatingColumnPortraits StepRate的列和画像指标的对应关系，所有版本共用，
+// 画像按StepRate的变化量累加，同一列在不同版本中不能对应不同的画像指标
+var RatingColumnPortraits = map[string][]RatingPortrait{
+	RatingColumnTargetReasonableness: {
+		{Dimension: PortraitDimensionTargetAndExecution, Key: PortraitTargetAndExecutionGoalReasonableness},
+	},
+	RatingColumnTargetClarity: {
+		{Dimension: PortraitDimensionTargetAndExecution, Key: PortraitTargetAndExecutionGoalClarity},
+	},
+	RatingColumnTargetAchievement: {
+		{Dimension: PortraitDimensionTargetAndExecution, Key: PortraitTargetAndExecutionGoalAchievement},
+	},
+	RatingColumnReflectionImprovement: {
+		{Dimension: PortraitDimensionLearningAndGrowth, Key: PortraitLearningAndGrowthReflectionAndImprovement},
+	},
+	RatingColumnInnovation: {
+		{Dimension: PortraitDimensionLearningAndGrowth, Key: PortraitLearningAndGrowthInnovativeMethod},
+	},
+	RatingColumnBasicReliability: {
+		{Dimension: PortraitDimensionLearningAndGrowth, Key: PortraitLearningAndGrowthBasicSolid},
+	},
+	RatingColumnSkillImprovement: {
+		{Dimension: PortraitDimensionLearningAndGrowth, Key: PortraitLearningAndGrowthSkillImprovement},
+	},
+	RatingColumnDifficulty: {
+		{Dimension: PortraitDimensionSelfDiscipline, Key: PortraitSelfDisciplineChallengeAttitude, ChallengeOnly: true},
+		{Dimension: PortraitDimensionLearningAndGrowth, Key: PortraitLearningAndGrowthChallengeAttitude, ChallengeOnly: true},
+	},
+}
+
+// RubricDimension 评分标准中的一项，Key是在data中的路径，用.分隔
+type RubricDimension struct {
+	Key         string
+	Title       string
+	Description string
+	Min         float64
+	Max         float64
+	// 只能是整数
+	Integer  bool
+	Required bool
+	// 映射到的StepRate列，自定义评价项为空
+	Column string
+	// 自定义评价项计算综合评价时的权重
+	Weight float64
+}
+
+// Rubric 一个版本的评分标准，评分以Neutral为中间值，StepRate和画像累加相对中间值的偏移
+type Rubric struct {
+	Version    string
+	Title      string
+	Neutral    float64
+	Dimensions []*RubricDimension
+}
+
+// comment v1.0
+/* {
+	"version": "v1.0",
+	"data":{
+	  "weighted_value": 5,
+	  "target": {
+		"target_reasonableness": 8,
+		"target_clarity": 8,
+		"target_achievement": 8
+	  },
+	  "quality":{
+		"reflection_improvement": 5,
+		"innovation": 5,
+		"basic_reliability": 5,
+		"skill_improvement": 5,
+		"difficulty": 5
+	  }
+	}
+   } */
+
+var rubrics = map[string]*Rubric{
+	"v1.0": {
+		Version: "v1.0",
+		Title:   "积累评价",
+		Neutral: 5,
+		Dimensions: []*RubricDimension{
+			{Key: "weighted_value", Title: "综合评价", Description: "对这次积累的整体评价", Min: 0, Max: 10, Required: true, Column: RatingColumnWeightedValue},
+			{Key: "target.target_reasonableness", Title: "目标合理性", Description: "目标是否切合实际", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnTargetReasonableness},
+			{Key: "target.target_clarity", Title: "目标明确性", Description: "目标是否具体清晰", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnTargetClarity},
+			{Key: "target.target_achievement", Title: "目标达成度", Description: "目标完成得怎么样", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnTargetAchievement},
+			{Key: "quality.reflection_improvement", Title: "反思与改进", Description: "是否总结问题并加以改进", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnReflectionImprovement},
+			{Key: "quality.innovation", Title: "创新方法", Description: "是否尝试了新的方法", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnInnovation},
+			{Key: "quality.basic_reliability", Title: "基础牢靠", Description: "基础知识和技能是否扎实", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnBasicReliability},
+			{Key: "quality.skill_improvement", Title: "技能提升", Description: "技能是否有明显提升", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnSkillImprovement},
+			{Key: "quality.difficulty", Title: "难度", Description: "这次积累的难度，挑战积累会计入挑战心态", Min: 0, Max: 10, Integer: true, Required: true, Column: RatingColumnDifficulty},
+		},
+	},
+}
+
+func init() {
+	for version, rubric := range rubrics {
+		columns := make(map[string]bool, len(rubric.Dimensions))
+		for _, dimension := range rubric.Dimensions {
+			if !validRatingColumn(dimension.Column) {
+				panic(fmt.Sprintf("rubric %s: unknown column %s", version, dimension.Column))
+			}
+			if columns[dimension.Column] {
+				panic(fmt.Sprintf("rubric %s: duplicate column %s", version, dimension.Column))
+			}
+			columns[dimension.Column] = true
+			if dimension.Min > rubric.Neutral || dimension.Max < rubric.Neutral {
+				panic(fmt.Sprintf("rubric %s: neutral out of range of %s", version, dimension.Key))
+			}
+		}
+	}
+}
+
+func validRatingColumn(column string) bool {
+	switch column {
+	case RatingColumnWeightedValue, RatingColumnTargetReasonableness, RatingColumnTargetClarity,
+		RatingColumnTargetAchievement, RatingColumnReflectionImprovement, RatingColumnInnovation,
+		RatingColumnBasicReliability, RatingColumnSkillImprovement, RatingColumnDifficulty:
+		return true
+	}
+	return false
+}
+
+// TargetRubricCriterion 自定义评价项，Key在目标内唯一
+type TargetRubricCriterion struct {
+	Key         string  `json:"key"`
+	Title       string  `json:"title"`
+	Description string  `json:"description,omitempty"`
+	Weight      float64 `json:"weight"`
+}
+
+// TargetRubric 顶层目标自定义的评分标准，如运动、音乐、数学各有不同的评价项；
+// 内置的StepRate列是默认评分标准
+type TargetRubric struct {
+	Title    string                   `json:"title"`
+	ScaleMin float64                  `json:"scale_min"`
+	ScaleMax float64                  `json:"scale_max"`
+	Criteria []*TargetRubricCriterion `json:"criteria"`
+}
+
+// IsCustom 没有评价项时使用默认评分标准
+func (t *TargetRubric) IsCustom() bool {
+	return t != nil && len(t.Criteria) > 0
+}
+
+// Validate 评价项不能和内置的StepRate列同名，否则统计时无法区分
+func (t *TargetRubric) Validate() error {
+	if t.ScaleMin < 0 || t.ScaleMax > TargetRubricMaxScale || t.ScaleMax <= t.ScaleMin {
+		return fmt.Errorf("scale must be within 0 and %d and max greater than min", TargetRubricMaxScale)
+	}
+	if len(t.Criteria) > TargetRubricMaxCriteria {
+		return fmt.Errorf("at most %d criteria", TargetRubricMaxCriteria)
+	}
+	keys := make(map[string]bool, len(t.Criteria))
+	for _, criterion := range t.Criteria {
+		if validRatingColumn(criterion.Key) {
+			return fmt.Errorf("criterion %s is reserved", criterion.Key)
+		}
+		if keys[criterion.Key] {
+			return fmt.Errorf("duplicate criterion %s", criterion.Key)
+		}
+		keys[criterion.Key] = true
+		if criterion.Weight <= 0 {
+			return fmt.Errorf("weight of %s must be positive", criterion.Key)
+		}
+	}
+	return nil
+}
+
+// SameCriteria 评价项和量程都相同时已有的评分仍然有效，标题、说明和权重可以修改
+func (t *TargetRubric) SameCriteria(o *TargetRubric) bool {
+	if !t.IsCustom() || !o.IsCustom() {
+		return t.IsCustom() == o.IsCustom()
+	}
+	if t.ScaleMin != o.ScaleMin || t.ScaleMax != o.ScaleMax || len(t.Criteria) != len(o.Criteria) {
+		return false
+	}
+	keys := make(map[string]bool, len(t.Criteria))
+	for _, criterion := range t.Criteria {
+		keys[criterion.Key] = true
+	}
+	for _, criterion := range o.Criteria {
+		if !keys[criterion.Key] {
+			return false
+		}
+	}
+	return true
+}
+
+// Rubric 转换成评分标准，中间值为量程的中点
+func (t *TargetRubric) Rubric() *Rubric {
+	rubric := &Rubric{
+		Version:    TargetRubricVersion,
+		Title:      t.Title,
+		Neutral:    (t.ScaleMin + t.ScaleMax) / 2,
+		Dimensions: make([]*RubricDimension, len(t.Criteria)),
+	}
+	for i, criterion := range t.Criteria {
+		rubric.Dimensions[i] = &RubricDimension{
+			Key:         criterion.Key,
+			Title:       criterion.Title,
+			Description: criterion.Description,
+			Min:         t.ScaleMin,
+			Max:         t.ScaleMax,
+			Required:    true,
+			Weight:      criterion.Weight,
+		}
+	}
+	return rubric
+}
+
+// GetRubric version为空时返回当前版本
+func GetRubric(version string) (*Rubric, bool) {
+	if version == "" {
+		version = CurrentRubricVersion
+	}
+	rubric, ok := rubrics[version]
+	return rubric, ok
+}
+
+// Rating 按评分标准校验后的评分，Values按StepRate列保存，Criteria按自定义评价项保存
+type Rating struct {
+	Rubric   *Rubric
+	Values   map[string]float64
+	Criteria map[string]float64
+}
+
+// ParseRating 按注册的评分标准校验
+func ParseRating(ratingMap map[string]any) (*Rating, error) {
+	version, _ := ratingMap["version"].(string)
+	if version == "" {
+		return nil, fmt.Errorf("version is required")
+	}
+	rubric, ok := rubrics[version]
+	if !ok {
+		return nil, fmt.Errorf("unknown rubric version %s", version)
+	}
+	return rubric.Parse(ratingMap)
+}
+
+// ParseTargetRating 顶层目标有自定义评分标准时按它校验，否则按注册的评分标准校验
+func ParseTargetRating(ratingMap map[string]any, targetRubric *TargetRubric) (*Rating, error) {
+	if !targetRubric.IsCustom() {
+		return ParseRating(ratingMap)
+	}
+	return targetRubric.Rubric().Parse(ratingMap)
+}
+
+// Parse 校验版本、必填项、取值范围，不允许评分标准之外的字段；
+// 非必填项没有评分时按中间值处理，不影响StepRate和画像
+func (rubric *Rubric) Parse(ratingMap map[string]any) (*Rating, error) {
+	if version, _ := ratingMap["version"].(string); version != rubric.Version {
+		return nil, fmt.Errorf("version must be %s", rubric.Version)
+	}
+	for key := range ratingMap {
+		if key != "version" && key != "data" {
+			return nil, fmt.Errorf("unknown field %s", key)
+		}
+	}
+	data, ok := ratingMap["data"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("data is required")
+	}
+
+	leaves := make(map[string]any)
+	if err := flattenRating("", data, leaves); err != nil {
+		return nil, err
+	}
+
+	rating := &Rating{
+		Rubric:   rubric,
+		Values:   make(map[string]float64, len(rubric.Dimensions)),
+		Criteria: make(map[string]float64),
+	}
+	for _, dimension := range rubric.Dimensions {
+		raw, ok := leaves[dimension.Key]
+		delete(leaves, dimension.Key)
+		if !ok {
+			if dimension.Required {
+				return nil, fmt.Errorf("%s is required", dimension.Key)
+			}
+			rating.set(dimension, rubric.Neutral)
+			continue
+		}
+		value, ok := ratingNumber(raw)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a number", dimension.Key)
+		}
+		if value < dimension.Min || value > dimension.Max {
+			return nil, fmt.Errorf("%s must be between %v and %v", dimension.Key, dimension.Min, dimension.Max)
+		}
+		if dimension.Integer && value != math.Trunc(value) {
+			return nil, fmt.Errorf("%s must be an integer", dimension.Key)
+		}
+		rating.set(dimension, value)
+	}
+
+	if len(leaves) > 0 {
+		unknown := make([]string, 0, len(leaves))
+		for key := range leaves {
+			unknown = append(unknown, key)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown field %s", strings.Join(unknown, ", "))
+	}
+
+	return rating, nil
+}
+
+func (r *Rating) set(dimension *RubricDimension, value float64) {
+	if dimension.Column == "" {
+		r.Criteria[dimension.Key] = value
+		return
+	}
+	r.Values[dimension.Column] = value
+}
+
+func flattenRating(prefix string, data map[string]any, leaves map[string]any) error {
+	for key, value := range data {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if child, ok := value.(map[string]any); ok {
+			if err := flattenRating(key, child, leaves); err != nil {
+				return err
+			}
+			continue
+		}
+		leaves[key] = value
+	}
+	return nil
+}
+
+// ratingNumber json解码后是float64，导入和测试中构造的评分可能是整数
+func ratingNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// WeightedValue 综合评价的分数，自定义评分标准按权重平均后换算到0-10
+func (r *Rating) WeightedValue() float64 {
+	if value, ok := r.Values[RatingColumnWeightedValue]; ok {
+		return value
+	}
+	return rubrics[CurrentRubricVersion].Neutral + r.criteriaOffset()
+}
+
+// criteriaOffset 自定义评价项按权重平均的偏移，按量程换算成0-10的偏移
+func (r *Rating) criteriaOffset() float64 {
+	var sum, weights, scale float64
+	for _, dimension := range r.Rubric.Dimensions {
+		value, ok := r.Criteria[dimension.Key]
+		if !ok {
+			continue
+		}
+		sum += (value - r.Rubric.Neutral) * dimension.Weight
+		weights += dimension.Weight
+		scale = dimension.Max - dimension.Min
+	}
+	if weights == 0 || scale == 0 {
+		return 0
+	}
+	return sum / weights * 10 / scale
+}
+
+// Value 评分标准中一项的原始分数，内置项按StepRate列取，不同版本之间可以比较
+func (r *Rating) Value(dimension *RubricDimension) (float64, bool) {
+	if dimension.Column == "" {
+		value, ok := r.Criteria[dimension.Key]
+		return value, ok
+	}
+	value, ok := r.Values[dimension.Column]
+	return value, ok
+}
+
+// CriteriaScores 自定义评价项相对中间值的偏移
+func (r *Rating) CriteriaScores() map[string]float64 {
+	scores := make(map[string]float64, len(r.Criteria))
+	for key, value := range r.Criteria {
+		scores[key] = value - r.Rubric.Neutral
+	}
+	return scores
+}
+
+// Scores 各列相对中间值的偏移，自定义评分标准只有综合评价
+func (r *Rating) Scores() CommentScores {
+	offset := func(column string) float64 {
+		value, ok := r.Values[column]
+		if !ok {
+			return 0
+		}
+		return value - r.Rubric.Neutral
+	}
+	weightedValue := offset(RatingColumnWeightedValue)
+	if _, ok := r.Values[RatingColumnWeightedValue]; !ok {
+		weightedValue = r.criteriaOffset()
+	}
+	return CommentScores{
+		WeightedValue:         weightedValue,
+		TargetReasonableness:  int32(offset(RatingColumnTargetReasonableness)),
+		TargetClarity:         int32(offset(RatingColumnTargetClarity)),
+		TargetAchievement:     int32(offset(RatingColumnTargetAchievement)),
+		ReflectionImprovement: int32(offset(RatingColumnReflectionImprovement)),
+		Innovation:            int32(offset(RatingColumnInnovation)),
+		BasicReliability:      int32(offset(RatingColumnBasicReliability)),
+		SkillImprovement:      int32(offset(RatingColumnSkillImprovement)),
+		Difficulty:            int32(offset(RatingColumnDifficulty)),
+	}
+}
+
+// Column 按StepRate列取整数偏移，用于累加画像
+func (s CommentScores) Column(column string) int32 {
+	switch column {
+	case RatingColumnTargetReasonableness:
+		return s.TargetReasonableness
+	case RatingColumnTargetClarity:
+		return s.TargetClarity
+	case RatingColumnTargetAchievement:
+		return s.TargetAchievement
+	case RatingColumnReflectionImprovement:
+		return s.ReflectionImprovement
+	case RatingColumnInnovation:
+		return s.Innovation
+	case RatingColumnBasicReliability:
+		return s.BasicReliability
+	case RatingColumnSkillImprovement:
+		return s.SkillImprovement
+	case RatingColumnDifficulty:
+		return s.Difficulty
+	}
+	return 0
+}
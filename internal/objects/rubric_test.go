@@ -0,0 +1,263 @@
+package objects
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// ratingV1 v1.0的完整评分，modify修改data后返回
+func ratingV1(modify func(data map[string]any)) map[string]any {
+	data := map[string]any{
+		"weighted_value": 8.5,
+		"target": map[string]any{
+			"target_reasonableness": 8.0,
+			"target_clarity":        7.0,
+			"target_achievement":    9.0,
+		},
+		"quality": map[string]any{
+			"reflection_improvement": 6.0,
+			"innovation":             5.0,
+			"basic_reliability":      4.0,
+			"skill_improvement":      7.0,
+			"difficulty":             9.0,
+		},
+	}
+	if modify != nil {
+		modify(data)
+	}
+	return map[string]any{"version": "v1.0", "data": data}
+}
+
+func TestRubricParse(t *testing.T) {
+	rubric, _ := GetRubric("v1.0")
+	cases := []struct {
+		name   string
+		rating map[string]any
+		err    string
+		values map[string]float64
+	}{
+		{
+			name:   "valid",
+			rating: ratingV1(nil),
+			values: map[string]float64{
+				RatingColumnWeightedValue:         8.5,
+				RatingColumnTargetReasonableness:  8,
+				RatingColumnTargetClarity:         7,
+				RatingColumnTargetAchievement:     9,
+				RatingColumnReflectionImprovement: 6,
+				RatingColumnInnovation:            5,
+				RatingColumnBasicReliability:      4,
+				RatingColumnSkillImprovement:      7,
+				RatingColumnDifficulty:            9,
+			},
+		},
+		{
+			name: "integer values from import",
+			rating: ratingV1(func(data map[string]any) {
+				data["weighted_value"] = 6
+				data["target"].(map[string]any)["target_clarity"] = int64(3)
+			}),
+			values: map[string]float64{
+				RatingColumnWeightedValue:         6,
+				RatingColumnTargetReasonableness:  8,
+				RatingColumnTargetClarity:         3,
+				RatingColumnTargetAchievement:     9,
+				RatingColumnReflectionImprovement: 6,
+				RatingColumnInnovation:            5,
+				RatingColumnBasicReliability:      4,
+				RatingColumnSkillImprovement:      7,
+				RatingColumnDifficulty:            9,
+			},
+		},
+		{
+			name:   "wrong version",
+			rating: map[string]any{"version": "v0.9", "data": ratingV1(nil)["data"]},
+			err:    "version must be v1.0",
+		},
+		{
+			name:   "unknown top level field",
+			rating: map[string]any{"version": "v1.0", "data": ratingV1(nil)["data"], "extra": 1},
+			err:    "unknown field extra",
+		},
+		{
+			name:   "missing data",
+			rating: map[string]any{"version": "v1.0"},
+			err:    "data is required",
+		},
+		{
+			name: "missing required",
+			rating: ratingV1(func(data map[string]any) {
+				delete(data["quality"].(map[string]any), "difficulty")
+			}),
+			err: "quality.difficulty is required",
+		},
+		{
+			name: "out of range",
+			rating: ratingV1(func(data map[string]any) {
+				data["target"].(map[string]any)["target_achievement"] = 11.0
+			}),
+			err: "target.target_achievement must be between 0 and 10",
+		},
+		{
+			name: "negative",
+			rating: ratingV1(func(data map[string]any) {
+				data["weighted_value"] = -1.0
+			}),
+			err: "weighted_value must be between 0 and 10",
+		},
+		{
+			name: "not an integer",
+			rating: ratingV1(func(data map[string]any) {
+				data["quality"].(map[string]any)["innovation"] = 5.5
+			}),
+			err: "quality.innovation must be an integer",
+		},
+		{
+			name: "not a number",
+			rating: ratingV1(func(data map[string]any) {
+				data["quality"].(map[string]any)["innovation"] = "5"
+			}),
+			err: "quality.innovation must be a number",
+		},
+		{
+			name: "unknown nested fields sorted",
+			rating: ratingV1(func(data map[string]any) {
+				data["quality"].(map[string]any)["speed"] = 5.0
+				data["accuracy"] = 5.0
+			}),
+			err: "unknown field accuracy, quality.speed",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rating, err := rubric.Parse(c.rating)
+			if c.err != "" {
+				if err == nil || err.Error() != c.err {
+					t.Fatalf("error = %v, want %s", err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rating.Values) != len(c.values) {
+				t.Fatalf("values = %v, want %v", rating.Values, c.values)
+			}
+			for column, want := range c.values {
+				if got := rating.Values[column]; got != want {
+					t.Errorf("%s = %v, want %v", column, got, want)
+				}
+			}
+			if len(rating.Criteria) != 0 {
+				t.Errorf("criteria = %v, want empty", rating.Criteria)
+			}
+		})
+	}
+}
+
+func TestRubricParseOptional(t *testing.T) {
+	rubric := &Rubric{
+		Version: "test",
+		Neutral: 5,
+		Dimensions: []*RubricDimension{
+			{Key: "tone", Min: 0, Max: 10, Required: true, Weight: 1},
+			{Key: "rhythm", Min: 0, Max: 10, Weight: 1},
+		},
+	}
+	cases := []struct {
+		name     string
+		data     map[string]any
+		criteria map[string]float64
+	}{
+		{"all rated", map[string]any{"tone": 7.0, "rhythm": 2.0}, map[string]float64{"tone": 7, "rhythm": 2}},
+		{"optional missing uses neutral", map[string]any{"tone": 7.0}, map[string]float64{"tone": 7, "rhythm": 5}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rating, err := rubric.Parse(map[string]any{"version": "test", "data": c.data})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rating.Values) != 0 {
+				t.Errorf("values = %v, want empty", rating.Values)
+			}
+			for key, want := range c.criteria {
+				if got := rating.Criteria[key]; got != want {
+					t.Errorf("%s = %v, want %v", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseTargetRating(t *testing.T) {
+	targetRubric := &TargetRubric{
+		ScaleMin: 0,
+		ScaleMax: 100,
+		Criteria: []*TargetRubricCriterion{{Key: "speed", Weight: 1}},
+	}
+	cases := []struct {
+		name   string
+		rubric *TargetRubric
+		rating map[string]any
+		err    string
+	}{
+		{"default rubric", nil, ratingV1(nil), ""},
+		{"custom rubric", targetRubric, map[string]any{"version": TargetRubricVersion, "data": map[string]any{"speed": 80.0}}, ""},
+		{"custom rubric rejects v1.0", targetRubric, ratingV1(nil), "version must be target"},
+		{"custom rubric range", targetRubric, map[string]any{"version": TargetRubricVersion, "data": map[string]any{"speed": 101.0}}, "speed must be between 0 and 100"},
+		{"missing version", nil, map[string]any{"data": map[string]any{}}, "version is required"},
+		{"unknown version", nil, map[string]any{"version": "v9", "data": map[string]any{}}, "unknown rubric version v9"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := ParseTargetRating(c.rating, c.rubric)
+			if c.err == "" && err != nil {
+				t.Fatal(err)
+			}
+			if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+				t.Fatalf("error = %v, want %s", err, c.err)
+			}
+		})
+	}
+}
+
+func TestCriteriaOffset(t *testing.T) {
+	cases := []struct {
+		name     string
+		min, max float64
+		weights  map[string]float64
+		values   map[string]float64
+		offset   float64
+	}{
+		{"neutral", 0, 100, map[string]float64{"a": 1, "b": 1}, map[string]float64{"a": 50, "b": 50}, 0},
+		{"top of scale", 0, 100, map[string]float64{"a": 1, "b": 1}, map[string]float64{"a": 100, "b": 100}, 5},
+		{"bottom of scale", 0, 10, map[string]float64{"a": 1}, map[string]float64{"a": 0}, -5},
+		{"weighted", 0, 100, map[string]float64{"a": 1, "b": 3}, map[string]float64{"a": 100, "b": 50}, 1.25},
+		{"opposite weights cancel", 0, 10, map[string]float64{"a": 2, "b": 2}, map[string]float64{"a": 10, "b": 0}, 0},
+		{"scale not starting at zero", 1, 5, map[string]float64{"a": 1}, map[string]float64{"a": 5}, 5},
+		{"no criteria rated", 0, 10, map[string]float64{"a": 1}, map[string]float64{}, 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			targetRubric := &TargetRubric{ScaleMin: c.min, ScaleMax: c.max}
+			for _, key := range []string{"a", "b"} {
+				if weight, ok := c.weights[key]; ok {
+					targetRubric.Criteria = append(targetRubric.Criteria, &TargetRubricCriterion{Key: key, Weight: weight})
+				}
+			}
+			rating := &Rating{Rubric: targetRubric.Rubric(), Values: map[string]float64{}, Criteria: c.values}
+
+			if got := rating.criteriaOffset(); math.Abs(got-c.offset) > 1e-9 {
+				t.Errorf("criteriaOffset = %v, want %v", got, c.offset)
+			}
+			if got := rating.WeightedValue(); math.Abs(got-(5+c.offset)) > 1e-9 {
+				t.Errorf("WeightedValue = %v, want %v", got, 5+c.offset)
+			}
+			if got := rating.Scores().WeightedValue; math.Abs(got-c.offset) > 1e-9 {
+				t.Errorf("Scores().WeightedValue = %v, want %v", got, c.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// 打卡周期类型
+const (
+	// 无固定周期，按自然日计算
+	ScheduleTypeNone = "none"
+	// 每天
+	ScheduleTypeDaily = "daily"
+	// 每周指定几天
+	ScheduleTypeWeekdays = "weekdays"
+	// 每周N次
+	ScheduleTypeTimesPerWeek = "times_per_week"
+)
+
+// 统计单位
+const (
+	StatUnitDay   = "day"
+	StatUnitWeek  = "week"
+	StatUnitMonth = "month"
+)
+
+// Schedule 目标的打卡周期
+type Schedule struct {
+	Type string
+	// 0-6, 0为周日
+	Weekdays []int
+	// 每周打卡次数
+	Times uint32
+}
+
+// PeriodAdherence 某个统计周期内的计划打卡与实际打卡情况
+type PeriodAdherence struct {
+	Unit        string
+	Expected    int
+	Actual      int
+	MissedDates []string
+}
+
+func (s *Schedule) IsRecurring() bool {
+	return s != nil && s.Type != "" && s.Type != ScheduleTypeNone
+}
+
+func (s *Schedule) Validate() error {
+	switch s.Type {
+	case "", ScheduleTypeNone, ScheduleTypeDaily:
+		return nil
+	case ScheduleTypeWeekdays:
+		if len(s.Weekdays) == 0 {
+			return errors.New("weekdays is empty")
+		}
+		for _, weekday := range s.Weekdays {
+			if weekday < 0 || weekday > 6 {
+				return fmt.Errorf("invalid weekday: %d", weekday)
+			}
+		}
+		return nil
+	case ScheduleTypeTimesPerWeek:
+		if s.Times == 0 || s.Times > 7 {
+			return fmt.Errorf("invalid times per week: %d", s.Times)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid schedule type: %s", s.Type)
+	}
+}
+
+// IsCheckinDay 是否为计划打卡日；每周N次的目标每天都可以打卡
+func (s *Schedule) IsCheckinDay(day time.Time) bool {
+	if s.Type != ScheduleTypeWeekdays {
+		return true
+	}
+	for _, weekday := range s.Weekdays {
+		if int(day.Weekday()) == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// Day 返回所在日的零点
+func Day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// DayKey 所在日的YYYYMMDD，作为按天去重的map键；time.Time带时区和单调时钟，不能直接比较
+func DayKey(t time.Time) int {
+	y, m, d := t.Date()
+	return y*10000 + int(m)*100 + d
+}
+
+// weekStart 返回所在ISO周的周一零点
+func weekStart(t time.Time) time.Time {
+	day := Day(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// StatUnitLabel 与step rate统计保持一致的周期标识
+func StatUnitLabel(t time.Time, statUnit string) string {
+	switch statUnit {
+	case StatUnitWeek:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case StatUnitMonth:
+		return t.Format("2006-01")
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+// CheckinDays 打卡时间去重到天，键为DayKey
+func CheckinDays(checkins []time.Time) map[int]bool {
+	days := make(map[int]bool, len(checkins))
+	for _, checkin := range checkins {
+		days[DayKey(checkin)] = true
+	}
+	return days
+}
+
+// Adherence 计算[start, end]内每个统计周期的计划打卡数与实际打卡数，end之后的日期不计入
+func (s *Schedule) Adherence(checkins []time.Time, start time.Time, end time.Time, statUnit string) []*PeriodAdherence {
+	start, end = Day(start), Day(end)
+	if end.Before(start) {
+		return nil
+	}
+	days := CheckinDays(checkins)
+
+	periods := make([]*PeriodAdherence, 0)
+	periodIndex := make(map[string]*PeriodAdherence)
+	getPeriod := func(day time.Time) *PeriodAdherence {
+		label := StatUnitLabel(day, statUnit)
+		period, ok := periodIndex[label]
+		if !ok {
+			period = &PeriodAdherence{Unit: label, MissedDates: []string{}}
+			periodIndex[label] = period
+			periods = append(periods, period)
+		}
+		return period
+	}
+
+	if s.Type == ScheduleTypeTimesPerWeek {
+		// 按周计算应打卡次数，不完整的周按天数折算
+		for ws := weekStart(start); !ws.After(end); ws = ws.AddDate(0, 0, 7) {
+			var inRange []time.Time
+			for i := 0; i < 7; i++ {
+				day := ws.AddDate(0, 0, i)
+				if day.Before(start) || day.After(end) {
+					continue
+				}
+				inRange = append(inRange, day)
+			}
+			expected := int(math.Ceil(float64(s.Times) * float64(len(inRange)) / 7))
+			actual := 0
+			for _, day := range inRange {
+				if days[DayKey(day)] {
+					actual++
+				}
+			}
+			// 多出来的打卡不计入，按天分摊到所属的统计周期
+			quota := expected
+			remaining := expected - min(actual, expected)
+			for _, day := range inRange {
+				period := getPeriod(day)
+				if days[DayKey(day)] && quota > 0 {
+					period.Actual++
+					period.Expected++
+					quota--
+				}
+			}
+			// 缺少的次数记在本周最后几个没有打卡的日期上
+			missed := make(map[int]bool)
+			for i := len(inRange) - 1; i >= 0 && remaining > 0; i-- {
+				if !days[DayKey(inRange[i])] {
+					missed[DayKey(inRange[i])] = true
+					remaining--
+				}
+			}
+			for _, day := range inRange {
+				if missed[DayKey(day)] {
+					period := getPeriod(day)
+					period.Expected++
+					period.MissedDates = append(period.MissedDates, day.Format("2006-01-02"))
+				}
+			}
+		}
+	} else {
+		for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+			period := getPeriod(day)
+			if !s.IsCheckinDay(day) {
+				continue
+			}
+			period.Expected++
+			if days[DayKey(day)] {
+				period.Actual++
+			} else {
+				period.MissedDates = append(period.MissedDates, day.Format("2006-01-02"))
+			}
+		}
+	}
+
+	sort.SliceStable(periods, func(i, j int) bool {
+		return periods[i].Unit < periods[j].Unit
+	})
+	return periods
+}
+
+// Streak 计算截止到today的连续达成周期数：
+// 按天的计划按计划打卡日计算，休息日不打断；每周N次的计划按周计算；无计划的按自然日计算。
+// 当天(当周)尚未达成时不打断连续性。
+func (s *Schedule) Streak(checkins []time.Time, today time.Time) int {
+	days := CheckinDays(checkins)
+	today = Day(today)
+	if len(days) == 0 {
+		return 0
+	}
+	earliest := today
+	for _, checkin := range checkins {
+		if day := Day(checkin); day.Before(earliest) {
+			earliest = day
+		}
+	}
+
+	streak := 0
+	if s.Type == ScheduleTypeTimesPerWeek {
+		for ws := weekStart(today); !ws.Before(weekStart(earliest)); ws = ws.AddDate(0, 0, -7) {
+			count := 0
+			for i := 0; i < 7; i++ {
+				if days[DayKey(ws.AddDate(0, 0, i))] {
+					count++
+				}
+			}
+			if count >= int(s.Times) {
+				streak++
+			} else if !ws.Equal(weekStart(today)) {
+				break
+			}
+		}
+		return streak
+	}
+
+	for day := today; !day.Before(earliest); day = day.AddDate(0, 0, -1) {
+		if s.Type == ScheduleTypeWeekdays && !s.IsCheckinDay(day) {
+			continue
+		}
+		if days[DayKey(day)] {
+			streak++
+		} else if !day.Equal(today) {
+			break
+		}
+	}
+	return streak
+}
+
+// MissedOn day这一天(或以day结尾的一周)缺少的计划打卡次数
+func (s *Schedule) MissedOn(checkins []time.Time, day time.Time) int {
+	days := CheckinDays(checkins)
+	day = Day(day)
+	switch s.Type {
+	case ScheduleTypeDaily, ScheduleTypeWeekdays:
+		if s.IsCheckinDay(day) && !days[DayKey(day)] {
+			return 1
+		}
+	case ScheduleTypeTimesPerWeek:
+		// 只在周日结算本周
+		if day.Weekday() != time.Sunday {
+			return 0
+		}
+		count := 0
+		for ws, i := weekStart(day), 0; i < 7; i++ {
+			if days[DayKey(ws.AddDate(0, 0, i))] {
+				count++
+			}
+		}
+		if count < int(s.Times) {
+			return int(s.Times) - count
+		}
+	}
+	return 0
+}
+
+// NeedsReminder today是否需要提醒打卡
+func (s *Schedule) NeedsReminder(checkins []time.Time, today time.Time) bool {
+	days := CheckinDays(checkins)
+	today = Day(today)
+	if days[DayKey(today)] {
+		return false
+	}
+	switch s.Type {
+	case ScheduleTypeDaily, ScheduleTypeWeekdays:
+		return s.IsCheckinDay(today)
+	case ScheduleTypeTimesPerWeek:
+		// 本周剩余天数不多于剩余次数时提醒
+		ws := weekStart(today)
+		count := 0
+		for i := 0; i < 7; i++ {
+			if days[DayKey(ws.AddDate(0, 0, i))] {
+				count++
+			}
+		}
+		remainingTimes := int(s.Times) - count
+		remainingDays := 7 - int(math.Round(today.Sub(ws).Hours()/24))
+		return remainingTimes > 0 && remainingDays <= remainingTimes
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package objects
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testDays 按本地时区解析日期，格式2006-01-02
+func testDays(t *testing.T, values ...string) []time.Time {
+	t.Helper()
+	days := make([]time.Time, len(values))
+	for i, value := range values {
+		day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			t.Fatal(err)
+		}
+		days[i] = day
+	}
+	return days
+}
+
+func TestScheduleAdherence(t *testing.T) {
+	// 2026-10-12为周一
+	cases := []struct {
+		name       string
+		schedule   *Schedule
+		checkins   []string
+		start, end string
+		statUnit   string
+		periods    []PeriodAdherence
+	}{
+		{
+			name:     "daily by day",
+			schedule: &Schedule{Type: ScheduleTypeDaily},
+			checkins: []string{"2026-10-12", "2026-10-14"},
+			start:    "2026-10-12", end: "2026-10-14",
+			statUnit: StatUnitDay,
+			periods: []PeriodAdherence{
+				{Unit: "2026-10-12", Expected: 1, Actual: 1, MissedDates: []string{}},
+				{Unit: "2026-10-13", Expected: 1, Actual: 0, MissedDates: []string{"2026-10-13"}},
+				{Unit: "2026-10-14", Expected: 1, Actual: 1, MissedDates: []string{}},
+			},
+		},
+		{
+			name:     "weekdays skip rest days",
+			schedule: &Schedule{Type: ScheduleTypeWeekdays, Weekdays: []int{1, 3}},
+			checkins: []string{"2026-10-12", "2026-10-13"},
+			start:    "2026-10-12", end: "2026-10-18",
+			statUnit: StatUnitWeek,
+			periods: []PeriodAdherence{
+				{Unit: "2026-W42", Expected: 2, Actual: 1, MissedDates: []string{"2026-10-14"}},
+			},
+		},
+		{
+			name:     "times per week misses at end of week",
+			schedule: &Schedule{Type: ScheduleTypeTimesPerWeek, Times: 3},
+			checkins: []string{"2026-10-12", "2026-10-20"},
+			start:    "2026-10-12", end: "2026-10-25",
+			statUnit: StatUnitWeek,
+			periods: []PeriodAdherence{
+				{Unit: "2026-W42", Expected: 3, Actual: 1, MissedDates: []string{"2026-10-17", "2026-10-18"}},
+				{Unit: "2026-W43", Expected: 3, Actual: 1, MissedDates: []string{"2026-10-24", "2026-10-25"}},
+			},
+		},
+		{
+			name:     "times per week extra check-ins not counted",
+			schedule: &Schedule{Type: ScheduleTypeTimesPerWeek, Times: 1},
+			checkins: []string{"2026-10-12", "2026-10-13"},
+			start:    "2026-10-12", end: "2026-10-18",
+			statUnit: StatUnitWeek,
+			periods: []PeriodAdherence{
+				{Unit: "2026-W42", Expected: 1, Actual: 1, MissedDates: []string{}},
+			},
+		},
+		{
+			name:     "times per week partial week prorated",
+			schedule: &Schedule{Type: ScheduleTypeTimesPerWeek, Times: 3},
+			checkins: []string{"2026-10-17"},
+			start:    "2026-10-16", end: "2026-10-18",
+			statUnit: StatUnitDay,
+			periods: []PeriodAdherence{
+				{Unit: "2026-10-16", Expected: 0, Actual: 0, MissedDates: []string{}},
+				{Unit: "2026-10-17", Expected: 1, Actual: 1, MissedDates: []string{}},
+				{Unit: "2026-10-18", Expected: 1, Actual: 0, MissedDates: []string{"2026-10-18"}},
+			},
+		},
+		{
+			name:     "end before start",
+			schedule: &Schedule{Type: ScheduleTypeDaily},
+			start:    "2026-10-14", end: "2026-10-12",
+			statUnit: StatUnitDay,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			bounds := testDays(t, c.start, c.end)
+			got := c.schedule.Adherence(testDays(t, c.checkins...), bounds[0], bounds[1], c.statUnit)
+			if len(got) != len(c.periods) {
+				t.Fatalf("got %d periods, want %d", len(got), len(c.periods))
+			}
+			for i, period := range got {
+				if !reflect.DeepEqual(*period, c.periods[i]) {
+					t.Errorf("period %d = %+v, want %+v", i, *period, c.periods[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleStreak(t *testing.T) {
+	cases := []struct {
+		name     string
+		schedule *Schedule
+		checkins []string
+		today    string
+		streak   int
+	}{
+		{"no check-ins", &Schedule{Type: ScheduleTypeDaily}, nil, "2026-10-19", 0},
+		{"today not yet checked in", &Schedule{Type: ScheduleTypeDaily}, []string{"2026-10-17", "2026-10-18"}, "2026-10-19", 2},
+		{"today checked in", &Schedule{Type: ScheduleTypeDaily}, []string{"2026-10-18", "2026-10-19"}, "2026-10-19", 2},
+		{"gap breaks", &Schedule{Type: ScheduleTypeDaily}, []string{"2026-10-15", "2026-10-17", "2026-10-18"}, "2026-10-18", 2},
+		{"rest days don't break", &Schedule{Type: ScheduleTypeWeekdays, Weekdays: []int{1, 3}}, []string{"2026-10-12", "2026-10-14"}, "2026-10-18", 2},
+		{"missed weekday breaks", &Schedule{Type: ScheduleTypeWeekdays, Weekdays: []int{1, 3}}, []string{"2026-10-05", "2026-10-12", "2026-10-14"}, "2026-10-18", 2},
+		{"weeks reaching times", &Schedule{Type: ScheduleTypeTimesPerWeek, Times: 2}, []string{"2026-10-05", "2026-10-07", "2026-10-12", "2026-10-16"}, "2026-10-18", 2},
+		{"current week not done", &Schedule{Type: ScheduleTypeTimesPerWeek, Times: 2}, []string{"2026-10-12", "2026-10-16"}, "2026-10-20", 1},
+		{"short week breaks", &Schedule{Type: ScheduleTypeTimesPerWeek, Times: 2}, []string{"2026-10-05", "2026-10-07", "2026-10-12"}, "2026-10-20", 0},
+		{"no schedule counts days", &Schedule{Type: ScheduleTypeNone}, []string{"2026-10-18", "2026-10-19"}, "2026-10-19", 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			today := testDays(t, c.today)[0]
+			if got := c.schedule.Streak(testDays(t, c.checkins...), today); got != c.streak {
+				t.Errorf("Streak = %d, want %d", got, c.streak)
+			}
+		})
+	}
+}
+
+func TestScheduleDayKey(t *testing.T) {
+	// 同一时区的不同Location实例，按天比较时应视为同一天
+	checkin := time.Date(2026, 10, 19, 8, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	today := time.Date(2026, 10, 19, 21, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	schedule := &Schedule{Type: ScheduleTypeDaily}
+
+	if schedule.NeedsReminder([]time.Time{checkin}, today) {
+		t.Error("NeedsReminder = true, want false")
+	}
+	if got := schedule.MissedOn([]time.Time{checkin}, today); got != 0 {
+		t.Errorf("MissedOn = %d, want 0", got)
+	}
+	if got := schedule.Streak([]time.Time{checkin}, today); got != 1 {
+		t.Errorf("Streak = %d, want 1", got)
+	}
+	if got := DayKey(checkin); got != 20261019 {
+		t.Errorf("DayKey = %d, want 20261019", got)
+	}
+}
@@ -0,0 +1,159 @@
+package objects
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// 搜索引擎
+const (
+	SearchEngineSql   = "sql"
+	SearchEngineBleve = "bleve"
+)
+
+// 搜索文档类型
+const (
+	SearchKindTarget  = "target"
+	SearchKindStep    = "step"
+	SearchKindComment = "comment"
+)
+
+func ValidSearchKind(kind string) bool {
+	switch kind {
+	case SearchKindTarget, SearchKindStep, SearchKindComment:
+		return true
+	}
+	return false
+}
+
+// 摘要的长度(字符数)
+const searchSnippetRunes = 120
+
+// SearchDocument 搜索索引中的一个文档，一个目标、一个积累或一条评论
+type SearchDocument struct {
+	UserID   string
+	Kind     string
+	TargetID uint64
+	// 目标文档为0
+	StepID uint64
+	// 评论文档的评论ID和角色
+	CommentID   uint64
+	CommentRole string
+	Title       string
+	Content     string
+	CreatedAt   int64
+}
+
+// Key 文档在索引中的唯一标识
+func (d *SearchDocument) Key() string {
+	switch d.Kind {
+	case SearchKindTarget:
+		return SearchKindTarget + ":" + strconv.FormatUint(d.TargetID, 10)
+	case SearchKindStep:
+		return SearchKindStep + ":" + strconv.FormatUint(d.StepID, 10)
+	default:
+		return SearchKindComment + ":" + strconv.FormatUint(d.StepID, 10) + ":" + strconv.FormatUint(d.CommentID, 10)
+	}
+}
+
+type SearchQuery struct {
+	UserID string
+	Query  string
+	// 为空时搜索全部类型
+	Kinds []string
+	// 按标签筛选，为nil时不筛选
+	Tagged *SearchTagged
+	Offset int
+	Limit  int
+}
+
+// SearchTagged 带有标签的目标和积累，目标下所有积累的文档也会匹配
+type SearchTagged struct {
+	TargetIDs []uint64
+	StepIDs   []uint64
+}
+
+func (t *SearchTagged) Empty() bool {
+	return len(t.TargetIDs) == 0 && len(t.StepIDs) == 0
+}
+
+type SearchHit struct {
+	Document *SearchDocument
+	Score    float64
+}
+
+// SearchTerms 按空白拆分搜索词并去重
+func SearchTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range strings.Fields(query) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// SearchSnippet 截取内容中第一个匹配词附近的一段作为摘要
+func SearchSnippet(content string, query string) string {
+	lower := strings.ToLower(content)
+	start := -1
+	for _, term := range SearchTerms(strings.ToLower(query)) {
+		if i := strings.Index(lower, term); i >= 0 && (start < 0 || i < start) {
+			start = i
+		}
+	}
+
+	runes := []rune(content)
+	from := 0
+	if start > 0 {
+		// 匹配词前保留一小段上下文
+		from = max(utf8.RuneCountInString(content[:start])-searchSnippetRunes/4, 0)
+	}
+	to := min(from+searchSnippetRunes, len(runes))
+
+	snippet := string(runes[from:to])
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+// CommentText 评论中的文字内容，评论是自由格式的json，取出其中所有的字符串
+func CommentText(commentMap map[string]any) string {
+	var texts []string
+	collectCommentText(commentMap, &texts)
+	return strings.Join(texts, "\n")
+}
+
+func collectCommentText(value any, texts *[]string) {
+	switch v := value.(type) {
+	case string:
+		if strings.TrimSpace(v) != "" {
+			*texts = append(*texts, v)
+		}
+	case []any:
+		for _, item := range v {
+			collectCommentText(item, texts)
+		}
+	case map[string]any:
+		// map无序，按key排序保证索引内容稳定
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			// 版本号不是评论内容
+			if key != "version" {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			collectCommentText(v[key], texts)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package objects
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	TemplateVisibilityPrivate = "private"
+	// 持有分享链接的用户可以查看和使用
+	TemplateVisibilityLink = "link"
+)
+
+// 模板树的规模限制，避免一次实例化创建过多数据
+const (
+	TemplateMaxTargets = 200
+	TemplateMaxDirs    = 2000
+	TemplateMaxDepth   = 10
+)
+
+func ValidTemplateVisibility(visibility string) bool {
+	return visibility == TemplateVisibilityPrivate || visibility == TemplateVisibilityLink
+}
+
+// TemplateTarget 模板中的目标，只保存目标树和目录结构，不含进度、积累和评论
+type TemplateTarget struct {
+	Title            string            `json:"title"`
+	Description      string            `json:"description,omitempty"`
+	Type             string            `json:"type,omitempty"`
+	Mode             string            `json:"mode,omitempty"`
+	Unit             string            `json:"unit,omitempty"`
+	GoalValue        float64           `json:"goal_value,omitempty"`
+	ScheduleType     string            `json:"schedule_type,omitempty"`
+	ScheduleWeekdays []int             `json:"schedule_weekdays,omitempty"`
+	ScheduleTimes    uint32            `json:"schedule_times,omitempty"`
+	Dirs             []*TemplateDir    `json:"dirs,omitempty"`
+	Children         []*TemplateTarget `json:"children,omitempty"`
+}
+
+// TemplateDir 模板中的目录积累
+type TemplateDir struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Dirs        []*TemplateDir `json:"dirs,omitempty"`
+}
+
+// Count 模板中目标和目录的数量
+func (t *TemplateTarget) Count() (targets int, dirs int) {
+	targets = 1
+	dirs = countTemplateDirs(t.Dirs)
+	for _, child := range t.Children {
+		childTargets, childDirs := child.Count()
+		targets += childTargets
+		dirs += childDirs
+	}
+	return targets, dirs
+}
+
+func countTemplateDirs(dirs []*TemplateDir) int {
+	count := len(dirs)
+	for _, dir := range dirs {
+		count += countTemplateDirs(dir.Dirs)
+	}
+	return count
+}
+
+func (t *TemplateTarget) depth() int {
+	depth := 0
+	for _, child := range t.Children {
+		depth = max(depth, child.depth())
+	}
+	return depth + 1
+}
+
+// Validate 校验模板的规模，目标名称不能为空
+func (t *TemplateTarget) Validate() error {
+	if t == nil {
+		return errors.New("template is empty")
+	}
+
+	targets, dirs := t.Count()
+	if targets > TemplateMaxTargets {
+		return fmt.Errorf("template has too many targets, max %d", TemplateMaxTargets)
+	}
+	if dirs > TemplateMaxDirs {
+		return fmt.Errorf("template has too many dirs, max %d", TemplateMaxDirs)
+	}
+	if t.depth() > TemplateMaxDepth {
+		return fmt.Errorf("template is too deep, max %d", TemplateMaxDepth)
+	}
+
+	return t.validateTitles()
+}
+
+func (t *TemplateTarget) validateTitles() error {
+	if t.Title == "" {
+		return errors.New("template target title is empty")
+	}
+	for _, child := range t.Children {
+		if err := child.validateTitles(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
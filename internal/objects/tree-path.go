@@ -0,0 +1,31 @@
+package objects
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 目标和积累目录的物化路径: 从根到父节点的祖先ID以/分隔，如/1/5/
+// 子树查询为路径前缀匹配，祖先查询为路径中的ID
+const RootPath = "/"
+
+// ChildPath 父节点下子节点的路径，也是父节点子树的路径前缀
+func ChildPath(parentPath string, parentID uint64) string {
+	if parentPath == "" {
+		parentPath = RootPath
+	}
+	return parentPath + strconv.FormatUint(parentID, 10) + "/"
+}
+
+// PathIDs 路径中的祖先ID，从根开始
+func PathIDs(path string) []uint64 {
+	ids := make([]uint64, 0)
+	for _, part := range strings.Split(strings.Trim(path, "/"), "/") {
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil || id == 0 {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -5,6 +5,7 @@ import (
 	"step/internal/biz"
 	"step/internal/conf"
 	"step/internal/objects"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/hibiken/asynq"
@@ -14,6 +15,7 @@ type AsynqServer struct {
 	log         *log.Helper
 	asynqServer *asynq.Server
 	mux         *asynq.ServeMux
+	scheduler   *asynq.Scheduler
 }
 
 // NewAsynqServer new an asynq server.
@@ -22,6 +24,12 @@ func NewAsynqServer(
 	logger log.Logger,
 	asynqStatisticsUsecase *biz.AsynqStatisticsUsecase,
 	asynqFeedbackUsecase *biz.AsynqFeedbackUsecase,
+	asynqDocumentUsecase *biz.AsynqDocumentUsecase,
+	asynqSearchUsecase *biz.AsynqSearchUsecase,
+	asynqExportUsecase *biz.AsynqExportUsecase,
+	asynqImportUsecase *biz.AsynqImportUsecase,
+	asynqReportUsecase *biz.AsynqReportUsecase,
+	asynqReviewUsecase *biz.AsynqReviewUsecase,
 ) *AsynqServer {
 	log := log.NewHelper(logger, log.WithMessageKey("asynqSrv msg"))
 
@@ -45,19 +53,55 @@ func NewAsynqServer(
 	mux.HandleFunc(objects.TypeStepCreate, asynqStatisticsUsecase.HandleStepCreate)
 	mux.HandleFunc(objects.TypeStepComment, asynqStatisticsUsecase.HandleStepComment)
 	mux.HandleFunc(objects.TypeFeedbackPortraitChange, asynqFeedbackUsecase.HandleFeedbackPortraitChange)
+	mux.HandleFunc(objects.TypeTargetMissedCheckin, asynqStatisticsUsecase.HandleTargetMissedCheckin)
+	mux.HandleFunc(objects.TypeTargetCheckinReminder, asynqStatisticsUsecase.HandleTargetCheckinReminder)
+	mux.HandleFunc(objects.TypeStepDocument, asynqDocumentUsecase.HandleStepDocument)
+	mux.HandleFunc(objects.TypeSearchIndex, asynqSearchUsecase.HandleSearchIndex)
+	mux.HandleFunc(objects.TypeTargetExport, asynqExportUsecase.HandleTargetExport)
+	mux.HandleFunc(objects.TypeImportProcess, asynqImportUsecase.HandleImport)
+	mux.HandleFunc(objects.TypeReportGenerate, asynqReportUsecase.HandleReportGenerate)
+	mux.HandleFunc(objects.TypeReviewRequestRemind, asynqReviewUsecase.HandleReviewRequestRemind)
+	mux.HandleFunc(objects.TypeReviewRequestNotify, asynqReviewUsecase.HandleReviewRequestNotify)
+
+	// 定时任务：每天凌晨检查前一天的漏打卡，晚上提醒当天还未打卡的目标。
+	// 每个实例都有调度器，触发的任务不带日期，处理时按日期作为任务ID再入队，每天只执行一次
+	scheduler := asynq.NewScheduler(
+		asynq.RedisClientOpt{
+			Addr:     dc.Redis.Addr,
+			Password: dc.Redis.Password,
+			DB:       int(dc.Redis.AsynqDb),
+		},
+		&asynq.SchedulerOpts{Location: time.Local},
+	)
+	periodicTasks := map[string]string{
+		objects.TypeTargetMissedCheckin:   "10 0 * * *",
+		objects.TypeTargetCheckinReminder: "0 19 * * *",
+	}
+	for taskType, cronspec := range periodicTasks {
+		_, err := scheduler.Register(cronspec, asynq.NewTask(taskType, nil), asynq.Queue(objects.QueueLow))
+		if err != nil {
+			log.Errorf("register periodic task %s: %v", taskType, err)
+		}
+	}
 
 	return &AsynqServer{
 		log:         log,
 		asynqServer: asynqServer,
 		mux:         mux,
+		scheduler:   scheduler,
 	}
 }
 
 func (qs *AsynqServer) Start(ctx context.Context) error {
+	err := qs.scheduler.Start()
+	if err != nil {
+		return err
+	}
 	return qs.asynqServer.Run(qs.mux)
 }
 
 func (qs *AsynqServer) Stop(ctx context.Context) error {
+	qs.scheduler.Shutdown()
 	qs.asynqServer.Stop()
 	qs.asynqServer.Shutdown()
 	return nil
@@ -4,8 +4,11 @@ import (
 	v1 "step/api/helloworld/v1"
 	minioApi "step/api/minio/v1"
 	stepApi "step/api/step/v1"
+	"step/internal/biz"
 	"step/internal/conf"
 	"step/internal/service"
+	"step/pkg/middleware/ratelimit"
+	"step/pkg/middleware/reason"
 	selfTrace "step/pkg/middleware/trace"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -13,13 +16,14 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
 func NewGRPCServer(
+	cd *conf.Data,
 	c *conf.Server,
 	greeter *service.GreeterService,
 	step *service.StepService,
@@ -27,6 +31,15 @@ func NewGRPCServer(
 	stepNoauth *service.StepNoauthService,
 	portrait *service.PortraitService,
 	feedback *service.FeedbackService,
+	tag *service.TagService,
+	template *service.TemplateService,
+	export *service.ExportService,
+	imp *service.ImportService,
+	report *service.ReportService,
+	reviewer *service.ReviewerService,
+	comment *service.CommentService,
+	rubric *service.RubricService,
+	guard biz.NoauthGuardRepo,
 	logger log.Logger,
 ) *grpc.Server {
 	var opts = []grpc.ServerOption{
@@ -36,7 +49,9 @@ func NewGRPCServer(
 			logging.Server(logger),
 			metadata.Server(),
 			metrics.Server(),
-			validate.Validator(),
+			selector.Server(ratelimit.Server(guard, noauthRules(cd)...)).Prefix(stepNoauthOperationPrefix).Build(),
+			reason.Validator(),
+			reason.Server(entErrorReason),
 			selfTrace.MetaServer(),
 			selfTrace.Server(),
 		),
@@ -57,6 +72,14 @@ func NewGRPCServer(
 	stepApi.RegisterStepNoauthServiceServer(srv, stepNoauth)
 	stepApi.RegisterPortraitServiceServer(srv, portrait)
 	stepApi.RegisterFeedbackServiceServer(srv, feedback)
+	stepApi.RegisterTagServiceServer(srv, tag)
+	stepApi.RegisterTemplateServiceServer(srv, template)
+	stepApi.RegisterExportServiceServer(srv, export)
+	stepApi.RegisterImportServiceServer(srv, imp)
+	stepApi.RegisterReportServiceServer(srv, report)
+	stepApi.RegisterReviewerServiceServer(srv, reviewer)
+	stepApi.RegisterCommentServiceServer(srv, comment)
+	stepApi.RegisterRubricServiceServer(srv, rubric)
 
 	return srv
 }
@@ -4,9 +4,12 @@ import (
 	v1 "step/api/helloworld/v1"
 	minioApi "step/api/minio/v1"
 	stepApi "step/api/step/v1"
+	"step/internal/biz"
 	"step/internal/conf"
 	"step/internal/service"
 
+	"step/pkg/middleware/ratelimit"
+	"step/pkg/middleware/reason"
 	selfTrace "step/pkg/middleware/trace"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -14,8 +17,8 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/metrics"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
-	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/gorilla/handlers"
 )
@@ -30,6 +33,15 @@ func NewHTTPServer(
 	stepNoauth *service.StepNoauthService,
 	portrait *service.PortraitService,
 	feedback *service.FeedbackService,
+	tag *service.TagService,
+	template *service.TemplateService,
+	export *service.ExportService,
+	imp *service.ImportService,
+	report *service.ReportService,
+	reviewer *service.ReviewerService,
+	comment *service.CommentService,
+	rubric *service.RubricService,
+	guard biz.NoauthGuardRepo,
 	logger log.Logger,
 ) *http.Server {
 	var opts = []http.ServerOption{
@@ -39,7 +51,9 @@ func NewHTTPServer(
 			logging.Server(logger),
 			metadata.Server(),
 			metrics.Server(),
-			validate.Validator(),
+			selector.Server(ratelimit.Server(guard, noauthRules(cd)...)).Prefix(stepNoauthOperationPrefix).Build(),
+			reason.Validator(),
+			reason.Server(entErrorReason),
 			selfTrace.MetaServer(),
 			selfTrace.Server(),
 		),
@@ -69,10 +83,24 @@ func NewHTTPServer(
 	stepApi.RegisterStepNoauthServiceHTTPServer(srv, stepNoauth)
 	stepApi.RegisterPortraitServiceHTTPServer(srv, portrait)
 	stepApi.RegisterFeedbackServiceHTTPServer(srv, feedback)
+	stepApi.RegisterTagServiceHTTPServer(srv, tag)
+	stepApi.RegisterTemplateServiceHTTPServer(srv, template)
+	stepApi.RegisterExportServiceHTTPServer(srv, export)
+	stepApi.RegisterImportServiceHTTPServer(srv, imp)
+	stepApi.RegisterReportServiceHTTPServer(srv, report)
+	stepApi.RegisterReviewerServiceHTTPServer(srv, reviewer)
+	stepApi.RegisterCommentServiceHTTPServer(srv, comment)
+	stepApi.RegisterRubricServiceHTTPServer(srv, rubric)
 
 	stepRoute := srv.Route("/step")
 	stepRoute.POST("/upload", step.Upload)
 
+	importRoute := srv.Route("/import")
+	importRoute.POST("/upload", imp.UploadImport)
+
+	stepNoauthRoute := srv.Route("/apis/step-go-noauth/step")
+	stepNoauthRoute.GET("/{id}/download", ratelimit.Route(guard, stepNoauth.Download, noauthRules(cd)...))
+
 	feedBackRoute := srv.Route("/feedback")
 	feedBackRoute.POST("/award/create", feedback.CreateFeedbackAward)
 	feedBackRoute.POST("/award/realize", feedback.RealizeFeedbackAward)
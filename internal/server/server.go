@@ -1,6 +1,18 @@
 package server
 
 import (
+	"errors"
+	"strings"
+
+	stepApi "step/api/step/v1"
+	"step/internal/conf"
+	"step/internal/data/ent"
+	entStep "step/internal/data/ent/step"
+	entTarget "step/internal/data/ent/target"
+	"step/internal/objects"
+	"step/pkg/middleware/ratelimit"
+
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 )
 
@@ -10,3 +22,57 @@ var ProviderSet = wire.NewSet(
 	NewHTTPServer,
 	NewAsynqServer,
 )
+
+// 免登录接口需要限流的服务前缀
+const stepNoauthOperationPrefix = "/step.v1.StepNoauthService/"
+
+// noauthRules 免登录接口按IP、按积累和IP限流，未配置时使用默认值
+func noauthRules(cd *conf.Data) []ratelimit.Rule {
+	c := cd.GetNoauth()
+	trustedProxies, err := ratelimit.ParseTrustedProxies(c.GetTrustedProxies())
+	if err != nil {
+		log.Fatalf("failed parsing noauth trusted proxies: %v", err)
+	}
+	orDefault := func(value uint32, def uint32) uint32 {
+		if value == 0 {
+			return def
+		}
+		return value
+	}
+	return []ratelimit.Rule{
+		ratelimit.ByIP(
+			orDefault(c.GetIpPerMinute(), objects.NoauthIpPerMinute),
+			orDefault(c.GetIpBurst(), objects.NoauthIpBurst),
+			trustedProxies,
+		),
+		ratelimit.ByStep(
+			orDefault(c.GetStepPerMinute(), objects.NoauthStepPerMinute),
+			orDefault(c.GetStepBurst(), objects.NoauthStepBurst),
+			trustedProxies,
+		),
+	}
+}
+
+// entErrorReason ent的错误转换为带ErrorReason的错误，不存在返回404，违反约束返回409，其它错误原样返回
+func entErrorReason(err error) error {
+	var notFound *ent.NotFoundError
+	if errors.As(err, &notFound) {
+		// NotFoundError没有导出label，从错误信息中取出
+		label := strings.TrimSuffix(strings.TrimPrefix(notFound.Error(), "ent: "), " not found")
+		switch label {
+		case entTarget.Label:
+			return stepApi.ErrorTargetNotFound("target not found").WithCause(err)
+		case entStep.Label:
+			return stepApi.ErrorStepNotFound("step not found").WithCause(err)
+		default:
+			return stepApi.ErrorResourceNotFound("%s not found", label).WithCause(err)
+		}
+	}
+
+	var constraint *ent.ConstraintError
+	if errors.As(err, &constraint) {
+		return stepApi.ErrorConflict("constraint failed").WithCause(err)
+	}
+
+	return err
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type CommentService struct {
+	stepApi.UnimplementedCommentServiceServer
+
+	uc *biz.CommentUsecase
+}
+
+func NewCommentService(uc *biz.CommentUsecase) *CommentService {
+	return &CommentService{uc: uc}
+}
+
+func (s *CommentService) GetStepComments(ctx context.Context, req *stepApi.GetStepCommentsRequest) (*stepApi.GetStepCommentsReply, error) {
+	return s.uc.GetStepComments(ctx, req)
+}
+
+func (s *CommentService) ReplyComment(ctx context.Context, req *stepApi.ReplyCommentRequest) (*stepApi.ReplyCommentReply, error) {
+	return s.uc.ReplyComment(ctx, req)
+}
+
+func (s *CommentService) UpdateComment(ctx context.Context, req *stepApi.UpdateCommentRequest) (*stepApi.UpdateCommentReply, error) {
+	return s.uc.UpdateComment(ctx, req)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type ExportService struct {
+	stepApi.UnimplementedExportServiceServer
+
+	uc *biz.ExportUsecase
+}
+
+func NewExportService(uc *biz.ExportUsecase) *ExportService {
+	return &ExportService{uc: uc}
+}
+
+func (s *ExportService) CreateExport(ctx context.Context, req *stepApi.CreateExportRequest) (*stepApi.CreateExportReply, error) {
+	return s.uc.CreateExport(ctx, req)
+}
+
+func (s *ExportService) GetExport(ctx context.Context, req *stepApi.GetExportRequest) (*stepApi.GetExportReply, error) {
+	return s.uc.GetExport(ctx, req)
+}
+
+func (s *ExportService) GetExports(ctx context.Context, req *stepApi.GetExportsRequest) (*stepApi.GetExportsReply, error) {
+	return s.uc.GetExports(ctx, req)
+}
+
+func (s *ExportService) DeleteExport(ctx context.Context, req *stepApi.DeleteExportRequest) (*stepApi.DeleteExportReply, error) {
+	return s.uc.DeleteExport(ctx, req)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+type ImportService struct {
+	stepApi.UnimplementedImportServiceServer
+
+	uc *biz.ImportUsecase
+}
+
+func NewImportService(uc *biz.ImportUsecase) *ImportService {
+	return &ImportService{uc: uc}
+}
+
+func (s *ImportService) UploadImport(ctx http.Context) error {
+	return s.uc.UploadImport(ctx)
+}
+
+func (s *ImportService) GetImport(ctx context.Context, req *stepApi.GetImportRequest) (*stepApi.GetImportReply, error) {
+	return s.uc.GetImport(ctx, req)
+}
+
+func (s *ImportService) GetImports(ctx context.Context, req *stepApi.GetImportsRequest) (*stepApi.GetImportsReply, error) {
+	return s.uc.GetImports(ctx, req)
+}
+
+func (s *ImportService) GetImportItems(ctx context.Context, req *stepApi.GetImportItemsRequest) (*stepApi.GetImportItemsReply, error) {
+	return s.uc.GetImportItems(ctx, req)
+}
+
+func (s *ImportService) ResumeImport(ctx context.Context, req *stepApi.ResumeImportRequest) (*stepApi.ResumeImportReply, error) {
+	return s.uc.ResumeImport(ctx, req)
+}
@@ -24,4 +24,6 @@ func (s *PortraitService) GetPortraitStepRate(ctx context.Context, req *stepApi.
 	return s.uc.GetPortraitStepRate(ctx, req)
 }
 
-
+func (s *PortraitService) GetPortraitTargetProgress(ctx context.Context, req *stepApi.GetPortraitTargetProgressRequest) (*stepApi.GetPortraitTargetProgressReply, error) {
+	return s.uc.GetPortraitTargetProgress(ctx, req)
+}
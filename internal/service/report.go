@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type ReportService struct {
+	stepApi.UnimplementedReportServiceServer
+
+	uc *biz.ReportUsecase
+}
+
+func NewReportService(uc *biz.ReportUsecase) *ReportService {
+	return &ReportService{uc: uc}
+}
+
+func (s *ReportService) CreateReport(ctx context.Context, req *stepApi.CreateReportRequest) (*stepApi.CreateReportReply, error) {
+	return s.uc.CreateReport(ctx, req)
+}
+
+func (s *ReportService) GetReport(ctx context.Context, req *stepApi.GetReportRequest) (*stepApi.GetReportReply, error) {
+	return s.uc.GetReport(ctx, req)
+}
+
+func (s *ReportService) GetReports(ctx context.Context, req *stepApi.GetReportsRequest) (*stepApi.GetReportsReply, error) {
+	return s.uc.GetReports(ctx, req)
+}
+
+func (s *ReportService) DeleteReport(ctx context.Context, req *stepApi.DeleteReportRequest) (*stepApi.DeleteReportReply, error) {
+	return s.uc.DeleteReport(ctx, req)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type ReviewerService struct {
+	stepApi.UnimplementedReviewerServiceServer
+
+	uc *biz.ReviewerUsecase
+}
+
+func NewReviewerService(uc *biz.ReviewerUsecase) *ReviewerService {
+	return &ReviewerService{uc: uc}
+}
+
+func (s *ReviewerService) CreateReviewerInvite(ctx context.Context, req *stepApi.CreateReviewerInviteRequest) (*stepApi.CreateReviewerInviteReply, error) {
+	return s.uc.CreateReviewerInvite(ctx, req)
+}
+
+func (s *ReviewerService) AcceptReviewerInvite(ctx context.Context, req *stepApi.AcceptReviewerInviteRequest) (*stepApi.AcceptReviewerInviteReply, error) {
+	return s.uc.AcceptReviewerInvite(ctx, req)
+}
+
+func (s *ReviewerService) GetReviewers(ctx context.Context, req *stepApi.GetReviewersRequest) (*stepApi.GetReviewersReply, error) {
+	return s.uc.GetReviewers(ctx, req)
+}
+
+func (s *ReviewerService) DeleteReviewer(ctx context.Context, req *stepApi.DeleteReviewerRequest) (*stepApi.DeleteReviewerReply, error) {
+	return s.uc.DeleteReviewer(ctx, req)
+}
+
+func (s *ReviewerService) GetReviewerInbox(ctx context.Context, req *stepApi.GetReviewerInboxRequest) (*stepApi.GetReviewerInboxReply, error) {
+	return s.uc.GetReviewerInbox(ctx, req)
+}
+
+func (s *ReviewerService) SetReviewerComment(ctx context.Context, req *stepApi.SetReviewerCommentRequest) (*stepApi.SetReviewerCommentReply, error) {
+	return s.uc.SetReviewerComment(ctx, req)
+}
+
+func (s *ReviewerService) RequestReview(ctx context.Context, req *stepApi.RequestReviewRequest) (*stepApi.RequestReviewReply, error) {
+	return s.uc.RequestReview(ctx, req)
+}
+
+func (s *ReviewerService) GetReviewRequests(ctx context.Context, req *stepApi.GetReviewRequestsRequest) (*stepApi.GetReviewRequestsReply, error) {
+	return s.uc.GetReviewRequests(ctx, req)
+}
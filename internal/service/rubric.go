@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type RubricService struct {
+	stepApi.UnimplementedRubricServiceServer
+
+	uc *biz.RubricUsecase
+}
+
+func NewRubricService(uc *biz.RubricUsecase) *RubricService {
+	return &RubricService{uc: uc}
+}
+
+func (s *RubricService) GetTargetRubric(ctx context.Context, req *stepApi.GetTargetRubricRequest) (*stepApi.GetTargetRubricReply, error) {
+	return s.uc.GetTargetRubric(ctx, req)
+}
+
+func (s *RubricService) SetTargetRubric(ctx context.Context, req *stepApi.SetTargetRubricRequest) (*stepApi.SetTargetRubricReply, error) {
+	return s.uc.SetTargetRubric(ctx, req)
+}
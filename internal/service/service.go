@@ -10,4 +10,12 @@ var ProviderSet = wire.NewSet(
 	NewStepNoauthService,
 	NewPortraitService,
 	NewFeedbackService,
+	NewTagService,
+	NewTemplateService,
+	NewExportService,
+	NewImportService,
+	NewReportService,
+	NewReviewerService,
+	NewCommentService,
+	NewRubricService,
 )
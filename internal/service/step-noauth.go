@@ -4,6 +4,8 @@ import (
 	"context"
 	stepApi "step/api/step/v1"
 	"step/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 type StepNoauthService struct {
@@ -20,6 +22,14 @@ func (s *StepNoauthService) GetNoauthStep(ctx context.Context, req *stepApi.GetN
 	return s.uc.GetNoauthStep(ctx, req)
 }
 
+func (s *StepNoauthService) GetCommentChallenge(ctx context.Context, req *stepApi.GetCommentChallengeRequest) (*stepApi.GetCommentChallengeReply, error) {
+	return s.uc.GetCommentChallenge(ctx, req)
+}
+
+func (s *StepNoauthService) GetCommentRubric(ctx context.Context, req *stepApi.GetCommentRubricRequest) (*stepApi.GetCommentRubricReply, error) {
+	return s.uc.GetCommentRubric(ctx, req)
+}
+
 func (s *StepNoauthService) SetCommentForStep(ctx context.Context, req *stepApi.SetCommentForStepRequest) (*stepApi.SetCommentForStepReply, error) {
 	return s.uc.SetCommentForStep(ctx, req)
 }
@@ -27,3 +37,7 @@ func (s *StepNoauthService) SetCommentForStep(ctx context.Context, req *stepApi.
 func (s *StepNoauthService) Decrypt(ctx context.Context, req *stepApi.DecryptRequest) (*stepApi.DecryptReply, error) {
 	return s.uc.Decrypt(ctx, req)
 }
+
+func (s *StepNoauthService) Download(ctx http.Context) error {
+	return s.uc.Download(ctx)
+}
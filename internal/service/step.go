@@ -11,11 +11,12 @@ import (
 type StepService struct {
 	stepApi.UnimplementedStepServiceServer
 
-	uc *biz.StepUsecase
+	uc       *biz.StepUsecase
+	searchUc *biz.SearchUsecase
 }
 
-func NewStepService(uc *biz.StepUsecase) *StepService {
-	return &StepService{uc: uc}
+func NewStepService(uc *biz.StepUsecase, searchUc *biz.SearchUsecase) *StepService {
+	return &StepService{uc: uc, searchUc: searchUc}
 }
 
 func (s *StepService) Upload(ctx http.Context) error {
@@ -46,6 +47,54 @@ func (s *StepService) GetTarget(ctx context.Context, req *stepApi.GetTargetReque
 	return s.uc.GetTarget(ctx, req)
 }
 
+func (s *StepService) GetTargetAdherence(ctx context.Context, req *stepApi.GetTargetAdherenceRequest) (*stepApi.GetTargetAdherenceReply, error) {
+	return s.uc.GetTargetAdherence(ctx, req)
+}
+
+func (s *StepService) AddTargetMeasurementStep(ctx context.Context, req *stepApi.AddTargetMeasurementStepRequest) (*stepApi.AddTargetMeasurementStepReply, error) {
+	return s.uc.AddTargetMeasurementStep(ctx, req)
+}
+
+func (s *StepService) CreateNoteStep(ctx context.Context, req *stepApi.CreateNoteStepRequest) (*stepApi.CreateNoteStepReply, error) {
+	return s.uc.CreateNoteStep(ctx, req)
+}
+
+func (s *StepService) UpdateNoteStep(ctx context.Context, req *stepApi.UpdateNoteStepRequest) (*stepApi.UpdateNoteStepReply, error) {
+	return s.uc.UpdateNoteStep(ctx, req)
+}
+
+func (s *StepService) CreateLinkStep(ctx context.Context, req *stepApi.CreateLinkStepRequest) (*stepApi.CreateLinkStepReply, error) {
+	return s.uc.CreateLinkStep(ctx, req)
+}
+
+func (s *StepService) UpdateLinkStep(ctx context.Context, req *stepApi.UpdateLinkStepRequest) (*stepApi.UpdateLinkStepReply, error) {
+	return s.uc.UpdateLinkStep(ctx, req)
+}
+
+func (s *StepService) RenameDirStep(ctx context.Context, req *stepApi.RenameDirStepRequest) (*stepApi.RenameDirStepReply, error) {
+	return s.uc.RenameDirStep(ctx, req)
+}
+
+func (s *StepService) MoveStep(ctx context.Context, req *stepApi.MoveStepRequest) (*stepApi.MoveStepReply, error) {
+	return s.uc.MoveStep(ctx, req)
+}
+
+func (s *StepService) ReorderTargets(ctx context.Context, req *stepApi.ReorderTargetsRequest) (*stepApi.ReorderTargetsReply, error) {
+	return s.uc.ReorderTargets(ctx, req)
+}
+
+func (s *StepService) ReorderSteps(ctx context.Context, req *stepApi.ReorderStepsRequest) (*stepApi.ReorderStepsReply, error) {
+	return s.uc.ReorderSteps(ctx, req)
+}
+
+func (s *StepService) Search(ctx context.Context, req *stepApi.SearchRequest) (*stepApi.SearchReply, error) {
+	return s.searchUc.Search(ctx, req)
+}
+
+func (s *StepService) CloneTarget(ctx context.Context, req *stepApi.CloneTargetRequest) (*stepApi.CloneTargetReply, error) {
+	return s.uc.CloneTarget(ctx, req)
+}
+
 func (s *StepService) GetTargetTree(ctx context.Context, req *stepApi.GetTargetTreeRequest) (*stepApi.GetTargetTreeReply, error) {
 	return s.uc.GetTargetTree(ctx, req)
 }
@@ -69,3 +118,19 @@ func (s *StepService) DeleteTargetStep(ctx context.Context, req *stepApi.DeleteT
 func (s *StepService) Encrypt(ctx context.Context, req *stepApi.EncryptRequest) (*stepApi.EncryptReply, error) {
 	return s.uc.Encrypt(ctx, req)
 }
+
+func (s *StepService) GetActivityTimeline(ctx context.Context, req *stepApi.GetActivityTimelineRequest) (*stepApi.GetActivityTimelineReply, error) {
+	return s.uc.GetActivityTimeline(ctx, req)
+}
+
+func (s *StepService) GetActivityHeatmap(ctx context.Context, req *stepApi.GetActivityHeatmapRequest) (*stepApi.GetActivityHeatmapReply, error) {
+	return s.uc.GetActivityHeatmap(ctx, req)
+}
+
+func (s *StepService) SetSelfAssessment(ctx context.Context, req *stepApi.SetSelfAssessmentRequest) (*stepApi.SetSelfAssessmentReply, error) {
+	return s.uc.SetSelfAssessment(ctx, req)
+}
+
+func (s *StepService) GetSelfAssessmentComparison(ctx context.Context, req *stepApi.GetSelfAssessmentComparisonRequest) (*stepApi.GetSelfAssessmentComparisonReply, error) {
+	return s.uc.GetSelfAssessmentComparison(ctx, req)
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type TagService struct {
+	stepApi.UnimplementedTagServiceServer
+
+	uc *biz.TagUsecase
+}
+
+func NewTagService(uc *biz.TagUsecase) *TagService {
+	return &TagService{uc: uc}
+}
+
+func (s *TagService) CreateTag(ctx context.Context, req *stepApi.CreateTagRequest) (*stepApi.CreateTagReply, error) {
+	return s.uc.CreateTag(ctx, req)
+}
+
+func (s *TagService) UpdateTag(ctx context.Context, req *stepApi.UpdateTagRequest) (*stepApi.UpdateTagReply, error) {
+	return s.uc.UpdateTag(ctx, req)
+}
+
+func (s *TagService) DeleteTag(ctx context.Context, req *stepApi.DeleteTagRequest) (*stepApi.DeleteTagReply, error) {
+	return s.uc.DeleteTag(ctx, req)
+}
+
+func (s *TagService) GetTags(ctx context.Context, req *stepApi.GetTagsRequest) (*stepApi.GetTagsReply, error) {
+	return s.uc.GetTags(ctx, req)
+}
+
+func (s *TagService) AssignTag(ctx context.Context, req *stepApi.AssignTagRequest) (*stepApi.AssignTagReply, error) {
+	return s.uc.AssignTag(ctx, req)
+}
+
+func (s *TagService) UnassignTag(ctx context.Context, req *stepApi.UnassignTagRequest) (*stepApi.UnassignTagReply, error) {
+	return s.uc.UnassignTag(ctx, req)
+}
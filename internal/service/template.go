@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"step/internal/biz"
+
+	stepApi "step/api/step/v1"
+)
+
+type TemplateService struct {
+	stepApi.UnimplementedTemplateServiceServer
+
+	uc *biz.TemplateUsecase
+}
+
+func NewTemplateService(uc *biz.TemplateUsecase) *TemplateService {
+	return &TemplateService{uc: uc}
+}
+
+func (s *TemplateService) CreateTemplate(ctx context.Context, req *stepApi.CreateTemplateRequest) (*stepApi.CreateTemplateReply, error) {
+	return s.uc.CreateTemplate(ctx, req)
+}
+
+func (s *TemplateService) UpdateTemplate(ctx context.Context, req *stepApi.UpdateTemplateRequest) (*stepApi.UpdateTemplateReply, error) {
+	return s.uc.UpdateTemplate(ctx, req)
+}
+
+func (s *TemplateService) DeleteTemplate(ctx context.Context, req *stepApi.DeleteTemplateRequest) (*stepApi.DeleteTemplateReply, error) {
+	return s.uc.DeleteTemplate(ctx, req)
+}
+
+func (s *TemplateService) GetTemplates(ctx context.Context, req *stepApi.GetTemplatesRequest) (*stepApi.GetTemplatesReply, error) {
+	return s.uc.GetTemplates(ctx, req)
+}
+
+func (s *TemplateService) GetTemplate(ctx context.Context, req *stepApi.GetTemplateRequest) (*stepApi.GetTemplateReply, error) {
+	return s.uc.GetTemplate(ctx, req)
+}
+
+func (s *TemplateService) GetSharedTemplate(ctx context.Context, req *stepApi.GetSharedTemplateRequest) (*stepApi.GetSharedTemplateReply, error) {
+	return s.uc.GetSharedTemplate(ctx, req)
+}
+
+func (s *TemplateService) InstantiateTemplate(ctx context.Context, req *stepApi.InstantiateTemplateRequest) (*stepApi.InstantiateTemplateReply, error) {
+	return s.uc.InstantiateTemplate(ctx, req)
+}
@@ -0,0 +1,203 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// Limiter 令牌桶限流，perMinute或burst为0时不限制
+type Limiter interface {
+	Allow(ctx context.Context, key string, perMinute uint32, burst uint32) bool
+}
+
+// Rule 按Key分桶限流，Key返回空字符串时跳过该规则
+type Rule struct {
+	Key       func(ctx context.Context, req interface{}) string
+	PerMinute uint32
+	Burst     uint32
+}
+
+// ParseTrustedProxies 可信代理的CIDR，单个IP按/32或/128处理
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	proxies := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %s", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %w", err)
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies, nil
+}
+
+// ByIP 按客户端IP限流，只信任来自trustedProxies的转发头
+func ByIP(perMinute uint32, burst uint32, trustedProxies []*net.IPNet) Rule {
+	return Rule{
+		Key: func(ctx context.Context, req interface{}) string {
+			ip := ClientIP(ctx, trustedProxies)
+			if ip == "" {
+				return ""
+			}
+			return "ip:" + ip
+		},
+		PerMinute: perMinute,
+		Burst:     burst,
+	}
+}
+
+// ByStep 按请求的积累ID和客户端IP限流，单个客户端不能用完其他人访问同一分享的额度；
+// 请求中没有id时从路由参数中获取
+func ByStep(perMinute uint32, burst uint32, trustedProxies []*net.IPNet) Rule {
+	return Rule{
+		Key: func(ctx context.Context, req interface{}) string {
+			id := stepID(ctx, req)
+			if id == "" {
+				return ""
+			}
+			ip := ClientIP(ctx, trustedProxies)
+			if ip == "" {
+				return "step:" + id
+			}
+			return "step:" + id + ":ip:" + ip
+		},
+		PerMinute: perMinute,
+		Burst:     burst,
+	}
+}
+
+func stepID(ctx context.Context, req interface{}) string {
+	if r, ok := req.(interface{ GetId() uint64 }); ok {
+		if id := r.GetId(); id > 0 {
+			return strconv.FormatUint(id, 10)
+		}
+		return ""
+	}
+	if c, ok := ctx.(http.Context); ok {
+		return c.Vars().Get("id")
+	}
+	return ""
+}
+
+func allow(ctx context.Context, limiter Limiter, req interface{}, rules []Rule) bool {
+	for _, rule := range rules {
+		key := rule.Key(ctx, req)
+		if key == "" {
+			continue
+		}
+		if !limiter.Allow(ctx, key, rule.PerMinute, rule.Burst) {
+			return false
+		}
+	}
+	return true
+}
+
+// Server 超过任一规则的频率时返回RATE_LIMITED
+func Server(limiter Limiter, rules ...Rule) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if !allow(ctx, limiter, req, rules) {
+				return nil, stepApi.ErrorRateLimited("too many requests")
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Route 用于没有经过中间件的自定义路由，如下载
+func Route(limiter Limiter, handler http.HandlerFunc, rules ...Rule) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		if !allow(ctx, limiter, nil, rules) {
+			return stepApi.ErrorRateLimited("too many requests")
+		}
+		return handler(ctx)
+	}
+}
+
+// ClientIP 连接来自可信代理时使用X-Forwarded-For和X-Real-IP，否则为连接的地址
+func ClientIP(ctx context.Context, trustedProxies []*net.IPNet) string {
+	if c, ok := ctx.(http.Context); ok {
+		return requestIP(c.Request().Header.Get, c.Request().RemoteAddr, trustedProxies)
+	}
+
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(*http.Transport); ok {
+			return requestIP(ht.RequestHeader().Get, ht.Request().RemoteAddr, trustedProxies)
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return hostOf(p.Addr.String())
+	}
+	return ""
+}
+
+func trusted(ip string, trustedProxies []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range trustedProxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestIP X-Forwarded-For从右往左跳过可信代理，第一个不可信的地址是客户端，
+// 更左边的地址可以由客户端任意填写
+func requestIP(header func(string) string, remoteAddr string, trustedProxies []*net.IPNet) string {
+	remote := hostOf(remoteAddr)
+	if !trusted(remote, trustedProxies) {
+		return remote
+	}
+
+	if forwarded := header("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(hops[i])
+			if net.ParseIP(ip) == nil {
+				break
+			}
+			if !trusted(ip, trustedProxies) {
+				return ip
+			}
+		}
+	}
+	if ip := strings.TrimSpace(header("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return remote
+}
+
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
@@ -0,0 +1,24 @@
+package reason
+
+import (
+	"context"
+	"errors"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Server 把handler返回的错误交给convert转换为带ErrorReason的错误，如ent的不存在和违反约束；
+// 已经带有ErrorReason的错误不再转换
+func Server(convert func(error) error) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			var kratosErr *kratosErrors.Error
+			if err != nil && !errors.As(err, &kratosErr) {
+				err = convert(err)
+			}
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package reason
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+
+	stepApi "step/api/step/v1"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// protoc-gen-validate生成的方法和错误类型
+type validatorAll interface {
+	ValidateAll() error
+}
+
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validator 校验请求的所有字段，返回INVALID_ARGUMENT，metadata为字段名到原因的映射
+func Validator() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if v, ok := req.(validatorAll); ok {
+				if err := v.ValidateAll(); err != nil {
+					return nil, validationError(err)
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func validationError(err error) error {
+	fields := make(map[string]string)
+	collectViolations(err, "", fields)
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	messages := make([]string, len(names))
+	for i, name := range names {
+		messages[i] = name + ": " + fields[name]
+	}
+
+	return stepApi.ErrorInvalidArgument("%s", strings.Join(messages, "; ")).
+		WithMetadata(fields).
+		WithCause(err)
+}
+
+// collectViolations 嵌套消息的字段用.连接，如schedule.type
+func collectViolations(err error, prefix string, fields map[string]string) {
+	var multi multiError
+	if errors.As(err, &multi) {
+		for _, e := range multi.AllErrors() {
+			collectViolations(e, prefix, fields)
+		}
+		return
+	}
+
+	var field fieldError
+	if !errors.As(err, &field) {
+		fields[strings.TrimSuffix(prefix, ".")] = err.Error()
+		return
+	}
+
+	name := prefix + fieldName(field.Field())
+	if cause := field.Cause(); cause != nil {
+		var nested fieldError
+		if errors.As(cause, &multi) || errors.As(cause, &nested) {
+			collectViolations(cause, name+".", fields)
+			return
+		}
+	}
+	fields[name] = field.Reason()
+}
+
+// fieldName 生成代码中的字段名是Go的命名(StepTypes[0])，转换为proto的命名(step_types[0])
+func fieldName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && name[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}